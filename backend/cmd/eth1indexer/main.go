@@ -18,6 +18,7 @@ import (
 
 	"github.com/gobitfly/beaconchain/pkg/commons/db"
 	"github.com/gobitfly/beaconchain/pkg/commons/erc20"
+	"github.com/gobitfly/beaconchain/pkg/commons/gas"
 	"github.com/gobitfly/beaconchain/pkg/commons/log"
 	"github.com/gobitfly/beaconchain/pkg/commons/metrics"
 	"github.com/gobitfly/beaconchain/pkg/commons/rpc"
@@ -68,6 +69,10 @@ func Run() {
 	tokenPriceExportList := fs.String("token.price.list", "", "Tokenlist path to use for the token price export")
 	tokenPriceExportFrequency := fs.Duration("token.price.frequency", time.Hour, "Token price export interval")
 
+	enableGasNow := fs.Bool("gasnow.enabled", false, "Enable gas price estimates export process")
+	gasNowBlocks := fs.Uint64("gasnow.blocks", 20, "Number of recent blocks to derive the gas price estimates from")
+	gasNowFrequency := fs.Duration("gasnow.frequency", time.Minute, "Gas price estimates export interval")
+
 	versionFlag := fs.Bool("version", false, "Print version and exit")
 
 	configPath := fs.String("config", "", "Path to the config file, if empty string defaults will be used")
@@ -189,6 +194,18 @@ func Run() {
 		}()
 	}
 
+	if *enableGasNow {
+		go func() {
+			for {
+				err := gas.CollectAndSave(bt, *gasNowBlocks)
+				if err != nil {
+					log.Error(err, "error while exporting gas price estimates", 0)
+				}
+				time.Sleep(*gasNowFrequency)
+			}
+		}()
+	}
+
 	if *enableEnsUpdater {
 		go ImportEnsUpdatesLoop(bt, client, *ensBatchSize)
 	}
@@ -210,7 +227,9 @@ func Run() {
 		bt.TransformUncle,
 		bt.TransformWithdrawals,
 		bt.TransformEnsNameRegistered,
-		bt.TransformContract)
+		bt.TransformContract,
+		bt.TransformLayer2,
+		bt.TransformSafe)
 
 	cache := freecache.NewCache(100 * 1024 * 1024) // 100 MB limit
 
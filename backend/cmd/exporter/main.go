@@ -193,6 +193,10 @@ func Run() {
 		go services.StartHistoricPriceService()
 	}
 
+	if utils.Config.PriceHistory.Enabled {
+		go services.StartPriceHistoryService()
+	}
+
 	usedModules := []modules.ModuleInterface{}
 
 	if cfg.JustV2 {
@@ -202,7 +206,14 @@ func Run() {
 			modules.NewSlotExporter(context),
 			modules.NewExecutionDepositsExporter(context),
 			modules.NewExecutionPayloadsExporter(context),
+			modules.NewPendingQueuesExporter(context),
 		)
+		if utils.Config.GossipExporter.Enabled {
+			usedModules = append(usedModules, modules.NewGossipExporter(context))
+		}
+	}
+	if utils.Config.RewardReconciliation.Enabled {
+		usedModules = append(usedModules, modules.NewRewardReconciler(context))
 	}
 
 	go modules.StartAll(context, usedModules, cfg.JustV2)
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"flag"
+	"time"
+
+	"github.com/gobitfly/beaconchain/cmd/misc/misctypes"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/gobitfly/beaconchain/pkg/consapi/fixtures"
+	"github.com/gobitfly/beaconchain/pkg/consapi/types"
+
+	"github.com/pkg/errors"
+)
+
+// RecordBeaconFixturesCommand records the beacon api responses of a slot range into a fixture file that can be replayed in tests
+type RecordBeaconFixturesCommand struct {
+	FlagSet *flag.FlagSet
+	Config  recordBeaconFixturesCommandConfig
+}
+
+type recordBeaconFixturesCommandConfig struct {
+	Node           string
+	StartSlot      uint64
+	EndSlot        uint64
+	Output         string
+	EventsDuration time.Duration
+}
+
+func (s *RecordBeaconFixturesCommand) ParseCommandOptions() {
+	s.FlagSet.StringVar(&s.Config.Node, "fixtures.node", "", "Beacon node to record from (Default: indexer node of the config)")
+	s.FlagSet.Uint64Var(&s.Config.StartSlot, "fixtures.start-slot", 0, "First slot to record")
+	s.FlagSet.Uint64Var(&s.Config.EndSlot, "fixtures.end-slot", 0, "Last slot to record")
+	s.FlagSet.StringVar(&s.Config.Output, "fixtures.output", "", "Path of the gzip compressed fixture file, e.g. pkg/consapi/testdata/holesky.json.gz")
+	s.FlagSet.DurationVar(&s.Config.EventsDuration, "fixtures.events-duration", 0, "How long to record the event stream of the node (Default: no events)")
+}
+
+func (s *RecordBeaconFixturesCommand) Requires() misctypes.Requires {
+	return misctypes.Requires{}
+}
+
+func (s *RecordBeaconFixturesCommand) Run() error {
+	if s.Config.Output == "" {
+		return errors.New("Please provide the fixture path via --fixtures.output")
+	}
+	if s.Config.EndSlot < s.Config.StartSlot {
+		return errors.New("The end slot must not be before the start slot")
+	}
+	if s.Config.Node == "" {
+		s.Config.Node = "http://" + utils.Config.Indexer.Node.Host + ":" + utils.Config.Indexer.Node.Port
+	}
+
+	recorder := fixtures.NewRecorder(s.Config.Node)
+	if s.Config.EventsDuration > 0 {
+		log.Infof("recording events of %s for %v", s.Config.Node, s.Config.EventsDuration)
+		err := recorder.RecordEvents([]types.EventTopic{types.EventHead, types.EventBlock, types.EventChainReorg, types.EventFinalizedCheckpoint}, s.Config.EventsDuration)
+		if err != nil {
+			return errors.Wrap(err, "Error recording events")
+		}
+	}
+
+	log.Infof("recording slots %d to %d of %s", s.Config.StartSlot, s.Config.EndSlot, s.Config.Node)
+	if err := recorder.RecordSlotRange(s.Config.StartSlot, s.Config.EndSlot); err != nil {
+		return errors.Wrap(err, "Error recording slot range")
+	}
+
+	fixture := recorder.Fixture()
+	if err := fixture.Save(s.Config.Output); err != nil {
+		return errors.Wrap(err, "Error saving fixture")
+	}
+	log.Infof("saved %d responses and %d events to %s", len(fixture.Responses), len(fixture.Events), s.Config.Output)
+	return nil
+}
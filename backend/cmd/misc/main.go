@@ -78,7 +78,8 @@ var opts = struct {
  * By default, all commands that are not in the REQUIRES_LIST will automatically require everything.
  */
 var REQUIRES_LIST = map[string]misctypes.Requires{
-	"app-bundle": (&commands.AppBundleCommand{}).Requires(),
+	"app-bundle":             (&commands.AppBundleCommand{}).Requires(),
+	"record-beacon-fixtures": (&commands.RecordBeaconFixturesCommand{}).Requires(),
 }
 
 func Run() {
@@ -92,8 +93,12 @@ func Run() {
 		FlagSet: fs,
 	}
 
+	recordBeaconFixturesCommand := commands.RecordBeaconFixturesCommand{
+		FlagSet: fs,
+	}
+
 	configPath := fs.String("config", "config/default.config.yml", "Path to the config file")
-	fs.StringVar(&opts.Command, "command", "", "command to run, available: updateAPIKey, applyDbSchema, initBigtableSchema, epoch-export, debug-rewards, debug-blocks, clear-bigtable, index-old-eth1-blocks, update-aggregation-bits, historic-prices-export, index-missing-blocks, export-epoch-missed-slots, migrate-last-attestation-slot-bigtable, export-genesis-validators, update-block-finalization-sequentially, nameValidatorsByRanges, export-stats-totals, export-sync-committee-periods, export-sync-committee-validator-stats, partition-validator-stats, migrate-app-purchases, collect-notifications, collect-user-db-notifications, verify-fcm-tokens, app-bundle")
+	fs.StringVar(&opts.Command, "command", "", "command to run, available: updateAPIKey, applyDbSchema, initBigtableSchema, epoch-export, debug-rewards, debug-blocks, clear-bigtable, index-old-eth1-blocks, update-aggregation-bits, historic-prices-export, index-missing-blocks, export-epoch-missed-slots, migrate-last-attestation-slot-bigtable, export-genesis-validators, update-block-finalization-sequentially, nameValidatorsByRanges, export-stats-totals, export-sync-committee-periods, export-sync-committee-validator-stats, partition-validator-stats, migrate-app-purchases, collect-notifications, collect-user-db-notifications, verify-fcm-tokens, app-bundle, record-beacon-fixtures, fix-ens, fix-ens-addresses, backfill-ens-history")
 	fs.Uint64Var(&opts.StartEpoch, "start-epoch", 0, "start epoch")
 	fs.Uint64Var(&opts.EndEpoch, "end-epoch", 0, "end epoch")
 	fs.Uint64Var(&opts.User, "user", 0, "user id")
@@ -118,6 +123,7 @@ func Run() {
 
 	statsPartitionCommand.ParseCommandOptions()
 	appBundleCommand.ParseCommandOptions()
+	recordBeaconFixturesCommand.ParseCommandOptions()
 	_ = fs.Parse(os.Args[2:])
 
 	if *versionFlag {
@@ -531,10 +537,14 @@ func Run() {
 	case "app-bundle":
 		appBundleCommand.Config.DryRun = opts.DryRun
 		err = appBundleCommand.Run()
+	case "record-beacon-fixtures":
+		err = recordBeaconFixturesCommand.Run()
 	case "fix-ens":
 		err = fixEns(erigonClient)
 	case "fix-ens-addresses":
 		err = fixEnsAddresses(erigonClient)
+	case "backfill-ens-history":
+		err = backfillEnsHistory(opts.StartBlock, opts.EndBlock, opts.BatchSize, opts.DataConcurrency, bt)
 	case "collect-notifications":
 		err = collectNotifications(opts.StartEpoch)
 	case "collect-user-db-notifications":
@@ -747,6 +757,82 @@ func fixEns(erigonClient *rpc.ErigonClient) error {
 	return nil
 }
 
+// backfillEnsHistory writes the ens name history rows of the already indexed blocks [startBlock, endBlock].
+// Unlike index-old-eth1-blocks it leaves the block keys used for reorg handling and the ens verification queue untouched.
+// Pass math.MaxInt64 as endBlock to backfill up to the last block in the blocks table
+func backfillEnsHistory(startBlock, endBlock, batchSize, concurrency uint64, bt *db.Bigtable) error {
+	log.InfoWithFields(log.Fields{"start": startBlock, "end": endBlock, "dry": opts.DryRun}, "command: backfill-ens-history")
+	if bt == nil {
+		return errors.New("no bigtable provided")
+	}
+	if concurrency == 0 {
+		return errors.New("concurrency must be greater than 0")
+	}
+
+	if endBlock == math.MaxInt64 {
+		lastBlockFromBlocksTable, err := bt.GetLastBlockInBlocksTable()
+		if err != nil {
+			return fmt.Errorf("error retrieving last block from blocks table: %w", err)
+		}
+		endBlock = uint64(lastBlockFromBlocksTable)
+	}
+	if endBlock < startBlock {
+		return fmt.Errorf("endBlock [%v] < startBlock [%v]", endBlock, startBlock)
+	}
+
+	cache := freecache.NewCache(100 * 1024 * 1024) // 100 MB limit
+	blockCount := utilMath.MaxU64(1, batchSize)
+	for from := startBlock; from <= endBlock; from += blockCount {
+		to := utilMath.MinU64(endBlock, from+blockCount-1)
+
+		blocks := make(chan *types.Eth1Block, blockCount)
+		g := new(errgroup.Group)
+		g.Go(func() error {
+			defer close(blocks)
+			return bt.GetFullBlocksDescending(blocks, to, from)
+		})
+
+		mu := sync.Mutex{}
+		bulkData := &types.BulkMutations{}
+		transformG := new(errgroup.Group)
+		transformG.SetLimit(int(concurrency))
+		for b := range blocks {
+			block := b
+			transformG.Go(func() error {
+				muts, _, err := bt.TransformEnsNameHistory(block, cache)
+				if err != nil {
+					return fmt.Errorf("error transforming block [%v]: %w", block.Number, err)
+				}
+				if muts == nil {
+					return nil
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				bulkData.Keys = append(bulkData.Keys, muts.Keys...)
+				bulkData.Muts = append(bulkData.Muts, muts.Muts...)
+				return nil
+			})
+		}
+		if err := transformG.Wait(); err != nil {
+			return err
+		}
+		if err := g.Wait(); err != nil {
+			return fmt.Errorf("error getting blocks %v-%v: %w", from, to, err)
+		}
+
+		log.InfoWithFields(log.Fields{"from": from, "to": to, "rows": len(bulkData.Keys)}, "backfilled ens name history")
+		if opts.DryRun || len(bulkData.Keys) == 0 {
+			cache.Clear()
+			continue
+		}
+		if err := bt.SaveEnsNameHistory(bulkData); err != nil {
+			return fmt.Errorf("error writing ens name history of blocks %v-%v: %w", from, to, err)
+		}
+		cache.Clear()
+	}
+	return nil
+}
+
 func fixEnsAddresses(erigonClient *rpc.ErigonClient) error {
 	log.InfoWithFields(log.Fields{"dry": opts.DryRun}, "command: fix-ens-addresses")
 	if opts.Addresses == "" {
@@ -1682,7 +1768,7 @@ func indexOldEth1Blocks(startBlock uint64, endBlock uint64, batchSize uint64, co
 	log.Infof("transformerFlag: %v", transformerFlag)
 	transformerList := strings.Split(transformerFlag, ",")
 	if transformerFlag == "all" {
-		transformerList = []string{"TransformBlock", "TransformTx", "TransformBlobTx", "TransformItx", "TransformERC20", "TransformERC721", "TransformERC1155", "TransformWithdrawals", "TransformUncle", "TransformEnsNameRegistered", "TransformContract"}
+		transformerList = []string{"TransformBlock", "TransformTx", "TransformBlobTx", "TransformItx", "TransformERC20", "TransformERC721", "TransformERC1155", "TransformWithdrawals", "TransformUncle", "TransformEnsNameRegistered", "TransformContract", "TransformLayer2", "TransformSafe"}
 	} else if len(transformerList) == 0 {
 		log.Error(nil, "no transformer functions provided", 0)
 		return
@@ -1717,6 +1803,10 @@ func indexOldEth1Blocks(startBlock uint64, endBlock uint64, batchSize uint64, co
 			importENSChanges = true
 		case "TransformContract":
 			transforms = append(transforms, bt.TransformContract)
+		case "TransformLayer2":
+			transforms = append(transforms, bt.TransformLayer2)
+		case "TransformSafe":
+			transforms = append(transforms, bt.TransformSafe)
 		default:
 			log.Error(nil, "Invalid transformer flag %v", 0)
 			return
@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"fmt"
@@ -19,6 +20,7 @@ import (
 	"github.com/go-openapi/spec"
 	"github.com/gobitfly/beaconchain/pkg/api"
 	dataaccess "github.com/gobitfly/beaconchain/pkg/api/data_access"
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	api_types "github.com/gobitfly/beaconchain/pkg/api/types"
 	"github.com/gobitfly/beaconchain/pkg/commons/log"
 	"github.com/gobitfly/beaconchain/pkg/commons/types"
@@ -490,6 +492,110 @@ func TestPublicAndSharedDashboards(t *testing.T) {
 	}
 }
 
+func TestValidatorDashboardMembers(t *testing.T) {
+	ctx := context.Background()
+	roles := enums.VDBMemberRoles
+	ownerId, err := dataAccessor.GetUserByEmail(ctx, "admin@admin.com")
+	require.NoError(t, err)
+	memberId := uint64(122558)
+
+	dashboard, err := dataAccessor.CreateValidatorDashboard(ctx, ownerId, "members test", utils.Config.Chain.ClConfig.DepositChainID)
+	require.NoError(t, err)
+	dashboardId := api_types.VDBIdPrimary(dashboard.Id)
+	t.Cleanup(func() {
+		_ = dataAccessor.RemoveValidatorDashboard(ctx, dashboardId)
+	})
+
+	t.Run("invite and accept", func(t *testing.T) {
+		require.NoError(t, dataAccessor.CreateValidatorDashboardInvite(ctx, dashboardId, ownerId, "Admin2@admin.com", roles.Editor, "members-test-token-1"))
+		// inviting the same email again replaces the previous invite
+		require.NoError(t, dataAccessor.CreateValidatorDashboardInvite(ctx, dashboardId, ownerId, "admin2@admin.com", roles.Viewer, "members-test-token-2"))
+		_, err := dataAccessor.GetValidatorDashboardInvite(ctx, "members-test-token-1")
+		assert.ErrorIs(t, err, dataaccess.ErrNotFound, "replaced invite should be invalidated")
+		invite, err := dataAccessor.GetValidatorDashboardInvite(ctx, "members-test-token-2")
+		require.NoError(t, err)
+		assert.Equal(t, dashboardId, invite.DashboardId)
+		assert.Equal(t, "admin2@admin.com", invite.Email)
+		assert.Equal(t, "viewer", invite.Role)
+
+		require.NoError(t, dataAccessor.AcceptValidatorDashboardInvite(ctx, "members-test-token-2", memberId))
+		role, err := dataAccessor.GetValidatorDashboardRole(ctx, dashboardId, memberId)
+		require.NoError(t, err)
+		assert.Equal(t, roles.Viewer, role)
+		assert.ErrorIs(t, dataAccessor.AcceptValidatorDashboardInvite(ctx, "members-test-token-2", memberId), dataaccess.ErrNotFound, "accepted invite should be removed")
+	})
+
+	t.Run("accepting an invite does not change the role of a member", func(t *testing.T) {
+		require.NoError(t, dataAccessor.CreateValidatorDashboardInvite(ctx, dashboardId, ownerId, "admin2@admin.com", roles.Admin, "members-test-token-3"))
+		require.NoError(t, dataAccessor.AcceptValidatorDashboardInvite(ctx, "members-test-token-3", memberId))
+		role, err := dataAccessor.GetValidatorDashboardRole(ctx, dashboardId, memberId)
+		require.NoError(t, err)
+		assert.Equal(t, roles.Viewer, role)
+
+		// the owner can't become a member of their own dashboard
+		require.NoError(t, dataAccessor.CreateValidatorDashboardInvite(ctx, dashboardId, memberId, "admin@admin.com", roles.Viewer, "members-test-token-4"))
+		require.NoError(t, dataAccessor.AcceptValidatorDashboardInvite(ctx, "members-test-token-4", ownerId))
+		role, err = dataAccessor.GetValidatorDashboardRole(ctx, dashboardId, ownerId)
+		require.NoError(t, err)
+		assert.Equal(t, roles.Owner, role)
+
+		members, err := dataAccessor.GetValidatorDashboardMembers(ctx, dashboardId)
+		require.NoError(t, err)
+		require.Len(t, members.Members, 2)
+		assert.Equal(t, ownerId, members.Members[0].UserId)
+		assert.Equal(t, "owner", members.Members[0].Role)
+		assert.Equal(t, memberId, members.Members[1].UserId)
+		assert.Equal(t, "admin2@admin.com", members.Members[1].Email)
+		assert.Empty(t, members.Invites)
+	})
+
+	t.Run("update and remove members", func(t *testing.T) {
+		require.NoError(t, dataAccessor.UpdateValidatorDashboardMember(ctx, dashboardId, memberId, roles.Editor))
+		role, err := dataAccessor.GetValidatorDashboardRole(ctx, dashboardId, memberId)
+		require.NoError(t, err)
+		assert.Equal(t, roles.Editor, role)
+
+		require.NoError(t, dataAccessor.RemoveValidatorDashboardMember(ctx, dashboardId, memberId))
+		_, err = dataAccessor.GetValidatorDashboardRole(ctx, dashboardId, memberId)
+		assert.ErrorIs(t, err, dataaccess.ErrNotFound)
+		assert.ErrorIs(t, dataAccessor.RemoveValidatorDashboardMember(ctx, dashboardId, memberId), dataaccess.ErrNotFound)
+		assert.ErrorIs(t, dataAccessor.UpdateValidatorDashboardMember(ctx, dashboardId, memberId, roles.Viewer), dataaccess.ErrNotFound)
+	})
+
+	t.Run("audit log", func(t *testing.T) {
+		groupId := uint64(0)
+		require.NoError(t, dataAccessor.AddValidatorDashboardAuditLogEntry(ctx, dashboardId, ownerId, "validators_added", &groupId, []api_types.VDBValidator{1, 2}))
+		require.NoError(t, dataAccessor.AddValidatorDashboardAuditLogEntry(ctx, dashboardId, memberId, "group_added", nil, nil))
+		require.NoError(t, dataAccessor.AddValidatorDashboardAuditLogEntry(ctx, dashboardId, ownerId, "validators_removed", &groupId, nil))
+
+		// newest entries first
+		entries, paging, err := dataAccessor.GetValidatorDashboardAuditLog(ctx, dashboardId, "", 2)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "validators_removed", entries[0].Action)
+		assert.Equal(t, &groupId, entries[0].GroupId)
+		assert.Nil(t, entries[0].Validators, "removing all validators of a group should not list them")
+		assert.Equal(t, "group_added", entries[1].Action)
+		assert.Equal(t, memberId, entries[1].UserId)
+		assert.Nil(t, entries[1].GroupId)
+		require.NotEmpty(t, paging.NextCursor)
+
+		entries, paging, err = dataAccessor.GetValidatorDashboardAuditLog(ctx, dashboardId, paging.NextCursor, 2)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "validators_added", entries[0].Action)
+		assert.Equal(t, "admin@admin.com", entries[0].Email)
+		assert.Equal(t, []uint64{1, 2}, entries[0].Validators)
+		assert.Empty(t, paging.NextCursor)
+		require.NotEmpty(t, paging.PrevCursor)
+
+		entries, _, err = dataAccessor.GetValidatorDashboardAuditLog(ctx, dashboardId, paging.PrevCursor, 2)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "validators_removed", entries[0].Action)
+	})
+}
+
 func TestApiDoc(t *testing.T) {
 	e := httpexpect.WithConfig(getExpectConfig(t, ts))
 
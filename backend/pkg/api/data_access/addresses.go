@@ -0,0 +1,480 @@
+package dataaccess
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// max number of indexed rows that are scanned for a single page of a filtered address table
+	maxAddressIndexScan = 1000
+	// number of indexed rows fetched per bigtable request while scanning
+	bigtableScanPageSize = 100
+)
+
+type AddressRepository interface {
+	GetAddressTransactions(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.BlockTransactionTableRow, *t.Paging, error)
+	GetAddressEventLogs(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.AddressEventLogTableRow, *t.Paging, error)
+	GetAddressBalanceHistory(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.AddressBalanceHistoryTableRow, *t.Paging, error)
+	GetAddressTokenSupplyHistory(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.AddressTokenSupplyHistoryTableRow, *t.Paging, error)
+}
+
+func (d *DataAccessService) GetAddressTransactions(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.BlockTransactionTableRow, *t.Paging, error) {
+	if err := d.checkExecutionLayerNetwork(chainId); err != nil {
+		return nil, nil, err
+	}
+
+	prefix := fmt.Sprintf("%d:I:TX:%x:%s:", chainId, address, db.FILTER_TIME)
+	pageToken, err := getBigtablePageToken(cursor, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txs, keys, err := d.bigtable.GetEth1TxsForAddress(pageToken, int64(limit+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	// one more row than requested is fetched to know whether there is a next page
+	moreDataFlag := uint64(len(txs)) > limit
+	if moreDataFlag {
+		txs = txs[:limit]
+		keys = keys[:limit]
+	}
+	if len(txs) == 0 {
+		return []t.BlockTransactionTableRow{}, &t.Paging{}, nil
+	}
+
+	txIndexes := make([]int64, len(keys))
+	for i, key := range keys {
+		txIndexes[i], err = getTxIndexFromIndexKey(key)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	interactions, err := d.bigtable.GetAddressContractInteractionsAtTransactions(txs, txIndexes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.BlockTransactionTableRow, 0, len(txs))
+	for i, tx := range txs {
+		result = append(result, d.getTransactionTableRow(tx, interactions[i], address))
+	}
+	if err := d.resolveTransactionTableAddressNames(result); err != nil {
+		return nil, nil, err
+	}
+	if err := d.labelMultisigExecutions(result); err != nil {
+		return nil, nil, err
+	}
+
+	p, err := getBigtablePaging(t.BigtableIndexCursor{PageToken: keys[len(keys)-1]}, moreDataFlag)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, p, nil
+}
+
+// Logs are looked up through the transactions the address took part in,
+// so logs emitted by a contract during a call it wasn't the direct recipient of are not included.
+func (d *DataAccessService) GetAddressEventLogs(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.AddressEventLogTableRow, *t.Paging, error) {
+	if err := d.checkExecutionLayerNetwork(chainId); err != nil {
+		return nil, nil, err
+	}
+
+	prefix := fmt.Sprintf("%d:I:TX:%x:%s:", chainId, address, db.FILTER_TIME)
+	var currentCursor t.EventLogsCursor
+	pageToken := prefix
+	if cursor != "" {
+		var err error
+		currentCursor, err = utils.StringToCursor[t.EventLogsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as EventLogsCursor: %w", err)
+		}
+		if !strings.HasPrefix(currentCursor.PageToken, prefix) {
+			return nil, nil, fmt.Errorf("passed cursor does not belong to address %#x", address)
+		}
+		pageToken = currentCursor.PageToken
+	}
+
+	result := make([]t.AddressEventLogTableRow, 0, limit)
+	blocks := make(map[uint64]*types.Eth1Block)
+	var nextCursor *t.EventLogsCursor
+	skipLogs := currentCursor.LogIndex
+	for scanned := 0; nextCursor == nil && scanned < maxAddressIndexScan; {
+		txs, keys, err := d.bigtable.GetEth1TxsForAddress(pageToken, bigtableScanPageSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(txs) == 0 {
+			break
+		}
+		scanned += len(txs)
+
+		for i, indexedTx := range txs {
+			blk, ok := blocks[indexedTx.GetBlockNumber()]
+			if !ok {
+				blk, err = d.bigtable.GetBlockFromBlocksTable(indexedTx.GetBlockNumber())
+				if err != nil {
+					return nil, nil, err
+				}
+				blocks[indexedTx.GetBlockNumber()] = blk
+			}
+
+			logIndex := uint64(0)
+			for _, tx := range blk.GetTransactions() {
+				if !bytes.Equal(tx.GetHash(), indexedTx.GetHash()) {
+					logIndex += uint64(len(tx.GetLogs()))
+					continue
+				}
+				for _, log := range tx.GetLogs() {
+					logIndex++
+					if logIndex-1 < skipLogs || !bytes.Equal(log.GetAddress(), address) {
+						continue
+					}
+					if uint64(len(result)) == limit {
+						// resume with this log on the next page
+						nextCursor = &t.EventLogsCursor{PageToken: pageToken, LogIndex: logIndex - 1}
+						if i > 0 {
+							nextCursor.PageToken = keys[i-1]
+						}
+						break
+					}
+					result = append(result, t.AddressEventLogTableRow{
+						TxHash:   t.Hash(hexutil.Encode(tx.GetHash())),
+						Block:    blk.GetNumber(),
+						Age:      uint64(blk.GetTime().AsTime().Unix()),
+						LogIndex: logIndex - 1,
+						Address:  t.Address{Hash: t.Hash(hexutil.Encode(log.GetAddress())), IsContract: true},
+						Event:    d.getEventLabel(log),
+						Topics:   hashesFromBytes(log.GetTopics()),
+						Data:     t.Hash(hexutil.Encode(log.GetData())),
+					})
+				}
+				break
+			}
+			skipLogs = 0
+			if nextCursor != nil {
+				break
+			}
+		}
+
+		pageToken = keys[len(keys)-1]
+		if len(txs) < bigtableScanPageSize {
+			break
+		}
+		if nextCursor == nil && scanned >= maxAddressIndexScan {
+			// stop scanning for now, the next page continues after the last scanned transaction
+			nextCursor = &t.EventLogsCursor{PageToken: pageToken}
+		}
+	}
+
+	if len(result) > 0 {
+		names := map[string]string{string(result[0].Address.Hash): ""}
+		if err := d.bigtable.GetAddressNames(names); err != nil {
+			return nil, nil, err
+		}
+		for i := range result {
+			applyAddressName(&result[i].Address, names)
+		}
+	}
+
+	if nextCursor == nil {
+		return result, &t.Paging{}, nil
+	}
+	p, err := getBigtablePaging(*nextCursor, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, p, nil
+}
+
+// The balance history is reconstructed backwards from the current balance using transactions, internal transactions and withdrawals.
+// Fee recipient rewards are not indexed per address and therefore not part of the history.
+func (d *DataAccessService) GetAddressBalanceHistory(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.AddressBalanceHistoryTableRow, *t.Paging, error) {
+	if err := d.checkExecutionLayerNetwork(chainId); err != nil {
+		return nil, nil, err
+	}
+
+	txPrefix := fmt.Sprintf("%d:I:TX:%x:%s:", chainId, address, db.FILTER_TIME)
+	itxPrefix := fmt.Sprintf("%d:I:ITX:%x:%s:", chainId, address, db.FILTER_TIME)
+	withdrawalPrefix := fmt.Sprintf("%d:I:W:%x:%s:", chainId, address, db.FILTER_TIME)
+
+	var currentCursor t.AddressBalanceHistoryCursor
+	if cursor != "" {
+		var err error
+		currentCursor, err = utils.StringToCursor[t.AddressBalanceHistoryCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as AddressBalanceHistoryCursor: %w", err)
+		}
+		if !strings.HasPrefix(currentCursor.TxPageToken, txPrefix) || !strings.HasPrefix(currentCursor.ItxPageToken, itxPrefix) || !strings.HasPrefix(currentCursor.WithdrawalPageToken, withdrawalPrefix) {
+			return nil, nil, fmt.Errorf("passed cursor does not belong to address %#x", address)
+		}
+	} else {
+		currentCursor.TxPageToken = txPrefix
+		currentCursor.ItxPageToken = itxPrefix
+		currentCursor.WithdrawalPageToken = withdrawalPrefix
+		balance, err := d.bigtable.GetBalanceForAddress(address, []byte{0x0})
+		if err != nil {
+			return nil, nil, err
+		}
+		if balance != nil {
+			currentCursor.Balance = weiBytesToDecimal(balance.Balance)
+		}
+	}
+
+	type balanceChange struct {
+		key    string // index key the change was read from
+		sortTs string // reverse padded timestamp, ascending order equals newest first
+		rank   int    // orders the different sources within a block, withdrawals are processed after all transactions
+		sortIx string // reverse padded position within the block
+		row    t.AddressBalanceHistoryTableRow
+	}
+	changes := make([]balanceChange, 0, 3*(limit+1))
+	splitKey := func(key string) (string, string) {
+		// <chainID>:I:<type>:<address>:TIME:<reversePaddedBigtableTimestamp>:<reversePaddedIndices>
+		parts := strings.SplitN(key, ":", 7)
+		if len(parts) < 7 {
+			return key, ""
+		}
+		return parts[5], parts[6]
+	}
+
+	txs, txKeys, err := d.bigtable.GetEth1TxsForAddress(currentCursor.TxPageToken, int64(limit+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, tx := range txs {
+		change := new(big.Int)
+		if tx.GetErrorMsg() == "" {
+			if bytes.Equal(tx.GetTo(), address) {
+				change.Add(change, new(big.Int).SetBytes(tx.GetValue()))
+			}
+			if bytes.Equal(tx.GetFrom(), address) {
+				change.Sub(change, new(big.Int).SetBytes(tx.GetValue()))
+			}
+		}
+		if bytes.Equal(tx.GetFrom(), address) {
+			change.Sub(change, new(big.Int).SetBytes(tx.GetTxFee()))
+			change.Sub(change, new(big.Int).SetBytes(tx.GetBlobTxFee()))
+		}
+		ts, ix := splitKey(txKeys[i])
+		changes = append(changes, balanceChange{key: txKeys[i], sortTs: ts, rank: 1, sortIx: ix, row: t.AddressBalanceHistoryTableRow{
+			Block:  tx.GetBlockNumber(),
+			Age:    uint64(tx.GetTime().AsTime().Unix()),
+			TxHash: t.Hash(hexutil.Encode(tx.GetHash())),
+			Type:   "transaction",
+			Change: decimal.NewFromBigInt(change, 0),
+		}})
+	}
+
+	itxs, itxKeys, err := d.bigtable.GetEth1ItxsForAddress(currentCursor.ItxPageToken, int64(limit+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, itx := range itxs {
+		change := new(big.Int)
+		if bytes.Equal(itx.GetTo(), address) {
+			change.Add(change, new(big.Int).SetBytes(itx.GetValue()))
+		}
+		if bytes.Equal(itx.GetFrom(), address) {
+			change.Sub(change, new(big.Int).SetBytes(itx.GetValue()))
+		}
+		ts, ix := splitKey(itxKeys[i])
+		changes = append(changes, balanceChange{key: itxKeys[i], sortTs: ts, rank: 1, sortIx: ix, row: t.AddressBalanceHistoryTableRow{
+			Block:  itx.GetBlockNumber(),
+			Age:    uint64(itx.GetTime().AsTime().Unix()),
+			TxHash: t.Hash(hexutil.Encode(itx.GetParentHash())),
+			Type:   "internal_transaction",
+			Change: decimal.NewFromBigInt(change, 0),
+		}})
+	}
+
+	withdrawals, withdrawalKeys, err := d.bigtable.GetEth1WithdrawalsForAddress(currentCursor.WithdrawalPageToken, int64(limit+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, withdrawal := range withdrawals {
+		ts, ix := splitKey(withdrawalKeys[i])
+		changes = append(changes, balanceChange{key: withdrawalKeys[i], sortTs: ts, rank: 0, sortIx: ix, row: t.AddressBalanceHistoryTableRow{
+			Block:  withdrawal.GetBlockNumber(),
+			Age:    uint64(withdrawal.GetTime().AsTime().Unix()),
+			Type:   "withdrawal",
+			Change: utils.GWeiBytesToWei(withdrawal.GetAmount()),
+		}})
+	}
+
+	slices.SortStableFunc(changes, func(a, b balanceChange) int {
+		if c := strings.Compare(a.sortTs, b.sortTs); c != 0 {
+			return c
+		}
+		if a.rank != b.rank {
+			return a.rank - b.rank
+		}
+		return strings.Compare(a.sortIx, b.sortIx)
+	})
+	moreDataFlag := uint64(len(changes)) > limit
+	if moreDataFlag {
+		changes = changes[:limit]
+	}
+
+	result := make([]t.AddressBalanceHistoryTableRow, 0, len(changes))
+	nextCursor := currentCursor
+	for _, change := range changes {
+		change.row.Balance = nextCursor.Balance
+		nextCursor.Balance = nextCursor.Balance.Sub(change.row.Change)
+		result = append(result, change.row)
+
+		switch change.row.Type {
+		case "transaction":
+			nextCursor.TxPageToken = change.key
+		case "internal_transaction":
+			nextCursor.ItxPageToken = change.key
+		case "withdrawal":
+			nextCursor.WithdrawalPageToken = change.key
+		}
+	}
+
+	p, err := getBigtablePaging(nextCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, p, nil
+}
+
+// The supply history is reconstructed backwards from the current total supply using the mints and burns of the token.
+func (d *DataAccessService) GetAddressTokenSupplyHistory(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.AddressTokenSupplyHistoryTableRow, *t.Paging, error) {
+	if err := d.checkExecutionLayerNetwork(chainId); err != nil {
+		return nil, nil, err
+	}
+
+	prefix := fmt.Sprintf("%d:I:ERC20:%x:%s:", chainId, address, db.FILTER_TIME)
+	var currentCursor t.TokenSupplyHistoryCursor
+	if cursor != "" {
+		var err error
+		currentCursor, err = utils.StringToCursor[t.TokenSupplyHistoryCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as TokenSupplyHistoryCursor: %w", err)
+		}
+		if !strings.HasPrefix(currentCursor.PageToken, prefix) {
+			return nil, nil, fmt.Errorf("passed cursor does not belong to token %#x", address)
+		}
+	} else {
+		metadata, err := d.bigtable.GetERC20MetadataForAddress(address)
+		if err != nil {
+			return nil, nil, err
+		}
+		if metadata == nil || len(metadata.TotalSupply) == 0 {
+			return nil, nil, fmt.Errorf("%w: no erc20 token found at address %#x", ErrNotFound, address)
+		}
+		currentCursor.PageToken = prefix
+		currentCursor.TotalSupply = weiBytesToDecimal(metadata.TotalSupply)
+	}
+
+	result := make([]t.AddressTokenSupplyHistoryTableRow, 0, limit)
+	pageToken := currentCursor.PageToken
+	skip := currentCursor.Skip
+	totalSupply := currentCursor.TotalSupply
+	var nextCursor *t.TokenSupplyHistoryCursor
+	for scanned := 0; nextCursor == nil; {
+		transfers, lastKey, err := d.bigtable.GetEth1TxForToken(pageToken, bigtableScanPageSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		scanned += len(transfers)
+
+		for i := skip; i < uint64(len(transfers)); i++ {
+			transfer := transfers[i]
+			var supplyChange decimal.Decimal
+			var changeType string
+			switch {
+			case bytes.Equal(transfer.GetFrom(), db.ZERO_ADDRESS):
+				supplyChange, changeType = weiBytesToDecimal(transfer.GetValue()), "mint"
+			case bytes.Equal(transfer.GetTo(), db.ZERO_ADDRESS):
+				supplyChange, changeType = weiBytesToDecimal(transfer.GetValue()).Neg(), "burn"
+			default:
+				continue
+			}
+			if uint64(len(result)) == limit {
+				// resume with this transfer on the next page
+				nextCursor = &t.TokenSupplyHistoryCursor{PageToken: pageToken, Skip: i, TotalSupply: totalSupply}
+				break
+			}
+			result = append(result, t.AddressTokenSupplyHistoryTableRow{
+				Block:       transfer.GetBlockNumber(),
+				Age:         uint64(transfer.GetTime().AsTime().Unix()),
+				TxHash:      t.Hash(hexutil.Encode(transfer.GetParentHash())),
+				Type:        changeType,
+				Change:      supplyChange,
+				TotalSupply: totalSupply,
+			})
+			totalSupply = totalSupply.Sub(supplyChange)
+		}
+		skip = 0
+
+		if len(transfers) < bigtableScanPageSize {
+			break
+		}
+		pageToken = lastKey
+		if nextCursor == nil && scanned >= maxAddressIndexScan {
+			nextCursor = &t.TokenSupplyHistoryCursor{PageToken: pageToken, TotalSupply: totalSupply}
+		}
+	}
+
+	if nextCursor == nil {
+		return result, &t.Paging{}, nil
+	}
+	p, err := getBigtablePaging(*nextCursor, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, p, nil
+}
+
+// returns the bigtable row key to continue scanning from; bigtable cursors must stay within the given prefix
+func getBigtablePageToken(cursor, prefix string) (string, error) {
+	if cursor == "" {
+		return prefix, nil
+	}
+	currentCursor, err := utils.StringToCursor[t.BigtableIndexCursor](cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse passed cursor as BigtableIndexCursor: %w", err)
+	}
+	if !strings.HasPrefix(currentCursor.PageToken, prefix) {
+		return "", fmt.Errorf("passed cursor does not match the requested data")
+	}
+	return currentCursor.PageToken, nil
+}
+
+// bigtable indexes can only be scanned forward, so only a next cursor is provided
+func getBigtablePaging[T t.CursorLike](nextCursor T, moreDataFlag bool) (*t.Paging, error) {
+	if !moreDataFlag {
+		return &t.Paging{}, nil
+	}
+	next, err := utils.CursorToString(nextCursor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate next_cursor: %w", err)
+	}
+	return &t.Paging{NextCursor: next}, nil
+}
+
+// <chainID>:I:TX:<address>:<filter>:<reversePaddedBigtableTimestamp>:<reversePaddedTxIndex>
+func getTxIndexFromIndexKey(key string) (int64, error) {
+	parts := strings.Split(key, ":")
+	reversed, err := strconv.ParseInt(parts[len(parts)-1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected transaction index key %s: %w", key, err)
+	}
+	return db.TX_PER_BLOCK_LIMIT - reversed, nil
+}
@@ -2,8 +2,11 @@ package dataaccess
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
 )
 
 type BlockRepository interface {
@@ -39,8 +42,24 @@ func (d *DataAccessService) GetBlockOverview(ctx context.Context, chainId, block
 }
 
 func (d *DataAccessService) GetBlockTransactions(ctx context.Context, chainId, block uint64) ([]t.BlockTransactionTableRow, error) {
-	// @DATA-ACCESS
-	return d.dummy.GetBlockTransactions(ctx, chainId, block)
+	if err := d.checkExecutionLayerNetwork(chainId); err != nil {
+		return nil, err
+	}
+	blk, err := d.bigtable.GetBlockFromBlocksTable(block)
+	if errors.Is(err, db.ErrBlockNotFound) {
+		return nil, fmt.Errorf("%w: block %d", ErrNotFound, block)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rows, err := d.getBlockTransactionTableRows(blk)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.resolveTransactionTableAddressNames(rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
 }
 
 func (d *DataAccessService) GetBlockVotes(ctx context.Context, chainId, block uint64) ([]t.BlockVoteTableRow, error) {
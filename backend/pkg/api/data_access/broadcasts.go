@@ -0,0 +1,81 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/gobitfly/beaconchain/pkg/nodejobs"
+)
+
+type BroadcastRepository interface {
+	CreateBroadcast(ctx context.Context, chainId uint64, data []byte) (*t.Broadcast, error)
+	GetBroadcast(ctx context.Context, chainId uint64, id string) (*t.Broadcast, error)
+}
+
+// broadcasts are node jobs, they are validated and stored here and submitted and tracked by the node jobs processor
+func (d *DataAccessService) CreateBroadcast(ctx context.Context, chainId uint64, data []byte) (*t.Broadcast, error) {
+	if chainId != utils.Config.Chain.ClConfig.DepositChainID {
+		return nil, fmt.Errorf("%w: broadcasting is not available for network %d", ErrNotFound, chainId)
+	}
+	job, err := nodejobs.CreateNodeJob(data)
+	if err != nil {
+		return nil, err
+	}
+	return nodeJobToBroadcast(job), nil
+}
+
+func (d *DataAccessService) GetBroadcast(ctx context.Context, chainId uint64, id string) (*t.Broadcast, error) {
+	if chainId != utils.Config.Chain.ClConfig.DepositChainID {
+		return nil, fmt.Errorf("%w: broadcasting is not available for network %d", ErrNotFound, chainId)
+	}
+	job, err := nodejobs.GetNodeJob(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: broadcast %s", ErrNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nodeJobToBroadcast(job), nil
+}
+
+func nodeJobToBroadcast(job *types.NodeJob) *t.Broadcast {
+	result := &t.Broadcast{
+		Id:          job.ID,
+		Type:        strings.ToLower(string(job.Type)),
+		Status:      strings.ToLower(string(job.Status)),
+		CreatedTime: job.CreatedTime.Unix(),
+	}
+	if job.SubmittedToNodeTime.Valid {
+		ts := job.SubmittedToNodeTime.Time.Unix()
+		result.SubmittedToNodeTime = &ts
+	}
+	if job.CompletedTime.Valid {
+		ts := job.CompletedTime.Time.Unix()
+		result.CompletedTime = &ts
+	}
+
+	switch job.Type {
+	case types.BLSToExecutionChangesNodeJobType:
+		if ops, ok := job.GetBLSToExecutionChangesNodeJobData(); ok {
+			for _, op := range ops {
+				result.Validators = append(result.Validators, uint64(op.Message.ValidatorIndex))
+			}
+		}
+	case types.VoluntaryExitsNodeJobType:
+		if op, ok := job.GetVoluntaryExitsNodeJobData(); ok {
+			result.Validators = []uint64{uint64(op.Message.ValidatorIndex)}
+		}
+	case types.SignedTransactionNodeJobType:
+		if tx, ok := job.GetSignedTransactionNodeJobData(); ok {
+			hash := t.Hash(tx.Hash().Hex())
+			result.TxHash = &hash
+		}
+	}
+	return result
+}
@@ -24,11 +24,26 @@ type DataAccessor interface {
 	ClientRepository
 	UserRepository
 	AppRepository
+	OAuthRepository
+	TwoFactorRepository
+	VerifiedAddressRepository
 	NotificationsRepository
 	AdminRepository
 	BlockRepository
+	TransactionRepository
+	AddressRepository
+	GasRepository
+	BroadcastRepository
+	EnsRepository
+	Layer2Repository
+	MultisigRepository
 	ArchiverRepository
 	ProtocolRepository
+	PriceHistoryRepository
+	SyncCommitteeRepository
+	ExecutionRequestsRepository
+	PendingQueuesRepository
+	RelaysRepository
 	RatelimitRepository
 	HealthzRepository
 	MachineRepository
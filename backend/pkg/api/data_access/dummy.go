@@ -12,6 +12,7 @@ import (
 
 	mathrand "math/rand"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-faker/faker/v4"
 	"github.com/go-faker/faker/v4/pkg/interfaces"
 	"github.com/go-faker/faker/v4/pkg/options"
@@ -175,6 +176,10 @@ func (d *DummyService) GetUserIdByApiKey(ctx context.Context, apiKey string) (ui
 	return getDummyData[uint64](ctx)
 }
 
+func (d *DummyService) AddUserApiKey(ctx context.Context, userId uint64, apiKey string, maxApiKeys uint64) (bool, error) {
+	return true, nil
+}
+
 func (d *DummyService) GetUserIdByConfirmationHash(ctx context.Context, hash string) (uint64, error) {
 	return getDummyData[uint64](ctx)
 }
@@ -258,8 +263,8 @@ func (d *DummyService) RemoveValidatorDashboardGroup(ctx context.Context, dashbo
 	return nil
 }
 
-func (d *DummyService) RemoveValidatorDashboardGroupValidators(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64) error {
-	return nil
+func (d *DummyService) RemoveValidatorDashboardGroupValidators(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64) ([]t.VDBValidator, error) {
+	return getDummyData[[]t.VDBValidator](ctx)
 }
 
 func (d *DummyService) GetValidatorDashboardGroupExists(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64) (bool, error) {
@@ -282,7 +287,7 @@ func (d *DummyService) AddValidatorDashboardValidatorsByGraffiti(ctx context.Con
 	return getDummyData[[]t.VDBPostValidatorsData](ctx)
 }
 
-func (d *DummyService) GetValidatorDashboardValidators(ctx context.Context, dashboardId t.VDBId, groupId int64, cursor string, colSort t.Sort[enums.VDBManageValidatorsColumn], search string, limit uint64) ([]t.VDBManageValidatorsTableRow, *t.Paging, error) {
+func (d *DummyService) GetValidatorDashboardValidators(ctx context.Context, dashboardId t.VDBId, groupId int64, cursor string, colSort t.Sort[enums.VDBManageValidatorsColumn], search string, limit uint64, credentialTypes []uint8) ([]t.VDBManageValidatorsTableRow, *t.Paging, error) {
 	return getDummyWithPaging[t.VDBManageValidatorsTableRow](ctx)
 }
 
@@ -306,6 +311,54 @@ func (d *DummyService) RemoveValidatorDashboardPublicId(ctx context.Context, pub
 	return nil
 }
 
+func (d *DummyService) GetValidatorDashboardRole(ctx context.Context, dashboardId t.VDBIdPrimary, userId uint64) (enums.VDBMemberRole, error) {
+	return enums.VDBMemberRoles.Owner, nil
+}
+
+func (d *DummyService) GetValidatorDashboardMembers(ctx context.Context, dashboardId t.VDBIdPrimary) (*t.VDBMembersData, error) {
+	return getDummyStruct[t.VDBMembersData](ctx)
+}
+
+func (d *DummyService) CreateValidatorDashboardInvite(ctx context.Context, dashboardId t.VDBIdPrimary, invitedBy uint64, email string, role enums.VDBMemberRole, token string) error {
+	return nil
+}
+
+func (d *DummyService) RemoveValidatorDashboardInvite(ctx context.Context, dashboardId t.VDBIdPrimary, email string) error {
+	return nil
+}
+
+func (d *DummyService) GetValidatorDashboardInvite(ctx context.Context, token string) (*t.VDBInviteInfo, error) {
+	return getDummyStruct[t.VDBInviteInfo](ctx)
+}
+
+func (d *DummyService) AcceptValidatorDashboardInvite(ctx context.Context, token string, userId uint64) error {
+	return nil
+}
+
+func (d *DummyService) UpdateValidatorDashboardMember(ctx context.Context, dashboardId t.VDBIdPrimary, userId uint64, role enums.VDBMemberRole) error {
+	return nil
+}
+
+func (d *DummyService) RemoveValidatorDashboardMember(ctx context.Context, dashboardId t.VDBIdPrimary, userId uint64) error {
+	return nil
+}
+
+func (d *DummyService) AddValidatorDashboardAuditLogEntry(ctx context.Context, dashboardId t.VDBIdPrimary, userId uint64, action string, groupId *uint64, validators []t.VDBValidator) error {
+	return nil
+}
+
+func (d *DummyService) GetValidatorDashboardAuditLog(ctx context.Context, dashboardId t.VDBIdPrimary, cursor string, limit uint64) ([]t.VDBAuditLogTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.VDBAuditLogTableRow](ctx)
+}
+
+func (d *DummyService) GetValidatorDashboardTwoFactorRequired(ctx context.Context, dashboardId t.VDBIdPrimary) (bool, error) {
+	return false, nil
+}
+
+func (d *DummyService) UpdateValidatorDashboardTwoFactorRequired(ctx context.Context, dashboardId t.VDBIdPrimary, required bool) error {
+	return nil
+}
+
 func (d *DummyService) GetValidatorDashboardSlotViz(ctx context.Context, dashboardId t.VDBId, groupIds []uint64) ([]t.SlotVizEpoch, error) {
 	r := struct {
 		Epochs []t.SlotVizEpoch `faker:"slice_len=4"`
@@ -314,14 +367,14 @@ func (d *DummyService) GetValidatorDashboardSlotViz(ctx context.Context, dashboa
 	return r.Epochs, err
 }
 
-func (d *DummyService) GetValidatorDashboardSummary(ctx context.Context, dashboardId t.VDBId, period enums.TimePeriod, cursor string, colSort t.Sort[enums.VDBSummaryColumn], search string, limit uint64, protocolModes t.VDBProtocolModes) ([]t.VDBSummaryTableRow, *t.Paging, error) {
+func (d *DummyService) GetValidatorDashboardSummary(ctx context.Context, dashboardId t.VDBId, period enums.TimePeriod, cursor string, colSort t.Sort[enums.VDBSummaryColumn], search string, limit uint64, protocolModes t.VDBProtocolModes, credentialTypes []uint8) ([]t.VDBSummaryTableRow, *t.Paging, error) {
 	return getDummyWithPaging[t.VDBSummaryTableRow](ctx)
 }
-func (d *DummyService) GetValidatorDashboardGroupSummary(ctx context.Context, dashboardId t.VDBId, groupId int64, period enums.TimePeriod, protocolModes t.VDBProtocolModes) (*t.VDBGroupSummaryData, error) {
+func (d *DummyService) GetValidatorDashboardGroupSummary(ctx context.Context, dashboardId t.VDBId, groupId int64, period enums.TimePeriod, protocolModes t.VDBProtocolModes, credentialTypes []uint8) (*t.VDBGroupSummaryData, error) {
 	return getDummyStruct[t.VDBGroupSummaryData](ctx)
 }
 
-func (d *DummyService) GetValidatorDashboardSummaryChart(ctx context.Context, dashboardId t.VDBId, groupIds []int64, efficiency enums.VDBSummaryChartEfficiencyType, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64) (*t.ChartData[int, float64], error) {
+func (d *DummyService) GetValidatorDashboardSummaryChart(ctx context.Context, dashboardId t.VDBId, groupIds []int64, efficiency enums.VDBSummaryChartEfficiencyType, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64, credentialTypes []uint8) (*t.ChartData[int, float64], error) {
 	return getDummyStruct[t.ChartData[int, float64]](ctx)
 }
 
@@ -338,7 +391,7 @@ func (d *DummyService) GetValidatorDashboardProposalSummaryValidators(ctx contex
 	return getDummyStruct[t.VDBProposalSummaryValidators](ctx)
 }
 
-func (d *DummyService) GetValidatorDashboardRewards(ctx context.Context, dashboardId t.VDBId, cursor string, colSort t.Sort[enums.VDBRewardsColumn], search string, limit uint64, protocolModes t.VDBProtocolModes) ([]t.VDBRewardsTableRow, *t.Paging, error) {
+func (d *DummyService) GetValidatorDashboardRewards(ctx context.Context, dashboardId t.VDBId, cursor string, colSort t.Sort[enums.VDBRewardsColumn], search string, limit uint64, protocolModes t.VDBProtocolModes, credentialTypes []uint8) ([]t.VDBRewardsTableRow, *t.Paging, error) {
 	return getDummyWithPaging[t.VDBRewardsTableRow](ctx)
 }
 
@@ -346,7 +399,7 @@ func (d *DummyService) GetValidatorDashboardGroupRewards(ctx context.Context, da
 	return getDummyStruct[t.VDBGroupRewardsData](ctx)
 }
 
-func (d *DummyService) GetValidatorDashboardRewardsChart(ctx context.Context, dashboardId t.VDBId, protocolModes t.VDBProtocolModes) (*t.ChartData[int, decimal.Decimal], error) {
+func (d *DummyService) GetValidatorDashboardRewardsChart(ctx context.Context, dashboardId t.VDBId, protocolModes t.VDBProtocolModes, credentialTypes []uint8) (*t.ChartData[int, decimal.Decimal], error) {
 	return getDummyStruct[t.ChartData[int, decimal.Decimal]](ctx)
 }
 
@@ -358,11 +411,11 @@ func (d *DummyService) GetValidatorDashboardBlocks(ctx context.Context, dashboar
 	return getDummyWithPaging[t.VDBBlocksTableRow](ctx)
 }
 
-func (d *DummyService) GetValidatorDashboardHeatmap(ctx context.Context, dashboardId t.VDBId, protocolModes t.VDBProtocolModes, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64) (*t.VDBHeatmap, error) {
+func (d *DummyService) GetValidatorDashboardHeatmap(ctx context.Context, dashboardId t.VDBId, metric enums.VDBHeatmapMetric, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64) (*t.VDBHeatmap, error) {
 	return getDummyStruct[t.VDBHeatmap](ctx)
 }
 
-func (d *DummyService) GetValidatorDashboardGroupHeatmap(ctx context.Context, dashboardId t.VDBId, groupId uint64, protocolModes t.VDBProtocolModes, aggregation enums.ChartAggregation, timestamp uint64) (*t.VDBHeatmapTooltipData, error) {
+func (d *DummyService) GetValidatorDashboardGroupHeatmap(ctx context.Context, dashboardId t.VDBId, groupId uint64, aggregation enums.ChartAggregation, timestamp uint64) (*t.VDBHeatmapTooltipData, error) {
 	return getDummyStruct[t.VDBHeatmapTooltipData](ctx)
 }
 
@@ -390,15 +443,39 @@ func (d *DummyService) GetValidatorDashboardTotalWithdrawals(ctx context.Context
 	return getDummyStruct[t.VDBTotalWithdrawalsData](ctx)
 }
 
-func (d *DummyService) GetValidatorDashboardRocketPool(ctx context.Context, dashboardId t.VDBId, cursor string, colSort t.Sort[enums.VDBRocketPoolColumn], search string, limit uint64) ([]t.VDBRocketPoolTableRow, *t.Paging, error) {
+func (d *DummyService) GetValidatorDashboardWithdrawalRequests(ctx context.Context, dashboardId t.VDBId, cursor string, limit uint64) ([]t.VDBWithdrawalRequestsTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.VDBWithdrawalRequestsTableRow](ctx)
+}
+
+func (d *DummyService) GetValidatorDashboardConsolidations(ctx context.Context, dashboardId t.VDBId, cursor string, limit uint64) ([]t.VDBConsolidationsTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.VDBConsolidationsTableRow](ctx)
+}
+
+func (d *DummyService) GetValidatorDashboardPendingQueues(ctx context.Context, dashboardId t.VDBId) (*t.VDBPendingQueuesData, error) {
+	return getDummyStruct[t.VDBPendingQueuesData](ctx)
+}
+
+func (d *DummyService) GetValidatorDashboardGossip(ctx context.Context, dashboardId t.VDBId) (*t.VDBGossipData, error) {
+	return getDummyStruct[t.VDBGossipData](ctx)
+}
+
+func (d *DummyService) GetValidatorDashboardMevBids(ctx context.Context, dashboardId t.VDBId, cursor string, limit uint64) ([]t.VDBMevBidsTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.VDBMevBidsTableRow](ctx)
+}
+
+func (d *DummyService) GetValidatorDashboardSsvClusters(ctx context.Context, dashboardId t.VDBId) ([]t.VDBSsvClusterTableRow, error) {
+	return getDummyData[[]t.VDBSsvClusterTableRow](ctx)
+}
+
+func (d *DummyService) GetValidatorDashboardRocketPool(ctx context.Context, dashboardId t.VDBId, groupId int64, cursor string, colSort t.Sort[enums.VDBRocketPoolColumn], search string, limit uint64) ([]t.VDBRocketPoolTableRow, *t.Paging, error) {
 	return getDummyWithPaging[t.VDBRocketPoolTableRow](ctx)
 }
 
-func (d *DummyService) GetValidatorDashboardTotalRocketPool(ctx context.Context, dashboardId t.VDBId, search string) (*t.VDBRocketPoolTableRow, error) {
+func (d *DummyService) GetValidatorDashboardTotalRocketPool(ctx context.Context, dashboardId t.VDBId, groupId int64, search string) (*t.VDBRocketPoolTableRow, error) {
 	return getDummyStruct[t.VDBRocketPoolTableRow](ctx)
 }
 
-func (d *DummyService) GetValidatorDashboardRocketPoolMinipools(ctx context.Context, dashboardId t.VDBId, node string, cursor string, colSort t.Sort[enums.VDBRocketPoolMinipoolsColumn], search string, limit uint64) ([]t.VDBRocketPoolMinipoolsTableRow, *t.Paging, error) {
+func (d *DummyService) GetValidatorDashboardRocketPoolMinipools(ctx context.Context, dashboardId t.VDBId, groupId int64, node string, cursor string, colSort t.Sort[enums.VDBRocketPoolMinipoolsColumn], search string, limit uint64) ([]t.VDBRocketPoolMinipoolsTableRow, *t.Paging, error) {
 	return getDummyWithPaging[t.VDBRocketPoolMinipoolsTableRow](ctx)
 }
 
@@ -522,6 +599,10 @@ func (d *DummyService) GetSearchValidatorsByWithdrawalCredential(ctx context.Con
 	return getDummyStruct[t.SearchValidatorsByWithdrwalCredential](ctx)
 }
 
+func (d *DummyService) GetSearchValidatorsByWithdrawalAddress(ctx context.Context, chainId uint64, address []byte) (*t.SearchValidatorsByWithdrwalCredential, error) {
+	return getDummyStruct[t.SearchValidatorsByWithdrwalCredential](ctx)
+}
+
 func (d *DummyService) GetSearchValidatorsByWithdrawalEnsName(ctx context.Context, chainId uint64, ensName string) (*t.SearchValidatorsByWithdrwalCredential, error) {
 	return getDummyStruct[t.SearchValidatorsByWithdrwalCredential](ctx)
 }
@@ -646,6 +727,182 @@ func (d *DummyService) GetAppDataFromRedirectUri(ctx context.Context, callback s
 	return getDummyStruct[t.OAuthAppData](ctx)
 }
 
+func (d *DummyService) GetOAuthAppByClientId(ctx context.Context, clientId string) (*t.OAuthClientApp, error) {
+	return getDummyStruct[t.OAuthClientApp](ctx)
+}
+
+func (d *DummyService) CreateOAuthAuthorizationCode(ctx context.Context, codeHash string, code t.OAuthAuthorizationCode) error {
+	return nil
+}
+
+func (d *DummyService) GetOAuthAuthorizationCode(ctx context.Context, codeHash string) (*t.OAuthAuthorizationCode, error) {
+	return getDummyStruct[t.OAuthAuthorizationCode](ctx)
+}
+
+func (d *DummyService) ExchangeOAuthAuthorizationCode(ctx context.Context, codeHash string, accessTokenHash, refreshTokenHash string, accessExpiresAt, refreshExpiresAt time.Time) error {
+	return nil
+}
+
+func (d *DummyService) GetOAuthAccessToken(ctx context.Context, accessTokenHash string) (*t.OAuthTokenInfo, error) {
+	return getDummyStruct[t.OAuthTokenInfo](ctx)
+}
+
+func (d *DummyService) GetOAuthRefreshToken(ctx context.Context, refreshTokenHash string) (*t.OAuthTokenInfo, error) {
+	return getDummyStruct[t.OAuthTokenInfo](ctx)
+}
+
+func (d *DummyService) RotateOAuthRefreshToken(ctx context.Context, oldRefreshTokenHash, accessTokenHash, refreshTokenHash string, accessExpiresAt, refreshExpiresAt time.Time) error {
+	return nil
+}
+
+func (d *DummyService) RevokeOAuthGrant(ctx context.Context, grantId uint64) error {
+	return nil
+}
+
+func (d *DummyService) RevokeOAuthToken(ctx context.Context, appId uint64, tokenHash string) error {
+	return nil
+}
+
+func (d *DummyService) GetUserOAuthApps(ctx context.Context, userId uint64) ([]t.UserOAuthApp, error) {
+	return getDummyData[[]t.UserOAuthApp](ctx)
+}
+
+func (d *DummyService) RemoveUserOAuthApp(ctx context.Context, userId, appId uint64) error {
+	return nil
+}
+
+func (d *DummyService) GetUserTwoFactorStatus(ctx context.Context, userId uint64) (*t.UserTwoFactorStatus, error) {
+	return getDummyStruct[t.UserTwoFactorStatus](ctx)
+}
+
+func (d *DummyService) HasUserTwoFactor(ctx context.Context, userId uint64) (bool, error) {
+	return false, nil
+}
+
+func (d *DummyService) GetUserTotp(ctx context.Context, userId uint64) (*t.UserTotp, error) {
+	return getDummyStruct[t.UserTotp](ctx)
+}
+
+func (d *DummyService) SetUserTotpSecret(ctx context.Context, userId uint64, secret string) error {
+	return nil
+}
+
+func (d *DummyService) ConfirmUserTotp(ctx context.Context, userId uint64, step int64) error {
+	return nil
+}
+
+func (d *DummyService) UpdateUserTotpLastUsedStep(ctx context.Context, userId uint64, step int64) error {
+	return nil
+}
+
+func (d *DummyService) RemoveUserTotp(ctx context.Context, userId uint64) error {
+	return nil
+}
+
+func (d *DummyService) GetUserWebAuthnCredentials(ctx context.Context, userId uint64) ([]t.WebAuthnCredential, error) {
+	return getDummyData[[]t.WebAuthnCredential](ctx)
+}
+
+func (d *DummyService) AddUserWebAuthnCredential(ctx context.Context, userId uint64, name string, credential t.WebAuthnCredential) error {
+	return nil
+}
+
+func (d *DummyService) UpdateUserWebAuthnSignCount(ctx context.Context, userId uint64, id uint64, signCount uint32) error {
+	return nil
+}
+
+func (d *DummyService) RemoveUserWebAuthnCredential(ctx context.Context, userId uint64, id uint64) error {
+	return nil
+}
+
+func (d *DummyService) ReplaceUserRecoveryCodes(ctx context.Context, userId uint64, codeHashes []string) error {
+	return nil
+}
+
+func (d *DummyService) UseUserRecoveryCode(ctx context.Context, userId uint64, codeHash string) (bool, error) {
+	return true, nil
+}
+
+func (d *DummyService) ReserveUserTwoFactorAttempt(ctx context.Context, userId uint64) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (d *DummyService) ResetUserTwoFactorFailures(ctx context.Context, userId uint64) error {
+	return nil
+}
+
+func (d *DummyService) GetUserIdByVerifiedAddress(ctx context.Context, address common.Address) (uint64, error) {
+	return getDummyData[uint64](ctx)
+}
+
+func (d *DummyService) GetUserVerifiedAddresses(ctx context.Context, userId uint64) ([]t.VerifiedAddress, error) {
+	return getDummyData[[]t.VerifiedAddress](ctx)
+}
+
+func (d *DummyService) AddUserVerifiedAddress(ctx context.Context, userId uint64, address common.Address) (bool, error) {
+	return true, nil
+}
+
+func (d *DummyService) RemoveUserVerifiedAddress(ctx context.Context, userId uint64, address common.Address) error {
+	return nil
+}
+
+func (d *DummyService) GetEthPriceHistory(ctx context.Context, currency string, resolution string, afterTs, beforeTs uint64) (*t.EthPriceHistoryData, error) {
+	return getDummyStruct[t.EthPriceHistoryData](ctx)
+}
+
+func (d *DummyService) GetDailyPrices(ctx context.Context, a, b string, days []time.Time) (map[time.Time]float64, error) {
+	result := make(map[time.Time]float64, len(days))
+	for _, day := range days {
+		result[day.UTC().Truncate(time.Hour*24)] = 1
+	}
+	return result, nil
+}
+
+func (d *DummyService) GetSyncCommittee(ctx context.Context, chainId, period uint64) (*t.SyncCommitteeData, error) {
+	return getDummyStruct[t.SyncCommitteeData](ctx)
+}
+
+func (d *DummyService) GetSyncCommitteeSlots(ctx context.Context, chainId, period uint64) ([]t.SyncCommitteeSlot, error) {
+	return getDummyData[[]t.SyncCommitteeSlot](ctx)
+}
+
+func (d *DummyService) GetWithdrawalRequests(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.WithdrawalRequest, *t.Paging, error) {
+	return getDummyWithPaging[t.WithdrawalRequest](ctx)
+}
+
+func (d *DummyService) GetConsolidationRequests(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.ConsolidationRequest, *t.Paging, error) {
+	return getDummyWithPaging[t.ConsolidationRequest](ctx)
+}
+
+func (d *DummyService) GetValidatorQueue(ctx context.Context, chainId uint64) (*t.NetworkValidatorQueue, error) {
+	return getDummyStruct[t.NetworkValidatorQueue](ctx)
+}
+
+func (d *DummyService) GetPendingDeposits(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.PendingDeposit, *t.Paging, error) {
+	return getDummyWithPaging[t.PendingDeposit](ctx)
+}
+
+func (d *DummyService) GetPendingPartialWithdrawals(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.PendingPartialWithdrawal, *t.Paging, error) {
+	return getDummyWithPaging[t.PendingPartialWithdrawal](ctx)
+}
+
+func (d *DummyService) GetPendingConsolidations(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.PendingConsolidation, *t.Paging, error) {
+	return getDummyWithPaging[t.PendingConsolidation](ctx)
+}
+
+func (d *DummyService) GetRelayPerformance(ctx context.Context, chainId uint64) ([]t.RelayPerformance, error) {
+	return getDummyData[[]t.RelayPerformance](ctx)
+}
+
+func (d *DummyService) GetBuilderPerformance(ctx context.Context, chainId uint64) ([]t.BuilderPerformance, error) {
+	return getDummyData[[]t.BuilderPerformance](ctx)
+}
+
+func (d *DummyService) GetValidatorDashboardSyncCommittees(ctx context.Context, dashboardId t.VDBId) ([]t.VDBSyncCommitteesTableRow, error) {
+	return getDummyData[[]t.VDBSyncCommitteesTableRow](ctx)
+}
+
 func (d *DummyService) AddUserDevice(ctx context.Context, userID uint64, hashedRefreshToken string, deviceID, deviceName string, appID uint64) error {
 	return nil
 }
@@ -670,6 +927,86 @@ func (d *DummyService) GetBlockTransactions(ctx context.Context, chainId, block
 	return getDummyData[[]t.BlockTransactionTableRow](ctx)
 }
 
+func (d *DummyService) GetTransactions(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.BlockTransactionTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.BlockTransactionTableRow](ctx)
+}
+
+func (d *DummyService) GetTransaction(ctx context.Context, chainId uint64, hash []byte) (*t.TransactionDetails, error) {
+	return getDummyStruct[t.TransactionDetails](ctx)
+}
+
+func (d *DummyService) GetAddressTransactions(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.BlockTransactionTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.BlockTransactionTableRow](ctx)
+}
+
+func (d *DummyService) GetAddressEventLogs(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.AddressEventLogTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.AddressEventLogTableRow](ctx)
+}
+
+func (d *DummyService) GetAddressBalanceHistory(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.AddressBalanceHistoryTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.AddressBalanceHistoryTableRow](ctx)
+}
+
+func (d *DummyService) GetAddressTokenSupplyHistory(ctx context.Context, chainId uint64, address []byte, cursor string, limit uint64) ([]t.AddressTokenSupplyHistoryTableRow, *t.Paging, error) {
+	return getDummyWithPaging[t.AddressTokenSupplyHistoryTableRow](ctx)
+}
+
+func (d *DummyService) GetGasNow(ctx context.Context, chainId uint64) (*t.GasNowData, error) {
+	return getDummyStruct[t.GasNowData](ctx)
+}
+
+func (d *DummyService) GetAverageGasLimitHistory(ctx context.Context, chainId uint64, afterTs uint64, beforeTs uint64) (*t.ChartData[string, float64], error) {
+	return getDummyStruct[t.ChartData[string, float64]](ctx)
+}
+
+func (d *DummyService) GetGasUsedHistory(ctx context.Context, chainId uint64, afterTs uint64, beforeTs uint64) (*t.ChartData[string, float64], error) {
+	return getDummyStruct[t.ChartData[string, float64]](ctx)
+}
+
+func (d *DummyService) CreateBroadcast(ctx context.Context, chainId uint64, data []byte) (*t.Broadcast, error) {
+	return getDummyStruct[t.Broadcast](ctx)
+}
+
+func (d *DummyService) GetBroadcast(ctx context.Context, chainId uint64, id string) (*t.Broadcast, error) {
+	return getDummyStruct[t.Broadcast](ctx)
+}
+
+func (d *DummyService) GetEnsName(ctx context.Context, chainId uint64, name string) (*t.EnsNameDetails, error) {
+	return getDummyStruct[t.EnsNameDetails](ctx)
+}
+
+func (d *DummyService) GetAddressEnsNames(ctx context.Context, chainId uint64, address string) (*t.EnsAddressNames, error) {
+	return getDummyStruct[t.EnsAddressNames](ctx)
+}
+
+func (d *DummyService) GetEnsBatchResolution(ctx context.Context, chainId uint64, names []string, addresses []string) (*t.EnsBatchResolution, error) {
+	return getDummyStruct[t.EnsBatchResolution](ctx)
+}
+
+func (d *DummyService) GetLayer2Batches(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.Layer2Batch, *t.Paging, error) {
+	return getDummyWithPaging[t.Layer2Batch](ctx)
+}
+
+func (d *DummyService) GetLayer1ToLayer2Transactions(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.Layer1ToLayer2Transaction, *t.Paging, error) {
+	return getDummyWithPaging[t.Layer1ToLayer2Transaction](ctx)
+}
+
+func (d *DummyService) GetLayer2ToLayer1Transactions(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.Layer2ToLayer1Transaction, *t.Paging, error) {
+	return getDummyWithPaging[t.Layer2ToLayer1Transaction](ctx)
+}
+
+func (d *DummyService) GetMultisigSafe(ctx context.Context, address []byte) (*t.MultisigSafe, error) {
+	return getDummyStruct[t.MultisigSafe](ctx)
+}
+
+func (d *DummyService) GetMultisigSafeTransactions(ctx context.Context, address []byte, cursor string, limit uint64) ([]t.MultisigSafeTransaction, *t.Paging, error) {
+	return getDummyWithPaging[t.MultisigSafeTransaction](ctx)
+}
+
+func (d *DummyService) GetMultisigTransactionConfirmations(ctx context.Context, safeTxHash []byte) ([]t.MultisigTransactionConfirmation, error) {
+	return getDummyData[[]t.MultisigTransactionConfirmation](ctx)
+}
+
 func (d *DummyService) GetBlock(ctx context.Context, chainId, block uint64) (*t.BlockSummary, error) {
 	return getDummyStruct[t.BlockSummary](ctx)
 }
@@ -742,6 +1079,18 @@ func (d *DummyService) GetRocketPoolOverview(ctx context.Context) (*t.RocketPool
 	return getDummyStruct[t.RocketPoolData](ctx)
 }
 
+func (d *DummyService) GetRocketPoolNodes(ctx context.Context, cursor string, limit uint64) ([]t.RocketPoolNode, *t.Paging, error) {
+	return getDummyWithPaging[t.RocketPoolNode](ctx)
+}
+
+func (d *DummyService) GetRocketPoolMinipools(ctx context.Context, node []byte, cursor string, limit uint64) ([]t.RocketPoolMinipool, *t.Paging, error) {
+	return getDummyWithPaging[t.RocketPoolMinipool](ctx)
+}
+
+func (d *DummyService) GetStakingProtocol(ctx context.Context, protocol string) (*t.StakingProtocolData, error) {
+	return getDummyStruct[t.StakingProtocolData](ctx)
+}
+
 func (d *DummyService) GetApiWeights(ctx context.Context) ([]t.ApiWeightItem, error) {
 	return getDummyData[[]t.ApiWeightItem](ctx)
 }
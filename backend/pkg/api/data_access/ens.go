@@ -0,0 +1,260 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/cache"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+)
+
+// resolutions (including unresolvable names and addresses without names) are cached for this duration
+const ensCacheDuration = time.Minute * 5
+
+type EnsRepository interface {
+	GetEnsName(ctx context.Context, chainId uint64, name string) (*t.EnsNameDetails, error)
+	GetAddressEnsNames(ctx context.Context, chainId uint64, address string) (*t.EnsAddressNames, error)
+	GetEnsBatchResolution(ctx context.Context, chainId uint64, names []string, addresses []string) (*t.EnsBatchResolution, error)
+}
+
+// cached forward resolution of a name, Address is empty if the name does not resolve
+type ensNameCacheEntry struct {
+	Address   string `json:"address"`
+	IsPrimary bool   `json:"is_primary"`
+	ValidTo   int64  `json:"valid_to"`
+}
+
+// cached reverse resolution of an address, Names contains all names resolving to the address with the primary name first
+type ensAddressCacheEntry struct {
+	Names []ensAddressCacheName `json:"names"`
+}
+
+type ensAddressCacheName struct {
+	Name      string `json:"name"`
+	IsPrimary bool   `json:"is_primary"`
+	ValidTo   int64  `json:"valid_to"`
+}
+
+func (e *ensAddressCacheEntry) primaryName() string {
+	if len(e.Names) > 0 && e.Names[0].IsPrimary {
+		return e.Names[0].Name
+	}
+	return ""
+}
+
+func (d *DataAccessService) GetEnsName(ctx context.Context, chainId uint64, name string) (*t.EnsNameDetails, error) {
+	if err := d.checkExecutionLayerNetwork(chainId); err != nil {
+		return nil, err
+	}
+	resolved, err := d.resolveEnsNames(ctx, chainId, []string{name})
+	if err != nil {
+		return nil, err
+	}
+	history, err := d.bigtable.GetEnsNameHistory(name)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving history of ens name %s: %w", name, err)
+	}
+	entry := resolved[name]
+	if entry.Address == "" && len(history) == 0 {
+		return nil, fmt.Errorf("%w: ens name %s", ErrNotFound, name)
+	}
+
+	result := &t.EnsNameDetails{
+		Name:    name,
+		History: ensNameHistory(history),
+	}
+	if entry.Address != "" {
+		address := t.Hash(entry.Address)
+		result.Address = &address
+		result.IsPrimary = entry.IsPrimary
+		result.ExpiresAt = &entry.ValidTo
+	}
+	// names without a current resolution still carry the expiry of their latest registration or renewal
+	if result.ExpiresAt == nil && len(result.History) > 0 {
+		result.ExpiresAt = &result.History[0].ExpiresAt
+	}
+	return result, nil
+}
+
+// ensNameHistory converts the indexed events of a name, events with an owner are registrations and the others renewals
+func ensNameHistory(events []*db.EnsNameEvent) []t.EnsNameHistoryEntry {
+	history := make([]t.EnsNameHistoryEntry, 0, len(events))
+	for _, event := range events {
+		entry := t.EnsNameHistoryEntry{
+			Type:      "renewal",
+			Block:     event.Event.BlockNumber,
+			Timestamp: event.Event.Time.AsTime().Unix(),
+			TxHash:    t.Hash(event.TxHash.Hex()),
+			ExpiresAt: event.Event.Expires.AsTime().Unix(),
+		}
+		if len(event.Event.Owner) > 0 {
+			owner := t.Hash(hexutil.Encode(event.Event.Owner))
+			entry.Type = "registration"
+			entry.Owner = &owner
+		}
+		history = append(history, entry)
+	}
+	return history
+}
+
+func (d *DataAccessService) GetAddressEnsNames(ctx context.Context, chainId uint64, address string) (*t.EnsAddressNames, error) {
+	if err := d.checkExecutionLayerNetwork(chainId); err != nil {
+		return nil, err
+	}
+	address = hexutil.Encode(common.FromHex(address))
+	resolved, err := d.reverseResolveEnsAddresses(ctx, chainId, []string{address})
+	if err != nil {
+		return nil, err
+	}
+	entry := resolved[address]
+	result := &t.EnsAddressNames{
+		Address:     t.Hash(address),
+		PrimaryName: entry.primaryName(),
+		Names:       make([]t.EnsName, 0, len(entry.Names)),
+	}
+	for _, name := range entry.Names {
+		result.Names = append(result.Names, t.EnsName{
+			Name:      name.Name,
+			IsPrimary: name.IsPrimary,
+			ExpiresAt: name.ValidTo,
+		})
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetEnsBatchResolution(ctx context.Context, chainId uint64, names []string, addresses []string) (*t.EnsBatchResolution, error) {
+	if err := d.checkExecutionLayerNetwork(chainId); err != nil {
+		return nil, err
+	}
+	normalized := normalizeEnsAddresses(addresses)
+	resolvedNames, err := d.resolveEnsNames(ctx, chainId, names)
+	if err != nil {
+		return nil, err
+	}
+	resolvedAddresses, err := d.reverseResolveEnsAddresses(ctx, chainId, normalized)
+	if err != nil {
+		return nil, err
+	}
+	return ensBatchResolution(names, normalized, resolvedNames, resolvedAddresses), nil
+}
+
+// normalizeEnsAddresses returns the addresses as lower case hex, the addresses of the caller are left untouched
+func normalizeEnsAddresses(addresses []string) []string {
+	normalized := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		normalized = append(normalized, hexutil.Encode(common.FromHex(address)))
+	}
+	return normalized
+}
+
+// ensBatchResolution lists every requested name and address, the ones that don't resolve map to nil
+func ensBatchResolution(names, addresses []string, resolvedNames map[string]ensNameCacheEntry, resolvedAddresses map[string]ensAddressCacheEntry) *t.EnsBatchResolution {
+	result := &t.EnsBatchResolution{
+		Names:     make(map[string]*t.Hash, len(names)),
+		Addresses: make(map[string]*string, len(addresses)),
+	}
+	for _, name := range names {
+		result.Names[name] = nil
+		if entry := resolvedNames[name]; entry.Address != "" {
+			address := t.Hash(entry.Address)
+			result.Names[name] = &address
+		}
+	}
+	for _, address := range addresses {
+		result.Addresses[address] = nil
+		if entry := resolvedAddresses[address]; entry.primaryName() != "" {
+			name := entry.primaryName()
+			result.Addresses[address] = &name
+		}
+	}
+	return result
+}
+
+// resolveEnsNames resolves the given (normalised) names to their addresses, unresolvable names are returned with an empty address
+func (d *DataAccessService) resolveEnsNames(ctx context.Context, chainId uint64, names []string) (map[string]ensNameCacheEntry, error) {
+	return getCachedEnsResolutions(chainId, "n", names, func(missing []string) (map[string]ensNameCacheEntry, error) {
+		entries, err := db.GetEnsEntriesForNames(ctx, missing)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving ens entries for names: %w", err)
+		}
+		result := make(map[string]ensNameCacheEntry, len(entries))
+		for _, entry := range entries {
+			result[entry.EnsName] = ensNameCacheEntry{
+				Address:   hexutil.Encode(entry.Address),
+				IsPrimary: entry.IsPrimaryName,
+				ValidTo:   entry.ValidTo.Unix(),
+			}
+		}
+		return result, nil
+	})
+}
+
+// reverseResolveEnsAddresses returns all names resolving to the given (lower case hex) addresses, primary names first
+func (d *DataAccessService) reverseResolveEnsAddresses(ctx context.Context, chainId uint64, addresses []string) (map[string]ensAddressCacheEntry, error) {
+	return getCachedEnsResolutions(chainId, "a", addresses, func(missing []string) (map[string]ensAddressCacheEntry, error) {
+		addressBytes := make([][]byte, 0, len(missing))
+		for _, address := range missing {
+			addressBytes = append(addressBytes, common.FromHex(address))
+		}
+		entries, err := db.GetEnsEntriesForAddresses(ctx, addressBytes)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving ens entries for addresses: %w", err)
+		}
+		result := make(map[string]ensAddressCacheEntry, len(entries))
+		for _, entry := range entries {
+			address := hexutil.Encode(entry.Address)
+			resolved := result[address]
+			resolved.Names = append(resolved.Names, ensAddressCacheName{
+				Name:      entry.EnsName,
+				IsPrimary: entry.IsPrimaryName,
+				ValidTo:   entry.ValidTo.Unix(),
+			})
+			result[address] = resolved
+		}
+		return result, nil
+	})
+}
+
+// getCachedEnsResolutions returns the cached resolutions of the given keys and fetches the missing ones.
+// Keys that could not be resolved are cached as zero values so repeated lookups don't hit the database.
+func getCachedEnsResolutions[T any](chainId uint64, kind string, keys []string, fetch func(missing []string) (map[string]T, error)) (map[string]T, error) {
+	result := make(map[string]T, len(keys))
+	missing := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if cache.TieredCache != nil {
+			var cached T
+			if _, err := cache.TieredCache.GetWithLocalTimeout(ensCacheKey(chainId, kind, key), ensCacheDuration, &cached); err == nil {
+				result[key] = cached
+				continue
+			}
+		}
+		missing = append(missing, key)
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	fetched, err := fetch(missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range missing {
+		value := fetched[key]
+		result[key] = value
+		if cache.TieredCache != nil {
+			if err := cache.TieredCache.Set(ensCacheKey(chainId, kind, key), value, ensCacheDuration); err != nil {
+				log.Error(err, "error caching ens resolution", 0, map[string]interface{}{"key": key})
+			}
+		}
+	}
+	return result, nil
+}
+
+func ensCacheKey(chainId uint64, kind string, key string) string {
+	return fmt.Sprintf("%d:ens:%s:%s", chainId, kind, key)
+}
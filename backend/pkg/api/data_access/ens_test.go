@@ -0,0 +1,119 @@
+package dataaccess
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNormalizeEnsAddresses(test *testing.T) {
+	addresses := []string{"0xABCDEF0000000000000000000000000000000001", "abcdef0000000000000000000000000000000002"}
+	normalized := normalizeEnsAddresses(addresses)
+	expected := []string{"0xabcdef0000000000000000000000000000000001", "0xabcdef0000000000000000000000000000000002"}
+	if !slices.Equal(normalized, expected) {
+		test.Errorf("expected %v, got %v", expected, normalized)
+	}
+	if addresses[0] != "0xABCDEF0000000000000000000000000000000001" || addresses[1] != "abcdef0000000000000000000000000000000002" {
+		test.Errorf("expected the addresses of the caller to be left untouched, got %v", addresses)
+	}
+}
+
+func TestEnsBatchResolution(test *testing.T) {
+	names := []string{"foo.eth", "expired.eth"}
+	addresses := []string{"0x01", "0x02", "0x03"}
+	resolvedNames := map[string]ensNameCacheEntry{
+		"foo.eth": {Address: "0x01", IsPrimary: true},
+		// names that don't resolve are cached without an address
+		"expired.eth": {},
+	}
+	resolvedAddresses := map[string]ensAddressCacheEntry{
+		"0x01": {Names: []ensAddressCacheName{{Name: "foo.eth", IsPrimary: true}, {Name: "bar.eth"}}},
+		// an address without a primary name has no reverse resolution
+		"0x02": {Names: []ensAddressCacheName{{Name: "baz.eth"}}},
+	}
+
+	result := ensBatchResolution(names, addresses, resolvedNames, resolvedAddresses)
+	address := t.Hash("0x01")
+	name := "foo.eth"
+	expected := &t.EnsBatchResolution{
+		Names:     map[string]*t.Hash{"foo.eth": &address, "expired.eth": nil},
+		Addresses: map[string]*string{"0x01": &name, "0x02": nil, "0x03": nil},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("expected %+v, got %+v", expected, result)
+	}
+}
+
+func TestGetCachedEnsResolutions(test *testing.T) {
+	var fetched []string
+	fetch := func(missing []string) (map[string]ensNameCacheEntry, error) {
+		fetched = append(fetched, missing...)
+		return map[string]ensNameCacheEntry{"foo.eth": {Address: "0x01"}}, nil
+	}
+	result, err := getCachedEnsResolutions(1, "n", []string{"foo.eth", "unknown.eth"}, fetch)
+	if err != nil {
+		test.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(fetched, []string{"foo.eth", "unknown.eth"}) {
+		test.Errorf("expected all names to be fetched without a cache, got %v", fetched)
+	}
+	expected := map[string]ensNameCacheEntry{"foo.eth": {Address: "0x01"}, "unknown.eth": {}}
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("expected %+v, got %+v", expected, result)
+	}
+
+	_, err = getCachedEnsResolutions(1, "n", []string{"foo.eth"}, func(missing []string) (map[string]ensNameCacheEntry, error) {
+		return nil, errors.New("db down")
+	})
+	if err == nil {
+		test.Errorf("expected the error of the fetch to be returned")
+	}
+	if key := ensCacheKey(17000, "a", "0x01"); key != "17000:ens:a:0x01" {
+		test.Errorf("unexpected cache key %s", key)
+	}
+}
+
+func TestEnsNameHistory(test *testing.T) {
+	owner := common.HexToAddress("0xa11ce")
+	events := []*db.EnsNameEvent{
+		{
+			TxHash: common.HexToHash("0x03"),
+			Event: &types.EnsNameRegistered{
+				BlockNumber: 200,
+				Time:        timestamppb.New(time.Unix(1700002400, 0)),
+				Expires:     timestamppb.New(time.Unix(1850000000, 0)),
+			},
+		},
+		{
+			TxHash:   common.HexToHash("0x01"),
+			LogIndex: 1,
+			Event: &types.EnsNameRegistered{
+				BlockNumber: 100,
+				Time:        timestamppb.New(time.Unix(1700001200, 0)),
+				Expires:     timestamppb.New(time.Unix(1800000000, 0)),
+				Owner:       owner.Bytes(),
+			},
+		},
+	}
+
+	history := ensNameHistory(events)
+	ownerHash := t.Hash("0x00000000000000000000000000000000000a11ce")
+	expected := []t.EnsNameHistoryEntry{
+		{Type: "renewal", Block: 200, Timestamp: 1700002400, TxHash: t.Hash(common.HexToHash("0x03").Hex()), ExpiresAt: 1850000000},
+		{Type: "registration", Block: 100, Timestamp: 1700001200, TxHash: t.Hash(common.HexToHash("0x01").Hex()), ExpiresAt: 1800000000, Owner: &ownerHash},
+	}
+	if !reflect.DeepEqual(history, expected) {
+		test.Errorf("expected %+v, got %+v", expected, history)
+	}
+	if history := ensNameHistory(nil); history == nil || len(history) != 0 {
+		test.Errorf("expected an empty history, got %v", history)
+	}
+}
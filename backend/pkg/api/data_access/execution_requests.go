@@ -0,0 +1,265 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+)
+
+type ExecutionRequestsRepository interface {
+	GetWithdrawalRequests(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.WithdrawalRequest, *t.Paging, error)
+	GetConsolidationRequests(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.ConsolidationRequest, *t.Paging, error)
+}
+
+type withdrawalRequestRow struct {
+	Slot            uint64        `db:"slot"`
+	RequestIndex    uint64        `db:"request_index"`
+	SourceAddress   []byte        `db:"source_address"`
+	ValidatorPubkey []byte        `db:"validator_pubkey"`
+	ValidatorIndex  sql.NullInt64 `db:"validator_index"`
+	Amount          uint64        `db:"amount"`
+	GroupId         sql.NullInt64 `db:"group_id"`
+}
+
+type consolidationRequestRow struct {
+	Slot          uint64        `db:"slot"`
+	RequestIndex  uint64        `db:"request_index"`
+	SourceAddress []byte        `db:"source_address"`
+	SourcePubkey  []byte        `db:"source_pubkey"`
+	SourceIndex   sql.NullInt64 `db:"source_index"`
+	TargetPubkey  []byte        `db:"target_pubkey"`
+	TargetIndex   sql.NullInt64 `db:"target_index"`
+	GroupId       sql.NullInt64 `db:"group_id"`
+}
+
+// only requests of canonical blocks are returned, the validator indices are resolved via the validators table
+func withdrawalRequestsDs() *goqu.SelectDataset {
+	return goqu.Dialect("postgres").
+		Select(
+			goqu.L("r.block_slot AS slot"),
+			goqu.L("r.request_index"),
+			goqu.L("r.source_address"),
+			goqu.L("r.validator_pubkey"),
+			goqu.L("v.validatorindex AS validator_index"),
+			goqu.L("r.amount")).
+		From(goqu.L("blocks_withdrawal_requests r")).
+		InnerJoin(goqu.L("blocks b"), goqu.On(goqu.L("b.slot = r.block_slot AND b.blockroot = r.block_root AND b.status = '1'"))).
+		LeftJoin(goqu.L("validators v"), goqu.On(goqu.L("v.pubkey = r.validator_pubkey")))
+}
+
+func consolidationRequestsDs() *goqu.SelectDataset {
+	return goqu.Dialect("postgres").
+		Select(
+			goqu.L("r.block_slot AS slot"),
+			goqu.L("r.request_index"),
+			goqu.L("r.source_address"),
+			goqu.L("r.source_pubkey"),
+			goqu.L("vs.validatorindex AS source_index"),
+			goqu.L("r.target_pubkey"),
+			goqu.L("vt.validatorindex AS target_index")).
+		From(goqu.L("blocks_consolidation_requests r")).
+		InnerJoin(goqu.L("blocks b"), goqu.On(goqu.L("b.slot = r.block_slot AND b.blockroot = r.block_root AND b.status = '1'"))).
+		LeftJoin(goqu.L("validators vs"), goqu.On(goqu.L("vs.pubkey = r.source_pubkey"))).
+		LeftJoin(goqu.L("validators vt"), goqu.On(goqu.L("vt.pubkey = r.target_pubkey")))
+}
+
+// executionRequestsPageQuery applies the keyset paging on slot and request index of the given query, newest requests first
+func executionRequestsPageQuery(ds *goqu.SelectDataset, currentCursor t.ExecutionRequestsCursor, limit uint64) *goqu.SelectDataset {
+	if currentCursor.IsValid() {
+		if currentCursor.IsReverse() {
+			ds = ds.Where(goqu.L("(r.block_slot, r.request_index) > (?, ?)", currentCursor.Slot, currentCursor.RequestIndex))
+		} else {
+			ds = ds.Where(goqu.L("(r.block_slot, r.request_index) < (?, ?)", currentCursor.Slot, currentCursor.RequestIndex))
+		}
+	}
+	if currentCursor.IsReverse() {
+		ds = ds.Order(goqu.L("r.block_slot").Asc(), goqu.L("r.request_index").Asc())
+	} else {
+		ds = ds.Order(goqu.L("r.block_slot").Desc(), goqu.L("r.request_index").Desc())
+	}
+	return ds.Limit(uint(limit + 1))
+}
+
+// executionRequestsPage trims the extra row of a page queried by executionRequestsPageQuery and returns the paging for it
+func executionRequestsPage[T any](data []T, currentCursor t.ExecutionRequestsCursor, limit uint64, key func(T) (uint64, uint64)) ([]T, *t.Paging, error) {
+	var paging t.Paging
+	moreDataFlag := len(data) > int(limit)
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// no paging required
+		return data, &paging, nil
+	}
+	if moreDataFlag {
+		data = data[:len(data)-1]
+	}
+	if currentCursor.IsReverse() {
+		slices.Reverse(data)
+	}
+	if len(data) == 0 {
+		return data, &paging, nil
+	}
+
+	cursors := make([]t.ExecutionRequestsCursor, len(data))
+	for i, row := range data {
+		cursors[i].Slot, cursors[i].RequestIndex = key(row)
+	}
+	p, err := utils.GetPagingFromData(cursors, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	return data, p, nil
+}
+
+func parseExecutionRequestsCursor(cursor string) (t.ExecutionRequestsCursor, error) {
+	var currentCursor t.ExecutionRequestsCursor
+	if cursor == "" {
+		return currentCursor, nil
+	}
+	currentCursor, err := utils.StringToCursor[t.ExecutionRequestsCursor](cursor)
+	if err != nil {
+		return currentCursor, fmt.Errorf("failed to parse passed cursor as ExecutionRequestsCursor: %w", err)
+	}
+	return currentCursor, nil
+}
+
+func (d *DataAccessService) getWithdrawalRequestRows(ctx context.Context, ds *goqu.SelectDataset, cursor string, limit uint64) ([]withdrawalRequestRow, *t.Paging, error) {
+	currentCursor, err := parseExecutionRequestsCursor(cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+	query, args, err := executionRequestsPageQuery(ds, currentCursor, limit).Prepared(true).ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing query: %w", err)
+	}
+	var rows []withdrawalRequestRow
+	err = d.alloyReader.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving withdrawal requests: %w", err)
+	}
+	return executionRequestsPage(rows, currentCursor, limit, func(row withdrawalRequestRow) (uint64, uint64) { return row.Slot, row.RequestIndex })
+}
+
+func (d *DataAccessService) getConsolidationRequestRows(ctx context.Context, ds *goqu.SelectDataset, cursor string, limit uint64) ([]consolidationRequestRow, *t.Paging, error) {
+	currentCursor, err := parseExecutionRequestsCursor(cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+	query, args, err := executionRequestsPageQuery(ds, currentCursor, limit).Prepared(true).ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing query: %w", err)
+	}
+	var rows []consolidationRequestRow
+	err = d.alloyReader.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving consolidation requests: %w", err)
+	}
+	return executionRequestsPage(rows, currentCursor, limit, func(row consolidationRequestRow) (uint64, uint64) { return row.Slot, row.RequestIndex })
+}
+
+func executionRequestValidator(pubkey []byte, index sql.NullInt64) t.ExecutionRequestValidator {
+	validator := t.ExecutionRequestValidator{PublicKey: t.PubKey(hexutil.Encode(pubkey))}
+	if index.Valid {
+		validatorIndex := uint64(index.Int64)
+		validator.Index = &validatorIndex
+	}
+	return validator
+}
+
+// getSourceAddresses resolves the names of the source addresses of the requests
+func (d *DataAccessService) getSourceAddresses(ctx context.Context, sourceAddresses [][]byte) (map[string]*t.Address, error) {
+	addresses := make(map[string]*t.Address)
+	for _, address := range sourceAddresses {
+		hash := hexutil.Encode(address)
+		addresses[hash] = &t.Address{Hash: t.Hash(hash)}
+	}
+	if len(addresses) == 0 {
+		return addresses, nil
+	}
+	if err := d.GetNamesAndEnsForAddresses(ctx, addresses); err != nil {
+		return nil, fmt.Errorf("error retrieving names of request source addresses: %w", err)
+	}
+	return addresses, nil
+}
+
+func (d *DataAccessService) toWithdrawalRequests(ctx context.Context, rows []withdrawalRequestRow) ([]t.WithdrawalRequest, error) {
+	sourceAddresses := make([][]byte, len(rows))
+	for i, row := range rows {
+		sourceAddresses[i] = row.SourceAddress
+	}
+	addresses, err := d.getSourceAddresses(ctx, sourceAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]t.WithdrawalRequest, len(rows))
+	for i, row := range rows {
+		result[i] = t.WithdrawalRequest{
+			Slot:          row.Slot,
+			Epoch:         utils.EpochOfSlot(row.Slot),
+			SourceAddress: *addresses[hexutil.Encode(row.SourceAddress)],
+			Validator:     executionRequestValidator(row.ValidatorPubkey, row.ValidatorIndex),
+			Amount:        utils.GWeiToWei(new(big.Int).SetUint64(row.Amount)),
+			IsFullExit:    row.Amount == 0,
+		}
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) toConsolidationRequests(ctx context.Context, rows []consolidationRequestRow) ([]t.ConsolidationRequest, error) {
+	sourceAddresses := make([][]byte, len(rows))
+	for i, row := range rows {
+		sourceAddresses[i] = row.SourceAddress
+	}
+	addresses, err := d.getSourceAddresses(ctx, sourceAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]t.ConsolidationRequest, len(rows))
+	for i, row := range rows {
+		result[i] = t.ConsolidationRequest{
+			Slot:          row.Slot,
+			Epoch:         utils.EpochOfSlot(row.Slot),
+			SourceAddress: *addresses[hexutil.Encode(row.SourceAddress)],
+			Source:        executionRequestValidator(row.SourcePubkey, row.SourceIndex),
+			Target:        executionRequestValidator(row.TargetPubkey, row.TargetIndex),
+		}
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetWithdrawalRequests(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.WithdrawalRequest, *t.Paging, error) {
+	if err := d.checkConsensusLayerNetwork(chainId); err != nil {
+		return nil, nil, err
+	}
+	rows, paging, err := d.getWithdrawalRequestRows(ctx, withdrawalRequestsDs(), cursor, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	result, err := d.toWithdrawalRequests(ctx, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, paging, nil
+}
+
+func (d *DataAccessService) GetConsolidationRequests(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.ConsolidationRequest, *t.Paging, error) {
+	if err := d.checkConsensusLayerNetwork(chainId); err != nil {
+		return nil, nil, err
+	}
+	rows, paging, err := d.getConsolidationRequestRows(ctx, consolidationRequestsDs(), cursor, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	result, err := d.toConsolidationRequests(ctx, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, paging, nil
+}
@@ -0,0 +1,161 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/gas"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// stored estimates older than this are considered outdated
+	maxGasNowAge = time.Minute * 5
+	// number of recent blocks the utilization used for the base fee forecast is averaged over
+	gasUtilizationBlocks = 20
+	// number of upcoming blocks the base fee is forecasted for
+	baseFeeForecastBlocks = 10
+)
+
+type GasRepository interface {
+	GetGasNow(ctx context.Context, chainId uint64) (*t.GasNowData, error)
+	GetAverageGasLimitHistory(ctx context.Context, chainId uint64, afterTs uint64, beforeTs uint64) (*t.ChartData[string, float64], error)
+	GetGasUsedHistory(ctx context.Context, chainId uint64, afterTs uint64, beforeTs uint64) (*t.ChartData[string, float64], error)
+}
+
+func (d *DataAccessService) GetGasNow(ctx context.Context, chainId uint64) (*t.GasNowData, error) {
+	if err := d.checkExecutionLayerNetwork(chainId); err != nil {
+		return nil, err
+	}
+
+	latest, err := gas.GetLatest(d.bigtable, maxGasNowAge)
+	if err != nil {
+		return nil, err
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("%w: no recent gas price estimates available for network %d", ErrNotFound, chainId)
+	}
+
+	lastBlock, err := d.bigtable.GetLastBlockInBlocksTable()
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := d.bigtable.GetBlocksDescending(uint64(lastBlock), gasUtilizationBlocks)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) == 0 {
+		return nil, fmt.Errorf("%w: no blocks available for network %d", ErrNotFound, chainId)
+	}
+
+	head := blocks[0]
+	headBaseFee := decimal.NewFromBigInt(new(big.Int).SetBytes(head.BaseFee), 0)
+	utilization := gas.AverageUtilization(blocks)
+	baseFeePercentiles := gas.BaseFeePercentiles(blocks)
+
+	result := &t.GasNowData{
+		Timestamp:   latest.Ts.Unix(),
+		Slow:        decimal.NewFromBigInt(latest.Slow, 0),
+		Standard:    decimal.NewFromBigInt(latest.Standard, 0),
+		Fast:        decimal.NewFromBigInt(latest.Fast, 0),
+		Rapid:       decimal.NewFromBigInt(latest.Rapid, 0),
+		LatestBlock: head.Number,
+		BaseFee:     headBaseFee,
+		Utilization: utilization,
+		BaseFeePercentiles: t.GasPercentiles{
+			Slow:     decimal.NewFromBigInt(baseFeePercentiles.Slow, 0),
+			Standard: decimal.NewFromBigInt(baseFeePercentiles.Standard, 0),
+			Fast:     decimal.NewFromBigInt(baseFeePercentiles.Fast, 0),
+			Rapid:    decimal.NewFromBigInt(baseFeePercentiles.Rapid, 0),
+		},
+		BaseFeeForecast: make([]t.BaseFeeForecast, 0, baseFeeForecastBlocks),
+	}
+	forecast := gas.ForecastBaseFees(headBaseFee.BigInt(), head.GasUsed, head.GasLimit, utilization, baseFeeForecastBlocks)
+	for i, baseFee := range forecast {
+		result.BaseFeeForecast = append(result.BaseFeeForecast, t.BaseFeeForecast{
+			Block:   head.Number + uint64(i) + 1,
+			BaseFee: decimal.NewFromBigInt(baseFee, 0),
+		})
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetAverageGasLimitHistory(ctx context.Context, chainId uint64, afterTs uint64, beforeTs uint64) (*t.ChartData[string, float64], error) {
+	return d.getChartSeriesHistory(ctx, chainId, afterTs, beforeTs, []chartSeriesIndicator{
+		{SeriesId: "average", Indicator: "AVG_GASLIMIT"},
+	})
+}
+
+func (d *DataAccessService) GetGasUsedHistory(ctx context.Context, chainId uint64, afterTs uint64, beforeTs uint64) (*t.ChartData[string, float64], error) {
+	return d.getChartSeriesHistory(ctx, chainId, afterTs, beforeTs, []chartSeriesIndicator{
+		{SeriesId: "total", Indicator: "TOTAL_GASUSED"},
+		{SeriesId: "average", Indicator: "AVG_GASUSED"},
+	})
+}
+
+type chartSeriesIndicator struct {
+	SeriesId  string
+	Indicator string
+}
+
+// getChartSeriesHistory returns the daily values of the given chart_series indicators between afterTs and beforeTs
+func (d *DataAccessService) getChartSeriesHistory(ctx context.Context, chainId uint64, afterTs uint64, beforeTs uint64, indicators []chartSeriesIndicator) (*t.ChartData[string, float64], error) {
+	// chart_series only contains the data of the configured network
+	if chainId != utils.Config.Chain.ClConfig.DepositChainID {
+		return nil, fmt.Errorf("%w: no chart data available for network %d", ErrNotFound, chainId)
+	}
+
+	indicatorNames := make([]string, 0, len(indicators))
+	for _, indicator := range indicators {
+		indicatorNames = append(indicatorNames, indicator.Indicator)
+	}
+
+	var rows []struct {
+		Time      time.Time `db:"time"`
+		Indicator string    `db:"indicator"`
+		Value     float64   `db:"value"`
+	}
+	err := d.readerDb.SelectContext(ctx, &rows, `
+		SELECT time, indicator, value
+		FROM chart_series
+		WHERE indicator = ANY($1) AND time >= $2 AND time <= $3
+		ORDER BY time`, pq.StringArray(indicatorNames), time.Unix(int64(afterTs), 0).UTC(), time.Unix(int64(beforeTs), 0).UTC())
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving chart series %v: %w", indicatorNames, err)
+	}
+
+	// collect the series values per timestamp; days where an indicator is missing are reported as 0
+	categories := make([]uint64, 0)
+	values := make(map[string]map[uint64]float64, len(indicators))
+	for _, row := range rows {
+		ts := uint64(row.Time.Unix())
+		if len(categories) == 0 || categories[len(categories)-1] != ts {
+			categories = append(categories, ts)
+		}
+		if values[row.Indicator] == nil {
+			values[row.Indicator] = make(map[uint64]float64)
+		}
+		values[row.Indicator][ts] = row.Value
+	}
+
+	result := &t.ChartData[string, float64]{
+		Categories: categories,
+		Series:     make([]t.ChartSeries[string, float64], 0, len(indicators)),
+	}
+	for _, indicator := range indicators {
+		series := t.ChartSeries[string, float64]{
+			Id:   indicator.SeriesId,
+			Data: make([]float64, len(categories)),
+		}
+		for i, ts := range categories {
+			series.Data[i] = values[indicator.Indicator][ts]
+		}
+		result.Series = append(result.Series, series)
+	}
+	return result, nil
+}
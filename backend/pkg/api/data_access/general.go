@@ -6,32 +6,35 @@ import (
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gobitfly/beaconchain/pkg/api/types"
-	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
 )
 
 // retrieve (primary) ens name and optional name (=label) maintained by beaconcha.in, if present
 func (d *DataAccessService) GetNamesAndEnsForAddresses(ctx context.Context, addressMap map[string]*types.Address) error {
 	addresses := make([][]byte, 0, len(addressMap))
-	ensMapping := make(map[string]string, len(addressMap))
+	ensAddresses := make([]string, 0, len(addressMap))
 	for address, data := range addressMap {
-		ensMapping[address] = ""
 		add, err := hexutil.Decode(address)
 		if err != nil {
 			return err
 		}
 		addresses = append(addresses, add)
+		ensAddresses = append(ensAddresses, hexutil.Encode(add))
 		if data == nil {
 			addressMap[address] = &types.Address{Hash: types.Hash(address)}
 		}
 	}
 	// determine ENS names
-	if err := db.GetEnsNamesForAddresses(ensMapping); err != nil {
+	ensNames, err := d.reverseResolveEnsAddresses(ctx, utils.Config.Chain.ClConfig.DepositChainID, ensAddresses)
+	if err != nil {
 		return err
 	}
-	for address, ens := range ensMapping {
-		addressMap[address].Ens = ens
+	for address, data := range addressMap {
+		entry := ensNames[hexutil.Encode(common.FromHex(address))]
+		data.Ens = entry.primaryName()
 	}
 
 	// determine names
@@ -39,7 +42,7 @@ func (d *DataAccessService) GetNamesAndEnsForAddresses(ctx context.Context, addr
 		Address []byte `db:"address"`
 		Name    string `db:"name"`
 	}{}
-	err := d.alloyReader.SelectContext(ctx, &names, `SELECT address, name FROM address_names WHERE address = ANY($1)`, addresses)
+	err = d.alloyReader.SelectContext(ctx, &names, `SELECT address, name FROM address_names WHERE address = ANY($1)`, addresses)
 	if err != nil {
 		return err
 	}
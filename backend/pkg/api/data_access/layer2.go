@@ -0,0 +1,179 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+)
+
+type Layer2Repository interface {
+	GetLayer2Batches(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.Layer2Batch, *t.Paging, error)
+	GetLayer1ToLayer2Transactions(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.Layer1ToLayer2Transaction, *t.Paging, error)
+	GetLayer2ToLayer1Transactions(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.Layer2ToLayer1Transaction, *t.Paging, error)
+}
+
+func (d *DataAccessService) GetLayer2Batches(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.Layer2Batch, *t.Paging, error) {
+	if err := d.checkLayer2Network(chainId); err != nil {
+		return nil, nil, err
+	}
+	batches, p, err := getLayer2Page(fmt.Sprintf("%d:L2:B:", chainId), cursor, limit, d.bigtable.GetLayer2Batches)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.Layer2Batch, 0, len(batches))
+	for _, batch := range batches {
+		row := t.Layer2Batch{
+			TxHash:       t.Hash(hexutil.Encode(batch.GetTxHash())),
+			Block:        batch.GetBlockNumber(),
+			Timestamp:    batch.GetTime().AsTime().Unix(),
+			Submitter:    t.Hash(hexutil.Encode(batch.GetSubmitter())),
+			DataLocation: batch.GetDataLocation(),
+			DataSize:     batch.GetDataSize(),
+			BlobCount:    batch.GetBlobCount(),
+			TxFee:        weiBytesToDecimal(batch.GetTxFee()),
+			BlobFee:      weiBytesToDecimal(batch.GetBlobTxFee()),
+		}
+		if batch.GetHasBatchNumber() {
+			batchNumber := batch.GetBatchNumber()
+			row.BatchNumber = &batchNumber
+		}
+		result = append(result, row)
+	}
+	return result, p, nil
+}
+
+func (d *DataAccessService) GetLayer1ToLayer2Transactions(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.Layer1ToLayer2Transaction, *t.Paging, error) {
+	if err := d.checkLayer2Network(chainId); err != nil {
+		return nil, nil, err
+	}
+	deposits, p, err := getLayer2Page(fmt.Sprintf("%d:L2:D:", chainId), cursor, limit, d.bigtable.GetLayer2Deposits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.Layer1ToLayer2Transaction, 0, len(deposits))
+	for _, deposit := range deposits {
+		row := t.Layer1ToLayer2Transaction{
+			Type:      deposit.GetKind(),
+			L1TxHash:  t.Hash(hexutil.Encode(deposit.GetL1TxHash())),
+			L1Block:   deposit.GetBlockNumber(),
+			Timestamp: deposit.GetTime().AsTime().Unix(),
+			From:      t.Hash(hexutil.Encode(deposit.GetFrom())),
+			Value:     weiBytesToDecimal(deposit.GetValue()),
+		}
+		if len(deposit.GetTo()) > 0 {
+			to := t.Hash(hexutil.Encode(deposit.GetTo()))
+			row.To = &to
+		}
+		if len(deposit.GetL2TxHash()) > 0 {
+			l2TxHash := t.Hash(hexutil.Encode(deposit.GetL2TxHash()))
+			row.L2TxHash = &l2TxHash
+		}
+		if deposit.GetHasMessageIndex() {
+			messageIndex := deposit.GetMessageIndex()
+			row.MessageIndex = &messageIndex
+		}
+		result = append(result, row)
+	}
+	return result, p, nil
+}
+
+func (d *DataAccessService) GetLayer2ToLayer1Transactions(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.Layer2ToLayer1Transaction, *t.Paging, error) {
+	if err := d.checkLayer2Network(chainId); err != nil {
+		return nil, nil, err
+	}
+	withdrawals, p, err := getLayer2Page(fmt.Sprintf("%d:L2:W:", chainId), cursor, limit, d.bigtable.GetLayer2Withdrawals)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// link the settlement on the layer 1 to the transaction initiating the withdrawal on the layer 2
+	messageIds := make([][]byte, 0, len(withdrawals))
+	for _, withdrawal := range withdrawals {
+		messageIds = append(messageIds, withdrawal.GetMessageId())
+	}
+	messages, err := d.bigtable.GetLayer2Messages(chainId, messageIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.Layer2ToLayer1Transaction, 0, len(withdrawals))
+	for _, withdrawal := range withdrawals {
+		row := t.Layer2ToLayer1Transaction{
+			Status:    withdrawal.GetStatus(),
+			L1TxHash:  t.Hash(hexutil.Encode(withdrawal.GetL1TxHash())),
+			L1Block:   withdrawal.GetBlockNumber(),
+			Timestamp: withdrawal.GetTime().AsTime().Unix(),
+			MessageId: t.Hash(hexutil.Encode(withdrawal.GetMessageId())),
+			Success:   withdrawal.GetSuccess(),
+		}
+		from, to := withdrawal.GetFrom(), withdrawal.GetTo()
+		if message, ok := messages[fmt.Sprintf("%x", withdrawal.GetMessageId())]; ok {
+			l2TxHash := t.Hash(hexutil.Encode(message.GetL2TxHash()))
+			l2Block := message.GetBlockNumber()
+			value := weiBytesToDecimal(message.GetValue())
+			row.L2TxHash = &l2TxHash
+			row.L2Block = &l2Block
+			row.Value = &value
+			// finalization events only carry the withdrawal hash
+			if len(from) == 0 {
+				from = message.GetSender()
+			}
+			if len(to) == 0 {
+				to = message.GetTarget()
+			}
+		}
+		if len(from) > 0 {
+			fromHash := t.Hash(hexutil.Encode(from))
+			row.From = &fromHash
+		}
+		if len(to) > 0 {
+			toHash := t.Hash(hexutil.Encode(to))
+			row.To = &toHash
+		}
+		result = append(result, row)
+	}
+	return result, p, nil
+}
+
+// layer 2 data is indexed by the execution layer indexer of the layer 1, keyed by the chain id of the rollup
+func (d *DataAccessService) checkLayer2Network(chainId uint64) error {
+	if d.bigtable == nil {
+		return fmt.Errorf("%w: no layer 2 data available for network %d", ErrNotFound, chainId)
+	}
+	for _, network := range utils.Config.Layer2Networks {
+		if network.ChainId == chainId {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: no layer 2 data available for network %d", ErrNotFound, chainId)
+}
+
+// getLayer2Page reads a single page of the given layer 2 table, newest first
+func getLayer2Page[T any](prefix string, cursor string, limit uint64, read func(pageToken string, limit int64) ([]*T, []string, error)) ([]*T, *t.Paging, error) {
+	pageToken, err := getBigtablePageToken(cursor, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, keys, err := read(pageToken, int64(limit+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	moreDataFlag := uint64(len(rows)) > limit
+	if moreDataFlag {
+		rows = rows[:limit]
+		keys = keys[:limit]
+	}
+	if len(rows) == 0 {
+		return rows, &t.Paging{}, nil
+	}
+	p, err := getBigtablePaging(t.BigtableIndexCursor{PageToken: keys[len(keys)-1]}, moreDataFlag)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, p, nil
+}
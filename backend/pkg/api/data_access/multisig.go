@@ -0,0 +1,258 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	safeContracts "github.com/gobitfly/beaconchain/pkg/commons/contracts/safe"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+)
+
+type MultisigRepository interface {
+	GetMultisigSafe(ctx context.Context, address []byte) (*t.MultisigSafe, error)
+	GetMultisigSafeTransactions(ctx context.Context, address []byte, cursor string, limit uint64) ([]t.MultisigSafeTransaction, *t.Paging, error)
+	GetMultisigTransactionConfirmations(ctx context.Context, safeTxHash []byte) ([]t.MultisigTransactionConfirmation, error)
+}
+
+// Safes are indexed by the execution layer indexer of the configured network
+func (d *DataAccessService) getSafeCreation(address []byte) (*types.SafeCreationIndexed, error) {
+	if err := d.checkExecutionLayerNetwork(utils.Config.Chain.ClConfig.DepositChainID); err != nil {
+		return nil, err
+	}
+	creation, err := d.bigtable.GetSafeCreation(address)
+	if err != nil {
+		return nil, err
+	}
+	if creation == nil {
+		return nil, fmt.Errorf("%w: no safe found at address %#x", ErrNotFound, address)
+	}
+	return creation, nil
+}
+
+// The current owners and threshold are reconstructed by replaying the indexed events of the Safe, oldest first.
+func (d *DataAccessService) GetMultisigSafe(ctx context.Context, address []byte) (*t.MultisigSafe, error) {
+	creation, err := d.getSafeCreation(address)
+	if err != nil {
+		return nil, err
+	}
+	events, err := d.bigtable.GetSafeEvents(address)
+	if err != nil {
+		return nil, err
+	}
+
+	var owners []string
+	var threshold uint64
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		switch event.GetKind() {
+		case db.SafeEventSetup:
+			owners = owners[:0]
+			for _, owner := range event.GetOwners() {
+				owners = append(owners, hexutil.Encode(owner))
+			}
+			threshold = event.GetThreshold()
+		case db.SafeEventAddedOwner:
+			// owners are added in front of the linked list of the contract
+			owners = append([]string{hexutil.Encode(event.GetOwners()[0])}, owners...)
+		case db.SafeEventRemovedOwner:
+			removed := hexutil.Encode(event.GetOwners()[0])
+			for j, owner := range owners {
+				if owner == removed {
+					owners = append(owners[:j], owners[j+1:]...)
+					break
+				}
+			}
+		case db.SafeEventChangedThreshold:
+			threshold = event.GetThreshold()
+		}
+	}
+
+	result := &t.MultisigSafe{
+		Address:           t.Address{Hash: t.Hash(hexutil.Encode(address)), IsContract: true},
+		Owners:            make([]t.Address, 0, len(owners)),
+		Threshold:         threshold,
+		Creator:           t.Hash(hexutil.Encode(creation.GetCreator())),
+		CreationTxHash:    t.Hash(hexutil.Encode(creation.GetTxHash())),
+		CreationBlock:     creation.GetBlockNumber(),
+		CreationTimestamp: creation.GetTime().AsTime().Unix(),
+	}
+	if len(creation.GetFactory()) > 0 {
+		factory := t.Hash(hexutil.Encode(creation.GetFactory()))
+		singleton := t.Hash(hexutil.Encode(creation.GetSingleton()))
+		result.Factory = &factory
+		result.Singleton = &singleton
+	}
+
+	names := map[string]string{string(result.Address.Hash): ""}
+	for _, owner := range owners {
+		names[owner] = ""
+	}
+	if err := d.bigtable.GetAddressNames(names); err != nil {
+		return nil, err
+	}
+	applyAddressName(&result.Address, names)
+	for _, owner := range owners {
+		address := t.Address{Hash: t.Hash(owner)}
+		applyAddressName(&address, names)
+		result.Owners = append(result.Owners, address)
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetMultisigSafeTransactions(ctx context.Context, address []byte, cursor string, limit uint64) ([]t.MultisigSafeTransaction, *t.Paging, error) {
+	if _, err := d.getSafeCreation(address); err != nil {
+		return nil, nil, err
+	}
+	executions, p, err := getLayer2Page(fmt.Sprintf("%d:SAFE:X:%x:", utils.Config.Chain.ClConfig.DepositChainID, address), cursor, limit, d.bigtable.GetSafeExecutions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.MultisigSafeTransaction, 0, len(executions))
+	names := make(map[string]string, len(executions)*2)
+	for _, execution := range executions {
+		row := t.MultisigSafeTransaction{
+			TxHash:     t.Hash(hexutil.Encode(execution.GetTxHash())),
+			Block:      execution.GetBlockNumber(),
+			Timestamp:  execution.GetTime().AsTime().Unix(),
+			SafeTxHash: t.Hash(hexutil.Encode(execution.GetSafeTxHash())),
+			Success:    execution.GetSuccess(),
+			Executor:   t.Address{Hash: t.Hash(hexutil.Encode(execution.GetExecutor()))},
+			Payment:    weiBytesToDecimal(execution.GetPayment()),
+		}
+		names[string(row.Executor.Hash)] = ""
+		if len(execution.GetTo()) > 0 {
+			interaction := types.CONTRACT_NONE
+			if len(execution.GetMethod()) > 0 {
+				interaction = types.CONTRACT_PRESENT
+			}
+			to := t.Address{Hash: t.Hash(hexutil.Encode(execution.GetTo())), IsContract: interaction != types.CONTRACT_NONE}
+			value := weiBytesToDecimal(execution.GetValue())
+			method := d.bigtable.GetMethodLabel(execution.GetMethod(), interaction)
+			operation := "call"
+			if execution.GetOperation() == 1 {
+				operation = "delegate_call"
+			}
+			confirmations := uint64(len(execution.GetSigners()))
+			row.To = &to
+			row.Value = &value
+			row.Method = &method
+			row.Operation = &operation
+			row.Confirmations = &confirmations
+			names[string(to.Hash)] = ""
+		}
+		result = append(result, row)
+	}
+
+	if err := d.bigtable.GetAddressNames(names); err != nil {
+		return nil, nil, err
+	}
+	for i := range result {
+		applyAddressName(&result[i].Executor, names)
+		if result[i].To != nil {
+			applyAddressName(result[i].To, names)
+		}
+	}
+	return result, p, nil
+}
+
+// Confirmations are taken from the signatures of the execution and the on chain approvals of the hash,
+// so confirmations of pending transactions are only known if they were given on chain.
+func (d *DataAccessService) GetMultisigTransactionConfirmations(ctx context.Context, safeTxHash []byte) ([]t.MultisigTransactionConfirmation, error) {
+	if err := d.checkExecutionLayerNetwork(utils.Config.Chain.ClConfig.DepositChainID); err != nil {
+		return nil, err
+	}
+	execution, err := d.bigtable.GetSafeExecution(safeTxHash)
+	if err != nil {
+		return nil, err
+	}
+	approvals, err := d.bigtable.GetSafeApprovals(safeTxHash)
+	if err != nil {
+		return nil, err
+	}
+	if execution == nil && len(approvals) == 0 {
+		return nil, fmt.Errorf("%w: no safe transaction found with hash %#x", ErrNotFound, safeTxHash)
+	}
+
+	approvalByOwner := make(map[string]*types.SafeApprovalIndexed, len(approvals))
+	for _, approval := range approvals {
+		approvalByOwner[hexutil.Encode(approval.GetOwner())] = approval
+	}
+	withApproval := func(confirmation t.MultisigTransactionConfirmation) t.MultisigTransactionConfirmation {
+		approval, ok := approvalByOwner[string(confirmation.Owner.Hash)]
+		if !ok {
+			return confirmation
+		}
+		delete(approvalByOwner, string(confirmation.Owner.Hash))
+		txHash := t.Hash(hexutil.Encode(approval.GetTxHash()))
+		block := approval.GetBlockNumber()
+		timestamp := approval.GetTime().AsTime().Unix()
+		confirmation.TxHash = &txHash
+		confirmation.Block = &block
+		confirmation.Timestamp = &timestamp
+		return confirmation
+	}
+
+	result := make([]t.MultisigTransactionConfirmation, 0, len(execution.GetSigners())+len(approvals))
+	for i, signer := range execution.GetSigners() {
+		confirmation := t.MultisigTransactionConfirmation{
+			Owner: t.Address{Hash: t.Hash(hexutil.Encode(signer))},
+			Kind:  execution.GetSignatureKinds()[i],
+		}
+		if confirmation.Kind == safeContracts.SignatureKindApprovedHash {
+			confirmation = withApproval(confirmation)
+		}
+		result = append(result, confirmation)
+	}
+	// approvals that aren't part of the decoded signatures, e.g. of pending transactions
+	for _, approval := range approvals {
+		owner := hexutil.Encode(approval.GetOwner())
+		if _, ok := approvalByOwner[owner]; !ok {
+			continue
+		}
+		result = append(result, withApproval(t.MultisigTransactionConfirmation{
+			Owner: t.Address{Hash: t.Hash(owner)},
+			Kind:  safeContracts.SignatureKindApprovedHash,
+		}))
+	}
+
+	names := make(map[string]string, len(result))
+	for _, confirmation := range result {
+		names[string(confirmation.Owner.Hash)] = ""
+	}
+	if err := d.bigtable.GetAddressNames(names); err != nil {
+		return nil, err
+	}
+	for i := range result {
+		applyAddressName(&result[i].Owner, names)
+	}
+	return result, nil
+}
+
+// labelMultisigExecutions marks the rows of transactions that executed a Safe transaction
+func (d *DataAccessService) labelMultisigExecutions(rows []t.BlockTransactionTableRow) error {
+	txHashes := make([][]byte, 0, len(rows))
+	for _, row := range rows {
+		txHashes = append(txHashes, hexutil.MustDecode(string(row.TxHash)))
+	}
+	executions, err := d.bigtable.GetSafeExecutionsByTxHashes(txHashes)
+	if err != nil {
+		return err
+	}
+	for i, txHash := range txHashes {
+		execution, ok := executions[fmt.Sprintf("%x", txHash)]
+		if !ok {
+			continue
+		}
+		rows[i].MultisigExecution = &t.MultisigExecutionLabel{
+			Safe:       t.Hash(hexutil.Encode(execution.GetSafe())),
+			SafeTxHash: t.Hash(hexutil.Encode(execution.GetSafeTxHash())),
+			Success:    execution.GetSuccess(),
+		}
+	}
+	return nil
+}
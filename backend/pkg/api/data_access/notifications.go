@@ -74,6 +74,8 @@ func (*DataAccessService) registerNotificationInterfaceTypes() {
 		gob.Register(&n.ValidatorIsOnlineNotification{})
 		gob.Register(&n.ValidatorGotSlashedNotification{})
 		gob.Register(&n.ValidatorWithdrawalNotification{})
+		gob.Register(&n.ValidatorConsolidationNotification{})
+		gob.Register(&n.ValidatorMissedBestBidNotification{})
 		gob.Register(&n.NetworkNotification{})
 		gob.Register(&n.RocketpoolNotification{})
 		gob.Register(&n.MonitorMachineNotification{})
@@ -92,6 +94,7 @@ const (
 	GroupEfficiencyBelowThresholdDefault     float64 = 0.95
 	MaxCollateralThresholdDefault            float64 = 1.0
 	MinCollateralThresholdDefault            float64 = 0.2
+	MissedBestBidThresholdDefault            float64 = 0.01
 	ERC20TokenTransfersValueThresholdDefault float64 = 0.1
 
 	MachineStorageUsageThresholdDefault float64 = 0.9
@@ -411,6 +414,8 @@ func (d *DataAccessService) GetValidatorDashboardNotificationDetails(ctx context
 		Sync:                     []uint64{},
 		AttestationMissed:        []t.IndexEpoch{},
 		Withdrawal:               []t.NotificationEventWithdrawal{},
+		Consolidation:            []t.NotificationEventConsolidation{},
+		MissedBestBid:            []t.NotificationEventMissedBestBid{},
 		ValidatorOfflineReminder: []uint64{},
 		ValidatorOnline:          []t.NotificationEventValidatorBackOnline{},
 		MinCollateral:            []t.Address{},
@@ -606,6 +611,34 @@ func (d *DataAccessService) GetValidatorDashboardNotificationDetails(ctx context
 					Amount:  decimal.NewFromUint64(curNotification.Amount).Mul(decimal.NewFromFloat(params.GWei)), // Amounts have to be in WEI
 					Address: addr,
 				})
+			case types.ValidatorConsolidationEventName:
+				curNotification, ok := notification.(*n.ValidatorConsolidationNotification)
+				if !ok {
+					return nil, fmt.Errorf("failed to cast notification to ValidatorConsolidationNotification")
+				}
+				if searchEnabled && !searchIndexSet[curNotification.SourceIndex] && !searchIndexSet[curNotification.TargetIndex] {
+					continue
+				}
+				notificationDetails.Consolidation = append(notificationDetails.Consolidation, t.NotificationEventConsolidation{
+					Source: curNotification.SourceIndex,
+					Target: curNotification.TargetIndex,
+					Slot:   curNotification.Slot,
+				})
+			case types.ValidatorMissedBestBidEventName:
+				curNotification, ok := notification.(*n.ValidatorMissedBestBidNotification)
+				if !ok {
+					return nil, fmt.Errorf("failed to cast notification to ValidatorMissedBestBidNotification")
+				}
+				if searchEnabled && !searchIndexSet[curNotification.ValidatorIndex] {
+					continue
+				}
+				notificationDetails.MissedBestBid = append(notificationDetails.MissedBestBid, t.NotificationEventMissedBestBid{
+					Index:          curNotification.ValidatorIndex,
+					Slot:           curNotification.Slot,
+					DeliveredValue: decimal.NewFromFloat(curNotification.DeliveredValue).Mul(decimal.NewFromFloat(params.Ether)), // Amounts have to be in WEI
+					BestBid:        decimal.NewFromFloat(curNotification.BestBid).Mul(decimal.NewFromFloat(params.Ether)),
+					BestBidRelay:   curNotification.BestBidRelay,
+				})
 			case types.NetworkLivenessIncreasedEventName,
 				types.EthClientUpdateEventName,
 				types.MonitoringMachineOfflineEventName,
@@ -1277,6 +1310,7 @@ func (d *DataAccessService) GetNotificationSettingsDefaultValues(ctx context.Con
 		GroupEfficiencyBelowThreshold:     GroupEfficiencyBelowThresholdDefault,
 		MaxCollateralThreshold:            MaxCollateralThresholdDefault,
 		MinCollateralThreshold:            MinCollateralThresholdDefault,
+		MissedBestBidThreshold:            MissedBestBidThresholdDefault,
 		ERC20TokenTransfersValueThreshold: ERC20TokenTransfersValueThresholdDefault,
 
 		MachineStorageUsageThreshold: MachineStorageUsageThresholdDefault,
@@ -1700,6 +1734,7 @@ func (d *DataAccessService) GetNotificationSettingsDashboards(ctx context.Contex
 						GroupEfficiencyBelowThreshold: GroupEfficiencyBelowThresholdDefault,
 						MaxCollateralThreshold:        MaxCollateralThresholdDefault,
 						MinCollateralThreshold:        MinCollateralThresholdDefault,
+						MissedBestBidThreshold:        MissedBestBidThresholdDefault,
 					},
 				}
 			} else if dashboardType == AccountDashboardEventPrefix {
@@ -1729,6 +1764,11 @@ func (d *DataAccessService) GetNotificationSettingsDashboards(ctx context.Contex
 				settings.IsSyncSubscribed = true
 			case types.ValidatorReceivedWithdrawalEventName:
 				settings.IsWithdrawalProcessedSubscribed = true
+			case types.ValidatorConsolidationEventName:
+				settings.IsConsolidationSubscribed = true
+			case types.ValidatorMissedBestBidEventName:
+				settings.IsMissedBestBidSubscribed = true
+				settings.MissedBestBidThreshold = event.Threshold
 			case types.ValidatorGotSlashedEventName:
 				settings.IsSlashedSubscribed = true
 			case types.RocketpoolCollateralMinReachedEventName:
@@ -1767,6 +1807,7 @@ func (d *DataAccessService) GetNotificationSettingsDashboards(ctx context.Contex
 					GroupEfficiencyBelowThreshold: GroupEfficiencyBelowThresholdDefault,
 					MaxCollateralThreshold:        MaxCollateralThresholdDefault,
 					MinCollateralThreshold:        MinCollateralThresholdDefault,
+					MissedBestBidThreshold:        MissedBestBidThresholdDefault,
 				},
 			}
 		}
@@ -1958,6 +1999,8 @@ func (d *DataAccessService) UpdateNotificationSettingsValidatorDashboard(ctx con
 	d.AddOrRemoveEvent(&eventsToInsert, &eventsToDelete, settings.IsUpcomingBlockProposalSubscribed, userId, types.ValidatorUpcomingProposalEventName, networkName, eventFilter, epoch, 0)
 	d.AddOrRemoveEvent(&eventsToInsert, &eventsToDelete, settings.IsSyncSubscribed, userId, types.SyncCommitteeSoonEventName, networkName, eventFilter, epoch, 0)
 	d.AddOrRemoveEvent(&eventsToInsert, &eventsToDelete, settings.IsWithdrawalProcessedSubscribed, userId, types.ValidatorReceivedWithdrawalEventName, networkName, eventFilter, epoch, 0)
+	d.AddOrRemoveEvent(&eventsToInsert, &eventsToDelete, settings.IsConsolidationSubscribed, userId, types.ValidatorConsolidationEventName, networkName, eventFilter, epoch, 0)
+	d.AddOrRemoveEvent(&eventsToInsert, &eventsToDelete, settings.IsMissedBestBidSubscribed, userId, types.ValidatorMissedBestBidEventName, networkName, eventFilter, epoch, settings.MissedBestBidThreshold)
 	d.AddOrRemoveEvent(&eventsToInsert, &eventsToDelete, settings.IsSlashedSubscribed, userId, types.ValidatorGotSlashedEventName, networkName, eventFilter, epoch, 0)
 	d.AddOrRemoveEvent(&eventsToInsert, &eventsToDelete, settings.IsMaxCollateralSubscribed, userId, types.RocketpoolCollateralMaxReachedEventName, networkName, eventFilter, epoch, settings.MaxCollateralThreshold)
 	d.AddOrRemoveEvent(&eventsToInsert, &eventsToDelete, settings.IsMinCollateralSubscribed, userId, types.RocketpoolCollateralMinReachedEventName, networkName, eventFilter, epoch, settings.MinCollateralThreshold)
@@ -0,0 +1,280 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+type OAuthRepository interface {
+	GetOAuthAppByClientId(ctx context.Context, clientId string) (*t.OAuthClientApp, error)
+	CreateOAuthAuthorizationCode(ctx context.Context, codeHash string, code t.OAuthAuthorizationCode) error
+	GetOAuthAuthorizationCode(ctx context.Context, codeHash string) (*t.OAuthAuthorizationCode, error)
+	ExchangeOAuthAuthorizationCode(ctx context.Context, codeHash string, accessTokenHash, refreshTokenHash string, accessExpiresAt, refreshExpiresAt time.Time) error
+	GetOAuthAccessToken(ctx context.Context, accessTokenHash string) (*t.OAuthTokenInfo, error)
+	GetOAuthRefreshToken(ctx context.Context, refreshTokenHash string) (*t.OAuthTokenInfo, error)
+	RotateOAuthRefreshToken(ctx context.Context, oldRefreshTokenHash, accessTokenHash, refreshTokenHash string, accessExpiresAt, refreshExpiresAt time.Time) error
+	RevokeOAuthGrant(ctx context.Context, grantId uint64) error
+	RevokeOAuthToken(ctx context.Context, appId uint64, tokenHash string) error
+	GetUserOAuthApps(ctx context.Context, userId uint64) ([]t.UserOAuthApp, error)
+	RemoveUserOAuthApp(ctx context.Context, userId, appId uint64) error
+}
+
+// GetOAuthAppByClientId returns an active third party app, the mobile app has no client id and can't be found this way
+func (d *DataAccessService) GetOAuthAppByClientId(ctx context.Context, clientId string) (*t.OAuthClientApp, error) {
+	var app struct {
+		Id               uint64         `db:"id"`
+		AppName          string         `db:"app_name"`
+		RedirectUri      string         `db:"redirect_uri"`
+		ClientSecretHash sql.NullString `db:"client_secret_hash"`
+		Scopes           pq.StringArray `db:"scopes"`
+	}
+	err := d.userReader.GetContext(ctx, &app, `
+		SELECT id, app_name, redirect_uri, client_secret_hash, scopes
+		FROM oauth_apps
+		WHERE client_id = $1 AND active = true`, clientId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: oauth app with client id %s not found", ErrNotFound, clientId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t.OAuthClientApp{
+		Id:               app.Id,
+		AppName:          app.AppName,
+		RedirectUri:      app.RedirectUri,
+		ClientSecretHash: app.ClientSecretHash.String,
+		Scopes:           app.Scopes,
+	}, nil
+}
+
+func (d *DataAccessService) CreateOAuthAuthorizationCode(ctx context.Context, codeHash string, code t.OAuthAuthorizationCode) error {
+	_, err := d.userWriter.ExecContext(ctx, `
+		INSERT INTO oauth_authorization_codes (code_hash, app_id, user_id, redirect_uri, scopes, code_challenge)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		codeHash, code.AppId, code.UserId, code.RedirectUri, pq.StringArray(code.Scopes), code.CodeChallenge)
+	return err
+}
+
+// GetOAuthAuthorizationCode returns the code, exchanged codes are kept so a reuse can revoke the grant issued for them
+func (d *DataAccessService) GetOAuthAuthorizationCode(ctx context.Context, codeHash string) (*t.OAuthAuthorizationCode, error) {
+	var code struct {
+		AppId         uint64         `db:"app_id"`
+		UserId        uint64         `db:"user_id"`
+		RedirectUri   string         `db:"redirect_uri"`
+		Scopes        pq.StringArray `db:"scopes"`
+		CodeChallenge string         `db:"code_challenge"`
+		GrantId       sql.NullInt64  `db:"grant_id"`
+		CreatedTs     time.Time      `db:"created_ts"`
+	}
+	err := d.userWriter.GetContext(ctx, &code, `
+		SELECT app_id, user_id, redirect_uri, scopes, code_challenge, grant_id, created_ts
+		FROM oauth_authorization_codes
+		WHERE code_hash = $1`, codeHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: authorization code not found", ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t.OAuthAuthorizationCode{
+		AppId:         code.AppId,
+		UserId:        code.UserId,
+		RedirectUri:   code.RedirectUri,
+		Scopes:        code.Scopes,
+		CodeChallenge: code.CodeChallenge,
+		GrantId:       uint64(code.GrantId.Int64),
+		CreatedAt:     code.CreatedTs,
+	}, nil
+}
+
+// ExchangeOAuthAuthorizationCode stores a new grant for the code together with the first token pair issued for it.
+// Returns ErrNotFound if the code has been exchanged concurrently, codes can only be exchanged once.
+func (d *DataAccessService) ExchangeOAuthAuthorizationCode(ctx context.Context, codeHash string, accessTokenHash, refreshTokenHash string, accessExpiresAt, refreshExpiresAt time.Time) error {
+	tx, err := d.userWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting db transaction to create oauth grant: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	var grantId uint64
+	err = tx.GetContext(ctx, &grantId, `
+		INSERT INTO oauth_grants (app_id, user_id, scopes)
+		SELECT app_id, user_id, scopes
+		FROM oauth_authorization_codes
+		WHERE code_hash = $1
+		RETURNING id`, codeHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: authorization code not found", ErrNotFound)
+	}
+	if err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, `
+		UPDATE oauth_authorization_codes
+		SET grant_id = $2
+		WHERE code_hash = $1 AND grant_id IS NULL`, codeHash, grantId)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: authorization code already used", ErrNotFound)
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO oauth_tokens (grant_id, access_token_hash, refresh_token_hash, access_expires_ts, refresh_expires_ts)
+		VALUES ($1, $2, $3, $4, $5)`,
+		grantId, accessTokenHash, refreshTokenHash, accessExpiresAt.UTC(), refreshExpiresAt.UTC())
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetOAuthAccessToken returns the token info of a valid, non expired access token
+func (d *DataAccessService) GetOAuthAccessToken(ctx context.Context, accessTokenHash string) (*t.OAuthTokenInfo, error) {
+	return d.getOAuthToken(ctx, `ot.access_token_hash = $1 AND ot.access_expires_ts > NOW()`, accessTokenHash)
+}
+
+// GetOAuthRefreshToken returns the token info of a refresh token, including rotated and expired ones so reuse can be detected
+func (d *DataAccessService) GetOAuthRefreshToken(ctx context.Context, refreshTokenHash string) (*t.OAuthTokenInfo, error) {
+	return d.getOAuthToken(ctx, `ot.refresh_token_hash = $1`, refreshTokenHash)
+}
+
+func (d *DataAccessService) getOAuthToken(ctx context.Context, condition string, tokenHash string) (*t.OAuthTokenInfo, error) {
+	var token struct {
+		GrantId   uint64         `db:"grant_id"`
+		AppId     uint64         `db:"app_id"`
+		UserId    uint64         `db:"user_id"`
+		Scopes    pq.StringArray `db:"scopes"`
+		Rotated   bool           `db:"rotated"`
+		ExpiresTs time.Time      `db:"refresh_expires_ts"`
+	}
+	// read from the writer, tokens are used right after they have been issued
+	err := d.userWriter.GetContext(ctx, &token, `
+		SELECT og.id AS grant_id, og.app_id, og.user_id, og.scopes, ot.rotated, ot.refresh_expires_ts
+		FROM oauth_tokens ot
+		INNER JOIN oauth_grants og ON og.id = ot.grant_id
+		INNER JOIN oauth_apps oa ON oa.id = og.app_id AND oa.active = true
+		WHERE `+condition, tokenHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: oauth token not found", ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t.OAuthTokenInfo{
+		GrantId:   token.GrantId,
+		AppId:     token.AppId,
+		UserId:    token.UserId,
+		Scopes:    token.Scopes,
+		Rotated:   token.Rotated,
+		ExpiresAt: token.ExpiresTs,
+	}, nil
+}
+
+// RotateOAuthRefreshToken marks the old refresh token as used and issues a new token pair for the same grant.
+// Returns ErrNotFound if the old refresh token has been rotated concurrently.
+func (d *DataAccessService) RotateOAuthRefreshToken(ctx context.Context, oldRefreshTokenHash, accessTokenHash, refreshTokenHash string, accessExpiresAt, refreshExpiresAt time.Time) error {
+	tx, err := d.userWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting db transaction to rotate oauth refresh token: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	var grantId uint64
+	err = tx.GetContext(ctx, &grantId, `
+		UPDATE oauth_tokens
+		SET rotated = true
+		WHERE refresh_token_hash = $1 AND rotated = false
+		RETURNING grant_id`, oldRefreshTokenHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: refresh token already used", ErrNotFound)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO oauth_tokens (grant_id, access_token_hash, refresh_token_hash, access_expires_ts, refresh_expires_ts)
+		VALUES ($1, $2, $3, $4, $5)`,
+		grantId, accessTokenHash, refreshTokenHash, accessExpiresAt.UTC(), refreshExpiresAt.UTC())
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// RevokeOAuthGrant removes the grant and all tokens issued for it
+func (d *DataAccessService) RevokeOAuthGrant(ctx context.Context, grantId uint64) error {
+	_, err := d.userWriter.ExecContext(ctx, `DELETE FROM oauth_grants WHERE id = $1`, grantId)
+	return err
+}
+
+// RevokeOAuthToken removes the grant the given access or refresh token belongs to, unknown tokens are ignored
+func (d *DataAccessService) RevokeOAuthToken(ctx context.Context, appId uint64, tokenHash string) error {
+	_, err := d.userWriter.ExecContext(ctx, `
+		DELETE FROM oauth_grants
+		WHERE app_id = $1 AND id IN (
+			SELECT grant_id FROM oauth_tokens WHERE access_token_hash = $2 OR refresh_token_hash = $2
+		)`, appId, tokenHash)
+	return err
+}
+
+func (d *DataAccessService) GetUserOAuthApps(ctx context.Context, userId uint64) ([]t.UserOAuthApp, error) {
+	var apps []struct {
+		AppId     uint64         `db:"app_id"`
+		AppName   string         `db:"app_name"`
+		Scopes    pq.StringArray `db:"scopes"`
+		CreatedTs time.Time      `db:"created_ts"`
+	}
+	err := d.userReader.SelectContext(ctx, &apps, `
+		SELECT
+			og.app_id,
+			oa.app_name,
+			array_agg(DISTINCT scope ORDER BY scope) AS scopes,
+			MIN(og.created_ts) AS created_ts
+		FROM oauth_grants og
+		CROSS JOIN LATERAL unnest(og.scopes) AS scope
+		INNER JOIN oauth_apps oa ON oa.id = og.app_id
+		WHERE og.user_id = $1
+		GROUP BY og.app_id, oa.app_name
+		ORDER BY created_ts`, userId)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]t.UserOAuthApp, 0, len(apps))
+	for _, app := range apps {
+		result = append(result, t.UserOAuthApp{
+			AppId:        app.AppId,
+			AppName:      app.AppName,
+			Scopes:       app.Scopes,
+			AuthorizedAt: app.CreatedTs.Unix(),
+		})
+	}
+	return result, nil
+}
+
+// RemoveUserOAuthApp revokes all grants the user has given to the app
+func (d *DataAccessService) RemoveUserOAuthApp(ctx context.Context, userId, appId uint64) error {
+	result, err := d.userWriter.ExecContext(ctx, `DELETE FROM oauth_grants WHERE user_id = $1 AND app_id = $2`, userId, appId)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: no authorization for oauth app %d found", ErrNotFound, appId)
+	}
+	return nil
+}
@@ -0,0 +1,376 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
+)
+
+type PendingQueuesRepository interface {
+	GetValidatorQueue(ctx context.Context, chainId uint64) (*t.NetworkValidatorQueue, error)
+	GetPendingDeposits(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.PendingDeposit, *t.Paging, error)
+	GetPendingPartialWithdrawals(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.PendingPartialWithdrawal, *t.Paging, error)
+	GetPendingConsolidations(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.PendingConsolidation, *t.Paging, error)
+}
+
+type pendingQueuesSnapshot struct {
+	Epoch               uint64 `db:"epoch"`
+	ActivationExitChurn uint64 `db:"activation_exit_churn"`
+	ConsolidationChurn  uint64 `db:"consolidation_churn"`
+}
+
+type pendingDepositRow struct {
+	QueueIndex            uint64        `db:"queue_index"`
+	Pubkey                []byte        `db:"pubkey"`
+	ValidatorIndex        sql.NullInt64 `db:"validator_index"`
+	WithdrawalCredentials []byte        `db:"withdrawal_credentials"`
+	Amount                uint64        `db:"amount"`
+	Slot                  uint64        `db:"slot"`
+	EstimatedEpoch        uint64        `db:"estimated_epoch"`
+	GroupId               sql.NullInt64 `db:"group_id"`
+}
+
+type pendingPartialWithdrawalRow struct {
+	QueueIndex        uint64        `db:"queue_index"`
+	ValidatorIndex    uint64        `db:"validator_index"`
+	Amount            uint64        `db:"amount"`
+	WithdrawableEpoch uint64        `db:"withdrawable_epoch"`
+	EstimatedEpoch    uint64        `db:"estimated_epoch"`
+	GroupId           sql.NullInt64 `db:"group_id"`
+}
+
+type pendingConsolidationRow struct {
+	QueueIndex     uint64        `db:"queue_index"`
+	SourceIndex    uint64        `db:"source_index"`
+	TargetIndex    uint64        `db:"target_index"`
+	EstimatedEpoch sql.NullInt64 `db:"estimated_epoch"`
+	GroupId        sql.NullInt64 `db:"group_id"`
+}
+
+// getPendingQueuesSnapshot returns the latest completely exported snapshot of the pending queues, nil if there is none yet
+func (d *DataAccessService) getPendingQueuesSnapshot(ctx context.Context) (*pendingQueuesSnapshot, error) {
+	var snapshot pendingQueuesSnapshot
+	err := d.alloyReader.GetContext(ctx, &snapshot, `
+		SELECT epoch, activation_exit_churn, consolidation_churn
+		FROM pending_queues_snapshots
+		ORDER BY epoch DESC
+		LIMIT 1`)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving pending queues snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
+
+func pendingDepositsDs(epoch uint64) *goqu.SelectDataset {
+	return goqu.Dialect("postgres").
+		Select(
+			goqu.L("q.queue_index"),
+			goqu.L("q.pubkey"),
+			goqu.L("v.validatorindex AS validator_index"),
+			goqu.L("q.withdrawal_credentials"),
+			goqu.L("q.amount"),
+			goqu.L("q.slot"),
+			goqu.L("q.estimated_epoch")).
+		From(goqu.L("pending_deposits q")).
+		LeftJoin(goqu.L("validators v"), goqu.On(goqu.L("v.pubkey = q.pubkey"))).
+		Where(goqu.L("q.epoch = ?", epoch))
+}
+
+func pendingPartialWithdrawalsDs(epoch uint64) *goqu.SelectDataset {
+	return goqu.Dialect("postgres").
+		Select(
+			goqu.L("q.queue_index"),
+			goqu.L("q.validator_index"),
+			goqu.L("q.amount"),
+			goqu.L("q.withdrawable_epoch"),
+			goqu.L("q.estimated_epoch")).
+		From(goqu.L("pending_partial_withdrawals q")).
+		Where(goqu.L("q.epoch = ?", epoch))
+}
+
+func pendingConsolidationsDs(epoch uint64) *goqu.SelectDataset {
+	return goqu.Dialect("postgres").
+		Select(
+			goqu.L("q.queue_index"),
+			goqu.L("q.source_index"),
+			goqu.L("q.target_index"),
+			goqu.L("q.estimated_epoch")).
+		From(goqu.L("pending_consolidations q")).
+		Where(goqu.L("q.epoch = ?", epoch))
+}
+
+// pendingQueuePageQuery applies the keyset paging on the queue index of the given query, front of the queue first
+func pendingQueuePageQuery(ds *goqu.SelectDataset, currentCursor t.PendingQueueCursor, limit uint64) *goqu.SelectDataset {
+	if currentCursor.IsValid() {
+		if currentCursor.IsReverse() {
+			ds = ds.Where(goqu.L("q.queue_index < ?", currentCursor.QueueIndex))
+		} else {
+			ds = ds.Where(goqu.L("q.queue_index > ?", currentCursor.QueueIndex))
+		}
+	}
+	if currentCursor.IsReverse() {
+		ds = ds.Order(goqu.L("q.queue_index").Desc())
+	} else {
+		ds = ds.Order(goqu.L("q.queue_index").Asc())
+	}
+	return ds.Limit(uint(limit + 1))
+}
+
+// pendingQueuePage trims the extra row of a page queried by pendingQueuePageQuery and returns the paging for it
+func pendingQueuePage[T any](data []T, currentCursor t.PendingQueueCursor, limit uint64, key func(T) uint64) ([]T, *t.Paging, error) {
+	var paging t.Paging
+	moreDataFlag := len(data) > int(limit)
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// no paging required
+		return data, &paging, nil
+	}
+	if moreDataFlag {
+		data = data[:len(data)-1]
+	}
+	if currentCursor.IsReverse() {
+		slices.Reverse(data)
+	}
+	if len(data) == 0 {
+		return data, &paging, nil
+	}
+
+	cursors := make([]t.PendingQueueCursor, len(data))
+	for i, row := range data {
+		cursors[i].QueueIndex = key(row)
+	}
+	p, err := utils.GetPagingFromData(cursors, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	return data, p, nil
+}
+
+func parsePendingQueueCursor(cursor string) (t.PendingQueueCursor, error) {
+	var currentCursor t.PendingQueueCursor
+	if cursor == "" {
+		return currentCursor, nil
+	}
+	currentCursor, err := utils.StringToCursor[t.PendingQueueCursor](cursor)
+	if err != nil {
+		return currentCursor, fmt.Errorf("failed to parse passed cursor as PendingQueueCursor: %w", err)
+	}
+	return currentCursor, nil
+}
+
+// getPendingQueueRows returns one page of the given pending queue query
+func getPendingQueueRows[T any](ctx context.Context, d *DataAccessService, ds *goqu.SelectDataset, cursor string, limit uint64, key func(T) uint64) ([]T, *t.Paging, error) {
+	currentCursor, err := parsePendingQueueCursor(cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+	query, args, err := pendingQueuePageQuery(ds, currentCursor, limit).Prepared(true).ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing query: %w", err)
+	}
+	var rows []T
+	err = d.alloyReader.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving pending queue: %w", err)
+	}
+	return pendingQueuePage(rows, currentCursor, limit, key)
+}
+
+func gweiToWei(gwei uint64) decimal.Decimal {
+	return utils.GWeiToWei(new(big.Int).SetUint64(gwei))
+}
+
+func epochTimestamp(epoch uint64) uint64 {
+	return uint64(utils.EpochToTime(epoch).Unix())
+}
+
+func (d *DataAccessService) GetValidatorQueue(ctx context.Context, chainId uint64) (*t.NetworkValidatorQueue, error) {
+	if err := d.checkConsensusLayerNetwork(chainId); err != nil {
+		return nil, err
+	}
+	snapshot, err := d.getPendingQueuesSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("%w: no pending queues available for network %d", ErrNotFound, chainId)
+	}
+
+	type queueSummaryRow struct {
+		Count               uint64        `db:"count"`
+		Amount              uint64        `db:"amount"`
+		EstimatedClearEpoch sql.NullInt64 `db:"estimated_clear_epoch"`
+	}
+	var deposits, partialWithdrawals, consolidations queueSummaryRow
+
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		err := d.alloyReader.GetContext(ctx, &deposits, `
+			SELECT COUNT(*) AS count, COALESCE(SUM(amount), 0) AS amount, MAX(estimated_epoch) AS estimated_clear_epoch
+			FROM pending_deposits
+			WHERE epoch = $1`, snapshot.Epoch)
+		if err != nil {
+			return fmt.Errorf("error retrieving pending deposits summary: %w", err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		err := d.alloyReader.GetContext(ctx, &partialWithdrawals, `
+			SELECT COUNT(*) AS count, COALESCE(SUM(amount), 0) AS amount, MAX(estimated_epoch) AS estimated_clear_epoch
+			FROM pending_partial_withdrawals
+			WHERE epoch = $1`, snapshot.Epoch)
+		if err != nil {
+			return fmt.Errorf("error retrieving pending partial withdrawals summary: %w", err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		// consolidations without an estimate block all following ones, so the queue only has a clear epoch if every item has an estimate
+		err := d.alloyReader.GetContext(ctx, &consolidations, `
+			SELECT
+				COUNT(*) AS count,
+				COALESCE(SUM(v.effectivebalance), 0) AS amount,
+				CASE WHEN COUNT(q.estimated_epoch) = COUNT(*) THEN MAX(q.estimated_epoch) END AS estimated_clear_epoch
+			FROM pending_consolidations q
+			LEFT JOIN validators v ON v.validatorindex = q.source_index
+			WHERE q.epoch = $1`, snapshot.Epoch)
+		if err != nil {
+			return fmt.Errorf("error retrieving pending consolidations summary: %w", err)
+		}
+		return nil
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	toSummary := func(row queueSummaryRow) t.PendingQueueSummary {
+		summary := t.PendingQueueSummary{
+			Count:  row.Count,
+			Amount: gweiToWei(row.Amount),
+		}
+		if row.EstimatedClearEpoch.Valid {
+			epoch := uint64(row.EstimatedClearEpoch.Int64)
+			summary.EstimatedClearEpoch = &epoch
+		}
+		return summary
+	}
+	return &t.NetworkValidatorQueue{
+		Epoch:               snapshot.Epoch,
+		ActivationExitChurn: gweiToWei(snapshot.ActivationExitChurn),
+		ConsolidationChurn:  gweiToWei(snapshot.ConsolidationChurn),
+		Deposits:            toSummary(deposits),
+		PartialWithdrawals:  toSummary(partialWithdrawals),
+		Consolidations:      toSummary(consolidations),
+	}, nil
+}
+
+func (d *DataAccessService) GetPendingDeposits(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.PendingDeposit, *t.Paging, error) {
+	if err := d.checkConsensusLayerNetwork(chainId); err != nil {
+		return nil, nil, err
+	}
+	snapshot, err := d.getPendingQueuesSnapshot(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if snapshot == nil {
+		return []t.PendingDeposit{}, &t.Paging{}, nil
+	}
+	rows, paging, err := getPendingQueueRows(ctx, d, pendingDepositsDs(snapshot.Epoch), cursor, limit, func(row pendingDepositRow) uint64 { return row.QueueIndex })
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.PendingDeposit, len(rows))
+	for i, row := range rows {
+		result[i] = t.PendingDeposit{
+			Position:             row.QueueIndex,
+			Validator:            executionRequestValidator(row.Pubkey, row.ValidatorIndex),
+			WithdrawalCredential: t.Hash(hexutil.Encode(row.WithdrawalCredentials)),
+			Amount:               gweiToWei(row.Amount),
+			Slot:                 row.Slot,
+			EstimatedEpoch:       row.EstimatedEpoch,
+			EstimatedTimestamp:   epochTimestamp(row.EstimatedEpoch),
+		}
+	}
+	return result, paging, nil
+}
+
+func (d *DataAccessService) GetPendingPartialWithdrawals(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.PendingPartialWithdrawal, *t.Paging, error) {
+	if err := d.checkConsensusLayerNetwork(chainId); err != nil {
+		return nil, nil, err
+	}
+	snapshot, err := d.getPendingQueuesSnapshot(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if snapshot == nil {
+		return []t.PendingPartialWithdrawal{}, &t.Paging{}, nil
+	}
+	rows, paging, err := getPendingQueueRows(ctx, d, pendingPartialWithdrawalsDs(snapshot.Epoch), cursor, limit, func(row pendingPartialWithdrawalRow) uint64 { return row.QueueIndex })
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.PendingPartialWithdrawal, len(rows))
+	for i, row := range rows {
+		result[i] = t.PendingPartialWithdrawal{
+			Position:           row.QueueIndex,
+			Index:              row.ValidatorIndex,
+			Amount:             gweiToWei(row.Amount),
+			WithdrawableEpoch:  row.WithdrawableEpoch,
+			EstimatedEpoch:     row.EstimatedEpoch,
+			EstimatedTimestamp: epochTimestamp(row.EstimatedEpoch),
+		}
+	}
+	return result, paging, nil
+}
+
+// pendingConsolidationEstimate returns the estimated epoch and timestamp of a consolidation, both nil if there is no estimate yet
+func pendingConsolidationEstimate(estimatedEpoch sql.NullInt64) (*uint64, *uint64) {
+	if !estimatedEpoch.Valid {
+		return nil, nil
+	}
+	epoch := uint64(estimatedEpoch.Int64)
+	ts := epochTimestamp(epoch)
+	return &epoch, &ts
+}
+
+func (d *DataAccessService) GetPendingConsolidations(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.PendingConsolidation, *t.Paging, error) {
+	if err := d.checkConsensusLayerNetwork(chainId); err != nil {
+		return nil, nil, err
+	}
+	snapshot, err := d.getPendingQueuesSnapshot(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if snapshot == nil {
+		return []t.PendingConsolidation{}, &t.Paging{}, nil
+	}
+	rows, paging, err := getPendingQueueRows(ctx, d, pendingConsolidationsDs(snapshot.Epoch), cursor, limit, func(row pendingConsolidationRow) uint64 { return row.QueueIndex })
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.PendingConsolidation, len(rows))
+	for i, row := range rows {
+		result[i] = t.PendingConsolidation{
+			Position:    row.QueueIndex,
+			SourceIndex: row.SourceIndex,
+			TargetIndex: row.TargetIndex,
+		}
+		result[i].EstimatedEpoch, result[i].EstimatedTimestamp = pendingConsolidationEstimate(row.EstimatedEpoch)
+	}
+	return result, paging, nil
+}
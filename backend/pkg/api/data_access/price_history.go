@@ -0,0 +1,91 @@
+package dataaccess
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/price"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+)
+
+type PriceHistoryRepository interface {
+	GetEthPriceHistory(ctx context.Context, currency string, resolution string, afterTs, beforeTs uint64) (*t.EthPriceHistoryData, error)
+	GetDailyPrices(ctx context.Context, a, b string, days []time.Time) (map[time.Time]float64, error)
+}
+
+var priceHistoryResolutions = map[string]string{
+	"hourly": price.ResolutionHour,
+	"daily":  price.ResolutionDay,
+}
+
+// GetEthPriceHistory returns the price candles of the main currency of the chain, e.g. GNO on gnosis
+func (d *DataAccessService) GetEthPriceHistory(ctx context.Context, currency string, resolution string, afterTs, beforeTs uint64) (*t.EthPriceHistoryData, error) {
+	base := utils.Config.Frontend.MainCurrency
+	result := &t.EthPriceHistoryData{
+		Base:       base,
+		Currency:   currency,
+		Resolution: resolution,
+		Candles:    []t.PriceCandle{},
+	}
+	pair, factor := price.HistoricPair(base, currency)
+	if a, b, _ := strings.Cut(pair, "/"); a == b {
+		// not stored, every candle would be the factor
+		return result, nil
+	}
+	var candles []price.Candle
+	err := d.readerDb.SelectContext(ctx, &candles, `
+		SELECT pair, resolution, ts, open, high, low, close, sources
+		FROM price_history
+		WHERE pair = $1 AND resolution = $2 AND ts >= $3 AND ts <= $4
+		ORDER BY ts`, pair, priceHistoryResolutions[resolution], time.Unix(int64(afterTs), 0).UTC(), time.Unix(int64(beforeTs), 0).UTC())
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range candles {
+		result.Candles = append(result.Candles, t.PriceCandle{
+			Ts:    c.Ts.Unix(),
+			Open:  c.Open * factor,
+			High:  c.High * factor,
+			Low:   c.Low * factor,
+			Close: c.Close * factor,
+		})
+	}
+	return result, nil
+}
+
+// GetDailyPrices returns the closing prices of a in b keyed by the start of the (UTC) day, days without a price are omitted
+func (d *DataAccessService) GetDailyPrices(ctx context.Context, a, b string, days []time.Time) (map[time.Time]float64, error) {
+	result := make(map[time.Time]float64, len(days))
+	if len(days) == 0 {
+		return result, nil
+	}
+	utcDays := make([]time.Time, 0, len(days))
+	for _, day := range days {
+		utcDays = append(utcDays, day.UTC().Truncate(time.Hour*24))
+	}
+	pair, factor := price.HistoricPair(a, b)
+	if base, quote, _ := strings.Cut(pair, "/"); base == quote {
+		for _, day := range utcDays {
+			result[day] = factor
+		}
+		return result, nil
+	}
+	var rows []struct {
+		Ts    time.Time `db:"ts"`
+		Close float64   `db:"close"`
+	}
+	err := d.readerDb.SelectContext(ctx, &rows, `
+		SELECT ts, close
+		FROM price_history
+		WHERE pair = $1 AND resolution = $2 AND ts = ANY($3::TIMESTAMP[])`, pair, price.ResolutionDay, pq.Array(utcDays))
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		result[row.Ts.UTC()] = row.Close * factor
+	}
+	return result, nil
+}
@@ -2,18 +2,381 @@ package dataaccess
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/doug-martin/goqu/v9"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/shopspring/decimal"
 )
 
 type ProtocolRepository interface {
 	// Rocket Pool
 	GetRocketPoolOverview(context.Context) (*types.RocketPoolData, error)
+	GetRocketPoolNodes(ctx context.Context, cursor string, limit uint64) ([]types.RocketPoolNode, *types.Paging, error)
+	GetRocketPoolMinipools(ctx context.Context, node []byte, cursor string, limit uint64) ([]types.RocketPoolMinipool, *types.Paging, error)
 
-	// Lido, ...
+	// Staking protocols (Lido, ether.fi, ...)
+	GetStakingProtocol(ctx context.Context, protocol string) (*types.StakingProtocolData, error)
 }
 
 func (d *DataAccessService) GetRocketPoolOverview(ctx context.Context) (*types.RocketPoolData, error) {
-	// TODO @DATA-ACCESS
-	return d.dummy.GetRocketPoolOverview(ctx)
+	var stats struct {
+		RplPrice               decimal.Decimal `db:"rpl_price"`
+		ClaimIntervalSeconds   float64         `db:"claim_interval_seconds"`
+		ClaimIntervalTimeStart time.Time       `db:"claim_interval_time_start"`
+		CurrentNodeFee         float64         `db:"current_node_fee"`
+		CurrentNodeDemand      decimal.Decimal `db:"current_node_demand"`
+		RethSupply             decimal.Decimal `db:"reth_supply"`
+		EffectiveRplStaked     decimal.Decimal `db:"effective_rpl_staked"`
+		RethExchangeRate       float64         `db:"reth_exchange_rate"`
+		NodeCount              uint64          `db:"node_count"`
+		MinipoolCount          uint64          `db:"minipool_count"`
+		OdaoMemberCount        uint64          `db:"odao_member_count"`
+		TotalEthStaking        decimal.Decimal `db:"total_eth_staking"`
+	}
+	err := d.alloyReader.GetContext(ctx, &stats, `
+		SELECT
+			rpl_price,
+			EXTRACT(EPOCH FROM claim_interval_time) AS claim_interval_seconds,
+			claim_interval_time_start,
+			current_node_fee,
+			current_node_demand,
+			reth_supply,
+			effective_rpl_staked,
+			reth_exchange_rate,
+			node_count,
+			minipool_count,
+			odao_member_count,
+			total_eth_staking
+		FROM rocketpool_network_stats
+		ORDER BY id DESC
+		LIMIT 1`)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no rocketpool network stats found", ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving rocketpool network stats: %w", err)
+	}
+
+	var activeProposals uint64
+	err = d.alloyReader.GetContext(ctx, &activeProposals, `
+		SELECT COUNT(*) FROM rocketpool_dao_proposals WHERE state IN ('Pending', 'Active')`)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving rocketpool dao proposals: %w", err)
+	}
+
+	// the interval start is a timestamp without time zone, it is stored in utc
+	intervalStart := uint64(stats.ClaimIntervalTimeStart.Unix())
+	intervalEnd := intervalStart + uint64(stats.ClaimIntervalSeconds)
+	result := types.RocketPoolData{
+		LastUpdateSlot:     utils.TimeToSlot(intervalStart),
+		NextUpdateSlot:     utils.TimeToSlot(intervalEnd),
+		Nodes:              stats.NodeCount,
+		Minipools:          stats.MinipoolCount,
+		OracleDaoMembers:   stats.OdaoMemberCount,
+		RethSupply:         stats.RethSupply,
+		TotalEthStaking:    stats.TotalEthStaking,
+		EffectiveRplStaked: stats.EffectiveRplStaked,
+		NodeFee:            stats.CurrentNodeFee,
+		NodeDemand:         stats.CurrentNodeDemand,
+		ActiveDaoProposals: activeProposals,
+	}
+	result.EthRates.Rpl = stats.RplPrice.Div(decimal.New(1, 18)).InexactFloat64()
+	result.EthRates.Reth = stats.RethExchangeRate
+	return &result, nil
+}
+
+// rocketPoolPageQuery applies the keyset paging on the address column of the given query
+func rocketPoolPageQuery(ds *goqu.SelectDataset, column string, currentCursor types.RocketPoolCursor, limit uint64) *goqu.SelectDataset {
+	if currentCursor.IsValid() {
+		if currentCursor.IsReverse() {
+			ds = ds.Where(goqu.L(column+" < ?", currentCursor.Address))
+		} else {
+			ds = ds.Where(goqu.L(column+" > ?", currentCursor.Address))
+		}
+	}
+	if currentCursor.IsReverse() {
+		ds = ds.Order(goqu.L(column).Desc())
+	} else {
+		ds = ds.Order(goqu.L(column).Asc())
+	}
+	return ds.Limit(uint(limit + 1))
+}
+
+// rocketPoolPage trims the extra row of a page queried by rocketPoolPageQuery and returns the paging for it
+func rocketPoolPage[T any](data []T, currentCursor types.RocketPoolCursor, limit uint64, address func(T) []byte) ([]T, *types.Paging, error) {
+	var paging types.Paging
+	moreDataFlag := len(data) > int(limit)
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// no paging required
+		return data, &paging, nil
+	}
+	if moreDataFlag {
+		data = data[:len(data)-1]
+	}
+	if currentCursor.IsReverse() {
+		slices.Reverse(data)
+	}
+	if len(data) == 0 {
+		return data, &paging, nil
+	}
+
+	cursors := make([]types.RocketPoolCursor, len(data))
+	for i, row := range data {
+		cursors[i].Address = address(row)
+	}
+	p, err := utils.GetPagingFromData(cursors, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	return data, p, nil
+}
+
+func (d *DataAccessService) GetRocketPoolNodes(ctx context.Context, cursor string, limit uint64) ([]types.RocketPoolNode, *types.Paging, error) {
+	var currentCursor types.RocketPoolCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[types.RocketPoolCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as RocketPoolCursor: %w", err)
+		}
+	}
+
+	var nodes []struct {
+		rocketPoolNode
+		Minipools uint64 `db:"minipools"`
+	}
+	ds := goqu.Dialect("postgres").
+		Select(
+			goqu.L("n.address"),
+			goqu.L("n.timezone_location"),
+			goqu.L("n.rpl_stake"),
+			goqu.L("n.min_rpl_stake"),
+			goqu.L("n.max_rpl_stake"),
+			goqu.L("n.rpl_cumulative_rewards"),
+			goqu.L("n.smoothing_pool_opted_in"),
+			goqu.L("n.claimed_smoothing_pool"),
+			goqu.L("n.unclaimed_smoothing_pool"),
+			goqu.L("n.unclaimed_rpl_rewards"),
+			goqu.L("n.effective_rpl_stake"),
+			goqu.L("COALESCE(n.deposit_credit, 0) AS deposit_credit"),
+			goqu.L("(SELECT COUNT(*) FROM rocketpool_minipools m WHERE m.node_address = n.address) AS minipools")).
+		From(goqu.L("rocketpool_nodes AS n"))
+	query, args, err := rocketPoolPageQuery(ds, "n.address", currentCursor, limit).Prepared(true).ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing query: %w", err)
+	}
+	err = d.alloyReader.SelectContext(ctx, &nodes, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving rocketpool nodes: %w", err)
+	}
+
+	result := make([]types.RocketPoolNode, 0, len(nodes))
+	addressMap := make(map[string]*types.Address, len(nodes))
+	for _, node := range nodes {
+		row := types.RocketPoolNode{
+			Address:       types.Address{Hash: types.Hash(hexutil.Encode(node.Address))},
+			Timezone:      node.Timezone,
+			Minipools:     node.Minipools,
+			DepositCredit: node.DepositCredit,
+		}
+		row.RplStake.Current = node.RplStake
+		row.RplStake.Effective = node.EffectiveRplStake
+		row.RplStake.Min = node.MinRplStake
+		row.RplStake.Max = node.MaxRplStake
+		row.Rpl.Claimed = node.RplCumulativeRewards
+		row.Rpl.Unclaimed = node.UnclaimedRplRewards
+		row.SmoothingPool.IsOptIn = node.SmoothingPoolOptedIn
+		row.SmoothingPool.Claimed = node.ClaimedSmoothingPool
+		row.SmoothingPool.Unclaimed = node.UnclaimedSmoothingPool
+		result = append(result, row)
+	}
+
+	result, p, err := rocketPoolPage(result, currentCursor, limit, func(row types.RocketPoolNode) []byte {
+		return hexutil.MustDecode(string(row.Address.Hash))
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range result {
+		addressMap[string(result[i].Address.Hash)] = &result[i].Address
+	}
+	if err := d.GetNamesAndEnsForAddresses(ctx, addressMap); err != nil {
+		return nil, nil, err
+	}
+	return result, p, nil
+}
+
+func (d *DataAccessService) GetRocketPoolMinipools(ctx context.Context, node []byte, cursor string, limit uint64) ([]types.RocketPoolMinipool, *types.Paging, error) {
+	var currentCursor types.RocketPoolCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[types.RocketPoolCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as RocketPoolCursor: %w", err)
+		}
+	}
+
+	var minipools []struct {
+		Address        []byte          `db:"address"`
+		PublicKey      []byte          `db:"pubkey"`
+		Node           []byte          `db:"node_address"`
+		ValidatorIndex sql.NullInt64   `db:"validator_index"`
+		Status         string          `db:"status"`
+		StatusTime     sql.NullTime    `db:"status_time"`
+		DepositType    string          `db:"deposit_type"`
+		NodeFee        float64         `db:"node_fee"`
+		PenaltyCount   uint64          `db:"penalty_count"`
+		NodeDeposit    decimal.Decimal `db:"node_deposit_balance"`
+		UserDeposit    decimal.Decimal `db:"user_deposit_balance"`
+		RefundBalance  decimal.Decimal `db:"node_refund_balance"`
+		IsVacant       bool            `db:"is_vacant"`
+	}
+	ds := goqu.Dialect("postgres").
+		Select(
+			goqu.L("m.address"),
+			goqu.L("m.pubkey"),
+			goqu.L("m.node_address"),
+			goqu.L("m.validator_index"),
+			goqu.L("m.status"),
+			goqu.L("m.status_time"),
+			goqu.L("m.deposit_type"),
+			goqu.L("m.node_fee"),
+			goqu.L("m.penalty_count"),
+			goqu.L("COALESCE(m.node_deposit_balance, 0) AS node_deposit_balance"),
+			goqu.L("COALESCE(m.user_deposit_balance, 0) AS user_deposit_balance"),
+			goqu.L("COALESCE(m.node_refund_balance, 0) AS node_refund_balance"),
+			goqu.L("COALESCE(m.is_vacant, false) AS is_vacant")).
+		From(goqu.L("rocketpool_minipools AS m"))
+	if node != nil {
+		ds = ds.Where(goqu.L("m.node_address = ?", node))
+	}
+	query, args, err := rocketPoolPageQuery(ds, "m.address", currentCursor, limit).Prepared(true).ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing query: %w", err)
+	}
+	err = d.alloyReader.SelectContext(ctx, &minipools, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving rocketpool minipools: %w", err)
+	}
+
+	result := make([]types.RocketPoolMinipool, 0, len(minipools))
+	for _, minipool := range minipools {
+		row := types.RocketPoolMinipool{
+			Address:       types.Address{Hash: types.Hash(hexutil.Encode(minipool.Address)), IsContract: true},
+			Node:          types.Address{Hash: types.Hash(hexutil.Encode(minipool.Node))},
+			PublicKey:     types.PubKey(hexutil.Encode(minipool.PublicKey)),
+			Status:        strings.ToLower(minipool.Status),
+			DepositType:   strings.ToLower(minipool.DepositType),
+			Commission:    minipool.NodeFee,
+			Bond:          minipool.NodeDeposit,
+			Borrowed:      minipool.UserDeposit,
+			RefundBalance: minipool.RefundBalance,
+			Penalties:     minipool.PenaltyCount,
+			IsVacant:      minipool.IsVacant,
+		}
+		if minipool.ValidatorIndex.Valid {
+			index := uint64(minipool.ValidatorIndex.Int64)
+			row.ValidatorIndex = &index
+		}
+		if minipool.StatusTime.Valid {
+			row.StatusTimestamp = minipool.StatusTime.Time.Unix()
+		}
+		result = append(result, row)
+	}
+
+	result, p, err := rocketPoolPage(result, currentCursor, limit, func(row types.RocketPoolMinipool) []byte {
+		return hexutil.MustDecode(string(row.Address.Hash))
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	addressMap := make(map[string]*types.Address, len(result))
+	for i := range result {
+		addressMap[string(result[i].Node.Hash)] = nil
+	}
+	if err := d.GetNamesAndEnsForAddresses(ctx, addressMap); err != nil {
+		return nil, nil, err
+	}
+	for i := range result {
+		result[i].Node = *addressMap[string(result[i].Node.Hash)]
+	}
+	return result, p, nil
+}
+
+func (d *DataAccessService) GetStakingProtocol(ctx context.Context, protocol string) (*types.StakingProtocolData, error) {
+	var stats struct {
+		TreasuryFee float64   `db:"treasury_fee"`
+		OperatorFee float64   `db:"operator_fee"`
+		Validators  uint64    `db:"validators"`
+		UpdatedAt   time.Time `db:"updated_at"`
+	}
+	err := d.alloyReader.GetContext(ctx, &stats, `
+		SELECT
+			treasury_fee,
+			operator_fee,
+			(SELECT COUNT(*) FROM staking_protocol_validators WHERE protocol = $1) AS validators,
+			updated_at
+		FROM staking_protocols
+		WHERE protocol = $1`, protocol)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no data exported for staking protocol %s", ErrNotFound, protocol)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving staking protocol %s: %w", protocol, err)
+	}
+
+	var operators []struct {
+		Id            string `db:"operator_id"`
+		Name          string `db:"name"`
+		RewardAddress []byte `db:"reward_address"`
+		Active        bool   `db:"active"`
+		Validators    uint64 `db:"validator_count"`
+	}
+	err = d.alloyReader.SelectContext(ctx, &operators, `
+		SELECT operator_id, name, reward_address, active, validator_count
+		FROM staking_protocol_operators
+		WHERE protocol = $1
+		ORDER BY validator_count DESC, operator_id`, protocol)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving operators of staking protocol %s: %w", protocol, err)
+	}
+
+	data := &types.StakingProtocolData{
+		Protocol:    protocol,
+		TreasuryFee: stats.TreasuryFee,
+		OperatorFee: stats.OperatorFee,
+		Validators:  stats.Validators,
+		UpdatedAt:   stats.UpdatedAt.Unix(),
+		Operators:   make([]types.StakingProtocolOperator, 0, len(operators)),
+	}
+	addressMap := make(map[string]*types.Address)
+	for _, operator := range operators {
+		row := types.StakingProtocolOperator{
+			Id:         operator.Id,
+			Name:       operator.Name,
+			IsActive:   operator.Active,
+			Validators: operator.Validators,
+		}
+		if len(operator.RewardAddress) > 0 {
+			// operators may share a reward address
+			hash := hexutil.Encode(operator.RewardAddress)
+			if _, ok := addressMap[hash]; !ok {
+				addressMap[hash] = &types.Address{Hash: types.Hash(hash)}
+			}
+			row.RewardAddress = addressMap[hash]
+		}
+		data.Operators = append(data.Operators, row)
+	}
+	if err := d.GetNamesAndEnsForAddresses(ctx, addressMap); err != nil {
+		return nil, err
+	}
+	return data, nil
 }
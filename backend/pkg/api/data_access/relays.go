@@ -0,0 +1,200 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/cache"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+type RelaysRepository interface {
+	GetRelayPerformance(ctx context.Context, chainId uint64) ([]t.RelayPerformance, error)
+	GetBuilderPerformance(ctx context.Context, chainId uint64) ([]t.BuilderPerformance, error)
+}
+
+// the relay and builder performance is computed over the bids of the last week, the exporter keeps them for a week by default
+const relayStatsDays = 7
+
+type relayPerformanceRow struct {
+	TagId             string          `db:"tag_id"`
+	Link              string          `db:"public_link"`
+	IsCensoring       bool            `db:"is_censoring"`
+	IsEthical         bool            `db:"is_ethical"`
+	SlotsBid          uint64          `db:"slots_bid"`
+	SlotsDelivered    uint64          `db:"slots_delivered"`
+	MedianBestBid     decimal.Decimal `db:"median_best_bid"`
+	MedianBidLeadTime int64           `db:"median_bid_lead_time"`
+}
+
+type builderPerformanceRow struct {
+	BuilderPubkey     []byte          `db:"builder_pubkey"`
+	Relays            pq.StringArray  `db:"relays"`
+	IsCensoring       bool            `db:"is_censoring"`
+	SlotsBid          uint64          `db:"slots_bid"`
+	SlotsWon          uint64          `db:"slots_won"`
+	MedianBestBid     decimal.Decimal `db:"median_best_bid"`
+	MedianBidLeadTime int64           `db:"median_bid_lead_time"`
+}
+
+// relayStatsParams returns the first slot of the stats window and the parameters to compute the start of a slot in milliseconds
+func relayStatsParams() (fromSlot, genesisMs, slotMs uint64) {
+	window := relayStatsDays * utils.EpochsPerDay() * utils.Config.Chain.ClConfig.SlotsPerEpoch
+	if latestSlot := cache.LatestSlot.Get(); latestSlot > window {
+		fromSlot = latestSlot - window
+	}
+	return fromSlot, utils.Config.Chain.GenesisTimestamp * 1000, utils.Config.Chain.ClConfig.SecondsPerSlot * 1000
+}
+
+// relayWinRate is the share of the slots with bids that were won, only won slots with exported bids are counted so it
+// can't exceed 1
+func relayWinRate(won, bid uint64) float64 {
+	if bid == 0 {
+		return 0
+	}
+	return float64(won) / float64(bid)
+}
+
+func (d *DataAccessService) GetRelayPerformance(ctx context.Context, chainId uint64) ([]t.RelayPerformance, error) {
+	if err := d.checkConsensusLayerNetwork(chainId); err != nil {
+		return nil, err
+	}
+	fromSlot, genesisMs, slotMs := relayStatsParams()
+
+	var rows []relayPerformanceRow
+	err := d.alloyReader.SelectContext(ctx, &rows, `
+		WITH best AS (
+			SELECT DISTINCT ON (tag_id, slot) tag_id, slot, value
+			FROM relays_bids
+			WHERE slot >= $1
+			ORDER BY tag_id, slot, value DESC
+		), bids AS (
+			SELECT tag_id, COUNT(*) AS slots_bid, percentile_disc(0.5) WITHIN GROUP (ORDER BY value) AS median_best_bid
+			FROM best
+			GROUP BY tag_id
+		), lead_times AS (
+			SELECT tag_id, percentile_disc(0.5) WITHIN GROUP (ORDER BY ($2 + slot * $3) - timestamp_ms) AS median_bid_lead_time
+			FROM relays_bids
+			WHERE slot >= $1 AND timestamp_ms > 0
+			GROUP BY tag_id
+		), delivered AS (
+			SELECT rb.tag_id, COUNT(DISTINCT rb.block_slot) AS slots_delivered
+			FROM relays_blocks rb
+			INNER JOIN blocks b ON b.slot = rb.block_slot AND b.blockroot = rb.block_root AND b.status = '1'
+			WHERE rb.block_slot >= $1 AND (rb.tag_id, rb.block_slot) IN (SELECT tag_id, slot FROM best)
+			GROUP BY rb.tag_id
+		)
+		SELECT
+			r.tag_id,
+			r.public_link,
+			r.is_censoring,
+			r.is_ethical,
+			COALESCE(bids.slots_bid, 0) AS slots_bid,
+			COALESCE(delivered.slots_delivered, 0) AS slots_delivered,
+			COALESCE(bids.median_best_bid, 0) AS median_best_bid,
+			COALESCE(lead_times.median_bid_lead_time, 0) AS median_bid_lead_time
+		FROM (
+			SELECT
+				tag_id,
+				COALESCE(MAX(public_link), '') AS public_link,
+				bool_or(COALESCE(is_censoring, false)) AS is_censoring,
+				bool_or(COALESCE(is_ethical, false)) AS is_ethical
+			FROM relays
+			GROUP BY tag_id
+		) r
+		LEFT JOIN bids ON bids.tag_id = r.tag_id
+		LEFT JOIN lead_times ON lead_times.tag_id = r.tag_id
+		LEFT JOIN delivered ON delivered.tag_id = r.tag_id
+		ORDER BY slots_delivered DESC, r.tag_id`, fromSlot, genesisMs, slotMs)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving relay performance: %w", err)
+	}
+
+	result := make([]t.RelayPerformance, len(rows))
+	for i, row := range rows {
+		result[i] = t.RelayPerformance{
+			Name:              row.TagId,
+			Link:              row.Link,
+			IsCensoring:       row.IsCensoring,
+			IsEthical:         row.IsEthical,
+			SlotsBid:          row.SlotsBid,
+			SlotsDelivered:    row.SlotsDelivered,
+			WinRate:           relayWinRate(row.SlotsDelivered, row.SlotsBid),
+			MedianBestBid:     row.MedianBestBid,
+			MedianBidLeadTime: row.MedianBidLeadTime,
+		}
+	}
+	return result, nil
+}
+
+// a builder is flagged as censoring if all relays it submitted bids to are censoring
+func (d *DataAccessService) GetBuilderPerformance(ctx context.Context, chainId uint64) ([]t.BuilderPerformance, error) {
+	if err := d.checkConsensusLayerNetwork(chainId); err != nil {
+		return nil, err
+	}
+	fromSlot, genesisMs, slotMs := relayStatsParams()
+
+	var rows []builderPerformanceRow
+	err := d.alloyReader.SelectContext(ctx, &rows, `
+		WITH best AS (
+			SELECT DISTINCT ON (builder_pubkey, slot) builder_pubkey, slot, value
+			FROM relays_bids
+			WHERE slot >= $1
+			ORDER BY builder_pubkey, slot, value DESC
+		), bids AS (
+			SELECT builder_pubkey, COUNT(*) AS slots_bid, percentile_disc(0.5) WITHIN GROUP (ORDER BY value) AS median_best_bid
+			FROM best
+			GROUP BY builder_pubkey
+		), lead_times AS (
+			SELECT builder_pubkey, percentile_disc(0.5) WITHIN GROUP (ORDER BY ($2 + slot * $3) - timestamp_ms) AS median_bid_lead_time
+			FROM relays_bids
+			WHERE slot >= $1 AND timestamp_ms > 0
+			GROUP BY builder_pubkey
+		), builder_relays AS (
+			SELECT bi.builder_pubkey, array_agg(bi.tag_id ORDER BY bi.tag_id) AS relays, bool_and(COALESCE(r.is_censoring, false)) AS is_censoring
+			FROM (SELECT DISTINCT builder_pubkey, tag_id FROM relays_bids WHERE slot >= $1) bi
+			LEFT JOIN (SELECT tag_id, bool_or(COALESCE(is_censoring, false)) AS is_censoring FROM relays GROUP BY tag_id) r ON r.tag_id = bi.tag_id
+			GROUP BY bi.builder_pubkey
+		), won AS (
+			SELECT rb.builder_pubkey, COUNT(DISTINCT rb.block_slot) AS slots_won
+			FROM relays_blocks rb
+			INNER JOIN blocks b ON b.slot = rb.block_slot AND b.blockroot = rb.block_root AND b.status = '1'
+			WHERE rb.block_slot >= $1 AND (rb.builder_pubkey, rb.block_slot) IN (SELECT builder_pubkey, slot FROM best)
+			GROUP BY rb.builder_pubkey
+		)
+		SELECT
+			bids.builder_pubkey,
+			builder_relays.relays,
+			builder_relays.is_censoring,
+			bids.slots_bid,
+			COALESCE(won.slots_won, 0) AS slots_won,
+			bids.median_best_bid,
+			COALESCE(lead_times.median_bid_lead_time, 0) AS median_bid_lead_time
+		FROM bids
+		INNER JOIN builder_relays ON builder_relays.builder_pubkey = bids.builder_pubkey
+		LEFT JOIN lead_times ON lead_times.builder_pubkey = bids.builder_pubkey
+		LEFT JOIN won ON won.builder_pubkey = bids.builder_pubkey
+		ORDER BY slots_won DESC, bids.slots_bid DESC, bids.builder_pubkey`, fromSlot, genesisMs, slotMs)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving builder performance: %w", err)
+	}
+
+	result := make([]t.BuilderPerformance, len(rows))
+	for i, row := range rows {
+		result[i] = t.BuilderPerformance{
+			PublicKey:         t.PubKey(hexutil.Encode(row.BuilderPubkey)),
+			Relays:            row.Relays,
+			IsCensoring:       row.IsCensoring,
+			SlotsBid:          row.SlotsBid,
+			SlotsWon:          row.SlotsWon,
+			WinRate:           relayWinRate(row.SlotsWon, row.SlotsBid),
+			MedianBestBid:     row.MedianBestBid,
+			MedianBidLeadTime: row.MedianBidLeadTime,
+		}
+	}
+	return result, nil
+}
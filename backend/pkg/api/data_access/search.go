@@ -2,10 +2,14 @@ package dataaccess
 
 import (
 	"context"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	t "github.com/gobitfly/beaconchain/pkg/api/types"
 	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
 )
 
 type SearchRepository interface {
@@ -14,6 +18,7 @@ type SearchRepository interface {
 	GetSearchValidatorsByDepositAddress(ctx context.Context, chainId uint64, address []byte) (*t.SearchValidatorsByDepositAddress, error)
 	GetSearchValidatorsByDepositEnsName(ctx context.Context, chainId uint64, ensName string) (*t.SearchValidatorsByDepositAddress, error)
 	GetSearchValidatorsByWithdrawalCredential(ctx context.Context, chainId uint64, credential []byte) (*t.SearchValidatorsByWithdrwalCredential, error)
+	GetSearchValidatorsByWithdrawalAddress(ctx context.Context, chainId uint64, address []byte) (*t.SearchValidatorsByWithdrwalCredential, error)
 	GetSearchValidatorsByWithdrawalEnsName(ctx context.Context, chainId uint64, ensName string) (*t.SearchValidatorsByWithdrwalCredential, error)
 	GetSearchValidatorsByGraffiti(ctx context.Context, chainId uint64, graffiti string) (*t.SearchValidatorsByGraffiti, error)
 }
@@ -70,9 +75,16 @@ func (d *DataAccessService) GetSearchValidatorsByDepositAddress(ctx context.Cont
 }
 
 func (d *DataAccessService) GetSearchValidatorsByDepositEnsName(ctx context.Context, chainId uint64, ensName string) (*t.SearchValidatorsByDepositAddress, error) {
-	// TODO: implement handling of chainid
-	// TODO: finalize ens implementation first
-	return nil, ErrNotFound
+	address, err := d.resolveSearchEnsName(ctx, chainId, ensName)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := d.GetSearchValidatorsByDepositAddress(ctx, chainId, address)
+	if err != nil {
+		return nil, err
+	}
+	ret.EnsName = ensName
+	return ret, nil
 }
 
 func (d *DataAccessService) GetSearchValidatorsByWithdrawalCredential(ctx context.Context, chainId uint64, credential []byte) (*t.SearchValidatorsByWithdrwalCredential, error) {
@@ -90,10 +102,65 @@ func (d *DataAccessService) GetSearchValidatorsByWithdrawalCredential(ctx contex
 	return ret, nil
 }
 
-func (d *DataAccessService) GetSearchValidatorsByWithdrawalEnsName(ctx context.Context, chainId uint64, ensName string) (*t.SearchValidatorsByWithdrwalCredential, error) {
+// GetSearchValidatorsByWithdrawalAddress counts the validators withdrawing to the address with execution (0x01) or compounding (0x02) credentials.
+// The returned credential is the one used by most of them.
+func (d *DataAccessService) GetSearchValidatorsByWithdrawalAddress(ctx context.Context, chainId uint64, address []byte) (*t.SearchValidatorsByWithdrwalCredential, error) {
 	// TODO: implement handling of chainid
-	// TODO: finalize ens implementation first
-	return nil, ErrNotFound
+	credentials := make([][]byte, 0, 2)
+	for _, credentialsType := range []uint8{utils.ExecutionWithdrawalCredentialsType, utils.CompoundingWithdrawalCredentialsType} {
+		credential := make([]byte, 12, 32)
+		credential[0] = credentialsType
+		credentials = append(credentials, append(credential, address...))
+	}
+
+	counts := []struct {
+		Credential []byte `db:"withdrawalcredentials"`
+		Count      uint64 `db:"count"`
+	}{}
+	err := db.ReaderDb.SelectContext(ctx, &counts, `
+		select withdrawalcredentials, count(validatorindex) as count from validators where withdrawalcredentials = any($1) group by withdrawalcredentials order by count desc, withdrawalcredentials;`, pq.ByteaArray(credentials))
+	if err != nil {
+		return nil, err
+	}
+	if len(counts) == 0 {
+		return nil, ErrNotFound
+	}
+	ret := &t.SearchValidatorsByWithdrwalCredential{
+		WithdrawalCredential: hexutil.Encode(counts[0].Credential),
+	}
+	for _, c := range counts {
+		ret.Count += c.Count
+	}
+	return ret, nil
+}
+
+func (d *DataAccessService) GetSearchValidatorsByWithdrawalEnsName(ctx context.Context, chainId uint64, ensName string) (*t.SearchValidatorsByWithdrwalCredential, error) {
+	address, err := d.resolveSearchEnsName(ctx, chainId, ensName)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := d.GetSearchValidatorsByWithdrawalAddress(ctx, chainId, address)
+	if err != nil {
+		return nil, err
+	}
+	ret.EnsName = ensName
+	return ret, nil
+}
+
+// resolves a searched ens name to its address via the ens resolver
+func (d *DataAccessService) resolveSearchEnsName(ctx context.Context, chainId uint64, ensName string) ([]byte, error) {
+	if err := d.checkExecutionLayerNetwork(chainId); err != nil {
+		return nil, err
+	}
+	ensName = strings.ToLower(ensName)
+	resolved, err := d.resolveEnsNames(ctx, chainId, []string{ensName})
+	if err != nil {
+		return nil, err
+	}
+	if resolved[ensName].Address == "" {
+		return nil, ErrNotFound
+	}
+	return common.FromHex(resolved[ensName].Address), nil
 }
 
 func (d *DataAccessService) GetSearchValidatorsByGraffiti(ctx context.Context, chainId uint64, graffiti string) (*t.SearchValidatorsByGraffiti, error) {
@@ -0,0 +1,301 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/cache"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+type SyncCommitteeRepository interface {
+	GetSyncCommittee(ctx context.Context, chainId, period uint64) (*t.SyncCommitteeData, error)
+	GetSyncCommitteeSlots(ctx context.Context, chainId, period uint64) ([]t.SyncCommitteeSlot, error)
+}
+
+type syncCommitteeSeat struct {
+	Validator      uint64 `db:"validatorindex"`
+	CommitteeIndex uint64 `db:"committeeindex"`
+}
+
+type syncAggregateSlot struct {
+	Slot   uint64 `db:"slot"`
+	Status uint8  `db:"status"`
+	Bits   []byte `db:"syncaggregate_bits"`
+}
+
+// signed reports whether the member at the committee index is part of the sync aggregate
+func (s *syncAggregateSlot) signed(committeeIndex uint64) bool {
+	return committeeIndex/8 < uint64(len(s.Bits)) && s.Bits[committeeIndex/8]&(1<<(committeeIndex%8)) != 0
+}
+
+// the sync committee data is only available for the configured network
+func (d *DataAccessService) checkConsensusLayerNetwork(chainId uint64) error {
+	if chainId != utils.Config.Chain.ClConfig.DepositChainID {
+		return fmt.Errorf("%w: no consensus layer data available for network %d", ErrNotFound, chainId)
+	}
+	return nil
+}
+
+func currentSyncPeriod() uint64 {
+	return utils.SyncPeriodOfEpoch(cache.LatestSlot.Get() / utils.Config.Chain.ClConfig.SlotsPerEpoch)
+}
+
+func syncPeriodStatus(period, current uint64) string {
+	switch {
+	case period < current:
+		return "past"
+	case period == current:
+		return "current"
+	default:
+		return "next"
+	}
+}
+
+func (d *DataAccessService) getSyncCommitteeSeats(ctx context.Context, period uint64) ([]syncCommitteeSeat, error) {
+	var seats []syncCommitteeSeat
+	err := d.readerDb.SelectContext(ctx, &seats, `
+		SELECT validatorindex, committeeindex
+		FROM sync_committees
+		WHERE period = $1
+		ORDER BY committeeindex`, period)
+	return seats, err
+}
+
+// getSyncAggregateSlots returns one row per slot of the period, preferring the canonical block of a slot
+func (d *DataAccessService) getSyncAggregateSlots(ctx context.Context, period uint64) ([]syncAggregateSlot, error) {
+	firstSlot := utils.FirstEpochOfSyncPeriod(period) * utils.Config.Chain.ClConfig.SlotsPerEpoch
+	lastSlot := firstSlot + utils.SlotsPerSyncCommittee() - 1
+	var slots []syncAggregateSlot
+	err := d.readerDb.SelectContext(ctx, &slots, `
+		SELECT DISTINCT ON (slot) slot, status::SMALLINT AS status, COALESCE(syncaggregate_bits, '\x'::BYTEA) AS syncaggregate_bits
+		FROM blocks
+		WHERE slot >= $1 AND slot <= $2
+		ORDER BY slot, status = '1' DESC`, firstSlot, lastSlot)
+	return slots, err
+}
+
+func (d *DataAccessService) GetSyncCommittee(ctx context.Context, chainId, period uint64) (*t.SyncCommitteeData, error) {
+	if err := d.checkConsensusLayerNetwork(chainId); err != nil {
+		return nil, err
+	}
+	seats, err := d.getSyncCommitteeSeats(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+	if len(seats) == 0 {
+		return nil, fmt.Errorf("%w: sync committee of period %d", ErrNotFound, period)
+	}
+	startEpoch := max(utils.FirstEpochOfSyncPeriod(period), utils.Config.Chain.ClConfig.AltairForkEpoch)
+	result := &t.SyncCommitteeData{
+		Period:     period,
+		StartEpoch: startEpoch,
+		EndEpoch:   utils.FirstEpochOfSyncPeriod(period+1) - 1,
+		Status:     syncPeriodStatus(period, currentSyncPeriod()),
+	}
+	if result.Status == "next" {
+		result.Members, _ = syncCommitteeParticipation(seats, nil, nil)
+		return result, nil
+	}
+
+	slots, err := d.getSyncAggregateSlots(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+	// rewards are aggregated per hour, the hours at the borders of the period may include rewards of adjacent periods
+	// for validators that are part of consecutive committees
+	validators := make([]uint64, 0, len(seats))
+	for _, seat := range seats {
+		validators = append(validators, seat.Validator)
+	}
+	var rewards []struct {
+		Validator uint64 `db:"validator_index"`
+		Reward    int64  `db:"sync_rewards"`
+	}
+	err = d.clickhouseReader.SelectContext(ctx, &rewards, `
+		SELECT validator_index, COALESCE(SUM(sync_rewards), 0) AS sync_rewards
+		FROM validator_dashboard_data_hourly
+		WHERE hour >= toStartOfHour(fromUnixTimestamp($1)) AND hour <= toStartOfHour(fromUnixTimestamp($2)) AND validator_index IN ($3)
+		GROUP BY validator_index`,
+		utils.EpochToTime(result.StartEpoch).Unix(), utils.EpochToTime(result.EndEpoch).Unix(), validators)
+	if err != nil {
+		return nil, err
+	}
+	rewardByValidator := make(map[uint64]decimal.Decimal, len(rewards))
+	for _, reward := range rewards {
+		rewardByValidator[reward.Validator] = utils.GWeiToWei(big.NewInt(reward.Reward))
+	}
+
+	result.Members, result.Participation = syncCommitteeParticipation(seats, slots, rewardByValidator)
+	return result, nil
+}
+
+// syncCommitteeParticipation counts the signed and missed slots of every seat and of the whole committee, only slots with
+// a canonical block count. The rewards are per validator, a validator with multiple seats earns the rewards of all of them,
+// so they are split evenly across its seats.
+func syncCommitteeParticipation(seats []syncCommitteeSeat, slots []syncAggregateSlot, rewardByValidator map[uint64]decimal.Decimal) ([]t.SyncCommitteeMember, float64) {
+	members := make([]t.SyncCommitteeMember, len(seats))
+	var totalSigned, totalSlots uint64
+	for _, slot := range slots {
+		if slot.Status != 1 {
+			continue
+		}
+		for i, seat := range seats {
+			if slot.signed(seat.CommitteeIndex) {
+				members[i].Signed++
+				totalSigned++
+			} else {
+				members[i].Missed++
+			}
+			totalSlots++
+		}
+	}
+
+	seatsPerValidator := make(map[uint64]int64, len(seats))
+	for _, seat := range seats {
+		seatsPerValidator[seat.Validator]++
+	}
+	for i, seat := range seats {
+		member := &members[i]
+		member.CommitteeIndex = seat.CommitteeIndex
+		member.Validator = seat.Validator
+		if member.Signed+member.Missed > 0 {
+			member.Participation = float64(member.Signed) / float64(member.Signed+member.Missed)
+		}
+		member.Reward = rewardByValidator[seat.Validator].Div(decimal.NewFromInt(seatsPerValidator[seat.Validator]))
+	}
+
+	var participation float64
+	if totalSlots > 0 {
+		participation = float64(totalSigned) / float64(totalSlots)
+	}
+	return members, participation
+}
+
+func (d *DataAccessService) GetSyncCommitteeSlots(ctx context.Context, chainId, period uint64) ([]t.SyncCommitteeSlot, error) {
+	if err := d.checkConsensusLayerNetwork(chainId); err != nil {
+		return nil, err
+	}
+	seats, err := d.getSyncCommitteeSeats(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+	if len(seats) == 0 {
+		return nil, fmt.Errorf("%w: sync committee of period %d", ErrNotFound, period)
+	}
+	slots, err := d.getSyncAggregateSlots(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]t.SyncCommitteeSlot, 0, len(slots))
+	for _, slot := range slots {
+		row := t.SyncCommitteeSlot{Slot: slot.Slot}
+		switch slot.Status {
+		case 0:
+			row.Status = "scheduled"
+		case 1:
+			row.Status = "success"
+		case 2:
+			row.Status = "missed"
+		case 3:
+			row.Status = "orphaned"
+		}
+		if slot.Status == 1 {
+			row.MissedMembers = []uint64{}
+			for _, seat := range seats {
+				if !slot.signed(seat.CommitteeIndex) {
+					row.MissedMembers = append(row.MissedMembers, seat.CommitteeIndex)
+				}
+			}
+			participation := 1 - float64(len(row.MissedMembers))/float64(len(seats))
+			row.Participation = &participation
+		}
+		result = append(result, row)
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetValidatorDashboardSyncCommittees(ctx context.Context, dashboardId t.VDBId) ([]t.VDBSyncCommitteesTableRow, error) {
+	validatorGroups, err := d.getDashboardValidatorGroups(ctx, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+	result := []t.VDBSyncCommitteesTableRow{}
+	if len(validatorGroups) == 0 {
+		return result, nil
+	}
+	validators := make([]uint64, 0, len(validatorGroups))
+	for validator := range validatorGroups {
+		validators = append(validators, validator)
+	}
+
+	currentPeriod := currentSyncPeriod()
+	var seats []struct {
+		Period         uint64 `db:"period"`
+		Validator      uint64 `db:"validatorindex"`
+		CommitteeIndex uint64 `db:"committeeindex"`
+	}
+	err = d.readerDb.SelectContext(ctx, &seats, `
+		SELECT period, validatorindex, committeeindex
+		FROM sync_committees
+		WHERE period IN ($1, $2) AND validatorindex = ANY($3)
+		ORDER BY period, validatorindex, committeeindex`, currentPeriod, currentPeriod+1, pq.Array(validators))
+	if err != nil {
+		return nil, err
+	}
+	if len(seats) == 0 {
+		return result, nil
+	}
+
+	var currentSlots []syncAggregateSlot
+	for _, seat := range seats {
+		if seat.Period == currentPeriod {
+			currentSlots, err = d.getSyncAggregateSlots(ctx, currentPeriod)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	for _, seat := range seats {
+		if n := len(result); n > 0 && result[n-1].Period == seat.Period && result[n-1].Validator == seat.Validator {
+			result[n-1].CommitteeIndices = append(result[n-1].CommitteeIndices, seat.CommitteeIndex)
+		} else {
+			status := syncPeriodStatus(seat.Period, currentPeriod)
+			result = append(result, t.VDBSyncCommitteesTableRow{
+				Validator:        seat.Validator,
+				GroupId:          validatorGroups[seat.Validator],
+				Period:           seat.Period,
+				StartEpoch:       max(utils.FirstEpochOfSyncPeriod(seat.Period), utils.Config.Chain.ClConfig.AltairForkEpoch),
+				EndEpoch:         utils.FirstEpochOfSyncPeriod(seat.Period+1) - 1,
+				Status:           status,
+				CommitteeIndices: []uint64{seat.CommitteeIndex},
+			})
+		}
+		if seat.Period != currentPeriod {
+			continue
+		}
+		row := &result[len(result)-1]
+		for _, slot := range currentSlots {
+			if slot.Status != 1 {
+				continue
+			}
+			if slot.signed(seat.CommitteeIndex) {
+				row.Signed++
+			} else {
+				row.Missed++
+			}
+		}
+		if row.Signed+row.Missed > 0 {
+			participation := float64(row.Signed) / float64(row.Signed+row.Missed)
+			row.Participation = &participation
+		}
+	}
+	return result, nil
+}
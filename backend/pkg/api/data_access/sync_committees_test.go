@@ -0,0 +1,107 @@
+package dataaccess
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestSyncAggregateSlotSigned(test *testing.T) {
+	// bit i of the aggregate is bit i%8 of byte i/8, starting at the least significant bit
+	slot := syncAggregateSlot{Bits: []byte{0b00000101, 0b10000000}}
+	tests := []struct {
+		committeeIndex uint64
+		expected       bool
+	}{
+		{0, true},
+		{1, false},
+		{2, true},
+		{7, false},
+		{8, false},
+		{15, true},
+		{16, false}, // beyond the bits of the aggregate
+	}
+	for _, tt := range tests {
+		if signed := slot.signed(tt.committeeIndex); signed != tt.expected {
+			test.Errorf("committee index %d: expected signed %v, got %v", tt.committeeIndex, tt.expected, signed)
+		}
+	}
+	if (&syncAggregateSlot{}).signed(0) {
+		test.Errorf("expected a slot without bits to not be signed")
+	}
+}
+
+func TestSyncCommitteeParticipation(test *testing.T) {
+	// validator 20 holds two seats
+	seats := []syncCommitteeSeat{
+		{Validator: 10, CommitteeIndex: 0},
+		{Validator: 20, CommitteeIndex: 1},
+		{Validator: 20, CommitteeIndex: 2},
+		{Validator: 30, CommitteeIndex: 3},
+	}
+	slots := []syncAggregateSlot{
+		{Slot: 1, Status: 1, Bits: []byte{0b1111}},
+		{Slot: 2, Status: 1, Bits: []byte{0b0101}},
+		// missed and orphaned blocks don't count against the committee
+		{Slot: 3, Status: 2, Bits: []byte{}},
+		{Slot: 4, Status: 3, Bits: []byte{0b0000}},
+		{Slot: 5, Status: 1, Bits: []byte{0b0001}},
+	}
+	rewards := map[uint64]decimal.Decimal{
+		10: decimal.NewFromInt(300),
+		20: decimal.NewFromInt(500),
+	}
+
+	members, participation := syncCommitteeParticipation(seats, slots, rewards)
+	expected := []struct {
+		signed, missed uint64
+		participation  float64
+		reward         int64
+	}{
+		{3, 0, 1, 300},
+		{1, 2, 1.0 / 3, 250},
+		{2, 1, 2.0 / 3, 250},
+		{1, 2, 1.0 / 3, 0},
+	}
+	if len(members) != len(expected) {
+		test.Fatalf("expected %d members, got %d", len(expected), len(members))
+	}
+	for i, e := range expected {
+		m := members[i]
+		if m.CommitteeIndex != seats[i].CommitteeIndex || m.Validator != seats[i].Validator {
+			test.Errorf("member %d: unexpected seat %d of validator %d", i, m.CommitteeIndex, m.Validator)
+		}
+		if m.Signed != e.signed || m.Missed != e.missed || m.Participation != e.participation {
+			test.Errorf("member %d: expected %d signed, %d missed, participation %v, got %d, %d, %v", i, e.signed, e.missed, e.participation, m.Signed, m.Missed, m.Participation)
+		}
+		if !m.Reward.Equal(decimal.NewFromInt(e.reward)) {
+			test.Errorf("member %d: expected reward %d, got %v", i, e.reward, m.Reward)
+		}
+	}
+	if participation != 7.0/12 {
+		test.Errorf("expected total participation %v, got %v", 7.0/12, participation)
+	}
+
+	members, participation = syncCommitteeParticipation(seats, nil, nil)
+	if participation != 0 || len(members) != len(seats) || members[1].Validator != 20 || members[1].Signed+members[1].Missed != 0 || !members[1].Reward.IsZero() {
+		test.Errorf("expected empty members for a committee without slots, got %+v with participation %v", members, participation)
+	}
+}
+
+func TestSyncPeriodStatus(test *testing.T) {
+	tests := []struct {
+		period, current uint64
+		expected        string
+	}{
+		{0, 5, "past"},
+		{4, 5, "past"},
+		{5, 5, "current"},
+		{6, 5, "next"},
+		{0, 0, "current"},
+	}
+	for _, tt := range tests {
+		if status := syncPeriodStatus(tt.period, tt.current); status != tt.expected {
+			test.Errorf("period %d with current period %d: expected %v, got %v", tt.period, tt.current, tt.expected, status)
+		}
+	}
+}
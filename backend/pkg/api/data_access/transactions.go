@@ -0,0 +1,384 @@
+package dataaccess
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/shopspring/decimal"
+)
+
+// max number of blocks scanned for a single page of the network transactions table, avoids endless scans over empty blocks
+const maxTransactionsBlockScan = 1000
+
+type TransactionRepository interface {
+	GetTransactions(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.BlockTransactionTableRow, *t.Paging, error)
+	GetTransaction(ctx context.Context, chainId uint64, hash []byte) (*t.TransactionDetails, error)
+}
+
+func (d *DataAccessService) GetTransactions(ctx context.Context, chainId uint64, cursor string, limit uint64) ([]t.BlockTransactionTableRow, *t.Paging, error) {
+	if err := d.checkExecutionLayerNetwork(chainId); err != nil {
+		return nil, nil, err
+	}
+
+	var currentCursor t.TransactionsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.TransactionsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as TransactionsCursor: %w", err)
+		}
+	}
+
+	latestBlock, err := d.bigtable.GetLastBlockInBlocksTable()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, cursorData, moreDataFlag, err := scanTransactionsPage(currentCursor, uint64(latestBlock), limit, func(block uint64) ([]t.BlockTransactionTableRow, error) {
+		blk, err := d.bigtable.GetBlockFromBlocksTable(block)
+		if errors.Is(err, db.ErrBlockNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return d.getBlockTransactionTableRows(blk)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(result) == 0 {
+		return result, &t.Paging{}, nil
+	}
+
+	if err := d.resolveTransactionTableAddressNames(result); err != nil {
+		return nil, nil, err
+	}
+
+	p, err := utils.GetPagingFromData(cursorData, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	if p == nil {
+		p = &t.Paging{}
+	}
+	return result, p, nil
+}
+
+// scanTransactionsPage walks the blocks from the cursor position, newest first unless the cursor is reversed, and returns
+// the rows of the page in table order together with their cursors. getBlockRows returns the rows of a block ordered by
+// their position in the block, blocks that are missing have no rows.
+func scanTransactionsPage(currentCursor t.TransactionsCursor, latestBlock uint64, limit uint64, getBlockRows func(block uint64) ([]t.BlockTransactionTableRow, error)) ([]t.BlockTransactionTableRow, []t.TransactionsCursor, bool, error) {
+	result := make([]t.BlockTransactionTableRow, 0, limit+1)
+	cursorData := make([]t.TransactionsCursor, 0, limit+1)
+	block := latestBlock
+	if currentCursor.IsValid() {
+		block = currentCursor.Block
+	}
+	for scanned := 0; uint64(len(result)) <= limit && scanned < maxTransactionsBlockScan && block <= latestBlock; scanned++ {
+		rows, err := getBlockRows(block)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		for i := range rows {
+			txIndex := uint64(i)
+			if !currentCursor.IsReverse() {
+				txIndex = uint64(len(rows) - 1 - i)
+			}
+			if currentCursor.IsValid() && block == currentCursor.Block &&
+				((!currentCursor.IsReverse() && txIndex >= currentCursor.TxIndex) || (currentCursor.IsReverse() && txIndex <= currentCursor.TxIndex)) {
+				continue
+			}
+			result = append(result, rows[txIndex])
+			cursorData = append(cursorData, t.TransactionsCursor{Block: block, TxIndex: txIndex})
+		}
+
+		if currentCursor.IsReverse() {
+			block++
+		} else {
+			if block == 0 {
+				break
+			}
+			block--
+		}
+	}
+
+	moreDataFlag := uint64(len(result)) > limit
+	if moreDataFlag {
+		result = result[:limit]
+		cursorData = cursorData[:limit]
+	}
+	if currentCursor.IsReverse() {
+		slices.Reverse(result)
+		slices.Reverse(cursorData)
+	}
+	return result, cursorData, moreDataFlag, nil
+}
+
+func (d *DataAccessService) GetTransaction(ctx context.Context, chainId uint64, hash []byte) (*t.TransactionDetails, error) {
+	if err := d.checkExecutionLayerNetwork(chainId); err != nil {
+		return nil, err
+	}
+
+	indexedTx, err := d.bigtable.GetIndexedEth1Transaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	if indexedTx == nil {
+		return nil, fmt.Errorf("%w: transaction %#x", ErrNotFound, hash)
+	}
+
+	blk, err := d.bigtable.GetBlockFromBlocksTable(indexedTx.GetBlockNumber())
+	if errors.Is(err, db.ErrBlockNotFound) {
+		return nil, fmt.Errorf("%w: block %d", ErrNotFound, indexedTx.GetBlockNumber())
+	}
+	if err != nil {
+		return nil, err
+	}
+	position := slices.IndexFunc(blk.GetTransactions(), func(tx *types.Eth1Transaction) bool {
+		return bytes.Equal(tx.GetHash(), hash)
+	})
+	if position < 0 {
+		return nil, fmt.Errorf("%w: transaction %#x in block %d", ErrNotFound, hash, blk.GetNumber())
+	}
+	tx := blk.GetTransactions()[position]
+
+	interactions, err := d.bigtable.GetAddressContractInteractionsAt([]db.ContractInteractionAtRequest{{
+		Address:  fmt.Sprintf("%x", indexedTx.GetTo()),
+		Block:    int64(blk.GetNumber()),
+		TxIdx:    int64(position),
+		TraceIdx: -1,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	interaction := interactions[0]
+	if indexedTx.GetIsContractCreation() {
+		interaction = types.CONTRACT_CREATION
+	}
+
+	result := &t.TransactionDetails{
+		Hash:     t.Hash(hexutil.Encode(tx.GetHash())),
+		Block:    blk.GetNumber(),
+		Position: uint64(position),
+		Time:     blk.GetTime().AsTime().Unix(),
+		Success:  tx.GetErrorMsg() == "",
+		Error:    tx.GetErrorMsg(),
+		Method:   d.bigtable.GetMethodLabel(tx.GetData(), interaction),
+		TxType:   tx.GetType(),
+		Nonce:    tx.GetNonce(),
+		From:     t.Address{Hash: t.Hash(hexutil.Encode(tx.GetFrom()))},
+		Value:    weiBytesToDecimal(tx.GetValue()),
+		TxFee:    weiBytesToDecimal(indexedTx.GetTxFee()).Add(weiBytesToDecimal(indexedTx.GetBlobTxFee())),
+		GasPrice: weiBytesToDecimal(tx.GetGasPrice()),
+		GasLimit: tx.GetGas(),
+		GasUsed:  tx.GetGasUsed(),
+		Input:    t.Hash(hexutil.Encode(tx.GetData())),
+		Logs:     make([]t.TransactionLog, 0, len(tx.GetLogs())),
+	}
+	if len(tx.GetTo()) > 0 {
+		result.To = &t.Address{Hash: t.Hash(hexutil.Encode(tx.GetTo())), IsContract: interaction != types.CONTRACT_NONE}
+	}
+	if indexedTx.GetIsContractCreation() {
+		result.ContractCreated = &t.Address{Hash: t.Hash(hexutil.Encode(tx.GetContractAddress())), IsContract: true}
+	}
+	if len(tx.GetMaxFeePerGas()) > 0 {
+		maxFee := weiBytesToDecimal(tx.GetMaxFeePerGas())
+		maxPriorityFee := weiBytesToDecimal(tx.GetMaxPriorityFeePerGas())
+		result.MaxFeePerGas = &maxFee
+		result.MaxPriorityFeePerGas = &maxPriorityFee
+	}
+	if tx.GetBlobGasUsed() > 0 {
+		blobGasPrice := weiBytesToDecimal(tx.GetBlobGasPrice())
+		result.BlobGasUsed = tx.GetBlobGasUsed()
+		result.BlobGasPrice = &blobGasPrice
+		for _, versionedHash := range tx.GetBlobVersionedHashes() {
+			result.BlobVersionedHashes = append(result.BlobVersionedHashes, t.Hash(hexutil.Encode(versionedHash)))
+		}
+	}
+
+	logIndex := uint64(0)
+	for _, blockTx := range blk.GetTransactions()[:position] {
+		logIndex += uint64(len(blockTx.GetLogs()))
+	}
+	for i, log := range tx.GetLogs() {
+		result.Logs = append(result.Logs, t.TransactionLog{
+			Index:   logIndex + uint64(i),
+			Address: t.Address{Hash: t.Hash(hexutil.Encode(log.GetAddress())), IsContract: true},
+			Event:   d.getEventLabel(log),
+			Topics:  hashesFromBytes(log.GetTopics()),
+			Data:    t.Hash(hexutil.Encode(log.GetData())),
+			Removed: log.GetRemoved(),
+		})
+	}
+
+	names := make(map[string]string, len(result.Logs)+3)
+	addresses := []*t.Address{&result.From, result.To, result.ContractCreated}
+	for i := range result.Logs {
+		addresses = append(addresses, &result.Logs[i].Address)
+	}
+	for _, address := range addresses {
+		if address != nil {
+			names[string(address.Hash)] = ""
+		}
+	}
+	if err := d.bigtable.GetAddressNames(names); err != nil {
+		return nil, err
+	}
+	for _, address := range addresses {
+		if address != nil {
+			applyAddressName(address, names)
+		}
+	}
+
+	return result, nil
+}
+
+// the bigtable instance only holds the execution layer data of the configured network
+func (d *DataAccessService) checkExecutionLayerNetwork(chainId uint64) error {
+	if d.bigtable == nil || chainId != utils.Config.Chain.ClConfig.DepositChainID {
+		return fmt.Errorf("%w: no execution layer data available for network %d", ErrNotFound, chainId)
+	}
+	return nil
+}
+
+// returns the table rows of all transactions in the given block, ordered by their position in the block
+func (d *DataAccessService) getBlockTransactionTableRows(blk *types.Eth1Block) ([]t.BlockTransactionTableRow, error) {
+	interactions, err := d.bigtable.GetAddressContractInteractionsAtBlock(blk)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]t.BlockTransactionTableRow, 0, len(blk.GetTransactions()))
+	for i, tx := range blk.GetTransactions() {
+		rows = append(rows, d.getTransactionTableRow(indexBlockTransaction(blk, tx), interactions[i], nil))
+	}
+	return rows, nil
+}
+
+// converts an indexed transaction to a table row; the transaction type is determined from the perspective of the given address, if any
+func (d *DataAccessService) getTransactionTableRow(tx *types.Eth1TransactionIndexed, interaction types.ContractInteractionType, address []byte) t.BlockTransactionTableRow {
+	if tx.GetIsContractCreation() {
+		interaction = types.CONTRACT_CREATION
+	}
+
+	return t.BlockTransactionTableRow{
+		Success:  tx.GetErrorMsg() == "",
+		TxHash:   t.Hash(hexutil.Encode(tx.GetHash())),
+		Method:   d.bigtable.GetMethodLabel(tx.GetMethodId(), interaction),
+		Block:    tx.GetBlockNumber(),
+		Age:      uint64(tx.GetTime().AsTime().Unix()),
+		From:     t.Address{Hash: t.Hash(hexutil.Encode(tx.GetFrom()))},
+		Type:     transactionType(tx, address),
+		To:       t.Address{Hash: t.Hash(hexutil.Encode(tx.GetTo())), IsContract: interaction != types.CONTRACT_NONE},
+		Value:    weiBytesToDecimal(tx.GetValue()),
+		GasPrice: weiBytesToDecimal(tx.GetGasPrice()),
+		TxFee:    weiBytesToDecimal(tx.GetTxFee()).Add(weiBytesToDecimal(tx.GetBlobTxFee())),
+	}
+}
+
+// transactionType returns the direction of a transaction from the perspective of the given address, transactions are
+// outgoing if there is no address
+func transactionType(tx *types.Eth1TransactionIndexed, address []byte) string {
+	switch {
+	case tx.GetIsContractCreation():
+		return "contract"
+	case address == nil:
+		return "out"
+	case bytes.Equal(tx.GetFrom(), address) && bytes.Equal(tx.GetTo(), address):
+		return "self"
+	case bytes.Equal(tx.GetTo(), address):
+		return "in"
+	}
+	return "out"
+}
+
+func (d *DataAccessService) resolveTransactionTableAddressNames(rows []t.BlockTransactionTableRow) error {
+	names := make(map[string]string, len(rows)*2)
+	for _, row := range rows {
+		names[string(row.From.Hash)] = ""
+		names[string(row.To.Hash)] = ""
+	}
+	if err := d.bigtable.GetAddressNames(names); err != nil {
+		return err
+	}
+	for i := range rows {
+		applyAddressName(&rows[i].From, names)
+		applyAddressName(&rows[i].To, names)
+	}
+	return nil
+}
+
+func (d *DataAccessService) getEventLabel(log *types.Eth1Log) string {
+	if len(log.GetTopics()) == 0 {
+		return ""
+	}
+	return d.bigtable.GetEventLabel(log.GetTopics()[0])
+}
+
+// GetAddressNames prefers the primary ens name of an address over its label
+func applyAddressName(address *t.Address, names map[string]string) {
+	name := names[string(address.Hash)]
+	if name == "" {
+		return
+	}
+	if strings.HasSuffix(name, ".eth") {
+		address.Ens = name
+	} else {
+		address.Label = name
+	}
+}
+
+// mirrors the conversion done by the bigtable tx indexer
+func indexBlockTransaction(blk *types.Eth1Block, tx *types.Eth1Transaction) *types.Eth1TransactionIndexed {
+	to := tx.GetTo()
+	isContractCreation := false
+	if len(tx.GetContractAddress()) > 0 && !bytes.Equal(tx.GetContractAddress(), db.ZERO_ADDRESS) {
+		to = tx.GetContractAddress()
+		isContractCreation = true
+	}
+	method := make([]byte, 0)
+	if len(tx.GetData()) > 3 {
+		method = tx.GetData()[:4]
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetBytes(tx.GetGasPrice()), new(big.Int).SetUint64(tx.GetGasUsed()))
+	blobFee := new(big.Int).Mul(new(big.Int).SetBytes(tx.GetBlobGasPrice()), new(big.Int).SetUint64(tx.GetBlobGasUsed()))
+
+	return &types.Eth1TransactionIndexed{
+		Hash:               tx.GetHash(),
+		BlockNumber:        blk.GetNumber(),
+		Time:               blk.GetTime(),
+		MethodId:           method,
+		From:               tx.GetFrom(),
+		To:                 to,
+		Value:              tx.GetValue(),
+		TxFee:              fee.Bytes(),
+		GasPrice:           tx.GetGasPrice(),
+		BlobTxFee:          blobFee.Bytes(),
+		BlobGasPrice:       tx.GetBlobGasPrice(),
+		IsContractCreation: isContractCreation,
+		ErrorMsg:           tx.GetErrorMsg(),
+	}
+}
+
+func weiBytesToDecimal(wei []byte) decimal.Decimal {
+	return decimal.NewFromBigInt(new(big.Int).SetBytes(wei), 0)
+}
+
+func hashesFromBytes(data [][]byte) []t.Hash {
+	hashes := make([]t.Hash, 0, len(data))
+	for _, d := range data {
+		hashes = append(hashes, t.Hash(hexutil.Encode(d)))
+	}
+	return hashes
+}
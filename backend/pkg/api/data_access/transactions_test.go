@@ -0,0 +1,159 @@
+package dataaccess
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+)
+
+func TestScanTransactionsPage(test *testing.T) {
+	// block 12 has 3 transactions, block 11 is missing, block 10 has 2 and the blocks below are empty
+	txsPerBlock := map[uint64]int{12: 3, 10: 2}
+	getBlockRows := func(block uint64) ([]t.BlockTransactionTableRow, error) {
+		if block == 11 {
+			return nil, nil
+		}
+		rows := make([]t.BlockTransactionTableRow, 0, txsPerBlock[block])
+		for i := 0; i < txsPerBlock[block]; i++ {
+			rows = append(rows, t.BlockTransactionTableRow{Block: block, TxHash: t.Hash(fmt.Sprintf("%d/%d", block, i))})
+		}
+		return rows, nil
+	}
+	cursor := func(block, txIndex uint64, reverse bool) t.TransactionsCursor {
+		return t.TransactionsCursor{GenericCursor: t.GenericCursor{Valid: true, Reverse: reverse}, Block: block, TxIndex: txIndex}
+	}
+	none := t.TransactionsCursor{}
+
+	tests := []struct {
+		name   string
+		cursor t.TransactionsCursor
+		rows   []t.Hash
+		next   *t.TransactionsCursor
+		prev   *t.TransactionsCursor
+	}{
+		{
+			name: "first page inside the latest block",
+			rows: []t.Hash{"12/2", "12/1"},
+			next: &t.TransactionsCursor{Block: 12, TxIndex: 1},
+		},
+		{
+			name:   "page across a missing block",
+			cursor: cursor(12, 1, false),
+			rows:   []t.Hash{"12/0", "10/1"},
+			next:   &t.TransactionsCursor{Block: 10, TxIndex: 1},
+			prev:   &t.TransactionsCursor{Block: 12, TxIndex: 0},
+		},
+		{
+			name:   "last page",
+			cursor: cursor(10, 1, false),
+			rows:   []t.Hash{"10/0"},
+			prev:   &t.TransactionsCursor{Block: 10, TxIndex: 0},
+		},
+		{
+			name:   "empty last page",
+			cursor: cursor(10, 0, false),
+			rows:   []t.Hash{},
+		},
+		{
+			name:   "previous page",
+			cursor: cursor(10, 1, true),
+			rows:   []t.Hash{"12/1", "12/0"},
+			next:   &t.TransactionsCursor{Block: 12, TxIndex: 0},
+			prev:   &t.TransactionsCursor{Block: 12, TxIndex: 1},
+		},
+		{
+			name:   "previous page reaching the latest block",
+			cursor: cursor(12, 1, true),
+			rows:   []t.Hash{"12/2"},
+			next:   &t.TransactionsCursor{Block: 12, TxIndex: 2},
+		},
+	}
+	for _, tt := range tests {
+		test.Run(tt.name, func(test *testing.T) {
+			rows, cursorData, moreData, err := scanTransactionsPage(tt.cursor, 12, 2, getBlockRows)
+			if err != nil {
+				test.Fatalf("unexpected error: %v", err)
+			}
+			hashes := make([]t.Hash, 0, len(rows))
+			for _, row := range rows {
+				hashes = append(hashes, row.TxHash)
+			}
+			if !slices.Equal(hashes, tt.rows) {
+				test.Fatalf("expected rows %v, got %v", tt.rows, hashes)
+			}
+			if len(rows) == 0 {
+				return
+			}
+
+			paging, err := utils.GetPagingFromData(cursorData, tt.cursor, moreData)
+			if err != nil {
+				test.Fatalf("unexpected error: %v", err)
+			}
+			if paging == nil {
+				paging = &t.Paging{}
+			}
+			checkCursor := func(kind, encoded string, expected *t.TransactionsCursor, reverse bool) {
+				if expected == nil {
+					if encoded != "" {
+						test.Errorf("expected no %s cursor, got %s", kind, encoded)
+					}
+					return
+				}
+				c, err := utils.StringToCursor[t.TransactionsCursor](encoded)
+				if err != nil {
+					test.Fatalf("error decoding %s cursor %q: %v", kind, encoded, err)
+				}
+				if c.Block != expected.Block || c.TxIndex != expected.TxIndex || c.IsReverse() != reverse {
+					test.Errorf("expected %s cursor at %d/%d, got %+v", kind, expected.Block, expected.TxIndex, c)
+				}
+			}
+			checkCursor("next", paging.NextCursor, tt.next, false)
+			checkCursor("prev", paging.PrevCursor, tt.prev, true)
+		})
+	}
+
+	// the scan gives up after maxTransactionsBlockScan empty blocks
+	scanned := 0
+	rows, _, moreData, err := scanTransactionsPage(none, 5000, 2, func(block uint64) ([]t.BlockTransactionTableRow, error) {
+		scanned++
+		return nil, nil
+	})
+	if err != nil || len(rows) != 0 || moreData || scanned != maxTransactionsBlockScan {
+		test.Errorf("expected an empty page after scanning %d blocks, got %d rows after %d blocks (%v)", maxTransactionsBlockScan, len(rows), scanned, err)
+	}
+
+	if _, _, _, err := scanTransactionsPage(none, 12, 2, func(block uint64) ([]t.BlockTransactionTableRow, error) {
+		return nil, errors.New("bigtable unavailable")
+	}); err == nil {
+		test.Errorf("expected the error of the block lookup to be returned")
+	}
+}
+
+func TestTransactionType(test *testing.T) {
+	address := []byte{0x01}
+	other := []byte{0x02}
+	tests := []struct {
+		name     string
+		tx       *types.Eth1TransactionIndexed
+		address  []byte
+		expected string
+	}{
+		{"incoming", &types.Eth1TransactionIndexed{From: other, To: address}, address, "in"},
+		{"outgoing", &types.Eth1TransactionIndexed{From: address, To: other}, address, "out"},
+		{"self transfer", &types.Eth1TransactionIndexed{From: address, To: address}, address, "self"},
+		{"contract creation", &types.Eth1TransactionIndexed{From: address, To: other, IsContractCreation: true}, address, "contract"},
+		{"network table", &types.Eth1TransactionIndexed{From: address, To: address}, nil, "out"},
+	}
+	for _, tt := range tests {
+		test.Run(tt.name, func(test *testing.T) {
+			if txType := transactionType(tt.tx, tt.address); txType != tt.expected {
+				test.Errorf("expected %s, got %s", tt.expected, txType)
+			}
+		})
+	}
+}
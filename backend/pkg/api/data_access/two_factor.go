@@ -0,0 +1,302 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/twofactor"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+type TwoFactorRepository interface {
+	GetUserTwoFactorStatus(ctx context.Context, userId uint64) (*t.UserTwoFactorStatus, error)
+	HasUserTwoFactor(ctx context.Context, userId uint64) (bool, error)
+
+	GetUserTotp(ctx context.Context, userId uint64) (*t.UserTotp, error)
+	SetUserTotpSecret(ctx context.Context, userId uint64, secret string) error
+	ConfirmUserTotp(ctx context.Context, userId uint64, step int64) error
+	UpdateUserTotpLastUsedStep(ctx context.Context, userId uint64, step int64) error
+	RemoveUserTotp(ctx context.Context, userId uint64) error
+
+	GetUserWebAuthnCredentials(ctx context.Context, userId uint64) ([]t.WebAuthnCredential, error)
+	AddUserWebAuthnCredential(ctx context.Context, userId uint64, name string, credential t.WebAuthnCredential) error
+	UpdateUserWebAuthnSignCount(ctx context.Context, userId uint64, id uint64, signCount uint32) error
+	RemoveUserWebAuthnCredential(ctx context.Context, userId uint64, id uint64) error
+
+	ReplaceUserRecoveryCodes(ctx context.Context, userId uint64, codeHashes []string) error
+	UseUserRecoveryCode(ctx context.Context, userId uint64, codeHash string) (bool, error)
+
+	ReserveUserTwoFactorAttempt(ctx context.Context, userId uint64) (time.Time, error)
+	ResetUserTwoFactorFailures(ctx context.Context, userId uint64) error
+}
+
+func (d *DataAccessService) GetUserTwoFactorStatus(ctx context.Context, userId uint64) (*t.UserTwoFactorStatus, error) {
+	result := &t.UserTwoFactorStatus{
+		WebAuthnCredentials: []t.WebAuthnCredentialInfo{},
+	}
+	err := d.userReader.GetContext(ctx, &result.TotpEnabled, `
+		SELECT EXISTS(SELECT 1 FROM users_totp WHERE user_id = $1 AND confirmed = true)`, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var credentials []struct {
+		Id         uint64       `db:"id"`
+		Name       string       `db:"name"`
+		CreatedTs  time.Time    `db:"created_ts"`
+		LastUsedTs sql.NullTime `db:"last_used_ts"`
+	}
+	err = d.userReader.SelectContext(ctx, &credentials, `
+		SELECT id, name, created_ts, last_used_ts
+		FROM users_webauthn_credentials
+		WHERE user_id = $1
+		ORDER BY id`, userId)
+	if err != nil {
+		return nil, err
+	}
+	for _, credential := range credentials {
+		info := t.WebAuthnCredentialInfo{
+			Id:        credential.Id,
+			Name:      credential.Name,
+			CreatedAt: credential.CreatedTs.Unix(),
+		}
+		if credential.LastUsedTs.Valid {
+			info.LastUsedAt = credential.LastUsedTs.Time.Unix()
+		}
+		result.WebAuthnCredentials = append(result.WebAuthnCredentials, info)
+	}
+
+	err = d.userReader.GetContext(ctx, &result.RecoveryCodesLeft, `SELECT COUNT(*) FROM users_recovery_codes WHERE user_id = $1`, userId)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HasUserTwoFactor returns whether the user has a confirmed totp secret or a security key registered
+func (d *DataAccessService) HasUserTwoFactor(ctx context.Context, userId uint64) (bool, error) {
+	// read from the writer, the result decides whether freshly enrolled factors are enforced
+	return hasUserTwoFactor(ctx, d.userWriter, userId)
+}
+
+func hasUserTwoFactor(ctx context.Context, db sqlx.QueryerContext, userId uint64) (bool, error) {
+	var result bool
+	err := sqlx.GetContext(ctx, db, &result, `
+		SELECT
+			EXISTS(SELECT 1 FROM users_totp WHERE user_id = $1 AND confirmed = true) OR
+			EXISTS(SELECT 1 FROM users_webauthn_credentials WHERE user_id = $1)`, userId)
+	return result, err
+}
+
+func (d *DataAccessService) GetUserTotp(ctx context.Context, userId uint64) (*t.UserTotp, error) {
+	var result t.UserTotp
+	err := d.userWriter.GetContext(ctx, &result, `
+		SELECT secret, confirmed, last_used_step
+		FROM users_totp
+		WHERE user_id = $1`, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no totp secret found for user %d", ErrNotFound, userId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetUserTotpSecret stores a new unconfirmed secret, replacing a previous unconfirmed one. A confirmed secret is never replaced.
+func (d *DataAccessService) SetUserTotpSecret(ctx context.Context, userId uint64, secret string) error {
+	_, err := d.userWriter.ExecContext(ctx, `
+		INSERT INTO users_totp (user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET
+			secret = EXCLUDED.secret,
+			last_used_step = 0,
+			created_ts = NOW()
+		WHERE users_totp.confirmed = false`, userId, secret)
+	return err
+}
+
+func (d *DataAccessService) ConfirmUserTotp(ctx context.Context, userId uint64, step int64) error {
+	_, err := d.userWriter.ExecContext(ctx, `
+		UPDATE users_totp
+		SET confirmed = true, last_used_step = $2
+		WHERE user_id = $1`, userId, step)
+	return err
+}
+
+// UpdateUserTotpLastUsedStep stores the step of a used code. Returns ErrNotFound if a code of the same or a later step
+// has been used concurrently, so the code must be rejected.
+func (d *DataAccessService) UpdateUserTotpLastUsedStep(ctx context.Context, userId uint64, step int64) error {
+	result, err := d.userWriter.ExecContext(ctx, `
+		UPDATE users_totp
+		SET last_used_step = $2
+		WHERE user_id = $1 AND last_used_step < $2`, userId, step)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: totp code already used", ErrNotFound)
+	}
+	return nil
+}
+
+// RemoveUserTotp removes the totp secret, recovery codes are removed as well if no other factor is left
+func (d *DataAccessService) RemoveUserTotp(ctx context.Context, userId uint64) error {
+	return d.removeUserTwoFactor(ctx, userId, `DELETE FROM users_totp WHERE user_id = $1`, userId)
+}
+
+func (d *DataAccessService) GetUserWebAuthnCredentials(ctx context.Context, userId uint64) ([]t.WebAuthnCredential, error) {
+	result := []t.WebAuthnCredential{}
+	err := d.userWriter.SelectContext(ctx, &result, `
+		SELECT id, credential_id, public_key, sign_count
+		FROM users_webauthn_credentials
+		WHERE user_id = $1
+		ORDER BY id`, userId)
+	return result, err
+}
+
+func (d *DataAccessService) AddUserWebAuthnCredential(ctx context.Context, userId uint64, name string, credential t.WebAuthnCredential) error {
+	_, err := d.userWriter.ExecContext(ctx, `
+		INSERT INTO users_webauthn_credentials (user_id, credential_id, public_key, sign_count, name)
+		VALUES ($1, $2, $3, $4, $5)`,
+		userId, credential.CredentialId, credential.PublicKey, credential.SignCount, name)
+	return err
+}
+
+func (d *DataAccessService) UpdateUserWebAuthnSignCount(ctx context.Context, userId uint64, id uint64, signCount uint32) error {
+	_, err := d.userWriter.ExecContext(ctx, `
+		UPDATE users_webauthn_credentials
+		SET sign_count = $3, last_used_ts = NOW()
+		WHERE user_id = $1 AND id = $2`, userId, id, signCount)
+	return err
+}
+
+// RemoveUserWebAuthnCredential removes a security key, recovery codes are removed as well if no other factor is left
+func (d *DataAccessService) RemoveUserWebAuthnCredential(ctx context.Context, userId uint64, id uint64) error {
+	return d.removeUserTwoFactor(ctx, userId, `DELETE FROM users_webauthn_credentials WHERE user_id = $1 AND id = $2`, userId, id)
+}
+
+func (d *DataAccessService) removeUserTwoFactor(ctx context.Context, userId uint64, query string, args ...interface{}) error {
+	tx, err := d.userWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting db transaction to remove second factor: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: second factor not found", ErrNotFound)
+	}
+
+	hasTwoFactor, err := hasUserTwoFactor(ctx, tx, userId)
+	if err != nil {
+		return err
+	}
+	if !hasTwoFactor {
+		_, err = tx.ExecContext(ctx, `DELETE FROM users_recovery_codes WHERE user_id = $1`, userId)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ReplaceUserRecoveryCodes invalidates all existing recovery codes of the user and stores the new ones
+func (d *DataAccessService) ReplaceUserRecoveryCodes(ctx context.Context, userId uint64, codeHashes []string) error {
+	tx, err := d.userWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting db transaction to replace recovery codes: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM users_recovery_codes WHERE user_id = $1`, userId)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO users_recovery_codes (user_id, code_hash)
+		SELECT $1, unnest($2::TEXT[])`, userId, pq.StringArray(codeHashes))
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// UseUserRecoveryCode removes the recovery code and returns whether it existed, each code can only be used once
+func (d *DataAccessService) UseUserRecoveryCode(ctx context.Context, userId uint64, codeHash string) (bool, error) {
+	result, err := d.userWriter.ExecContext(ctx, `DELETE FROM users_recovery_codes WHERE user_id = $1 AND code_hash = $2`, userId, codeHash)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// ReserveUserTwoFactorAttempt counts a verification of the second factor as failed before it runs, so parallel
+// verifications can't try more codes than allowed, and locks the second factor once too many verifications failed in a
+// row. Returns until when the second factor is locked if it already was, the attempt isn't counted then and must be
+// rejected. Returns zero if the attempt may be verified.
+func (d *DataAccessService) ReserveUserTwoFactorAttempt(ctx context.Context, userId uint64) (time.Time, error) {
+	tx, err := d.userWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error starting db transaction to reserve second factor attempt: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	// the upsert locks the row until the transaction ends, parallel attempts of the user wait for the lockout below
+	var attempt struct {
+		FailedAttempts int          `db:"failed_attempts"`
+		Locked         bool         `db:"locked"`
+		LockedUntil    sql.NullTime `db:"locked_until"`
+	}
+	err = tx.GetContext(ctx, &attempt, `
+		INSERT INTO users_two_factor_attempts AS a (user_id, failed_attempts, last_failed_ts)
+		VALUES ($1, 1, NOW())
+		ON CONFLICT (user_id) DO UPDATE SET
+			failed_attempts = CASE WHEN a.locked_until > NOW() THEN a.failed_attempts ELSE a.failed_attempts + 1 END,
+			last_failed_ts = CASE WHEN a.locked_until > NOW() THEN a.last_failed_ts ELSE NOW() END
+		RETURNING failed_attempts, COALESCE(locked_until > NOW(), false) AS locked, locked_until`, userId)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if attempt.Locked {
+		return attempt.LockedUntil.Time, nil
+	}
+	if lockout := twofactor.LockoutDuration(attempt.FailedAttempts); lockout > 0 {
+		_, err = tx.ExecContext(ctx, `UPDATE users_two_factor_attempts SET locked_until = $2 WHERE user_id = $1`, userId, time.Now().Add(lockout).UTC())
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	return time.Time{}, tx.Commit()
+}
+
+// ResetUserTwoFactorFailures is called after a successful verification. It also lifts the lockout the reservation of
+// the successful attempt started.
+func (d *DataAccessService) ResetUserTwoFactorFailures(ctx context.Context, userId uint64) error {
+	_, err := d.userWriter.ExecContext(ctx, `
+		UPDATE users_two_factor_attempts
+		SET failed_attempts = 0, locked_until = NULL
+		WHERE user_id = $1 AND failed_attempts > 0`, userId)
+	return err
+}
@@ -28,6 +28,7 @@ type UserRepository interface {
 	UpdatePasswordResetHash(ctx context.Context, userId uint64, passwordHash string) error
 	GetUserCredentialInfo(ctx context.Context, userId uint64) (*t.UserCredentialInfo, error)
 	GetUserIdByApiKey(ctx context.Context, apiKey string) (uint64, error)
+	AddUserApiKey(ctx context.Context, userId uint64, apiKey string, maxApiKeys uint64) (bool, error)
 	GetUserIdByConfirmationHash(ctx context.Context, hash string) (uint64, error)
 	GetUserIdByResetHash(ctx context.Context, hash string) (uint64, error)
 	GetUserInfo(ctx context.Context, id uint64) (*t.UserInfo, error)
@@ -234,6 +235,22 @@ func (d *DataAccessService) GetUserIdByApiKey(ctx context.Context, apiKey string
 	return userId, err
 }
 
+// AddUserApiKey stores a new api key unless the user already has the maximum number of valid keys, returns whether it was added
+func (d *DataAccessService) AddUserApiKey(ctx context.Context, userId uint64, apiKey string, maxApiKeys uint64) (bool, error) {
+	result, err := d.userWriter.ExecContext(ctx, `
+		INSERT INTO api_keys (user_id, api_key)
+		SELECT $1, $2
+		WHERE (SELECT COUNT(*) FROM api_keys WHERE user_id = $1 AND valid_until > NOW()) < $3`, userId, apiKey, maxApiKeys)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
 func (d *DataAccessService) GetUserIdByConfirmationHash(ctx context.Context, hash string) (uint64, error) {
 	var result uint64
 
@@ -285,8 +302,10 @@ func (d *DataAccessService) GetUserDashboards(ctx context.Context, userId uint64
 			PublicId     sql.NullString `db:"public_id"`
 			PublicName   sql.NullString `db:"public_name"`
 			SharedGroups sql.NullBool   `db:"shared_groups"`
+			Role         string         `db:"role"`
 		}{}
 
+		// dashboards owned by the user and dashboards the user is a member of
 		err := d.alloyReader.SelectContext(ctx, &dbReturn, `
 		SELECT
 			uvd.id,
@@ -295,10 +314,12 @@ func (d *DataAccessService) GetUserDashboards(ctx context.Context, userId uint64
 			uvd.is_archived,
 			uvds.public_id,
 			uvds.name AS public_name,
-			uvds.shared_groups
+			uvds.shared_groups,
+			COALESCE(uvdm.role, 'owner') AS role
 		FROM users_val_dashboards uvd
 		LEFT JOIN users_val_dashboards_sharing uvds ON uvd.id = uvds.dashboard_id
-		WHERE uvd.user_id = $1
+		LEFT JOIN users_val_dashboards_members uvdm ON uvd.id = uvdm.dashboard_id AND uvdm.user_id = $1
+		WHERE uvd.user_id = $1 OR uvdm.user_id IS NOT NULL
 	`, userId)
 		if err != nil {
 			return err
@@ -313,6 +334,7 @@ func (d *DataAccessService) GetUserDashboards(ctx context.Context, userId uint64
 					PublicIds:      []t.VDBPublicId{},
 					IsArchived:     row.IsArchived.Valid,
 					ArchivedReason: row.IsArchived.String,
+					Role:           row.Role,
 				}
 			}
 			if row.PublicId.Valid {
@@ -346,7 +368,7 @@ func (d *DataAccessService) GetUserDashboards(ctx context.Context, userId uint64
 		FROM users_val_dashboards uvd
 		LEFT JOIN users_val_dashboards_groups uvdg ON uvd.id = uvdg.dashboard_id
 		LEFT JOIN users_val_dashboards_validators uvdv ON uvd.id = uvdv.dashboard_id
-		WHERE uvd.user_id = $1
+		WHERE uvd.user_id = $1 OR uvd.id IN (SELECT dashboard_id FROM users_val_dashboards_members WHERE user_id = $1)
 		GROUP BY uvd.id
 	`, userId)
 		if err != nil {
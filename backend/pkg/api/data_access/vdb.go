@@ -25,7 +25,7 @@ type ValidatorDashboardRepository interface {
 	CreateValidatorDashboardGroup(ctx context.Context, dashboardId t.VDBIdPrimary, name string) (*t.VDBPostCreateGroupData, error)
 	UpdateValidatorDashboardGroup(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64, name string) (*t.VDBPostCreateGroupData, error)
 	RemoveValidatorDashboardGroup(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64) error
-	RemoveValidatorDashboardGroupValidators(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64) error
+	RemoveValidatorDashboardGroupValidators(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64) ([]t.VDBValidator, error)
 	GetValidatorDashboardGroupCount(ctx context.Context, dashboardId t.VDBIdPrimary) (uint64, error)
 	GetValidatorDashboardGroupExists(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64) (bool, error)
 
@@ -35,7 +35,7 @@ type ValidatorDashboardRepository interface {
 	AddValidatorDashboardValidatorsByGraffiti(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64, graffiti string, limit uint64) ([]t.VDBPostValidatorsData, error)
 
 	RemoveValidatorDashboardValidators(ctx context.Context, dashboardId t.VDBIdPrimary, validators []t.VDBValidator) error
-	GetValidatorDashboardValidators(ctx context.Context, dashboardId t.VDBId, groupId int64, cursor string, colSort t.Sort[enums.VDBManageValidatorsColumn], search string, limit uint64) ([]t.VDBManageValidatorsTableRow, *t.Paging, error)
+	GetValidatorDashboardValidators(ctx context.Context, dashboardId t.VDBId, groupId int64, cursor string, colSort t.Sort[enums.VDBManageValidatorsColumn], search string, limit uint64, credentialTypes []uint8) ([]t.VDBManageValidatorsTableRow, *t.Paging, error)
 	GetValidatorDashboardValidatorsCount(ctx context.Context, dashboardId t.VDBIdPrimary) (uint64, error)
 
 	CreateValidatorDashboardPublicId(ctx context.Context, dashboardId t.VDBIdPrimary, name string, shareGroups bool) (*t.VDBPublicId, error)
@@ -44,28 +44,41 @@ type ValidatorDashboardRepository interface {
 	RemoveValidatorDashboardPublicId(ctx context.Context, publicDashboardId t.VDBIdPublic) error
 	GetValidatorDashboardPublicIdCount(ctx context.Context, dashboardId t.VDBIdPrimary) (uint64, error)
 
+	GetValidatorDashboardRole(ctx context.Context, dashboardId t.VDBIdPrimary, userId uint64) (enums.VDBMemberRole, error)
+	GetValidatorDashboardMembers(ctx context.Context, dashboardId t.VDBIdPrimary) (*t.VDBMembersData, error)
+	CreateValidatorDashboardInvite(ctx context.Context, dashboardId t.VDBIdPrimary, invitedBy uint64, email string, role enums.VDBMemberRole, token string) error
+	RemoveValidatorDashboardInvite(ctx context.Context, dashboardId t.VDBIdPrimary, email string) error
+	GetValidatorDashboardInvite(ctx context.Context, token string) (*t.VDBInviteInfo, error)
+	AcceptValidatorDashboardInvite(ctx context.Context, token string, userId uint64) error
+	UpdateValidatorDashboardMember(ctx context.Context, dashboardId t.VDBIdPrimary, userId uint64, role enums.VDBMemberRole) error
+	RemoveValidatorDashboardMember(ctx context.Context, dashboardId t.VDBIdPrimary, userId uint64) error
+	AddValidatorDashboardAuditLogEntry(ctx context.Context, dashboardId t.VDBIdPrimary, userId uint64, action string, groupId *uint64, validators []t.VDBValidator) error
+	GetValidatorDashboardAuditLog(ctx context.Context, dashboardId t.VDBIdPrimary, cursor string, limit uint64) ([]t.VDBAuditLogTableRow, *t.Paging, error)
+	GetValidatorDashboardTwoFactorRequired(ctx context.Context, dashboardId t.VDBIdPrimary) (bool, error)
+	UpdateValidatorDashboardTwoFactorRequired(ctx context.Context, dashboardId t.VDBIdPrimary, required bool) error
+
 	GetValidatorDashboardSlotViz(ctx context.Context, dashboardId t.VDBId, groupIds []uint64) ([]t.SlotVizEpoch, error)
 
 	GetLatestExportedChartTs(ctx context.Context, aggregation enums.ChartAggregation) (uint64, error)
 
-	GetValidatorDashboardSummary(ctx context.Context, dashboardId t.VDBId, period enums.TimePeriod, cursor string, colSort t.Sort[enums.VDBSummaryColumn], search string, limit uint64, protocolModes t.VDBProtocolModes) ([]t.VDBSummaryTableRow, *t.Paging, error)
-	GetValidatorDashboardGroupSummary(ctx context.Context, dashboardId t.VDBId, groupId int64, period enums.TimePeriod, protocolModes t.VDBProtocolModes) (*t.VDBGroupSummaryData, error)
-	GetValidatorDashboardSummaryChart(ctx context.Context, dashboardId t.VDBId, groupIds []int64, efficiencyType enums.VDBSummaryChartEfficiencyType, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64) (*t.ChartData[int, float64], error)
+	GetValidatorDashboardSummary(ctx context.Context, dashboardId t.VDBId, period enums.TimePeriod, cursor string, colSort t.Sort[enums.VDBSummaryColumn], search string, limit uint64, protocolModes t.VDBProtocolModes, credentialTypes []uint8) ([]t.VDBSummaryTableRow, *t.Paging, error)
+	GetValidatorDashboardGroupSummary(ctx context.Context, dashboardId t.VDBId, groupId int64, period enums.TimePeriod, protocolModes t.VDBProtocolModes, credentialTypes []uint8) (*t.VDBGroupSummaryData, error)
+	GetValidatorDashboardSummaryChart(ctx context.Context, dashboardId t.VDBId, groupIds []int64, efficiencyType enums.VDBSummaryChartEfficiencyType, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64, credentialTypes []uint8) (*t.ChartData[int, float64], error)
 	GetValidatorDashboardSummaryValidators(ctx context.Context, dashboardId t.VDBId, groupId int64) (*t.VDBGeneralSummaryValidators, error)
 	GetValidatorDashboardSyncSummaryValidators(ctx context.Context, dashboardId t.VDBId, groupId int64, period enums.TimePeriod) (*t.VDBSyncSummaryValidators, error)
 	GetValidatorDashboardSlashingsSummaryValidators(ctx context.Context, dashboardId t.VDBId, groupId int64, period enums.TimePeriod) (*t.VDBSlashingsSummaryValidators, error)
 	GetValidatorDashboardProposalSummaryValidators(ctx context.Context, dashboardId t.VDBId, groupId int64, period enums.TimePeriod) (*t.VDBProposalSummaryValidators, error)
 
-	GetValidatorDashboardRewards(ctx context.Context, dashboardId t.VDBId, cursor string, colSort t.Sort[enums.VDBRewardsColumn], search string, limit uint64, protocolModes t.VDBProtocolModes) ([]t.VDBRewardsTableRow, *t.Paging, error)
+	GetValidatorDashboardRewards(ctx context.Context, dashboardId t.VDBId, cursor string, colSort t.Sort[enums.VDBRewardsColumn], search string, limit uint64, protocolModes t.VDBProtocolModes, credentialTypes []uint8) ([]t.VDBRewardsTableRow, *t.Paging, error)
 	GetValidatorDashboardGroupRewards(ctx context.Context, dashboardId t.VDBId, groupId int64, epoch uint64, protocolModes t.VDBProtocolModes) (*t.VDBGroupRewardsData, error)
-	GetValidatorDashboardRewardsChart(ctx context.Context, dashboardId t.VDBId, protocolModes t.VDBProtocolModes) (*t.ChartData[int, decimal.Decimal], error)
+	GetValidatorDashboardRewardsChart(ctx context.Context, dashboardId t.VDBId, protocolModes t.VDBProtocolModes, credentialTypes []uint8) (*t.ChartData[int, decimal.Decimal], error)
 
 	GetValidatorDashboardDuties(ctx context.Context, dashboardId t.VDBId, epoch uint64, groupId int64, cursor string, colSort t.Sort[enums.VDBDutiesColumn], search string, limit uint64, protocolModes t.VDBProtocolModes) ([]t.VDBEpochDutiesTableRow, *t.Paging, error)
 
 	GetValidatorDashboardBlocks(ctx context.Context, dashboardId t.VDBId, cursor string, colSort t.Sort[enums.VDBBlocksColumn], search string, limit uint64, protocolModes t.VDBProtocolModes) ([]t.VDBBlocksTableRow, *t.Paging, error)
 
-	GetValidatorDashboardHeatmap(ctx context.Context, dashboardId t.VDBId, protocolModes t.VDBProtocolModes, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64) (*t.VDBHeatmap, error)
-	GetValidatorDashboardGroupHeatmap(ctx context.Context, dashboardId t.VDBId, groupId uint64, protocolModes t.VDBProtocolModes, aggregation enums.ChartAggregation, timestamp uint64) (*t.VDBHeatmapTooltipData, error)
+	GetValidatorDashboardHeatmap(ctx context.Context, dashboardId t.VDBId, metric enums.VDBHeatmapMetric, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64) (*t.VDBHeatmap, error)
+	GetValidatorDashboardGroupHeatmap(ctx context.Context, dashboardId t.VDBId, groupId uint64, aggregation enums.ChartAggregation, timestamp uint64) (*t.VDBHeatmapTooltipData, error)
 
 	GetValidatorDashboardElDeposits(ctx context.Context, dashboardId t.VDBId, cursor string, limit uint64) ([]t.VDBExecutionDepositsTableRow, *t.Paging, error)
 	GetValidatorDashboardClDeposits(ctx context.Context, dashboardId t.VDBId, cursor string, limit uint64) ([]t.VDBConsensusDepositsTableRow, *t.Paging, error)
@@ -75,9 +88,19 @@ type ValidatorDashboardRepository interface {
 	GetValidatorDashboardWithdrawals(ctx context.Context, dashboardId t.VDBId, cursor string, colSort t.Sort[enums.VDBWithdrawalsColumn], search string, limit uint64, protocolModes t.VDBProtocolModes) ([]t.VDBWithdrawalsTableRow, *t.Paging, error)
 	GetValidatorDashboardTotalWithdrawals(ctx context.Context, dashboardId t.VDBId, search string, protocolModes t.VDBProtocolModes) (*t.VDBTotalWithdrawalsData, error)
 
-	GetValidatorDashboardRocketPool(ctx context.Context, dashboardId t.VDBId, cursor string, colSort t.Sort[enums.VDBRocketPoolColumn], search string, limit uint64) ([]t.VDBRocketPoolTableRow, *t.Paging, error)
-	GetValidatorDashboardTotalRocketPool(ctx context.Context, dashboardId t.VDBId, search string) (*t.VDBRocketPoolTableRow, error)
-	GetValidatorDashboardRocketPoolMinipools(ctx context.Context, dashboardId t.VDBId, node, cursor string, colSort t.Sort[enums.VDBRocketPoolMinipoolsColumn], search string, limit uint64) ([]t.VDBRocketPoolMinipoolsTableRow, *t.Paging, error)
+	GetValidatorDashboardWithdrawalRequests(ctx context.Context, dashboardId t.VDBId, cursor string, limit uint64) ([]t.VDBWithdrawalRequestsTableRow, *t.Paging, error)
+	GetValidatorDashboardConsolidations(ctx context.Context, dashboardId t.VDBId, cursor string, limit uint64) ([]t.VDBConsolidationsTableRow, *t.Paging, error)
+	GetValidatorDashboardPendingQueues(ctx context.Context, dashboardId t.VDBId) (*t.VDBPendingQueuesData, error)
+	GetValidatorDashboardGossip(ctx context.Context, dashboardId t.VDBId) (*t.VDBGossipData, error)
+
+	GetValidatorDashboardMevBids(ctx context.Context, dashboardId t.VDBId, cursor string, limit uint64) ([]t.VDBMevBidsTableRow, *t.Paging, error)
+	GetValidatorDashboardSsvClusters(ctx context.Context, dashboardId t.VDBId) ([]t.VDBSsvClusterTableRow, error)
+
+	GetValidatorDashboardRocketPool(ctx context.Context, dashboardId t.VDBId, groupId int64, cursor string, colSort t.Sort[enums.VDBRocketPoolColumn], search string, limit uint64) ([]t.VDBRocketPoolTableRow, *t.Paging, error)
+	GetValidatorDashboardTotalRocketPool(ctx context.Context, dashboardId t.VDBId, groupId int64, search string) (*t.VDBRocketPoolTableRow, error)
+	GetValidatorDashboardRocketPoolMinipools(ctx context.Context, dashboardId t.VDBId, groupId int64, node, cursor string, colSort t.Sort[enums.VDBRocketPoolMinipoolsColumn], search string, limit uint64) ([]t.VDBRocketPoolMinipoolsTableRow, *t.Paging, error)
 
 	GetValidatorDashboardMobileWidget(ctx context.Context, dashboardId t.VDBIdPrimary) (*t.MobileWidgetData, error)
+
+	GetValidatorDashboardSyncCommittees(ctx context.Context, dashboardId t.VDBId) ([]t.VDBSyncCommitteesTableRow, error)
 }
@@ -0,0 +1,88 @@
+package dataaccess
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/lib/pq"
+)
+
+// dashboardGroupId returns the group a request of the dashboard is shown in
+func dashboardGroupId(dashboardId t.VDBId, groupId int64) uint64 {
+	if dashboardId.Validators != nil || dashboardId.AggregateGroups || groupId < 0 {
+		return t.DefaultGroupId
+	}
+	return uint64(groupId)
+}
+
+func (d *DataAccessService) GetValidatorDashboardWithdrawalRequests(ctx context.Context, dashboardId t.VDBId, cursor string, limit uint64) ([]t.VDBWithdrawalRequestsTableRow, *t.Paging, error) {
+	ds := withdrawalRequestsDs()
+	if dashboardId.Validators != nil {
+		ds = ds.Where(goqu.L("v.validatorindex = ANY(?)", pq.Array(dashboardId.Validators)))
+	} else {
+		ds = ds.
+			SelectAppend(goqu.L("uvdv.group_id")).
+			InnerJoin(goqu.L("users_val_dashboards_validators uvdv"), goqu.On(goqu.L("uvdv.validator_index = v.validatorindex"))).
+			Where(goqu.L("uvdv.dashboard_id = ?", dashboardId.Id))
+	}
+
+	rows, paging, err := d.getWithdrawalRequestRows(ctx, ds, cursor, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	requests, err := d.toWithdrawalRequests(ctx, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.VDBWithdrawalRequestsTableRow, len(requests))
+	for i, request := range requests {
+		result[i] = t.VDBWithdrawalRequestsTableRow{
+			Slot:          request.Slot,
+			Epoch:         request.Epoch,
+			GroupId:       dashboardGroupId(dashboardId, rows[i].GroupId.Int64),
+			SourceAddress: request.SourceAddress,
+			Validator:     request.Validator,
+			Amount:        request.Amount,
+			IsFullExit:    request.IsFullExit,
+		}
+	}
+	return result, paging, nil
+}
+
+// a consolidation is part of the dashboard if either its source or its target validator is
+func (d *DataAccessService) GetValidatorDashboardConsolidations(ctx context.Context, dashboardId t.VDBId, cursor string, limit uint64) ([]t.VDBConsolidationsTableRow, *t.Paging, error) {
+	ds := consolidationRequestsDs()
+	if dashboardId.Validators != nil {
+		ds = ds.Where(goqu.L("(vs.validatorindex = ANY(?) OR vt.validatorindex = ANY(?))", pq.Array(dashboardId.Validators), pq.Array(dashboardId.Validators)))
+	} else {
+		ds = ds.
+			SelectAppend(goqu.L("COALESCE(us.group_id, ut.group_id) AS group_id")).
+			LeftJoin(goqu.L("users_val_dashboards_validators us"), goqu.On(goqu.L("us.validator_index = vs.validatorindex AND us.dashboard_id = ?", dashboardId.Id))).
+			LeftJoin(goqu.L("users_val_dashboards_validators ut"), goqu.On(goqu.L("ut.validator_index = vt.validatorindex AND ut.dashboard_id = ?", dashboardId.Id))).
+			Where(goqu.L("(us.validator_index IS NOT NULL OR ut.validator_index IS NOT NULL)"))
+	}
+
+	rows, paging, err := d.getConsolidationRequestRows(ctx, ds, cursor, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	requests, err := d.toConsolidationRequests(ctx, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.VDBConsolidationsTableRow, len(requests))
+	for i, request := range requests {
+		result[i] = t.VDBConsolidationsTableRow{
+			Slot:          request.Slot,
+			Epoch:         request.Epoch,
+			GroupId:       dashboardGroupId(dashboardId, rows[i].GroupId.Int64),
+			SourceAddress: request.SourceAddress,
+			Source:        request.Source,
+			Target:        request.Target,
+		}
+	}
+	return result, paging, nil
+}
@@ -0,0 +1,122 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/cache"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"golang.org/x/sync/errgroup"
+)
+
+// gossipAttestationsEndSlot returns the first slot whose attestations can still be included in a block that is not
+// finalized, attestations can be included until the end of the epoch after their own
+func gossipAttestationsEndSlot(finalizedEpoch uint64) uint64 {
+	if finalizedEpoch == 0 {
+		return 0
+	}
+	return (finalizedEpoch - 1) * utils.Config.Chain.ClConfig.SlotsPerEpoch
+}
+
+func (d *DataAccessService) GetValidatorDashboardGossip(ctx context.Context, dashboardId t.VDBId) (*t.VDBGossipData, error) {
+	result := &t.VDBGossipData{
+		VoluntaryExits: []t.VDBPoolVoluntaryExitsTableRow{},
+		BlsChanges:     []t.VDBPoolBlsChangesTableRow{},
+	}
+	validatorGroups, err := d.getDashboardValidatorGroups(ctx, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+	if len(validatorGroups) == 0 {
+		return result, nil
+	}
+	validators := make([]uint64, 0, len(validatorGroups))
+	for validator := range validatorGroups {
+		validators = append(validators, validator)
+	}
+
+	var attestations struct {
+		Seen                uint64  `db:"seen"`
+		Included            uint64  `db:"included"`
+		SeenBeforeInclusion uint64  `db:"seen_before_inclusion"`
+		AverageLead         float64 `db:"average_lead"`
+	}
+	var exits []struct {
+		Validator uint64 `db:"validator_index"`
+		Epoch     uint64 `db:"epoch"`
+		SeenTs    int64  `db:"seen_ts"`
+	}
+	var blsChanges []struct {
+		Validator          uint64 `db:"validator_index"`
+		ToExecutionAddress []byte `db:"to_execution_address"`
+		SeenTs             int64  `db:"seen_ts"`
+	}
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		// the inclusion slot is set by the exporter once the including block is finalized
+		err := d.readerDb.GetContext(ctx, &attestations, `
+			SELECT
+				COUNT(*) AS seen,
+				COUNT(inclusion_slot) AS included,
+				COUNT(*) FILTER (WHERE lead > 0) AS seen_before_inclusion,
+				COALESCE(AVG(lead) FILTER (WHERE lead > 0), 0) AS average_lead
+			FROM (
+				SELECT inclusion_slot, ($3::BIGINT + inclusion_slot::BIGINT * $4::BIGINT) - EXTRACT(EPOCH FROM seen_ts) AS lead
+				FROM attestations_gossip
+				WHERE validator_index = ANY($1) AND slot < $2
+			) a`,
+			pq.Array(validators), gossipAttestationsEndSlot(cache.LatestFinalizedEpoch.Get()),
+			utils.Config.Chain.GenesisTimestamp, utils.Config.Chain.ClConfig.SecondsPerSlot)
+		if err != nil {
+			return fmt.Errorf("error retrieving gossip attestations: %w", err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		err := d.readerDb.SelectContext(ctx, &exits, `
+			SELECT validator_index, epoch, EXTRACT(EPOCH FROM seen_ts)::BIGINT AS seen_ts
+			FROM pool_voluntary_exits
+			WHERE validator_index = ANY($1)
+			ORDER BY seen_ts DESC, validator_index`, pq.Array(validators))
+		if err != nil {
+			return fmt.Errorf("error retrieving pool voluntary exits: %w", err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		err := d.readerDb.SelectContext(ctx, &blsChanges, `
+			SELECT validator_index, to_execution_address, EXTRACT(EPOCH FROM seen_ts)::BIGINT AS seen_ts
+			FROM pool_bls_to_execution_changes
+			WHERE validator_index = ANY($1)
+			ORDER BY seen_ts DESC, validator_index`, pq.Array(validators))
+		if err != nil {
+			return fmt.Errorf("error retrieving pool bls to execution changes: %w", err)
+		}
+		return nil
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	result.Attestations = t.VDBGossipAttestationsSummary(attestations)
+	for _, exit := range exits {
+		result.VoluntaryExits = append(result.VoluntaryExits, t.VDBPoolVoluntaryExitsTableRow{
+			GroupId:       dashboardGroupId(dashboardId, int64(validatorGroups[exit.Validator])),
+			Index:         exit.Validator,
+			Epoch:         exit.Epoch,
+			SeenTimestamp: exit.SeenTs,
+		})
+	}
+	for _, change := range blsChanges {
+		result.BlsChanges = append(result.BlsChanges, t.VDBPoolBlsChangesTableRow{
+			GroupId:            dashboardGroupId(dashboardId, int64(validatorGroups[change.Validator])),
+			Index:              change.Validator,
+			ToExecutionAddress: t.Address{Hash: t.Hash(hexutil.Encode(change.ToExecutionAddress))},
+			SeenTimestamp:      change.SeenTs,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,22 @@
+package dataaccess
+
+import (
+	"testing"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+)
+
+func TestGossipAttestationsEndSlot(test *testing.T) {
+	previousConfig := utils.Config
+	test.Cleanup(func() { utils.Config = previousConfig })
+	utils.Config = &types.Config{}
+	utils.Config.Chain.ClConfig.SlotsPerEpoch = 32
+
+	// the attestations of the epoch before the finalized one can still be included in the blocks of the finalized epoch, which are not finalized yet
+	for finalizedEpoch, expected := range map[uint64]uint64{0: 0, 1: 0, 2: 32, 100: 3168} {
+		if endSlot := gossipAttestationsEndSlot(finalizedEpoch); endSlot != expected {
+			test.Errorf("finalized epoch %d: expected slot %d, got %d", finalizedEpoch, expected, endSlot)
+		}
+	}
+}
@@ -1,19 +1,250 @@
 package dataaccess
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"slices"
 
+	"github.com/doug-martin/goqu/v9"
 	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
 )
 
-// retrieve data for last hour
-func (d *DataAccessService) GetValidatorDashboardHeatmap(ctx context.Context, dashboardId t.VDBId, protocolModes t.VDBProtocolModes, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64) (*t.VDBHeatmap, error) {
-	// WORKING Rami
-	return d.dummy.GetValidatorDashboardHeatmap(ctx, dashboardId, protocolModes, aggregation, afterTs, beforeTs)
+// getHeatmapTable returns the clickhouse table and its time bucket column for the given aggregation
+func getHeatmapTable(aggregation enums.ChartAggregation) (string, string, error) {
+	switch aggregation {
+	case enums.IntervalEpoch:
+		return "validator_dashboard_data_epoch", "epoch_timestamp", nil
+	case enums.IntervalHourly:
+		return "validator_dashboard_data_hourly", "hour", nil
+	case enums.IntervalDaily:
+		return "validator_dashboard_data_daily", "day", nil
+	case enums.IntervalWeekly:
+		return "validator_dashboard_data_weekly", "week", nil
+	default:
+		return "", "", fmt.Errorf("unexpected aggregation type: %v", aggregation)
+	}
 }
 
-func (d *DataAccessService) GetValidatorDashboardGroupHeatmap(ctx context.Context, dashboardId t.VDBId, groupId uint64, protocolModes t.VDBProtocolModes, aggregation enums.ChartAggregation, timestamp uint64) (*t.VDBHeatmapTooltipData, error) {
-	// WORKING Rami
-	return d.dummy.GetValidatorDashboardGroupHeatmap(ctx, dashboardId, groupId, protocolModes, aggregation, timestamp)
+// heatmapDashboardDs restricts the given dataset on table d to the validators of the dashboard and selects their group as group_id;
+// groupId can be t.AllGroups
+func heatmapDashboardDs(ds *goqu.SelectDataset, dashboardId t.VDBId, groupId int64) *goqu.SelectDataset {
+	if dashboardId.Validators != nil {
+		return ds.
+			SelectAppend(goqu.L("?::smallint AS group_id", t.DefaultGroupId)).
+			Where(goqu.L("d.validator_index IN ?", dashboardId.Validators))
+	}
+	if dashboardId.AggregateGroups {
+		groupId = t.AllGroups
+		ds = ds.SelectAppend(goqu.L("?::smallint AS group_id", t.DefaultGroupId))
+	} else {
+		ds = ds.SelectAppend(goqu.L("v.group_id AS group_id"))
+	}
+	return ds.
+		With("validators", goqu.L("(SELECT validator_index, group_id FROM users_val_dashboards_validators WHERE dashboard_id = ? AND (group_id = ? OR ?::smallint = -1))", dashboardId.Id, groupId, groupId)).
+		InnerJoin(goqu.L("validators v"), goqu.On(goqu.L("d.validator_index = v.validator_index"))).
+		Where(goqu.L("d.validator_index IN (SELECT validator_index FROM validators)"))
+}
+
+// heatmapRow holds the aggregated duties and rewards of a group in a time bucket
+type heatmapRow struct {
+	t.VDBValidatorSummaryChartRow
+	ClRewards int64  `db:"cl_rewards"`
+	Slashings uint64 `db:"slashings"`
+}
+
+// the heatmap shows either the total efficiency or the summed cl rewards of each group per time bucket
+func (d *DataAccessService) GetValidatorDashboardHeatmap(ctx context.Context, dashboardId t.VDBId, metric enums.VDBHeatmapMetric, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64) (*t.VDBHeatmap, error) {
+	dataTable, dateColumn, err := getHeatmapTable(aggregation)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := goqu.Dialect("postgres").
+		Select(
+			goqu.L(fmt.Sprintf("d.%s AS ts", dateColumn)),
+			goqu.L("COALESCE(SUM(d.attestations_reward), 0) AS attestation_reward"),
+			goqu.L("COALESCE(SUM(d.attestations_ideal_reward), 0) AS attestations_ideal_reward"),
+			goqu.L("COALESCE(SUM(d.blocks_proposed), 0) AS blocks_proposed"),
+			goqu.L("COALESCE(SUM(d.blocks_scheduled), 0) AS blocks_scheduled"),
+			goqu.L("COALESCE(SUM(d.sync_executed), 0) AS sync_executed"),
+			goqu.L("COALESCE(SUM(d.sync_scheduled), 0) AS sync_scheduled"),
+			goqu.L("SUM(COALESCE(d.attestations_reward, 0) + COALESCE(d.blocks_cl_reward, 0) + COALESCE(d.sync_rewards, 0)) AS cl_rewards"),
+			goqu.L("COALESCE(SUM(d.blocks_slashing_count), 0) + SUM(CASE WHEN d.slashed THEN 1 ELSE 0 END) AS slashings")).
+		From(goqu.L(fmt.Sprintf("%s d", dataTable))).
+		Where(goqu.L(fmt.Sprintf("d.%[1]s >= fromUnixTimestamp(?) AND d.%[1]s <= fromUnixTimestamp(?)", dateColumn), afterTs, beforeTs)).
+		GroupBy(goqu.L("ts"), goqu.L("group_id"))
+	ds = heatmapDashboardDs(ds, dashboardId, t.AllGroups)
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("error preparing query: %w", err)
+	}
+
+	var queryResult []heatmapRow
+	err = d.clickhouseReader.SelectContext(ctx, &queryResult, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving data from table %s: %w", dataTable, err)
+	}
+
+	// all groups of the dashboard are shown, even if there is no data for them in the requested range
+	var dashboardGroupIds []uint64
+	if dashboardId.Validators == nil && !dashboardId.AggregateGroups {
+		err = d.alloyReader.SelectContext(ctx, &dashboardGroupIds, `SELECT id FROM users_val_dashboards_groups WHERE dashboard_id = $1`, dashboardId.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving dashboard groups: %w", err)
+		}
+	} else {
+		dashboardGroupIds = []uint64{t.DefaultGroupId}
+	}
+
+	return d.assembleHeatmap(queryResult, dashboardGroupIds, metric, aggregation)
+}
+
+// assembleHeatmap turns the aggregated rows into heatmap cells sorted by timestamp and group, the axes contain every
+// timestamp with data and every given group, regardless of whether the group has data
+func (d *DataAccessService) assembleHeatmap(rows []heatmapRow, dashboardGroupIds []uint64, metric enums.VDBHeatmapMetric, aggregation enums.ChartAggregation) (*t.VDBHeatmap, error) {
+	ret := &t.VDBHeatmap{
+		Timestamps:  make([]int64, 0),
+		GroupIds:    make([]uint64, 0),
+		Data:        make([]t.VDBHeatmapCell, 0, len(rows)),
+		Aggregation: aggregation.ToString(),
+		Metric:      metric.ToString(),
+	}
+
+	groupIds := make(map[uint64]bool)
+	for _, groupId := range dashboardGroupIds {
+		groupIds[groupId] = true
+	}
+	timestamps := make(map[int64]bool)
+	for _, row := range rows {
+		cell := t.VDBHeatmapCell{
+			X: row.Timestamp.Unix(),
+			Y: uint64(row.GroupId),
+		}
+		switch metric {
+		case enums.VDBHeatmapEfficiency:
+			efficiency, err := d.calculateChartEfficiency(enums.VDBSummaryChartAll, &row.VDBValidatorSummaryChartRow)
+			if err != nil {
+				return nil, err
+			}
+			cell.Value = efficiency
+		case enums.VDBHeatmapReward:
+			cell.Value = float64(row.ClRewards)
+		default:
+			return nil, fmt.Errorf("unexpected heatmap metric: %v", metric)
+		}
+		if row.BlocksScheduled > 0 || row.SyncScheduled > 0 || row.Slashings > 0 {
+			cell.Events = &t.VDBHeatmapEvents{
+				Proposal: row.BlocksScheduled > 0,
+				Slash:    row.Slashings > 0,
+				Sync:     row.SyncScheduled > 0,
+			}
+		}
+		ret.Data = append(ret.Data, cell)
+		timestamps[cell.X] = true
+		groupIds[cell.Y] = true
+	}
+
+	for ts := range timestamps {
+		ret.Timestamps = append(ret.Timestamps, ts)
+	}
+	slices.Sort(ret.Timestamps)
+	for groupId := range groupIds {
+		ret.GroupIds = append(ret.GroupIds, groupId)
+	}
+	slices.Sort(ret.GroupIds)
+	slices.SortFunc(ret.Data, func(a, b t.VDBHeatmapCell) int {
+		if a.X != b.X {
+			return cmp.Compare(a.X, b.X)
+		}
+		return cmp.Compare(a.Y, b.Y)
+	})
+
+	return ret, nil
+}
+
+// breaks a single heatmap cell down by duty type
+func (d *DataAccessService) GetValidatorDashboardGroupHeatmap(ctx context.Context, dashboardId t.VDBId, groupId uint64, aggregation enums.ChartAggregation, timestamp uint64) (*t.VDBHeatmapTooltipData, error) {
+	ret := &t.VDBHeatmapTooltipData{
+		Timestamp: int64(timestamp),
+	}
+
+	dataTable, dateColumn, err := getHeatmapTable(aggregation)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := goqu.Dialect("postgres").
+		Select(
+			goqu.L("COALESCE(SUM(d.attestations_reward), 0) AS attestations_reward"),
+			goqu.L("COALESCE(SUM(d.attestations_ideal_reward), 0) AS attestations_ideal_reward"),
+			goqu.L("COALESCE(SUM(d.attestations_scheduled), 0) AS attestations_scheduled"),
+			goqu.L("COALESCE(SUM(d.attestation_head_executed), 0) AS attestation_head_executed"),
+			goqu.L("COALESCE(SUM(d.attestation_source_executed), 0) AS attestation_source_executed"),
+			goqu.L("COALESCE(SUM(d.attestation_target_executed), 0) AS attestation_target_executed"),
+			goqu.L("COALESCE(SUM(d.blocks_scheduled), 0) AS blocks_scheduled"),
+			goqu.L("COALESCE(SUM(d.blocks_proposed), 0) AS blocks_proposed"),
+			goqu.L("COALESCE(SUM(d.sync_executed), 0) AS sync_executed"),
+			goqu.L("COALESCE(SUM(d.blocks_slashing_count), 0) AS slashings_executed"),
+			goqu.L("SUM(CASE WHEN d.slashed THEN 1 ELSE 0 END) AS slashed")).
+		From(goqu.L(fmt.Sprintf("%s d", dataTable))).
+		Where(goqu.L(fmt.Sprintf("d.%s = fromUnixTimestamp(?)", dateColumn), timestamp)).
+		GroupBy(goqu.L("group_id"))
+	ds = heatmapDashboardDs(ds, dashboardId, int64(groupId))
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("error preparing query: %w", err)
+	}
+
+	var queryResult []struct {
+		GroupId                   int64  `db:"group_id"`
+		AttestationReward         int64  `db:"attestations_reward"`
+		AttestationIdealReward    int64  `db:"attestations_ideal_reward"`
+		AttestationsScheduled     uint64 `db:"attestations_scheduled"`
+		AttestationHeadExecuted   uint64 `db:"attestation_head_executed"`
+		AttestationSourceExecuted uint64 `db:"attestation_source_executed"`
+		AttestationTargetExecuted uint64 `db:"attestation_target_executed"`
+		BlocksScheduled           uint64 `db:"blocks_scheduled"`
+		BlocksProposed            uint64 `db:"blocks_proposed"`
+		SyncExecuted              uint64 `db:"sync_executed"`
+		SlashingsExecuted         uint64 `db:"slashings_executed"`
+		Slashed                   uint64 `db:"slashed"`
+	}
+	err = d.clickhouseReader.SelectContext(ctx, &queryResult, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving data from table %s: %w", dataTable, err)
+	}
+
+	// the query groups by group id, for aggregated or validator list dashboards there is only the default group
+	if len(queryResult) == 0 {
+		return ret, nil
+	}
+	row := queryResult[0]
+
+	ret.Proposers.Success = row.BlocksProposed
+	ret.Proposers.Failed = row.BlocksScheduled - row.BlocksProposed
+	ret.Syncs = row.SyncExecuted
+	ret.Slashings.Success = row.SlashingsExecuted
+	ret.Slashings.Failed = row.Slashed
+
+	ret.AttestationsHead.Success = row.AttestationHeadExecuted
+	ret.AttestationsHead.Failed = row.AttestationsScheduled - row.AttestationHeadExecuted
+	ret.AttestationsSource.Success = row.AttestationSourceExecuted
+	ret.AttestationsSource.Failed = row.AttestationsScheduled - row.AttestationSourceExecuted
+	ret.AttestationsTarget.Success = row.AttestationTargetExecuted
+	ret.AttestationsTarget.Failed = row.AttestationsScheduled - row.AttestationTargetExecuted
+
+	ret.AttestationIncome = utils.GWeiToWei(big.NewInt(row.AttestationReward))
+	if row.AttestationIdealReward > 0 {
+		attestationEfficiency := sql.NullFloat64{Float64: float64(row.AttestationReward) / float64(row.AttestationIdealReward), Valid: true}
+		ret.AttestationEfficiency = utils.CalculateTotalEfficiency(attestationEfficiency, sql.NullFloat64{}, sql.NullFloat64{})
+	}
+
+	return ret, nil
 }
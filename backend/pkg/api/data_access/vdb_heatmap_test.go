@@ -0,0 +1,96 @@
+package dataaccess
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+)
+
+func TestAssembleHeatmap(test *testing.T) {
+	hour := func(h int) time.Time { return time.Unix(1700000000, 0).Add(time.Duration(h) * time.Hour) }
+	row := func(ts time.Time, groupId int64, attestationReward, attestationIdealReward, blocksScheduled, blocksProposed float64, clRewards int64, slashings uint64) heatmapRow {
+		return heatmapRow{
+			VDBValidatorSummaryChartRow: t.VDBValidatorSummaryChartRow{
+				Timestamp:              ts,
+				GroupId:                groupId,
+				AttestationReward:      attestationReward,
+				AttestationIdealReward: attestationIdealReward,
+				BlocksScheduled:        blocksScheduled,
+				BlocksProposed:         blocksProposed,
+			},
+			ClRewards: clRewards,
+			Slashings: slashings,
+		}
+	}
+	// rows come out of the database in no particular order, group 3 has no data in the range
+	rows := []heatmapRow{
+		row(hour(1), 2, 90, 100, 0, 0, 90, 0),
+		row(hour(0), 2, 50, 100, 1, 1, 1050, 0),
+		row(hour(1), 1, -10, 100, 0, 0, -10, 1),
+		row(hour(0), 1, 100, 100, 0, 0, 100, 0),
+	}
+	groupIds := []uint64{3, 1, 2}
+
+	tests := []struct {
+		name     string
+		metric   enums.VDBHeatmapMetric
+		expected []t.VDBHeatmapCell
+	}{
+		{
+			name:   "efficiency",
+			metric: enums.VDBHeatmapMetrics.Efficiency,
+			expected: []t.VDBHeatmapCell{
+				{X: hour(0).Unix(), Y: 1, Value: 100},
+				{X: hour(0).Unix(), Y: 2, Value: 56.25, Events: &t.VDBHeatmapEvents{Proposal: true}},
+				{X: hour(1).Unix(), Y: 1, Value: 0, Events: &t.VDBHeatmapEvents{Slash: true}},
+				{X: hour(1).Unix(), Y: 2, Value: 90},
+			},
+		},
+		{
+			name:   "reward",
+			metric: enums.VDBHeatmapMetrics.Reward,
+			expected: []t.VDBHeatmapCell{
+				{X: hour(0).Unix(), Y: 1, Value: 100},
+				{X: hour(0).Unix(), Y: 2, Value: 1050, Events: &t.VDBHeatmapEvents{Proposal: true}},
+				{X: hour(1).Unix(), Y: 1, Value: -10, Events: &t.VDBHeatmapEvents{Slash: true}},
+				{X: hour(1).Unix(), Y: 2, Value: 90},
+			},
+		},
+	}
+	d := &DataAccessService{}
+	for _, tt := range tests {
+		test.Run(tt.name, func(test *testing.T) {
+			heatmap, err := d.assembleHeatmap(rows, groupIds, tt.metric, enums.IntervalHourly)
+			if err != nil {
+				test.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(heatmap.Timestamps, []int64{hour(0).Unix(), hour(1).Unix()}) {
+				test.Errorf("unexpected timestamps %v", heatmap.Timestamps)
+			}
+			if !reflect.DeepEqual(heatmap.GroupIds, []uint64{1, 2, 3}) {
+				test.Errorf("unexpected group ids %v", heatmap.GroupIds)
+			}
+			if !reflect.DeepEqual(heatmap.Data, tt.expected) {
+				test.Errorf("expected cells %+v, got %+v", tt.expected, heatmap.Data)
+			}
+			if heatmap.Aggregation != "hourly" || heatmap.Metric != tt.metric.ToString() {
+				test.Errorf("unexpected aggregation %v or metric %v", heatmap.Aggregation, heatmap.Metric)
+			}
+		})
+	}
+
+	heatmap, err := d.assembleHeatmap(nil, []uint64{t.DefaultGroupId}, enums.VDBHeatmapMetrics.Efficiency, enums.IntervalDaily)
+	if err != nil {
+		test.Fatalf("unexpected error: %v", err)
+	}
+	if len(heatmap.Data) != 0 || len(heatmap.Timestamps) != 0 || !reflect.DeepEqual(heatmap.GroupIds, []uint64{t.DefaultGroupId}) {
+		test.Errorf("expected an empty heatmap listing the default group, got %+v", heatmap)
+	}
+
+	if _, err := d.assembleHeatmap(rows, groupIds, enums.VDBHeatmapMetric(-1), enums.IntervalHourly); err == nil {
+		test.Errorf("expected an error for an invalid metric")
+	}
+}
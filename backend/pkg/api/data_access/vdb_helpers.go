@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
@@ -41,6 +42,32 @@ func (d DataAccessService) getDashboardValidators(ctx context.Context, dashboard
 	return dashboardId.Validators, nil
 }
 
+// getDashboardValidatorGroups returns the group of each validator of the dashboard, validators of a public id are in the default group
+func (d DataAccessService) getDashboardValidatorGroups(ctx context.Context, dashboardId t.VDBId) (map[uint64]uint64, error) {
+	validatorGroups := make(map[uint64]uint64)
+	if dashboardId.Validators == nil {
+		var queryResult []struct {
+			Validator uint64 `db:"validator_index"`
+			GroupId   uint64 `db:"group_id"`
+		}
+		err := d.alloyReader.SelectContext(ctx, &queryResult, `
+			SELECT validator_index, group_id
+			FROM users_val_dashboards_validators
+			WHERE dashboard_id = $1`, dashboardId.Id)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range queryResult {
+			validatorGroups[row.Validator] = row.GroupId
+		}
+	} else {
+		for _, validator := range dashboardId.Validators {
+			validatorGroups[validator] = t.DefaultGroupId
+		}
+	}
+	return validatorGroups, nil
+}
+
 func (d DataAccessService) calculateChartEfficiency(efficiencyType enums.VDBSummaryChartEfficiencyType, row *t.VDBValidatorSummaryChartRow) (float64, error) {
 	efficiency := float64(0)
 	switch efficiencyType {
@@ -130,3 +157,57 @@ func (d *DataAccessService) getTimeToNextWithdrawal(distance uint64) time.Time {
 
 	return timeToWithdrawal
 }
+
+// getValidatorsEffectiveBalance returns the summed up current effective balance (in gwei) of the validators
+func (d *DataAccessService) getValidatorsEffectiveBalance(validators []t.VDBValidator) (uint64, error) {
+	validatorMapping, err := d.services.GetCurrentValidatorMapping()
+	if err != nil {
+		return 0, err
+	}
+
+	var effectiveBalance uint64
+	for _, validator := range validators {
+		if validator >= uint64(len(validatorMapping.ValidatorMetadata)) {
+			continue
+		}
+		effectiveBalance += validatorMapping.ValidatorMetadata[validator].EffectiveBalance
+	}
+	return effectiveBalance, nil
+}
+
+// getCredentialTypeValidators returns the validators of the dashboard whose withdrawal credentials are of one of the given types.
+// Returns nil if no types are given so the validators are not restricted, an empty slice if none of the validators match.
+func (d *DataAccessService) getCredentialTypeValidators(ctx context.Context, dashboardId t.VDBId, credentialTypes []uint8) ([]t.VDBValidator, error) {
+	if len(credentialTypes) == 0 {
+		return nil, nil
+	}
+	dashboardValidators, err := d.getDashboardValidators(ctx, dashboardId, nil)
+	if err != nil {
+		return nil, err
+	}
+	return d.filterValidatorsByCredentialTypes(dashboardValidators, credentialTypes)
+}
+
+// filterValidatorsByCredentialTypes returns the validators whose withdrawal credentials are of one of the given types,
+// if no types are given all validators are returned
+func (d *DataAccessService) filterValidatorsByCredentialTypes(validators []t.VDBValidator, credentialTypes []uint8) ([]t.VDBValidator, error) {
+	if len(credentialTypes) == 0 {
+		return validators, nil
+	}
+
+	validatorMapping, err := d.services.GetCurrentValidatorMapping()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]t.VDBValidator, 0, len(validators))
+	for _, validator := range validators {
+		if validator >= uint64(len(validatorMapping.ValidatorMetadata)) {
+			continue
+		}
+		if slices.Contains(credentialTypes, utils.GetWithdrawalCredentialsType(validatorMapping.ValidatorMetadata[validator].WithdrawalCredentials)) {
+			result = append(result, validator)
+		}
+	}
+	return result, nil
+}
@@ -457,7 +457,7 @@ func (d *DataAccessService) GetValidatorDashboardOverview(ctx context.Context, d
 	retrieveRewardsAndEfficiency := func(table string, hours int, rewards *t.ClElValue[decimal.Decimal], apr *t.ClElValue[float64], efficiency *float64) {
 		// Rewards + APR
 		eg.Go(func() error {
-			(*rewards).El, (*apr).El, (*rewards).Cl, (*apr).Cl, err = d.internal_getElClAPR(ctx, dashboardId, -1, hours)
+			(*rewards).El, (*apr).El, (*rewards).Cl, (*apr).Cl, err = d.internal_getElClAPR(ctx, dashboardId, -1, hours, nil)
 			if err != nil {
 				return err
 			}
@@ -605,17 +605,20 @@ func (d *DataAccessService) RemoveValidatorDashboardGroup(ctx context.Context, d
 	return err
 }
 
-func (d *DataAccessService) RemoveValidatorDashboardGroupValidators(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64) error {
+// RemoveValidatorDashboardGroupValidators removes all validators of the group and returns the removed validators
+func (d *DataAccessService) RemoveValidatorDashboardGroupValidators(ctx context.Context, dashboardId t.VDBIdPrimary, groupId uint64) ([]t.VDBValidator, error) {
 	//Create the query to delete validators
 	deleteValidatorsQuery := `
 		DELETE FROM users_val_dashboards_validators
 		WHERE dashboard_id = $1 AND group_id = $2
+		RETURNING validator_index
 	`
 
 	// Delete the validators
-	_, err := d.alloyWriter.ExecContext(ctx, deleteValidatorsQuery, dashboardId, groupId)
+	var validators []t.VDBValidator
+	err := d.alloyWriter.SelectContext(ctx, &validators, deleteValidatorsQuery, dashboardId, groupId)
 
-	return err
+	return validators, err
 }
 
 func (d *DataAccessService) GetValidatorDashboardGroupCount(ctx context.Context, dashboardId t.VDBIdPrimary) (uint64, error) {
@@ -626,7 +629,7 @@ func (d *DataAccessService) GetValidatorDashboardGroupCount(ctx context.Context,
 	return count, err
 }
 
-func (d *DataAccessService) GetValidatorDashboardValidators(ctx context.Context, dashboardId t.VDBId, groupId int64, cursor string, colSort t.Sort[enums.VDBManageValidatorsColumn], search string, limit uint64) ([]t.VDBManageValidatorsTableRow, *t.Paging, error) {
+func (d *DataAccessService) GetValidatorDashboardValidators(ctx context.Context, dashboardId t.VDBId, groupId int64, cursor string, colSort t.Sort[enums.VDBManageValidatorsColumn], search string, limit uint64, credentialTypes []uint8) ([]t.VDBManageValidatorsTableRow, *t.Paging, error) {
 	// Initialize the cursor
 	var currentCursor t.ValidatorsCursor
 	var err error
@@ -690,6 +693,11 @@ func (d *DataAccessService) GetValidatorDashboardValidators(ctx context.Context,
 	}
 	var paging t.Paging
 
+	validators, err = d.filterValidatorsByCredentialTypes(validators, credentialTypes)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if len(validators) == 0 {
 		// Return if there are no validators
 		return nil, &paging, nil
@@ -711,6 +719,7 @@ func (d *DataAccessService) GetValidatorDashboardValidators(ctx context.Context,
 			PublicKey:            t.PubKey(hexutil.Encode(metadata.PublicKey)),
 			GroupId:              validatorGroupMap[validator].GroupId,
 			Balance:              utils.GWeiToWei(big.NewInt(int64(metadata.Balance))),
+			EffectiveBalance:     utils.GWeiToWei(big.NewInt(int64(metadata.EffectiveBalance))),
 			Status:               metadata.Status,
 			WithdrawalCredential: t.Hash(hexutil.Encode(metadata.WithdrawalCredentials)),
 		}
@@ -0,0 +1,388 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+func (d *DataAccessService) GetValidatorDashboardRole(ctx context.Context, dashboardId t.VDBIdPrimary, userId uint64) (enums.VDBMemberRole, error) {
+	result := struct {
+		OwnerId uint64         `db:"user_id"`
+		Role    sql.NullString `db:"role"`
+	}{}
+	err := d.alloyReader.GetContext(ctx, &result, `
+		SELECT
+			uvd.user_id,
+			uvdm.role
+		FROM users_val_dashboards uvd
+		LEFT JOIN users_val_dashboards_members uvdm ON uvdm.dashboard_id = uvd.id AND uvdm.user_id = $2
+		WHERE uvd.id = $1
+	`, dashboardId, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("%w: dashboard with id %v not found", ErrNotFound, dashboardId)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if result.OwnerId == userId {
+		return enums.VDBMemberRoles.Owner, nil
+	}
+	if !result.Role.Valid {
+		return 0, fmt.Errorf("%w: user %v is not a member of dashboard %v", ErrNotFound, userId, dashboardId)
+	}
+	return enums.VDBMemberRole(0).NewFromString(result.Role.String), nil
+}
+
+func (d *DataAccessService) GetValidatorDashboardMembers(ctx context.Context, dashboardId t.VDBIdPrimary) (*t.VDBMembersData, error) {
+	result := &t.VDBMembersData{
+		Members: []t.VDBMember{},
+		Invites: []t.VDBInvite{},
+	}
+
+	dashboardUser, err := d.GetValidatorDashboardUser(ctx, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+	var members []struct {
+		UserId  uint64    `db:"user_id"`
+		Role    string    `db:"role"`
+		AddedAt time.Time `db:"added_at"`
+	}
+	err = d.alloyReader.SelectContext(ctx, &members, `
+		SELECT user_id, role, added_at
+		FROM users_val_dashboards_members
+		WHERE dashboard_id = $1
+		ORDER BY added_at
+	`, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+
+	userIds := []uint64{dashboardUser.UserId}
+	for _, member := range members {
+		userIds = append(userIds, member.UserId)
+	}
+	emails, err := d.getUserEmails(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+	result.Members = append(result.Members, t.VDBMember{
+		UserId: dashboardUser.UserId,
+		Email:  emails[dashboardUser.UserId],
+		Role:   enums.VDBMemberRoles.Owner.ToString(),
+	})
+	for _, member := range members {
+		result.Members = append(result.Members, t.VDBMember{
+			UserId:  member.UserId,
+			Email:   emails[member.UserId],
+			Role:    member.Role,
+			AddedAt: member.AddedAt.Unix(),
+		})
+	}
+
+	var invites []struct {
+		Email     string    `db:"email"`
+		Role      string    `db:"role"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	err = d.alloyReader.SelectContext(ctx, &invites, `
+		SELECT email, role, created_at
+		FROM users_val_dashboards_invites
+		WHERE dashboard_id = $1
+		ORDER BY created_at
+	`, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+	for _, invite := range invites {
+		result.Invites = append(result.Invites, t.VDBInvite{
+			Email:     invite.Email,
+			Role:      invite.Role,
+			InvitedAt: invite.CreatedAt.Unix(),
+		})
+	}
+
+	result.RequireTwoFactor, err = d.GetValidatorDashboardTwoFactorRequired(ctx, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *DataAccessService) GetValidatorDashboardTwoFactorRequired(ctx context.Context, dashboardId t.VDBIdPrimary) (bool, error) {
+	var required bool
+	err := d.alloyReader.GetContext(ctx, &required, `
+		SELECT require_two_factor
+		FROM users_val_dashboards
+		WHERE id = $1
+	`, dashboardId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, fmt.Errorf("%w: dashboard with id %v not found", ErrNotFound, dashboardId)
+	}
+	return required, err
+}
+
+func (d *DataAccessService) UpdateValidatorDashboardTwoFactorRequired(ctx context.Context, dashboardId t.VDBIdPrimary, required bool) error {
+	_, err := d.alloyWriter.ExecContext(ctx, `
+		UPDATE users_val_dashboards
+		SET require_two_factor = $2
+		WHERE id = $1
+	`, dashboardId, required)
+	return err
+}
+
+// CreateValidatorDashboardInvite stores an invite, an existing invite for the same email is replaced (and its old token invalidated)
+func (d *DataAccessService) CreateValidatorDashboardInvite(ctx context.Context, dashboardId t.VDBIdPrimary, invitedBy uint64, email string, role enums.VDBMemberRole, token string) error {
+	_, err := d.alloyWriter.ExecContext(ctx, `
+		INSERT INTO users_val_dashboards_invites (token, dashboard_id, email, role, invited_by)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (dashboard_id, email) DO UPDATE SET
+			token = EXCLUDED.token,
+			role = EXCLUDED.role,
+			invited_by = EXCLUDED.invited_by,
+			created_at = NOW()
+	`, token, dashboardId, strings.ToLower(email), role.ToString(), invitedBy)
+	return err
+}
+
+func (d *DataAccessService) RemoveValidatorDashboardInvite(ctx context.Context, dashboardId t.VDBIdPrimary, email string) error {
+	result, err := d.alloyWriter.ExecContext(ctx, `
+		DELETE FROM users_val_dashboards_invites
+		WHERE dashboard_id = $1 AND email = $2
+	`, dashboardId, strings.ToLower(email))
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: invite for %v not found", ErrNotFound, email)
+	}
+	return nil
+}
+
+func (d *DataAccessService) GetValidatorDashboardInvite(ctx context.Context, token string) (*t.VDBInviteInfo, error) {
+	result := &t.VDBInviteInfo{}
+	err := d.alloyReader.GetContext(ctx, result, `
+		SELECT dashboard_id, email, role, created_at
+		FROM users_val_dashboards_invites
+		WHERE token = $1
+	`, token)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: invite not found", ErrNotFound)
+	}
+	return result, err
+}
+
+// AcceptValidatorDashboardInvite adds the user as a member with the role of the invite and removes the invite.
+// The role of an existing member is left untouched, it can only be changed through UpdateValidatorDashboardMember.
+func (d *DataAccessService) AcceptValidatorDashboardInvite(ctx context.Context, token string, userId uint64) error {
+	tx, err := d.alloyWriter.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting db transactions to accept dashboard invite: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	invite := struct {
+		DashboardId uint64 `db:"dashboard_id"`
+		Role        string `db:"role"`
+		InvitedBy   uint64 `db:"invited_by"`
+	}{}
+	err = tx.GetContext(ctx, &invite, `
+		DELETE FROM users_val_dashboards_invites
+		WHERE token = $1
+		RETURNING dashboard_id, role, invited_by
+	`, token)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: invite not found", ErrNotFound)
+	}
+	if err != nil {
+		return err
+	}
+
+	// the owner can't be a member of their own dashboard
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO users_val_dashboards_members (dashboard_id, user_id, role, added_by)
+		SELECT id, $2, $3, $4
+		FROM users_val_dashboards
+		WHERE id = $1 AND user_id != $2
+		ON CONFLICT (dashboard_id, user_id) DO NOTHING
+	`, invite.DashboardId, userId, invite.Role, invite.InvitedBy)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (d *DataAccessService) UpdateValidatorDashboardMember(ctx context.Context, dashboardId t.VDBIdPrimary, userId uint64, role enums.VDBMemberRole) error {
+	result, err := d.alloyWriter.ExecContext(ctx, `
+		UPDATE users_val_dashboards_members
+		SET role = $3
+		WHERE dashboard_id = $1 AND user_id = $2
+	`, dashboardId, userId, role.ToString())
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: user %v is not a member of dashboard %v", ErrNotFound, userId, dashboardId)
+	}
+	return nil
+}
+
+func (d *DataAccessService) RemoveValidatorDashboardMember(ctx context.Context, dashboardId t.VDBIdPrimary, userId uint64) error {
+	result, err := d.alloyWriter.ExecContext(ctx, `
+		DELETE FROM users_val_dashboards_members
+		WHERE dashboard_id = $1 AND user_id = $2
+	`, dashboardId, userId)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: user %v is not a member of dashboard %v", ErrNotFound, userId, dashboardId)
+	}
+	return nil
+}
+
+// AddValidatorDashboardAuditLogEntry records a change of the validators or groups of a dashboard.
+// groupId and validators are optional, a nil validator list on removals means that all validators of the group were removed.
+func (d *DataAccessService) AddValidatorDashboardAuditLogEntry(ctx context.Context, dashboardId t.VDBIdPrimary, userId uint64, action string, groupId *uint64, validators []t.VDBValidator) error {
+	var validatorsParam interface{}
+	if validators != nil {
+		validatorsParam = pq.Array(validators)
+	}
+	_, err := d.alloyWriter.ExecContext(ctx, `
+		INSERT INTO users_val_dashboards_audit_log (dashboard_id, user_id, action, group_id, validators)
+		VALUES ($1, $2, $3, $4, $5)
+	`, dashboardId, userId, action, groupId, validatorsParam)
+	return err
+}
+
+func (d *DataAccessService) GetValidatorDashboardAuditLog(ctx context.Context, dashboardId t.VDBIdPrimary, cursor string, limit uint64) ([]t.VDBAuditLogTableRow, *t.Paging, error) {
+	var err error
+	var currentCursor t.VDBAuditLogCursor
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.VDBAuditLogCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as VDBAuditLogCursor: %w", err)
+		}
+	}
+
+	var data []struct {
+		Id         uint64        `db:"id"`
+		UserId     uint64        `db:"user_id"`
+		Action     string        `db:"action"`
+		GroupId    sql.NullInt64 `db:"group_id"`
+		Validators pq.Int64Array `db:"validators"`
+		Ts         time.Time     `db:"ts"`
+	}
+	query := `
+		SELECT id, user_id, action, group_id, validators, ts
+		FROM users_val_dashboards_audit_log
+		WHERE dashboard_id = $1`
+	params := []interface{}{dashboardId}
+	// newest entries first
+	filterFragment := ` ORDER BY id DESC`
+	if currentCursor.IsValid() {
+		filterFragment = ` AND id < $2` + filterFragment
+		params = append(params, currentCursor.Id)
+	}
+	if currentCursor.IsReverse() {
+		filterFragment = strings.Replace(strings.Replace(filterFragment, "<", ">", -1), "DESC", "ASC", -1)
+	}
+	params = append(params, limit+1)
+	filterFragment += fmt.Sprintf(" LIMIT $%d", len(params))
+
+	err = d.alloyReader.SelectContext(ctx, &data, query+filterFragment, params...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) == 0 {
+		return []t.VDBAuditLogTableRow{}, &t.Paging{}, nil
+	}
+
+	moreDataFlag := len(data) > int(limit)
+	if moreDataFlag {
+		// Remove the last entry as it is only required for the more data flag
+		data = data[:len(data)-1]
+	}
+	if currentCursor.IsReverse() {
+		// Invert query result so response matches requested direction
+		slices.Reverse(data)
+	}
+
+	userIds := make([]uint64, 0, len(data))
+	for _, row := range data {
+		userIds = append(userIds, row.UserId)
+	}
+	emails, err := d.getUserEmails(ctx, userIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]t.VDBAuditLogTableRow, 0, len(data))
+	for _, row := range data {
+		entry := t.VDBAuditLogTableRow{
+			Timestamp: row.Ts.Unix(),
+			UserId:    row.UserId,
+			Email:     emails[row.UserId],
+			Action:    row.Action,
+		}
+		if row.GroupId.Valid {
+			groupId := uint64(row.GroupId.Int64)
+			entry.GroupId = &groupId
+		}
+		for _, validator := range row.Validators {
+			entry.Validators = append(entry.Validators, uint64(validator))
+		}
+		result = append(result, entry)
+	}
+
+	p, err := utils.GetPagingFromData(data, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	if p == nil {
+		p = &t.Paging{}
+	}
+	return result, p, nil
+}
+
+// getUserEmails returns the email addresses of the given users, users that don't exist anymore are omitted
+func (d *DataAccessService) getUserEmails(ctx context.Context, userIds []uint64) (map[uint64]string, error) {
+	var users []struct {
+		Id    uint64 `db:"id"`
+		Email string `db:"email"`
+	}
+	err := d.userReader.SelectContext(ctx, &users, `
+		SELECT id, email FROM users WHERE id = ANY($1)
+	`, pq.Array(userIds))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving user emails: %w", err)
+	}
+	result := make(map[uint64]string, len(users))
+	for _, user := range users {
+		result[user.Id] = user.Email
+	}
+	return result, nil
+}
@@ -0,0 +1,134 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+type mevBidRow struct {
+	Slot             uint64          `db:"slot"`
+	Epoch            uint64          `db:"epoch"`
+	Proposer         uint64          `db:"proposer"`
+	DeliveredByRelay bool            `db:"delivered_by_relay"`
+	DeliveredValue   decimal.Decimal `db:"delivered_value"`
+	BestBid          decimal.Decimal `db:"best_bid"`
+	BestBidRelay     string          `db:"best_bid_relay"`
+	BestBidBuilder   []byte          `db:"best_bid_builder"`
+	GroupId          sql.NullInt64   `db:"group_id"`
+}
+
+// valueLeftOnTable is the amount the best bid exceeded the delivered value by
+func valueLeftOnTable(bestBid, delivered decimal.Decimal) decimal.Decimal {
+	if delivered.GreaterThanOrEqual(bestBid) {
+		return decimal.Zero
+	}
+	return bestBid.Sub(delivered)
+}
+
+// GetValidatorDashboardMevBids compares the proposals of the dashboard validators with the best bid the relays received
+// for the slot. only bids on the parent of the proposed block are considered and slots without exported bids are skipped.
+func (d *DataAccessService) GetValidatorDashboardMevBids(ctx context.Context, dashboardId t.VDBId, cursor string, limit uint64) ([]t.VDBMevBidsTableRow, *t.Paging, error) {
+	var currentCursor t.MevBidsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.MevBidsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as MevBidsCursor: %w", err)
+		}
+	}
+
+	ds := goqu.Dialect("postgres").
+		Select(
+			goqu.L("b.slot"),
+			goqu.L("b.epoch"),
+			goqu.L("b.proposer"),
+			goqu.L("rb.value IS NOT NULL AS delivered_by_relay"),
+			goqu.L("COALESCE(rb.value, ROUND(ep.fee_recipient_reward * 1e18), 0) AS delivered_value"),
+			goqu.L("bb.value AS best_bid"),
+			goqu.L("bb.tag_id AS best_bid_relay"),
+			goqu.L("bb.builder_pubkey AS best_bid_builder")).
+		From(goqu.L("blocks b")).
+		InnerJoin(goqu.L("relays_best_bids bb"), goqu.On(goqu.L("bb.slot = b.slot AND bb.parent_hash = b.exec_parent_hash"))).
+		LeftJoin(goqu.L("execution_payloads ep"), goqu.On(goqu.L("ep.block_hash = b.exec_block_hash"))).
+		// relay bribe deduplication; select most likely (=max) relay bribe value for each block
+		LeftJoin(goqu.L("LATERAL (SELECT MAX(value) AS value FROM relays_blocks WHERE relays_blocks.exec_block_hash = b.exec_block_hash) rb"), goqu.On(goqu.L("true"))).
+		Where(goqu.L("b.status = '1'"))
+
+	if dashboardId.Validators != nil {
+		ds = ds.Where(goqu.L("b.proposer = ANY(?)", pq.Array(dashboardId.Validators)))
+	} else {
+		ds = ds.
+			SelectAppend(goqu.L("uvdv.group_id")).
+			InnerJoin(goqu.L("users_val_dashboards_validators uvdv"), goqu.On(goqu.L("uvdv.validator_index = b.proposer"))).
+			Where(goqu.L("uvdv.dashboard_id = ?", dashboardId.Id))
+	}
+
+	if currentCursor.IsValid() {
+		if currentCursor.IsReverse() {
+			ds = ds.Where(goqu.L("b.slot > ?", currentCursor.Slot))
+		} else {
+			ds = ds.Where(goqu.L("b.slot < ?", currentCursor.Slot))
+		}
+	}
+	if currentCursor.IsReverse() {
+		ds = ds.Order(goqu.L("b.slot").Asc())
+	} else {
+		ds = ds.Order(goqu.L("b.slot").Desc())
+	}
+	ds = ds.Limit(uint(limit + 1))
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing query: %w", err)
+	}
+	var rows []mevBidRow
+	err = d.alloyReader.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving mev bids of dashboard proposals: %w", err)
+	}
+
+	var paging t.Paging
+	moreDataFlag := len(rows) > int(limit)
+	if moreDataFlag {
+		rows = rows[:len(rows)-1]
+	}
+	if currentCursor.IsReverse() {
+		slices.Reverse(rows)
+	}
+
+	result := make([]t.VDBMevBidsTableRow, len(rows))
+	cursors := make([]t.MevBidsCursor, len(rows))
+	for i, row := range rows {
+		result[i] = t.VDBMevBidsTableRow{
+			Slot:             row.Slot,
+			Epoch:            row.Epoch,
+			GroupId:          dashboardGroupId(dashboardId, row.GroupId.Int64),
+			Proposer:         row.Proposer,
+			DeliveredByRelay: row.DeliveredByRelay,
+			DeliveredValue:   row.DeliveredValue,
+			BestBid:          row.BestBid,
+			BestBidRelay:     row.BestBidRelay,
+			BestBidBuilder:   t.PubKey(hexutil.Encode(row.BestBidBuilder)),
+			ValueLeft:        valueLeftOnTable(row.BestBid, row.DeliveredValue),
+		}
+		cursors[i].Slot = row.Slot
+	}
+	if (!moreDataFlag && !currentCursor.IsValid()) || len(rows) == 0 {
+		// no paging required
+		return result, &paging, nil
+	}
+	p, err := utils.GetPagingFromData(cursors, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	return result, p, nil
+}
@@ -0,0 +1,121 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/lib/pq"
+	"golang.org/x/sync/errgroup"
+)
+
+// selectPendingQueueRows returns all rows of the given pending queue query, front of the queue first
+func selectPendingQueueRows[T any](ctx context.Context, d *DataAccessService, ds *goqu.SelectDataset) ([]T, error) {
+	query, args, err := ds.Order(goqu.L("q.queue_index").Asc()).Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("error preparing query: %w", err)
+	}
+	var rows []T
+	err = d.alloyReader.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving pending queue: %w", err)
+	}
+	return rows, nil
+}
+
+// the queue items of a dashboard are matched by the validator index, a consolidation is part of the dashboard if either its source or its target validator is
+func (d *DataAccessService) GetValidatorDashboardPendingQueues(ctx context.Context, dashboardId t.VDBId) (*t.VDBPendingQueuesData, error) {
+	result := &t.VDBPendingQueuesData{
+		Deposits:           []t.VDBPendingDepositsTableRow{},
+		PartialWithdrawals: []t.VDBPendingPartialWithdrawalsTableRow{},
+		Consolidations:     []t.VDBPendingConsolidationsTableRow{},
+	}
+	snapshot, err := d.getPendingQueuesSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return result, nil
+	}
+	result.Epoch = snapshot.Epoch
+
+	depositsDs := pendingDepositsDs(snapshot.Epoch)
+	partialWithdrawalsDs := pendingPartialWithdrawalsDs(snapshot.Epoch)
+	consolidationsDs := pendingConsolidationsDs(snapshot.Epoch)
+	if dashboardId.Validators != nil {
+		validators := pq.Array(dashboardId.Validators)
+		depositsDs = depositsDs.Where(goqu.L("v.validatorindex = ANY(?)", validators))
+		partialWithdrawalsDs = partialWithdrawalsDs.Where(goqu.L("q.validator_index = ANY(?)", validators))
+		consolidationsDs = consolidationsDs.Where(goqu.L("(q.source_index = ANY(?) OR q.target_index = ANY(?))", validators, validators))
+	} else {
+		depositsDs = depositsDs.
+			SelectAppend(goqu.L("uvdv.group_id")).
+			InnerJoin(goqu.L("users_val_dashboards_validators uvdv"), goqu.On(goqu.L("uvdv.validator_index = v.validatorindex"))).
+			Where(goqu.L("uvdv.dashboard_id = ?", dashboardId.Id))
+		partialWithdrawalsDs = partialWithdrawalsDs.
+			SelectAppend(goqu.L("uvdv.group_id")).
+			InnerJoin(goqu.L("users_val_dashboards_validators uvdv"), goqu.On(goqu.L("uvdv.validator_index = q.validator_index"))).
+			Where(goqu.L("uvdv.dashboard_id = ?", dashboardId.Id))
+		consolidationsDs = consolidationsDs.
+			SelectAppend(goqu.L("COALESCE(us.group_id, ut.group_id) AS group_id")).
+			LeftJoin(goqu.L("users_val_dashboards_validators us"), goqu.On(goqu.L("us.validator_index = q.source_index AND us.dashboard_id = ?", dashboardId.Id))).
+			LeftJoin(goqu.L("users_val_dashboards_validators ut"), goqu.On(goqu.L("ut.validator_index = q.target_index AND ut.dashboard_id = ?", dashboardId.Id))).
+			Where(goqu.L("(us.validator_index IS NOT NULL OR ut.validator_index IS NOT NULL)"))
+	}
+
+	var deposits []pendingDepositRow
+	var partialWithdrawals []pendingPartialWithdrawalRow
+	var consolidations []pendingConsolidationRow
+	wg := errgroup.Group{}
+	wg.Go(func() error {
+		var err error
+		deposits, err = selectPendingQueueRows[pendingDepositRow](ctx, d, depositsDs)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		partialWithdrawals, err = selectPendingQueueRows[pendingPartialWithdrawalRow](ctx, d, partialWithdrawalsDs)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		consolidations, err = selectPendingQueueRows[pendingConsolidationRow](ctx, d, consolidationsDs)
+		return err
+	})
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	for _, row := range deposits {
+		result.Deposits = append(result.Deposits, t.VDBPendingDepositsTableRow{
+			Position:           row.QueueIndex,
+			GroupId:            dashboardGroupId(dashboardId, row.GroupId.Int64),
+			Validator:          executionRequestValidator(row.Pubkey, row.ValidatorIndex),
+			Amount:             gweiToWei(row.Amount),
+			EstimatedEpoch:     row.EstimatedEpoch,
+			EstimatedTimestamp: epochTimestamp(row.EstimatedEpoch),
+		})
+	}
+	for _, row := range partialWithdrawals {
+		result.PartialWithdrawals = append(result.PartialWithdrawals, t.VDBPendingPartialWithdrawalsTableRow{
+			Position:           row.QueueIndex,
+			GroupId:            dashboardGroupId(dashboardId, row.GroupId.Int64),
+			Index:              row.ValidatorIndex,
+			Amount:             gweiToWei(row.Amount),
+			EstimatedEpoch:     row.EstimatedEpoch,
+			EstimatedTimestamp: epochTimestamp(row.EstimatedEpoch),
+		})
+	}
+	for _, row := range consolidations {
+		consolidation := t.VDBPendingConsolidationsTableRow{
+			Position:    row.QueueIndex,
+			GroupId:     dashboardGroupId(dashboardId, row.GroupId.Int64),
+			SourceIndex: row.SourceIndex,
+			TargetIndex: row.TargetIndex,
+		}
+		consolidation.EstimatedEpoch, consolidation.EstimatedTimestamp = pendingConsolidationEstimate(row.EstimatedEpoch)
+		result.Consolidations = append(result.Consolidations, consolidation)
+	}
+	return result, nil
+}
@@ -0,0 +1,243 @@
+package dataaccess
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+// protocolValidator is a dashboard validator that is operated for a staking protocol, Fee is the share of its
+// rewards that is kept by the protocol (treasury and operator fee combined)
+type protocolValidator struct {
+	ValidatorIndex t.VDBValidator `db:"validator_index"`
+	GroupId        int64          `db:"group_id"`
+	Fee            float64        `db:"fee"`
+}
+
+// protocolFees holds the fees the staking protocols take from the rewards of the dashboard validators per epoch and group,
+// Cl is in gwei and El in wei like the rewards they are deducted from
+type protocolFees struct {
+	Cl map[uint64]map[int64]int64
+	El map[uint64]map[int64]decimal.Decimal
+}
+
+func newProtocolFees() *protocolFees {
+	return &protocolFees{
+		Cl: make(map[uint64]map[int64]int64),
+		El: make(map[uint64]map[int64]decimal.Decimal),
+	}
+}
+
+func (f *protocolFees) add(epoch uint64, groupId int64, clFee int64, elFee decimal.Decimal) {
+	if _, ok := f.Cl[epoch]; !ok {
+		f.Cl[epoch] = make(map[int64]int64)
+		f.El[epoch] = make(map[int64]decimal.Decimal)
+	}
+	f.Cl[epoch][groupId] += clFee
+	f.El[epoch][groupId] = f.El[epoch][groupId].Add(elFee)
+}
+
+// addClReward adds the fee of a cl reward of the validator, only positive rewards are subject to a fee
+func (f *protocolFees) addClReward(epoch uint64, validator protocolValidator, reward int64) {
+	if reward > 0 {
+		f.add(epoch, validator.GroupId, int64(float64(reward)*validator.Fee), decimal.Zero)
+	}
+}
+
+// addElReward adds the fee of an el reward of the validator, only positive rewards are subject to a fee
+func (f *protocolFees) addElReward(epoch uint64, validator protocolValidator, reward decimal.Decimal) {
+	if reward.IsPositive() {
+		f.add(epoch, validator.GroupId, 0, reward.Mul(decimal.NewFromFloat(validator.Fee)).Floor())
+	}
+}
+
+// getProtocolValidators returns the validators of the dashboard that belong to one of the staking protocols of the protocol modes
+func (d *DataAccessService) getProtocolValidators(ctx context.Context, dashboardId t.VDBId, protocolModes t.VDBProtocolModes) (map[t.VDBValidator]protocolValidator, error) {
+	result := make(map[t.VDBValidator]protocolValidator)
+	if len(protocolModes.StakingProtocols) == 0 {
+		return result, nil
+	}
+
+	ds := goqu.Dialect("postgres").
+		Select(
+			goqu.L("spv.validator_index"),
+			goqu.L("(sp.treasury_fee + sp.operator_fee)::float AS fee")).
+		From(goqu.L("staking_protocol_validators spv")).
+		InnerJoin(goqu.L("staking_protocols sp"), goqu.On(goqu.L("sp.protocol = spv.protocol"))).
+		Where(goqu.L("spv.protocol = ANY(?)", pq.Array(protocolModes.StakingProtocols)))
+
+	if dashboardId.Validators == nil {
+		ds = ds.
+			SelectAppend(goqu.L("uvdv.group_id")).
+			InnerJoin(goqu.L("users_val_dashboards_validators uvdv"), goqu.On(goqu.L("uvdv.validator_index = spv.validator_index"))).
+			Where(goqu.L("uvdv.dashboard_id = ?", dashboardId.Id))
+	} else {
+		ds = ds.
+			Where(goqu.L("spv.validator_index = ANY(?)", pq.Array(dashboardId.Validators)))
+	}
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("error preparing query: %w", err)
+	}
+
+	var validators []protocolValidator
+	err = d.alloyReader.SelectContext(ctx, &validators, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving staking protocol validators: %w", err)
+	}
+
+	for _, validator := range validators {
+		if dashboardId.Validators != nil || dashboardId.AggregateGroups {
+			validator.GroupId = t.DefaultGroupId
+		}
+		result[validator.ValidatorIndex] = validator
+	}
+	return result, nil
+}
+
+// getProtocolFeesForPeriod returns the fees of the protocol validators for the given period table, the fees are returned
+// for epoch 0. Only positive rewards are subject to a fee.
+func (d *DataAccessService) getProtocolFeesForPeriod(ctx context.Context, validators map[t.VDBValidator]protocolValidator, clickhouseTable string, epochMin, epochMax int64) (*protocolFees, error) {
+	fees := newProtocolFees()
+	if len(validators) == 0 {
+		return fees, nil
+	}
+	indices := make([]t.VDBValidator, 0, len(validators))
+	for index := range validators {
+		indices = append(indices, index)
+	}
+
+	ds := goqu.Dialect("postgres").
+		From(goqu.L(fmt.Sprintf(`%s AS r FINAL`, clickhouseTable))).
+		Select(
+			goqu.L("r.validator_index"),
+			goqu.L("(SUM(COALESCE(r.balance_end,0)) + SUM(COALESCE(r.withdrawals_amount,0)) - SUM(COALESCE(r.deposits_amount,0)) - SUM(COALESCE(r.balance_start,0))) AS cl_rewards")).
+		Where(goqu.L("r.validator_index IN ?", indices)).
+		GroupBy(goqu.L("r.validator_index"))
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("error preparing query: %w", err)
+	}
+
+	var clRewards []struct {
+		ValidatorIndex t.VDBValidator `db:"validator_index"`
+		ClRewards      int64          `db:"cl_rewards"`
+	}
+	err = d.clickhouseReader.SelectContext(ctx, &clRewards, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving cl rewards of staking protocol validators from table %s: %w", clickhouseTable, err)
+	}
+	for _, reward := range clRewards {
+		fees.addClReward(0, validators[reward.ValidatorIndex], reward.ClRewards)
+	}
+
+	elRewards, err := d.getProtocolElRewards(ctx, indices, epochMin, epochMax)
+	if err != nil {
+		return nil, err
+	}
+	for _, reward := range elRewards {
+		fees.addElReward(0, validators[reward.Proposer], reward.ElRewards)
+	}
+	return fees, nil
+}
+
+// getProtocolFeesPerEpoch returns the fees of the protocol validators for every epoch starting at startEpoch.
+// Only positive rewards are subject to a fee.
+func (d *DataAccessService) getProtocolFeesPerEpoch(ctx context.Context, validators map[t.VDBValidator]protocolValidator, startEpoch uint64) (*protocolFees, error) {
+	fees := newProtocolFees()
+	if len(validators) == 0 {
+		return fees, nil
+	}
+	indices := make([]t.VDBValidator, 0, len(validators))
+	for index := range validators {
+		indices = append(indices, index)
+	}
+
+	ds := goqu.Dialect("postgres").
+		From(goqu.L("validator_dashboard_data_epoch e")).
+		Select(
+			goqu.L("e.epoch"),
+			goqu.L("e.validator_index"),
+			goqu.L(`SUM(COALESCE(e.attestations_reward, 0) + COALESCE(e.blocks_cl_reward, 0) + COALESCE(e.sync_rewards, 0)) AS cl_rewards`)).
+		Where(goqu.L("e.epoch_timestamp >= fromUnixTimestamp(?)", utils.EpochToTime(startEpoch).Unix())).
+		Where(goqu.L("e.validator_index IN ?", indices)).
+		GroupBy(goqu.L("e.epoch"), goqu.L("e.validator_index"))
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("error preparing query: %w", err)
+	}
+
+	var clRewards []struct {
+		Epoch          uint64         `db:"epoch"`
+		ValidatorIndex t.VDBValidator `db:"validator_index"`
+		ClRewards      int64          `db:"cl_rewards"`
+	}
+	err = d.clickhouseReader.SelectContext(ctx, &clRewards, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving cl rewards of staking protocol validators: %w", err)
+	}
+	for _, reward := range clRewards {
+		fees.addClReward(reward.Epoch, validators[reward.ValidatorIndex], reward.ClRewards)
+	}
+
+	elRewards, err := d.getProtocolElRewards(ctx, indices, int64(startEpoch), -1)
+	if err != nil {
+		return nil, err
+	}
+	for _, reward := range elRewards {
+		fees.addElReward(reward.Epoch, validators[reward.Proposer], reward.ElRewards)
+	}
+	return fees, nil
+}
+
+type protocolElReward struct {
+	Epoch     uint64          `db:"epoch"`
+	Proposer  t.VDBValidator  `db:"proposer"`
+	ElRewards decimal.Decimal `db:"el_rewards"`
+}
+
+// getProtocolElRewards returns the el rewards of the given proposers per epoch, a negative epochMax means no upper bound
+func (d *DataAccessService) getProtocolElRewards(ctx context.Context, proposers []t.VDBValidator, epochMin, epochMax int64) ([]protocolElReward, error) {
+	ds := goqu.Dialect("postgres").
+		Select(
+			goqu.L("b.epoch"),
+			goqu.L("b.proposer"),
+			goqu.L("SUM(COALESCE(rb.value, ep.fee_recipient_reward * 1e18, 0)) AS el_rewards")).
+		From(goqu.L("blocks b")).
+		LeftJoin(goqu.L("execution_payloads ep"), goqu.On(goqu.L("ep.block_hash = b.exec_block_hash"))).
+		LeftJoin(
+			goqu.Lateral(goqu.Dialect("postgres").
+				From("relays_blocks").
+				Select(
+					goqu.L("exec_block_hash"),
+					goqu.MAX("value").As("value")).
+				Where(goqu.L("relays_blocks.exec_block_hash = b.exec_block_hash")).
+				GroupBy("exec_block_hash")).As("rb"),
+			goqu.On(goqu.L("rb.exec_block_hash = b.exec_block_hash")),
+		).
+		Where(goqu.L("b.proposer = ANY(?) AND b.epoch >= ? AND b.status = '1'", pq.Array(proposers), epochMin)).
+		GroupBy(goqu.L("b.epoch"), goqu.L("b.proposer"))
+	if epochMax >= 0 {
+		ds = ds.Where(goqu.L("b.epoch <= ?", epochMax))
+	}
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("error preparing query: %w", err)
+	}
+
+	var result []protocolElReward
+	err = d.alloyReader.SelectContext(ctx, &result, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving el rewards of staking protocol validators: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,49 @@
+package dataaccess
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestProtocolFees(test *testing.T) {
+	lido := protocolValidator{ValidatorIndex: 1, GroupId: 1, Fee: 0.1}
+	etherFi := protocolValidator{ValidatorIndex: 2, GroupId: 1, Fee: 0.25}
+	lidoOtherGroup := protocolValidator{ValidatorIndex: 3, GroupId: 2, Fee: 0.1}
+
+	fees := newProtocolFees()
+	// fees of the validators of a group add up, fractions of a gwei or wei are kept by the validator
+	fees.addClReward(5, lido, 1005)
+	fees.addClReward(5, etherFi, 1000)
+	fees.addElReward(5, lido, decimal.RequireFromString("1000000000000000005"))
+	fees.addElReward(5, etherFi, decimal.RequireFromString("2000000000000000000"))
+	// penalties and missed rewards are not subject to a fee
+	fees.addClReward(5, lidoOtherGroup, -500)
+	fees.addElReward(5, lidoOtherGroup, decimal.RequireFromString("-1000"))
+	fees.addClReward(6, lido, 0)
+	fees.addElReward(6, lido, decimal.Zero)
+	fees.addClReward(7, lidoOtherGroup, 2000)
+
+	if fee := fees.Cl[5][1]; fee != 350 {
+		test.Errorf("expected a cl fee of 350 gwei for group 1 in epoch 5, got %v", fee)
+	}
+	if fee := fees.El[5][1]; !fee.Equal(decimal.RequireFromString("600000000000000000")) {
+		test.Errorf("expected an el fee of 0.6 eth for group 1 in epoch 5, got %v", fee)
+	}
+	if fee, ok := fees.Cl[5][2]; ok {
+		test.Errorf("expected no cl fee for group 2 in epoch 5, got %v", fee)
+	}
+	if fee, ok := fees.El[5][2]; ok {
+		test.Errorf("expected no el fee for group 2 in epoch 5, got %v", fee)
+	}
+	if _, ok := fees.Cl[6]; ok {
+		test.Errorf("expected no fees in epoch 6")
+	}
+	if fee := fees.Cl[7][2]; fee != 200 {
+		test.Errorf("expected a cl fee of 200 gwei for group 2 in epoch 7, got %v", fee)
+	}
+	if fee := fees.El[7][2]; !fee.IsZero() {
+		test.Errorf("expected no el fee for group 2 in epoch 7, got %v", fee)
+	}
+
+}
@@ -22,8 +22,8 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-func (d *DataAccessService) GetValidatorDashboardRewards(ctx context.Context, dashboardId t.VDBId, cursor string, colSort t.Sort[enums.VDBRewardsColumn], search string, limit uint64, protocolModes t.VDBProtocolModes) ([]t.VDBRewardsTableRow, *t.Paging, error) {
-	// @DATA-ACCESS incorporate protocolModes
+func (d *DataAccessService) GetValidatorDashboardRewards(ctx context.Context, dashboardId t.VDBId, cursor string, colSort t.Sort[enums.VDBRewardsColumn], search string, limit uint64, protocolModes t.VDBProtocolModes, credentialTypes []uint8) ([]t.VDBRewardsTableRow, *t.Paging, error) {
+	// @DATA-ACCESS incorporate rocket pool protocol mode
 	result := make([]t.VDBRewardsTableRow, 0)
 	var paging t.Paging
 
@@ -77,6 +77,15 @@ func (d *DataAccessService) GetValidatorDashboardRewards(ctx context.Context, da
 		startEpoch = latestFinalizedEpoch - epochLookBack
 	}
 
+	// Restrict the validators to the requested withdrawal credential types
+	credentialValidators, err := d.getCredentialTypeValidators(ctx, dashboardId, credentialTypes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if credentialValidators != nil && len(credentialValidators) == 0 {
+		return result, &paging, nil
+	}
+
 	groupIdSearchMap := make(map[uint64]bool, 0)
 
 	// ------------------------------------------------------------------------------------------------------------------
@@ -116,6 +125,13 @@ func (d *DataAccessService) GetValidatorDashboardRewards(ctx context.Context, da
 			goqu.On(goqu.L("rb.exec_block_hash = b.exec_block_hash")),
 		)
 
+	if credentialValidators != nil {
+		rewardsDs = rewardsDs.
+			Where(goqu.L("e.validator_index IN ?", credentialValidators))
+		elDs = elDs.
+			Where(goqu.L("b.proposer = ANY(?)", pq.Array(credentialValidators)))
+	}
+
 	if dashboardId.Validators == nil {
 		rewardsDs = rewardsDs.
 			InnerJoin(goqu.L("validators v"), goqu.On(goqu.L("e.validator_index = v.validator_index"))).
@@ -356,6 +372,28 @@ func (d *DataAccessService) GetValidatorDashboardRewards(ctx context.Context, da
 		return nil, nil, fmt.Errorf("error retrieving validator dashboard rewards data: %w", err)
 	}
 
+	// ------------------------------------------------------------------------------------------------------------------
+	// Deduct the fees of the staking protocols from the rewards
+	protocolValidators, err := d.getProtocolValidators(ctx, dashboardId, protocolModes)
+	if err != nil {
+		return nil, nil, err
+	}
+	fees, err := d.getProtocolFeesPerEpoch(ctx, protocolValidators, startEpoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range queryResult {
+		queryResult[i].ClRewards -= fees.Cl[queryResult[i].Epoch][queryResult[i].GroupId]
+	}
+	for epoch, groupFees := range fees.El {
+		if _, ok := elRewards[epoch]; !ok {
+			elRewards[epoch] = make(map[int64]decimal.Decimal)
+		}
+		for groupId, fee := range groupFees {
+			elRewards[epoch][groupId] = elRewards[epoch][groupId].Sub(fee)
+		}
+	}
+
 	// ------------------------------------------------------------------------------------------------------------------
 	// Create the result without the total rewards first
 	resultWoTotal := make([]t.VDBRewardsTableRow, 0)
@@ -705,7 +743,7 @@ func (d *DataAccessService) GetValidatorDashboardGroupRewards(ctx context.Contex
 	return ret, nil
 }
 
-func (d *DataAccessService) GetValidatorDashboardRewardsChart(ctx context.Context, dashboardId t.VDBId, protocolModes t.VDBProtocolModes) (*t.ChartData[int, decimal.Decimal], error) {
+func (d *DataAccessService) GetValidatorDashboardRewardsChart(ctx context.Context, dashboardId t.VDBId, protocolModes t.VDBProtocolModes, credentialTypes []uint8) (*t.ChartData[int, decimal.Decimal], error) {
 	// @DATA-ACCESS incorporate protocolModes
 	// bar chart for the CL and EL rewards for each group for each epoch.
 	// NO series for all groups combined except if AggregateGroups is true.
@@ -713,6 +751,15 @@ func (d *DataAccessService) GetValidatorDashboardRewardsChart(ctx context.Contex
 
 	wg := errgroup.Group{}
 
+	// Restrict the validators to the requested withdrawal credential types
+	credentialValidators, err := d.getCredentialTypeValidators(ctx, dashboardId, credentialTypes)
+	if err != nil {
+		return nil, err
+	}
+	if credentialValidators != nil && len(credentialValidators) == 0 {
+		return &t.ChartData[int, decimal.Decimal]{}, nil
+	}
+
 	latestFinalizedEpoch := cache.LatestFinalizedEpoch.Get()
 	const epochLookBack = 224
 	startEpoch := uint64(0)
@@ -749,6 +796,13 @@ func (d *DataAccessService) GetValidatorDashboardRewardsChart(ctx context.Contex
 		).
 		Where(goqu.L("b.epoch >= ?", startEpoch))
 
+	if credentialValidators != nil {
+		rewardsDs = rewardsDs.
+			Where(goqu.L("e.validator_index IN ?", credentialValidators))
+		elDs = elDs.
+			Where(goqu.L("b.proposer = ANY(?)", pq.Array(credentialValidators)))
+	}
+
 	if dashboardId.Validators == nil {
 		rewardsDs = rewardsDs.
 			InnerJoin(goqu.L("validators v"), goqu.On(goqu.L("e.validator_index = v.validator_index"))).
@@ -839,7 +893,7 @@ func (d *DataAccessService) GetValidatorDashboardRewardsChart(ctx context.Contex
 		return nil
 	})
 
-	err := wg.Wait()
+	err = wg.Wait()
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving validator dashboard rewards chart data: %w", err)
 	}
@@ -1105,6 +1159,18 @@ func (d *DataAccessService) GetValidatorDashboardDuties(ctx context.Context, das
 		return nil, nil, fmt.Errorf("error retrieving validator dashboard rewards data: %w", err)
 	}
 
+	ssvOperators := make(map[uint64][]uint64)
+	if protocolModes.Ssv && len(queryResult) > 0 {
+		validators := make([]t.VDBValidator, len(queryResult))
+		for i, res := range queryResult {
+			validators[i] = res.ValidatorIndex
+		}
+		ssvOperators, err = d.getSsvOperatorsOfValidators(ctx, validators)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// ------------------------------------------------------------------------------------------------------------------
 	// Create the result
 	cursorData := make([]t.ValidatorDutiesCursor, 0)
@@ -1116,7 +1182,8 @@ func (d *DataAccessService) GetValidatorDashboardDuties(ctx context.Context, das
 		totalReward := clReward.Add(elRewards[res.ValidatorIndex])
 
 		row := t.VDBEpochDutiesTableRow{
-			Validator: res.ValidatorIndex,
+			Validator:    res.ValidatorIndex,
+			SsvOperators: ssvOperators[res.ValidatorIndex],
 		}
 
 		// Get attestation data
@@ -2,22 +2,479 @@ package dataaccess
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/doug-martin/goqu/v9"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
 )
 
-func (d *DataAccessService) GetValidatorDashboardRocketPool(ctx context.Context, dashboardId t.VDBId, cursor string, colSort t.Sort[enums.VDBRocketPoolColumn], search string, limit uint64) ([]t.VDBRocketPoolTableRow, *t.Paging, error) {
-	// TODO @DATA-ACCESS
-	return d.dummy.GetValidatorDashboardRocketPool(ctx, dashboardId, cursor, colSort, search, limit)
+type rocketPoolDashboardMinipool struct {
+	Address        []byte          `db:"address"`
+	Node           []byte          `db:"node_address"`
+	ValidatorIndex uint64          `db:"validator_index"`
+	GroupId        uint64          `db:"group_id"`
+	Status         string          `db:"status"`
+	StatusTime     sql.NullTime    `db:"status_time"`
+	NodeFee        float64         `db:"node_fee"`
+	PenaltyCount   uint64          `db:"penalty_count"`
+	NodeDeposit    decimal.Decimal `db:"node_deposit_balance"`
+	UserDeposit    decimal.Decimal `db:"user_deposit_balance"`
 }
 
-func (d *DataAccessService) GetValidatorDashboardTotalRocketPool(ctx context.Context, dashboardId t.VDBId, search string) (*t.VDBRocketPoolTableRow, error) {
-	// TODO @DATA-ACCESS
-	return d.dummy.GetValidatorDashboardTotalRocketPool(ctx, dashboardId, search)
+// only minipools that are prelaunched or staking count towards the bonded and borrowed eth of a node
+func (m rocketPoolDashboardMinipool) isActive() bool {
+	return m.Status == "Prelaunch" || m.Status == "Staking"
 }
 
-func (d *DataAccessService) GetValidatorDashboardRocketPoolMinipools(ctx context.Context, dashboardId t.VDBId, node, cursor string, colSort t.Sort[enums.VDBRocketPoolMinipoolsColumn], search string, limit uint64) ([]t.VDBRocketPoolMinipoolsTableRow, *t.Paging, error) {
-	// TODO @DATA-ACCESS
-	return d.dummy.GetValidatorDashboardRocketPoolMinipools(ctx, dashboardId, node, cursor, colSort, search, limit)
+type rocketPoolNode struct {
+	Address                []byte          `db:"address"`
+	Timezone               string          `db:"timezone_location"`
+	RplStake               decimal.Decimal `db:"rpl_stake"`
+	MinRplStake            decimal.Decimal `db:"min_rpl_stake"`
+	MaxRplStake            decimal.Decimal `db:"max_rpl_stake"`
+	RplCumulativeRewards   decimal.Decimal `db:"rpl_cumulative_rewards"`
+	SmoothingPoolOptedIn   bool            `db:"smoothing_pool_opted_in"`
+	ClaimedSmoothingPool   decimal.Decimal `db:"claimed_smoothing_pool"`
+	UnclaimedSmoothingPool decimal.Decimal `db:"unclaimed_smoothing_pool"`
+	UnclaimedRplRewards    decimal.Decimal `db:"unclaimed_rpl_rewards"`
+	EffectiveRplStake      decimal.Decimal `db:"effective_rpl_stake"`
+	DepositCredit          decimal.Decimal `db:"deposit_credit"`
+}
+
+func (d *DataAccessService) getRocketPoolDashboardMinipools(ctx context.Context, dashboardId t.VDBId, groupId int64) ([]rocketPoolDashboardMinipool, error) {
+	ds := goqu.Dialect("postgres").
+		Select(
+			goqu.L("m.address"),
+			goqu.L("m.node_address"),
+			goqu.L("m.validator_index"),
+			goqu.L("m.status"),
+			goqu.L("m.status_time"),
+			goqu.L("m.node_fee"),
+			goqu.L("m.penalty_count"),
+			goqu.L("COALESCE(m.node_deposit_balance, 0) AS node_deposit_balance"),
+			goqu.L("COALESCE(m.user_deposit_balance, 0) AS user_deposit_balance")).
+		From(goqu.L("rocketpool_minipools AS m")).
+		Where(goqu.L("m.validator_index IS NOT NULL"))
+
+	if dashboardId.Validators == nil {
+		ds = ds.
+			SelectAppend(goqu.L("uvdv.group_id")).
+			InnerJoin(goqu.L("users_val_dashboards_validators uvdv"), goqu.On(goqu.L("uvdv.validator_index = m.validator_index"))).
+			Where(goqu.L("uvdv.dashboard_id = ?", dashboardId.Id))
+		if groupId != t.AllGroups {
+			ds = ds.Where(goqu.L("uvdv.group_id = ?", groupId))
+		}
+	} else {
+		ds = ds.Where(goqu.L("m.validator_index = ANY(?)", pq.Array(dashboardId.Validators)))
+	}
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("error preparing query: %w", err)
+	}
+
+	var minipools []rocketPoolDashboardMinipool
+	err = d.alloyReader.SelectContext(ctx, &minipools, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving rocketpool minipools: %w", err)
+	}
+	if dashboardId.AggregateGroups {
+		for i := range minipools {
+			minipools[i].GroupId = t.DefaultGroupId
+		}
+	}
+	return minipools, nil
+}
+
+func (d *DataAccessService) getRocketPoolNodes(ctx context.Context, addresses [][]byte) (map[string]rocketPoolNode, error) {
+	var nodes []rocketPoolNode
+	err := d.alloyReader.SelectContext(ctx, &nodes, `
+		SELECT
+			address,
+			timezone_location,
+			rpl_stake,
+			min_rpl_stake,
+			max_rpl_stake,
+			rpl_cumulative_rewards,
+			smoothing_pool_opted_in,
+			claimed_smoothing_pool,
+			unclaimed_smoothing_pool,
+			unclaimed_rpl_rewards,
+			effective_rpl_stake,
+			COALESCE(deposit_credit, 0) AS deposit_credit
+		FROM rocketpool_nodes
+		WHERE address = ANY($1)`, pq.ByteaArray(addresses))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving rocketpool nodes: %w", err)
+	}
+	result := make(map[string]rocketPoolNode, len(nodes))
+	for _, node := range nodes {
+		result[hexutil.Encode(node.Address)] = node
+	}
+	return result, nil
+}
+
+// the network stats are only missing if the rocketpool exporter hasn't run yet, in which case no estimates are returned
+func (d *DataAccessService) getRocketPoolNetworkStatsIfPresent(ctx context.Context) (*t.RPNetworkStats, error) {
+	stats, err := d.getInternalRpNetworkStats(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &t.RPNetworkStats{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving rocketpool network stats: %w", err)
+	}
+	return stats, nil
+}
+
+// fillRocketPoolRplFields sets the collateral, apr and estimate of a row from its stakes and borrowed eth
+func fillRocketPoolRplFields(row *t.VDBRocketPoolTableRow, stats *t.RPNetworkStats, borrowed decimal.Decimal) {
+	if !borrowed.IsZero() {
+		row.Collateral.Percentage = row.Staked.Rpl.Mul(stats.RPLPrice).Div(decimal.New(1, 18)).Div(borrowed).Mul(decimal.NewFromInt(100)).InexactFloat64()
+	}
+	if stats.EffectiveRPLStaked.IsZero() || stats.NodeOperatorRewards.IsZero() || row.EffectiveRpl.IsZero() {
+		return
+	}
+	row.RplEstimate = stats.NodeOperatorRewards.Mul(row.EffectiveRpl).Div(stats.EffectiveRPLStaked)
+	if stats.ClaimIntervalHours > 0 && !row.Staked.Rpl.IsZero() {
+		periodsPerYear := decimal.NewFromFloat(365 / (stats.ClaimIntervalHours / 24))
+		row.RplApr = row.RplEstimate.
+			Div(row.Staked.Rpl).
+			Mul(periodsPerYear).
+			Mul(decimal.NewFromInt(100)).InexactFloat64()
+	}
+	row.RplAprUpdateTs = stats.Ts.Unix()
+}
+
+// rocketPoolNodeRow builds the row of a node from its minipools on the dashboard. Besides the row it returns the eth
+// borrowed from the deposit pool and the summed commission of the active minipools, which the collateral and the
+// average commission of the total are based on.
+func rocketPoolNodeRow(address string, node rocketPoolNode, minipools []rocketPoolDashboardMinipool) (t.VDBRocketPoolTableRow, decimal.Decimal, float64, uint64) {
+	row := t.VDBRocketPoolTableRow{
+		Node:          t.Address{Hash: t.Hash(address)},
+		EffectiveRpl:  node.EffectiveRplStake,
+		Timezone:      node.Timezone,
+		DepositCredit: node.DepositCredit,
+	}
+	row.Staked.Rpl = node.RplStake
+	row.Collateral.MinValue = node.MinRplStake
+	row.Collateral.MaxValue = node.MaxRplStake
+	row.RplStake.Min = node.MinRplStake
+	row.RplStake.Max = node.MaxRplStake
+	row.Rpl.Claimed = node.RplCumulativeRewards
+	row.Rpl.Unclaimed = node.UnclaimedRplRewards
+	row.SmoothingPool.IsOptIn = node.SmoothingPoolOptedIn
+	row.SmoothingPool.Claimed = node.ClaimedSmoothingPool
+	row.SmoothingPool.Unclaimed = node.UnclaimedSmoothingPool
+
+	eth8, eth16 := decimal.New(8, 18), decimal.New(16, 18)
+	var borrowed decimal.Decimal
+	var commission float64
+	var active uint64
+	for _, minipool := range minipools {
+		row.Minipools.Total++
+		if minipool.NodeDeposit.Equal(eth8) {
+			row.Minipools.Leb8++
+		} else if minipool.NodeDeposit.Equal(eth16) {
+			row.Minipools.Leb16++
+		}
+		if !minipool.isActive() {
+			continue
+		}
+		row.Staked.Eth = row.Staked.Eth.Add(minipool.NodeDeposit)
+		borrowed = borrowed.Add(minipool.UserDeposit)
+		commission += minipool.NodeFee
+		active++
+	}
+	if active > 0 {
+		row.AvgCommission = commission / float64(active)
+	}
+	return row, borrowed, commission, active
+}
+
+// addRocketPoolRowToTotal adds the stakes and rewards of a node row to the total row, the total only counts as opted
+// in to the smoothing pool if all of its nodes are
+func addRocketPoolRowToTotal(total, row *t.VDBRocketPoolTableRow) {
+	total.Staked.Eth = total.Staked.Eth.Add(row.Staked.Eth)
+	total.Staked.Rpl = total.Staked.Rpl.Add(row.Staked.Rpl)
+	total.Minipools.Total += row.Minipools.Total
+	total.Minipools.Leb8 += row.Minipools.Leb8
+	total.Minipools.Leb16 += row.Minipools.Leb16
+	total.Collateral.MinValue = total.Collateral.MinValue.Add(row.Collateral.MinValue)
+	total.Collateral.MaxValue = total.Collateral.MaxValue.Add(row.Collateral.MaxValue)
+	total.RplStake.Min = total.RplStake.Min.Add(row.RplStake.Min)
+	total.RplStake.Max = total.RplStake.Max.Add(row.RplStake.Max)
+	total.Rpl.Claimed = total.Rpl.Claimed.Add(row.Rpl.Claimed)
+	total.Rpl.Unclaimed = total.Rpl.Unclaimed.Add(row.Rpl.Unclaimed)
+	total.EffectiveRpl = total.EffectiveRpl.Add(row.EffectiveRpl)
+	total.SmoothingPool.IsOptIn = total.SmoothingPool.IsOptIn && row.SmoothingPool.IsOptIn
+	total.SmoothingPool.Claimed = total.SmoothingPool.Claimed.Add(row.SmoothingPool.Claimed)
+	total.SmoothingPool.Unclaimed = total.SmoothingPool.Unclaimed.Add(row.SmoothingPool.Unclaimed)
+	total.DepositCredit = total.DepositCredit.Add(row.DepositCredit)
+}
+
+func (d *DataAccessService) getValidatorDashboardRocketPoolRows(ctx context.Context, dashboardId t.VDBId, groupId int64, search string) ([]t.VDBRocketPoolTableRow, *t.VDBRocketPoolTableRow, error) {
+	minipools, err := d.getRocketPoolDashboardMinipools(ctx, dashboardId, groupId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	search = strings.ToLower(search)
+	minipoolsByNode := make(map[string][]rocketPoolDashboardMinipool)
+	var nodeAddresses [][]byte
+	for _, minipool := range minipools {
+		node := hexutil.Encode(minipool.Node)
+		if search != "" && !strings.HasPrefix(node, search) && !strings.HasPrefix(strings.TrimPrefix(node, "0x"), search) {
+			continue
+		}
+		if _, ok := minipoolsByNode[node]; !ok {
+			nodeAddresses = append(nodeAddresses, minipool.Node)
+		}
+		minipoolsByNode[node] = append(minipoolsByNode[node], minipool)
+	}
+
+	total := t.VDBRocketPoolTableRow{}
+	total.SmoothingPool.IsOptIn = len(nodeAddresses) > 0
+	if len(nodeAddresses) == 0 {
+		return []t.VDBRocketPoolTableRow{}, &total, nil
+	}
+
+	nodes, err := d.getRocketPoolNodes(ctx, nodeAddresses)
+	if err != nil {
+		return nil, nil, err
+	}
+	stats, err := d.getRocketPoolNetworkStatsIfPresent(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var totalBorrowed decimal.Decimal
+	var totalCommission float64
+	var activeMinipools uint64
+
+	result := make([]t.VDBRocketPoolTableRow, 0, len(minipoolsByNode))
+	for address, nodeMinipools := range minipoolsByNode {
+		row, borrowed, commission, active := rocketPoolNodeRow(address, nodes[address], nodeMinipools)
+		fillRocketPoolRplFields(&row, stats, borrowed)
+		result = append(result, row)
+
+		addRocketPoolRowToTotal(&total, &row)
+		totalBorrowed = totalBorrowed.Add(borrowed)
+		totalCommission += commission
+		activeMinipools += active
+	}
+	if activeMinipools > 0 {
+		total.AvgCommission = totalCommission / float64(activeMinipools)
+	}
+	fillRocketPoolRplFields(&total, stats, totalBorrowed)
+
+	// names are only needed for the rows, the total has no node
+	addressMap := make(map[string]*t.Address, len(result))
+	for i := range result {
+		addressMap[string(result[i].Node.Hash)] = &result[i].Node
+	}
+	if err := d.GetNamesAndEnsForAddresses(ctx, addressMap); err != nil {
+		return nil, nil, err
+	}
+	return result, &total, nil
+}
+
+// pageRows applies the cursor to the sorted rows of an in-memory table; isCursorRow identifies the row the cursor was created from
+func pageRows[T any, C t.CursorLike](data []T, currentCursor C, isCursorRow func(T) bool, limit uint64) ([]T, *t.Paging, error) {
+	var paging t.Paging
+	if len(data) == 0 {
+		return data, &paging, nil
+	}
+
+	var cursorIndex uint64
+	if currentCursor.IsValid() {
+		for idx, row := range data {
+			if isCursorRow(row) {
+				cursorIndex = uint64(idx)
+				break
+			}
+		}
+	}
+
+	var result []T
+	if currentCursor.IsReverse() {
+		// opposite direction
+		var limitCutoff uint64
+		if cursorIndex > limit+1 {
+			limitCutoff = cursorIndex - limit - 1
+		}
+		result = data[limitCutoff:cursorIndex]
+	} else {
+		if currentCursor.IsValid() {
+			cursorIndex++
+		}
+		limitCutoff := min(cursorIndex+limit+1, uint64(len(data)))
+		result = data[cursorIndex:limitCutoff]
+	}
+
+	// flag if above limit
+	moreDataFlag := len(result) > int(limit)
+	if !moreDataFlag && !currentCursor.IsValid() {
+		// no paging required
+		return result, &paging, nil
+	}
+
+	// remove the last entry from data as it is only required for the check
+	if moreDataFlag {
+		if currentCursor.IsReverse() {
+			result = result[1:]
+		} else {
+			result = result[:len(result)-1]
+		}
+	}
+
+	p, err := utils.GetPagingFromData(result, currentCursor, moreDataFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paging: %w", err)
+	}
+	return result, p, nil
+}
+
+func (d *DataAccessService) GetValidatorDashboardRocketPool(ctx context.Context, dashboardId t.VDBId, groupId int64, cursor string, colSort t.Sort[enums.VDBRocketPoolColumn], search string, limit uint64) ([]t.VDBRocketPoolTableRow, *t.Paging, error) {
+	var currentCursor t.VDBRocketPoolCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.VDBRocketPoolCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as VDBRocketPoolCursor: %w", err)
+		}
+	}
+
+	data, _, err := d.getValidatorDashboardRocketPoolRows(ctx, dashboardId, groupId, search)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the node address breaks ties so that the cursor position is deterministic
+	sort.Slice(data, func(i, j int) bool {
+		switch colSort.Column {
+		case enums.VDBRocketPoolMinipools:
+			if data[i].Minipools.Total != data[j].Minipools.Total {
+				return (data[i].Minipools.Total < data[j].Minipools.Total) != colSort.Desc
+			}
+		case enums.VDBRocketPoolCollateral:
+			if data[i].Collateral.Percentage != data[j].Collateral.Percentage {
+				return (data[i].Collateral.Percentage < data[j].Collateral.Percentage) != colSort.Desc
+			}
+		case enums.VDBRocketPoolRpl:
+			if !data[i].Staked.Rpl.Equal(data[j].Staked.Rpl) {
+				return data[i].Staked.Rpl.LessThan(data[j].Staked.Rpl) != colSort.Desc
+			}
+		case enums.VDBRocketPoolEffectiveRpl:
+			if !data[i].EffectiveRpl.Equal(data[j].EffectiveRpl) {
+				return data[i].EffectiveRpl.LessThan(data[j].EffectiveRpl) != colSort.Desc
+			}
+		case enums.VDBRocketPoolRplApr:
+			if data[i].RplApr != data[j].RplApr {
+				return (data[i].RplApr < data[j].RplApr) != colSort.Desc
+			}
+		case enums.VDBRocketPoolSmoothingPool:
+			claimedI := data[i].SmoothingPool.Claimed.Add(data[i].SmoothingPool.Unclaimed)
+			claimedJ := data[j].SmoothingPool.Claimed.Add(data[j].SmoothingPool.Unclaimed)
+			if !claimedI.Equal(claimedJ) {
+				return claimedI.LessThan(claimedJ) != colSort.Desc
+			}
+		}
+		return (data[i].Node.Hash < data[j].Node.Hash) != (colSort.Desc && colSort.Column == enums.VDBRocketPoolNode)
+	})
+
+	return pageRows(data, currentCursor, func(row t.VDBRocketPoolTableRow) bool {
+		return row.Node.Hash == currentCursor.Node.Hash
+	}, limit)
+}
+
+func (d *DataAccessService) GetValidatorDashboardTotalRocketPool(ctx context.Context, dashboardId t.VDBId, groupId int64, search string) (*t.VDBRocketPoolTableRow, error) {
+	_, total, err := d.getValidatorDashboardRocketPoolRows(ctx, dashboardId, groupId, search)
+	return total, err
+}
+
+func (d *DataAccessService) GetValidatorDashboardRocketPoolMinipools(ctx context.Context, dashboardId t.VDBId, groupId int64, node, cursor string, colSort t.Sort[enums.VDBRocketPoolMinipoolsColumn], search string, limit uint64) ([]t.VDBRocketPoolMinipoolsTableRow, *t.Paging, error) {
+	var currentCursor t.VDBRocketPoolMinipoolsCursor
+	var err error
+	if cursor != "" {
+		currentCursor, err = utils.StringToCursor[t.VDBRocketPoolMinipoolsCursor](cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse passed cursor as VDBRocketPoolMinipoolsCursor: %w", err)
+		}
+	}
+
+	minipools, err := d.getRocketPoolDashboardMinipools(ctx, dashboardId, groupId)
+	if err != nil {
+		return nil, nil, err
+	}
+	validatorMapping, err := d.services.GetCurrentValidatorMapping()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	node = strings.ToLower(node)
+	search = strings.ToLower(search)
+	nodeAddress := t.Address{Hash: t.Hash(node)}
+	data := []t.VDBRocketPoolMinipoolsTableRow{}
+	for _, minipool := range minipools {
+		if hexutil.Encode(minipool.Node) != node {
+			continue
+		}
+		if search != "" {
+			index, err := strconv.ParseUint(search, 10, 64)
+			indexSearch := err == nil && index == minipool.ValidatorIndex
+			nodeSearch := strings.TrimPrefix(search, "0x") == strings.TrimPrefix(node, "0x")
+			if !indexSearch && !nodeSearch {
+				continue
+			}
+		}
+
+		row := t.VDBRocketPoolMinipoolsTableRow{
+			Node:           nodeAddress,
+			ValidatorIndex: minipool.ValidatorIndex,
+			MinipoolStatus: strings.ToLower(minipool.Status),
+			GroupId:        minipool.GroupId,
+			Deposit:        minipool.NodeDeposit,
+			Commission:     minipool.NodeFee,
+			Penalties:      minipool.PenaltyCount,
+		}
+		if minipool.ValidatorIndex < uint64(len(validatorMapping.ValidatorMetadata)) {
+			row.ValidatorStatus = validatorMapping.ValidatorMetadata[minipool.ValidatorIndex].Status
+		}
+		// the creation of a minipool isn't exported, the last status change is the closest to it
+		if minipool.StatusTime.Valid {
+			row.CreatedTimestamp = minipool.StatusTime.Time.Unix()
+		}
+		data = append(data, row)
+	}
+
+	if len(data) > 0 {
+		addressMap := map[string]*t.Address{node: &nodeAddress}
+		if err := d.GetNamesAndEnsForAddresses(ctx, addressMap); err != nil {
+			return nil, nil, err
+		}
+		for i := range data {
+			data[i].Node = nodeAddress
+		}
+	}
+
+	sort.Slice(data, func(i, j int) bool {
+		if colSort.Column == enums.VDBRocketPoolMinipoolsGroup && data[i].GroupId != data[j].GroupId {
+			return (data[i].GroupId < data[j].GroupId) != colSort.Desc
+		}
+		return data[i].ValidatorIndex < data[j].ValidatorIndex
+	})
+
+	return pageRows(data, currentCursor, func(row t.VDBRocketPoolMinipoolsTableRow) bool {
+		return row.ValidatorIndex == currentCursor.ValidatorIndex
+	}, limit)
 }
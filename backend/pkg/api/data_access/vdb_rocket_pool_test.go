@@ -0,0 +1,194 @@
+package dataaccess
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/shopspring/decimal"
+)
+
+func eth(v float64) decimal.Decimal {
+	return decimal.NewFromFloat(v).Mul(decimal.New(1, 18))
+}
+
+func TestRocketPoolNodeRow(test *testing.T) {
+	node := rocketPoolNode{
+		Timezone:               "Europe/Berlin",
+		RplStake:               eth(1000),
+		MinRplStake:            eth(240),
+		MaxRplStake:            eth(6000),
+		RplCumulativeRewards:   eth(12),
+		UnclaimedRplRewards:    eth(3),
+		SmoothingPoolOptedIn:   true,
+		ClaimedSmoothingPool:   eth(0.5),
+		UnclaimedSmoothingPool: eth(0.25),
+		EffectiveRplStake:      eth(500),
+		DepositCredit:          eth(1),
+	}
+	// only the staking and prelaunched minipools count towards the staked and borrowed eth and the commission
+	minipools := []rocketPoolDashboardMinipool{
+		{Status: "Staking", NodeFee: 0.14, NodeDeposit: eth(8), UserDeposit: eth(24)},
+		{Status: "Prelaunch", NodeFee: 0.15, NodeDeposit: eth(16), UserDeposit: eth(16)},
+		{Status: "Dissolved", NodeFee: 0.2, NodeDeposit: eth(8), UserDeposit: eth(24)},
+		{Status: "Withdrawable", NodeFee: 0.2, NodeDeposit: eth(16), UserDeposit: eth(16)},
+	}
+
+	row, borrowed, commission, active := rocketPoolNodeRow("0x01", node, minipools)
+	if row.Node.Hash != "0x01" || row.Timezone != "Europe/Berlin" {
+		test.Errorf("unexpected node %v in timezone %v", row.Node.Hash, row.Timezone)
+	}
+	if row.Minipools.Total != 4 || row.Minipools.Leb8 != 2 || row.Minipools.Leb16 != 2 {
+		test.Errorf("unexpected minipool counts %+v", row.Minipools)
+	}
+	if !row.Staked.Eth.Equal(eth(24)) || !row.Staked.Rpl.Equal(eth(1000)) {
+		test.Errorf("unexpected stake %+v", row.Staked)
+	}
+	if !borrowed.Equal(eth(40)) || active != 2 {
+		test.Errorf("expected 40 eth borrowed by 2 active minipools, got %v by %d", borrowed, active)
+	}
+	if math.Abs(commission-0.29) > 1e-9 || math.Abs(row.AvgCommission-0.145) > 1e-9 {
+		test.Errorf("unexpected commission %v with average %v", commission, row.AvgCommission)
+	}
+	if !row.Rpl.Claimed.Equal(eth(12)) || !row.Rpl.Unclaimed.Equal(eth(3)) {
+		test.Errorf("unexpected rpl rewards %+v", row.Rpl)
+	}
+	if !row.SmoothingPool.IsOptIn || !row.SmoothingPool.Claimed.Equal(eth(0.5)) || !row.SmoothingPool.Unclaimed.Equal(eth(0.25)) {
+		test.Errorf("unexpected smoothing pool %+v", row.SmoothingPool)
+	}
+	if !row.Collateral.MinValue.Equal(eth(240)) || !row.Collateral.MaxValue.Equal(eth(6000)) || !row.RplStake.Min.Equal(eth(240)) || !row.RplStake.Max.Equal(eth(6000)) {
+		test.Errorf("unexpected collateral bounds %+v and rpl stake bounds %+v", row.Collateral, row.RplStake)
+	}
+
+	row, borrowed, _, active = rocketPoolNodeRow("0x02", node, minipools[2:])
+	if !row.Staked.Eth.IsZero() || !borrowed.IsZero() || active != 0 || row.AvgCommission != 0 {
+		test.Errorf("expected nothing staked or borrowed without active minipools, got %v staked, %v borrowed by %d minipools", row.Staked.Eth, borrowed, active)
+	}
+}
+
+func TestAddRocketPoolRowToTotal(test *testing.T) {
+	row := func(rpl, claimed, unclaimed, smoothingPool float64, optIn bool) t.VDBRocketPoolTableRow {
+		var r t.VDBRocketPoolTableRow
+		r.Staked.Eth = eth(8)
+		r.Staked.Rpl = eth(rpl)
+		r.Minipools.Total = 1
+		r.Minipools.Leb8 = 1
+		r.Rpl.Claimed = eth(claimed)
+		r.Rpl.Unclaimed = eth(unclaimed)
+		r.EffectiveRpl = eth(rpl)
+		r.SmoothingPool.IsOptIn = optIn
+		r.SmoothingPool.Unclaimed = eth(smoothingPool)
+		return r
+	}
+
+	total := t.VDBRocketPoolTableRow{}
+	total.SmoothingPool.IsOptIn = true
+	for _, r := range []t.VDBRocketPoolTableRow{row(100, 10, 1, 0.1, true), row(200, 20, 2, 0.2, true)} {
+		addRocketPoolRowToTotal(&total, &r)
+	}
+	if !total.Staked.Eth.Equal(eth(16)) || !total.Staked.Rpl.Equal(eth(300)) || !total.EffectiveRpl.Equal(eth(300)) {
+		test.Errorf("unexpected total stake %+v with effective rpl %v", total.Staked, total.EffectiveRpl)
+	}
+	if total.Minipools.Total != 2 || total.Minipools.Leb8 != 2 {
+		test.Errorf("unexpected total minipool counts %+v", total.Minipools)
+	}
+	if !total.Rpl.Claimed.Equal(eth(30)) || !total.Rpl.Unclaimed.Equal(eth(3)) || !total.SmoothingPool.Unclaimed.Equal(eth(0.3)) {
+		test.Errorf("unexpected total rewards %+v and smoothing pool %+v", total.Rpl, total.SmoothingPool)
+	}
+	if !total.SmoothingPool.IsOptIn {
+		test.Errorf("expected the total to be opted in when all nodes are")
+	}
+
+	r := row(100, 0, 0, 0, false)
+	addRocketPoolRowToTotal(&total, &r)
+	if total.SmoothingPool.IsOptIn {
+		test.Errorf("expected the total not to be opted in when a node isn't")
+	}
+}
+
+func TestFillRocketPoolRplFields(test *testing.T) {
+	ts := time.Unix(1700000000, 0)
+	// 1000 rpl of the operator rewards are distributed every 28 days, rpl is worth 0.01 eth
+	stats := &t.RPNetworkStats{
+		Ts:                  ts,
+		ClaimIntervalHours:  672,
+		NodeOperatorRewards: eth(1000),
+		EffectiveRPLStaked:  eth(100000),
+		RPLPrice:            eth(0.01),
+	}
+	newRow := func(rpl, effectiveRpl float64) *t.VDBRocketPoolTableRow {
+		row := &t.VDBRocketPoolTableRow{EffectiveRpl: eth(effectiveRpl)}
+		row.Staked.Rpl = eth(rpl)
+		return row
+	}
+
+	tests := []struct {
+		name       string
+		row        *t.VDBRocketPoolTableRow
+		stats      *t.RPNetworkStats
+		borrowed   decimal.Decimal
+		collateral float64
+		estimate   decimal.Decimal
+		apr        float64
+		updateTs   int64
+	}{
+		{
+			// 10 eth worth of rpl against 40 borrowed eth, 0.5% of the effective stake earns 5 rpl per interval
+			name:       "collateral and apr",
+			row:        newRow(1000, 500),
+			stats:      stats,
+			borrowed:   eth(40),
+			collateral: 25,
+			estimate:   eth(5),
+			apr:        5.0 / 1000 * (365.0 / 28) * 100,
+			updateTs:   ts.Unix(),
+		},
+		{
+			name:     "nothing borrowed",
+			row:      newRow(1000, 500),
+			stats:    stats,
+			estimate: eth(5),
+			apr:      5.0 / 1000 * (365.0 / 28) * 100,
+			updateTs: ts.Unix(),
+		},
+		{
+			name:       "no effective stake",
+			row:        newRow(1000, 0),
+			stats:      stats,
+			borrowed:   eth(40),
+			collateral: 25,
+		},
+		{
+			name:     "unknown claim interval",
+			row:      newRow(1000, 500),
+			stats:    &t.RPNetworkStats{Ts: ts, NodeOperatorRewards: eth(1000), EffectiveRPLStaked: eth(100000)},
+			borrowed: eth(40),
+			estimate: eth(5),
+			updateTs: ts.Unix(),
+		},
+		{
+			name:     "network stats missing",
+			row:      newRow(1000, 500),
+			stats:    &t.RPNetworkStats{},
+			borrowed: eth(40),
+		},
+	}
+	for _, tt := range tests {
+		test.Run(tt.name, func(test *testing.T) {
+			fillRocketPoolRplFields(tt.row, tt.stats, tt.borrowed)
+			if math.Abs(tt.row.Collateral.Percentage-tt.collateral) > 1e-9 {
+				test.Errorf("expected collateral %v%%, got %v%%", tt.collateral, tt.row.Collateral.Percentage)
+			}
+			if !tt.row.RplEstimate.Equal(tt.estimate) {
+				test.Errorf("expected estimate %v, got %v", tt.estimate, tt.row.RplEstimate)
+			}
+			if math.Abs(tt.row.RplApr-tt.apr) > 1e-9 {
+				test.Errorf("expected apr %v, got %v", tt.apr, tt.row.RplApr)
+			}
+			if tt.row.RplAprUpdateTs != tt.updateTs {
+				test.Errorf("expected update ts %v, got %v", tt.updateTs, tt.row.RplAprUpdateTs)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package dataaccess
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+// the performance of the ssv operators and the clusters is shown for the last week
+const ssvPerformanceDays = 7
+
+type ssvClusterValidatorRow struct {
+	ValidatorIndex t.VDBValidator `db:"validator_index"`
+	ClusterId      []byte         `db:"cluster_id"`
+	Owner          []byte         `db:"owner"`
+	OperatorIds    pq.Int64Array  `db:"operator_ids"`
+	Active         bool           `db:"active"`
+}
+
+type ssvOperatorRow struct {
+	OperatorId            uint64          `db:"operator_id"`
+	Name                  string          `db:"name"`
+	Owner                 []byte          `db:"owner"`
+	Fee                   decimal.Decimal `db:"fee"`
+	Removed               bool            `db:"removed"`
+	Validators            uint64          `db:"validators"`
+	AttestationsScheduled uint64          `db:"attestations_scheduled"`
+	AttestationsExecuted  uint64          `db:"attestations_executed"`
+}
+
+// getSsvOperatorsOfValidators returns the operators of the ssv clusters the given validators are registered with
+func (d *DataAccessService) getSsvOperatorsOfValidators(ctx context.Context, validators []t.VDBValidator) (map[uint64][]uint64, error) {
+	var rows []struct {
+		ValidatorIndex uint64        `db:"validator_index"`
+		OperatorIds    pq.Int64Array `db:"operator_ids"`
+	}
+	err := d.alloyReader.SelectContext(ctx, &rows, `
+		SELECT validator_index, operator_ids
+		FROM ssv_validators
+		WHERE NOT removed AND validator_index = ANY($1)`, pq.Array(validators))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving ssv operators of validators: %w", err)
+	}
+	result := make(map[uint64][]uint64, len(rows))
+	for _, row := range rows {
+		result[row.ValidatorIndex] = ssvOperatorIds(row.OperatorIds)
+	}
+	return result, nil
+}
+
+func ssvOperatorIds(ids pq.Int64Array) []uint64 {
+	result := make([]uint64, len(ids))
+	for i, id := range ids {
+		result[i] = uint64(id)
+	}
+	return result
+}
+
+// GetValidatorDashboardSsvClusters returns the ssv clusters the dashboard validators are registered with. the operators of
+// a cluster are listed with their performance over all validators they run, so an underperforming operator stands out
+// against the other operators of the cluster.
+func (d *DataAccessService) GetValidatorDashboardSsvClusters(ctx context.Context, dashboardId t.VDBId) ([]t.VDBSsvClusterTableRow, error) {
+	ds := goqu.Dialect("postgres").
+		Select(
+			goqu.L("sv.validator_index"),
+			goqu.L("sc.cluster_id"),
+			goqu.L("sc.owner"),
+			goqu.L("sc.operator_ids"),
+			goqu.L("sc.active")).
+		From(goqu.L("ssv_validators sv")).
+		InnerJoin(goqu.L("ssv_clusters sc"), goqu.On(goqu.L("sc.cluster_id = sv.cluster_id"))).
+		Where(goqu.L("NOT sv.removed")).
+		Order(goqu.L("sv.validator_index").Asc())
+
+	if dashboardId.Validators != nil {
+		ds = ds.Where(goqu.L("sv.validator_index = ANY(?)", pq.Array(dashboardId.Validators)))
+	} else {
+		ds = ds.
+			InnerJoin(goqu.L("users_val_dashboards_validators uvdv"), goqu.On(goqu.L("uvdv.validator_index = sv.validator_index"))).
+			Where(goqu.L("uvdv.dashboard_id = ?", dashboardId.Id))
+	}
+
+	query, args, err := ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("error preparing query: %w", err)
+	}
+	var validatorRows []ssvClusterValidatorRow
+	err = d.alloyReader.SelectContext(ctx, &validatorRows, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving ssv clusters of dashboard validators: %w", err)
+	}
+	if len(validatorRows) == 0 {
+		return []t.VDBSsvClusterTableRow{}, nil
+	}
+
+	addressMap := make(map[string]*t.Address)
+	address := func(b []byte) *t.Address {
+		hash := hexutil.Encode(b)
+		if _, ok := addressMap[hash]; !ok {
+			addressMap[hash] = &t.Address{Hash: t.Hash(hash)}
+		}
+		return addressMap[hash]
+	}
+
+	clusters := make(map[string]*t.VDBSsvClusterTableRow)
+	clusterOwners := make(map[string]*t.Address)
+	clusterOperators := make(map[string][]uint64)
+	clusterOfValidator := make(map[t.VDBValidator]string)
+	validators := make([]t.VDBValidator, 0, len(validatorRows))
+	var operatorIds []int64
+	for _, row := range validatorRows {
+		id := hexutil.Encode(row.ClusterId)
+		if _, ok := clusters[id]; !ok {
+			clusters[id] = &t.VDBSsvClusterTableRow{
+				ClusterId: t.Hash(id),
+				IsActive:  row.Active,
+			}
+			clusterOwners[id] = address(row.Owner)
+			clusterOperators[id] = ssvOperatorIds(row.OperatorIds)
+			operatorIds = append(operatorIds, row.OperatorIds...)
+		}
+		clusters[id].Validators = append(clusters[id].Validators, row.ValidatorIndex)
+		clusterOfValidator[row.ValidatorIndex] = id
+		validators = append(validators, row.ValidatorIndex)
+	}
+
+	var operatorRows []ssvOperatorRow
+	err = d.alloyReader.SelectContext(ctx, &operatorRows, `
+		SELECT
+			o.operator_id,
+			o.name,
+			o.owner,
+			o.fee,
+			o.removed,
+			COALESCE(p.validators, 0) AS validators,
+			COALESCE(p.attestations_scheduled, 0) AS attestations_scheduled,
+			COALESCE(p.attestations_executed, 0) AS attestations_executed
+		FROM ssv_operators o
+		LEFT JOIN (
+			SELECT
+				operator_id,
+				(array_agg(validators ORDER BY day DESC))[1] AS validators,
+				SUM(attestations_scheduled) AS attestations_scheduled,
+				SUM(attestations_executed) AS attestations_executed
+			FROM ssv_operator_performance
+			WHERE day >= $2
+			GROUP BY operator_id
+		) p ON p.operator_id = o.operator_id
+		WHERE o.operator_id = ANY($1)`, pq.Int64Array(operatorIds), time.Now().UTC().AddDate(0, 0, -ssvPerformanceDays).Format("2006-01-02"))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving ssv operators: %w", err)
+	}
+	operators := make(map[uint64]ssvOperatorRow, len(operatorRows))
+	operatorOwners := make(map[uint64]*t.Address, len(operatorRows))
+	for _, row := range operatorRows {
+		operators[row.OperatorId] = row
+		operatorOwners[row.OperatorId] = address(row.Owner)
+	}
+
+	var attestations []struct {
+		ValidatorIndex        t.VDBValidator `db:"validator_index"`
+		AttestationsScheduled uint64         `db:"attestations_scheduled"`
+		AttestationsExecuted  uint64         `db:"attestations_executed"`
+	}
+	ds = goqu.Dialect("postgres").
+		Select(
+			goqu.L("r.validator_index"),
+			goqu.L("COALESCE(SUM(r.attestations_scheduled), 0) AS attestations_scheduled"),
+			goqu.L("COALESCE(SUM(r.attestations_executed), 0) AS attestations_executed")).
+		From(goqu.L("validator_dashboard_data_rolling_7d AS r FINAL")).
+		Where(goqu.L("r.validator_index IN ?", validators)).
+		GroupBy(goqu.L("r.validator_index"))
+	query, args, err = ds.Prepared(true).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("error preparing query: %w", err)
+	}
+	err = d.clickhouseReader.SelectContext(ctx, &attestations, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving attestations of ssv validators: %w", err)
+	}
+	for _, a := range attestations {
+		cluster := clusters[clusterOfValidator[a.ValidatorIndex]]
+		cluster.Attestations.Success += a.AttestationsExecuted
+		cluster.Attestations.Failed += a.AttestationsScheduled - min(a.AttestationsExecuted, a.AttestationsScheduled)
+	}
+
+	if err := d.GetNamesAndEnsForAddresses(ctx, addressMap); err != nil {
+		return nil, err
+	}
+
+	result := make([]t.VDBSsvClusterTableRow, 0, len(clusters))
+	for id, cluster := range clusters {
+		cluster.Owner = *clusterOwners[id]
+		cluster.Operators = make([]t.SsvOperator, 0, len(clusterOperators[id]))
+		for _, operatorId := range clusterOperators[id] {
+			operator := t.SsvOperator{Id: operatorId}
+			if row, ok := operators[operatorId]; ok {
+				operator.Name = row.Name
+				operator.Owner = *operatorOwners[operatorId]
+				operator.IsActive = !row.Removed
+				operator.Fee = row.Fee
+				operator.Validators = row.Validators
+				operator.Attestations = t.StatusCount{
+					Success: row.AttestationsExecuted,
+					Failed:  row.AttestationsScheduled - min(row.AttestationsExecuted, row.AttestationsScheduled),
+				}
+			}
+			cluster.Operators = append(cluster.Operators, operator)
+		}
+		result = append(result, *cluster)
+	}
+	slices.SortFunc(result, func(a, b t.VDBSsvClusterTableRow) int {
+		if len(a.Validators) != len(b.Validators) {
+			return len(b.Validators) - len(a.Validators)
+		}
+		return cmp.Compare(a.ClusterId, b.ClusterId)
+	})
+	return result, nil
+}
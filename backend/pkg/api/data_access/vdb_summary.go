@@ -28,8 +28,8 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, dashboardId t.VDBId, period enums.TimePeriod, cursor string, colSort t.Sort[enums.VDBSummaryColumn], search string, limit uint64, protocolModes t.VDBProtocolModes) ([]t.VDBSummaryTableRow, *t.Paging, error) {
-	// @DATA-ACCESS incorporate protocolModes
+func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, dashboardId t.VDBId, period enums.TimePeriod, cursor string, colSort t.Sort[enums.VDBSummaryColumn], search string, limit uint64, protocolModes t.VDBProtocolModes, credentialTypes []uint8) ([]t.VDBSummaryTableRow, *t.Paging, error) {
+	// @DATA-ACCESS incorporate rocket pool protocol mode
 	result := make([]t.VDBSummaryTableRow, 0)
 	var paging t.Paging
 
@@ -85,6 +85,21 @@ func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, da
 		}
 	}
 
+	// ------------------------------------------------------------------------------------------------------------------
+	// Restrict the validators to the requested withdrawal credential types
+	credentialValidators, err := d.getCredentialTypeValidators(ctx, dashboardId, credentialTypes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if credentialValidators != nil {
+		if len(credentialValidators) == 0 {
+			return result, &paging, nil
+		}
+		if len(validators) > 0 {
+			validators = credentialValidators
+		}
+	}
+
 	// ------------------------------------------------------------------------------------------------------------------
 	// Get the average network efficiency
 	efficiency, err := d.services.GetCurrentEfficiencyInfo()
@@ -148,6 +163,11 @@ func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, da
 			InnerJoin(goqu.L("validators v"), goqu.On(goqu.L("r.validator_index = v.validator_index"))).
 			Where(goqu.L("r.validator_index IN (SELECT validator_index FROM validators)"))
 
+		if len(credentialValidators) > 0 {
+			ds = ds.
+				Where(goqu.L("r.validator_index IN ?", credentialValidators))
+		}
+
 		if groupNameSearchEnabled && (search != "" || colSort.Column == enums.VDBSummaryColumns.Group) {
 			// Get the group names since we can filter and/or sort for them
 			ds = ds.
@@ -220,6 +240,11 @@ func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, da
 		ds = ds.
 			InnerJoin(goqu.L("users_val_dashboards_validators v"), goqu.On(goqu.L("b.proposer = v.validator_index"))).
 			Where(goqu.L("v.dashboard_id = ?", dashboardId.Id))
+
+		if len(credentialValidators) > 0 {
+			ds = ds.
+				Where(goqu.L("b.proposer = ANY(?)", pq.Array(credentialValidators)))
+		}
 	}
 
 	var elRewardsQueryResult []struct {
@@ -241,6 +266,23 @@ func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, da
 		elRewards[entry.GroupId] = entry.ElRewards
 	}
 
+	// ------------------------------------------------------------------------------------------------------------------
+	// Deduct the fees of the staking protocols from the rewards
+	protocolValidators, err := d.getProtocolValidators(ctx, dashboardId, protocolModes)
+	if err != nil {
+		return nil, nil, err
+	}
+	fees, err := d.getProtocolFeesForPeriod(ctx, protocolValidators, clickhouseTable, epochMin, epochMax)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range queryResult {
+		queryResult[i].ClRewards -= fees.Cl[0][queryResult[i].GroupId]
+	}
+	for groupId, fee := range fees.El[0] {
+		elRewards[groupId] = elRewards[groupId].Sub(fee)
+	}
+
 	// ------------------------------------------------------------------------------------------------------------------
 	// Get the current and next sync committee validators
 	latestEpoch := cache.LatestEpoch.Get()
@@ -284,10 +326,15 @@ func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, da
 		SyncExecuted           uint64
 		SyncScheduled          uint64
 		Reward                 t.ClElValue[decimal.Decimal]
+		EffectiveBalance       uint64
 	}{
 		GroupId: t.AllGroups,
 	}
 
+	// The share of the effective balance that is online is used to compare groups, as compounding validators
+	// can hold up to 2048 ETH a plain count of validators would not reflect their weight
+	onlineBalanceShare := make(map[int64]float64)
+
 	for _, queryEntry := range queryResult {
 		resultEntry := t.VDBSummaryTableRow{
 			GroupId:                  queryEntry.GroupId,
@@ -312,8 +359,10 @@ func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, da
 			return nil, nil, err
 		}
 
+		var effectiveBalance, onlineEffectiveBalance, offlineEffectiveBalance uint64
 		for _, validator := range queryEntry.ValidatorIndices {
 			metadata := validatorMapping.ValidatorMetadata[validator]
+			effectiveBalance += metadata.EffectiveBalance
 
 			// As deposited and pending validators are neither online nor offline they are counted as the third state (exited)
 			switch constypes.ValidatorDbStatus(metadata.Status) {
@@ -323,8 +372,10 @@ func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, da
 				resultEntry.Validators.Exited++
 			case constypes.DbActiveOnline, constypes.DbExitingOnline, constypes.DbSlashingOnline:
 				resultEntry.Validators.Online++
+				onlineEffectiveBalance += metadata.EffectiveBalance
 			case constypes.DbActiveOffline, constypes.DbExitingOffline, constypes.DbSlashingOffline:
 				resultEntry.Validators.Offline++
+				offlineEffectiveBalance += metadata.EffectiveBalance
 			case constypes.DbSlashed:
 				resultEntry.Validators.Exited++
 				resultEntry.Status.SlashedCount++
@@ -337,6 +388,14 @@ func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, da
 		total.Validators.Exited += resultEntry.Validators.Exited
 		total.Status.SlashedCount += resultEntry.Status.SlashedCount
 
+		// Effective balance
+		resultEntry.EffectiveBalance = utils.GWeiToWei(new(big.Int).SetUint64(effectiveBalance))
+		total.EffectiveBalance += effectiveBalance
+		onlineBalanceShare[queryEntry.GroupId] = -1
+		if onlineEffectiveBalance+offlineEffectiveBalance > 0 {
+			onlineBalanceShare[queryEntry.GroupId] = float64(onlineEffectiveBalance) / float64(onlineEffectiveBalance+offlineEffectiveBalance)
+		}
+
 		// Attestations
 		resultEntry.Attestations.Success = queryEntry.AttestationsExecuted
 		resultEntry.Attestations.Failed = queryEntry.AttestationsScheduled - queryEntry.AttestationsExecuted
@@ -401,11 +460,11 @@ func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, da
 	switch colSort.Column {
 	case enums.VDBSummaryColumns.Validators:
 		sortParam = func(resultEntry t.VDBSummaryTableRow) float64 {
-			divisor := float64(resultEntry.Validators.Online + resultEntry.Validators.Offline)
-			if divisor == 0 {
-				return -1
-			}
-			return float64(resultEntry.Validators.Online) / divisor
+			return onlineBalanceShare[resultEntry.GroupId]
+		}
+	case enums.VDBSummaryColumns.EffectiveBalance:
+		sortParam = func(resultEntry t.VDBSummaryTableRow) float64 {
+			return resultEntry.EffectiveBalance.InexactFloat64()
 		}
 	case enums.VDBSummaryColumns.Efficiency:
 		sortParam = func(resultEntry t.VDBSummaryTableRow) float64 {
@@ -463,6 +522,7 @@ func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, da
 			Validators:               total.Validators,
 			AverageNetworkEfficiency: averageNetworkEfficiency,
 			Reward:                   total.Reward,
+			EffectiveBalance:         utils.GWeiToWei(new(big.Int).SetUint64(total.EffectiveBalance)),
 		}
 
 		// Attestations
@@ -497,7 +557,7 @@ func (d *DataAccessService) GetValidatorDashboardSummary(ctx context.Context, da
 	return result, &paging, nil
 }
 
-func (d *DataAccessService) GetValidatorDashboardGroupSummary(ctx context.Context, dashboardId t.VDBId, groupId int64, period enums.TimePeriod, protocolModes t.VDBProtocolModes) (*t.VDBGroupSummaryData, error) {
+func (d *DataAccessService) GetValidatorDashboardGroupSummary(ctx context.Context, dashboardId t.VDBId, groupId int64, period enums.TimePeriod, protocolModes t.VDBProtocolModes, credentialTypes []uint8) (*t.VDBGroupSummaryData, error) {
 	// TODO: implement data retrieval for the following new field
 	// Fetch validator list for user dashboard from the dashboard table when querying the past sync committees as the rolling table might miss exited validators
 	// TotalMissedRewards
@@ -530,6 +590,20 @@ func (d *DataAccessService) GetValidatorDashboardGroupSummary(ctx context.Contex
 		validators = dashboardId.Validators
 	}
 
+	// Restrict the validators to the requested withdrawal credential types
+	credentialValidators, err := d.getCredentialTypeValidators(ctx, dashboardId, credentialTypes)
+	if err != nil {
+		return nil, err
+	}
+	if credentialValidators != nil {
+		if len(credentialValidators) == 0 {
+			return ret, nil
+		}
+		if len(validators) > 0 {
+			validators = credentialValidators
+		}
+	}
+
 	getLastScheduledBlockAndSyncDate := func() (time.Time, time.Time, error) {
 		// we need to go to the all time table for last scheduled block/sync committee epoch
 		clickhouseTotalTable, _, err := d.getTablesForPeriod(enums.AllTime)
@@ -548,6 +622,11 @@ func (d *DataAccessService) GetValidatorDashboardGroupSummary(ctx context.Contex
 				With("validators", goqu.L("(SELECT validator_index as validator_index, group_id FROM users_val_dashboards_validators WHERE dashboard_id = ? AND (group_id = ? OR ?::smallint = -1))", dashboardId.Id, groupId, groupId)).
 				InnerJoin(goqu.L("validators v"), goqu.On(goqu.L("r.validator_index = v.validator_index"))).
 				Where(goqu.L("validator_index IN (SELECT validator_index FROM validators)"))
+
+			if credentialValidators != nil {
+				ds = ds.
+					Where(goqu.L("r.validator_index IN ?", credentialValidators))
+			}
 		} else {
 			ds = ds.
 				Where(goqu.L("validator_index IN ?", validators))
@@ -601,6 +680,11 @@ func (d *DataAccessService) GetValidatorDashboardGroupSummary(ctx context.Contex
 			With("validators", goqu.L("(SELECT validator_index as validator_index, group_id FROM users_val_dashboards_validators WHERE dashboard_id = ? AND (group_id = ? OR ?::smallint = -1))", dashboardId.Id, groupId, groupId)).
 			InnerJoin(goqu.L("validators v"), goqu.On(goqu.L("r.validator_index = v.validator_index"))).
 			Where(goqu.L("validator_index IN (SELECT validator_index FROM validators)"))
+
+		if credentialValidators != nil {
+			ds = ds.
+				Where(goqu.L("r.validator_index IN ?", credentialValidators))
+		}
 	} else {
 		ds = ds.
 			Where(goqu.L("validator_index IN ?", validators))
@@ -736,7 +820,7 @@ func (d *DataAccessService) GetValidatorDashboardGroupSummary(ctx context.Contex
 		}
 	}
 
-	_, ret.Apr.El, _, ret.Apr.Cl, err = d.internal_getElClAPR(ctx, dashboardId, groupId, hours)
+	_, ret.Apr.El, _, ret.Apr.Cl, err = d.internal_getElClAPR(ctx, dashboardId, groupId, hours, credentialTypes)
 	if err != nil {
 		return nil, err
 	}
@@ -822,7 +906,16 @@ func (d *DataAccessService) GetValidatorDashboardGroupSummary(ctx context.Contex
 	return ret, nil
 }
 
-func (d *DataAccessService) internal_getElClAPR(ctx context.Context, dashboardId t.VDBId, groupId int64, hours int) (elIncome decimal.Decimal, elAPR float64, clIncome decimal.Decimal, clAPR float64, err error) {
+func (d *DataAccessService) internal_getElClAPR(ctx context.Context, dashboardId t.VDBId, groupId int64, hours int, credentialTypes []uint8) (elIncome decimal.Decimal, elAPR float64, clIncome decimal.Decimal, clAPR float64, err error) {
+	// Restrict the validators to the requested withdrawal credential types
+	credentialValidators, err := d.getCredentialTypeValidators(ctx, dashboardId, credentialTypes)
+	if err != nil {
+		return decimal.Zero, 0, decimal.Zero, 0, err
+	}
+	if credentialValidators != nil && len(credentialValidators) == 0 {
+		return decimal.Zero, 0, decimal.Zero, 0, nil
+	}
+
 	table := ""
 
 	switch hours {
@@ -841,10 +934,10 @@ func (d *DataAccessService) internal_getElClAPR(ctx context.Context, dashboardId
 	}
 
 	type RewardsResult struct {
-		EpochStart     uint64        `db:"epoch_start"`
-		EpochEnd       uint64        `db:"epoch_end"`
-		ValidatorCount uint64        `db:"validator_count"`
-		Reward         sql.NullInt64 `db:"reward"`
+		EpochStart       uint64        `db:"epoch_start"`
+		EpochEnd         uint64        `db:"epoch_end"`
+		ValidatorIndices []uint64      `db:"validator_indices"`
+		Reward           sql.NullInt64 `db:"reward"`
 	}
 
 	var rewardsResultTable RewardsResult
@@ -856,7 +949,7 @@ func (d *DataAccessService) internal_getElClAPR(ctx context.Context, dashboardId
 		Select(
 			goqu.L("MIN(epoch_start) AS epoch_start"),
 			goqu.L("MAX(epoch_end) AS epoch_end"),
-			goqu.L("COUNT(*) AS validator_count"),
+			goqu.L("ARRAY_AGG(r.validator_index) AS validator_indices"),
 			goqu.L("(SUM(COALESCE(r.balance_end,0)) + SUM(COALESCE(r.withdrawals_amount,0)) - SUM(COALESCE(r.deposits_amount,0)) - SUM(COALESCE(r.balance_start,0))) AS reward"))
 
 	if len(dashboardId.Validators) > 0 {
@@ -872,6 +965,10 @@ func (d *DataAccessService) internal_getElClAPR(ctx context.Context, dashboardId
 				Where(goqu.L("v.group_id = ?", groupId))
 		}
 	}
+	if credentialValidators != nil {
+		rewardsDs = rewardsDs.
+			Where(goqu.L("r.validator_index IN ?", credentialValidators))
+	}
 
 	query, args, err := rewardsDs.Prepared(true).ToSQL()
 	if err != nil {
@@ -883,18 +980,21 @@ func (d *DataAccessService) internal_getElClAPR(ctx context.Context, dashboardId
 		return decimal.Zero, 0, decimal.Zero, 0, err
 	}
 
-	if rewardsResultTable.ValidatorCount == 0 {
+	if len(rewardsResultTable.ValidatorIndices) == 0 {
 		return decimal.Zero, 0, decimal.Zero, 0, nil
 	}
 
+	// the apr is based on the effective balance as compounding validators can hold more than 32 ETH
+	effectiveBalance, err := d.getValidatorsEffectiveBalance(rewardsResultTable.ValidatorIndices)
+	if err != nil {
+		return decimal.Zero, 0, decimal.Zero, 0, err
+	}
+
 	aprDivisor := hours
 	if hours == -1 { // for all time APR
 		aprDivisor = 90 * 24
 	}
-	clAPR = ((float64(rewardsResultTable.Reward.Int64) / float64(aprDivisor)) / (float64(32e9) * float64(rewardsResultTable.ValidatorCount))) * 24.0 * 365.0 * 100.0
-	if math.IsNaN(clAPR) {
-		clAPR = 0
-	}
+	clAPR = utils.CalculateAPR(float64(rewardsResultTable.Reward.Int64), effectiveBalance, float64(aprDivisor))
 
 	clIncome = decimal.NewFromInt(rewardsResultTable.Reward.Int64).Mul(decimal.NewFromInt(1e9))
 
@@ -944,6 +1044,10 @@ func (d *DataAccessService) internal_getElClAPR(ctx context.Context, dashboardId
 				Where(goqu.L("v.group_id = ?", groupId))
 		}
 	}
+	if credentialValidators != nil {
+		elDs = elDs.
+			Where(goqu.L("b.proposer = ANY(?)", pq.Array(credentialValidators)))
+	}
 
 	elTableDs := elDs.
 		Where(goqu.L("b.epoch >= ? AND b.epoch <= ?", rewardsResultTable.EpochStart, rewardsResultTable.EpochEnd))
@@ -958,10 +1062,7 @@ func (d *DataAccessService) internal_getElClAPR(ctx context.Context, dashboardId
 		return decimal.Zero, 0, decimal.Zero, 0, err
 	}
 	elIncomeFloat, _ := elIncome.Float64() // EL income is in ETH
-	elAPR = ((elIncomeFloat / float64(aprDivisor)) / (float64(32) * float64(rewardsResultTable.ValidatorCount))) * 24.0 * 365.0 * 100.0
-	if math.IsNaN(elAPR) {
-		elAPR = 0
-	}
+	elAPR = utils.CalculateAPR(elIncomeFloat*1e9, effectiveBalance, float64(aprDivisor))
 
 	if hours == -1 {
 		elTotalDs := elDs.
@@ -984,13 +1085,27 @@ func (d *DataAccessService) internal_getElClAPR(ctx context.Context, dashboardId
 
 // for summary charts: series id is group id, no stack
 
-func (d *DataAccessService) GetValidatorDashboardSummaryChart(ctx context.Context, dashboardId t.VDBId, groupIds []int64, efficiency enums.VDBSummaryChartEfficiencyType, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64) (*t.ChartData[int, float64], error) {
+func (d *DataAccessService) GetValidatorDashboardSummaryChart(ctx context.Context, dashboardId t.VDBId, groupIds []int64, efficiency enums.VDBSummaryChartEfficiencyType, aggregation enums.ChartAggregation, afterTs uint64, beforeTs uint64, credentialTypes []uint8) (*t.ChartData[int, float64], error) {
 	ret := &t.ChartData[int, float64]{}
 
 	if len(groupIds) == 0 { // short circuit if no groups are selected
 		return ret, nil
 	}
 
+	// Restrict the validators to the requested withdrawal credential types
+	credentialValidators, err := d.getCredentialTypeValidators(ctx, dashboardId, credentialTypes)
+	if err != nil {
+		return nil, err
+	}
+	if credentialValidators != nil {
+		if len(credentialValidators) == 0 {
+			return ret, nil
+		}
+		if dashboardId.Validators != nil {
+			dashboardId.Validators = credentialValidators
+		}
+	}
+
 	// log.Infof("retrieving data between %v and %v for aggregation %v", time.Unix(int64(afterTs), 0), time.Unix(int64(beforeTs), 0), aggregation)
 	dataTable := ""
 	dateColumn := ""
@@ -1045,9 +1160,16 @@ func (d *DataAccessService) GetValidatorDashboardSummaryChart(ctx context.Contex
 			return nil, fmt.Errorf("error retrieving data from table %s: %w", dataTable, err)
 		}
 	} else {
+		args := []interface{}{afterTs, beforeTs, dashboardId.Id, groupIds, totalLineRequested}
+		credentialFilter := ""
+		if credentialValidators != nil {
+			credentialFilter = "AND validator_index IN ($6)"
+			args = append(args, credentialValidators)
+		}
+
 		query := fmt.Sprintf(`
 		WITH validators AS (
-			SELECT validator_index as validator_index, group_id FROM users_val_dashboards_validators WHERE dashboard_id = $3 AND (group_id IN ($4) OR $5)
+			SELECT validator_index as validator_index, group_id FROM users_val_dashboards_validators WHERE dashboard_id = $3 AND (group_id IN ($4) OR $5) %[3]s
 		)		
 		SELECT
 			%[2]s as ts,
@@ -1061,9 +1183,9 @@ func (d *DataAccessService) GetValidatorDashboardSummaryChart(ctx context.Contex
 		FROM %[1]s d
 		INNER JOIN validators v ON d.validator_index = v.validator_index
 		WHERE %[2]s >= fromUnixTimestamp($1) AND %[2]s <= fromUnixTimestamp($2) AND validator_index in (select validator_index from validators)
-		GROUP BY 1, 2;`, dataTable, dateColumn)
+		GROUP BY 1, 2;`, dataTable, dateColumn, credentialFilter)
 
-		err := d.clickhouseReader.SelectContext(ctx, &queryResults, query, afterTs, beforeTs, dashboardId.Id, groupIds, totalLineRequested)
+		err := d.clickhouseReader.SelectContext(ctx, &queryResults, query, args...)
 		if err != nil {
 			return nil, fmt.Errorf("error retrieving data from table %s: %w", dataTable, err)
 		}
@@ -268,17 +268,14 @@ func (d *DataAccessService) GetValidatorDashboardWithdrawals(ctx context.Context
 			return nil, nil, err
 		}
 		if nextData != nil {
-			// Complete the next data
-			nextData.GroupId = validatorGroupMap[nextData.Index]
-			// TODO integrate label/ens data for "next" row
-			// nextData.Recipient.Ens = addressEns[string(nextData.Recipient.Hash)]
-		} else {
-			// If there is no next data, add a missing estimate row
-			nextData = &t.VDBWithdrawalsTableRow{
-				IsMissingEstimate: true,
+			if !nextData.IsMissingEstimate {
+				// Complete the next data
+				nextData.GroupId = validatorGroupMap[nextData.Index]
+				// TODO integrate label/ens data for "next" row
+				// nextData.Recipient.Ens = addressEns[string(nextData.Recipient.Hash)]
 			}
+			result = append([]t.VDBWithdrawalsTableRow{*nextData}, result...)
 		}
-		result = append([]t.VDBWithdrawalsTableRow{*nextData}, result...)
 
 		// Flag if above limit
 		moreDataFlag = moreDataFlag || len(result) > int(limit)
@@ -302,6 +299,9 @@ func (d *DataAccessService) GetValidatorDashboardWithdrawals(ctx context.Context
 	return result, p, nil
 }
 
+// getNextWithdrawalRow estimates the next withdrawal of the validators. If none of the validators is expected to be withdrawn from,
+// e.g. because all of them are compounding validators below their effective balance cap, no row is returned.
+// If a withdrawal is expected but cannot be estimated a row flagged as missing estimate is returned.
 func (d *DataAccessService) getNextWithdrawalRow(queryValidators []t.VDBValidator) (*t.VDBWithdrawalsTableRow, error) {
 	if len(queryValidators) == 0 {
 		return nil, nil
@@ -329,6 +329,7 @@ func (d *DataAccessService) getNextWithdrawalRow(queryValidators []t.VDBValidato
 	latestFinalized := cache.LatestFinalizedEpoch.Get()
 
 	var nextValidator *t.VDBValidator
+	withdrawalExpected := false
 	for _, validator := range queryValidators {
 		metadata := validatorMapping.ValidatorMetadata[validator]
 
@@ -345,9 +346,11 @@ func (d *DataAccessService) getNextWithdrawalRow(queryValidators []t.VDBValidato
 			continue
 		}
 
+		maxEffectiveBalance := utils.GetMaxEffectiveBalanceOfWithdrawalCredentials(metadata.WithdrawalCredentials)
 		if (metadata.Balance > 0 && metadata.WithdrawableEpoch.Valid && metadata.WithdrawableEpoch.Int64 <= int64(epoch)) ||
-			(metadata.EffectiveBalance == utils.Config.Chain.ClConfig.MaxEffectiveBalance && metadata.Balance > utils.Config.Chain.ClConfig.MaxEffectiveBalance) {
+			(metadata.EffectiveBalance == maxEffectiveBalance && metadata.Balance > maxEffectiveBalance) {
 			// this validator is eligible for withdrawal, check if it is the next one
+			withdrawalExpected = true
 			if nextValidator == nil || validator > *stats.LatestValidatorWithdrawalIndex {
 				distance, err := d.getWithdrawableCountFromCursor(validator, *stats.LatestValidatorWithdrawalIndex)
 				if err != nil {
@@ -372,10 +375,16 @@ func (d *DataAccessService) getNextWithdrawalRow(queryValidators []t.VDBValidato
 	}
 
 	if nextValidator == nil {
+		if withdrawalExpected {
+			return &t.VDBWithdrawalsTableRow{
+				IsMissingEstimate: true,
+			}, nil
+		}
 		return nil, nil
 	}
 
 	nextValidatorData := validatorMapping.ValidatorMetadata[*nextValidator]
+	nextMaxEffectiveBalance := utils.GetMaxEffectiveBalanceOfWithdrawalCredentials(nextValidatorData.WithdrawalCredentials)
 
 	lastWithdrawnEpochs, err := db.GetLastWithdrawalEpoch([]t.VDBValidator{*nextValidator})
 	if err != nil {
@@ -401,10 +410,10 @@ func (d *DataAccessService) getNextWithdrawalRow(queryValidators []t.VDBValidato
 		withdrawalAmount = nextValidatorData.Balance
 	} else {
 		// partial withdrawal
-		withdrawalAmount = nextValidatorData.Balance - utils.Config.Chain.ClConfig.MaxEffectiveBalance
+		withdrawalAmount = nextValidatorData.Balance - nextMaxEffectiveBalance
 	}
 
-	if lastWithdrawnEpoch == epoch || nextValidatorData.Balance < utils.Config.Chain.ClConfig.MaxEffectiveBalance {
+	if lastWithdrawnEpoch == epoch || nextValidatorData.Balance < nextMaxEffectiveBalance {
 		withdrawalAmount = 0
 	}
 
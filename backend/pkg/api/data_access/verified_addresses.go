@@ -0,0 +1,86 @@
+package dataaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	t "github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/pkg/errors"
+)
+
+// addresses users have proven ownership of by signing a Sign-In with Ethereum message
+type VerifiedAddressRepository interface {
+	GetUserIdByVerifiedAddress(ctx context.Context, address common.Address) (uint64, error)
+	GetUserVerifiedAddresses(ctx context.Context, userId uint64) ([]t.VerifiedAddress, error)
+	AddUserVerifiedAddress(ctx context.Context, userId uint64, address common.Address) (bool, error)
+	RemoveUserVerifiedAddress(ctx context.Context, userId uint64, address common.Address) error
+}
+
+func (d *DataAccessService) GetUserIdByVerifiedAddress(ctx context.Context, address common.Address) (uint64, error) {
+	var userId uint64
+	err := d.userReader.GetContext(ctx, &userId, `SELECT user_id FROM users_verified_addresses WHERE address = $1`, address.Bytes())
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("%w: no user with verified address %s found", ErrNotFound, address.Hex())
+	}
+	return userId, err
+}
+
+func (d *DataAccessService) GetUserVerifiedAddresses(ctx context.Context, userId uint64) ([]t.VerifiedAddress, error) {
+	var addresses []struct {
+		Address    []byte    `db:"address"`
+		VerifiedTs time.Time `db:"verified_ts"`
+	}
+	err := d.userReader.SelectContext(ctx, &addresses, `
+		SELECT address, verified_ts
+		FROM users_verified_addresses
+		WHERE user_id = $1
+		ORDER BY verified_ts`, userId)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]t.VerifiedAddress, 0, len(addresses))
+	for _, address := range addresses {
+		result = append(result, t.VerifiedAddress{
+			Address:    t.Hash(common.BytesToAddress(address.Address).Hex()),
+			VerifiedAt: address.VerifiedTs.Unix(),
+		})
+	}
+	return result, nil
+}
+
+// AddUserVerifiedAddress links the address to the user, verifying it again updates the timestamp.
+// Returns false if the address is already linked to another user.
+func (d *DataAccessService) AddUserVerifiedAddress(ctx context.Context, userId uint64, address common.Address) (bool, error) {
+	result, err := d.userWriter.ExecContext(ctx, `
+		INSERT INTO users_verified_addresses (address, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (address) DO UPDATE SET
+			verified_ts = NOW()
+		WHERE users_verified_addresses.user_id = EXCLUDED.user_id`, address.Bytes(), userId)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (d *DataAccessService) RemoveUserVerifiedAddress(ctx context.Context, userId uint64, address common.Address) error {
+	result, err := d.userWriter.ExecContext(ctx, `DELETE FROM users_verified_addresses WHERE user_id = $1 AND address = $2`, userId, address.Bytes())
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: address %s is not verified", ErrNotFound, address.Hex())
+	}
+	return nil
+}
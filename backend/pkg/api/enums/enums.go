@@ -235,6 +235,21 @@ func (ChartAggregation) NewFromString(s string) ChartAggregation {
 	}
 }
 
+func (c ChartAggregation) ToString() string {
+	switch c {
+	case IntervalEpoch:
+		return "epoch"
+	case IntervalHourly:
+		return "hourly"
+	case IntervalDaily:
+		return "daily"
+	case IntervalWeekly:
+		return "weekly"
+	default:
+		return ""
+	}
+}
+
 var ChartAggregations = struct {
 	Epoch  ChartAggregation
 	Hourly ChartAggregation
@@ -261,3 +276,56 @@ func (c ChartAggregation) Duration(secondsPerEpoch uint64) time.Duration {
 		return 0
 	}
 }
+
+// ----------------
+// OAuth Scopes
+
+type OAuthScope int
+
+var _ EnumFactory[OAuthScope] = OAuthScope(0)
+
+const (
+	OAuthScopeDashboardsRead OAuthScope = iota
+	OAuthScopeDashboardsManage
+	OAuthScopeNotificationsManage
+)
+
+func (s OAuthScope) Int() int {
+	return int(s)
+}
+
+func (OAuthScope) NewFromString(s string) OAuthScope {
+	switch s {
+	case "dashboards:read":
+		return OAuthScopeDashboardsRead
+	case "dashboards:manage":
+		return OAuthScopeDashboardsManage
+	case "notifications:manage":
+		return OAuthScopeNotificationsManage
+	default:
+		return OAuthScope(-1)
+	}
+}
+
+func (s OAuthScope) ToString() string {
+	switch s {
+	case OAuthScopeDashboardsRead:
+		return "dashboards:read"
+	case OAuthScopeDashboardsManage:
+		return "dashboards:manage"
+	case OAuthScopeNotificationsManage:
+		return "notifications:manage"
+	default:
+		return ""
+	}
+}
+
+var OAuthScopes = struct {
+	DashboardsRead      OAuthScope
+	DashboardsManage    OAuthScope
+	NotificationsManage OAuthScope
+}{
+	OAuthScopeDashboardsRead,
+	OAuthScopeDashboardsManage,
+	OAuthScopeNotificationsManage,
+}
@@ -19,6 +19,7 @@ const (
 	VDBSummaryAttestations
 	VDBSummaryProposals
 	VDBSummaryReward
+	VDBSummaryEffectiveBalance
 )
 
 func (c VDBSummaryColumn) Int() int {
@@ -39,18 +40,21 @@ func (VDBSummaryColumn) NewFromString(s string) VDBSummaryColumn {
 		return VDBSummaryProposals
 	case "reward":
 		return VDBSummaryReward
+	case "effective_balance":
+		return VDBSummaryEffectiveBalance
 	default:
 		return VDBSummaryColumn(-1)
 	}
 }
 
 var VDBSummaryColumns = struct {
-	Group        VDBSummaryColumn
-	Validators   VDBSummaryColumn
-	Efficiency   VDBSummaryColumn
-	Attestations VDBSummaryColumn
-	Proposals    VDBSummaryColumn
-	Reward       VDBSummaryColumn
+	Group            VDBSummaryColumn
+	Validators       VDBSummaryColumn
+	Efficiency       VDBSummaryColumn
+	Attestations     VDBSummaryColumn
+	Proposals        VDBSummaryColumn
+	Reward           VDBSummaryColumn
+	EffectiveBalance VDBSummaryColumn
 }{
 	VDBSummaryGroup,
 	VDBSummaryValidators,
@@ -58,6 +62,7 @@ var VDBSummaryColumns = struct {
 	VDBSummaryAttestations,
 	VDBSummaryProposals,
 	VDBSummaryReward,
+	VDBSummaryEffectiveBalance,
 }
 
 // ----------------
@@ -388,6 +393,52 @@ var VDBSummaryChartEfficiencyFilters = struct {
 	VDBSummaryChartProposal,
 }
 
+// ----------------
+// Validator Dashboard Heatmap Metric
+
+type VDBHeatmapMetric int
+
+var _ EnumFactory[VDBHeatmapMetric] = VDBHeatmapMetric(0)
+
+const (
+	VDBHeatmapEfficiency VDBHeatmapMetric = iota
+	VDBHeatmapReward
+)
+
+func (c VDBHeatmapMetric) Int() int {
+	return int(c)
+}
+
+func (VDBHeatmapMetric) NewFromString(s string) VDBHeatmapMetric {
+	switch s {
+	case "", "efficiency":
+		return VDBHeatmapEfficiency
+	case "reward":
+		return VDBHeatmapReward
+	default:
+		return VDBHeatmapMetric(-1)
+	}
+}
+
+func (c VDBHeatmapMetric) ToString() string {
+	switch c {
+	case VDBHeatmapEfficiency:
+		return "efficiency"
+	case VDBHeatmapReward:
+		return "reward"
+	default:
+		return ""
+	}
+}
+
+var VDBHeatmapMetrics = struct {
+	Efficiency VDBHeatmapMetric
+	Reward     VDBHeatmapMetric
+}{
+	VDBHeatmapEfficiency,
+	VDBHeatmapReward,
+}
+
 // ----------------
 // Validator Dashboard Rocket Pool Table
 
@@ -477,3 +528,64 @@ var VDBWRocketPoolColumns = struct {
 }{
 	VDBRocketPoolMinipoolsGroup,
 }
+
+// ----------------
+// Validator Dashboard Member Roles
+
+// roles are ordered, every role includes the permissions of the roles before it
+type VDBMemberRole int
+
+var _ EnumFactory[VDBMemberRole] = VDBMemberRole(0)
+
+const (
+	VDBMemberRoleViewer VDBMemberRole = iota
+	VDBMemberRoleEditor
+	VDBMemberRoleAdmin
+	VDBMemberRoleOwner
+)
+
+func (r VDBMemberRole) Int() int {
+	return int(r)
+}
+
+func (VDBMemberRole) NewFromString(s string) VDBMemberRole {
+	switch s {
+	case "viewer":
+		return VDBMemberRoleViewer
+	case "editor":
+		return VDBMemberRoleEditor
+	case "admin":
+		return VDBMemberRoleAdmin
+	case "owner":
+		return VDBMemberRoleOwner
+	default:
+		return VDBMemberRole(-1)
+	}
+}
+
+func (r VDBMemberRole) ToString() string {
+	switch r {
+	case VDBMemberRoleViewer:
+		return "viewer"
+	case VDBMemberRoleEditor:
+		return "editor"
+	case VDBMemberRoleAdmin:
+		return "admin"
+	case VDBMemberRoleOwner:
+		return "owner"
+	default:
+		return ""
+	}
+}
+
+var VDBMemberRoles = struct {
+	Viewer VDBMemberRole
+	Editor VDBMemberRole
+	Admin  VDBMemberRole
+	Owner  VDBMemberRole
+}{
+	VDBMemberRoleViewer,
+	VDBMemberRoleEditor,
+	VDBMemberRoleAdmin,
+	VDBMemberRoleOwner,
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	dataaccess "github.com/gobitfly/beaconchain/pkg/api/data_access"
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	"github.com/gobitfly/beaconchain/pkg/api/types"
 	"github.com/gobitfly/beaconchain/pkg/commons/log"
 	"github.com/gobitfly/beaconchain/pkg/commons/mail"
@@ -33,6 +34,7 @@ const (
 const authConfirmEmailRateLimit = time.Minute * 2
 const authResetEmailRateLimit = time.Minute * 2
 const authEmailExpireTime = time.Minute * 30
+const authDashboardInviteExpireTime = time.Hour * 24 * 7
 
 var errBadCredentials = newUnauthorizedErr("invalid email or password")
 
@@ -116,6 +118,40 @@ Best regards,
 	return nil
 }
 
+func (h *HandlerService) sendDashboardInviteEmail(ctx context.Context, dashboardId types.VDBIdPrimary, invitedBy uint64, email string, role enums.VDBMemberRole) error {
+	dashboardName, err := h.daService.GetValidatorDashboardName(ctx, dashboardId)
+	if err != nil {
+		return err
+	}
+
+	// 1. store invite (before sending so there's no token mismatch on failure), re-inviting replaces the previous token
+	inviteToken := utils.RandomString(40)
+	err = h.daService.CreateValidatorDashboardInvite(ctx, dashboardId, invitedBy, email, role, inviteToken)
+	if err != nil {
+		return errors.New("error creating dashboard invite")
+	}
+
+	// 2. send invite email
+	subject := fmt.Sprintf("%s: You have been invited to a validator dashboard", utils.Config.Frontend.SiteDomain)
+	msg := fmt.Sprintf(`You have been invited to join the validator dashboard "%[2]s" on %[1]s as %[3]s.
+
+Log in with this email address and accept the invite by clicking this link:
+
+https://%[1]s/api/i/users/me/dashboard-invites/%[4]s
+
+The invite expires in %[5]d days.
+
+Best regards,
+
+%[1]s
+`, utils.Config.Frontend.SiteDomain, dashboardName, role.ToString(), inviteToken, int(authDashboardInviteExpireTime.Hours()/24))
+	err = mail.SendTextMail(email, subject, msg, []commonTypes.EmailAttachment{})
+	if err != nil {
+		return errors.New("error sending invite email, try again later")
+	}
+	return nil
+}
+
 // TODO move to service?
 func (h *HandlerService) sendPasswordResetEmail(ctx context.Context, userId uint64, email string) error {
 	// 0. check if password resets are allowed
@@ -202,21 +238,55 @@ func GetUserIdByContext(r *http.Request) (uint64, error) {
 	if !ok {
 		return 0, newUnauthorizedErr("user not authenticated")
 	}
+	// oauth clients may only access endpoints covered by a scope, see OAuthScopeMiddleware
+	if _, isOAuth := r.Context().Value(types.CtxOAuthScopesKey).([]string); isOAuth {
+		if checked, _ := r.Context().Value(types.CtxOAuthScopeCheckedKey).(bool); !checked {
+			return 0, newForbiddenErr("endpoint is not available with an oauth access token")
+		}
+	}
 	return userId, nil
 }
 
 // Handlers
 
-func (h *HandlerService) InternalPostOauthAuthorize(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
-
-func (h *HandlerService) InternalPostOauthToken(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
-
+// Creates a new api key for the user, the number of valid keys is limited by the api perks of the user
 func (h *HandlerService) InternalPostApiKeys(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	// an api key grants access to the account without the second factor
+	if err := h.checkRecentTwoFactor(ctx, user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	userInfo, err := h.daService.GetUserInfo(ctx, user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	apiKey, err := utils.GenerateRandomAPIKey()
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	added, err := h.daService.AddUserApiKey(ctx, user.Id, apiKey, userInfo.ApiPerks.ApiKeys)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if !added {
+		handleErr(w, r, newConflictErr("maximum number of api keys reached"))
+		return
+	}
+	returnCreated(w, r, types.InternalPostApiKeysResponse{
+		Data: types.ApiKey{
+			ApiKey:    apiKey,
+			CreatedAt: time.Now().Unix(),
+		},
+	})
 }
 
 func (h *HandlerService) InternalPostUsers(w http.ResponseWriter, r *http.Request) {
@@ -316,6 +386,48 @@ func (h *HandlerService) InternalPostUserConfirm(w http.ResponseWriter, r *http.
 	returnNoContent(w, r)
 }
 
+// dashboard invites, the invite must have been sent to the email of the logged in user
+func (h *HandlerService) InternalPostUserDashboardInvite(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	inviteToken := v.checkUserEmailToken(mux.Vars(r)["token"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	invite, err := h.daService.GetValidatorDashboardInvite(r.Context(), inviteToken)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if invite.CreatedAt.Add(authDashboardInviteExpireTime).Before(time.Now()) {
+		handleErr(w, r, newGoneErr("invite expired"))
+		return
+	}
+	userInfo, err := h.daService.GetUserInfo(r.Context(), userId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if !strings.EqualFold(userInfo.Email, invite.Email) {
+		handleErr(w, r, newForbiddenErr("invite was sent to a different email address"))
+		return
+	}
+
+	err = h.daService.AcceptValidatorDashboardInvite(r.Context(), inviteToken, userId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	returnNoContent(w, r)
+}
+
 func (h *HandlerService) InternalPostUserPasswordReset(w http.ResponseWriter, r *http.Request) {
 	var v validationError
 	req := struct {
@@ -468,19 +580,49 @@ func (h *HandlerService) InternalPostLogin(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// change privileges
-	err = h.scs.RenewToken(r.Context())
+	h.completeFirstFactorLogin(w, r, user)
+}
+
+// completeFirstFactorLogin starts the session of a user that passed the first factor,
+// if the user has a second factor the login has to be completed with it first
+func (h *HandlerService) completeFirstFactorLogin(w http.ResponseWriter, r *http.Request, user *types.UserCredentialInfo) {
+	hasTwoFactor, err := h.daService.HasUserTwoFactor(r.Context(), user.Id)
 	if err != nil {
-		handleErr(w, r, errors.New("error creating session"))
+		handleErr(w, r, err)
 		return
 	}
+	if hasTwoFactor {
+		methods, err := h.getTwoFactorMethods(r.Context(), user.Id)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+		err = h.scs.RenewToken(r.Context())
+		if err != nil {
+			handleErr(w, r, errors.New("error creating session"))
+			return
+		}
+		h.scs.Put(r.Context(), twoFactorPendingUserIdKey, user.Id)
+		h.scs.Put(r.Context(), twoFactorPendingSinceKey, time.Now())
 
-	h.scs.Put(r.Context(), authenticatedKey, true)
-	h.scs.Put(r.Context(), userIdKey, user.Id)
-	h.scs.Put(r.Context(), subscriptionKey, user.ProductId)
-	h.scs.Put(r.Context(), userGroupKey, user.UserGroup)
+		returnOk(w, r, types.InternalPostLoginResponse{
+			Data: types.LoginResult{
+				TwoFactorRequired: true,
+				TwoFactorMethods:  methods,
+			},
+		})
+		return
+	}
 
-	returnOk(w, r, nil)
+	err = h.startUserSession(r.Context(), user)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	returnOk(w, r, types.InternalPostLoginResponse{
+		Data: types.LoginResult{},
+	})
 }
 
 // Can be used to login on mobile, requires an authenticated session
@@ -745,6 +887,11 @@ func (h *HandlerService) InternalDeleteUser(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if err := h.checkRecentTwoFactor(r.Context(), user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
 	// TODO allow if user has any subsciptions etc?
 	err = h.daService.RemoveUser(r.Context(), user.Id)
 	if err != nil {
@@ -777,6 +924,10 @@ func (h *HandlerService) InternalPostUserEmail(w http.ResponseWriter, r *http.Re
 		handleErr(w, r, newConflictErr("email not confirmed"))
 		return
 	}
+	if err := h.checkRecentTwoFactor(r.Context(), user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
 
 	// validate request
 	var v validationError
@@ -852,6 +1003,10 @@ func (h *HandlerService) InternalPutUserPassword(w http.ResponseWriter, r *http.
 		handleErr(w, r, err)
 		return
 	}
+	if err := h.checkRecentTwoFactor(r.Context(), user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
 
 	// validate request
 	var v validationError
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gobitfly/beaconchain/pkg/commons/log"
 	"github.com/invopop/jsonschema"
@@ -20,6 +21,7 @@ import (
 	"github.com/gobitfly/beaconchain/pkg/api/services"
 	types "github.com/gobitfly/beaconchain/pkg/api/types"
 	commontypes "github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
 )
 
 type HandlerService struct {
@@ -190,6 +192,14 @@ func (h *HandlerService) getCurrentChartTimeLimitsForDashboard(ctx context.Conte
 	return limits, nil
 }
 
+// helper function to retrieve the chart timestamp boundaries of daily network charts, which are not limited by premium perks
+func getDailyChartTimeLimits() ChartTimeDashboardLimits {
+	return ChartTimeDashboardLimits{
+		LatestExportedTs:   uint64(time.Now().Unix()),
+		MaxAllowedInterval: chartDatapointLimit*uint64((24*time.Hour).Seconds()) - 1,
+	}
+}
+
 // getDashboardPremiumPerks gets the premium perks of the dashboard OWNER or if it's a guest dashboard, it returns free tier premium perks
 func (h *HandlerService) getDashboardPremiumPerks(ctx context.Context, id types.VDBId) (*types.PremiumPerks, error) {
 	// for guest dashboards, return free tier perks
@@ -213,6 +223,91 @@ func (h *HandlerService) getDashboardPremiumPerks(ctx context.Context, id types.
 	return &userInfo.PremiumPerks, nil
 }
 
+// getDashboardOwnerInfo gets the user info of the dashboard OWNER, premium limits of shared dashboards are counted for the owner
+func (h *HandlerService) getDashboardOwnerInfo(ctx context.Context, dashboardId types.VDBIdPrimary) (*types.UserInfo, error) {
+	dashboardUser, err := h.daService.GetValidatorDashboardUser(ctx, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+	return h.daService.GetUserInfo(ctx, dashboardUser.UserId)
+}
+
+// getDashboardRole returns the role of the user on the dashboard as determined by the VDB auth middlewares.
+// If the access check was skipped (e.g. mocked data or debug mode) the user is treated as owner.
+func getDashboardRole(r *http.Request) enums.VDBMemberRole {
+	if role, ok := r.Context().Value(types.CtxDashboardRoleKey).(enums.VDBMemberRole); ok {
+		return role
+	}
+	return enums.VDBMemberRoles.Owner
+}
+
+// audit log actions of validator dashboards
+const (
+	vdbAuditValidatorsAdded   = "validators_added"
+	vdbAuditValidatorsRemoved = "validators_removed"
+	vdbAuditGroupAdded        = "group_added"
+	vdbAuditGroupRemoved      = "group_removed"
+)
+
+// checkMemberManagement checks whether the user of the request may change the membership of the given member.
+// Admins can manage editors and viewers, only the owner can manage other admins.
+func (h *HandlerService) checkMemberManagement(r *http.Request, dashboardId types.VDBIdPrimary, memberId uint64) error {
+	role := getDashboardRole(r)
+	if role < enums.VDBMemberRoles.Admin {
+		return newForbiddenErr("only admins can manage members")
+	}
+	memberRole, err := h.getDataAccessor(r).GetValidatorDashboardRole(r.Context(), dashboardId, memberId)
+	if err != nil {
+		return err
+	}
+	if memberRole >= role {
+		return newForbiddenErr("not allowed to manage members with role %s", memberRole.ToString())
+	}
+	return nil
+}
+
+// checkRoleGrant checks whether the user of the request may give the given role to a member, only the owner can make
+// other members admins.
+func checkRoleGrant(r *http.Request, role enums.VDBMemberRole) error {
+	if role >= enums.VDBMemberRoles.Admin && getDashboardRole(r) < enums.VDBMemberRoles.Owner {
+		return newForbiddenErr("only the owner can grant the %s role", role.ToString())
+	}
+	return nil
+}
+
+// checkNotMember checks that the user with the given email isn't already the owner or a member of the dashboard,
+// otherwise an invite could be used to change the role of a member without the checks of checkMemberManagement.
+func (h *HandlerService) checkNotMember(ctx context.Context, dashboardId types.VDBIdPrimary, email string) error {
+	userId, err := h.daService.GetUserByEmail(ctx, email)
+	if errors.Is(err, dataaccess.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = h.daService.GetValidatorDashboardRole(ctx, dashboardId, userId)
+	if errors.Is(err, dataaccess.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return newConflictErr("%s is already a member of the dashboard", email)
+}
+
+// recordDashboardChange adds an entry to the audit log of a dashboard.
+// The change itself already happened at this point, so failures are only logged.
+func (h *HandlerService) recordDashboardChange(r *http.Request, dashboardId types.VDBIdPrimary, action string, groupId *uint64, validators []types.VDBValidator) {
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		return
+	}
+	err = h.getDataAccessor(r).AddValidatorDashboardAuditLogEntry(r.Context(), dashboardId, userId, action, groupId, validators)
+	if err != nil {
+		log.Error(err, "error adding dashboard audit log entry", 0, map[string]interface{}{"dashboard_id": dashboardId, "action": action})
+	}
+}
+
 // getMaxChartAge returns the maximum age of a chart in seconds based on the given aggregation type and premium perks
 func getMaxChartAge(aggregation enums.ChartAggregation, perkSeconds types.ChartHistorySeconds) uint64 {
 	aggregations := enums.ChartAggregations
@@ -510,6 +605,8 @@ var dbEventToResponse = map[string]string{
 	string(commontypes.ValidatorUpcomingProposalEventName):         "proposal_upcoming",
 	string(commontypes.SyncCommitteeSoonEventName):                 "sync",
 	string(commontypes.ValidatorReceivedWithdrawalEventName):       "withdrawal",
+	string(commontypes.ValidatorConsolidationEventName):            "consolidation",
+	string(commontypes.ValidatorMissedBestBidEventName):            "missed_best_bid",
 	string(commontypes.ValidatorGotSlashedEventName):               "validator_got_slashed",
 	string(commontypes.ValidatorDidSlashEventName):                 "validator_has_slashed",
 	string(commontypes.ValidatorGroupEfficiencyEventName):          "group_efficiency_below",
@@ -615,3 +712,33 @@ func isMocked(r *http.Request) bool {
 	isMocked, ok := r.Context().Value(types.CtxIsMockedKey).(bool)
 	return ok && isMocked
 }
+
+// valueRewardsAtHistoricPrices sets the value of each reward in the given currency at the price of the day of its epoch
+func valueRewardsAtHistoricPrices(ctx context.Context, da dataaccess.DataAccessor, rows []types.VDBRewardsTableRow, currency string) error {
+	days := make([]time.Time, 0, len(rows))
+	for _, row := range rows {
+		days = append(days, utils.EpochToTime(row.Epoch))
+	}
+	clPrices, err := da.GetDailyPrices(ctx, utils.Config.Frontend.ClCurrency, currency, days)
+	if err != nil {
+		return err
+	}
+	elPrices, err := da.GetDailyPrices(ctx, utils.Config.Frontend.ElCurrency, currency, days)
+	if err != nil {
+		return err
+	}
+	for i := range rows {
+		day := utils.EpochToTime(rows[i].Epoch).UTC().Truncate(time.Hour * 24)
+		clPrice, clOk := clPrices[day]
+		elPrice, elOk := elPrices[day]
+		if !clOk || !elOk {
+			// no price history for this day (yet)
+			continue
+		}
+		rows[i].RewardFiat = &types.ClElValue[float64]{
+			Cl: rows[i].Reward.Cl.Shift(-18).InexactFloat64() * clPrice,
+			El: rows[i].Reward.El.Shift(-18).InexactFloat64() * elPrice,
+		}
+	}
+	return nil
+}
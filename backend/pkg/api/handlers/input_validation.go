@@ -9,15 +9,21 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	"github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/price"
+	commonTypes "github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
 	"github.com/gorilla/mux"
 	"github.com/invopop/jsonschema"
 	"github.com/shopspring/decimal"
+	go_ens "github.com/wealdtech/go-ens/v3"
 	"github.com/xeipuuv/gojsonschema"
 )
 
@@ -32,6 +38,7 @@ var (
 	reValidatorPublicKey           = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{96}$`)
 	reValidatorList                = regexp.MustCompile(`^(0x[0-9a-fA-F]{96}|[0-9]+)(,\s*(0x[0-9a-fA-F]{96}|[0-9]+)\s*)+$`)
 	reEthereumAddress              = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{40}$`)
+	reTransactionHash              = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{64}$`)
 	reWithdrawalCredential         = regexp.MustCompile(`^(0x0[01])?[0-9a-fA-F]{62}$`)
 	reEnsName                      = regexp.MustCompile(`^.+\.eth$`)
 	reGraffiti                     = regexp.MustCompile(`^.{2,}$`)          // at least 2 characters, so that queries won't time out
@@ -39,12 +46,18 @@ var (
 	reEmail                        = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
 	rePassword                     = regexp.MustCompile(`^.{5,}$`)
 	reEmailUserToken               = regexp.MustCompile(`^[a-z0-9]{40}$`)
+	reBroadcastId                  = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	reOAuthCodeChallenge           = regexp.MustCompile(`^[A-Za-z0-9_-]{43}$`)       // base64url encoded sha256 hash
+	reOAuthCodeVerifier            = regexp.MustCompile(`^[A-Za-z0-9._~-]{43,128}$`) // see RFC 7636
+	reTotpCode                     = regexp.MustCompile(`^[0-9]{6}$`)
+	reRecoveryCode                 = regexp.MustCompile(`^[a-z0-9]{10}$`) // without the separator
 	reJsonContentType              = regexp.MustCompile(`^application\/json(;.*)?$`)
 )
 
 const (
 	maxNameLength                     = 50
 	maxValidatorsInList               = 20
+	maxEnsBatchEntries                = 100
 	maxQueryLimit              uint64 = 100
 	defaultReturnLimit         uint64 = 10
 	sortOrderAscending                = "asc"
@@ -139,6 +152,23 @@ func (v *validationError) checkUserEmailToken(token string) string {
 	return v.checkRegex(reEmailUserToken, token, "token")
 }
 
+func (v *validationError) checkBroadcastId(id string) string {
+	return v.checkRegex(reBroadcastId, id, "broadcast_id")
+}
+
+// returns the normalised name, ens names are stored and resolved in their normalised form
+func (v *validationError) checkEnsName(name string, paramName string) string {
+	if v.checkRegex(reEnsName, name, paramName) == "" {
+		return ""
+	}
+	normalised, err := go_ens.NormaliseDomain(name)
+	if err != nil {
+		v.add(paramName, fmt.Sprintf("given value '%s' is not a valid ens name", name))
+		return ""
+	}
+	return normalised
+}
+
 // check request structure (body contains valid json and all required parameters are present)
 // return error only if internal error occurs, otherwise add error to validationError and/or return nil
 func (v *validationError) checkBody(data interface{}, r *http.Request) error {
@@ -344,6 +374,34 @@ func (v *validationError) checkAddress(publicId string) string {
 	return v.checkRegex(reEthereumAddress, publicId, "address")
 }
 
+func (v *validationError) checkTransactionHash(hash string) []byte {
+	return common.FromHex(v.checkRegex(reTransactionHash, hash, "hash"))
+}
+
+// helper function to unify handling of address table request validation
+func validateAddressTableRequest(r *http.Request) (uint64, []byte, Paging, error) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkNetworkParameter(vars["network"])
+	address := common.FromHex(v.checkAddress(vars["address"]))
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		return 0, nil, Paging{}, v
+	}
+	return chainId, address, pagingParams, nil
+}
+
+// helper function to unify handling of layer 2 table request validation
+func validateLayer2TableRequest(r *http.Request) (uint64, Paging, error) {
+	var v validationError
+	chainId := v.checkLayer2NetworkParameter(mux.Vars(r)["layer_2_network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		return 0, Paging{}, v
+	}
+	return chainId, pagingParams, nil
+}
+
 func (v *validationError) checkUintMinMax(param string, min uint64, max uint64, paramName string) uint64 {
 	return checkMinMax(v, v.checkUint(param, paramName), min, max, paramName)
 }
@@ -427,13 +485,47 @@ func (v *validationError) checkProtocolModes(protocolModes string) types.VDBProt
 		switch protocolMode {
 		case "rocket_pool":
 			modes.RocketPool = true
+		case "ssv":
+			modes.Ssv = true
 		default:
-			v.add("modes", fmt.Sprintf("given value '%s' is not a valid protocol mode", protocolMode))
+			if !slices.Contains(commonTypes.StakingProtocols, protocolMode) {
+				v.add("modes", fmt.Sprintf("given value '%s' is not a valid protocol mode", protocolMode))
+				continue
+			}
+			if !slices.Contains(modes.StakingProtocols, protocolMode) {
+				modes.StakingProtocols = append(modes.StakingProtocols, protocolMode)
+			}
 		}
 	}
 	return modes
 }
 
+// checkCredentialTypes parses a comma separated list of withdrawal credential types (0x00, 0x01, 0x02)
+func (v *validationError) checkCredentialTypes(credentialTypes string) []uint8 {
+	var result []uint8
+	if credentialTypes == "" {
+		return result
+	}
+	for _, credentialType := range splitParameters(credentialTypes, ',') {
+		parsed, err := strconv.ParseUint(strings.TrimPrefix(credentialType, "0x"), 16, 8)
+		if !strings.HasPrefix(credentialType, "0x") || err != nil || !slices.Contains(utils.WithdrawalCredentialsTypes, uint8(parsed)) {
+			v.add("credential_types", fmt.Sprintf("given value '%s' is not a valid withdrawal credential type", credentialType))
+			continue
+		}
+		if !slices.Contains(result, uint8(parsed)) {
+			result = append(result, uint8(parsed))
+		}
+	}
+	return result
+}
+
+func (v *validationError) checkStakingProtocol(protocol string) string {
+	if !slices.Contains(commonTypes.StakingProtocols, protocol) {
+		v.add("protocol", fmt.Sprintf("given value '%s' is not a supported staking protocol", protocol))
+	}
+	return protocol
+}
+
 func (v *validationError) checkValidatorList(validators string, allowEmpty bool) ([]types.VDBValidator, []string) {
 	if validators == "" && !allowEmpty {
 		v.add("validators", "list of validators must not be empty")
@@ -512,6 +604,17 @@ func (v *validationError) checkNetworksParameter(param string) []uint64 {
 	return chainIds
 }
 
+// checkLayer2NetworkParameter accepts the name or chain id of one of the configured layer 2 networks
+func (v *validationError) checkLayer2NetworkParameter(param string) uint64 {
+	for _, network := range utils.Config.Layer2Networks {
+		if network.Name == param || strconv.FormatUint(network.ChainId, 10) == param {
+			return network.ChainId
+		}
+	}
+	v.add("layer_2_network", fmt.Sprintf("given value '%s' is not a valid layer 2 network", param))
+	return 0
+}
+
 // isValidNetwork checks if the given network is a valid network.
 // It returns the chain id of the network and true if it is valid, otherwise 0 and false.
 func isValidNetwork(network intOrString) (uint64, bool) {
@@ -523,6 +626,17 @@ func isValidNetwork(network intOrString) (uint64, bool) {
 	return 0, false
 }
 
+// checkCurrency returns the default currency if the param is empty
+func (v *validationError) checkCurrency(param, defaultCurrency string) string {
+	if param == "" {
+		return defaultCurrency
+	}
+	if !price.IsAvailableCurrency(param) {
+		v.add("currency", fmt.Sprintf("given value '%s' is not a supported currency", param))
+	}
+	return param
+}
+
 func (v *validationError) checkTimestamps(r *http.Request, chartLimits ChartTimeDashboardLimits) (after uint64, before uint64) {
 	afterParam := r.URL.Query().Get("after_ts")
 	beforeParam := r.URL.Query().Get("before_ts")
@@ -420,6 +420,34 @@ func (h *HandlerService) InternalPutValidatorDashboardArchiving(w http.ResponseW
 	h.PublicPutValidatorDashboardArchiving(w, r)
 }
 
+func (h *HandlerService) InternalGetValidatorDashboardMembers(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetValidatorDashboardMembers(w, r)
+}
+
+func (h *HandlerService) InternalPostValidatorDashboardInvites(w http.ResponseWriter, r *http.Request) {
+	h.PublicPostValidatorDashboardInvites(w, r)
+}
+
+func (h *HandlerService) InternalDeleteValidatorDashboardInvite(w http.ResponseWriter, r *http.Request) {
+	h.PublicDeleteValidatorDashboardInvite(w, r)
+}
+
+func (h *HandlerService) InternalPutValidatorDashboardMember(w http.ResponseWriter, r *http.Request) {
+	h.PublicPutValidatorDashboardMember(w, r)
+}
+
+func (h *HandlerService) InternalDeleteValidatorDashboardMember(w http.ResponseWriter, r *http.Request) {
+	h.PublicDeleteValidatorDashboardMember(w, r)
+}
+
+func (h *HandlerService) InternalGetValidatorDashboardAuditLog(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetValidatorDashboardAuditLog(w, r)
+}
+
+func (h *HandlerService) InternalPutValidatorDashboardTwoFactorRequirement(w http.ResponseWriter, r *http.Request) {
+	h.PublicPutValidatorDashboardTwoFactorRequirement(w, r)
+}
+
 func (h *HandlerService) InternalGetValidatorDashboardSlotViz(w http.ResponseWriter, r *http.Request) {
 	h.PublicGetValidatorDashboardSlotViz(w, r)
 }
@@ -492,10 +520,38 @@ func (h *HandlerService) InternalGetValidatorDashboardTotalWithdrawals(w http.Re
 	h.PublicGetValidatorDashboardTotalWithdrawals(w, r)
 }
 
+func (h *HandlerService) InternalGetValidatorDashboardWithdrawalRequests(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetValidatorDashboardWithdrawalRequests(w, r)
+}
+
+func (h *HandlerService) InternalGetValidatorDashboardConsolidations(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetValidatorDashboardConsolidations(w, r)
+}
+
+func (h *HandlerService) InternalGetValidatorDashboardPendingQueues(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetValidatorDashboardPendingQueues(w, r)
+}
+
+func (h *HandlerService) InternalGetValidatorDashboardGossip(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetValidatorDashboardGossip(w, r)
+}
+
+func (h *HandlerService) InternalGetValidatorDashboardMevBids(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetValidatorDashboardMevBids(w, r)
+}
+
+func (h *HandlerService) InternalGetValidatorDashboardSsvClusters(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetValidatorDashboardSsvClusters(w, r)
+}
+
 func (h *HandlerService) InternalGetValidatorDashboardRocketPool(w http.ResponseWriter, r *http.Request) {
 	h.PublicGetValidatorDashboardRocketPool(w, r)
 }
 
+func (h *HandlerService) InternalGetValidatorDashboardSyncCommittees(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetValidatorDashboardSyncCommittees(w, r)
+}
+
 func (h *HandlerService) InternalGetValidatorDashboardTotalRocketPool(w http.ResponseWriter, r *http.Request) {
 	h.PublicGetValidatorDashboardTotalRocketPool(w, r)
 }
@@ -703,22 +759,7 @@ func (h *HandlerService) InternalGetBlockOverview(w http.ResponseWriter, r *http
 }
 
 func (h *HandlerService) InternalGetBlockTransactions(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "block")
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	data, err := h.daService.GetBlockTransactions(r.Context(), chainId, block)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	response := types.InternalGetBlockTransactionsResponse{
-		Data: data,
-	}
-	returnOk(w, r, response)
+	h.PublicGetNetworkBlockTransactions(w, r)
 }
 
 func (h *HandlerService) InternalGetBlockVotes(w http.ResponseWriter, r *http.Request) {
@@ -876,22 +917,7 @@ func (h *HandlerService) InternalGetSlotOverview(w http.ResponseWriter, r *http.
 }
 
 func (h *HandlerService) InternalGetSlotTransactions(w http.ResponseWriter, r *http.Request) {
-	chainId, block, err := h.validateBlockRequest(r, "slot")
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	data, err := h.daService.GetSlotTransactions(r.Context(), chainId, block)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-
-	response := types.InternalGetBlockTransactionsResponse{
-		Data: data,
-	}
-	returnOk(w, r, response)
+	h.PublicGetNetworkSlotTransactions(w, r)
 }
 
 func (h *HandlerService) InternalGetSlotVotes(w http.ResponseWriter, r *http.Request) {
@@ -1011,3 +1037,14 @@ func (h *HandlerService) InternalGetSlotBlobs(w http.ResponseWriter, r *http.Req
 func (h *HandlerService) ReturnOk(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
+
+// --------------------------------------
+// Sync Committees
+
+func (h *HandlerService) InternalGetNetworkSyncCommittee(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetNetworkSyncCommittee(w, r)
+}
+
+func (h *HandlerService) InternalGetNetworkSyncCommitteeSlots(w http.ResponseWriter, r *http.Request) {
+	h.PublicGetNetworkSyncCommitteeSlots(w, r)
+}
@@ -7,15 +7,37 @@ import (
 	"slices"
 	"strconv"
 
+	dataaccess "github.com/gobitfly/beaconchain/pkg/api/data_access"
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	"github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
 	"github.com/gorilla/mux"
 )
 
 // Middlewares
 
-// middleware that stores user id in context, using the provided function
-func StoreUserIdMiddleware(next http.Handler, userIdFunc func(r *http.Request) (uint64, error)) http.Handler {
+// middleware that stores user id in context, using the provided function.
+// oauth access tokens are accepted as well, the granted scopes are stored alongside the user id.
+func (h *HandlerService) StoreUserIdMiddleware(next http.Handler, userIdFunc func(r *http.Request) (uint64, error)) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if accessToken, ok := getOAuthAccessToken(r); ok {
+			token, err := h.daService.GetOAuthAccessToken(r.Context(), utils.HashAndEncode(accessToken))
+			if errors.Is(err, dataaccess.ErrNotFound) {
+				handleErr(w, r, newUnauthorizedErr("invalid or expired access token"))
+				return
+			}
+			if err != nil {
+				handleErr(w, r, err)
+				return
+			}
+			ctx := r.Context()
+			ctx = context.WithValue(ctx, types.CtxUserIdKey, token.UserId)
+			ctx = context.WithValue(ctx, types.CtxOAuthScopesKey, token.Scopes)
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		userId, err := userIdFunc(r)
 		if err != nil {
 			if errors.Is(err, errUnauthorized) {
@@ -37,20 +59,86 @@ func StoreUserIdMiddleware(next http.Handler, userIdFunc func(r *http.Request) (
 
 // middleware that stores user id in context, using the session to get the user id
 func (h *HandlerService) StoreUserIdBySessionMiddleware(next http.Handler) http.Handler {
-	return StoreUserIdMiddleware(next, func(r *http.Request) (uint64, error) {
+	return h.StoreUserIdMiddleware(next, func(r *http.Request) (uint64, error) {
 		return h.GetUserIdBySession(r)
 	})
 }
 
 // middleware that stores user id in context, using the api key to get the user id
 func (h *HandlerService) StoreUserIdByApiKeyMiddleware(next http.Handler) http.Handler {
-	return StoreUserIdMiddleware(next, func(r *http.Request) (uint64, error) {
+	return h.StoreUserIdMiddleware(next, func(r *http.Request) (uint64, error) {
 		return h.GetUserIdByApiKey(r)
 	})
 }
 
-// middleware that checks if user has access to dashboard when a primary id is used
+// middleware that limits requests authenticated by an oauth access token to endpoints covered by the granted scopes
+func (h *HandlerService) OAuthScopeMiddleware(requiredScope func(r *http.Request) enums.OAuthScope) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, isOAuth := r.Context().Value(types.CtxOAuthScopesKey).([]string); !isOAuth {
+				next.ServeHTTP(w, r)
+				return
+			}
+			scope := requiredScope(r)
+			if !hasOAuthScope(r, scope) {
+				handleErr(w, r, newForbiddenErr("access token lacks the %s scope", scope.ToString()))
+				return
+			}
+			ctx := context.WithValue(r.Context(), types.CtxOAuthScopeCheckedKey, true)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// dashboards can be read with the read scope, all other requests require the manage scope
+func (h *HandlerService) DashboardsOAuthScopeMiddleware(next http.Handler) http.Handler {
+	return h.OAuthScopeMiddleware(func(r *http.Request) enums.OAuthScope {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			return enums.OAuthScopes.DashboardsRead
+		}
+		return enums.OAuthScopes.DashboardsManage
+	})(next)
+}
+
+func (h *HandlerService) NotificationsOAuthScopeMiddleware(next http.Handler) http.Handler {
+	return h.OAuthScopeMiddleware(func(r *http.Request) enums.OAuthScope {
+		return enums.OAuthScopes.NotificationsManage
+	})(next)
+}
+
+// middleware that checks if user has access to dashboard when a primary id is used.
+// read requests require the viewer role, all other requests at least the editor role.
 func (h *HandlerService) VDBAuthMiddleware(next http.Handler) http.Handler {
+	return h.vdbAuthMiddleware(next, func(r *http.Request) enums.VDBMemberRole {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			return enums.VDBMemberRoles.Viewer
+		}
+		return enums.VDBMemberRoles.Editor
+	})
+}
+
+// middleware that checks if user is a member of the dashboard with any role, for endpoints that check the role themselves
+func (h *HandlerService) VDBMemberAuthMiddleware(next http.Handler) http.Handler {
+	return h.vdbAuthMiddleware(next, func(r *http.Request) enums.VDBMemberRole {
+		return enums.VDBMemberRoles.Viewer
+	})
+}
+
+// middleware that additionally requires the given role, must be used after one of the VDB auth middlewares
+func (h *HandlerService) VDBRoleMiddleware(role enums.VDBMemberRole) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// no role is stored if the access check was skipped
+			if userRole, ok := r.Context().Value(types.CtxDashboardRoleKey).(enums.VDBMemberRole); ok && userRole < role {
+				handleErr(w, r, newForbiddenErr("the %s role is required for this action", role.ToString()))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (h *HandlerService) vdbAuthMiddleware(next http.Handler, requiredRole func(r *http.Request) enums.VDBMemberRole) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// if mock data is used, no need to check access
 		if isMocked, ok := r.Context().Value(types.CtxIsMockedKey).(bool); ok && isMocked {
@@ -71,19 +159,44 @@ func (h *HandlerService) VDBAuthMiddleware(next http.Handler) http.Handler {
 			handleErr(w, r, err)
 			return
 		}
-		dashboardUser, err := h.daService.GetValidatorDashboardUser(r.Context(), types.VDBIdPrimary(dashboardId))
+		role, err := h.daService.GetValidatorDashboardRole(r.Context(), types.VDBIdPrimary(dashboardId), userId)
+		if errors.Is(err, dataaccess.ErrNotFound) {
+			// user does not have access to dashboard
+			// the proper error would be 403 Forbidden, but we don't want to leak information so we return 404 Not Found
+			handleErr(w, r, newNotFoundErr("dashboard with id %v not found", dashboardId))
+			return
+		}
 		if err != nil {
 			handleErr(w, r, err)
 			return
 		}
-
-		if dashboardUser.UserId != userId {
-			// user does not have access to dashboard
-			// the proper error would be 403 Forbidden, but we don't want to leak information so we return 404 Not Found
-			handleErr(w, r, newNotFoundErr("dashboard with id %v not found", dashboardId))
+		if role < requiredRole(r) {
+			handleErr(w, r, newForbiddenErr("your role on dashboard %v does not allow this action", dashboardId))
 			return
 		}
+		if role != enums.VDBMemberRoles.Owner {
+			requireTwoFactor, err := h.daService.GetValidatorDashboardTwoFactorRequired(r.Context(), types.VDBIdPrimary(dashboardId))
+			if err != nil {
+				handleErr(w, r, err)
+				return
+			}
+			if requireTwoFactor {
+				hasTwoFactor, err := h.daService.HasUserTwoFactor(r.Context(), userId)
+				if err != nil {
+					handleErr(w, r, err)
+					return
+				}
+				if !hasTwoFactor {
+					handleErr(w, r, newForbiddenErr("dashboard %v requires two factor authentication to be enabled on your account", dashboardId))
+					return
+				}
+			}
+		}
 
+		// store role in context
+		ctx := r.Context()
+		ctx = context.WithValue(ctx, types.CtxDashboardRoleKey, role)
+		r = r.WithContext(ctx)
 		next.ServeHTTP(w, r)
 	})
 }
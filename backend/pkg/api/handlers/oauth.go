@@ -0,0 +1,485 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	dataaccess "github.com/gobitfly/beaconchain/pkg/api/data_access"
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
+	"github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/gorilla/mux"
+)
+
+// OAuth 2.0 authorization code flow with PKCE (RFC 6749, RFC 7636) for third party apps.
+// Apps are registered in the oauth_apps table with a client id, tokens are opaque and only stored hashed.
+
+const (
+	oauthCodeExpireTime         = time.Minute * 10
+	oauthAccessTokenExpireTime  = time.Hour
+	oauthRefreshTokenExpireTime = time.Hour * 24 * 30
+
+	oauthAccessTokenPrefix  = "oat_"
+	oauthRefreshTokenPrefix = "ort_"
+)
+
+// error response as defined in RFC 6749 section 5.2
+type oauthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e oauthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+func returnOAuthError(w http.ResponseWriter, r *http.Request, code int, err oauthError) {
+	if code == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeResponse(w, r, code, err)
+}
+
+type oauthAuthorizeRequest struct {
+	ResponseType        string `json:"response_type"`
+	ClientId            string `json:"client_id"`
+	RedirectUri         string `json:"redirect_uri"`
+	Scope               string `json:"scope"`
+	State               string `json:"state"`
+	CodeChallenge       string `json:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method"`
+}
+
+// checkOAuthAuthorizeRequest validates an authorization request.
+// If the client or its redirect uri is invalid, an error is returned and the user must not be redirected.
+// Errors of the remaining parameters are returned as oauth error, to be passed on to the client via the redirect uri.
+func (h *HandlerService) checkOAuthAuthorizeRequest(r *http.Request, req *oauthAuthorizeRequest) (*types.OAuthClientApp, []string, *oauthError, error) {
+	app, err := h.daService.GetOAuthAppByClientId(r.Context(), req.ClientId)
+	if errors.Is(err, dataaccess.ErrNotFound) {
+		return nil, nil, nil, newBadRequestErr("unknown client_id")
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if req.RedirectUri == "" {
+		req.RedirectUri = app.RedirectUri
+	}
+	if req.RedirectUri != app.RedirectUri {
+		return nil, nil, nil, newBadRequestErr("redirect_uri does not match the registered redirect uri")
+	}
+
+	if req.ResponseType != "code" {
+		return app, nil, &oauthError{"unsupported_response_type", "only the authorization code flow is supported"}, nil
+	}
+	// PKCE is required for all clients, the plain method doesn't protect against intercepted codes
+	if req.CodeChallengeMethod != "S256" || !reOAuthCodeChallenge.MatchString(req.CodeChallenge) {
+		return app, nil, &oauthError{"invalid_request", "a code_challenge with code_challenge_method S256 is required"}, nil
+	}
+	scopes, oauthErr := checkOAuthScopes(req.Scope, app.Scopes)
+	if oauthErr != nil {
+		return app, nil, oauthErr, nil
+	}
+	return app, scopes, nil, nil
+}
+
+// checkOAuthScopes parses the space separated scope parameter, all scopes must be allowed for the app
+func checkOAuthScopes(scope string, allowedScopes []string) ([]string, *oauthError) {
+	var scopes []string
+	for _, s := range strings.Fields(scope) {
+		if enums.IsInvalidEnum(enums.OAuthScope(0).NewFromString(s)) {
+			return nil, &oauthError{"invalid_scope", "unknown scope " + s}
+		}
+		if !slices.Contains(allowedScopes, s) {
+			return nil, &oauthError{"invalid_scope", "scope " + s + " is not allowed for this client"}
+		}
+		if !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+	if len(scopes) == 0 {
+		return nil, &oauthError{"invalid_scope", "at least one scope is required"}
+	}
+	slices.Sort(scopes)
+	return scopes, nil
+}
+
+func oauthRedirectUri(redirectUri string, params url.Values) (string, error) {
+	u, err := url.Parse(redirectUri)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	for key, values := range params {
+		for _, value := range values {
+			if value != "" {
+				q.Add(key, value)
+			}
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// authenticateOAuthClient checks the client credentials of a token or revocation request.
+// Confidential clients must authenticate with their secret, either via basic auth or the request body.
+func (h *HandlerService) authenticateOAuthClient(r *http.Request) (*types.OAuthClientApp, *oauthError, error) {
+	clientId, clientSecret, hasBasicAuth := r.BasicAuth()
+	if !hasBasicAuth {
+		clientId = r.PostForm.Get("client_id")
+		clientSecret = r.PostForm.Get("client_secret")
+	}
+	if clientId == "" {
+		return nil, &oauthError{"invalid_client", "missing client_id"}, nil
+	}
+	app, err := h.daService.GetOAuthAppByClientId(r.Context(), clientId)
+	if errors.Is(err, dataaccess.ErrNotFound) {
+		return nil, &oauthError{"invalid_client", "unknown client_id"}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if app.ClientSecretHash != "" && subtle.ConstantTimeCompare([]byte(utils.HashAndEncode(clientSecret)), []byte(app.ClientSecretHash)) != 1 {
+		return nil, &oauthError{"invalid_client", "invalid client credentials"}, nil
+	}
+	return app, nil, nil
+}
+
+func verifyPkceChallenge(verifier, challenge string) bool {
+	hash := sha256.Sum256([]byte(verifier))
+	return subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(hash[:])), []byte(challenge)) == 1
+}
+
+func newOAuthTokenPair() (accessToken, refreshToken string, accessExpiresAt, refreshExpiresAt time.Time) {
+	now := time.Now()
+	return oauthAccessTokenPrefix + utils.RandomString(40), oauthRefreshTokenPrefix + utils.RandomString(40),
+		now.Add(oauthAccessTokenExpireTime), now.Add(oauthRefreshTokenExpireTime)
+}
+
+func returnOAuthTokens(w http.ResponseWriter, r *http.Request, accessToken, refreshToken string, scopes []string) {
+	w.Header().Set("Cache-Control", "no-store")
+	returnOk(w, r, types.OAuthTokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    uint64(oauthAccessTokenExpireTime.Seconds()),
+		RefreshToken: refreshToken,
+		Scope:        strings.Join(scopes, " "),
+	})
+}
+
+// getOAuthAccessToken returns the oauth access token passed as bearer token, if any
+func getOAuthAccessToken(r *http.Request) (string, bool) {
+	token, isBearer := strings.CutPrefix(r.Header.Get("Authorization"), authHeaderPrefix)
+	if !isBearer || !strings.HasPrefix(token, oauthAccessTokenPrefix) {
+		return "", false
+	}
+	return token, true
+}
+
+// hasOAuthScope checks if the request may access endpoints covered by the given scope.
+// Requests not authenticated by an oauth access token are not limited by scopes.
+func hasOAuthScope(r *http.Request, scope enums.OAuthScope) bool {
+	grantedScopes, isOAuth := r.Context().Value(types.CtxOAuthScopesKey).([]string)
+	if !isOAuth {
+		return true
+	}
+	if slices.Contains(grantedScopes, scope.ToString()) {
+		return true
+	}
+	// managing dashboards includes reading them
+	return scope == enums.OAuthScopes.DashboardsRead && slices.Contains(grantedScopes, enums.OAuthScopes.DashboardsManage.ToString())
+}
+
+// Handlers
+
+// returns the app name and scopes to display on the consent screen
+func (h *HandlerService) InternalGetOauthAuthorize(w http.ResponseWriter, r *http.Request) {
+	if _, err := GetUserIdByContext(r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	q := r.URL.Query()
+	req := oauthAuthorizeRequest{
+		ResponseType:        q.Get("response_type"),
+		ClientId:            q.Get("client_id"),
+		RedirectUri:         q.Get("redirect_uri"),
+		Scope:               q.Get("scope"),
+		State:               q.Get("state"),
+		CodeChallenge:       q.Get("code_challenge"),
+		CodeChallengeMethod: q.Get("code_challenge_method"),
+	}
+	app, scopes, oauthErr, err := h.checkOAuthAuthorizeRequest(r, &req)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if oauthErr != nil {
+		handleErr(w, r, newBadRequestErr("%s", oauthErr.Error()))
+		return
+	}
+	returnOk(w, r, types.GetOAuthAuthorizeInfoResponse{
+		Data: types.OAuthAuthorizeInfo{
+			AppName: app.AppName,
+			Scopes:  scopes,
+		},
+	})
+}
+
+// called once the user consented, returns the redirect uri including the authorization code.
+// if the user denies access, the frontend redirects with error=access_denied itself.
+func (h *HandlerService) InternalPostOauthAuthorize(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	var req oauthAuthorizeRequest
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	app, scopes, oauthErr, err := h.checkOAuthAuthorizeRequest(r, &req)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	params := url.Values{"state": {req.State}}
+	if oauthErr != nil {
+		params.Set("error", oauthErr.Code)
+		params.Set("error_description", oauthErr.Description)
+	} else {
+		code := utils.RandomString(40)
+		err = h.daService.CreateOAuthAuthorizationCode(r.Context(), utils.HashAndEncode(code), types.OAuthAuthorizationCode{
+			AppId:         app.Id,
+			UserId:        userId,
+			RedirectUri:   req.RedirectUri,
+			Scopes:        scopes,
+			CodeChallenge: req.CodeChallenge,
+		})
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+		params.Set("code", code)
+	}
+	redirectUri, err := oauthRedirectUri(req.RedirectUri, params)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnOk(w, r, types.PostOAuthAuthorizeResponse{
+		Data: types.OAuthAuthorizeRedirect{RedirectUri: redirectUri},
+	})
+}
+
+// token endpoint, exchanges authorization codes and rotates refresh tokens.
+// Request and response must conform to the OAuth spec.
+func (h *HandlerService) InternalPostOauthToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_request", "request body must be form encoded"})
+		return
+	}
+	app, oauthErr, err := h.authenticateOAuthClient(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if oauthErr != nil {
+		returnOAuthError(w, r, http.StatusUnauthorized, *oauthErr)
+		return
+	}
+
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		h.exchangeOAuthAuthorizationCode(w, r, app)
+	case "refresh_token":
+		h.refreshOAuthToken(w, r, app)
+	default:
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"unsupported_grant_type", "grant_type must be authorization_code or refresh_token"})
+	}
+}
+
+func (h *HandlerService) exchangeOAuthAuthorizationCode(w http.ResponseWriter, r *http.Request, app *types.OAuthClientApp) {
+	codeVerifier := r.PostForm.Get("code_verifier")
+	if !reOAuthCodeVerifier.MatchString(codeVerifier) {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_request", "invalid or missing code_verifier"})
+		return
+	}
+	codeHash := utils.HashAndEncode(r.PostForm.Get("code"))
+	code, err := h.daService.GetOAuthAuthorizationCode(r.Context(), codeHash)
+	if errors.Is(err, dataaccess.ErrNotFound) {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_grant", "invalid authorization code"})
+		return
+	}
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	// codes of other clients are left untouched, so a client can't invalidate them
+	if code.AppId != app.Id {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_grant", "invalid authorization code"})
+		return
+	}
+	if code.GrantId != 0 {
+		// the code was used twice, revoke the tokens issued for it as the code may have been stolen (RFC 6749 section 4.1.2)
+		if err := h.daService.RevokeOAuthGrant(r.Context(), code.GrantId); err != nil {
+			log.Error(err, "error revoking oauth grant after authorization code reuse", 0, log.Fields{"grant_id": code.GrantId})
+		}
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_grant", "authorization code has already been used"})
+		return
+	}
+	if code.CreatedAt.Add(oauthCodeExpireTime).Before(time.Now()) {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_grant", "invalid authorization code"})
+		return
+	}
+	if redirectUri := r.PostForm.Get("redirect_uri"); redirectUri != "" && redirectUri != code.RedirectUri {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_grant", "redirect_uri does not match the authorization request"})
+		return
+	}
+	if !verifyPkceChallenge(codeVerifier, code.CodeChallenge) {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_grant", "code_verifier does not match the code_challenge"})
+		return
+	}
+
+	accessToken, refreshToken, accessExpiresAt, refreshExpiresAt := newOAuthTokenPair()
+	err = h.daService.ExchangeOAuthAuthorizationCode(r.Context(), codeHash, utils.HashAndEncode(accessToken), utils.HashAndEncode(refreshToken), accessExpiresAt, refreshExpiresAt)
+	if errors.Is(err, dataaccess.ErrNotFound) {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_grant", "authorization code has already been used"})
+		return
+	}
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnOAuthTokens(w, r, accessToken, refreshToken, code.Scopes)
+}
+
+func (h *HandlerService) refreshOAuthToken(w http.ResponseWriter, r *http.Request, app *types.OAuthClientApp) {
+	oldRefreshTokenHash := utils.HashAndEncode(r.PostForm.Get("refresh_token"))
+	token, err := h.daService.GetOAuthRefreshToken(r.Context(), oldRefreshTokenHash)
+	if errors.Is(err, dataaccess.ErrNotFound) {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_grant", "invalid refresh token"})
+		return
+	}
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if token.AppId != app.Id {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_grant", "invalid refresh token"})
+		return
+	}
+	if token.Rotated {
+		// a refresh token was used twice, either the client or an attacker holds a stolen token.
+		// revoke the whole grant so both have to go through the authorization again.
+		if err := h.daService.RevokeOAuthGrant(r.Context(), token.GrantId); err != nil {
+			log.Error(err, "error revoking oauth grant after refresh token reuse", 0, log.Fields{"grant_id": token.GrantId})
+		}
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_grant", "refresh token has already been used"})
+		return
+	}
+	if token.ExpiresAt.Before(time.Now()) {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_grant", "refresh token expired"})
+		return
+	}
+	if scope := r.PostForm.Get("scope"); scope != "" {
+		scopes, oauthErr := checkOAuthScopes(scope, token.Scopes)
+		if oauthErr != nil {
+			returnOAuthError(w, r, http.StatusBadRequest, *oauthErr)
+			return
+		}
+		if !slices.Equal(scopes, token.Scopes) {
+			returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_scope", "narrowing the scope of a grant is not supported"})
+			return
+		}
+	}
+
+	accessToken, refreshToken, accessExpiresAt, refreshExpiresAt := newOAuthTokenPair()
+	err = h.daService.RotateOAuthRefreshToken(r.Context(), oldRefreshTokenHash, utils.HashAndEncode(accessToken), utils.HashAndEncode(refreshToken), accessExpiresAt, refreshExpiresAt)
+	if errors.Is(err, dataaccess.ErrNotFound) {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_grant", "refresh token has already been used"})
+		return
+	}
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnOAuthTokens(w, r, accessToken, refreshToken, token.Scopes)
+}
+
+// revocation endpoint as defined in RFC 7009, revoking either token revokes the whole grant
+func (h *HandlerService) InternalPostOauthRevoke(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_request", "request body must be form encoded"})
+		return
+	}
+	app, oauthErr, err := h.authenticateOAuthClient(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if oauthErr != nil {
+		returnOAuthError(w, r, http.StatusUnauthorized, *oauthErr)
+		return
+	}
+	token := r.PostForm.Get("token")
+	if token == "" {
+		returnOAuthError(w, r, http.StatusBadRequest, oauthError{"invalid_request", "missing token"})
+		return
+	}
+	// invalid tokens don't cause an error response, the client can't do anything about them anyway
+	err = h.daService.RevokeOAuthToken(r.Context(), app.Id, utils.HashAndEncode(token))
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnOk(w, r, nil)
+}
+
+// lists the apps the user has authorized
+func (h *HandlerService) InternalGetUserOauthApps(w http.ResponseWriter, r *http.Request) {
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	data, err := h.daService.GetUserOAuthApps(r.Context(), userId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnOk(w, r, types.GetUserOAuthAppsResponse{
+		Data: data,
+	})
+}
+
+// revokes all tokens the user has issued to the app
+func (h *HandlerService) InternalDeleteUserOauthApp(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	appId := v.checkUint(mux.Vars(r)["app_id"], "app_id")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	err = h.daService.RemoveUserOAuthApp(r.Context(), userId, appId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnNoContent(w, r)
+}
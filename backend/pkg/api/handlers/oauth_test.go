@@ -0,0 +1,420 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	dataaccess "github.com/gobitfly/beaconchain/pkg/api/data_access"
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
+	types "github.com/gobitfly/beaconchain/pkg/api/types"
+)
+
+const (
+	testOAuthClientId    = "test-client"
+	testOAuthRedirectUri = "https://app.example.com/callback"
+	testOAuthUserId      = uint64(7)
+)
+
+type oauthStoreGrant struct {
+	appId  uint64
+	userId uint64
+	scopes []string
+}
+
+type oauthStoreToken struct {
+	grantId          uint64
+	accessTokenHash  string
+	refreshTokenHash string
+	accessExpiresAt  time.Time
+	refreshExpiresAt time.Time
+	rotated          bool
+}
+
+// oauthStore keeps the authorization codes, grants and tokens of a single public client in memory
+type oauthStore struct {
+	dataaccess.DataAccessor
+	codes       map[string]types.OAuthAuthorizationCode
+	grants      map[uint64]oauthStoreGrant
+	tokens      []*oauthStoreToken
+	nextGrantId uint64
+	revoked     []uint64
+}
+
+func newOAuthStore() *oauthStore {
+	return &oauthStore{
+		codes:       make(map[string]types.OAuthAuthorizationCode),
+		grants:      make(map[uint64]oauthStoreGrant),
+		nextGrantId: 1,
+	}
+}
+
+func (s *oauthStore) GetOAuthAppByClientId(ctx context.Context, clientId string) (*types.OAuthClientApp, error) {
+	if clientId != testOAuthClientId {
+		return nil, fmt.Errorf("%w: oauth app not found", dataaccess.ErrNotFound)
+	}
+	return &types.OAuthClientApp{
+		Id:          1,
+		AppName:     "Test App",
+		RedirectUri: testOAuthRedirectUri,
+		Scopes:      []string{"dashboards:read", "notifications:manage"},
+	}, nil
+}
+
+func (s *oauthStore) CreateOAuthAuthorizationCode(ctx context.Context, codeHash string, code types.OAuthAuthorizationCode) error {
+	code.CreatedAt = time.Now()
+	s.codes[codeHash] = code
+	return nil
+}
+
+func (s *oauthStore) GetOAuthAuthorizationCode(ctx context.Context, codeHash string) (*types.OAuthAuthorizationCode, error) {
+	code, ok := s.codes[codeHash]
+	if !ok {
+		return nil, fmt.Errorf("%w: authorization code not found", dataaccess.ErrNotFound)
+	}
+	return &code, nil
+}
+
+func (s *oauthStore) ExchangeOAuthAuthorizationCode(ctx context.Context, codeHash string, accessTokenHash, refreshTokenHash string, accessExpiresAt, refreshExpiresAt time.Time) error {
+	code, ok := s.codes[codeHash]
+	if !ok || code.GrantId != 0 {
+		return fmt.Errorf("%w: authorization code not found", dataaccess.ErrNotFound)
+	}
+	code.GrantId = s.nextGrantId
+	s.nextGrantId++
+	s.codes[codeHash] = code
+	s.grants[code.GrantId] = oauthStoreGrant{appId: code.AppId, userId: code.UserId, scopes: code.Scopes}
+	s.tokens = append(s.tokens, &oauthStoreToken{code.GrantId, accessTokenHash, refreshTokenHash, accessExpiresAt, refreshExpiresAt, false})
+	return nil
+}
+
+// token returns a token whose grant hasn't been revoked
+func (s *oauthStore) token(match func(token *oauthStoreToken) bool) (*oauthStoreToken, *types.OAuthTokenInfo, error) {
+	for _, token := range s.tokens {
+		grant, ok := s.grants[token.grantId]
+		if ok && match(token) {
+			return token, &types.OAuthTokenInfo{
+				GrantId:   token.grantId,
+				AppId:     grant.appId,
+				UserId:    grant.userId,
+				Scopes:    grant.scopes,
+				Rotated:   token.rotated,
+				ExpiresAt: token.refreshExpiresAt,
+			}, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("%w: oauth token not found", dataaccess.ErrNotFound)
+}
+
+func (s *oauthStore) GetOAuthAccessToken(ctx context.Context, accessTokenHash string) (*types.OAuthTokenInfo, error) {
+	_, info, err := s.token(func(token *oauthStoreToken) bool {
+		return token.accessTokenHash == accessTokenHash && token.accessExpiresAt.After(time.Now())
+	})
+	return info, err
+}
+
+func (s *oauthStore) GetOAuthRefreshToken(ctx context.Context, refreshTokenHash string) (*types.OAuthTokenInfo, error) {
+	_, info, err := s.token(func(token *oauthStoreToken) bool {
+		return token.refreshTokenHash == refreshTokenHash
+	})
+	return info, err
+}
+
+func (s *oauthStore) RotateOAuthRefreshToken(ctx context.Context, oldRefreshTokenHash, accessTokenHash, refreshTokenHash string, accessExpiresAt, refreshExpiresAt time.Time) error {
+	old, _, err := s.token(func(token *oauthStoreToken) bool {
+		return token.refreshTokenHash == oldRefreshTokenHash && !token.rotated
+	})
+	if err != nil {
+		return err
+	}
+	old.rotated = true
+	s.tokens = append(s.tokens, &oauthStoreToken{old.grantId, accessTokenHash, refreshTokenHash, accessExpiresAt, refreshExpiresAt, false})
+	return nil
+}
+
+func (s *oauthStore) RevokeOAuthGrant(ctx context.Context, grantId uint64) error {
+	delete(s.grants, grantId)
+	s.revoked = append(s.revoked, grantId)
+	return nil
+}
+
+func pkceChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// authorizeOAuthClient runs the consent step of the authorization code flow and returns the issued code
+func authorizeOAuthClient(t *testing.T, h *HandlerService, verifier, scope string) string {
+	body, err := json.Marshal(oauthAuthorizeRequest{
+		ResponseType:        "code",
+		ClientId:            testOAuthClientId,
+		RedirectUri:         testOAuthRedirectUri,
+		Scope:               scope,
+		State:               "state",
+		CodeChallenge:       pkceChallenge(verifier),
+		CodeChallengeMethod: "S256",
+	})
+	if err != nil {
+		t.Fatalf("error encoding authorize request: %v", err)
+	}
+	r := httptest.NewRequest(http.MethodPost, "/api/i/oauth/authorize", strings.NewReader(string(body)))
+	r.Header.Set("Content-Type", "application/json")
+	r = r.WithContext(context.WithValue(r.Context(), types.CtxUserIdKey, testOAuthUserId))
+	w := httptest.NewRecorder()
+	h.InternalPostOauthAuthorize(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var resp types.PostOAuthAuthorizeResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("error decoding authorize response: %v", err)
+	}
+	redirectUri, err := url.Parse(resp.Data.RedirectUri)
+	if err != nil {
+		t.Fatalf("error parsing redirect uri: %v", err)
+	}
+	code := redirectUri.Query().Get("code")
+	if code == "" || redirectUri.Query().Get("state") != "state" {
+		t.Fatalf("expected a code and the state in the redirect uri, got %s", resp.Data.RedirectUri)
+	}
+	return code
+}
+
+// requestOAuthToken calls the token endpoint, the response is either a token response or an oauth error
+func requestOAuthToken(t *testing.T, h *HandlerService, form url.Values) (int, types.OAuthTokenResponse, oauthError) {
+	form.Set("client_id", testOAuthClientId)
+	r := httptest.NewRequest(http.MethodPost, "/api/oauth/token", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.InternalPostOauthToken(w, r)
+
+	var tokens types.OAuthTokenResponse
+	var oauthErr oauthError
+	target := interface{}(&tokens)
+	if w.Code != http.StatusOK {
+		target = &oauthErr
+	}
+	if err := json.NewDecoder(w.Body).Decode(target); err != nil {
+		t.Fatalf("error decoding token response: %v", err)
+	}
+	return w.Code, tokens, oauthErr
+}
+
+func exchangeOAuthCode(t *testing.T, h *HandlerService, code, verifier string) (int, types.OAuthTokenResponse, oauthError) {
+	return requestOAuthToken(t, h, url.Values{"grant_type": {"authorization_code"}, "code": {code}, "code_verifier": {verifier}})
+}
+
+// oauthProtectedEndpoint mimics the router: the user is looked up by access token, the dashboard endpoints check the scope of the token.
+// Endpoints without a scope check return the user id of GetUserIdByContext.
+func oauthProtectedEndpoint(h *HandlerService, scopeMiddleware func(http.Handler) http.Handler) http.Handler {
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userId, err := GetUserIdByContext(r)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+		returnOk(w, r, userId)
+	})
+	if scopeMiddleware != nil {
+		handler = scopeMiddleware(handler)
+	}
+	return h.StoreUserIdMiddleware(handler, func(r *http.Request) (uint64, error) {
+		return 0, newUnauthorizedErr("no session")
+	})
+}
+
+func callWithAccessToken(endpoint http.Handler, method, accessToken string) int {
+	r := httptest.NewRequest(method, "/api/v2/validator-dashboards/1", nil)
+	r.Header.Set("Authorization", authHeaderPrefix+accessToken)
+	w := httptest.NewRecorder()
+	endpoint.ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestCheckOAuthScopes(t *testing.T) {
+	allowed := []string{"dashboards:read", "notifications:manage"}
+	tests := []struct {
+		scope    string
+		expected []string
+	}{
+		{"dashboards:read", []string{"dashboards:read"}},
+		{"notifications:manage  dashboards:read dashboards:read", []string{"dashboards:read", "notifications:manage"}},
+		{"", nil},
+		{"dashboards:manage", nil},
+		{"dashboards:write", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scope, func(t *testing.T) {
+			scopes, oauthErr := checkOAuthScopes(tt.scope, allowed)
+			if tt.expected == nil {
+				if oauthErr == nil || oauthErr.Code != "invalid_scope" {
+					t.Errorf("expected an invalid_scope error, got scopes %v", scopes)
+				}
+				return
+			}
+			if oauthErr != nil {
+				t.Fatalf("unexpected error: %v", oauthErr)
+			}
+			if !slices.Equal(scopes, tt.expected) {
+				t.Errorf("expected scopes %v, got %v", tt.expected, scopes)
+			}
+		})
+	}
+}
+
+func TestVerifyPkceChallenge(t *testing.T) {
+	// BASE64URL(SHA256(verifier)) without padding
+	verifier := "dBjftJeZ4CVP-mJ92IyU1pRUBYY8Ltv3oZ1RoNHbbjg"
+	challenge := "uEQOVSUKQ1gNPMNm9oxeOUGag9tacedgwL_Wy6AMbkA"
+	if !verifyPkceChallenge(verifier, challenge) {
+		t.Errorf("expected the verifier to match the challenge")
+	}
+	if verifyPkceChallenge(verifier+"x", challenge) || verifyPkceChallenge(challenge, challenge) {
+		t.Errorf("expected other verifiers not to match the challenge")
+	}
+}
+
+func TestOAuthAuthorizationCodeExchange(t *testing.T) {
+	verifier := strings.Repeat("v", 43)
+
+	t.Run("pkce verifier mismatch", func(t *testing.T) {
+		store := newOAuthStore()
+		h := &HandlerService{daService: store}
+		code := authorizeOAuthClient(t, h, verifier, "dashboards:read")
+
+		status, _, oauthErr := exchangeOAuthCode(t, h, code, strings.Repeat("w", 43))
+		if status != http.StatusBadRequest || oauthErr.Code != "invalid_grant" {
+			t.Errorf("expected invalid_grant for a wrong verifier, got %d %+v", status, oauthErr)
+		}
+		status, _, oauthErr = exchangeOAuthCode(t, h, code, "short")
+		if status != http.StatusBadRequest || oauthErr.Code != "invalid_request" {
+			t.Errorf("expected invalid_request for a malformed verifier, got %d %+v", status, oauthErr)
+		}
+		if len(store.grants) != 0 {
+			t.Errorf("expected no grant to be issued, got %v", store.grants)
+		}
+		// the code is not used up by a failed exchange
+		status, tokens, oauthErr := exchangeOAuthCode(t, h, code, verifier)
+		if status != http.StatusOK {
+			t.Fatalf("expected the exchange to succeed, got %d %+v", status, oauthErr)
+		}
+		if !strings.HasPrefix(tokens.AccessToken, oauthAccessTokenPrefix) || !strings.HasPrefix(tokens.RefreshToken, oauthRefreshTokenPrefix) || tokens.Scope != "dashboards:read" {
+			t.Errorf("unexpected token response %+v", tokens)
+		}
+	})
+
+	t.Run("authorization code reuse revokes the grant", func(t *testing.T) {
+		store := newOAuthStore()
+		h := &HandlerService{daService: store}
+		code := authorizeOAuthClient(t, h, verifier, "dashboards:read")
+		status, tokens, oauthErr := exchangeOAuthCode(t, h, code, verifier)
+		if status != http.StatusOK {
+			t.Fatalf("expected the exchange to succeed, got %d %+v", status, oauthErr)
+		}
+		endpoint := oauthProtectedEndpoint(h, h.DashboardsOAuthScopeMiddleware)
+		if status := callWithAccessToken(endpoint, http.MethodGet, tokens.AccessToken); status != http.StatusOK {
+			t.Fatalf("expected the access token to be accepted, got %d", status)
+		}
+
+		status, _, oauthErr = exchangeOAuthCode(t, h, code, verifier)
+		if status != http.StatusBadRequest || oauthErr.Code != "invalid_grant" {
+			t.Errorf("expected invalid_grant for a reused code, got %d %+v", status, oauthErr)
+		}
+		if !slices.Equal(store.revoked, []uint64{1}) {
+			t.Errorf("expected the grant of the code to be revoked, got %v", store.revoked)
+		}
+		if status := callWithAccessToken(endpoint, http.MethodGet, tokens.AccessToken); status != http.StatusUnauthorized {
+			t.Errorf("expected the access token of the revoked grant to be rejected, got %d", status)
+		}
+		status, _, _ = requestOAuthToken(t, h, url.Values{"grant_type": {"refresh_token"}, "refresh_token": {tokens.RefreshToken}})
+		if status != http.StatusBadRequest {
+			t.Errorf("expected the refresh token of the revoked grant to be rejected, got %d", status)
+		}
+	})
+}
+
+func TestOAuthRefreshTokenRotation(t *testing.T) {
+	verifier := strings.Repeat("v", 43)
+	store := newOAuthStore()
+	h := &HandlerService{daService: store}
+	code := authorizeOAuthClient(t, h, verifier, "dashboards:read")
+	_, first, _ := exchangeOAuthCode(t, h, code, verifier)
+	refresh := func(refreshToken string) (int, types.OAuthTokenResponse, oauthError) {
+		return requestOAuthToken(t, h, url.Values{"grant_type": {"refresh_token"}, "refresh_token": {refreshToken}})
+	}
+
+	status, second, oauthErr := refresh(first.RefreshToken)
+	if status != http.StatusOK {
+		t.Fatalf("expected the refresh to succeed, got %d %+v", status, oauthErr)
+	}
+	if second.RefreshToken == first.RefreshToken || second.AccessToken == first.AccessToken || second.Scope != "dashboards:read" {
+		t.Errorf("expected a new token pair with the scope of the grant, got %+v", second)
+	}
+
+	// the rotated token can't be used again, the reuse revokes the tokens issued since
+	status, _, oauthErr = refresh(first.RefreshToken)
+	if status != http.StatusBadRequest || oauthErr.Code != "invalid_grant" {
+		t.Errorf("expected invalid_grant for a rotated refresh token, got %d %+v", status, oauthErr)
+	}
+	if !slices.Equal(store.revoked, []uint64{1}) {
+		t.Errorf("expected the grant to be revoked, got %v", store.revoked)
+	}
+	if status, _, _ := refresh(second.RefreshToken); status != http.StatusBadRequest {
+		t.Errorf("expected the refresh token of the revoked grant to be rejected, got %d", status)
+	}
+	endpoint := oauthProtectedEndpoint(h, h.DashboardsOAuthScopeMiddleware)
+	if status := callWithAccessToken(endpoint, http.MethodGet, second.AccessToken); status != http.StatusUnauthorized {
+		t.Errorf("expected the access token of the revoked grant to be rejected, got %d", status)
+	}
+}
+
+func TestOAuthScopeMiddleware(t *testing.T) {
+	verifier := strings.Repeat("v", 43)
+	store := newOAuthStore()
+	h := &HandlerService{daService: store}
+	code := authorizeOAuthClient(t, h, verifier, "dashboards:read")
+	_, tokens, _ := exchangeOAuthCode(t, h, code, verifier)
+
+	tests := []struct {
+		name     string
+		endpoint http.Handler
+		method   string
+		token    string
+		status   int
+	}{
+		{"read dashboards", oauthProtectedEndpoint(h, h.DashboardsOAuthScopeMiddleware), http.MethodGet, tokens.AccessToken, http.StatusOK},
+		{"manage dashboards without the scope", oauthProtectedEndpoint(h, h.DashboardsOAuthScopeMiddleware), http.MethodPost, tokens.AccessToken, http.StatusForbidden},
+		{"notifications without the scope", oauthProtectedEndpoint(h, h.NotificationsOAuthScopeMiddleware), http.MethodGet, tokens.AccessToken, http.StatusForbidden},
+		{"endpoint without scope check", oauthProtectedEndpoint(h, nil), http.MethodGet, tokens.AccessToken, http.StatusForbidden},
+		{"unknown access token", oauthProtectedEndpoint(h, h.DashboardsOAuthScopeMiddleware), http.MethodGet, oauthAccessTokenPrefix + "unknown", http.StatusUnauthorized},
+		// refresh tokens are only accepted by the token endpoint, they are treated like an invalid api key
+		{"refresh token", oauthProtectedEndpoint(h, nil), http.MethodGet, tokens.RefreshToken, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status := callWithAccessToken(tt.endpoint, tt.method, tt.token); status != tt.status {
+				t.Errorf("expected status %d, got %d", tt.status, status)
+			}
+		})
+	}
+
+	// managing dashboards includes reading them
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r = r.WithContext(context.WithValue(r.Context(), types.CtxOAuthScopesKey, []string{"dashboards:manage"}))
+	if !hasOAuthScope(r, enums.OAuthScopes.DashboardsRead) || hasOAuthScope(r, enums.OAuthScopes.NotificationsManage) {
+		t.Errorf("expected the manage scope to cover reading dashboards only")
+	}
+	// requests without an access token are not limited by scopes
+	if !hasOAuthScope(httptest.NewRequest(http.MethodGet, "/", nil), enums.OAuthScopes.NotificationsManage) {
+		t.Errorf("expected requests without an access token to have all scopes")
+	}
+}
@@ -2,14 +2,18 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"net/http"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	"github.com/gobitfly/beaconchain/pkg/api/types"
+	commontypes "github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
 	"github.com/gorilla/mux"
 	"github.com/shopspring/decimal"
 )
@@ -209,7 +213,7 @@ func (h *HandlerService) PublicPostValidatorDashboards(w http.ResponseWriter, r
 //	@Tags			Validator Dashboard
 //	@Produce		json
 //	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
-//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``."
+//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``, `lido``, `ether_fi``, `ssv``."
 //	@Success		200				{object}	types.GetValidatorDashboardResponse
 //	@Failure		400				{object}	types.ApiErrorResponse	"Bad Request"
 //	@Router			/validator-dashboards/{dashboard_id} [get]
@@ -358,13 +362,8 @@ func (h *HandlerService) PublicPostValidatorDashboardGroups(w http.ResponseWrite
 		return
 	}
 	ctx := r.Context()
-	// check if user has reached the maximum number of groups
-	userId, err := GetUserIdByContext(r)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-	userInfo, err := h.getDataAccessor(r).GetUserInfo(ctx, userId)
+	// check if the dashboard owner has reached the maximum number of groups
+	ownerInfo, err := h.getDashboardOwnerInfo(ctx, dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -374,7 +373,7 @@ func (h *HandlerService) PublicPostValidatorDashboardGroups(w http.ResponseWrite
 		handleErr(w, r, err)
 		return
 	}
-	if groupCount >= userInfo.PremiumPerks.ValidatorGroupsPerDashboard {
+	if groupCount >= ownerInfo.PremiumPerks.ValidatorGroupsPerDashboard {
 		returnConflict(w, r, errors.New("maximum number of validator dashboard groups reached"))
 		return
 	}
@@ -384,6 +383,7 @@ func (h *HandlerService) PublicPostValidatorDashboardGroups(w http.ResponseWrite
 		handleErr(w, r, err)
 		return
 	}
+	h.recordDashboardChange(r, dashboardId, vdbAuditGroupAdded, &data.Id, nil)
 
 	response := types.ApiDataResponse[types.VDBPostCreateGroupData]{
 		Data: *data,
@@ -484,6 +484,7 @@ func (h *HandlerService) PublicDeleteValidatorDashboardGroup(w http.ResponseWrit
 		handleErr(w, r, err)
 		return
 	}
+	h.recordDashboardChange(r, dashboardId, vdbAuditGroupRemoved, &groupId, nil)
 
 	returnNoContent(w, r)
 }
@@ -517,17 +518,18 @@ func (h *HandlerService) PublicDeleteValidatorDashboardGroupValidators(w http.Re
 		returnNotFound(w, r, errors.New("group not found"))
 		return
 	}
-	err = h.getDataAccessor(r).RemoveValidatorDashboardGroupValidators(r.Context(), dashboardId, groupId)
+	removed, err := h.getDataAccessor(r).RemoveValidatorDashboardGroupValidators(r.Context(), dashboardId, groupId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
+	h.recordDashboardChange(r, dashboardId, vdbAuditValidatorsRemoved, &groupId, removed)
 	returnNoContent(w, r)
 }
 
 // PublicGetValidatorDashboardGroups godoc
 //
-//	@Description	Add new validators to a specified dashboard or update the group of already-added validators. This endpoint will always add as many validators as possible, even if more validators are provided than allowed by the subscription plan. The response will contain a list of added validators.
+//	@Description	Add new validators to a specified dashboard or update the group of already-added validators. This endpoint will always add as many validators as possible, even if more validators are provided than allowed by the subscription plan. The response will contain a list of added validators. Adding by deposit address or withdrawal credential requires the bulk adding perk, unless the dashboard owner has verified ownership of the address.
 //	@Security		ApiKeyInHeader || ApiKeyInQuery
 //	@Tags			Validator Dashboard Management
 //	@Accept			json
@@ -590,28 +592,33 @@ func (h *HandlerService) PublicPostValidatorDashboardValidators(w http.ResponseW
 		returnNotFound(w, r, errors.New("group not found"))
 		return
 	}
-	userId, err := GetUserIdByContext(r)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-	userInfo, err := h.getDataAccessor(r).GetUserInfo(ctx, userId)
+	// limits are given by the subscription of the dashboard owner
+	ownerInfo, err := h.getDashboardOwnerInfo(ctx, dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	if req.Validators == nil && !userInfo.PremiumPerks.BulkAdding {
-		returnForbidden(w, r, errors.New("bulk adding not allowed with current subscription plan"))
-		return
+	if req.Validators == nil && !ownerInfo.PremiumPerks.BulkAdding {
+		// the dashboard owner can always add validators of addresses they verified, members can't use their own addresses
+		// to get around the perks of the owner
+		isOwner, err := h.isVerifiedOwner(ctx, ownerInfo.Id, req.DepositAddress, req.WithdrawalCredential)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+		if !isOwner {
+			returnForbidden(w, r, errors.New("bulk adding not allowed with current subscription plan"))
+			return
+		}
 	}
-	dashboardLimit := userInfo.PremiumPerks.ValidatorsPerDashboard
+	dashboardLimit := ownerInfo.PremiumPerks.ValidatorsPerDashboard
 	existingValidatorCount, err := h.getDataAccessor(r).GetValidatorDashboardValidatorsCount(ctx, dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
 	var limit uint64
-	if isUserAdmin(userInfo) {
+	if isUserAdmin(ownerInfo) {
 		limit = math.MaxUint32 // no limit for admins
 	} else if dashboardLimit >= existingValidatorCount {
 		limit = dashboardLimit - existingValidatorCount
@@ -665,6 +672,13 @@ func (h *HandlerService) PublicPostValidatorDashboardValidators(w http.ResponseW
 		handleErr(w, r, dataErr)
 		return
 	}
+	if len(data) > 0 {
+		added := make([]types.VDBValidator, 0, len(data))
+		for _, validator := range data {
+			added = append(added, validator.Index)
+		}
+		h.recordDashboardChange(r, dashboardId, vdbAuditValidatorsAdded, &groupId, added)
+	}
 	response := types.ApiDataResponse[[]types.VDBPostValidatorsData]{
 		Data: data,
 	}
@@ -682,6 +696,7 @@ func (h *HandlerService) PublicPostValidatorDashboardValidators(w http.ResponseW
 //	@Param			limit			query		string	false	"The maximum number of results that may be returned."
 //	@Param			sort			query		string	false	"The field you want to sort by. Append with `:desc` for descending order."	Enums(index, public_key, balance, status, withdrawal_credentials)
 //	@Param			search			query		string	false	"Search for Address, ENS."
+//	@Param			credential_types	query		string	false	"Provide a comma separated list of withdrawal credential types the validators should be filtered by. Possible values are `0x00`, `0x01`, `0x02`."
 //	@Success		200				{object}	types.GetValidatorDashboardValidatorsResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/validators [get]
@@ -696,15 +711,23 @@ func (h *HandlerService) PublicGetValidatorDashboardValidators(w http.ResponseWr
 	groupId := v.checkGroupId(q.Get("group_id"), allowEmpty)
 	pagingParams := v.checkPagingParams(q)
 	sort := checkSort[enums.VDBManageValidatorsColumn](&v, q.Get("sort"))
+	credentialTypes := v.checkCredentialTypes(q.Get("credential_types"))
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
-	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardValidators(r.Context(), *dashboardId, groupId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
+	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardValidators(r.Context(), *dashboardId, groupId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, credentialTypes)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
+	if dashboardId.Validators == nil && !isMocked(r) {
+		err = h.markVerifiedOwnerValidators(r.Context(), dashboardId.Id, data)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+	}
 	response := types.GetValidatorDashboardValidatorsResponse{
 		Data:   data,
 		Paging: *paging,
@@ -751,6 +774,7 @@ func (h *HandlerService) PublicDeleteValidatorDashboardValidators(w http.Respons
 		handleErr(w, r, err)
 		return
 	}
+	h.recordDashboardChange(r, dashboardId, vdbAuditValidatorsRemoved, nil, validators)
 
 	returnNoContent(w, r)
 }
@@ -947,18 +971,13 @@ func (h *HandlerService) PublicPutValidatorDashboardArchiving(w http.ResponseWri
 		return
 	}
 
-	userId, err := GetUserIdByContext(r)
-	if err != nil {
-		handleErr(w, r, err)
-		return
-	}
-	dashboardCount, err := h.getDataAccessor(r).GetUserValidatorDashboardCount(r.Context(), userId, !req.IsArchived)
+	// limits are given by the subscription and the dashboards of the dashboard owner
+	userInfo, err := h.getDashboardOwnerInfo(r.Context(), dashboardId)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-
-	userInfo, err := h.getDataAccessor(r).GetUserInfo(r.Context(), userId)
+	dashboardCount, err := h.getDataAccessor(r).GetUserValidatorDashboardCount(r.Context(), userInfo.Id, !req.IsArchived)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -999,6 +1018,321 @@ func (h *HandlerService) PublicPutValidatorDashboardArchiving(w http.ResponseWri
 	returnOk(w, r, response)
 }
 
+// PublicGetValidatorDashboardMembers godoc
+//
+//	@Description	Get the members and pending invites of a specified validator dashboard. Available to all members of the dashboard.
+//	@Security		ApiKeyInHeader || ApiKeyInQuery
+//	@Tags			Validator Dashboard Management
+//	@Produce		json
+//	@Param			dashboard_id	path		integer	true	"The ID of the dashboard."
+//	@Success		200				{object}	types.GetValidatorDashboardMembersResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Router			/validator-dashboards/{dashboard_id}/members [get]
+func (h *HandlerService) PublicGetValidatorDashboardMembers(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetValidatorDashboardMembers(r.Context(), dashboardId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	for i := range data.Invites {
+		data.Invites[i].ExpiresAt = data.Invites[i].InvitedAt + int64(authDashboardInviteExpireTime.Seconds())
+	}
+	response := types.GetValidatorDashboardMembersResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicPostValidatorDashboardInvites godoc
+//
+//	@Description	Invite a user to a specified validator dashboard by email. Inviting the same email again replaces the previous invite. Requires the admin role on the dashboard, only the owner can invite admins. Existing members can't be invited, their role is changed through the member endpoint.
+//	@Security		ApiKeyInHeader || ApiKeyInQuery
+//	@Tags			Validator Dashboard Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			dashboard_id	path	integer													true	"The ID of the dashboard."
+//	@Param			request			body	handlers.PublicPostValidatorDashboardInvites.request	true	"`role`: The role the user gets when accepting the invite."
+//	@Success		204
+//	@Failure		400	{object}	types.ApiErrorResponse
+//	@Failure		403	{object}	types.ApiErrorResponse
+//	@Failure		409	{object}	types.ApiErrorResponse	"Conflict. The invited user is already a member of the dashboard."
+//	@Router			/validator-dashboards/{dashboard_id}/invites [post]
+func (h *HandlerService) PublicPostValidatorDashboardInvites(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	type request struct {
+		Email string `json:"email"`
+		Role  string `json:"role" tstype:"'admin' | 'editor' | 'viewer'"`
+	}
+	var req request
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	email := v.checkEmail(req.Email)
+	role := checkEnum[enums.VDBMemberRole](&v, req.Role, "role")
+	if role == enums.VDBMemberRoles.Owner {
+		v.add("role", "ownership of a dashboard can't be shared")
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	if getDashboardRole(r) < enums.VDBMemberRoles.Admin {
+		handleErr(w, r, newForbiddenErr("only admins can invite members"))
+		return
+	}
+	if err := checkRoleGrant(r, role); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if err := h.checkNotMember(r.Context(), dashboardId, email); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	err = h.sendDashboardInviteEmail(r.Context(), dashboardId, userId, email, role)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnNoContent(w, r)
+}
+
+// PublicDeleteValidatorDashboardInvite godoc
+//
+//	@Description	Revoke a pending invite to a specified validator dashboard. Requires the admin role on the dashboard.
+//	@Security		ApiKeyInHeader || ApiKeyInQuery
+//	@Tags			Validator Dashboard Management
+//	@Produce		json
+//	@Param			dashboard_id	path	integer	true	"The ID of the dashboard."
+//	@Param			email			path	string	true	"The email the invite was sent to."
+//	@Success		204
+//	@Failure		400	{object}	types.ApiErrorResponse
+//	@Failure		403	{object}	types.ApiErrorResponse
+//	@Router			/validator-dashboards/{dashboard_id}/invites/{email} [delete]
+func (h *HandlerService) PublicDeleteValidatorDashboardInvite(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	dashboardId := v.checkPrimaryDashboardId(vars["dashboard_id"])
+	email := v.checkEmail(vars["email"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	if getDashboardRole(r) < enums.VDBMemberRoles.Admin {
+		handleErr(w, r, newForbiddenErr("only admins can revoke invites"))
+		return
+	}
+
+	err := h.getDataAccessor(r).RemoveValidatorDashboardInvite(r.Context(), dashboardId, email)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnNoContent(w, r)
+}
+
+// PublicPutValidatorDashboardMember godoc
+//
+//	@Description	Change the role of a member of a specified validator dashboard. Requires the admin role on the dashboard, only the owner can change the role of other admins or make members admins.
+//	@Security		ApiKeyInHeader || ApiKeyInQuery
+//	@Tags			Validator Dashboard Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			dashboard_id	path	integer												true	"The ID of the dashboard."
+//	@Param			user_id			path	integer												true	"The ID of the member."
+//	@Param			request			body	handlers.PublicPutValidatorDashboardMember.request	true	"`role`: The new role of the member."
+//	@Success		204
+//	@Failure		400	{object}	types.ApiErrorResponse
+//	@Failure		403	{object}	types.ApiErrorResponse
+//	@Failure		404	{object}	types.ApiErrorResponse
+//	@Router			/validator-dashboards/{dashboard_id}/members/{user_id} [put]
+func (h *HandlerService) PublicPutValidatorDashboardMember(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	dashboardId := v.checkPrimaryDashboardId(vars["dashboard_id"])
+	memberId := v.checkUint(vars["user_id"], "user_id")
+	type request struct {
+		Role string `json:"role" tstype:"'admin' | 'editor' | 'viewer'"`
+	}
+	var req request
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	role := checkEnum[enums.VDBMemberRole](&v, req.Role, "role")
+	if role == enums.VDBMemberRoles.Owner {
+		v.add("role", "ownership of a dashboard can't be shared")
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	if err := h.checkMemberManagement(r, dashboardId, memberId); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if err := checkRoleGrant(r, role); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	err := h.getDataAccessor(r).UpdateValidatorDashboardMember(r.Context(), dashboardId, memberId, role)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnNoContent(w, r)
+}
+
+// PublicDeleteValidatorDashboardMember godoc
+//
+//	@Description	Remove a member from a specified validator dashboard. Requires the admin role on the dashboard, only the owner can remove other admins. Every member can remove themselves.
+//	@Security		ApiKeyInHeader || ApiKeyInQuery
+//	@Tags			Validator Dashboard Management
+//	@Produce		json
+//	@Param			dashboard_id	path	integer	true	"The ID of the dashboard."
+//	@Param			user_id			path	integer	true	"The ID of the member."
+//	@Success		204
+//	@Failure		400	{object}	types.ApiErrorResponse
+//	@Failure		403	{object}	types.ApiErrorResponse
+//	@Failure		404	{object}	types.ApiErrorResponse
+//	@Router			/validator-dashboards/{dashboard_id}/members/{user_id} [delete]
+func (h *HandlerService) PublicDeleteValidatorDashboardMember(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	dashboardId := v.checkPrimaryDashboardId(vars["dashboard_id"])
+	memberId := v.checkUint(vars["user_id"], "user_id")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	userId, err := GetUserIdByContext(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	// members can always leave a dashboard
+	if memberId != userId {
+		if err := h.checkMemberManagement(r, dashboardId, memberId); err != nil {
+			handleErr(w, r, err)
+			return
+		}
+	}
+
+	err = h.getDataAccessor(r).RemoveValidatorDashboardMember(r.Context(), dashboardId, memberId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnNoContent(w, r)
+}
+
+// PublicGetValidatorDashboardAuditLog godoc
+//
+//	@Description	Get the log of validator and group changes of a specified validator dashboard, newest first. Available to all members of the dashboard.
+//	@Security		ApiKeyInHeader || ApiKeyInQuery
+//	@Tags			Validator Dashboard Management
+//	@Produce		json
+//	@Param			dashboard_id	path		integer	true	"The ID of the dashboard."
+//	@Param			cursor			query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
+//	@Param			limit			query		string	false	"The maximum number of results that may be returned."
+//	@Success		200				{object}	types.GetValidatorDashboardAuditLogResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Router			/validator-dashboards/{dashboard_id}/audit-log [get]
+func (h *HandlerService) PublicGetValidatorDashboardAuditLog(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardAuditLog(r.Context(), dashboardId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardAuditLogResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicPutValidatorDashboardTwoFactorRequirement godoc
+//
+//	@Description	Require all members of a specified validator dashboard to have two factor authentication enabled. Members without a second factor lose access until they enable one, the owner is exempt. Requires the admin role on the dashboard, enabling the requirement also requires the caller to have two factor authentication enabled.
+//	@Security		ApiKeyInHeader || ApiKeyInQuery
+//	@Tags			Validator Dashboard Management
+//	@Accept			json
+//	@Produce		json
+//	@Param			dashboard_id	path	integer																true	"The ID of the dashboard."
+//	@Param			request			body	handlers.PublicPutValidatorDashboardTwoFactorRequirement.request	true	"`required`: Whether members need two factor authentication."
+//	@Success		204
+//	@Failure		400	{object}	types.ApiErrorResponse
+//	@Failure		403	{object}	types.ApiErrorResponse
+//	@Failure		409	{object}	types.ApiErrorResponse	"Conflict. The request could not be performed by the server because the caller has no second factor."
+//	@Router			/validator-dashboards/{dashboard_id}/two-factor-requirement [put]
+func (h *HandlerService) PublicPutValidatorDashboardTwoFactorRequirement(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId := v.checkPrimaryDashboardId(mux.Vars(r)["dashboard_id"])
+	type request struct {
+		Required bool `json:"required"`
+	}
+	var req request
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	if getDashboardRole(r) < enums.VDBMemberRoles.Admin {
+		handleErr(w, r, newForbiddenErr("only admins can change the two factor requirement"))
+		return
+	}
+	if req.Required {
+		userId, err := GetUserIdByContext(r)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+		// don't let admins lock themselves out
+		hasTwoFactor, err := h.daService.HasUserTwoFactor(r.Context(), userId)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+		if !hasTwoFactor {
+			handleErr(w, r, newConflictErr("two factor authentication must be enabled on your account first"))
+			return
+		}
+	}
+
+	err := h.getDataAccessor(r).UpdateValidatorDashboardTwoFactorRequired(r.Context(), dashboardId, req.Required)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnNoContent(w, r)
+}
+
 // PublicGetValidatorDashboardSlotViz godoc
 //
 //	@Description	Get slot viz information for a specified dashboard
@@ -1043,9 +1377,10 @@ func (h *HandlerService) PublicGetValidatorDashboardSlotViz(w http.ResponseWrite
 //	@Param			period			query		string	true	"Time period to get data for."	Enums(all_time, last_30d, last_7d, last_24h, last_1h)
 //	@Param			cursor			query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
 //	@Param			limit			query		string	false	"The maximum number of results that may be returned."
-//	@Param			sort			query		string	false	"The field you want to sort by. Append with `:desc` for descending order."	Enums(group_id, validators, efficiency, attestations, proposals, reward)
+//	@Param			sort			query		string	false	"The field you want to sort by. Append with `:desc` for descending order."	Enums(group_id, validators, efficiency, attestations, proposals, reward, effective_balance)
 //	@Param			search			query		string	false	"Search for Index, Public Key, Group."
-//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``."
+//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``, `lido``, `ether_fi``, `ssv``."
+//	@Param			credential_types	query		string	false	"Provide a comma separated list of withdrawal credential types the validators should be filtered by. Possible values are `0x00`, `0x01`, `0x02`."
 //	@Success		200				{object}	types.GetValidatorDashboardSummaryResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/summary [get]
@@ -1060,6 +1395,7 @@ func (h *HandlerService) PublicGetValidatorDashboardSummary(w http.ResponseWrite
 	pagingParams := v.checkPagingParams(q)
 	sort := checkSort[enums.VDBSummaryColumn](&v, q.Get("sort"))
 	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	credentialTypes := v.checkCredentialTypes(q.Get("credential_types"))
 
 	period := checkEnum[enums.TimePeriod](&v, q.Get("period"), "period")
 	if v.hasErrors() {
@@ -1067,7 +1403,7 @@ func (h *HandlerService) PublicGetValidatorDashboardSummary(w http.ResponseWrite
 		return
 	}
 
-	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardSummary(r.Context(), *dashboardId, period, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes)
+	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardSummary(r.Context(), *dashboardId, period, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes, credentialTypes)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -1087,7 +1423,8 @@ func (h *HandlerService) PublicGetValidatorDashboardSummary(w http.ResponseWrite
 //	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
 //	@Param			group_id		path		integer	true	"The ID of the group."
 //	@Param			period			query		string	true	"Time period to get data for."	Enums(all_time, last_30d, last_7d, last_24h, last_1h)
-//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``."
+//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``, `lido``, `ether_fi``, `ssv``."
+//	@Param			credential_types	query		string	false	"Provide a comma separated list of withdrawal credential types the validators should be filtered by. Possible values are `0x00`, `0x01`, `0x02`."
 //	@Success		200				{object}	types.GetValidatorDashboardGroupSummaryResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/groups/{group_id}/summary [get]
@@ -1097,6 +1434,7 @@ func (h *HandlerService) PublicGetValidatorDashboardGroupSummary(w http.Response
 	dashboardId, err := h.handleDashboardId(r.Context(), vars["dashboard_id"])
 	q := r.URL.Query()
 	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	credentialTypes := v.checkCredentialTypes(q.Get("credential_types"))
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
@@ -1112,7 +1450,7 @@ func (h *HandlerService) PublicGetValidatorDashboardGroupSummary(w http.Response
 		return
 	}
 
-	data, err := h.getDataAccessor(r).GetValidatorDashboardGroupSummary(r.Context(), *dashboardId, groupId, period, protocolModes)
+	data, err := h.getDataAccessor(r).GetValidatorDashboardGroupSummary(r.Context(), *dashboardId, groupId, period, protocolModes, credentialTypes)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -1134,6 +1472,7 @@ func (h *HandlerService) PublicGetValidatorDashboardGroupSummary(w http.Response
 //	@Param			aggregation		query		string	false	"Aggregation type to get data for."	Enums(epoch, hourly, daily, weekly)	Default(hourly)
 //	@Param			after_ts		query		string	false	"Return data after this timestamp."
 //	@Param			before_ts		query		string	false	"Return data before this timestamp."
+//	@Param			credential_types	query		string	false	"Provide a comma separated list of withdrawal credential types the validators should be filtered by. Possible values are `0x00`, `0x01`, `0x02`."
 //	@Success		200				{object}	types.GetValidatorDashboardSummaryChartResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/summary-chart [get]
@@ -1148,6 +1487,7 @@ func (h *HandlerService) PublicGetValidatorDashboardSummaryChart(w http.Response
 	q := r.URL.Query()
 	groupIds := v.checkGroupIdList(q.Get("group_ids"))
 	efficiencyType := checkEnum[enums.VDBSummaryChartEfficiencyType](&v, q.Get("efficiency_type"), "efficiency_type")
+	credentialTypes := v.checkCredentialTypes(q.Get("credential_types"))
 
 	aggregation := checkEnum[enums.ChartAggregation](&v, r.URL.Query().Get("aggregation"), "aggregation")
 	chartLimits, err := h.getCurrentChartTimeLimitsForDashboard(ctx, dashboardId, aggregation)
@@ -1165,7 +1505,7 @@ func (h *HandlerService) PublicGetValidatorDashboardSummaryChart(w http.Response
 		return
 	}
 
-	data, err := h.getDataAccessor(r).GetValidatorDashboardSummaryChart(ctx, *dashboardId, groupIds, efficiencyType, aggregation, afterTs, beforeTs)
+	data, err := h.getDataAccessor(r).GetValidatorDashboardSummaryChart(ctx, *dashboardId, groupIds, efficiencyType, aggregation, afterTs, beforeTs, credentialTypes)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -1245,7 +1585,9 @@ func (h *HandlerService) PublicGetValidatorDashboardSummaryValidators(w http.Res
 //	@Param			limit			query		string	false	"The maximum number of results that may be returned."
 //	@Param			sort			query		string	false	"The field you want to sort by. Append with `:desc` for descending order."	Enums(epoch)
 //	@Param			search			query		string	false	"Search for Epoch, Index, Public Key, Group."
-//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``."
+//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``, `lido``, `ether_fi``, `ssv``."
+//	@Param			credential_types	query		string	false	"Provide a comma separated list of withdrawal credential types the validators should be filtered by. Possible values are `0x00`, `0x01`, `0x02`."
+//	@Param			currency		query		string	false	"Additionally value each reward in this currency at the price of the day of its epoch."
 //	@Success		200				{object}	types.GetValidatorDashboardRewardsResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/rewards [get]
@@ -1260,20 +1602,30 @@ func (h *HandlerService) PublicGetValidatorDashboardRewards(w http.ResponseWrite
 	pagingParams := v.checkPagingParams(q)
 	sort := checkSort[enums.VDBRewardsColumn](&v, q.Get("sort"))
 	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	credentialTypes := v.checkCredentialTypes(q.Get("credential_types"))
+	currency := v.checkCurrency(q.Get("currency"), "")
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
 
-	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardRewards(r.Context(), *dashboardId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes)
+	da := h.getDataAccessor(r)
+	data, paging, err := da.GetValidatorDashboardRewards(r.Context(), *dashboardId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit, protocolModes, credentialTypes)
 	if err != nil {
 		handleErr(w, r, err)
 		return
 	}
-	response := types.GetValidatorDashboardRewardsResponse{
-		Data:   data,
-		Paging: *paging,
-	}
+	if currency != "" {
+		err = valueRewardsAtHistoricPrices(r.Context(), da, data, currency)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+	}
+	response := types.GetValidatorDashboardRewardsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
 	returnOk(w, r, response)
 }
 
@@ -1285,7 +1637,7 @@ func (h *HandlerService) PublicGetValidatorDashboardRewards(w http.ResponseWrite
 //	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
 //	@Param			group_id		path		integer	true	"The ID of the group."
 //	@Param			epoch			path		integer	true	"The epoch to get data for."
-//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``."
+//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``, `lido``, `ether_fi``, `ssv``."
 //	@Success		200				{object}	types.GetValidatorDashboardGroupRewardsResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/groups/{group_id}/rewards/{epoch} [get]
@@ -1323,7 +1675,8 @@ func (h *HandlerService) PublicGetValidatorDashboardGroupRewards(w http.Response
 //	@Tags			Validator Dashboard
 //	@Produce		json
 //	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
-//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``."
+//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``, `lido``, `ether_fi``, `ssv``."
+//	@Param			credential_types	query		string	false	"Provide a comma separated list of withdrawal credential types the validators should be filtered by. Possible values are `0x00`, `0x01`, `0x02`."
 //	@Success		200				{object}	types.GetValidatorDashboardRewardsChartResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/rewards-chart [get]
@@ -1337,12 +1690,13 @@ func (h *HandlerService) PublicGetValidatorDashboardRewardsChart(w http.Response
 		return
 	}
 	protocolModes := v.checkProtocolModes(q.Get("modes"))
+	credentialTypes := v.checkCredentialTypes(q.Get("credential_types"))
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
 
-	data, err := h.getDataAccessor(r).GetValidatorDashboardRewardsChart(r.Context(), *dashboardId, protocolModes)
+	data, err := h.getDataAccessor(r).GetValidatorDashboardRewardsChart(r.Context(), *dashboardId, protocolModes, credentialTypes)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -1365,7 +1719,7 @@ func (h *HandlerService) PublicGetValidatorDashboardRewardsChart(w http.Response
 //	@Param			limit			query		string	false	"The maximum number of results that may be returned."
 //	@Param			sort			query		string	false	"The field you want to sort by. Append with `:desc` for descending order."	Enums(validator, reward)
 //	@Param			search			query		string	false	"Search for Index, Public Key."
-//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``."
+//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``, `lido``, `ether_fi``, `ssv``."
 //	@Success		200				{object}	types.GetValidatorDashboardDutiesResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/duties/{epoch} [get]
@@ -1410,7 +1764,7 @@ func (h *HandlerService) PublicGetValidatorDashboardDuties(w http.ResponseWriter
 //	@Param			limit			query		string	false	"The maximum number of results that may be returned."
 //	@Param			sort			query		string	false	"The field you want to sort by. Append with `:desc` for descending order."	Enums(proposer, slot, block, status, reward)
 //	@Param			search			query		string	false	"Search for Index, Public Key, Group."
-//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``."
+//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``, `lido``, `ether_fi``, `ssv``."
 //	@Success		200				{object}	types.GetValidatorDashboardBlocksResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/blocks [get]
@@ -1451,7 +1805,7 @@ func (h *HandlerService) PublicGetValidatorDashboardBlocks(w http.ResponseWriter
 //	@Param			aggregation		query		string	false	"Aggregation type to get data for."	Enums(epoch, hourly, daily, weekly)	Default(hourly)
 //	@Param			after_ts		query		string	false	"Return data after this timestamp."
 //	@Param			before_ts		query		string	false	"Return data before this timestamp."
-//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``."
+//	@Param			metric			query		string	false	"Metric the heatmap cells show, either the total efficiency or the consensus layer rewards in gwei."	Enums(efficiency, reward)	Default(efficiency)
 //	@Success		200				{object}	types.GetValidatorDashboardHeatmapResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/heatmap [get]
@@ -1463,8 +1817,8 @@ func (h *HandlerService) PublicGetValidatorDashboardHeatmap(w http.ResponseWrite
 		return
 	}
 	q := r.URL.Query()
-	protocolModes := v.checkProtocolModes(q.Get("modes"))
-	aggregation := checkEnum[enums.ChartAggregation](&v, r.URL.Query().Get("aggregation"), "aggregation")
+	metric := checkEnum[enums.VDBHeatmapMetric](&v, q.Get("metric"), "metric")
+	aggregation := checkEnum[enums.ChartAggregation](&v, q.Get("aggregation"), "aggregation")
 	chartLimits, err := h.getCurrentChartTimeLimitsForDashboard(r.Context(), dashboardId, aggregation)
 	if err != nil {
 		handleErr(w, r, err)
@@ -1480,7 +1834,7 @@ func (h *HandlerService) PublicGetValidatorDashboardHeatmap(w http.ResponseWrite
 		return
 	}
 
-	data, err := h.getDataAccessor(r).GetValidatorDashboardHeatmap(r.Context(), *dashboardId, protocolModes, aggregation, afterTs, beforeTs)
+	data, err := h.getDataAccessor(r).GetValidatorDashboardHeatmap(r.Context(), *dashboardId, metric, aggregation, afterTs, beforeTs)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -1499,7 +1853,6 @@ func (h *HandlerService) PublicGetValidatorDashboardHeatmap(w http.ResponseWrite
 //	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
 //	@Param			group_id		path		integer	true	"The ID of the group."
 //	@Param			timestamp		path		integer	true	"The timestamp to get data for."
-//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``."
 //	@Param			aggregation		query		string	false	"Aggregation type to get data for."	Enums(epoch, hourly, daily, weekly)	Default(hourly)
 //	@Success		200				{object}	types.GetValidatorDashboardGroupHeatmapResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
@@ -1514,7 +1867,6 @@ func (h *HandlerService) PublicGetValidatorDashboardGroupHeatmap(w http.Response
 	}
 	groupId := v.checkExistingGroupId(vars["group_id"])
 	requestedTimestamp := v.checkUint(vars["timestamp"], "timestamp")
-	protocolModes := v.checkProtocolModes(r.URL.Query().Get("modes"))
 	aggregation := checkEnum[enums.ChartAggregation](&v, r.URL.Query().Get("aggregation"), "aggregation")
 	if v.hasErrors() {
 		handleErr(w, r, v)
@@ -1530,7 +1882,7 @@ func (h *HandlerService) PublicGetValidatorDashboardGroupHeatmap(w http.Response
 		return
 	}
 
-	data, err := h.getDataAccessor(r).GetValidatorDashboardGroupHeatmap(r.Context(), *dashboardId, groupId, protocolModes, aggregation, requestedTimestamp)
+	data, err := h.getDataAccessor(r).GetValidatorDashboardGroupHeatmap(r.Context(), *dashboardId, groupId, aggregation, requestedTimestamp)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -1680,7 +2032,7 @@ func (h *HandlerService) PublicGetValidatorDashboardTotalExecutionLayerDeposits(
 //	@Param			limit			query		string	false	"The maximum number of results that may be returned."
 //	@Param			sort			query		string	false	"The field you want to sort by. Append with `:desc` for descending order."	Enums(epoch, slot, index, recipient, amount)
 //	@Param			search			query		string	false	"Search for Index, Public Key, Address."
-//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``."
+//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``, `lido``, `ether_fi``, `ssv``."
 //	@Success		200				{object}	types.GetValidatorDashboardWithdrawalsResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/withdrawals [get]
@@ -1718,7 +2070,7 @@ func (h *HandlerService) PublicGetValidatorDashboardWithdrawals(w http.ResponseW
 //	@Tags			Validator Dashboard
 //	@Produce		json
 //	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
-//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``."
+//	@Param			modes			query		string	false	"Provide a comma separated list of protocol modes which should be respected for validator calculations. Possible values are `rocket_pool``, `lido``, `ether_fi``, `ssv``."
 //	@Success		200				{object}	types.GetValidatorDashboardTotalWithdrawalsResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/total-withdrawals [get]
@@ -1749,12 +2101,202 @@ func (h *HandlerService) PublicGetValidatorDashboardTotalWithdrawals(w http.Resp
 	returnOk(w, r, response)
 }
 
+// PublicGetValidatorDashboardWithdrawalRequests godoc
+//
+//	@Description	Get the execution layer triggered withdrawal requests (EIP-7002) of the validators of a specified dashboard.
+//	@Tags			Validator Dashboard
+//	@Produce		json
+//	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
+//	@Param			cursor			query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
+//	@Param			limit			query		string	false	"The maximum number of results that may be returned."
+//	@Success		200				{object}	types.GetValidatorDashboardWithdrawalRequestsResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Router			/validator-dashboards/{dashboard_id}/withdrawal-requests [get]
+func (h *HandlerService) PublicGetValidatorDashboardWithdrawalRequests(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardWithdrawalRequests(r.Context(), *dashboardId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardWithdrawalRequestsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetValidatorDashboardConsolidations godoc
+//
+//	@Description	Get the consolidation requests (EIP-7251) in which a validator of a specified dashboard is the source or the target.
+//	@Tags			Validator Dashboard
+//	@Produce		json
+//	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
+//	@Param			cursor			query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
+//	@Param			limit			query		string	false	"The maximum number of results that may be returned."
+//	@Success		200				{object}	types.GetValidatorDashboardConsolidationsResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Router			/validator-dashboards/{dashboard_id}/consolidations [get]
+func (h *HandlerService) PublicGetValidatorDashboardConsolidations(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardConsolidations(r.Context(), *dashboardId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardConsolidationsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetValidatorDashboardPendingQueues godoc
+//
+//	@Description	Get the pending deposits, partial withdrawals and consolidations of the validators of a specified dashboard together with the estimated epoch they will be processed in.
+//	@Tags			Validator Dashboard
+//	@Produce		json
+//	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
+//	@Success		200				{object}	types.GetValidatorDashboardPendingQueuesResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Router			/validator-dashboards/{dashboard_id}/pending-queues [get]
+func (h *HandlerService) PublicGetValidatorDashboardPendingQueues(w http.ResponseWriter, r *http.Request) {
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetValidatorDashboardPendingQueues(r.Context(), *dashboardId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardPendingQueuesResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetValidatorDashboardGossip godoc
+//
+//	@Description	Get how early the attestations of the validators of a specified dashboard were seen on gossip compared to the slot of the block that included them, together with their voluntary exits and BLS to execution changes that are in the operation pool but not finalized yet.
+//	@Tags			Validator Dashboard
+//	@Produce		json
+//	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
+//	@Success		200				{object}	types.GetValidatorDashboardGossipResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Router			/validator-dashboards/{dashboard_id}/gossip [get]
+func (h *HandlerService) PublicGetValidatorDashboardGossip(w http.ResponseWriter, r *http.Request) {
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetValidatorDashboardGossip(r.Context(), *dashboardId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardGossipResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetValidatorDashboardMevBids godoc
+//
+//	@Description	Get the proposals of the validators of a specified dashboard compared to the best bid the MEV-Boost relays received for the slot, showing the value left on the table. Only slots the bids were exported for are returned.
+//	@Tags			Validator Dashboard
+//	@Produce		json
+//	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
+//	@Param			cursor			query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
+//	@Param			limit			query		string	false	"The maximum number of results that may be returned."
+//	@Success		200				{object}	types.GetValidatorDashboardMevBidsResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Router			/validator-dashboards/{dashboard_id}/mev-bids [get]
+func (h *HandlerService) PublicGetValidatorDashboardMevBids(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardMevBids(r.Context(), *dashboardId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardMevBidsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetValidatorDashboardSsvClusters godoc
+//
+//	@Description	Get the SSV clusters the validators of a specified dashboard are registered with. The operators of every cluster are listed with their attestation performance over all validators they run in the last 7 days.
+//	@Tags			Validator Dashboard
+//	@Produce		json
+//	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
+//	@Success		200				{object}	types.GetValidatorDashboardSsvClustersResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Router			/validator-dashboards/{dashboard_id}/ssv-clusters [get]
+func (h *HandlerService) PublicGetValidatorDashboardSsvClusters(w http.ResponseWriter, r *http.Request) {
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetValidatorDashboardSsvClusters(r.Context(), *dashboardId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardSsvClustersResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
 // PublicGetValidatorDashboardRocketPool godoc
 //
 //	@Description	Get an aggregated list of the Rocket Pool nodes details associated with a specified dashboard.
 //	@Tags			Validator Dashboard
 //	@Produce		json
 //	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
+//	@Param			group_id		query		integer	false	"The ID of the group."
 //	@Param			cursor			query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
 //	@Param			limit			query		string	false	"The maximum number of results that may be returned."
 //	@Param			sort			query		string	false	"The field you want to sort by. Append with `:desc` for descending order."	Enums(node, minipools, collateral, rpl, effective_rpl, rpl_apr, smoothing_pool)
@@ -1770,6 +2312,7 @@ func (h *HandlerService) PublicGetValidatorDashboardRocketPool(w http.ResponseWr
 		handleErr(w, r, err)
 		return
 	}
+	groupId := v.checkGroupId(q.Get("group_id"), allowEmpty)
 	pagingParams := v.checkPagingParams(q)
 	sort := checkSort[enums.VDBRocketPoolColumn](&v, q.Get("sort"))
 	if v.hasErrors() {
@@ -1777,7 +2320,7 @@ func (h *HandlerService) PublicGetValidatorDashboardRocketPool(w http.ResponseWr
 		return
 	}
 
-	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardRocketPool(r.Context(), *dashboardId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
+	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardRocketPool(r.Context(), *dashboardId, groupId, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -1789,12 +2332,41 @@ func (h *HandlerService) PublicGetValidatorDashboardRocketPool(w http.ResponseWr
 	returnOk(w, r, response)
 }
 
+// PublicGetValidatorDashboardSyncCommittees godoc
+//
+//	@Description	Get the validators of a specified dashboard that are part of the current or the next sync committee.
+//	@Tags			Validator Dashboard
+//	@Produce		json
+//	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
+//	@Success		200				{object}	types.GetValidatorDashboardSyncCommitteesResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Router			/validator-dashboards/{dashboard_id}/sync-committees [get]
+func (h *HandlerService) PublicGetValidatorDashboardSyncCommittees(w http.ResponseWriter, r *http.Request) {
+	dashboardId, err := h.handleDashboardId(r.Context(), mux.Vars(r)["dashboard_id"])
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetValidatorDashboardSyncCommittees(r.Context(), *dashboardId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetValidatorDashboardSyncCommitteesResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
 // PublicGetValidatorDashboardTotalRocketPool godoc
 //
 //	@Description	Get a summary of all Rocket Pool nodes details associated with a specified dashboard.
 //	@Tags			Validator Dashboard
 //	@Produce		json
 //	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
+//	@Param			group_id		query		integer	false	"The ID of the group."
+//	@Param			search			query		string	false	"Search for Node address."
 //	@Success		200				{object}	types.GetValidatorDashboardTotalRocketPoolResponse
 //	@Failure		400				{object}	types.ApiErrorResponse
 //	@Router			/validator-dashboards/{dashboard_id}/total-rocket-pool [get]
@@ -1806,13 +2378,14 @@ func (h *HandlerService) PublicGetValidatorDashboardTotalRocketPool(w http.Respo
 		handleErr(w, r, err)
 		return
 	}
+	groupId := v.checkGroupId(q.Get("group_id"), allowEmpty)
 	pagingParams := v.checkPagingParams(q)
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
 	}
 
-	data, err := h.getDataAccessor(r).GetValidatorDashboardTotalRocketPool(r.Context(), *dashboardId, pagingParams.search)
+	data, err := h.getDataAccessor(r).GetValidatorDashboardTotalRocketPool(r.Context(), *dashboardId, groupId, pagingParams.search)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -1830,6 +2403,7 @@ func (h *HandlerService) PublicGetValidatorDashboardTotalRocketPool(w http.Respo
 //	@Produce		json
 //	@Param			dashboard_id	path		string	true	"The ID of the dashboard."
 //	@Param			node_address	path		string	true	"The address of the node."
+//	@Param			group_id		query		integer	false	"The ID of the group."
 //	@Param			cursor			query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
 //	@Param			limit			query		string	false	"The maximum number of results that may be returned."
 //	@Param			sort			query		string	false	"The field you want to sort by. Append with `:desc` for descending order."	Enums(group_id)
@@ -1848,6 +2422,7 @@ func (h *HandlerService) PublicGetValidatorDashboardRocketPoolMinipools(w http.R
 	}
 	// support ENS names ?
 	nodeAddress := v.checkAddress(vars["node_address"])
+	groupId := v.checkGroupId(q.Get("group_id"), allowEmpty)
 	pagingParams := v.checkPagingParams(q)
 	sort := checkSort[enums.VDBRocketPoolMinipoolsColumn](&v, q.Get("sort"))
 	if v.hasErrors() {
@@ -1855,7 +2430,7 @@ func (h *HandlerService) PublicGetValidatorDashboardRocketPoolMinipools(w http.R
 		return
 	}
 
-	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardRocketPoolMinipools(r.Context(), *dashboardId, nodeAddress, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
+	data, paging, err := h.getDataAccessor(r).GetValidatorDashboardRocketPoolMinipools(r.Context(), *dashboardId, groupId, nodeAddress, pagingParams.cursor, *sort, pagingParams.search, pagingParams.limit)
 	if err != nil {
 		handleErr(w, r, err)
 		return
@@ -2561,6 +3136,7 @@ func (h *HandlerService) PublicPutUserNotificationSettingsValidatorDashboard(w h
 
 	checkMinMax(&v, req.MaxCollateralThreshold, 0, 1, "max_collateral_threshold")
 	checkMinMax(&v, req.MinCollateralThreshold, 0, 1, "min_collateral_threshold")
+	checkMinMax(&v, req.MissedBestBidThreshold, 0, math.MaxFloat64, "missed_best_bid_threshold")
 	if v.hasErrors() {
 		handleErr(w, r, v)
 		return
@@ -2770,8 +3346,33 @@ func (h *HandlerService) PublicGetNetworkValidatorLeaderboard(w http.ResponseWri
 	returnOk(w, r, nil)
 }
 
+// PublicGetNetworkValidatorQueue godoc
+//
+//	@Description	Get a summary of the pending deposit, partial withdrawal and consolidation queues of the beacon state, including the churn limits and the estimated epoch each queue is cleared.
+//	@Tags			Network
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Success		200		{object}	types.GetNetworkValidatorQueueResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/validator-queue [get]
 func (h *HandlerService) PublicGetNetworkValidatorQueue(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetValidatorQueue(r.Context(), chainId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkValidatorQueueResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
 func (h *HandlerService) PublicGetNetworkEpochs(w http.ResponseWriter, r *http.Request) {
@@ -2909,142 +3510,1221 @@ func (h *HandlerService) PublicGetNetworkWithdrawalCredentialWithdrawals(w http.
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetNetworkVoluntaryExits(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
-
-func (h *HandlerService) PublicGetNetworkEpochVoluntaryExits(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkWithdrawalRequests godoc
+//
+//	@Description	Get the execution layer triggered withdrawal requests (EIP-7002) included in canonical blocks, newest first.
+//	@Tags			Network
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetWithdrawalRequestsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/withdrawal-requests [get]
+func (h *HandlerService) PublicGetNetworkWithdrawalRequests(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkSlotVoluntaryExits(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.getDataAccessor(r).GetWithdrawalRequests(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetWithdrawalRequestsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkBlockVoluntaryExits(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkConsolidations godoc
+//
+//	@Description	Get the consolidation requests (EIP-7251) included in canonical blocks, newest first.
+//	@Tags			Network
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetConsolidationRequestsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/consolidations [get]
+func (h *HandlerService) PublicGetNetworkConsolidations(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkAddressBalanceHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.getDataAccessor(r).GetConsolidationRequests(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetConsolidationRequestsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkAddressTokenSupplyHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
-
-func (h *HandlerService) PublicGetNetworkAddressEventLogs(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkPendingDeposits godoc
+//
+//	@Description	Get the pending deposits of the beacon state in queue order together with the estimated epoch they are applied to the validator balance.
+//	@Tags			Network
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetPendingDepositsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/pending-deposits [get]
+func (h *HandlerService) PublicGetNetworkPendingDeposits(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkTransactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.getDataAccessor(r).GetPendingDeposits(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetPendingDepositsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkTransaction(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkPendingPartialWithdrawals godoc
+//
+//	@Description	Get the pending partial withdrawals of the beacon state in queue order together with the estimated epoch they are swept.
+//	@Tags			Network
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetPendingPartialWithdrawalsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/pending-partial-withdrawals [get]
+func (h *HandlerService) PublicGetNetworkPendingPartialWithdrawals(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkAddressTransactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.getDataAccessor(r).GetPendingPartialWithdrawals(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetPendingPartialWithdrawalsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkSlotTransactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkPendingConsolidations godoc
+//
+//	@Description	Get the pending consolidations of the beacon state in queue order together with the estimated epoch the balance is moved to the target validator.
+//	@Tags			Network
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetPendingConsolidationsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/pending-consolidations [get]
+func (h *HandlerService) PublicGetNetworkPendingConsolidations(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkBlockTransactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.getDataAccessor(r).GetPendingConsolidations(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetPendingConsolidationsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkBlockBlobs(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkRelays godoc
+//
+//	@Description	Get the performance of the MEV-Boost relays over the last 7 days: the share of the slots with bids the relay delivered the payload for, the median best bid and how long before the start of the slot the bids arrived.
+//	@Tags			Network
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Success		200		{object}	types.GetNetworkRelaysResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/relays [get]
+func (h *HandlerService) PublicGetNetworkRelays(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkBlsChanges(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.getDataAccessor(r).GetRelayPerformance(r.Context(), chainId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkRelaysResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkEpochBlsChanges(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkBuilders godoc
+//
+//	@Description	Get the performance of the block builders over the last 7 days based on the bids received by the MEV-Boost relays, most won slots first. A builder is flagged as censoring if it only submitted bids to censoring relays.
+//	@Tags			Network
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Success		200		{object}	types.GetNetworkBuildersResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/builders [get]
+func (h *HandlerService) PublicGetNetworkBuilders(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkSlotBlsChanges(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.getDataAccessor(r).GetBuilderPerformance(r.Context(), chainId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetNetworkBuildersResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkBlockBlsChanges(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetNetworkVoluntaryExits(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetNetworkValidatorBlsChanges(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetNetworkEpochVoluntaryExits(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetNetworkAddressEns(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetNetworkSlotVoluntaryExits(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetNetworkEns(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetNetworkBlockVoluntaryExits(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetNetworkBatches(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkAddressBalanceHistory godoc
+//
+//	@Description	Get the history of balance changes of an address, newest first. The balance is reconstructed from transactions, internal transactions and withdrawals.
+//	@Tags			Addresses
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			address	path		string	true	"The address."
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate forward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetAddressBalanceHistoryResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/addresses/{address}/balance-history [get]
+func (h *HandlerService) PublicGetNetworkAddressBalanceHistory(w http.ResponseWriter, r *http.Request) {
+	chainId, address, pagingParams, err := validateAddressTableRequest(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkLayer2ToLayer1Transactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.getDataAccessor(r).GetAddressBalanceHistory(r.Context(), chainId, address, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetAddressBalanceHistoryResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkLayer1ToLayer2Transactions(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkAddressTokenSupplyHistory godoc
+//
+//	@Description	Get the history of total supply changes (mints and burns) of an ERC20 token, newest first.
+//	@Tags			Addresses
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			address	path		string	true	"The token contract address."
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate forward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetAddressTokenSupplyHistoryResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/addresses/{address}/token-supply-history [get]
+func (h *HandlerService) PublicGetNetworkAddressTokenSupplyHistory(w http.ResponseWriter, r *http.Request) {
+	chainId, address, pagingParams, err := validateAddressTableRequest(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 
-func (h *HandlerService) PublicPostNetworkBroadcasts(w http.ResponseWriter, r *http.Request) {
-	returnCreated(w, r, nil)
+	data, paging, err := h.getDataAccessor(r).GetAddressTokenSupplyHistory(r.Context(), chainId, address, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetAddressTokenSupplyHistoryResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetEthPriceHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkAddressEventLogs godoc
+//
+//	@Description	Get the event logs emitted by a contract in transactions it was part of, newest first.
+//	@Tags			Addresses
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			address	path		string	true	"The contract address."
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate forward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetAddressEventLogsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/addresses/{address}/event-logs [get]
+func (h *HandlerService) PublicGetNetworkAddressEventLogs(w http.ResponseWriter, r *http.Request) {
+	chainId, address, pagingParams, err := validateAddressTableRequest(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkGasNow(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.getDataAccessor(r).GetAddressEventLogs(r.Context(), chainId, address, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetAddressEventLogsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetNetworkAverageGasLimitHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkTransactions godoc
+//
+//	@Description	Get the latest transactions of a network, newest first.
+//	@Tags			Transactions
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetTransactionsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/transactions [get]
+func (h *HandlerService) PublicGetNetworkTransactions(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkGasUsedHistory(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.getDataAccessor(r).GetTransactions(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetTransactionsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetRocketPool(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkTransaction godoc
+//
+//	@Description	Get the details of a transaction including its event logs.
+//	@Tags			Transactions
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			hash	path		string	true	"The transaction hash."
+//	@Success		200		{object}	types.GetTransactionResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/transactions/{hash} [get]
+func (h *HandlerService) PublicGetNetworkTransaction(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkNetworkParameter(vars["network"])
+	hash := v.checkTransactionHash(vars["hash"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
 
-func (h *HandlerService) PublicGetRocketPoolNodes(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, err := h.getDataAccessor(r).GetTransaction(r.Context(), chainId, hash)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetTransactionResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetRocketPoolMinipools(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
-}
+// PublicGetNetworkAddressTransactions godoc
+//
+//	@Description	Get the transactions an address sent or received, newest first. Transactions executing a Safe transaction are labelled with the Safe transaction.
+//	@Tags			Addresses
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			address	path		string	true	"The address."
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate forward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetAddressTransactionsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/addresses/{address}/transactions [get]
+func (h *HandlerService) PublicGetNetworkAddressTransactions(w http.ResponseWriter, r *http.Request) {
+	chainId, address, pagingParams, err := validateAddressTableRequest(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
 
-func (h *HandlerService) PublicGetNetworkSyncCommittee(w http.ResponseWriter, r *http.Request) {
-	returnOk(w, r, nil)
+	data, paging, err := h.getDataAccessor(r).GetAddressTransactions(r.Context(), chainId, address, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetAddressTransactionsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
 }
 
-func (h *HandlerService) PublicGetMultisigSafe(w http.ResponseWriter, r *http.Request) {
+// PublicGetNetworkSlotTransactions godoc
+//
+//	@Description	Get the transactions of the execution payload of a slot.
+//	@Tags			Transactions
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			slot	path		string	true	"The slot number or `latest`."
+//	@Success		200		{object}	types.InternalGetBlockTransactionsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/slots/{slot}/transactions [get]
+func (h *HandlerService) PublicGetNetworkSlotTransactions(w http.ResponseWriter, r *http.Request) {
+	chainId, slot, err := h.validateBlockRequest(r, "slot")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetSlotTransactions(r.Context(), chainId, slot)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.InternalGetBlockTransactionsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetNetworkBlockTransactions godoc
+//
+//	@Description	Get the transactions of a block.
+//	@Tags			Transactions
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			block	path		string	true	"The block number or `latest`."
+//	@Success		200		{object}	types.InternalGetBlockTransactionsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/blocks/{block}/transactions [get]
+func (h *HandlerService) PublicGetNetworkBlockTransactions(w http.ResponseWriter, r *http.Request) {
+	chainId, block, err := h.validateBlockRequest(r, "block")
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetBlockTransactions(r.Context(), chainId, block)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	response := types.InternalGetBlockTransactionsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) PublicGetNetworkBlockBlobs(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetMultisigSafeTransactions(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetNetworkBlsChanges(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
 
-func (h *HandlerService) PublicGetMultisigTransactionConfirmations(w http.ResponseWriter, r *http.Request) {
+func (h *HandlerService) PublicGetNetworkEpochBlsChanges(w http.ResponseWriter, r *http.Request) {
 	returnOk(w, r, nil)
 }
+
+func (h *HandlerService) PublicGetNetworkSlotBlsChanges(w http.ResponseWriter, r *http.Request) {
+	returnOk(w, r, nil)
+}
+
+func (h *HandlerService) PublicGetNetworkBlockBlsChanges(w http.ResponseWriter, r *http.Request) {
+	returnOk(w, r, nil)
+}
+
+func (h *HandlerService) PublicGetNetworkValidatorBlsChanges(w http.ResponseWriter, r *http.Request) {
+	returnOk(w, r, nil)
+}
+
+// PublicGetNetworkAddressEns godoc
+//
+//	@Description	Get the primary ENS name (reverse resolution) and all ENS names resolving to an address.
+//	@Tags			ENS
+//	@Produce		json
+//	@Param			address	path		string	true	"The address."
+//	@Success		200		{object}	types.GetAddressEnsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/ethereum/addresses/{address}/ens [get]
+func (h *HandlerService) PublicGetNetworkAddressEns(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(ethereum)
+	address := v.checkAddress(mux.Vars(r)["address"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetAddressEnsNames(r.Context(), chainId, address)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetAddressEnsResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetNetworkEns godoc
+//
+//	@Description	Get the address an ENS name resolves to along with its registration and renewal history.
+//	@Tags			ENS
+//	@Produce		json
+//	@Param			ens_name	path		string	true	"The ENS name, e.g. `vitalik.eth`."
+//	@Success		200			{object}	types.GetEnsNameResponse
+//	@Failure		400			{object}	types.ApiErrorResponse
+//	@Failure		404			{object}	types.ApiErrorResponse
+//	@Router			/networks/ethereum/ens/{ens_name} [get]
+func (h *HandlerService) PublicGetNetworkEns(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(ethereum)
+	name := v.checkEnsName(mux.Vars(r)["ens_name"], "ens_name")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetEnsName(r.Context(), chainId, name)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetEnsNameResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicPostNetworkEnsBatchResolution godoc
+//
+//	@Description	Resolve multiple ENS names to their addresses and multiple addresses to their primary ENS names at once.
+//	@Tags			ENS
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		handlers.PublicPostNetworkEnsBatchResolution.request	true	"`names`: ENS names to resolve.<br>`addresses`: Addresses to reverse resolve.<br>At most 100 entries in total."
+//	@Success		200		{object}	types.PostEnsBatchResolutionResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Router			/networks/ethereum/ens/batch-resolution [post]
+func (h *HandlerService) PublicPostNetworkEnsBatchResolution(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(ethereum)
+	type request struct {
+		Names     []string `json:"names,omitempty"`
+		Addresses []string `json:"addresses,omitempty"`
+	}
+	var req request
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if len(req.Names)+len(req.Addresses) > maxEnsBatchEntries {
+		v.add("request body", fmt.Sprintf("too many entries, at most %d names and addresses can be resolved at once", maxEnsBatchEntries))
+	}
+	names := make([]string, 0, len(req.Names))
+	for _, name := range req.Names {
+		names = append(names, v.checkEnsName(name, "names"))
+	}
+	addresses := make([]string, 0, len(req.Addresses))
+	for _, address := range req.Addresses {
+		addresses = append(addresses, v.checkAddress(address))
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetEnsBatchResolution(r.Context(), chainId, names, addresses)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.PostEnsBatchResolutionResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetNetworkBatches godoc
+//
+//	@Description	Get the batches a rollup submitted to the layer 1, newest first.
+//	@Tags			Layer 2
+//	@Produce		json
+//	@Param			layer_2_network	path		string	true	"The layer 2 network name or chain ID."
+//	@Param			cursor			query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate forward."
+//	@Param			limit			query		string	false	"The maximum number of results that may be returned."
+//	@Success		200				{object}	types.GetLayer2BatchesResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Failure		404				{object}	types.ApiErrorResponse
+//	@Router			/networks/{layer_2_network}/batches [get]
+func (h *HandlerService) PublicGetNetworkBatches(w http.ResponseWriter, r *http.Request) {
+	chainId, pagingParams, err := validateLayer2TableRequest(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, paging, err := h.getDataAccessor(r).GetLayer2Batches(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetLayer2BatchesResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetNetworkLayer2ToLayer1Transactions godoc
+//
+//	@Description	Get the withdrawal transactions of a rollup on the layer 1 (proofs, finalizations and outbox executions), newest first. Where indexed, the transaction initiating the withdrawal on the layer 2 is included.
+//	@Tags			Layer 2
+//	@Produce		json
+//	@Param			layer_2_network	path		string	true	"The layer 2 network name or chain ID."
+//	@Param			cursor			query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate forward."
+//	@Param			limit			query		string	false	"The maximum number of results that may be returned."
+//	@Success		200				{object}	types.GetLayer2ToLayer1TransactionsResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Failure		404				{object}	types.ApiErrorResponse
+//	@Router			/networks/{layer_2_network}/layer2-to-layer1-transactions [get]
+func (h *HandlerService) PublicGetNetworkLayer2ToLayer1Transactions(w http.ResponseWriter, r *http.Request) {
+	chainId, pagingParams, err := validateLayer2TableRequest(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, paging, err := h.getDataAccessor(r).GetLayer2ToLayer1Transactions(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetLayer2ToLayer1TransactionsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetNetworkLayer1ToLayer2Transactions godoc
+//
+//	@Description	Get the deposits and messages sent from the layer 1 to a rollup, newest first, along with the hash of the resulting layer 2 transaction.
+//	@Tags			Layer 2
+//	@Produce		json
+//	@Param			layer_2_network	path		string	true	"The layer 2 network name or chain ID."
+//	@Param			cursor			query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate forward."
+//	@Param			limit			query		string	false	"The maximum number of results that may be returned."
+//	@Success		200				{object}	types.GetLayer1ToLayer2TransactionsResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Failure		404				{object}	types.ApiErrorResponse
+//	@Router			/networks/{layer_2_network}/layer1-to-layer2-transactions [get]
+func (h *HandlerService) PublicGetNetworkLayer1ToLayer2Transactions(w http.ResponseWriter, r *http.Request) {
+	chainId, pagingParams, err := validateLayer2TableRequest(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	data, paging, err := h.getDataAccessor(r).GetLayer1ToLayer2Transactions(r.Context(), chainId, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetLayer1ToLayer2TransactionsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicPostNetworkBroadcasts godoc
+//
+//	@Description	Broadcast signed BLS to execution changes, a signed voluntary exit or a signed raw transaction. The data is validated and then submitted to the network by a node, use the returned id to follow its status.
+//	@Tags			Broadcasts
+//	@Accept			json
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			request	body		object	true	"A list of signed BLS to execution changes, a signed voluntary exit or a hex encoded signed raw transaction as string."
+//	@Success		201		{object}	types.PostBroadcastResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/broadcasts [post]
+func (h *HandlerService) PublicPostNetworkBroadcasts(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	var req json.RawMessage
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).CreateBroadcast(r.Context(), chainId, req)
+	var userErr commontypes.CreateNodeJobUserError
+	if errors.As(err, &userErr) {
+		handleErr(w, r, newBadRequestErr("%s", userErr.Message))
+		return
+	}
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.PostBroadcastResponse{
+		Data: *data,
+	}
+	returnCreated(w, r, response)
+}
+
+// PublicGetNetworkBroadcast godoc
+//
+//	@Description	Get the status of a broadcast.
+//	@Tags			Broadcasts
+//	@Produce		json
+//	@Param			network			path		string	true	"The network name or chain ID."
+//	@Param			broadcast_id	path		string	true	"The id returned when the broadcast was created."
+//	@Success		200				{object}	types.GetBroadcastResponse
+//	@Failure		400				{object}	types.ApiErrorResponse
+//	@Failure		404				{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/broadcasts/{broadcast_id} [get]
+func (h *HandlerService) PublicGetNetworkBroadcast(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkNetworkParameter(vars["network"])
+	id := v.checkBroadcastId(vars["broadcast_id"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetBroadcast(r.Context(), chainId, id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetBroadcastResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetEthPriceHistory godoc
+//
+//	@Description	Get the historic price of the main currency of the network (ETH, or GNO on Gnosis) as hourly or daily OHLC candles. Prices are the median of multiple sources.
+//	@Tags			Network
+//	@Produce		json
+//	@Param			currency	query		string	false	"The currency to get the price in."	Default(USD)
+//	@Param			resolution	query		string	false	"The interval of a candle. Hourly candles are limited to 31 days, daily candles to 5 years per request."	Enums(hourly, daily)	Default(daily)
+//	@Param			after_ts	query		integer	false	"Return candles after this timestamp."
+//	@Param			before_ts	query		integer	false	"Return candles before this timestamp."
+//	@Success		200			{object}	types.GetEthPriceHistoryResponse
+//	@Failure		400			{object}	types.ApiErrorResponse
+//	@Router			/eth-price-history [get]
+func (h *HandlerService) PublicGetEthPriceHistory(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	currency := v.checkCurrency(q.Get("currency"), "USD")
+	resolution := q.Get("resolution")
+	limits := ChartTimeDashboardLimits{
+		MinAllowedTs:     utils.Config.Chain.GenesisTimestamp,
+		LatestExportedTs: uint64(time.Now().Unix()),
+	}
+	switch resolution {
+	case "", "daily":
+		resolution = "daily"
+		limits.MaxAllowedInterval = uint64((time.Hour * 24 * 365 * 5).Seconds())
+	case "hourly":
+		limits.MaxAllowedInterval = uint64((time.Hour * 24 * 31).Seconds())
+	default:
+		v.add("resolution", fmt.Sprintf("given value '%s' is not valid", resolution))
+	}
+	afterTs, beforeTs := v.checkTimestamps(r, limits)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetEthPriceHistory(r.Context(), currency, resolution, afterTs, beforeTs)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetEthPriceHistoryResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetNetworkGasNow godoc
+//
+//	@Description	Get the current gas price estimates and a short-term forecast of the base fee. Estimates are derived from the priority fees paid in recent blocks.
+//	@Tags			Gas
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Success		200		{object}	types.GetGasNowResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/gasnow [get]
+func (h *HandlerService) PublicGetNetworkGasNow(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetGasNow(r.Context(), chainId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetGasNowResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetNetworkAverageGasLimitHistory godoc
+//
+//	@Description	Get the daily average gas limit of blocks.
+//	@Tags			Gas
+//	@Produce		json
+//	@Param			network		path		string	true	"The network name or chain ID."
+//	@Param			after_ts	query		string	false	"Return data after this timestamp."
+//	@Param			before_ts	query		string	false	"Return data before this timestamp."
+//	@Success		200			{object}	types.GetAverageGasLimitHistoryResponse
+//	@Failure		400			{object}	types.ApiErrorResponse
+//	@Failure		404			{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/average-gas-limit-history [get]
+func (h *HandlerService) PublicGetNetworkAverageGasLimitHistory(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	afterTs, beforeTs := v.checkTimestamps(r, getDailyChartTimeLimits())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetAverageGasLimitHistory(r.Context(), chainId, afterTs, beforeTs)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetAverageGasLimitHistoryResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetNetworkGasUsedHistory godoc
+//
+//	@Description	Get the daily total gas used and the daily average gas used per block.
+//	@Tags			Gas
+//	@Produce		json
+//	@Param			network		path		string	true	"The network name or chain ID."
+//	@Param			after_ts	query		string	false	"Return data after this timestamp."
+//	@Param			before_ts	query		string	false	"Return data before this timestamp."
+//	@Success		200			{object}	types.GetGasUsedHistoryResponse
+//	@Failure		400			{object}	types.ApiErrorResponse
+//	@Failure		404			{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/gas-used-history [get]
+func (h *HandlerService) PublicGetNetworkGasUsedHistory(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	chainId := v.checkNetworkParameter(mux.Vars(r)["network"])
+	afterTs, beforeTs := v.checkTimestamps(r, getDailyChartTimeLimits())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetGasUsedHistory(r.Context(), chainId, afterTs, beforeTs)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetGasUsedHistoryResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetRocketPool godoc
+//
+//	@Description	Get an overview of the Rocket Pool network, including the current rewards interval and exchange rates.
+//	@Tags			Protocols
+//	@Produce		json
+//	@Success		200	{object}	types.InternalGetRocketPoolResponse
+//	@Failure		404	{object}	types.ApiErrorResponse
+//	@Router			/rocket-pool [get]
+func (h *HandlerService) PublicGetRocketPool(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getDataAccessor(r).GetRocketPoolOverview(r.Context())
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.InternalGetRocketPoolResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetRocketPoolNodes godoc
+//
+//	@Description	Get a list of all Rocket Pool nodes, ordered by address.
+//	@Tags			Protocols
+//	@Produce		json
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetRocketPoolNodesResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Router			/rocket-pool/nodes [get]
+func (h *HandlerService) PublicGetRocketPoolNodes(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.getDataAccessor(r).GetRocketPoolNodes(r.Context(), pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetRocketPoolNodesResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetRocketPoolMinipools godoc
+//
+//	@Description	Get a list of all Rocket Pool minipools, ordered by address.
+//	@Tags			Protocols
+//	@Produce		json
+//	@Param			node	query		string	false	"Only return the minipools of the node with this address."
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate to forward, or pass the `paging.prev_cursor`` value of the previous response to navigate to backward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetRocketPoolMinipoolsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Router			/rocket-pool/minipools [get]
+func (h *HandlerService) PublicGetRocketPoolMinipools(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	q := r.URL.Query()
+	var node []byte
+	if q.Has("node") {
+		node = common.FromHex(v.checkAddress(q.Get("node")))
+	}
+	pagingParams := v.checkPagingParams(q)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.getDataAccessor(r).GetRocketPoolMinipools(r.Context(), node, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetRocketPoolMinipoolsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetStakingProtocol godoc
+//
+//	@Description	Get the fee split and the operators of a staking protocol, together with the number of its validators that have been identified.
+//	@Tags			Protocols
+//	@Produce		json
+//	@Param			protocol	path		string	true	"The staking protocol."	Enums(lido, ether_fi)
+//	@Success		200			{object}	types.GetStakingProtocolResponse
+//	@Failure		400			{object}	types.ApiErrorResponse
+//	@Failure		404			{object}	types.ApiErrorResponse
+//	@Router			/staking-protocols/{protocol} [get]
+func (h *HandlerService) PublicGetStakingProtocol(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	protocol := v.checkStakingProtocol(mux.Vars(r)["protocol"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetStakingProtocol(r.Context(), protocol)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetStakingProtocolResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// checkSyncPeriod accepts a period number or "current" / "next", relative to the latest slot
+func (h *HandlerService) checkSyncPeriod(v *validationError, r *http.Request, param string) uint64 {
+	switch param {
+	case "current", "next":
+		latestSlot, err := h.getDataAccessor(r).GetLatestSlot(r.Context())
+		if err != nil {
+			v.add("period", "could not determine the current sync committee period")
+			return 0
+		}
+		period := utils.SyncPeriodOfEpoch(latestSlot / utils.Config.Chain.ClConfig.SlotsPerEpoch)
+		if param == "next" {
+			period++
+		}
+		return period
+	}
+	return v.checkUint(param, "period")
+}
+
+// PublicGetNetworkSyncCommittee godoc
+//
+//	@Description	Get the members of a sync committee with their participation and sync rewards. Participation only counts slots with a canonical block.
+//	@Tags			Network
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			period	path		string	true	"The sync committee period, `current` or `next`."
+//	@Success		200		{object}	types.GetSyncCommitteeResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/sync-committee/{period} [get]
+func (h *HandlerService) PublicGetNetworkSyncCommittee(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkNetworkParameter(vars["network"])
+	period := h.checkSyncPeriod(&v, r, vars["period"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetSyncCommittee(r.Context(), chainId, period)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetSyncCommitteeResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetNetworkSyncCommitteeSlots godoc
+//
+//	@Description	Get the sync aggregate of each slot of a sync committee period, including the committee indices of the members that did not sign.
+//	@Tags			Network
+//	@Produce		json
+//	@Param			network	path		string	true	"The network name or chain ID."
+//	@Param			period	path		string	true	"The sync committee period, `current` or `next`."
+//	@Success		200		{object}	types.GetSyncCommitteeSlotsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/networks/{network}/sync-committee/{period}/slots [get]
+func (h *HandlerService) PublicGetNetworkSyncCommitteeSlots(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	vars := mux.Vars(r)
+	chainId := v.checkNetworkParameter(vars["network"])
+	period := h.checkSyncPeriod(&v, r, vars["period"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetSyncCommitteeSlots(r.Context(), chainId, period)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetSyncCommitteeSlotsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetMultisigSafe godoc
+//
+//	@Description	Get the current owners and threshold of a Safe multisig wallet along with its deployment.
+//	@Tags			Multisig
+//	@Produce		json
+//	@Param			address	path		string	true	"The address of the Safe."
+//	@Success		200		{object}	types.GetMultisigSafeResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/multisig-safes/{address} [get]
+func (h *HandlerService) PublicGetMultisigSafe(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	address := common.FromHex(v.checkAddress(mux.Vars(r)["address"]))
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetMultisigSafe(r.Context(), address)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetMultisigSafeResponse{
+		Data: *data,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetMultisigSafeTransactions godoc
+//
+//	@Description	Get the transactions executed by a Safe multisig wallet, newest first. The details of a Safe transaction are only available if it was executed by calling the Safe directly.
+//	@Tags			Multisig
+//	@Produce		json
+//	@Param			address	path		string	true	"The address of the Safe."
+//	@Param			cursor	query		string	false	"Return data for the given cursor value. Pass the `paging.next_cursor`` value of the previous response to navigate forward."
+//	@Param			limit	query		string	false	"The maximum number of results that may be returned."
+//	@Success		200		{object}	types.GetMultisigSafeTransactionsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/multisig-safes/{address}/transactions [get]
+func (h *HandlerService) PublicGetMultisigSafeTransactions(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	address := common.FromHex(v.checkAddress(mux.Vars(r)["address"]))
+	pagingParams := v.checkPagingParams(r.URL.Query())
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, paging, err := h.getDataAccessor(r).GetMultisigSafeTransactions(r.Context(), address, pagingParams.cursor, pagingParams.limit)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetMultisigSafeTransactionsResponse{
+		Data:   data,
+		Paging: *paging,
+	}
+	returnOk(w, r, response)
+}
+
+// PublicGetMultisigTransactionConfirmations godoc
+//
+//	@Description	Get the owners that confirmed a Safe transaction, decoded from the signatures of its execution and from on chain approvals of its hash.
+//	@Tags			Multisig
+//	@Produce		json
+//	@Param			hash	path		string	true	"The Safe transaction hash."
+//	@Success		200		{object}	types.GetMultisigTransactionConfirmationsResponse
+//	@Failure		400		{object}	types.ApiErrorResponse
+//	@Failure		404		{object}	types.ApiErrorResponse
+//	@Router			/multisig-transactions/{hash}/confirmations [get]
+func (h *HandlerService) PublicGetMultisigTransactionConfirmations(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	safeTxHash := v.checkTransactionHash(mux.Vars(r)["hash"])
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+
+	data, err := h.getDataAccessor(r).GetMultisigTransactionConfirmations(r.Context(), safeTxHash)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	response := types.GetMultisigTransactionConfirmationsResponse{
+		Data: data,
+	}
+	returnOk(w, r, response)
+}
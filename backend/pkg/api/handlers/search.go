@@ -252,12 +252,11 @@ func (h *HandlerService) handleSearchValidatorsByWithdrawalCredential(ctx contex
 }
 
 func (h *HandlerService) handleSearchValidatorsByWithdrawalAddress(ctx context.Context, input string, chainId uint64) (*types.SearchResult, error) {
-	withdrawalString := "010000000000000000000000" + strings.TrimPrefix(input, "0x")
-	withdrawalCredential, err := hex.DecodeString(withdrawalString)
+	address, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
 	if err != nil {
 		return nil, err
 	}
-	result, err := h.daService.GetSearchValidatorsByWithdrawalCredential(ctx, chainId, withdrawalCredential)
+	result, err := h.daService.GetSearchValidatorsByWithdrawalAddress(ctx, chainId, address)
 	return asSearchResult(validatorsByWithdrawalAddress, chainId, result, err)
 }
 
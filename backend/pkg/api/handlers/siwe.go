@@ -0,0 +1,272 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	dataaccess "github.com/gobitfly/beaconchain/pkg/api/data_access"
+	"github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/siwe"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/gorilla/mux"
+)
+
+const (
+	siweNonceKey       = "siwe_nonce"
+	siweNonceIssuedKey = "siwe_nonce_issued"
+)
+
+const siweNonceExpireTime = time.Minute * 10
+
+// request body of endpoints that require a signed Sign-In with Ethereum message
+type siweRequest struct {
+	Message   string `json:"message"`
+	Signature string `json:"signature"` // hex encoded personal_sign signature
+}
+
+func (v *validationError) checkSiweRequest(req siweRequest) []byte {
+	if req.Message == "" {
+		v.add("message", "message must not be empty")
+	}
+	signature, err := hexutil.Decode(req.Signature)
+	if err != nil {
+		v.add("signature", "signature must be hex encoded with 0x prefix")
+	}
+	return signature
+}
+
+// verifySiweMessage consumes the nonce stored in the session and returns the address that signed the message
+func (h *HandlerService) verifySiweMessage(ctx context.Context, message string, signature []byte) (common.Address, error) {
+	nonce := h.scs.PopString(ctx, siweNonceKey)
+	issued := h.scs.PopTime(ctx, siweNonceIssuedKey)
+	if nonce == "" || time.Since(issued) > siweNonceExpireTime {
+		return common.Address{}, newUnauthorizedErr("no valid nonce, request a new one")
+	}
+	parsed, err := siwe.ParseMessage(message)
+	if err != nil {
+		return common.Address{}, newBadRequestErr("invalid message: %v", err)
+	}
+	err = parsed.Validate("https://"+utils.Config.Frontend.SiteDomain, utils.Config.Chain.ClConfig.DepositChainID, nonce, time.Now())
+	if err == nil {
+		err = parsed.VerifySignature(message, signature)
+	}
+	if err != nil {
+		return common.Address{}, newUnauthorizedErr("%v", err)
+	}
+	return parsed.Address, nil
+}
+
+// withdrawalCredentialAddress returns the execution layer address of 0x01 and 0x02 withdrawal credentials
+func withdrawalCredentialAddress(credential []byte) (common.Address, bool) {
+	if len(credential) != 32 || (credential[0] != 0x01 && credential[0] != 0x02) || !bytes.Equal(credential[1:12], make([]byte, 11)) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(credential[12:]), true
+}
+
+// isVerifiedOwner returns whether the user has verified the deposit address or the address of the withdrawal credential, exactly one has to be set
+func (h *HandlerService) isVerifiedOwner(ctx context.Context, userId uint64, depositAddress, withdrawalCredential string) (bool, error) {
+	var address common.Address
+	switch {
+	case depositAddress != "":
+		if !common.IsHexAddress(depositAddress) {
+			return false, nil
+		}
+		address = common.HexToAddress(depositAddress)
+	case withdrawalCredential != "":
+		credential, err := hex.DecodeString(strings.TrimPrefix(withdrawalCredential, "0x"))
+		if err != nil {
+			return false, nil
+		}
+		var ok bool
+		if address, ok = withdrawalCredentialAddress(credential); !ok {
+			return false, nil
+		}
+	default:
+		return false, nil
+	}
+	ownerId, err := h.daService.GetUserIdByVerifiedAddress(ctx, address)
+	if errors.Is(err, dataaccess.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return ownerId == userId, nil
+}
+
+// markVerifiedOwnerValidators flags the validators whose withdrawal address has been verified by the dashboard owner
+func (h *HandlerService) markVerifiedOwnerValidators(ctx context.Context, dashboardId types.VDBIdPrimary, validators []types.VDBManageValidatorsTableRow) error {
+	dashboardUser, err := h.daService.GetValidatorDashboardUser(ctx, dashboardId)
+	if err != nil {
+		return err
+	}
+	verifiedAddresses, err := h.daService.GetUserVerifiedAddresses(ctx, dashboardUser.UserId)
+	if err != nil || len(verifiedAddresses) == 0 {
+		return err
+	}
+	verified := make(map[common.Address]bool, len(verifiedAddresses))
+	for _, address := range verifiedAddresses {
+		verified[common.HexToAddress(string(address.Address))] = true
+	}
+	for i := range validators {
+		credential, err := hexutil.Decode(string(validators[i].WithdrawalCredential))
+		if err != nil {
+			continue
+		}
+		if address, ok := withdrawalCredentialAddress(credential); ok {
+			validators[i].VerifiedOwner = verified[address]
+		}
+	}
+	return nil
+}
+
+// Returns a nonce that has to be included in the next Sign-In with Ethereum message
+func (h *HandlerService) InternalPostSiweNonces(w http.ResponseWriter, r *http.Request) {
+	nonce := utils.RandomString(16)
+	issued := time.Now()
+	h.scs.Put(r.Context(), siweNonceKey, nonce)
+	h.scs.Put(r.Context(), siweNonceIssuedKey, issued)
+
+	returnOk(w, r, types.InternalPostSiweNonceResponse{
+		Data: types.SiweNonce{
+			Nonce:     nonce,
+			ExpiresAt: issued.Add(siweNonceExpireTime).Unix(),
+			ChainId:   utils.Config.Chain.ClConfig.DepositChainID,
+		},
+	})
+}
+
+// Logs in the user the signing address has been verified by
+func (h *HandlerService) InternalPostLoginSiwe(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	var req siweRequest
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	signature := v.checkSiweRequest(req)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	address, err := h.verifySiweMessage(r.Context(), req.Message, signature)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	userId, err := h.daService.GetUserIdByVerifiedAddress(r.Context(), address)
+	if err != nil {
+		if errors.Is(err, dataaccess.ErrNotFound) {
+			err = newUnauthorizedErr("no account is linked to this address")
+		}
+		handleErr(w, r, err)
+		return
+	}
+	user, err := h.daService.GetUserCredentialInfo(r.Context(), userId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if !user.EmailConfirmed {
+		handleErr(w, r, newUnauthorizedErr("email not confirmed"))
+		return
+	}
+
+	h.completeFirstFactorLogin(w, r, user)
+}
+
+func (h *HandlerService) InternalGetUserVerifiedAddresses(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	data, err := h.daService.GetUserVerifiedAddresses(r.Context(), user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnOk(w, r, types.InternalGetUserVerifiedAddressesResponse{
+		Data: data,
+	})
+}
+
+// Links the signing address to the user, it can be used to sign in and proves ownership of validators afterwards
+func (h *HandlerService) InternalPostUserVerifiedAddresses(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	var req siweRequest
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	signature := v.checkSiweRequest(req)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	// a linked address can be used to sign in
+	if err := h.checkRecentTwoFactor(ctx, user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	address, err := h.verifySiweMessage(ctx, req.Message, signature)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	added, err := h.daService.AddUserVerifiedAddress(ctx, user.Id, address)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if !added {
+		handleErr(w, r, newConflictErr("address is already linked to another account"))
+		return
+	}
+	returnCreated(w, r, types.ApiDataResponse[types.VerifiedAddress]{
+		Data: types.VerifiedAddress{
+			Address:    types.Hash(address.Hex()),
+			VerifiedAt: time.Now().Unix(),
+		},
+	})
+}
+
+func (h *HandlerService) InternalDeleteUserVerifiedAddress(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	address := v.checkRegex(reEthereumAddress, mux.Vars(r)["address"], "address")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if err := h.checkRecentTwoFactor(r.Context(), user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	err = h.daService.RemoveUserVerifiedAddress(r.Context(), user.Id, common.HexToAddress(address))
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnNoContent(w, r)
+}
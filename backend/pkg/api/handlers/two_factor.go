@@ -0,0 +1,745 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	dataaccess "github.com/gobitfly/beaconchain/pkg/api/data_access"
+	"github.com/gobitfly/beaconchain/pkg/api/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/twofactor"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/gorilla/mux"
+)
+
+const (
+	twoFactorPendingUserIdKey = "two_factor_pending_user_id" // user that passed the password check but still has to provide a second factor
+	twoFactorPendingSinceKey  = "two_factor_pending_since"
+	twoFactorVerifiedAtKey    = "two_factor_verified_at"
+	webAuthnAssertionKey      = "webauthn_assertion_challenge"
+	webAuthnRegistrationKey   = "webauthn_registration_challenge"
+)
+
+const twoFactorLoginExpireTime = time.Minute * 5
+const twoFactorStepUpExpireTime = time.Minute * 15 // how long a verification allows sensitive operations
+const webAuthnTimeout = time.Minute * 5
+const recoveryCodeCount = 10
+
+var errInvalidSecondFactor = newUnauthorizedErr("invalid second factor")
+var errInvalidTotpCode = newBadRequestErr("invalid code")
+
+// request body used to provide a second factor, exactly one of the fields has to be set
+type twoFactorRequest struct {
+	TotpCode     string                                 `json:"totp_code"`
+	RecoveryCode string                                 `json:"recovery_code"`
+	WebAuthn     *twofactor.CredentialAssertionResponse `json:"webauthn"`
+}
+
+func (v *validationError) checkTwoFactorRequest(req twoFactorRequest) {
+	given := 0
+	if req.TotpCode != "" {
+		v.checkRegex(reTotpCode, req.TotpCode, "totp_code")
+		given++
+	}
+	if req.RecoveryCode != "" {
+		v.checkRegex(reRecoveryCode, normalizeRecoveryCode(req.RecoveryCode), "recovery_code")
+		given++
+	}
+	if req.WebAuthn != nil {
+		given++
+	}
+	if given != 1 {
+		v.add("request body", "exactly one of 'totp_code', 'recovery_code' or 'webauthn' must be provided")
+	}
+}
+
+// relying party used for security keys, credentials are bound to the frontend domain
+func getWebAuthnRelyingParty() twofactor.RelyingParty {
+	domain := utils.Config.Frontend.SiteDomain
+	host := domain
+	if h, _, err := net.SplitHostPort(domain); err == nil {
+		host = h
+	}
+	rp := twofactor.RelyingParty{
+		Id:      host,
+		Origins: []string{"https://" + domain},
+	}
+	if utils.Config.Frontend.Debug {
+		rp.Origins = append(rp.Origins, "http://"+domain)
+	}
+	return rp
+}
+
+func newWebAuthnChallenge() ([]byte, error) {
+	challenge := make([]byte, 32)
+	_, err := rand.Read(challenge)
+	return challenge, err
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(code)), "-", "")
+}
+
+// generateRecoveryCodes replaces all recovery codes of the user and returns the new ones, they are only stored hashed
+func (h *HandlerService) generateRecoveryCodes(ctx context.Context, userId uint64) ([]string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		code := utils.RandomString(10)
+		codes = append(codes, code[:5]+"-"+code[5:])
+		hashes = append(hashes, utils.HashAndEncode(code))
+	}
+	err := h.daService.ReplaceUserRecoveryCodes(ctx, userId, hashes)
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// getTwoFactorMethods returns the methods the user can currently use to provide a second factor
+func (h *HandlerService) getTwoFactorMethods(ctx context.Context, userId uint64) ([]string, error) {
+	status, err := h.daService.GetUserTwoFactorStatus(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	methods := []string{}
+	if status.TotpEnabled {
+		methods = append(methods, "totp")
+	}
+	if len(status.WebAuthnCredentials) > 0 {
+		methods = append(methods, "webauthn")
+	}
+	if status.RecoveryCodesLeft > 0 {
+		methods = append(methods, "recovery_code")
+	}
+	return methods, nil
+}
+
+// verifySecondFactor checks the provided factor, used totp codes, recovery codes and webauthn challenges can't be reused
+func (h *HandlerService) verifySecondFactor(ctx context.Context, userId uint64, req twoFactorRequest) error {
+	return h.limitTwoFactorAttempts(ctx, userId, func() error {
+		return h.checkSecondFactor(ctx, userId, req)
+	})
+}
+
+func (h *HandlerService) checkSecondFactor(ctx context.Context, userId uint64, req twoFactorRequest) error {
+	switch {
+	case req.TotpCode != "":
+		totp, err := h.daService.GetUserTotp(ctx, userId)
+		if errors.Is(err, dataaccess.ErrNotFound) {
+			return errInvalidSecondFactor
+		}
+		if err != nil {
+			return err
+		}
+		if !totp.Confirmed {
+			return errInvalidSecondFactor
+		}
+		step, ok := twofactor.ValidateTotp(totp.Secret, req.TotpCode, time.Now(), totp.LastUsedStep)
+		if !ok {
+			return errInvalidSecondFactor
+		}
+		err = h.daService.UpdateUserTotpLastUsedStep(ctx, userId, step)
+		if errors.Is(err, dataaccess.ErrNotFound) {
+			return errInvalidSecondFactor
+		}
+		return err
+	case req.RecoveryCode != "":
+		used, err := h.daService.UseUserRecoveryCode(ctx, userId, utils.HashAndEncode(normalizeRecoveryCode(req.RecoveryCode)))
+		if err != nil {
+			return err
+		}
+		if !used {
+			return errInvalidSecondFactor
+		}
+		return nil
+	case req.WebAuthn != nil:
+		challenge := h.scs.PopBytes(ctx, webAuthnAssertionKey)
+		if len(challenge) == 0 {
+			return newUnauthorizedErr("no pending security key challenge")
+		}
+		credentials, err := h.daService.GetUserWebAuthnCredentials(ctx, userId)
+		if err != nil {
+			return err
+		}
+		credentialId, err := twofactor.DecodeBase64Url(req.WebAuthn.Id)
+		if err != nil {
+			return errInvalidSecondFactor
+		}
+		for _, credential := range credentials {
+			if !bytes.Equal(credential.CredentialId, credentialId) {
+				continue
+			}
+			signCount, err := getWebAuthnRelyingParty().VerifyAssertion(challenge, *req.WebAuthn, twofactor.Credential{
+				Id:        credential.CredentialId,
+				PublicKey: credential.PublicKey,
+				SignCount: credential.SignCount,
+			})
+			if err != nil {
+				return errInvalidSecondFactor
+			}
+			return h.daService.UpdateUserWebAuthnSignCount(ctx, userId, credential.Id, signCount)
+		}
+		return errInvalidSecondFactor
+	default:
+		return errInvalidSecondFactor
+	}
+}
+
+// checkRecentTwoFactor returns an error if the user has a second factor but didn't verify it recently in this session.
+// Must be called before sensitive operations.
+func (h *HandlerService) checkRecentTwoFactor(ctx context.Context, userId uint64) error {
+	hasTwoFactor, err := h.daService.HasUserTwoFactor(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if !hasTwoFactor {
+		return nil
+	}
+	verifiedAt := h.scs.GetTime(ctx, twoFactorVerifiedAtKey)
+	if verifiedAt.IsZero() || time.Since(verifiedAt) > twoFactorStepUpExpireTime {
+		return newForbiddenErr("this action requires a recent verification of your second factor")
+	}
+	return nil
+}
+
+// limitTwoFactorAttempts runs the verification unless the second factor of the user is locked. Each attempt is counted
+// as failed before it is verified, so parallel requests can't try more codes than allowed, and the count is only reset
+// by a successful verification. Attempts are counted per user and not per session, so a new login doesn't grant new
+// attempts.
+func (h *HandlerService) limitTwoFactorAttempts(ctx context.Context, userId uint64, verify func() error) error {
+	lockedUntil, err := h.daService.ReserveUserTwoFactorAttempt(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if !lockedUntil.IsZero() {
+		return newTooManyRequestsErr("too many failed attempts, try again in %s", max(time.Until(lockedUntil), 0).Round(time.Second))
+	}
+	if err := verify(); err != nil {
+		return err
+	}
+	return h.daService.ResetUserTwoFactorFailures(ctx, userId)
+}
+
+// getPendingLoginUserId returns the user that passed the password check of the current login attempt
+func (h *HandlerService) getPendingLoginUserId(ctx context.Context) (uint64, error) {
+	userId, ok := h.scs.Get(ctx, twoFactorPendingUserIdKey).(uint64)
+	if !ok {
+		return 0, newUnauthorizedErr("no pending login")
+	}
+	if time.Since(h.scs.GetTime(ctx, twoFactorPendingSinceKey)) > twoFactorLoginExpireTime {
+		h.clearPendingLogin(ctx)
+		return 0, newUnauthorizedErr("login expired")
+	}
+	return userId, nil
+}
+
+func (h *HandlerService) clearPendingLogin(ctx context.Context) {
+	h.scs.Remove(ctx, twoFactorPendingUserIdKey)
+	h.scs.Remove(ctx, twoFactorPendingSinceKey)
+	h.scs.Remove(ctx, webAuthnAssertionKey)
+}
+
+// completes a login after all factors have been verified
+func (h *HandlerService) startUserSession(ctx context.Context, user *types.UserCredentialInfo) error {
+	// change privileges
+	err := h.scs.RenewToken(ctx)
+	if err != nil {
+		return errors.New("error creating session")
+	}
+	h.clearPendingLogin(ctx)
+
+	h.scs.Put(ctx, authenticatedKey, true)
+	h.scs.Put(ctx, userIdKey, user.Id)
+	h.scs.Put(ctx, subscriptionKey, user.ProductId)
+	h.scs.Put(ctx, userGroupKey, user.UserGroup)
+	return nil
+}
+
+func (h *HandlerService) getWebAuthnAssertionOptions(ctx context.Context, userId uint64) (*types.WebAuthnRequestOptions, error) {
+	credentials, err := h.daService.GetUserWebAuthnCredentials(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if len(credentials) == 0 {
+		return nil, newConflictErr("no security key registered")
+	}
+	challenge, err := newWebAuthnChallenge()
+	if err != nil {
+		return nil, err
+	}
+	h.scs.Put(ctx, webAuthnAssertionKey, challenge)
+
+	options := &types.WebAuthnRequestOptions{
+		Challenge:        twofactor.EncodeBase64Url(challenge),
+		Timeout:          uint64(webAuthnTimeout.Milliseconds()),
+		RpId:             getWebAuthnRelyingParty().Id,
+		UserVerification: "discouraged",
+	}
+	for _, credential := range credentials {
+		options.AllowCredentials = append(options.AllowCredentials, types.WebAuthnCredentialDescriptor{
+			Type: "public-key",
+			Id:   twofactor.EncodeBase64Url(credential.CredentialId),
+		})
+	}
+	return options, nil
+}
+
+// ------------------------------------------------------------
+// Login
+
+// Completes a login of a user with a second factor, requires a preceding successful password check
+func (h *HandlerService) InternalPostLoginTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	var req twoFactorRequest
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	v.checkTwoFactorRequest(req)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	ctx := r.Context()
+	userId, err := h.getPendingLoginUserId(ctx)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	err = h.verifySecondFactor(ctx, userId, req)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	user, err := h.daService.GetUserCredentialInfo(ctx, userId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	err = h.startUserSession(ctx, user)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	h.scs.Put(ctx, twoFactorVerifiedAtKey, time.Now())
+
+	returnOk(w, r, types.InternalPostLoginResponse{
+		Data: types.LoginResult{},
+	})
+}
+
+// Returns the options to sign in with a security key, requires a preceding successful password check
+func (h *HandlerService) InternalPostLoginTwoFactorWebAuthnChallenges(w http.ResponseWriter, r *http.Request) {
+	userId, err := h.getPendingLoginUserId(r.Context())
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	options, err := h.getWebAuthnAssertionOptions(r.Context(), userId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnOk(w, r, types.InternalPostWebAuthnAssertionOptionsResponse{
+		Data: *options,
+	})
+}
+
+// ------------------------------------------------------------
+// Verification of the second factor before sensitive operations
+
+func (h *HandlerService) InternalPostUserTwoFactorVerifications(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	var req twoFactorRequest
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	v.checkTwoFactorRequest(req)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	err = h.verifySecondFactor(ctx, user.Id, req)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	h.scs.Put(ctx, twoFactorVerifiedAtKey, time.Now())
+	returnNoContent(w, r)
+}
+
+func (h *HandlerService) InternalPostUserTwoFactorWebAuthnChallenges(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	options, err := h.getWebAuthnAssertionOptions(r.Context(), user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnOk(w, r, types.InternalPostWebAuthnAssertionOptionsResponse{
+		Data: *options,
+	})
+}
+
+// ------------------------------------------------------------
+// Management
+
+func (h *HandlerService) InternalGetUserTwoFactor(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	data, err := h.daService.GetUserTwoFactorStatus(r.Context(), user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnOk(w, r, types.InternalGetUserTwoFactorResponse{
+		Data: *data,
+	})
+}
+
+// Starts the totp enrollment, the secret has to be confirmed with a code before it is enforced
+func (h *HandlerService) InternalPostUserTotp(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	if err := h.checkRecentTwoFactor(ctx, user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	totp, err := h.daService.GetUserTotp(ctx, user.Id)
+	if err != nil && !errors.Is(err, dataaccess.ErrNotFound) {
+		handleErr(w, r, err)
+		return
+	}
+	if totp != nil && totp.Confirmed {
+		handleErr(w, r, newConflictErr("totp is already enabled"))
+		return
+	}
+	userInfo, err := h.daService.GetUserCredentialInfo(ctx, user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	secret, err := twofactor.NewTotpSecret()
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	err = h.daService.SetUserTotpSecret(ctx, user.Id, secret)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnOk(w, r, types.InternalPostUserTotpResponse{
+		Data: types.TotpEnrollment{
+			Secret: secret,
+			Uri:    twofactor.TotpUri(utils.Config.Frontend.SiteDomain, userInfo.Email, secret),
+		},
+	})
+}
+
+// Enables totp, returns recovery codes if it is the first factor of the user
+func (h *HandlerService) InternalPostUserTotpConfirmations(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	req := struct {
+		Code string `json:"code"`
+	}{}
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	code := v.checkRegex(reTotpCode, req.Code, "code")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	if err := h.checkRecentTwoFactor(ctx, user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	totp, err := h.daService.GetUserTotp(ctx, user.Id)
+	if errors.Is(err, dataaccess.ErrNotFound) || (err == nil && totp.Confirmed) {
+		handleErr(w, r, newConflictErr("no pending totp enrollment"))
+		return
+	}
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	var step int64
+	err = h.limitTwoFactorAttempts(ctx, user.Id, func() error {
+		var ok bool
+		step, ok = twofactor.ValidateTotp(totp.Secret, code, time.Now(), totp.LastUsedStep)
+		if !ok {
+			return errInvalidTotpCode
+		}
+		return nil
+	})
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+
+	hadTwoFactor, err := h.daService.HasUserTwoFactor(ctx, user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	err = h.daService.ConfirmUserTotp(ctx, user.Id, step)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	h.scs.Put(ctx, twoFactorVerifiedAtKey, time.Now())
+
+	response := types.InternalPostUserRecoveryCodesResponse{}
+	if !hadTwoFactor {
+		response.Data.RecoveryCodes, err = h.generateRecoveryCodes(ctx, user.Id)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+	}
+	returnOk(w, r, response)
+}
+
+func (h *HandlerService) InternalDeleteUserTotp(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if err := h.checkRecentTwoFactor(r.Context(), user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	err = h.daService.RemoveUserTotp(r.Context(), user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnNoContent(w, r)
+}
+
+// Returns the options to register a new security key
+func (h *HandlerService) InternalPostUserWebAuthnRegistrationOptions(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	if err := h.checkRecentTwoFactor(ctx, user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	userInfo, err := h.daService.GetUserCredentialInfo(ctx, user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	credentials, err := h.daService.GetUserWebAuthnCredentials(ctx, user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	challenge, err := newWebAuthnChallenge()
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	h.scs.Put(ctx, webAuthnRegistrationKey, challenge)
+
+	rp := getWebAuthnRelyingParty()
+	userHandle := binary.BigEndian.AppendUint64(nil, user.Id)
+	options := types.WebAuthnCreationOptions{
+		Challenge: twofactor.EncodeBase64Url(challenge),
+		Rp: types.WebAuthnRelyingParty{
+			Id:   rp.Id,
+			Name: utils.Config.Frontend.SiteDomain,
+		},
+		User: types.WebAuthnUser{
+			Id:          twofactor.EncodeBase64Url(userHandle),
+			Name:        userInfo.Email,
+			DisplayName: userInfo.Email,
+		},
+		Timeout:            uint64(webAuthnTimeout.Milliseconds()),
+		ExcludeCredentials: []types.WebAuthnCredentialDescriptor{},
+		Attestation:        "none",
+		AuthenticatorSelection: types.WebAuthnAuthenticatorSelection{
+			UserVerification: "discouraged",
+		},
+	}
+	for _, alg := range twofactor.SupportedCoseAlgorithms {
+		options.PubKeyCredParams = append(options.PubKeyCredParams, types.WebAuthnCredentialParameter{Type: "public-key", Alg: alg})
+	}
+	for _, credential := range credentials {
+		options.ExcludeCredentials = append(options.ExcludeCredentials, types.WebAuthnCredentialDescriptor{
+			Type: "public-key",
+			Id:   twofactor.EncodeBase64Url(credential.CredentialId),
+		})
+	}
+	returnOk(w, r, types.InternalPostUserWebAuthnRegistrationOptionsResponse{
+		Data: options,
+	})
+}
+
+// Registers a security key, returns recovery codes if it is the first factor of the user
+func (h *HandlerService) InternalPostUserWebAuthnCredentials(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	req := struct {
+		Name       string                               `json:"name"`
+		Credential twofactor.CredentialCreationResponse `json:"credential"`
+	}{}
+	if err := v.checkBody(&req, r); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	name := v.checkNameNotEmpty(req.Name)
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	if err := h.checkRecentTwoFactor(ctx, user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	challenge := h.scs.PopBytes(ctx, webAuthnRegistrationKey)
+	if len(challenge) == 0 {
+		handleErr(w, r, newConflictErr("no pending security key registration"))
+		return
+	}
+	credential, err := getWebAuthnRelyingParty().VerifyRegistration(challenge, req.Credential)
+	if err != nil {
+		handleErr(w, r, newBadRequestErr("invalid credential: %v", err))
+		return
+	}
+
+	credentials, err := h.daService.GetUserWebAuthnCredentials(ctx, user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	for _, existing := range credentials {
+		if bytes.Equal(existing.CredentialId, credential.Id) {
+			handleErr(w, r, newConflictErr("security key is already registered"))
+			return
+		}
+	}
+	hadTwoFactor, err := h.daService.HasUserTwoFactor(ctx, user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	err = h.daService.AddUserWebAuthnCredential(ctx, user.Id, name, types.WebAuthnCredential{
+		CredentialId: credential.Id,
+		PublicKey:    credential.PublicKey,
+		SignCount:    credential.SignCount,
+	})
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	h.scs.Put(ctx, twoFactorVerifiedAtKey, time.Now())
+
+	response := types.InternalPostUserRecoveryCodesResponse{}
+	if !hadTwoFactor {
+		response.Data.RecoveryCodes, err = h.generateRecoveryCodes(ctx, user.Id)
+		if err != nil {
+			handleErr(w, r, err)
+			return
+		}
+	}
+	returnCreated(w, r, response)
+}
+
+func (h *HandlerService) InternalDeleteUserWebAuthnCredential(w http.ResponseWriter, r *http.Request) {
+	var v validationError
+	credentialId := v.checkUint(mux.Vars(r)["credential_id"], "credential_id")
+	if v.hasErrors() {
+		handleErr(w, r, v)
+		return
+	}
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if err := h.checkRecentTwoFactor(r.Context(), user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	err = h.daService.RemoveUserWebAuthnCredential(r.Context(), user.Id, credentialId)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnNoContent(w, r)
+}
+
+// Invalidates all recovery codes and returns new ones
+func (h *HandlerService) InternalPostUserRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserBySession(r)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	hasTwoFactor, err := h.daService.HasUserTwoFactor(ctx, user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	if !hasTwoFactor {
+		handleErr(w, r, newConflictErr("two factor authentication is not enabled"))
+		return
+	}
+	if err := h.checkRecentTwoFactor(ctx, user.Id); err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	codes, err := h.generateRecoveryCodes(ctx, user.Id)
+	if err != nil {
+		handleErr(w, r, err)
+		return
+	}
+	returnOk(w, r, types.InternalPostUserRecoveryCodesResponse{
+		Data: types.RecoveryCodes{RecoveryCodes: codes},
+	})
+}
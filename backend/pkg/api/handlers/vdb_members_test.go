@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	dataaccess "github.com/gobitfly/beaconchain/pkg/api/data_access"
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
+	types "github.com/gobitfly/beaconchain/pkg/api/types"
+)
+
+const (
+	testOwnerId  = uint64(1)
+	testAdminId  = uint64(2)
+	testEditorId = uint64(3)
+	testViewerId = uint64(4)
+	testOtherId  = uint64(5) // registered but not a member of the dashboard
+)
+
+// vdbMembersDataAccessor serves the members of dashboard 1
+type vdbMembersDataAccessor struct {
+	dataaccess.DataAccessor
+	requireTwoFactor bool
+	twoFactorUsers   map[uint64]bool
+	invites          []string
+}
+
+func (d *vdbMembersDataAccessor) GetValidatorDashboardRole(ctx context.Context, dashboardId types.VDBIdPrimary, userId uint64) (enums.VDBMemberRole, error) {
+	roles := map[uint64]enums.VDBMemberRole{
+		testOwnerId:  enums.VDBMemberRoles.Owner,
+		testAdminId:  enums.VDBMemberRoles.Admin,
+		testEditorId: enums.VDBMemberRoles.Editor,
+		testViewerId: enums.VDBMemberRoles.Viewer,
+	}
+	role, ok := roles[userId]
+	if dashboardId != 1 || !ok {
+		return 0, fmt.Errorf("%w: user %v is not a member of dashboard %v", dataaccess.ErrNotFound, userId, dashboardId)
+	}
+	return role, nil
+}
+
+func (d *vdbMembersDataAccessor) GetValidatorDashboardTwoFactorRequired(ctx context.Context, dashboardId types.VDBIdPrimary) (bool, error) {
+	return d.requireTwoFactor, nil
+}
+
+func (d *vdbMembersDataAccessor) HasUserTwoFactor(ctx context.Context, userId uint64) (bool, error) {
+	return d.twoFactorUsers[userId], nil
+}
+
+func (d *vdbMembersDataAccessor) GetUserByEmail(ctx context.Context, email string) (uint64, error) {
+	userIds := map[string]uint64{
+		"owner@example.com":  testOwnerId,
+		"admin@example.com":  testAdminId,
+		"editor@example.com": testEditorId,
+		"viewer@example.com": testViewerId,
+		"other@example.com":  testOtherId,
+	}
+	userId, ok := userIds[email]
+	if !ok {
+		return 0, fmt.Errorf("%w: user not found", dataaccess.ErrNotFound)
+	}
+	return userId, nil
+}
+
+func (d *vdbMembersDataAccessor) CreateValidatorDashboardInvite(ctx context.Context, dashboardId types.VDBIdPrimary, invitedBy uint64, email string, role enums.VDBMemberRole, token string) error {
+	d.invites = append(d.invites, email)
+	return nil
+}
+
+// newDashboardRequest returns a request of the given user to dashboard 1, the role is stored like the VDB auth middlewares do
+func newDashboardRequest(method, body string, userId uint64, role enums.VDBMemberRole) *http.Request {
+	r := httptest.NewRequest(method, "/api/v2/validator-dashboards/1", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	r = mux.SetURLVars(r, map[string]string{"dashboard_id": "1"})
+	ctx := context.WithValue(r.Context(), types.CtxUserIdKey, userId)
+	ctx = context.WithValue(ctx, types.CtxDashboardRoleKey, role)
+	return r.WithContext(ctx)
+}
+
+func TestCheckRoleGrant(t *testing.T) {
+	roles := enums.VDBMemberRoles
+	tests := []struct {
+		role    enums.VDBMemberRole
+		grant   enums.VDBMemberRole
+		allowed bool
+	}{
+		{roles.Owner, roles.Admin, true},
+		{roles.Owner, roles.Viewer, true},
+		{roles.Admin, roles.Admin, false},
+		{roles.Admin, roles.Editor, true},
+		{roles.Admin, roles.Viewer, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s grants %s", tt.role.ToString(), tt.grant.ToString()), func(t *testing.T) {
+			err := checkRoleGrant(newDashboardRequest(http.MethodPut, "", testOwnerId, tt.role), tt.grant)
+			if tt.allowed && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !tt.allowed && !errors.Is(err, errForbidden) {
+				t.Errorf("expected a forbidden error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckMemberManagement(t *testing.T) {
+	h := &HandlerService{daService: &vdbMembersDataAccessor{}}
+	roles := enums.VDBMemberRoles
+	tests := []struct {
+		name     string
+		role     enums.VDBMemberRole
+		memberId uint64
+		expected error
+	}{
+		{"owner manages an admin", roles.Owner, testAdminId, nil},
+		{"admin manages an editor", roles.Admin, testEditorId, nil},
+		{"admin manages a viewer", roles.Admin, testViewerId, nil},
+		{"admin manages another admin", roles.Admin, testAdminId, errForbidden},
+		{"admin manages the owner", roles.Admin, testOwnerId, errForbidden},
+		{"editor manages a viewer", roles.Editor, testViewerId, errForbidden},
+		{"owner manages a non member", roles.Owner, testOtherId, dataaccess.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newDashboardRequest(http.MethodPut, "", testOwnerId, tt.role)
+			err := h.checkMemberManagement(r, 1, tt.memberId)
+			if tt.expected == nil && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.expected != nil && !errors.Is(err, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestVDBRoleMiddleware(t *testing.T) {
+	da := &vdbMembersDataAccessor{}
+	h := &HandlerService{daService: da}
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	adminEndpoint := h.VDBAuthMiddleware(h.VDBRoleMiddleware(enums.VDBMemberRoles.Admin)(ok))
+	ownerEndpoint := h.VDBAuthMiddleware(h.VDBRoleMiddleware(enums.VDBMemberRoles.Owner)(ok))
+	editEndpoint := h.VDBAuthMiddleware(ok)
+
+	tests := []struct {
+		name             string
+		endpoint         http.Handler
+		method           string
+		userId           uint64
+		requireTwoFactor bool
+		twoFactor        bool
+		status           int
+	}{
+		{"admin on admin endpoint", adminEndpoint, http.MethodPost, testAdminId, false, false, http.StatusNoContent},
+		{"editor on admin endpoint", adminEndpoint, http.MethodPost, testEditorId, false, false, http.StatusForbidden},
+		{"admin on owner endpoint", ownerEndpoint, http.MethodPut, testAdminId, false, false, http.StatusForbidden},
+		{"owner on owner endpoint", ownerEndpoint, http.MethodPut, testOwnerId, false, false, http.StatusNoContent},
+		{"viewer reads", editEndpoint, http.MethodGet, testViewerId, false, false, http.StatusNoContent},
+		{"viewer edits", editEndpoint, http.MethodPost, testViewerId, false, false, http.StatusForbidden},
+		{"editor edits", editEndpoint, http.MethodPost, testEditorId, false, false, http.StatusNoContent},
+		{"non member", editEndpoint, http.MethodGet, testOtherId, false, false, http.StatusNotFound},
+		{"member without required two factor", editEndpoint, http.MethodGet, testViewerId, true, false, http.StatusForbidden},
+		{"member with required two factor", editEndpoint, http.MethodGet, testViewerId, true, true, http.StatusNoContent},
+		{"owner without required two factor", ownerEndpoint, http.MethodPut, testOwnerId, true, false, http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			da.requireTwoFactor = tt.requireTwoFactor
+			da.twoFactorUsers = map[uint64]bool{tt.userId: tt.twoFactor}
+			r := httptest.NewRequest(tt.method, "/api/v2/validator-dashboards/1", nil)
+			r = mux.SetURLVars(r, map[string]string{"dashboard_id": "1"})
+			r = r.WithContext(context.WithValue(r.Context(), types.CtxUserIdKey, tt.userId))
+			w := httptest.NewRecorder()
+			tt.endpoint.ServeHTTP(w, r)
+			if w.Code != tt.status {
+				t.Errorf("expected status %d, got %d: %s", tt.status, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestPublicPostValidatorDashboardInvites(t *testing.T) {
+	da := &vdbMembersDataAccessor{}
+	h := &HandlerService{daService: da}
+	roles := enums.VDBMemberRoles
+	tests := []struct {
+		name   string
+		role   enums.VDBMemberRole
+		body   string
+		status int
+	}{
+		// re-inviting members would change their role without the checks of the member endpoints
+		{"owner re-invites an editor as admin", roles.Owner, `{"email":"editor@example.com","role":"admin"}`, http.StatusConflict},
+		{"admin re-invites an admin as viewer", roles.Admin, `{"email":"Admin@example.com","role":"viewer"}`, http.StatusConflict},
+		{"admin invites the owner", roles.Admin, `{"email":"owner@example.com","role":"viewer"}`, http.StatusConflict},
+		{"admin invites an admin", roles.Admin, `{"email":"other@example.com","role":"admin"}`, http.StatusForbidden},
+		{"editor invites a viewer", roles.Editor, `{"email":"other@example.com","role":"viewer"}`, http.StatusForbidden},
+		{"owner shares ownership", roles.Owner, `{"email":"other@example.com","role":"owner"}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.PublicPostValidatorDashboardInvites(w, newDashboardRequest(http.MethodPost, tt.body, testOwnerId, tt.role))
+			if w.Code != tt.status {
+				t.Errorf("expected status %d, got %d: %s", tt.status, w.Code, w.Body.String())
+			}
+		})
+	}
+	if len(da.invites) != 0 {
+		t.Errorf("expected no invites to be created, got %v", da.invites)
+	}
+
+	for email, isMember := range map[string]bool{"viewer@example.com": true, "other@example.com": false, "new@example.com": false} {
+		err := h.checkNotMember(context.Background(), 1, email)
+		if isMember && !errors.Is(err, errConflict) {
+			t.Errorf("%s: expected a conflict, got %v", email, err)
+		}
+		if !isMember && err != nil {
+			t.Errorf("%s: unexpected error: %v", email, err)
+		}
+	}
+}
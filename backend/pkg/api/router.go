@@ -6,6 +6,7 @@ import (
 
 	dataaccess "github.com/gobitfly/beaconchain/pkg/api/data_access"
 	"github.com/gobitfly/beaconchain/pkg/api/docs"
+	"github.com/gobitfly/beaconchain/pkg/api/enums"
 	handlers "github.com/gobitfly/beaconchain/pkg/api/handlers"
 	"github.com/gobitfly/beaconchain/pkg/commons/log"
 	"github.com/gobitfly/beaconchain/pkg/commons/metrics"
@@ -44,6 +45,11 @@ func NewApiRouter(dataAccessor dataaccess.DataAccessor, dummy dataaccess.DataAcc
 		internalRouter.Use(handlerService.StoreIsMockedFlagMiddleware)
 	}
 
+	// oauth client endpoints are called by third party apps, so neither sessions nor csrf protection apply
+	oauthRouter := apiRouter.PathPrefix("/oauth").Subrouter()
+	oauthRouter.HandleFunc("/token", handlerService.InternalPostOauthToken).Methods(http.MethodPost, http.MethodOptions)
+	oauthRouter.HandleFunc("/revoke", handlerService.InternalPostOauthRevoke).Methods(http.MethodPost, http.MethodOptions)
+
 	addRoutes(handlerService, publicRouter, internalRouter, cfg)
 	addLegacyRoutes(handlerService, legacyRouter)
 
@@ -101,6 +107,10 @@ func addRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Ro
 		{http.MethodGet, "/ratelimit-weights", nil, hs.InternalGetRatelimitWeights},
 
 		{http.MethodPost, "/login", nil, hs.InternalPostLogin},
+		{http.MethodPost, "/login/two-factor", nil, hs.InternalPostLoginTwoFactor},
+		{http.MethodPost, "/login/two-factor/webauthn-challenges", nil, hs.InternalPostLoginTwoFactorWebAuthnChallenges},
+		{http.MethodPost, "/login/siwe", nil, hs.InternalPostLoginSiwe},
+		{http.MethodPost, "/siwe/nonces", nil, hs.InternalPostSiweNonces},
 
 		{http.MethodGet, "/mobile/authorize", nil, hs.InternalPostMobileAuthorize},
 		{http.MethodPost, "/mobile/equivalent-exchange", nil, hs.InternalPostMobileEquivalentExchange},
@@ -127,7 +137,25 @@ func addRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Ro
 		{http.MethodDelete, "/users/me", nil, hs.InternalDeleteUser},
 		{http.MethodPost, "/users/me/email", nil, hs.InternalPostUserEmail},
 		{http.MethodPut, "/users/me/password", nil, hs.InternalPutUserPassword},
-		{http.MethodGet, "/users/me/dashboards", hs.PublicGetUserDashboards, hs.InternalGetUserDashboards},
+		{http.MethodPost, "/users/me/api-keys", nil, hs.InternalPostApiKeys},
+		{http.MethodGet, "/users/me/two-factor", nil, hs.InternalGetUserTwoFactor},
+		{http.MethodPost, "/users/me/two-factor/verifications", nil, hs.InternalPostUserTwoFactorVerifications},
+		{http.MethodPost, "/users/me/two-factor/webauthn-challenges", nil, hs.InternalPostUserTwoFactorWebAuthnChallenges},
+		{http.MethodPost, "/users/me/two-factor/totp", nil, hs.InternalPostUserTotp},
+		{http.MethodPost, "/users/me/two-factor/totp/confirmations", nil, hs.InternalPostUserTotpConfirmations},
+		{http.MethodDelete, "/users/me/two-factor/totp", nil, hs.InternalDeleteUserTotp},
+		{http.MethodPost, "/users/me/two-factor/webauthn/registration-options", nil, hs.InternalPostUserWebAuthnRegistrationOptions},
+		{http.MethodPost, "/users/me/two-factor/webauthn/credentials", nil, hs.InternalPostUserWebAuthnCredentials},
+		{http.MethodDelete, "/users/me/two-factor/webauthn/credentials/{credential_id}", nil, hs.InternalDeleteUserWebAuthnCredential},
+		{http.MethodPost, "/users/me/two-factor/recovery-codes", nil, hs.InternalPostUserRecoveryCodes},
+		{http.MethodGet, "/users/me/verified-addresses", nil, hs.InternalGetUserVerifiedAddresses},
+		{http.MethodPost, "/users/me/verified-addresses", nil, hs.InternalPostUserVerifiedAddresses},
+		{http.MethodDelete, "/users/me/verified-addresses/{address}", nil, hs.InternalDeleteUserVerifiedAddress},
+		{http.MethodPost, "/users/me/dashboard-invites/{token}", nil, hs.InternalPostUserDashboardInvite},
+		{http.MethodGet, "/users/me/oauth-apps", nil, hs.InternalGetUserOauthApps},
+		{http.MethodDelete, "/users/me/oauth-apps/{app_id}", nil, hs.InternalDeleteUserOauthApp},
+		{http.MethodGet, "/oauth/authorize", nil, hs.InternalGetOauthAuthorize},
+		{http.MethodPost, "/oauth/authorize", nil, hs.InternalPostOauthAuthorize},
 		{http.MethodPut, "/users/me/notifications/settings/paired-devices/{client_id}/token", nil, hs.InternalPostUsersMeNotificationSettingsPairedDevicesToken},
 
 		{http.MethodGet, "/users/me/machine-metrics", hs.PublicGetUserMachineMetrics, hs.InternalGetUserMachineMetrics},
@@ -200,6 +228,13 @@ func addRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Ro
 		{http.MethodGet, "/networks/{network}/blocks/{block}/withdrawals", hs.PublicGetNetworkBlockWithdrawals, hs.InternalGetBlockWithdrawals},
 		{http.MethodGet, "/networks/{network}/validators/{validator}/withdrawals", hs.PublicGetNetworkValidatorWithdrawals, nil},
 		{http.MethodGet, "/networks/{network}/withdrawal-credentials/{credential}/withdrawals", hs.PublicGetNetworkWithdrawalCredentialWithdrawals, nil},
+		{http.MethodGet, "/networks/{network}/withdrawal-requests", hs.PublicGetNetworkWithdrawalRequests, nil},
+		{http.MethodGet, "/networks/{network}/consolidations", hs.PublicGetNetworkConsolidations, nil},
+		{http.MethodGet, "/networks/{network}/pending-deposits", hs.PublicGetNetworkPendingDeposits, nil},
+		{http.MethodGet, "/networks/{network}/pending-partial-withdrawals", hs.PublicGetNetworkPendingPartialWithdrawals, nil},
+		{http.MethodGet, "/networks/{network}/pending-consolidations", hs.PublicGetNetworkPendingConsolidations, nil},
+		{http.MethodGet, "/networks/{network}/relays", hs.PublicGetNetworkRelays, nil},
+		{http.MethodGet, "/networks/{network}/builders", hs.PublicGetNetworkBuilders, nil},
 
 		{http.MethodGet, "/networks/{network}/voluntary-exits", hs.PublicGetNetworkVoluntaryExits, nil},
 		{http.MethodGet, "/networks/{network}/epochs/{epoch}/voluntary-exits", hs.PublicGetNetworkEpochVoluntaryExits, nil},
@@ -225,12 +260,14 @@ func addRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Ro
 
 		{http.MethodGet, "/networks/ethereum/addresses/{address}/ens", hs.PublicGetNetworkAddressEns, nil},
 		{http.MethodGet, "/networks/ethereum/ens/{ens_name}", hs.PublicGetNetworkEns, nil},
+		{http.MethodPost, "/networks/ethereum/ens/batch-resolution", hs.PublicPostNetworkEnsBatchResolution, nil},
 
 		{http.MethodGet, "/networks/{layer_2_network}/batches", hs.PublicGetNetworkBatches, nil},
 		{http.MethodGet, "/networks/{layer_2_network}/layer1-to-layer2-transactions", hs.PublicGetNetworkLayer1ToLayer2Transactions, nil},
 		{http.MethodGet, "/networks/{layer_2_network}/layer2-to-layer1-transactions", hs.PublicGetNetworkLayer2ToLayer1Transactions, nil},
 
 		{http.MethodPost, "/networks/{network}/broadcasts", hs.PublicPostNetworkBroadcasts, nil},
+		{http.MethodGet, "/networks/{network}/broadcasts/{broadcast_id}", hs.PublicGetNetworkBroadcast, nil},
 		{http.MethodGet, "/eth-price-history", hs.PublicGetEthPriceHistory, nil},
 
 		{http.MethodGet, "/networks/{network}/gasnow", hs.PublicGetNetworkGasNow, nil},
@@ -240,8 +277,10 @@ func addRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Ro
 		{http.MethodGet, "/rocket-pool", hs.PublicGetRocketPool, hs.InternalGetRocketPool},
 		{http.MethodGet, "/rocket-pool/nodes", hs.PublicGetRocketPoolNodes, nil},
 		{http.MethodGet, "/rocket-pool/minipools", hs.PublicGetRocketPoolMinipools, nil},
+		{http.MethodGet, "/staking-protocols/{protocol}", hs.PublicGetStakingProtocol, nil},
 
-		{http.MethodGet, "/networks/{network}/sync-committee/{period}", hs.PublicGetNetworkSyncCommittee, nil},
+		{http.MethodGet, "/networks/{network}/sync-committee/{period}", hs.PublicGetNetworkSyncCommittee, hs.InternalGetNetworkSyncCommittee},
+		{http.MethodGet, "/networks/{network}/sync-committee/{period}/slots", hs.PublicGetNetworkSyncCommitteeSlots, hs.InternalGetNetworkSyncCommitteeSlots},
 
 		{http.MethodGet, "/multisig-safes/{address}", hs.PublicGetMultisigSafe, nil},
 		{http.MethodGet, "/multisig-safes/{address}/transactions", hs.PublicGetMultisigSafeTransactions, nil},
@@ -256,9 +295,36 @@ func addLegacyRoutes(hs *handlers.HandlerService, publicRouter *mux.Router) {
 }
 
 func addValidatorDashboardRoutes(hs *handlers.HandlerService, publicRouter, internalRouter *mux.Router, cfg *types.Config) {
+	// all dashboard endpoints are covered by the dashboard oauth scopes
+	publicRouter = publicRouter.NewRoute().Subrouter()
+	internalRouter = internalRouter.NewRoute().Subrouter()
+	publicRouter.Use(hs.DashboardsOAuthScopeMiddleware)
+	internalRouter.Use(hs.DashboardsOAuthScopeMiddleware)
+
 	vdbPath := "/validator-dashboards"
 	publicRouter.HandleFunc(vdbPath, hs.PublicPostValidatorDashboards).Methods(http.MethodPost, http.MethodOptions)
 	internalRouter.HandleFunc(vdbPath, hs.InternalPostValidatorDashboards).Methods(http.MethodPost, http.MethodOptions)
+	publicRouter.HandleFunc("/users/me/dashboards", hs.PublicGetUserDashboards).Methods(http.MethodGet, http.MethodOptions)
+	internalRouter.HandleFunc("/users/me/dashboards", hs.InternalGetUserDashboards).Methods(http.MethodGet, http.MethodOptions)
+
+	// membership endpoints are available to all members, the handlers check the required role themselves
+	publicMemberRouter := publicRouter.PathPrefix(vdbPath).Subrouter()
+	internalMemberRouter := internalRouter.PathPrefix(vdbPath).Subrouter()
+	if !cfg.Frontend.Debug {
+		publicMemberRouter.Use(hs.VDBMemberAuthMiddleware, hs.ManageDashboardsViaApiCheckMiddleware)
+		internalMemberRouter.Use(hs.VDBMemberAuthMiddleware)
+	}
+
+	memberEndpoints := []endpoint{
+		{http.MethodGet, "/{dashboard_id}/members", hs.PublicGetValidatorDashboardMembers, hs.InternalGetValidatorDashboardMembers},
+		{http.MethodPut, "/{dashboard_id}/members/{user_id}", hs.PublicPutValidatorDashboardMember, hs.InternalPutValidatorDashboardMember},
+		{http.MethodDelete, "/{dashboard_id}/members/{user_id}", hs.PublicDeleteValidatorDashboardMember, hs.InternalDeleteValidatorDashboardMember},
+		{http.MethodPost, "/{dashboard_id}/invites", hs.PublicPostValidatorDashboardInvites, hs.InternalPostValidatorDashboardInvites},
+		{http.MethodDelete, "/{dashboard_id}/invites/{email}", hs.PublicDeleteValidatorDashboardInvite, hs.InternalDeleteValidatorDashboardInvite},
+		{http.MethodGet, "/{dashboard_id}/audit-log", hs.PublicGetValidatorDashboardAuditLog, hs.InternalGetValidatorDashboardAuditLog},
+		{http.MethodPut, "/{dashboard_id}/two-factor-requirement", hs.PublicPutValidatorDashboardTwoFactorRequirement, hs.InternalPutValidatorDashboardTwoFactorRequirement},
+	}
+	addEndpointsToRouters(memberEndpoints, publicMemberRouter, internalMemberRouter)
 
 	publicDashboardRouter := publicRouter.PathPrefix(vdbPath).Subrouter()
 	internalDashboardRouter := internalRouter.PathPrefix(vdbPath).Subrouter()
@@ -274,7 +340,14 @@ func addValidatorDashboardRoutes(hs *handlers.HandlerService, publicRouter, inte
 		{http.MethodPut, "/{dashboard_id}/archiving", hs.PublicPutValidatorDashboardArchiving, hs.InternalPutValidatorDashboardArchiving},
 	}
 
-	addEndpointsToRouters(archivalEndpoints, publicDashboardRouter, internalDashboardRouter)
+	// only the owner can delete or archive a dashboard
+	publicOwnerRouter := publicDashboardRouter.NewRoute().Subrouter()
+	internalOwnerRouter := internalDashboardRouter.NewRoute().Subrouter()
+	if !cfg.Frontend.Debug {
+		publicOwnerRouter.Use(hs.VDBRoleMiddleware(enums.VDBMemberRoles.Owner))
+		internalOwnerRouter.Use(hs.VDBRoleMiddleware(enums.VDBMemberRoles.Owner))
+	}
+	addEndpointsToRouters(archivalEndpoints, publicOwnerRouter, internalOwnerRouter)
 
 	// create new subrouters for archived check middleware, will be used for all endpoints added after this
 	if !cfg.Frontend.Debug {
@@ -284,6 +357,20 @@ func addValidatorDashboardRoutes(hs *handlers.HandlerService, publicRouter, inte
 		internalDashboardRouter.Use(hs.VDBArchivedCheckMiddleware)
 	}
 
+	// public ids expose the dashboard, so only admins and the owner can share it
+	sharingEndpoints := []endpoint{
+		{http.MethodPost, "/{dashboard_id}/public-ids", hs.PublicPostValidatorDashboardPublicIds, hs.InternalPostValidatorDashboardPublicIds},
+		{http.MethodPut, "/{dashboard_id}/public-ids/{public_id}", hs.PublicPutValidatorDashboardPublicId, hs.InternalPutValidatorDashboardPublicId},
+		{http.MethodDelete, "/{dashboard_id}/public-ids/{public_id}", hs.PublicDeleteValidatorDashboardPublicId, hs.InternalDeleteValidatorDashboardPublicId},
+	}
+	publicAdminRouter := publicDashboardRouter.NewRoute().Subrouter()
+	internalAdminRouter := internalDashboardRouter.NewRoute().Subrouter()
+	if !cfg.Frontend.Debug {
+		publicAdminRouter.Use(hs.VDBRoleMiddleware(enums.VDBMemberRoles.Admin))
+		internalAdminRouter.Use(hs.VDBRoleMiddleware(enums.VDBMemberRoles.Admin))
+	}
+	addEndpointsToRouters(sharingEndpoints, publicAdminRouter, internalAdminRouter)
+
 	endpoints := []endpoint{
 		{http.MethodGet, "/{dashboard_id}", hs.PublicGetValidatorDashboard, hs.InternalGetValidatorDashboard},
 		{http.MethodPut, "/{dashboard_id}/name", hs.PublicPutValidatorDashboardName, hs.InternalPutValidatorDashboardName},
@@ -294,9 +381,6 @@ func addValidatorDashboardRoutes(hs *handlers.HandlerService, publicRouter, inte
 		{http.MethodPost, "/{dashboard_id}/validators", hs.PublicPostValidatorDashboardValidators, hs.InternalPostValidatorDashboardValidators},
 		{http.MethodGet, "/{dashboard_id}/validators", hs.PublicGetValidatorDashboardValidators, hs.InternalGetValidatorDashboardValidators},
 		{http.MethodPost, "/{dashboard_id}/validators/bulk-deletions", hs.PublicDeleteValidatorDashboardValidators, hs.InternalDeleteValidatorDashboardValidators},
-		{http.MethodPost, "/{dashboard_id}/public-ids", hs.PublicPostValidatorDashboardPublicIds, hs.InternalPostValidatorDashboardPublicIds},
-		{http.MethodPut, "/{dashboard_id}/public-ids/{public_id}", hs.PublicPutValidatorDashboardPublicId, hs.InternalPutValidatorDashboardPublicId},
-		{http.MethodDelete, "/{dashboard_id}/public-ids/{public_id}", hs.PublicDeleteValidatorDashboardPublicId, hs.InternalDeleteValidatorDashboardPublicId},
 		{http.MethodGet, "/{dashboard_id}/slot-viz", hs.PublicGetValidatorDashboardSlotViz, hs.InternalGetValidatorDashboardSlotViz},
 		{http.MethodGet, "/{dashboard_id}/summary", hs.PublicGetValidatorDashboardSummary, hs.InternalGetValidatorDashboardSummary},
 		{http.MethodGet, "/{dashboard_id}/summary/validators", hs.PublicGetValidatorDashboardSummaryValidators, hs.InternalGetValidatorDashboardSummaryValidators},
@@ -315,9 +399,16 @@ func addValidatorDashboardRoutes(hs *handlers.HandlerService, publicRouter, inte
 		{http.MethodGet, "/{dashboard_id}/total-consensus-layer-deposits", hs.PublicGetValidatorDashboardTotalConsensusLayerDeposits, hs.InternalGetValidatorDashboardTotalConsensusLayerDeposits},
 		{http.MethodGet, "/{dashboard_id}/withdrawals", hs.PublicGetValidatorDashboardWithdrawals, hs.InternalGetValidatorDashboardWithdrawals},
 		{http.MethodGet, "/{dashboard_id}/total-withdrawals", hs.PublicGetValidatorDashboardTotalWithdrawals, hs.InternalGetValidatorDashboardTotalWithdrawals},
+		{http.MethodGet, "/{dashboard_id}/withdrawal-requests", hs.PublicGetValidatorDashboardWithdrawalRequests, hs.InternalGetValidatorDashboardWithdrawalRequests},
+		{http.MethodGet, "/{dashboard_id}/consolidations", hs.PublicGetValidatorDashboardConsolidations, hs.InternalGetValidatorDashboardConsolidations},
+		{http.MethodGet, "/{dashboard_id}/pending-queues", hs.PublicGetValidatorDashboardPendingQueues, hs.InternalGetValidatorDashboardPendingQueues},
+		{http.MethodGet, "/{dashboard_id}/gossip", hs.PublicGetValidatorDashboardGossip, hs.InternalGetValidatorDashboardGossip},
+		{http.MethodGet, "/{dashboard_id}/mev-bids", hs.PublicGetValidatorDashboardMevBids, hs.InternalGetValidatorDashboardMevBids},
+		{http.MethodGet, "/{dashboard_id}/ssv-clusters", hs.PublicGetValidatorDashboardSsvClusters, hs.InternalGetValidatorDashboardSsvClusters},
 		{http.MethodGet, "/{dashboard_id}/rocket-pool", hs.PublicGetValidatorDashboardRocketPool, hs.InternalGetValidatorDashboardRocketPool},
 		{http.MethodGet, "/{dashboard_id}/total-rocket-pool", hs.PublicGetValidatorDashboardTotalRocketPool, hs.InternalGetValidatorDashboardTotalRocketPool},
 		{http.MethodGet, "/{dashboard_id}/rocket-pool/{node_address}/minipools", hs.PublicGetValidatorDashboardRocketPoolMinipools, hs.InternalGetValidatorDashboardRocketPoolMinipools},
+		{http.MethodGet, "/{dashboard_id}/sync-committees", hs.PublicGetValidatorDashboardSyncCommittees, hs.InternalGetValidatorDashboardSyncCommittees},
 		{http.MethodGet, "/{dashboard_id}/mobile/widget", nil, hs.InternalGetValidatorDashboardMobileWidget},
 		{http.MethodGet, "/{dashboard_id}/mobile/validators", nil, hs.InternalGetValidatorDashboardMobileValidators},
 	}
@@ -329,6 +420,8 @@ func addNotificationRoutes(hs *handlers.HandlerService, publicRouter, internalRo
 	publicNotificationRouter := publicRouter.PathPrefix(path).Subrouter()
 	internalNotificationRouter := internalRouter.PathPrefix(path).Subrouter()
 
+	publicNotificationRouter.Use(hs.NotificationsOAuthScopeMiddleware)
+	internalNotificationRouter.Use(hs.NotificationsOAuthScopeMiddleware)
 	if !debug {
 		publicNotificationRouter.Use(hs.ManageNotificationsViaApiCheckMiddleware)
 	}
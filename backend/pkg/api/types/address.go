@@ -0,0 +1,42 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+type AddressEventLogTableRow struct {
+	TxHash   Hash    `json:"tx_hash"`
+	Block    uint64  `json:"block"`
+	Age      uint64  `json:"age"`
+	LogIndex uint64  `json:"log_index"`
+	Address  Address `json:"address"`
+	Event    string  `json:"event,omitempty"`
+	Topics   []Hash  `json:"topics"`
+	Data     Hash    `json:"data"`
+}
+
+type GetAddressEventLogsResponse ApiPagingResponse[AddressEventLogTableRow]
+
+type AddressBalanceHistoryTableRow struct {
+	Block  uint64          `json:"block"`
+	Age    uint64          `json:"age"`
+	TxHash Hash            `json:"tx_hash,omitempty"`
+	Type   string          `json:"type" tstype:"'transaction' | 'internal_transaction' | 'withdrawal'" faker:"oneof: transaction, internal_transaction, withdrawal"`
+	Change decimal.Decimal `json:"change"`
+	// balance after the change was applied
+	Balance decimal.Decimal `json:"balance"`
+}
+
+type GetAddressBalanceHistoryResponse ApiPagingResponse[AddressBalanceHistoryTableRow]
+
+type AddressTokenSupplyHistoryTableRow struct {
+	Block  uint64          `json:"block"`
+	Age    uint64          `json:"age"`
+	TxHash Hash            `json:"tx_hash"`
+	Type   string          `json:"type" tstype:"'mint' | 'burn'" faker:"oneof: mint, burn"`
+	Change decimal.Decimal `json:"change"`
+	// total supply after the change was applied
+	TotalSupply decimal.Decimal `json:"total_supply"`
+}
+
+type GetAddressTokenSupplyHistoryResponse ApiPagingResponse[AddressTokenSupplyHistoryTableRow]
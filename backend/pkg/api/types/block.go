@@ -117,6 +117,13 @@ type BlockOverview struct {
 
 type InternalGetBlockOverviewResponse ApiDataResponse[BlockOverview]
 
+// MultisigExecutionLabel marks a transaction that executed a Safe transaction
+type MultisigExecutionLabel struct {
+	Safe       Hash `json:"safe"`
+	SafeTxHash Hash `json:"safe_tx_hash"`
+	Success    bool `json:"success"`
+}
+
 type BlockTransactionTableRow struct {
 	Success  bool            `json:"success"`
 	TxHash   Hash            `json:"tx_hash"`
@@ -129,10 +136,16 @@ type BlockTransactionTableRow struct {
 	Value    decimal.Decimal `json:"value"`
 	GasPrice decimal.Decimal `json:"gas_price"`
 	TxFee    decimal.Decimal `json:"tx_fee"`
+	// only set for transactions executing a Safe transaction
+	MultisigExecution *MultisigExecutionLabel `json:"multisig_execution,omitempty"`
 }
 
 type InternalGetBlockTransactionsResponse ApiDataResponse[[]BlockTransactionTableRow]
 
+type GetTransactionsResponse ApiPagingResponse[BlockTransactionTableRow]
+
+type GetAddressTransactionsResponse ApiPagingResponse[BlockTransactionTableRow]
+
 type BlockVoteTableRow struct {
 	AllocatedSlot   uint64   `json:"allocated_slot"`
 	Committee       uint64   `json:"committee"`
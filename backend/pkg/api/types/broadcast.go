@@ -0,0 +1,18 @@
+package types
+
+type Broadcast struct {
+	Id     string `json:"id"`
+	Type   string `json:"type" tstype:"'bls_to_execution_changes' | 'voluntary_exits' | 'signed_transaction'" faker:"oneof: bls_to_execution_changes, voluntary_exits, signed_transaction"`
+	Status string `json:"status" tstype:"'pending' | 'submitted_to_node' | 'completed' | 'failed'" faker:"oneof: pending, submitted_to_node, completed, failed"`
+
+	CreatedTime         int64  `json:"created_time"`
+	SubmittedToNodeTime *int64 `json:"submitted_to_node_time,omitempty"`
+	CompletedTime       *int64 `json:"completed_time,omitempty"`
+
+	Validators []uint64 `json:"validators,omitempty"` // for consensus layer broadcasts
+	TxHash     *Hash    `json:"tx_hash,omitempty"`    // for signed transactions
+}
+
+type PostBroadcastResponse ApiDataResponse[Broadcast]
+
+type GetBroadcastResponse ApiDataResponse[Broadcast]
@@ -13,6 +13,7 @@ type ValidatorDashboard struct {
 	ArchivedReason string        `json:"archived_reason,omitempty" tstype:"'user' | 'dashboard_limit' | 'validator_limit' | 'group_limit'" extensions:"x-order=6"`
 	ValidatorCount uint64        `json:"validator_count" extensions:"x-order=7"`
 	GroupCount     uint64        `json:"group_count" extensions:"x-order=8"`
+	Role           string        `json:"role,omitempty" tstype:"'owner' | 'admin' | 'editor' | 'viewer'" extensions:"x-order=9"` // role of the requesting user
 }
 
 type UserDashboardsData struct {
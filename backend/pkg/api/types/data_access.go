@@ -49,6 +49,13 @@ type DashboardUser struct {
 	UserId uint64       `db:"user_id"`
 }
 
+type VDBInviteInfo struct {
+	DashboardId VDBIdPrimary `db:"dashboard_id"`
+	Email       string       `db:"email"`
+	Role        string       `db:"role"`
+	CreatedAt   time.Time    `db:"created_at"`
+}
+
 type CursorLike interface {
 	IsCursor() bool
 	IsValid() bool
@@ -85,6 +92,12 @@ type ELDepositsCursor struct {
 	LogIndex    int64
 }
 
+type VDBAuditLogCursor struct {
+	GenericCursor
+
+	Id uint64
+}
+
 type ValidatorsCursor struct {
 	GenericCursor
 
@@ -178,6 +191,62 @@ type NotificationsDashboardsCursor struct {
 	GroupId       uint64
 }
 
+type TransactionsCursor struct {
+	GenericCursor
+
+	Block   uint64
+	TxIndex uint64
+}
+
+// bigtable can only be scanned forward, so these cursors never produce a prev_cursor
+type BigtableIndexCursor struct {
+	GenericCursor
+
+	PageToken string
+}
+
+type EventLogsCursor struct {
+	GenericCursor
+
+	PageToken string
+	LogIndex  uint64
+}
+
+type AddressBalanceHistoryCursor struct {
+	GenericCursor
+
+	TxPageToken         string
+	ItxPageToken        string
+	WithdrawalPageToken string
+	Balance             decimal.Decimal
+}
+
+type TokenSupplyHistoryCursor struct {
+	GenericCursor
+
+	PageToken   string
+	Skip        uint64
+	TotalSupply decimal.Decimal
+}
+
+type VDBRocketPoolCursor struct {
+	GenericCursor
+
+	Node Address
+}
+
+type VDBRocketPoolMinipoolsCursor struct {
+	GenericCursor
+
+	ValidatorIndex uint64
+}
+
+type RocketPoolCursor struct {
+	GenericCursor
+
+	Address []byte
+}
+
 type NetworkInfo struct {
 	ChainId           uint64
 	Name              string
@@ -246,6 +315,10 @@ type VDBProposalSummaryValidators struct {
 
 type VDBProtocolModes struct {
 	RocketPool bool
+	// staking protocols whose fees are deducted from the rewards of their validators
+	StakingProtocols []string
+	// distributed validators of the ssv network are attributed to the operators of their cluster
+	Ssv bool
 }
 
 type MobileSubscription struct {
@@ -305,6 +378,7 @@ type NotificationSettingsDefaultValues struct {
 	GroupEfficiencyBelowThreshold     float64
 	MaxCollateralThreshold            float64
 	MinCollateralThreshold            float64
+	MissedBestBidThreshold            float64
 	ERC20TokenTransfersValueThreshold float64
 
 	MachineStorageUsageThreshold float64
@@ -318,9 +392,73 @@ type NotificationSettingsDefaultValues struct {
 
 // ------------------------------
 
+type OAuthClientApp struct {
+	Id               uint64
+	AppName          string
+	RedirectUri      string
+	ClientSecretHash string // empty for public clients
+	Scopes           []string
+}
+
+type OAuthAuthorizationCode struct {
+	AppId         uint64
+	UserId        uint64
+	RedirectUri   string
+	Scopes        []string
+	CodeChallenge string
+	GrantId       uint64 // grant issued for the code, 0 if it hasn't been exchanged yet
+	CreatedAt     time.Time
+}
+
+type OAuthTokenInfo struct {
+	GrantId   uint64
+	AppId     uint64
+	UserId    uint64
+	Scopes    []string
+	Rotated   bool
+	ExpiresAt time.Time
+}
+
+type UserTotp struct {
+	Secret       string `db:"secret"`
+	Confirmed    bool   `db:"confirmed"`
+	LastUsedStep int64  `db:"last_used_step"`
+}
+
+type WebAuthnCredential struct {
+	Id           uint64 `db:"id"`
+	CredentialId []byte `db:"credential_id"`
+	PublicKey    []byte `db:"public_key"`
+	SignCount    uint32 `db:"sign_count"`
+}
+
+// ------------------------------
+
 type CtxKey string
 
 const CtxUserIdKey CtxKey = "user_id"
 const CtxIsMockedKey CtxKey = "is_mocked"
 const CtxMockSeedKey CtxKey = "mock_seed"
 const CtxDashboardIdKey CtxKey = "dashboard_id"
+const CtxDashboardRoleKey CtxKey = "dashboard_role"
+const CtxOAuthScopesKey CtxKey = "oauth_scopes"              // only set if the request was authenticated by an oauth access token
+const CtxOAuthScopeCheckedKey CtxKey = "oauth_scope_checked" // set once the granted scopes have been checked for the endpoint
+
+type ExecutionRequestsCursor struct {
+	GenericCursor
+
+	Slot         uint64
+	RequestIndex uint64
+}
+
+type PendingQueueCursor struct {
+	GenericCursor
+
+	QueueIndex uint64
+}
+
+type MevBidsCursor struct {
+	GenericCursor
+
+	Slot uint64
+}
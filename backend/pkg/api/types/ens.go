@@ -0,0 +1,41 @@
+package types
+
+type EnsName struct {
+	Name      string `json:"name"`
+	IsPrimary bool   `json:"is_primary"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+type EnsAddressNames struct {
+	Address     Hash      `json:"address"`
+	PrimaryName string    `json:"primary_name,omitempty"` // name the address reverse resolves to
+	Names       []EnsName `json:"names"`                  // all names resolving to the address
+}
+
+type GetAddressEnsResponse ApiDataResponse[EnsAddressNames]
+
+type EnsNameHistoryEntry struct {
+	Type      string `json:"type" tstype:"'registration' | 'renewal'" faker:"oneof: registration, renewal"`
+	Block     uint64 `json:"block"`
+	Timestamp int64  `json:"timestamp"`
+	TxHash    Hash   `json:"tx_hash"`
+	Owner     *Hash  `json:"owner,omitempty"` // only set for registrations
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+type EnsNameDetails struct {
+	Name      string                `json:"name"`
+	Address   *Hash                 `json:"address,omitempty"` // not set if the name currently does not resolve
+	IsPrimary bool                  `json:"is_primary"`
+	ExpiresAt *int64                `json:"expires_at,omitempty"`
+	History   []EnsNameHistoryEntry `json:"history"` // registrations and renewals of .eth names, newest first
+}
+
+type GetEnsNameResponse ApiDataResponse[EnsNameDetails]
+
+type EnsBatchResolution struct {
+	Names     map[string]*Hash   `json:"names"`     // name -> resolved address, null if the name does not resolve
+	Addresses map[string]*string `json:"addresses"` // address -> primary name, null if the address has none
+}
+
+type PostEnsBatchResolutionResponse ApiDataResponse[EnsBatchResolution]
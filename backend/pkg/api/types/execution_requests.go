@@ -0,0 +1,34 @@
+package types
+
+import "github.com/shopspring/decimal"
+
+// ------------------------------------------------------------
+// Execution Layer Requests
+
+type ExecutionRequestValidator struct {
+	Index     *uint64 `json:"index,omitempty"` // not set if the public key is not known to the beacon chain
+	PublicKey PubKey  `json:"public_key"`
+}
+
+// EIP-7002 withdrawal request triggered from the withdrawal address of a validator
+type WithdrawalRequest struct {
+	Slot          uint64                    `json:"slot"`
+	Epoch         uint64                    `json:"epoch"`
+	SourceAddress Address                   `json:"source_address"`
+	Validator     ExecutionRequestValidator `json:"validator"`
+	Amount        decimal.Decimal           `json:"amount"`
+	IsFullExit    bool                      `json:"is_full_exit"` // a request with an amount of 0 exits the validator
+}
+
+type GetWithdrawalRequestsResponse ApiPagingResponse[WithdrawalRequest]
+
+// EIP-7251 consolidation request, a request with the same source and target switches the validator to compounding withdrawal credentials
+type ConsolidationRequest struct {
+	Slot          uint64                    `json:"slot"`
+	Epoch         uint64                    `json:"epoch"`
+	SourceAddress Address                   `json:"source_address"`
+	Source        ExecutionRequestValidator `json:"source"`
+	Target        ExecutionRequestValidator `json:"target"`
+}
+
+type GetConsolidationRequestsResponse ApiPagingResponse[ConsolidationRequest]
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+type BaseFeeForecast struct {
+	Block   uint64          `json:"block"`
+	BaseFee decimal.Decimal `json:"base_fee"`
+}
+
+type GasPercentiles struct {
+	Slow     decimal.Decimal `json:"slow"`
+	Standard decimal.Decimal `json:"standard"`
+	Fast     decimal.Decimal `json:"fast"`
+	Rapid    decimal.Decimal `json:"rapid"`
+}
+
+type GasNowData struct {
+	Timestamp int64 `json:"timestamp"`
+	// gas prices in wei, base fee of the next block plus the 25th, 50th, 75th and 90th percentile of recent priority fees
+	Slow     decimal.Decimal `json:"slow"`
+	Standard decimal.Decimal `json:"standard"`
+	Fast     decimal.Decimal `json:"fast"`
+	Rapid    decimal.Decimal `json:"rapid"`
+
+	LatestBlock uint64          `json:"latest_block"`
+	BaseFee     decimal.Decimal `json:"base_fee"`    // of the latest block
+	Utilization float64         `json:"utilization"` // average ratio of gas used to gas limit of recent blocks
+	// 25th, 50th, 75th and 90th percentile of the base fees of recent blocks
+	BaseFeePercentiles GasPercentiles `json:"base_fee_percentiles"`
+	// projected base fees of the upcoming blocks, assuming they are filled to the recent utilization
+	BaseFeeForecast []BaseFeeForecast `json:"base_fee_forecast"`
+}
+
+type GetGasNowResponse ApiDataResponse[GasNowData]
+
+type GetAverageGasLimitHistoryResponse ApiDataResponse[ChartData[string, float64]] // line chart, series id is 'average'
+
+type GetGasUsedHistoryResponse ApiDataResponse[ChartData[string, float64]] // line chart, series id is 'total' or 'average'
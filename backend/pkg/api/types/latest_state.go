@@ -1,5 +1,7 @@
 package types
 
+import "github.com/shopspring/decimal"
+
 // ------------------------------
 // various types that are used for frontend configs
 
@@ -20,12 +22,40 @@ type LatestStateData struct {
 type InternalGetLatestStateResponse ApiDataResponse[LatestStateData]
 
 type RocketPoolData struct {
+	// start of the current and the next rewards interval
 	LastUpdateSlot uint64 `json:"last_update_slot"`
 	NextUpdateSlot uint64 `json:"next_update_slot"`
 	EthRates       struct {
 		Rpl  float64 `json:"rpl"`
 		Reth float64 `json:"reth"`
 	} `json:"eth_rates"`
+	Nodes              uint64          `json:"nodes"`
+	Minipools          uint64          `json:"minipools"`
+	OracleDaoMembers   uint64          `json:"oracle_dao_members"`
+	RethSupply         decimal.Decimal `json:"reth_supply"`
+	TotalEthStaking    decimal.Decimal `json:"total_eth_staking"`
+	EffectiveRplStaked decimal.Decimal `json:"effective_rpl_staked"`
+	NodeFee            float64         `json:"node_fee"`    // commission of new minipools
+	NodeDemand         decimal.Decimal `json:"node_demand"` // eth in the deposit pool minus the eth waiting in the minipool queue
+	ActiveDaoProposals uint64          `json:"active_dao_proposals"`
 }
 
 type InternalGetRocketPoolResponse ApiDataResponse[RocketPoolData]
+
+// PriceCandle is the price of ETH within an interval starting at Ts
+type PriceCandle struct {
+	Ts    int64   `json:"ts"`
+	Open  float64 `json:"open"`
+	High  float64 `json:"high"`
+	Low   float64 `json:"low"`
+	Close float64 `json:"close"`
+}
+
+type EthPriceHistoryData struct {
+	Base       string        `json:"base"` // main currency of the network the prices are given for, e.g. ETH or GNO
+	Currency   string        `json:"currency"`
+	Resolution string        `json:"resolution" tstype:"'hourly' | 'daily'" faker:"oneof: hourly, daily"`
+	Candles    []PriceCandle `json:"candles"`
+}
+
+type GetEthPriceHistoryResponse ApiDataResponse[EthPriceHistoryData]
@@ -0,0 +1,48 @@
+package types
+
+import "github.com/shopspring/decimal"
+
+type Layer2Batch struct {
+	TxHash       Hash            `json:"tx_hash"` // layer 1 transaction submitting the batch
+	Block        uint64          `json:"block"`
+	Timestamp    int64           `json:"timestamp"`
+	Submitter    Hash            `json:"submitter"`
+	BatchNumber  *uint64         `json:"batch_number,omitempty"` // only known for arbitrum style rollups
+	DataLocation string          `json:"data_location" tstype:"'calldata' | 'blob' | 'alt_da' | 'none'" faker:"oneof: calldata, blob, alt_da, none"`
+	DataSize     uint64          `json:"data_size"` // bytes posted to the layer 1, blobs always count as full blobs
+	BlobCount    uint64          `json:"blob_count"`
+	TxFee        decimal.Decimal `json:"tx_fee"`
+	BlobFee      decimal.Decimal `json:"blob_fee"`
+}
+
+type GetLayer2BatchesResponse ApiPagingResponse[Layer2Batch]
+
+type Layer1ToLayer2Transaction struct {
+	Type         string          `json:"type" tstype:"'deposit' | 'retryable' | 'message'" faker:"oneof: deposit, retryable, message"`
+	L1TxHash     Hash            `json:"l1_tx_hash"`
+	L1Block      uint64          `json:"l1_block"`
+	Timestamp    int64           `json:"timestamp"`
+	From         Hash            `json:"from"`
+	To           *Hash           `json:"to,omitempty"`         // not set for contract creations and undecoded messages
+	Value        decimal.Decimal `json:"value"`                // eth credited on the layer 2
+	L2TxHash     *Hash           `json:"l2_tx_hash,omitempty"` // not set for messages the layer 2 hash cannot be derived for
+	MessageIndex *uint64         `json:"message_index,omitempty"`
+}
+
+type GetLayer1ToLayer2TransactionsResponse ApiPagingResponse[Layer1ToLayer2Transaction]
+
+type Layer2ToLayer1Transaction struct {
+	Status    string           `json:"status" tstype:"'proven' | 'finalized' | 'executed'" faker:"oneof: proven, finalized, executed"`
+	L1TxHash  Hash             `json:"l1_tx_hash"`
+	L1Block   uint64           `json:"l1_block"`
+	Timestamp int64            `json:"timestamp"`
+	MessageId Hash             `json:"message_id"` // withdrawal hash for op-stack, outbox position for arbitrum style rollups
+	From      *Hash            `json:"from,omitempty"`
+	To        *Hash            `json:"to,omitempty"`
+	Success   bool             `json:"success"`
+	L2TxHash  *Hash            `json:"l2_tx_hash,omitempty"` // transaction initiating the withdrawal, if indexed
+	L2Block   *uint64          `json:"l2_block,omitempty"`
+	Value     *decimal.Decimal `json:"value,omitempty"`
+}
+
+type GetLayer2ToLayer1TransactionsResponse ApiPagingResponse[Layer2ToLayer1Transaction]
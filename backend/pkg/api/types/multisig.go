@@ -0,0 +1,50 @@
+package types
+
+import "github.com/shopspring/decimal"
+
+// ------------------------------------------------------------
+// Multisig (Safe)
+
+type MultisigSafe struct {
+	Address   Address   `json:"address"`
+	Owners    []Address `json:"owners"`
+	Threshold uint64    `json:"threshold"`
+	// not set for Safes deployed through a proxy factory that isn't indexed
+	Factory           *Hash  `json:"factory,omitempty"`
+	Singleton         *Hash  `json:"singleton,omitempty"`
+	Creator           Hash   `json:"creator"`
+	CreationTxHash    Hash   `json:"creation_tx_hash"`
+	CreationBlock     uint64 `json:"creation_block"`
+	CreationTimestamp int64  `json:"creation_timestamp"`
+}
+
+type GetMultisigSafeResponse ApiDataResponse[MultisigSafe]
+
+type MultisigSafeTransaction struct {
+	TxHash     Hash            `json:"tx_hash"`
+	Block      uint64          `json:"block"`
+	Timestamp  int64           `json:"timestamp"`
+	SafeTxHash Hash            `json:"safe_tx_hash"`
+	Success    bool            `json:"success"`
+	Executor   Address         `json:"executor"`
+	Payment    decimal.Decimal `json:"payment"` // refund paid to the executor, in the gas token of the Safe transaction
+	// the details of the Safe transaction are only known if execTransaction was called directly
+	To            *Address         `json:"to,omitempty"`
+	Value         *decimal.Decimal `json:"value,omitempty"`
+	Method        *string          `json:"method,omitempty"`
+	Operation     *string          `json:"operation,omitempty" tstype:"'call' | 'delegate_call'" faker:"oneof: call, delegate_call"`
+	Confirmations *uint64          `json:"confirmations,omitempty"`
+}
+
+type GetMultisigSafeTransactionsResponse ApiPagingResponse[MultisigSafeTransaction]
+
+type MultisigTransactionConfirmation struct {
+	Owner Address `json:"owner"`
+	Kind  string  `json:"kind" tstype:"'ecdsa' | 'eth_sign' | 'approved_hash' | 'contract'" faker:"oneof: ecdsa, eth_sign, approved_hash, contract"`
+	// only set for confirmations given on chain through approveHash
+	TxHash    *Hash   `json:"tx_hash,omitempty"`
+	Block     *uint64 `json:"block,omitempty"`
+	Timestamp *int64  `json:"timestamp,omitempty"`
+}
+
+type GetMultisigTransactionConfirmationsResponse ApiDataResponse[[]MultisigTransactionConfirmation]
@@ -41,7 +41,7 @@ type NotificationDashboardsTableRow struct {
 	GroupId            uint64         `db:"group_id" json:"group_id"`
 	GroupName          string         `db:"group_name" json:"group_name"`
 	EntityCount        uint64         `db:"entity_count" json:"entity_count"`
-	EventTypes         pq.StringArray `db:"event_types" json:"event_types" tstype:"('validator_online' | 'validator_offline' | 'group_efficiency_below' | 'attestation_missed' | 'proposal_success' | 'proposal_missed' | 'proposal_upcoming' | 'max_collateral' | 'min_collateral' | 'sync' | 'withdrawal' | 'validator_got_slashed' | 'validator_has_slashed' | 'incoming_tx' | 'outgoing_tx' | 'transfer_erc20' | 'transfer_erc721' | 'transfer_erc1155')[]" faker:"slice_len=2, oneof: validator_online, validator_offline, group_efficiency_below, attestation_missed, proposal_success, proposal_missed, proposal_upcoming, max_collateral, min_collateral, sync, withdrawal, validator_got_slashed, validator_has_slashed, incoming_tx, outgoing_tx, transfer_erc20, transfer_erc721, transfer_erc1155"`
+	EventTypes         pq.StringArray `db:"event_types" json:"event_types" tstype:"('validator_online' | 'validator_offline' | 'group_efficiency_below' | 'attestation_missed' | 'proposal_success' | 'proposal_missed' | 'proposal_upcoming' | 'max_collateral' | 'min_collateral' | 'sync' | 'withdrawal' | 'consolidation' | 'missed_best_bid' | 'validator_got_slashed' | 'validator_has_slashed' | 'incoming_tx' | 'outgoing_tx' | 'transfer_erc20' | 'transfer_erc721' | 'transfer_erc1155')[]" faker:"slice_len=2, oneof: validator_online, validator_offline, group_efficiency_below, attestation_missed, proposal_success, proposal_missed, proposal_upcoming, max_collateral, min_collateral, sync, withdrawal, consolidation, missed_best_bid, validator_got_slashed, validator_has_slashed, incoming_tx, outgoing_tx, transfer_erc20, transfer_erc721, transfer_erc1155"`
 }
 
 type InternalGetUserNotificationDashboardsResponse ApiPagingResponse[NotificationDashboardsTableRow]
@@ -60,6 +60,22 @@ type NotificationEventWithdrawal struct {
 	Address Address         `json:"address"`
 }
 
+// a consolidation with the same source and target switches the validator to compounding withdrawal credentials
+type NotificationEventConsolidation struct {
+	Source uint64 `json:"source"`
+	Target uint64 `json:"target"`
+	Slot   uint64 `json:"slot"`
+}
+
+// a proposal that was worth less than the best bid the relays received for the slot, values in wei
+type NotificationEventMissedBestBid struct {
+	Index          uint64          `json:"index"`
+	Slot           uint64          `json:"slot"`
+	DeliveredValue decimal.Decimal `json:"delivered_value"`
+	BestBid        decimal.Decimal `json:"best_bid"`
+	BestBidRelay   string          `json:"best_bid_relay"`
+}
+
 type NotificationValidatorDashboardDetail struct {
 	DashboardName            string                                 `db:"dashboard_name" json:"dashboard_name"`
 	GroupName                string                                 `db:"group_name" json:"group_name"`
@@ -74,6 +90,8 @@ type NotificationValidatorDashboardDetail struct {
 	Sync                     []uint64                               `json:"sync"`               // validator indices
 	AttestationMissed        []IndexEpoch                           `json:"attestation_missed"` // index (epoch)
 	Withdrawal               []NotificationEventWithdrawal          `json:"withdrawal"`
+	Consolidation            []NotificationEventConsolidation       `json:"consolidation"`
+	MissedBestBid            []NotificationEventMissedBestBid       `json:"missed_best_bid"`
 	MinCollateral            []Address                              `json:"min_collateral"` // node addresses
 	MaxCollateral            []Address                              `json:"max_collateral"` // node addresses
 }
@@ -200,7 +218,10 @@ type NotificationSettingsValidatorDashboard struct {
 	IsUpcomingBlockProposalSubscribed bool    `json:"is_upcoming_block_proposal_subscribed"`
 	IsSyncSubscribed                  bool    `json:"is_sync_subscribed"`
 	IsWithdrawalProcessedSubscribed   bool    `json:"is_withdrawal_processed_subscribed"`
+	IsConsolidationSubscribed         bool    `json:"is_consolidation_subscribed"`
 	IsSlashedSubscribed               bool    `json:"is_slashed_subscribed"`
+	IsMissedBestBidSubscribed         bool    `json:"is_missed_best_bid_subscribed"`
+	MissedBestBidThreshold            float64 `json:"missed_best_bid_threshold" faker:"boundary_start=0, boundary_end=1"` // ETH the proposal has to miss the best bid by
 
 	IsMaxCollateralSubscribed bool    `json:"is_max_collateral_subscribed"`
 	MaxCollateralThreshold    float64 `json:"max_collateral_threshold" faker:"boundary_start=0, boundary_end=1"`
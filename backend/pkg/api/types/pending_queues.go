@@ -0,0 +1,56 @@
+package types
+
+import "github.com/shopspring/decimal"
+
+// ------------------------------------------------------------
+// Pending Queues (electra beacon state)
+
+type PendingQueueSummary struct {
+	Count               uint64          `json:"count"`
+	Amount              decimal.Decimal `json:"amount"`                          // for consolidations the effective balance of the source validators
+	EstimatedClearEpoch *uint64         `json:"estimated_clear_epoch,omitempty"` // not set if the queue is empty or the last item has no estimate yet
+}
+
+type NetworkValidatorQueue struct {
+	Epoch               uint64              `json:"epoch"` // epoch of the state the queues were read from
+	ActivationExitChurn decimal.Decimal     `json:"activation_exit_churn"`
+	ConsolidationChurn  decimal.Decimal     `json:"consolidation_churn"`
+	Deposits            PendingQueueSummary `json:"deposits"`
+	PartialWithdrawals  PendingQueueSummary `json:"partial_withdrawals"`
+	Consolidations      PendingQueueSummary `json:"consolidations"`
+}
+
+type GetNetworkValidatorQueueResponse ApiDataResponse[NetworkValidatorQueue]
+
+type PendingDeposit struct {
+	Position             uint64                    `json:"position"`
+	Validator            ExecutionRequestValidator `json:"validator"`
+	WithdrawalCredential Hash                      `json:"withdrawal_credential"`
+	Amount               decimal.Decimal           `json:"amount"`
+	Slot                 uint64                    `json:"slot"` // slot the deposit was included in
+	EstimatedEpoch       uint64                    `json:"estimated_epoch"`
+	EstimatedTimestamp   uint64                    `json:"estimated_timestamp"`
+}
+
+type GetPendingDepositsResponse ApiPagingResponse[PendingDeposit]
+
+type PendingPartialWithdrawal struct {
+	Position           uint64          `json:"position"`
+	Index              uint64          `json:"index"`
+	Amount             decimal.Decimal `json:"amount"`
+	WithdrawableEpoch  uint64          `json:"withdrawable_epoch"`
+	EstimatedEpoch     uint64          `json:"estimated_epoch"`
+	EstimatedTimestamp uint64          `json:"estimated_timestamp"`
+}
+
+type GetPendingPartialWithdrawalsResponse ApiPagingResponse[PendingPartialWithdrawal]
+
+type PendingConsolidation struct {
+	Position           uint64  `json:"position"`
+	SourceIndex        uint64  `json:"source_index"`
+	TargetIndex        uint64  `json:"target_index"`
+	EstimatedEpoch     *uint64 `json:"estimated_epoch,omitempty"` // not set while the source validator has not initiated its exit yet
+	EstimatedTimestamp *uint64 `json:"estimated_timestamp,omitempty"`
+}
+
+type GetPendingConsolidationsResponse ApiPagingResponse[PendingConsolidation]
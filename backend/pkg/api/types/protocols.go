@@ -0,0 +1,87 @@
+package types
+
+import "github.com/shopspring/decimal"
+
+// ------------------------------------------------------------
+// Rocket Pool
+
+type RocketPoolNode struct {
+	Address   Address `json:"address"`
+	Timezone  string  `json:"timezone"`
+	Minipools uint64  `json:"minipools"`
+	RplStake  struct {
+		Current   decimal.Decimal `json:"current"`
+		Effective decimal.Decimal `json:"effective"`
+		Min       decimal.Decimal `json:"min"`
+		Max       decimal.Decimal `json:"max"`
+	} `json:"rpl_stake"`
+	Rpl struct {
+		Claimed   decimal.Decimal `json:"claimed"`
+		Unclaimed decimal.Decimal `json:"unclaimed"`
+	} `json:"rpl"`
+	SmoothingPool struct {
+		IsOptIn   bool            `json:"is_opt_in"`
+		Claimed   decimal.Decimal `json:"claimed"`
+		Unclaimed decimal.Decimal `json:"unclaimed"`
+	} `json:"smoothing_pool"`
+	DepositCredit decimal.Decimal `json:"deposit_credit"`
+}
+
+type GetRocketPoolNodesResponse ApiPagingResponse[RocketPoolNode]
+
+type RocketPoolMinipool struct {
+	Address   Address `json:"address"`
+	Node      Address `json:"node"`
+	PublicKey PubKey  `json:"public_key"`
+	// not set for minipools whose validator isn't known to the beacon chain yet
+	ValidatorIndex  *uint64         `json:"validator_index,omitempty"`
+	Status          string          `json:"status" tstype:"'initialized' | 'prelaunch' | 'staking' | 'withdrawable' | 'dissolved'" faker:"oneof: initialized, prelaunch, staking, withdrawable, dissolved"`
+	StatusTimestamp int64           `json:"status_timestamp"`
+	DepositType     string          `json:"deposit_type" tstype:"'none' | 'full' | 'half' | 'empty' | 'variable'" faker:"oneof: none, full, half, empty, variable"`
+	Commission      float64         `json:"commission"`
+	Bond            decimal.Decimal `json:"bond"`
+	Borrowed        decimal.Decimal `json:"borrowed"`
+	RefundBalance   decimal.Decimal `json:"refund_balance"`
+	Penalties       uint64          `json:"penalties"`
+	IsVacant        bool            `json:"is_vacant"`
+}
+
+type GetRocketPoolMinipoolsResponse ApiPagingResponse[RocketPoolMinipool]
+
+// ------------------------------------------------------------
+// Staking Protocols (Lido, ether.fi, ...)
+
+type StakingProtocolOperator struct {
+	Id            string   `json:"id"`
+	Name          string   `json:"name"`
+	RewardAddress *Address `json:"reward_address,omitempty"`
+	IsActive      bool     `json:"is_active"`
+	Validators    uint64   `json:"validators"`
+}
+
+type StakingProtocolData struct {
+	Protocol string `json:"protocol" tstype:"'lido' | 'ether_fi'" faker:"oneof: lido, ether_fi"`
+	// shares of the rewards of the protocol validators that are kept by the protocol treasury and paid to the operators
+	TreasuryFee float64                   `json:"treasury_fee"`
+	OperatorFee float64                   `json:"operator_fee"`
+	Validators  uint64                    `json:"validators"`
+	UpdatedAt   int64                     `json:"updated_at"`
+	Operators   []StakingProtocolOperator `json:"operators"`
+}
+
+type GetStakingProtocolResponse ApiDataResponse[StakingProtocolData]
+
+// ------------------------------------------------------------
+// SSV Network (distributed validators)
+
+type SsvOperator struct {
+	Id       uint64          `json:"id"`
+	Name     string          `json:"name"`
+	Owner    Address         `json:"owner"`
+	IsActive bool            `json:"is_active"` // false if the operator got removed
+	Fee      decimal.Decimal `json:"fee"`       // per block, in ssv wei
+	// the chain doesn't tell which operators of a cluster signed a duty, every operator is accountable for all duties of
+	// the validators of its clusters. the performance is computed over the last 7 days.
+	Validators   uint64      `json:"validators"`
+	Attestations StatusCount `json:"attestations"`
+}
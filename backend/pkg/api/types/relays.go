@@ -0,0 +1,33 @@
+package types
+
+import "github.com/shopspring/decimal"
+
+// ------------------------------------------------------------
+// MEV-Boost Relays and Builders
+
+type RelayPerformance struct {
+	Name              string          `json:"name"`
+	Link              string          `json:"link,omitempty"`
+	IsCensoring       bool            `json:"is_censoring"` // relay filters transactions of sanctioned addresses
+	IsEthical         bool            `json:"is_ethical"`
+	SlotsBid          uint64          `json:"slots_bid"`            // slots the relay received at least one bid for
+	SlotsDelivered    uint64          `json:"slots_delivered"`      // slots with bids the relay delivered the payload for
+	WinRate           float64         `json:"win_rate"`             // delivered payloads per slot with bids
+	MedianBestBid     decimal.Decimal `json:"median_best_bid"`      // median of the best bid the relay received per slot
+	MedianBidLeadTime int64           `json:"median_bid_lead_time"` // milliseconds the bids arrived before the start of the slot, negative if they arrived late
+}
+
+type GetNetworkRelaysResponse ApiDataResponse[[]RelayPerformance]
+
+type BuilderPerformance struct {
+	PublicKey         PubKey          `json:"public_key"`
+	Relays            []string        `json:"relays"`       // relays the builder submitted bids to
+	IsCensoring       bool            `json:"is_censoring"` // builder only submitted bids to censoring relays
+	SlotsBid          uint64          `json:"slots_bid"`
+	SlotsWon          uint64          `json:"slots_won"` // slots with bids of the builder that included its payload
+	WinRate           float64         `json:"win_rate"`
+	MedianBestBid     decimal.Decimal `json:"median_best_bid"` // median of the best bid of the builder per slot
+	MedianBidLeadTime int64           `json:"median_bid_lead_time"`
+}
+
+type GetNetworkBuildersResponse ApiDataResponse[[]BuilderPerformance]
@@ -1,8 +1,13 @@
 package types
 
-import "github.com/shopspring/decimal"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
 
 type RPNetworkStats struct {
+	Ts                  time.Time       `db:"ts"`
 	ClaimIntervalHours  float64         `db:"claim_interval_hours"`
 	NodeOperatorRewards decimal.Decimal `db:"node_operator_rewards"`
 	EffectiveRPLStaked  decimal.Decimal `db:"effective_rpl_staked"`
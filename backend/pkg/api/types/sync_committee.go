@@ -0,0 +1,53 @@
+package types
+
+import "github.com/shopspring/decimal"
+
+// ------------------------------------------------------------
+// Sync Committee
+
+type SyncCommitteeMember struct {
+	CommitteeIndex uint64 `json:"committee_index"` // position in the committee, determines the bit in the sync aggregate
+	Validator      uint64 `json:"validator"`
+	// only slots with a canonical block count, a missed block is not the fault of the committee
+	Signed        uint64          `json:"signed"`
+	Missed        uint64          `json:"missed"`
+	Participation float64         `json:"participation"`
+	Reward        decimal.Decimal `json:"reward"`
+}
+
+type SyncCommitteeData struct {
+	Period        uint64                `json:"period"`
+	StartEpoch    uint64                `json:"start_epoch"`
+	EndEpoch      uint64                `json:"end_epoch"`
+	Status        string                `json:"status" tstype:"'past' | 'current' | 'next'" faker:"oneof: past, current, next"`
+	Participation float64               `json:"participation"` // average participation of all slots with a canonical block so far
+	Members       []SyncCommitteeMember `json:"members"`
+}
+
+type GetSyncCommitteeResponse ApiDataResponse[SyncCommitteeData]
+
+type SyncCommitteeSlot struct {
+	Slot          uint64   `json:"slot"`
+	Status        string   `json:"status" tstype:"'success' | 'missed' | 'orphaned' | 'scheduled'" faker:"oneof: success, missed, orphaned, scheduled"`
+	Participation *float64 `json:"participation,omitempty"` // only set for slots with a canonical block
+	// committee indices of the members that did not sign, only set for slots with a canonical block
+	MissedMembers []uint64 `json:"missed_members,omitempty"`
+}
+
+type GetSyncCommitteeSlotsResponse ApiDataResponse[[]SyncCommitteeSlot]
+
+type VDBSyncCommitteesTableRow struct {
+	Validator        uint64   `json:"validator"`
+	GroupId          uint64   `json:"group_id"`
+	Period           uint64   `json:"period"`
+	StartEpoch       uint64   `json:"start_epoch"`
+	EndEpoch         uint64   `json:"end_epoch"`
+	Status           string   `json:"status" tstype:"'current' | 'next'" faker:"oneof: current, next"`
+	CommitteeIndices []uint64 `json:"committee_indices"` // a validator may be selected more than once
+	// participation of the current period so far, per committee seat
+	Signed        uint64   `json:"signed"`
+	Missed        uint64   `json:"missed"`
+	Participation *float64 `json:"participation,omitempty"`
+}
+
+type GetValidatorDashboardSyncCommitteesResponse ApiDataResponse[[]VDBSyncCommitteesTableRow]
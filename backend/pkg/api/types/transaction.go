@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+type TransactionLog struct {
+	Index   uint64  `json:"index"`
+	Address Address `json:"address"`
+	Event   string  `json:"event,omitempty"`
+	Topics  []Hash  `json:"topics"`
+	Data    Hash    `json:"data"`
+	Removed bool    `json:"removed,omitempty"`
+}
+
+type TransactionDetails struct {
+	Hash     Hash   `json:"hash"`
+	Block    uint64 `json:"block"`
+	Position uint64 `json:"position"`
+	Time     int64  `json:"time"`
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
+	Method   string `json:"method"`
+	TxType   uint32 `json:"tx_type"`
+	Nonce    uint64 `json:"nonce"`
+
+	From            Address  `json:"from"`
+	To              *Address `json:"to,omitempty"`
+	ContractCreated *Address `json:"contract_created,omitempty"`
+
+	Value                decimal.Decimal  `json:"value"`
+	TxFee                decimal.Decimal  `json:"tx_fee"`
+	GasPrice             decimal.Decimal  `json:"gas_price"`
+	MaxFeePerGas         *decimal.Decimal `json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas *decimal.Decimal `json:"max_priority_fee_per_gas,omitempty"`
+	GasLimit             uint64           `json:"gas_limit"`
+	GasUsed              uint64           `json:"gas_used"`
+
+	// EIP-4844 only
+	BlobGasUsed         uint64           `json:"blob_gas_used,omitempty"`
+	BlobGasPrice        *decimal.Decimal `json:"blob_gas_price,omitempty"`
+	BlobVersionedHashes []Hash           `json:"blob_versioned_hashes,omitempty"`
+
+	Input Hash             `json:"input"`
+	Logs  []TransactionLog `json:"logs"`
+}
+
+type GetTransactionResponse ApiDataResponse[TransactionDetails]
@@ -24,6 +24,13 @@ type UserSubscription struct {
 
 type InternalGetUserInfoResponse ApiDataResponse[UserInfo]
 
+type ApiKey struct {
+	ApiKey    string `json:"api_key"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+type InternalPostApiKeysResponse ApiDataResponse[ApiKey]
+
 type EmailUpdate struct {
 	Id           uint64 `json:"id"`
 	CurrentEmail string `json:"current_email"`
@@ -155,3 +162,137 @@ type OAuthAppData struct {
 	RedirectURI string `db:"redirect_uri"`
 	Active      bool   `db:"active"`
 }
+
+// OAuth for third party apps
+type OAuthAuthorizeInfo struct {
+	AppName string   `json:"app_name"`
+	Scopes  []string `json:"scopes" tstype:"('dashboards:read' | 'dashboards:manage' | 'notifications:manage')[]"`
+}
+
+type GetOAuthAuthorizeInfoResponse ApiDataResponse[OAuthAuthorizeInfo]
+
+type OAuthAuthorizeRedirect struct {
+	RedirectUri string `json:"redirect_uri"` // includes the authorization code or the error
+}
+
+type PostOAuthAuthorizeResponse ApiDataResponse[OAuthAuthorizeRedirect]
+
+// token responses must conform to the OAuth spec and are not wrapped
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    uint64 `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	Scope        string `json:"scope"`
+}
+
+type UserOAuthApp struct {
+	AppId        uint64   `json:"app_id"`
+	AppName      string   `json:"app_name"`
+	Scopes       []string `json:"scopes" tstype:"('dashboards:read' | 'dashboards:manage' | 'notifications:manage')[]"`
+	AuthorizedAt int64    `json:"authorized_at"`
+}
+
+type GetUserOAuthAppsResponse ApiDataResponse[[]UserOAuthApp]
+
+// Two factor authentication
+type LoginResult struct {
+	TwoFactorRequired bool     `json:"two_factor_required"`
+	TwoFactorMethods  []string `json:"two_factor_methods,omitempty" tstype:"('totp' | 'webauthn' | 'recovery_code')[]"` // methods the login can be completed with
+}
+
+type InternalPostLoginResponse ApiDataResponse[LoginResult]
+
+type WebAuthnCredentialInfo struct {
+	Id         uint64 `json:"id"`
+	Name       string `json:"name"`
+	CreatedAt  int64  `json:"created_at"`
+	LastUsedAt int64  `json:"last_used_at,omitempty"`
+}
+
+type UserTwoFactorStatus struct {
+	TotpEnabled         bool                     `json:"totp_enabled"`
+	WebAuthnCredentials []WebAuthnCredentialInfo `json:"webauthn_credentials"`
+	RecoveryCodesLeft   uint64                   `json:"recovery_codes_left"`
+}
+
+type InternalGetUserTwoFactorResponse ApiDataResponse[UserTwoFactorStatus]
+
+type TotpEnrollment struct {
+	Secret string `json:"secret"`
+	Uri    string `json:"uri"` // otpauth uri, to be shown as qr code
+}
+
+type InternalPostUserTotpResponse ApiDataResponse[TotpEnrollment]
+
+type RecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes,omitempty"` // only returned once, when the first factor is enabled or the codes are regenerated
+}
+
+type InternalPostUserRecoveryCodesResponse ApiDataResponse[RecoveryCodes]
+
+type WebAuthnRelyingParty struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type WebAuthnUser struct {
+	Id          string `json:"id"` // base64url
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type WebAuthnCredentialParameter struct {
+	Type string `json:"type" tstype:"'public-key'"`
+	Alg  int64  `json:"alg"`
+}
+
+type WebAuthnCredentialDescriptor struct {
+	Type string `json:"type" tstype:"'public-key'"`
+	Id   string `json:"id"` // base64url
+}
+
+type WebAuthnAuthenticatorSelection struct {
+	UserVerification string `json:"userVerification" tstype:"'required' | 'preferred' | 'discouraged'"`
+}
+
+// options to be passed to navigator.credentials.create(), binary values are base64url encoded
+type WebAuthnCreationOptions struct {
+	Challenge              string                         `json:"challenge"`
+	Rp                     WebAuthnRelyingParty           `json:"rp"`
+	User                   WebAuthnUser                   `json:"user"`
+	PubKeyCredParams       []WebAuthnCredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                uint64                         `json:"timeout"`
+	ExcludeCredentials     []WebAuthnCredentialDescriptor `json:"excludeCredentials"`
+	Attestation            string                         `json:"attestation" tstype:"'none'"`
+	AuthenticatorSelection WebAuthnAuthenticatorSelection `json:"authenticatorSelection"`
+}
+
+type InternalPostUserWebAuthnRegistrationOptionsResponse ApiDataResponse[WebAuthnCreationOptions]
+
+// options to be passed to navigator.credentials.get(), binary values are base64url encoded
+type WebAuthnRequestOptions struct {
+	Challenge        string                         `json:"challenge"`
+	Timeout          uint64                         `json:"timeout"`
+	RpId             string                         `json:"rpId"`
+	AllowCredentials []WebAuthnCredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                         `json:"userVerification" tstype:"'required' | 'preferred' | 'discouraged'"`
+}
+
+type InternalPostWebAuthnAssertionOptionsResponse ApiDataResponse[WebAuthnRequestOptions]
+
+// Sign-In with Ethereum
+type SiweNonce struct {
+	Nonce     string `json:"nonce"`
+	ExpiresAt int64  `json:"expires_at"`
+	ChainId   uint64 `json:"chain_id"` // the message has to be signed for this chain
+}
+
+type InternalPostSiweNonceResponse ApiDataResponse[SiweNonce]
+
+type VerifiedAddress struct {
+	Address    Hash  `json:"address"`
+	VerifiedAt int64 `json:"verified_at"`
+}
+
+type InternalGetUserVerifiedAddressesResponse ApiDataResponse[[]VerifiedAddress]
@@ -61,6 +61,7 @@ type VDBSummaryTableRow struct {
 	Attestations             StatusCount                `json:"attestations"`
 	Proposals                StatusCount                `json:"proposals"`
 	Reward                   ClElValue[decimal.Decimal] `json:"reward" faker:"cl_el_eth"`
+	EffectiveBalance         decimal.Decimal            `json:"effective_balance" faker:"eth"`
 }
 type GetValidatorDashboardSummaryResponse ApiPagingResponse[VDBSummaryTableRow]
 
@@ -135,6 +136,8 @@ type VDBRewardsTableRow struct {
 	Duty    VDBRewardsTableDuty        `json:"duty"`
 	GroupId int64                      `json:"group_id"`
 	Reward  ClElValue[decimal.Decimal] `json:"reward"`
+	// reward valued at the price of the day of the epoch, only set if a currency was requested
+	RewardFiat *ClElValue[float64] `json:"reward_fiat,omitempty"`
 }
 
 type GetValidatorDashboardRewardsResponse ApiPagingResponse[VDBRewardsTableRow]
@@ -164,8 +167,9 @@ type GetValidatorDashboardRewardsChartResponse ApiDataResponse[ChartData[int, de
 // Duties Modal
 
 type VDBEpochDutiesTableRow struct {
-	Validator uint64                 `json:"validator" extensions:"x-order=1"`
-	Duties    ValidatorHistoryDuties `json:"duties"`
+	Validator    uint64                 `json:"validator" extensions:"x-order=1"`
+	Duties       ValidatorHistoryDuties `json:"duties"`
+	SsvOperators []uint64               `json:"ssv_operators,omitempty"` // operators of the ssv cluster of the validator, only set in the ssv protocol mode
 }
 type GetValidatorDashboardDutiesResponse ApiPagingResponse[VDBEpochDutiesTableRow]
 
@@ -196,7 +200,7 @@ type VDBHeatmapCell struct {
 	X int64  `json:"x" extensions:"x-order=1"` // Timestamp
 	Y uint64 `json:"y" extensions:"x-order=2"` // Group ID
 
-	Value  float64           `json:"value" extensions:"x-order=3"` // Attestaton Rewards
+	Value  float64           `json:"value" extensions:"x-order=3"` // Total efficiency or CL rewards in gwei, depending on the metric
 	Events *VDBHeatmapEvents `json:"events,omitempty"`
 }
 type VDBHeatmap struct {
@@ -204,6 +208,7 @@ type VDBHeatmap struct {
 	GroupIds    []uint64         `json:"group_ids" extensions:"x-order=2"`  // Y-Axis Categories
 	Data        []VDBHeatmapCell `json:"data" extensions:"x-order=3"`
 	Aggregation string           `json:"aggregation" tstype:"'epoch' | 'hourly' | 'daily' | 'weekly'" faker:"oneof: epoch, hourly, daily, weekly"`
+	Metric      string           `json:"metric" tstype:"'efficiency' | 'reward'" faker:"oneof: efficiency, reward"`
 }
 type GetValidatorDashboardHeatmapResponse ApiDataResponse[VDBHeatmap]
 
@@ -276,6 +281,125 @@ type VDBWithdrawalsTableRow struct {
 }
 type GetValidatorDashboardWithdrawalsResponse ApiPagingResponse[VDBWithdrawalsTableRow]
 
+// ------------------------------------------------------------
+// Execution Layer Requests Tab
+type VDBWithdrawalRequestsTableRow struct {
+	Slot          uint64                    `json:"slot"`
+	Epoch         uint64                    `json:"epoch"`
+	GroupId       uint64                    `json:"group_id"`
+	SourceAddress Address                   `json:"source_address"`
+	Validator     ExecutionRequestValidator `json:"validator"`
+	Amount        decimal.Decimal           `json:"amount"`
+	IsFullExit    bool                      `json:"is_full_exit"`
+}
+type GetValidatorDashboardWithdrawalRequestsResponse ApiPagingResponse[VDBWithdrawalRequestsTableRow]
+
+type VDBConsolidationsTableRow struct {
+	Slot          uint64                    `json:"slot"`
+	Epoch         uint64                    `json:"epoch"`
+	GroupId       uint64                    `json:"group_id"` // group of the source validator, or of the target if the source is not part of the dashboard
+	SourceAddress Address                   `json:"source_address"`
+	Source        ExecutionRequestValidator `json:"source"`
+	Target        ExecutionRequestValidator `json:"target"`
+}
+type GetValidatorDashboardConsolidationsResponse ApiPagingResponse[VDBConsolidationsTableRow]
+
+// ------------------------------------------------------------
+// Pending Queues Tab
+type VDBPendingDepositsTableRow struct {
+	Position           uint64                    `json:"position"`
+	GroupId            uint64                    `json:"group_id"`
+	Validator          ExecutionRequestValidator `json:"validator"`
+	Amount             decimal.Decimal           `json:"amount"`
+	EstimatedEpoch     uint64                    `json:"estimated_epoch"`
+	EstimatedTimestamp uint64                    `json:"estimated_timestamp"`
+}
+
+type VDBPendingPartialWithdrawalsTableRow struct {
+	Position           uint64          `json:"position"`
+	GroupId            uint64          `json:"group_id"`
+	Index              uint64          `json:"index"`
+	Amount             decimal.Decimal `json:"amount"`
+	EstimatedEpoch     uint64          `json:"estimated_epoch"`
+	EstimatedTimestamp uint64          `json:"estimated_timestamp"`
+}
+
+type VDBPendingConsolidationsTableRow struct {
+	Position           uint64  `json:"position"`
+	GroupId            uint64  `json:"group_id"` // group of the source validator, or of the target if the source is not part of the dashboard
+	SourceIndex        uint64  `json:"source_index"`
+	TargetIndex        uint64  `json:"target_index"`
+	EstimatedEpoch     *uint64 `json:"estimated_epoch,omitempty"`
+	EstimatedTimestamp *uint64 `json:"estimated_timestamp,omitempty"`
+}
+
+type VDBPendingQueuesData struct {
+	Epoch              uint64                                 `json:"epoch"` // epoch of the state the queues were read from
+	Deposits           []VDBPendingDepositsTableRow           `json:"deposits"`
+	PartialWithdrawals []VDBPendingPartialWithdrawalsTableRow `json:"partial_withdrawals"`
+	Consolidations     []VDBPendingConsolidationsTableRow     `json:"consolidations"`
+}
+
+type GetValidatorDashboardPendingQueuesResponse ApiDataResponse[VDBPendingQueuesData]
+
+type VDBGossipAttestationsSummary struct {
+	Seen                uint64  `json:"seen"`                  // attestations seen on gossip whose inclusion is finalized
+	Included            uint64  `json:"included"`              // seen attestations that were included in a canonical block
+	SeenBeforeInclusion uint64  `json:"seen_before_inclusion"` // included attestations that were seen before the slot of the including block started
+	AverageLead         float64 `json:"average_lead"`          // seconds the attestations seen before their inclusion were seen ahead of the including slot on average
+}
+
+type VDBPoolVoluntaryExitsTableRow struct {
+	GroupId       uint64 `json:"group_id"`
+	Index         uint64 `json:"index"`
+	Epoch         uint64 `json:"epoch"`
+	SeenTimestamp int64  `json:"seen_timestamp"`
+}
+
+type VDBPoolBlsChangesTableRow struct {
+	GroupId            uint64  `json:"group_id"`
+	Index              uint64  `json:"index"`
+	ToExecutionAddress Address `json:"to_execution_address"`
+	SeenTimestamp      int64   `json:"seen_timestamp"`
+}
+
+type VDBGossipData struct {
+	Attestations   VDBGossipAttestationsSummary    `json:"attestations"`
+	VoluntaryExits []VDBPoolVoluntaryExitsTableRow `json:"voluntary_exits"` // exits in the operation pool of the node whose inclusion is not finalized yet
+	BlsChanges     []VDBPoolBlsChangesTableRow     `json:"bls_changes"`     // bls to execution changes in the operation pool of the node whose inclusion is not finalized yet
+}
+
+type GetValidatorDashboardGossipResponse ApiDataResponse[VDBGossipData]
+
+// ------------------------------------------------------------
+// MEV Bids Tab
+type VDBMevBidsTableRow struct {
+	Slot             uint64          `json:"slot"`
+	Epoch            uint64          `json:"epoch"`
+	GroupId          uint64          `json:"group_id"`
+	Proposer         uint64          `json:"proposer"`
+	DeliveredByRelay bool            `json:"delivered_by_relay"` // false if the block was built locally
+	DeliveredValue   decimal.Decimal `json:"delivered_value"`    // value of the delivered payload or the fee recipient reward of a locally built block
+	BestBid          decimal.Decimal `json:"best_bid"`           // highest bid on the parent of the proposed block seen on any relay
+	BestBidRelay     string          `json:"best_bid_relay"`
+	BestBidBuilder   PubKey          `json:"best_bid_builder"`
+	ValueLeft        decimal.Decimal `json:"value_left"` // best bid minus the delivered value, 0 if the proposal got at least the best bid
+}
+type GetValidatorDashboardMevBidsResponse ApiPagingResponse[VDBMevBidsTableRow]
+
+// ------------------------------------------------------------
+// SSV Clusters Tab
+type VDBSsvClusterTableRow struct {
+	ClusterId  Hash     `json:"cluster_id"`
+	Owner      Address  `json:"owner"`
+	IsActive   bool     `json:"is_active"`  // false if the cluster got liquidated
+	Validators []uint64 `json:"validators"` // validators of the dashboard registered with the cluster
+	// attestations of the validators of the dashboard in the cluster in the last 7 days
+	Attestations StatusCount   `json:"attestations"`
+	Operators    []SsvOperator `json:"operators"`
+}
+type GetValidatorDashboardSsvClustersResponse ApiDataResponse[[]VDBSsvClusterTableRow]
+
 type VDBTotalWithdrawalsData struct {
 	TotalAmount decimal.Decimal `json:"total_amount"`
 }
@@ -343,13 +467,51 @@ type VDBManageValidatorsTableRow struct {
 	PublicKey            PubKey          `json:"public_key"`
 	GroupId              uint64          `json:"group_id"`
 	Balance              decimal.Decimal `json:"balance"`
+	EffectiveBalance     decimal.Decimal `json:"effective_balance"`
 	Status               string          `json:"status" tstype:"'slashed' | 'exited' | 'deposited' | 'pending' | 'slashing_offline' | 'slashing_online' | 'exiting_offline' | 'exiting_online' | 'active_offline' | 'active_online'" faker:"oneof: slashed, exited, deposited, pending, slashing_offline, slashing_online, exiting_offline, exiting_online, active_offline, active_online"`
 	QueuePosition        *uint64         `json:"queue_position,omitempty"`
 	WithdrawalCredential Hash            `json:"withdrawal_credential"`
+	VerifiedOwner        bool            `json:"verified_owner"` // the dashboard owner has proven ownership of the withdrawal address
 }
 
 type GetValidatorDashboardValidatorsResponse ApiPagingResponse[VDBManageValidatorsTableRow]
 
+// ------------------------------------------------------------
+// Members
+type VDBMember struct {
+	UserId  uint64 `json:"user_id"`
+	Email   string `json:"email"`
+	Role    string `json:"role" tstype:"'owner' | 'admin' | 'editor' | 'viewer'" faker:"oneof: owner, admin, editor, viewer"`
+	AddedAt int64  `json:"added_at,omitempty"` // not set for the owner
+}
+
+type VDBInvite struct {
+	Email     string `json:"email"`
+	Role      string `json:"role" tstype:"'admin' | 'editor' | 'viewer'" faker:"oneof: admin, editor, viewer"`
+	InvitedAt int64  `json:"invited_at"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+type VDBMembersData struct {
+	Members []VDBMember `json:"members"` // the owner is always listed first
+	Invites []VDBInvite `json:"invites"` // pending invites, including expired ones
+
+	RequireTwoFactor bool `json:"require_two_factor"` // members without a second factor can't access the dashboard
+}
+
+type GetValidatorDashboardMembersResponse ApiDataResponse[VDBMembersData]
+
+type VDBAuditLogTableRow struct {
+	Timestamp  int64    `json:"timestamp"`
+	UserId     uint64   `json:"user_id"`
+	Email      string   `json:"email"` // empty if the user no longer exists
+	Action     string   `json:"action" tstype:"'validators_added' | 'validators_removed' | 'group_added' | 'group_removed'" faker:"oneof: validators_added, validators_removed, group_added, group_removed"`
+	GroupId    *uint64  `json:"group_id,omitempty"`
+	Validators []uint64 `json:"validators,omitempty"` // not set if all validators of the group were removed
+}
+
+type GetValidatorDashboardAuditLogResponse ApiPagingResponse[VDBAuditLogTableRow]
+
 // ------------------------------------------------------------
 // Misc.
 type VDBPostReturnData struct {
@@ -0,0 +1,4 @@
+package etherfi
+
+//go:generate abigen -abi staking_manager.json -out staking_manager.go -pkg etherfi -type StakingManager
+//go:generate abigen -abi node_operator_manager.json -out node_operator_manager.go -pkg etherfi -type NodeOperatorManager
@@ -0,0 +1,318 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package etherfi
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// NodeOperatorManagerMetaData contains all meta data concerning the NodeOperatorManager contract.
+var NodeOperatorManagerMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"event\",\"name\":\"OperatorRegistered\",\"anonymous\":false,\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":false},{\"name\":\"totalKeys\",\"type\":\"uint64\",\"indexed\":false},{\"name\":\"keysUsed\",\"type\":\"uint64\",\"indexed\":false},{\"name\":\"ipfsHash\",\"type\":\"bytes\",\"indexed\":false}]}]",
+}
+
+// NodeOperatorManagerABI is the input ABI used to generate the binding from.
+// Deprecated: Use NodeOperatorManagerMetaData.ABI instead.
+var NodeOperatorManagerABI = NodeOperatorManagerMetaData.ABI
+
+// NodeOperatorManager is an auto generated Go binding around an Ethereum contract.
+type NodeOperatorManager struct {
+	NodeOperatorManagerCaller     // Read-only binding to the contract
+	NodeOperatorManagerTransactor // Write-only binding to the contract
+	NodeOperatorManagerFilterer   // Log filterer for contract events
+}
+
+// NodeOperatorManagerCaller is an auto generated read-only Go binding around an Ethereum contract.
+type NodeOperatorManagerCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NodeOperatorManagerTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type NodeOperatorManagerTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NodeOperatorManagerFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type NodeOperatorManagerFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NodeOperatorManagerSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type NodeOperatorManagerSession struct {
+	Contract     *NodeOperatorManager // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// NodeOperatorManagerCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type NodeOperatorManagerCallerSession struct {
+	Contract *NodeOperatorManagerCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts              // Call options to use throughout this session
+}
+
+// NodeOperatorManagerTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type NodeOperatorManagerTransactorSession struct {
+	Contract     *NodeOperatorManagerTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// NodeOperatorManagerRaw is an auto generated low-level Go binding around an Ethereum contract.
+type NodeOperatorManagerRaw struct {
+	Contract *NodeOperatorManager // Generic contract binding to access the raw methods on
+}
+
+// NodeOperatorManagerCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type NodeOperatorManagerCallerRaw struct {
+	Contract *NodeOperatorManagerCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// NodeOperatorManagerTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type NodeOperatorManagerTransactorRaw struct {
+	Contract *NodeOperatorManagerTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewNodeOperatorManager creates a new instance of NodeOperatorManager, bound to a specific deployed contract.
+func NewNodeOperatorManager(address common.Address, backend bind.ContractBackend) (*NodeOperatorManager, error) {
+	contract, err := bindNodeOperatorManager(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeOperatorManager{NodeOperatorManagerCaller: NodeOperatorManagerCaller{contract: contract}, NodeOperatorManagerTransactor: NodeOperatorManagerTransactor{contract: contract}, NodeOperatorManagerFilterer: NodeOperatorManagerFilterer{contract: contract}}, nil
+}
+
+// NewNodeOperatorManagerCaller creates a new read-only instance of NodeOperatorManager, bound to a specific deployed contract.
+func NewNodeOperatorManagerCaller(address common.Address, caller bind.ContractCaller) (*NodeOperatorManagerCaller, error) {
+	contract, err := bindNodeOperatorManager(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeOperatorManagerCaller{contract: contract}, nil
+}
+
+// NewNodeOperatorManagerTransactor creates a new write-only instance of NodeOperatorManager, bound to a specific deployed contract.
+func NewNodeOperatorManagerTransactor(address common.Address, transactor bind.ContractTransactor) (*NodeOperatorManagerTransactor, error) {
+	contract, err := bindNodeOperatorManager(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeOperatorManagerTransactor{contract: contract}, nil
+}
+
+// NewNodeOperatorManagerFilterer creates a new log filterer instance of NodeOperatorManager, bound to a specific deployed contract.
+func NewNodeOperatorManagerFilterer(address common.Address, filterer bind.ContractFilterer) (*NodeOperatorManagerFilterer, error) {
+	contract, err := bindNodeOperatorManager(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeOperatorManagerFilterer{contract: contract}, nil
+}
+
+// bindNodeOperatorManager binds a generic wrapper to an already deployed contract.
+func bindNodeOperatorManager(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := NodeOperatorManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NodeOperatorManager *NodeOperatorManagerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NodeOperatorManager.Contract.NodeOperatorManagerCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NodeOperatorManager *NodeOperatorManagerRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NodeOperatorManager.Contract.NodeOperatorManagerTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NodeOperatorManager *NodeOperatorManagerRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NodeOperatorManager.Contract.NodeOperatorManagerTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NodeOperatorManager *NodeOperatorManagerCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NodeOperatorManager.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NodeOperatorManager *NodeOperatorManagerTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NodeOperatorManager.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NodeOperatorManager *NodeOperatorManagerTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NodeOperatorManager.Contract.contract.Transact(opts, method, params...)
+}
+
+// NodeOperatorManagerOperatorRegisteredIterator is returned from FilterOperatorRegistered and is used to iterate over the raw logs and unpacked data for OperatorRegistered events raised by the NodeOperatorManager contract.
+type NodeOperatorManagerOperatorRegisteredIterator struct {
+	Event *NodeOperatorManagerOperatorRegistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeOperatorManagerOperatorRegisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeOperatorManagerOperatorRegistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeOperatorManagerOperatorRegistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeOperatorManagerOperatorRegisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeOperatorManagerOperatorRegisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NodeOperatorManagerOperatorRegistered represents a OperatorRegistered event raised by the NodeOperatorManager contract.
+type NodeOperatorManagerOperatorRegistered struct {
+	User      common.Address
+	TotalKeys uint64
+	KeysUsed  uint64
+	IpfsHash  []byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterOperatorRegistered is a free log retrieval operation binding the contract event 0x14b65bf05bae9ffbba84981c0d4ac7830cdd44e4ddd1890bac55ae48b56e084a.
+//
+// Solidity: event OperatorRegistered(address user, uint64 totalKeys, uint64 keysUsed, bytes ipfsHash)
+func (_NodeOperatorManager *NodeOperatorManagerFilterer) FilterOperatorRegistered(opts *bind.FilterOpts) (*NodeOperatorManagerOperatorRegisteredIterator, error) {
+
+	logs, sub, err := _NodeOperatorManager.contract.FilterLogs(opts, "OperatorRegistered")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeOperatorManagerOperatorRegisteredIterator{contract: _NodeOperatorManager.contract, event: "OperatorRegistered", logs: logs, sub: sub}, nil
+}
+
+// WatchOperatorRegistered is a free log subscription operation binding the contract event 0x14b65bf05bae9ffbba84981c0d4ac7830cdd44e4ddd1890bac55ae48b56e084a.
+//
+// Solidity: event OperatorRegistered(address user, uint64 totalKeys, uint64 keysUsed, bytes ipfsHash)
+func (_NodeOperatorManager *NodeOperatorManagerFilterer) WatchOperatorRegistered(opts *bind.WatchOpts, sink chan<- *NodeOperatorManagerOperatorRegistered) (event.Subscription, error) {
+
+	logs, sub, err := _NodeOperatorManager.contract.WatchLogs(opts, "OperatorRegistered")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeOperatorManagerOperatorRegistered)
+				if err := _NodeOperatorManager.contract.UnpackLog(event, "OperatorRegistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOperatorRegistered is a log parse operation binding the contract event 0x14b65bf05bae9ffbba84981c0d4ac7830cdd44e4ddd1890bac55ae48b56e084a.
+//
+// Solidity: event OperatorRegistered(address user, uint64 totalKeys, uint64 keysUsed, bytes ipfsHash)
+func (_NodeOperatorManager *NodeOperatorManagerFilterer) ParseOperatorRegistered(log types.Log) (*NodeOperatorManagerOperatorRegistered, error) {
+	event := new(NodeOperatorManagerOperatorRegistered)
+	if err := _NodeOperatorManager.contract.UnpackLog(event, "OperatorRegistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,340 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package layer2
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ArbitrumBridgeMetaData contains all meta data concerning the ArbitrumBridge contract.
+var ArbitrumBridgeMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"messageIndex\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"beforeInboxAcc\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"inbox\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"kind\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"messageDataHash\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"baseFeeL1\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"timestamp\",\"type\":\"uint64\"}],\"name\":\"MessageDelivered\",\"type\":\"event\"}]",
+}
+
+// ArbitrumBridgeABI is the input ABI used to generate the binding from.
+// Deprecated: Use ArbitrumBridgeMetaData.ABI instead.
+var ArbitrumBridgeABI = ArbitrumBridgeMetaData.ABI
+
+// ArbitrumBridge is an auto generated Go binding around an Ethereum contract.
+type ArbitrumBridge struct {
+	ArbitrumBridgeCaller     // Read-only binding to the contract
+	ArbitrumBridgeTransactor // Write-only binding to the contract
+	ArbitrumBridgeFilterer   // Log filterer for contract events
+}
+
+// ArbitrumBridgeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ArbitrumBridgeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ArbitrumBridgeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ArbitrumBridgeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ArbitrumBridgeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ArbitrumBridgeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ArbitrumBridgeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ArbitrumBridgeSession struct {
+	Contract     *ArbitrumBridge   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ArbitrumBridgeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ArbitrumBridgeCallerSession struct {
+	Contract *ArbitrumBridgeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// ArbitrumBridgeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ArbitrumBridgeTransactorSession struct {
+	Contract     *ArbitrumBridgeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// ArbitrumBridgeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ArbitrumBridgeRaw struct {
+	Contract *ArbitrumBridge // Generic contract binding to access the raw methods on
+}
+
+// ArbitrumBridgeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ArbitrumBridgeCallerRaw struct {
+	Contract *ArbitrumBridgeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ArbitrumBridgeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ArbitrumBridgeTransactorRaw struct {
+	Contract *ArbitrumBridgeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewArbitrumBridge creates a new instance of ArbitrumBridge, bound to a specific deployed contract.
+func NewArbitrumBridge(address common.Address, backend bind.ContractBackend) (*ArbitrumBridge, error) {
+	contract, err := bindArbitrumBridge(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ArbitrumBridge{ArbitrumBridgeCaller: ArbitrumBridgeCaller{contract: contract}, ArbitrumBridgeTransactor: ArbitrumBridgeTransactor{contract: contract}, ArbitrumBridgeFilterer: ArbitrumBridgeFilterer{contract: contract}}, nil
+}
+
+// NewArbitrumBridgeCaller creates a new read-only instance of ArbitrumBridge, bound to a specific deployed contract.
+func NewArbitrumBridgeCaller(address common.Address, caller bind.ContractCaller) (*ArbitrumBridgeCaller, error) {
+	contract, err := bindArbitrumBridge(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ArbitrumBridgeCaller{contract: contract}, nil
+}
+
+// NewArbitrumBridgeTransactor creates a new write-only instance of ArbitrumBridge, bound to a specific deployed contract.
+func NewArbitrumBridgeTransactor(address common.Address, transactor bind.ContractTransactor) (*ArbitrumBridgeTransactor, error) {
+	contract, err := bindArbitrumBridge(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ArbitrumBridgeTransactor{contract: contract}, nil
+}
+
+// NewArbitrumBridgeFilterer creates a new log filterer instance of ArbitrumBridge, bound to a specific deployed contract.
+func NewArbitrumBridgeFilterer(address common.Address, filterer bind.ContractFilterer) (*ArbitrumBridgeFilterer, error) {
+	contract, err := bindArbitrumBridge(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ArbitrumBridgeFilterer{contract: contract}, nil
+}
+
+// bindArbitrumBridge binds a generic wrapper to an already deployed contract.
+func bindArbitrumBridge(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ArbitrumBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ArbitrumBridge *ArbitrumBridgeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ArbitrumBridge.Contract.ArbitrumBridgeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ArbitrumBridge *ArbitrumBridgeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ArbitrumBridge.Contract.ArbitrumBridgeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ArbitrumBridge *ArbitrumBridgeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ArbitrumBridge.Contract.ArbitrumBridgeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ArbitrumBridge *ArbitrumBridgeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ArbitrumBridge.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ArbitrumBridge *ArbitrumBridgeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ArbitrumBridge.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ArbitrumBridge *ArbitrumBridgeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ArbitrumBridge.Contract.contract.Transact(opts, method, params...)
+}
+
+// ArbitrumBridgeMessageDeliveredIterator is returned from FilterMessageDelivered and is used to iterate over the raw logs and unpacked data for MessageDelivered events raised by the ArbitrumBridge contract.
+type ArbitrumBridgeMessageDeliveredIterator struct {
+	Event *ArbitrumBridgeMessageDelivered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ArbitrumBridgeMessageDeliveredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ArbitrumBridgeMessageDelivered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ArbitrumBridgeMessageDelivered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ArbitrumBridgeMessageDeliveredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ArbitrumBridgeMessageDeliveredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ArbitrumBridgeMessageDelivered represents a MessageDelivered event raised by the ArbitrumBridge contract.
+type ArbitrumBridgeMessageDelivered struct {
+	MessageIndex    *big.Int
+	BeforeInboxAcc  [32]byte
+	Inbox           common.Address
+	Kind            uint8
+	Sender          common.Address
+	MessageDataHash [32]byte
+	BaseFeeL1       *big.Int
+	Timestamp       uint64
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterMessageDelivered is a free log retrieval operation binding the contract event 0x5e3c1311ea442664e8b1611bfabef659120ea7a0a2cfc0667700bebc69cbffe1.
+//
+// Solidity: event MessageDelivered(uint256 indexed messageIndex, bytes32 indexed beforeInboxAcc, address inbox, uint8 kind, address sender, bytes32 messageDataHash, uint256 baseFeeL1, uint64 timestamp)
+func (_ArbitrumBridge *ArbitrumBridgeFilterer) FilterMessageDelivered(opts *bind.FilterOpts, messageIndex []*big.Int, beforeInboxAcc [][32]byte) (*ArbitrumBridgeMessageDeliveredIterator, error) {
+
+	var messageIndexRule []interface{}
+	for _, messageIndexItem := range messageIndex {
+		messageIndexRule = append(messageIndexRule, messageIndexItem)
+	}
+	var beforeInboxAccRule []interface{}
+	for _, beforeInboxAccItem := range beforeInboxAcc {
+		beforeInboxAccRule = append(beforeInboxAccRule, beforeInboxAccItem)
+	}
+
+	logs, sub, err := _ArbitrumBridge.contract.FilterLogs(opts, "MessageDelivered", messageIndexRule, beforeInboxAccRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ArbitrumBridgeMessageDeliveredIterator{contract: _ArbitrumBridge.contract, event: "MessageDelivered", logs: logs, sub: sub}, nil
+}
+
+// WatchMessageDelivered is a free log subscription operation binding the contract event 0x5e3c1311ea442664e8b1611bfabef659120ea7a0a2cfc0667700bebc69cbffe1.
+//
+// Solidity: event MessageDelivered(uint256 indexed messageIndex, bytes32 indexed beforeInboxAcc, address inbox, uint8 kind, address sender, bytes32 messageDataHash, uint256 baseFeeL1, uint64 timestamp)
+func (_ArbitrumBridge *ArbitrumBridgeFilterer) WatchMessageDelivered(opts *bind.WatchOpts, sink chan<- *ArbitrumBridgeMessageDelivered, messageIndex []*big.Int, beforeInboxAcc [][32]byte) (event.Subscription, error) {
+
+	var messageIndexRule []interface{}
+	for _, messageIndexItem := range messageIndex {
+		messageIndexRule = append(messageIndexRule, messageIndexItem)
+	}
+	var beforeInboxAccRule []interface{}
+	for _, beforeInboxAccItem := range beforeInboxAcc {
+		beforeInboxAccRule = append(beforeInboxAccRule, beforeInboxAccItem)
+	}
+
+	logs, sub, err := _ArbitrumBridge.contract.WatchLogs(opts, "MessageDelivered", messageIndexRule, beforeInboxAccRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ArbitrumBridgeMessageDelivered)
+				if err := _ArbitrumBridge.contract.UnpackLog(event, "MessageDelivered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMessageDelivered is a log parse operation binding the contract event 0x5e3c1311ea442664e8b1611bfabef659120ea7a0a2cfc0667700bebc69cbffe1.
+//
+// Solidity: event MessageDelivered(uint256 indexed messageIndex, bytes32 indexed beforeInboxAcc, address inbox, uint8 kind, address sender, bytes32 messageDataHash, uint256 baseFeeL1, uint64 timestamp)
+func (_ArbitrumBridge *ArbitrumBridgeFilterer) ParseMessageDelivered(log types.Log) (*ArbitrumBridgeMessageDelivered, error) {
+	event := new(ArbitrumBridgeMessageDelivered)
+	if err := _ArbitrumBridge.contract.UnpackLog(event, "MessageDelivered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,214 @@
+package layer2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// layer 2 transaction types of deposits, they are not known to go-ethereum
+const (
+	optimismDepositTxType         = 0x7e
+	arbitrumDepositTxType         = 0x64
+	arbitrumSubmitRetryableTxType = 0x69
+)
+
+// OptimismDeposit is a decoded TransactionDeposited event of an OptimismPortal
+type OptimismDeposit struct {
+	From  common.Address
+	To    *common.Address // nil for contract creations
+	Mint  *big.Int        // eth minted on the layer 2
+	Value *big.Int
+	Gas   uint64
+	Data  []byte
+}
+
+// DecodeOptimismDeposit decodes the opaque data of a version 0 TransactionDeposited event
+func DecodeOptimismDeposit(from, to common.Address, version *big.Int, opaqueData []byte) (*OptimismDeposit, error) {
+	if version.Sign() != 0 {
+		return nil, fmt.Errorf("unsupported deposit version %v", version)
+	}
+	if len(opaqueData) < 32+32+8+1 {
+		return nil, fmt.Errorf("unexpected opaque data length %d", len(opaqueData))
+	}
+	deposit := &OptimismDeposit{
+		From:  from,
+		Mint:  new(big.Int).SetBytes(opaqueData[0:32]),
+		Value: new(big.Int).SetBytes(opaqueData[32:64]),
+		Gas:   binary.BigEndian.Uint64(opaqueData[64:72]),
+		Data:  opaqueData[73:],
+	}
+	if opaqueData[72] != 1 {
+		deposit.To = &to
+	}
+	return deposit, nil
+}
+
+// L2TxHash returns the hash of the deposit transaction the layer 2 derives from the event.
+// logIndex is the index of the event within the layer 1 block (not within the transaction).
+func (d *OptimismDeposit) L2TxHash(l1BlockHash common.Hash, logIndex uint64) (common.Hash, error) {
+	var depositId [64]byte
+	copy(depositId[:32], l1BlockHash[:])
+	binary.BigEndian.PutUint64(depositId[56:], logIndex)
+	// user deposits use the source hash domain 0
+	var source [64]byte
+	copy(source[32:], crypto.Keccak256(depositId[:]))
+
+	var mint *big.Int
+	if d.Mint.Sign() != 0 {
+		mint = d.Mint
+	}
+	tx := struct {
+		SourceHash          common.Hash
+		From                common.Address
+		To                  *common.Address `rlp:"nil"`
+		Mint                *big.Int        `rlp:"nil"`
+		Value               *big.Int
+		Gas                 uint64
+		IsSystemTransaction bool
+		Data                []byte
+	}{
+		SourceHash: crypto.Keccak256Hash(source[:]),
+		From:       d.From,
+		To:         d.To,
+		Mint:       mint,
+		Value:      d.Value,
+		Gas:        d.Gas,
+		Data:       d.Data,
+	}
+	return typedTxHash(optimismDepositTxType, tx)
+}
+
+// ArbitrumDeposit is a message delivered to the delayed inbox of an arbitrum rollup that creates a transaction on the layer 2
+type ArbitrumDeposit struct {
+	Kind         uint8
+	MessageIndex *big.Int
+	Sender       common.Address // already aliased if the sender is a contract
+	L1BaseFee    *big.Int
+	To           *common.Address // nil for contract creations
+	Value        *big.Int        // eth deposited to the layer 2
+
+	retryable *arbitrumRetryable
+}
+
+type arbitrumRetryable struct {
+	CallValue        *big.Int
+	MaxSubmissionFee *big.Int
+	FeeRefundAddr    common.Address
+	Beneficiary      common.Address
+	Gas              uint64
+	GasFeeCap        *big.Int
+	Data             []byte
+}
+
+// DecodeArbitrumDeposit decodes the data of an eth deposit or retryable ticket message, other message kinds are not supported
+func DecodeArbitrumDeposit(kind uint8, messageIndex *big.Int, sender common.Address, l1BaseFee *big.Int, data []byte) (*ArbitrumDeposit, error) {
+	deposit := &ArbitrumDeposit{
+		Kind:         kind,
+		MessageIndex: messageIndex,
+		Sender:       sender,
+		L1BaseFee:    l1BaseFee,
+	}
+	switch kind {
+	case ArbitrumMessageKindEthDeposit:
+		if len(data) != 20+32 {
+			return nil, fmt.Errorf("unexpected eth deposit data length %d", len(data))
+		}
+		to := common.BytesToAddress(data[:20])
+		deposit.To = &to
+		deposit.Value = new(big.Int).SetBytes(data[20:])
+	case ArbitrumMessageKindSubmitRetryable:
+		if len(data) < 9*32 {
+			return nil, fmt.Errorf("unexpected retryable data length %d", len(data))
+		}
+		word := func(i int) []byte { return data[i*32 : (i+1)*32] }
+		if to := common.BytesToAddress(word(0)); to != (common.Address{}) {
+			deposit.To = &to
+		}
+		deposit.Value = new(big.Int).SetBytes(word(2))
+		gas := new(big.Int).SetBytes(word(6))
+		dataLength := new(big.Int).SetBytes(word(8))
+		if !gas.IsUint64() || !dataLength.IsUint64() || dataLength.Uint64() != uint64(len(data)-9*32) {
+			return nil, fmt.Errorf("invalid retryable gas limit or data length")
+		}
+		deposit.retryable = &arbitrumRetryable{
+			CallValue:        new(big.Int).SetBytes(word(1)),
+			MaxSubmissionFee: new(big.Int).SetBytes(word(3)),
+			FeeRefundAddr:    common.BytesToAddress(word(4)),
+			Beneficiary:      common.BytesToAddress(word(5)),
+			Gas:              gas.Uint64(),
+			GasFeeCap:        new(big.Int).SetBytes(word(7)),
+			Data:             data[9*32:],
+		}
+	default:
+		return nil, fmt.Errorf("unsupported message kind %d", kind)
+	}
+	return deposit, nil
+}
+
+// L2TxHash returns the hash of the transaction the layer 2 with the given chain id creates for the message.
+// For retryable tickets this is the ticket submission, not the (possibly delayed) redemption.
+func (d *ArbitrumDeposit) L2TxHash(l2ChainId *big.Int) (common.Hash, error) {
+	requestId := common.BigToHash(d.MessageIndex)
+	if d.retryable == nil {
+		tx := struct {
+			ChainId     *big.Int
+			L1RequestId common.Hash
+			From        common.Address
+			To          common.Address
+			Value       *big.Int
+		}{
+			ChainId:     l2ChainId,
+			L1RequestId: requestId,
+			From:        d.Sender,
+			To:          *d.To,
+			Value:       d.Value,
+		}
+		return typedTxHash(arbitrumDepositTxType, tx)
+	}
+	tx := struct {
+		ChainId          *big.Int
+		RequestId        common.Hash
+		From             common.Address
+		L1BaseFee        *big.Int
+		DepositValue     *big.Int
+		GasFeeCap        *big.Int
+		Gas              uint64
+		RetryTo          *common.Address `rlp:"nil"`
+		RetryValue       *big.Int
+		Beneficiary      common.Address
+		MaxSubmissionFee *big.Int
+		FeeRefundAddr    common.Address
+		RetryData        []byte
+	}{
+		ChainId:          l2ChainId,
+		RequestId:        requestId,
+		From:             d.Sender,
+		L1BaseFee:        d.L1BaseFee,
+		DepositValue:     d.Value,
+		GasFeeCap:        d.retryable.GasFeeCap,
+		Gas:              d.retryable.Gas,
+		RetryTo:          d.To,
+		RetryValue:       d.retryable.CallValue,
+		Beneficiary:      d.retryable.Beneficiary,
+		MaxSubmissionFee: d.retryable.MaxSubmissionFee,
+		FeeRefundAddr:    d.retryable.FeeRefundAddr,
+		RetryData:        d.retryable.Data,
+	}
+	return typedTxHash(arbitrumSubmitRetryableTxType, tx)
+}
+
+// typedTxHash hashes an EIP-2718 typed transaction: keccak256(type || rlp(tx))
+func typedTxHash(txType byte, tx interface{}) (common.Hash, error) {
+	var buf bytes.Buffer
+	buf.WriteByte(txType)
+	if err := rlp.Encode(&buf, tx); err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(buf.Bytes()), nil
+}
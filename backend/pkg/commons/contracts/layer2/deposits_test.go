@@ -0,0 +1,88 @@
+package layer2
+
+import (
+	"bytes"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestDecodeOptimismDeposit(t *testing.T) {
+	from, to := common.HexToAddress("0xa11ce"), common.HexToAddress("0xb0b")
+	opaqueData := func(isCreation byte, data ...byte) []byte {
+		return slices.Concat(common.BigToHash(big.NewInt(3)).Bytes(), common.BigToHash(big.NewInt(2)).Bytes(), []byte{0, 0, 0, 0, 0, 1, 0x86, 0xa0}, []byte{isCreation}, data)
+	}
+
+	deposit, err := DecodeOptimismDeposit(from, to, common.Big0, opaqueData(0, 0xde, 0xad))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deposit.From != from || deposit.To == nil || *deposit.To != to || deposit.Mint.Int64() != 3 || deposit.Value.Int64() != 2 || deposit.Gas != 100000 || !bytes.Equal(deposit.Data, []byte{0xde, 0xad}) {
+		t.Errorf("unexpected deposit %+v", deposit)
+	}
+	deposit, err = DecodeOptimismDeposit(from, common.Address{}, common.Big0, opaqueData(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deposit.To != nil || len(deposit.Data) != 0 {
+		t.Errorf("expected a contract creation without data, got %+v", deposit)
+	}
+
+	tests := []struct {
+		name       string
+		version    *big.Int
+		opaqueData []byte
+	}{
+		{"unknown version", common.Big1, opaqueData(0)},
+		{"missing creation flag", common.Big0, opaqueData(0)[:72]},
+		{"empty", common.Big0, nil},
+	}
+	for _, tt := range tests {
+		if _, err := DecodeOptimismDeposit(from, to, tt.version, tt.opaqueData); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
+
+func TestDecodeArbitrumDeposit(t *testing.T) {
+	sender, to := common.HexToAddress("0xa11ce"), common.HexToAddress("0xb0b")
+	word := func(v int64) []byte { return common.BigToHash(big.NewInt(v)).Bytes() }
+	retryable := func(to common.Address, dataLength int64, data ...byte) []byte {
+		return slices.Concat(common.BytesToHash(to.Bytes()).Bytes(), word(1), word(5), word(2), word(0xa11ce), word(0xa11ce), word(300000), word(100), word(dataLength), data)
+	}
+
+	deposit, err := DecodeArbitrumDeposit(ArbitrumMessageKindEthDeposit, common.Big1, sender, common.Big2, append(to.Bytes(), word(7)...))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deposit.To == nil || *deposit.To != to || deposit.Value.Int64() != 7 || deposit.retryable != nil {
+		t.Errorf("unexpected eth deposit %+v", deposit)
+	}
+	deposit, err = DecodeArbitrumDeposit(ArbitrumMessageKindSubmitRetryable, common.Big1, sender, common.Big2, retryable(to, 2, 0x01, 0x02))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deposit.To == nil || *deposit.To != to || deposit.Value.Int64() != 5 || deposit.retryable.CallValue.Int64() != 1 || deposit.retryable.Gas != 300000 || !bytes.Equal(deposit.retryable.Data, []byte{0x01, 0x02}) {
+		t.Errorf("unexpected retryable %+v %+v", deposit, deposit.retryable)
+	}
+
+	tests := []struct {
+		name string
+		kind uint8
+		data []byte
+	}{
+		{"short eth deposit", ArbitrumMessageKindEthDeposit, to.Bytes()},
+		{"long eth deposit", ArbitrumMessageKindEthDeposit, slices.Concat(to.Bytes(), word(7), []byte{0})},
+		{"short retryable", ArbitrumMessageKindSubmitRetryable, retryable(to, 0)[:8*32]},
+		{"retryable data longer than announced", ArbitrumMessageKindSubmitRetryable, retryable(to, 1, 0x01, 0x02)},
+		{"retryable data shorter than announced", ArbitrumMessageKindSubmitRetryable, retryable(to, 3, 0x01, 0x02)},
+		{"l2 message", ArbitrumMessageKindL2Message, []byte{0x04}},
+	}
+	for _, tt := range tests {
+		if _, err := DecodeArbitrumDeposit(tt.kind, common.Big1, sender, common.Big2, tt.data); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
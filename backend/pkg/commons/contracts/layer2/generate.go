@@ -0,0 +1,9 @@
+package layer2
+
+//go:generate abigen -abi optimism_portal.json -out optimism_portal.go -pkg layer2 -type OptimismPortal
+//go:generate abigen -abi l2_to_l1_message_passer.json -out l2_to_l1_message_passer.go -pkg layer2 -type L2ToL1MessagePasser
+//go:generate abigen -abi arbitrum_bridge.json -out arbitrum_bridge.go -pkg layer2 -type ArbitrumBridge
+//go:generate abigen -abi arbitrum_inbox.json -out arbitrum_inbox.go -pkg layer2 -type ArbitrumInbox
+//go:generate abigen -abi arbitrum_sequencer_inbox.json -out arbitrum_sequencer_inbox.go -pkg layer2 -type ArbitrumSequencerInbox
+//go:generate abigen -abi arbitrum_outbox.json -out arbitrum_outbox.go -pkg layer2 -type ArbitrumOutbox
+//go:generate abigen -abi arb_sys.json -out arb_sys.go -pkg layer2 -type ArbSys
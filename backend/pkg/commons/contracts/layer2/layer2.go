@@ -0,0 +1,83 @@
+package layer2
+
+import (
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// supported rollup stacks
+const (
+	StackOptimism = "op-stack"
+	StackArbitrum = "arbitrum"
+)
+
+// predeploys on the layer 2 that emit the events initiating layer 2 to layer 1 messages
+var (
+	L2ToL1MessagePasserAddress = common.HexToAddress("0x4200000000000000000000000000000000000016")
+	ArbSysAddress              = common.HexToAddress("0x0000000000000000000000000000000000000064")
+)
+
+// kinds of messages delivered to the delayed inbox of an arbitrum rollup
+const (
+	ArbitrumMessageKindL2Message        = 3
+	ArbitrumMessageKindL2FundedByL1     = 7
+	ArbitrumMessageKindSubmitRetryable  = 9
+	ArbitrumMessageKindEthDeposit       = 12
+	ArbitrumMessageKindBatchPostingInfo = 13
+)
+
+// location of the data of an arbitrum batch, as emitted by SequencerBatchDelivered
+const (
+	ArbitrumBatchDataLocationTxInput            = 0
+	ArbitrumBatchDataLocationSeparateBatchEvent = 1
+	ArbitrumBatchDataLocationNoData             = 2
+	ArbitrumBatchDataLocationBlob               = 3
+)
+
+var OptimismPortalParsedABI, L2ToL1MessagePasserParsedABI, ArbitrumBridgeParsedABI, ArbitrumInboxParsedABI, ArbitrumSequencerInboxParsedABI, ArbitrumOutboxParsedABI, ArbSysParsedABI *abi.ABI
+
+var OptimismPortalContract, L2ToL1MessagePasserContract, ArbitrumBridgeContract, ArbitrumInboxContract, ArbitrumSequencerInboxContract, ArbitrumOutboxContract, ArbSysContract *bind.BoundContract
+
+func init() {
+	var err error
+
+	OptimismPortalParsedABI, err = OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		log.Fatal(err, "error getting optimism-portal-abi", 0)
+	}
+	L2ToL1MessagePasserParsedABI, err = L2ToL1MessagePasserMetaData.GetAbi()
+	if err != nil {
+		log.Fatal(err, "error getting l2-to-l1-message-passer-abi", 0)
+	}
+	ArbitrumBridgeParsedABI, err = ArbitrumBridgeMetaData.GetAbi()
+	if err != nil {
+		log.Fatal(err, "error getting arbitrum-bridge-abi", 0)
+	}
+	ArbitrumInboxParsedABI, err = ArbitrumInboxMetaData.GetAbi()
+	if err != nil {
+		log.Fatal(err, "error getting arbitrum-inbox-abi", 0)
+	}
+	ArbitrumSequencerInboxParsedABI, err = ArbitrumSequencerInboxMetaData.GetAbi()
+	if err != nil {
+		log.Fatal(err, "error getting arbitrum-sequencer-inbox-abi", 0)
+	}
+	ArbitrumOutboxParsedABI, err = ArbitrumOutboxMetaData.GetAbi()
+	if err != nil {
+		log.Fatal(err, "error getting arbitrum-outbox-abi", 0)
+	}
+	ArbSysParsedABI, err = ArbSysMetaData.GetAbi()
+	if err != nil {
+		log.Fatal(err, "error getting arb-sys-abi", 0)
+	}
+
+	OptimismPortalContract = bind.NewBoundContract(common.Address{}, *OptimismPortalParsedABI, nil, nil, nil)
+	L2ToL1MessagePasserContract = bind.NewBoundContract(common.Address{}, *L2ToL1MessagePasserParsedABI, nil, nil, nil)
+	ArbitrumBridgeContract = bind.NewBoundContract(common.Address{}, *ArbitrumBridgeParsedABI, nil, nil, nil)
+	ArbitrumInboxContract = bind.NewBoundContract(common.Address{}, *ArbitrumInboxParsedABI, nil, nil, nil)
+	ArbitrumSequencerInboxContract = bind.NewBoundContract(common.Address{}, *ArbitrumSequencerInboxParsedABI, nil, nil, nil)
+	ArbitrumOutboxContract = bind.NewBoundContract(common.Address{}, *ArbitrumOutboxParsedABI, nil, nil, nil)
+	ArbSysContract = bind.NewBoundContract(common.Address{}, *ArbSysParsedABI, nil, nil, nil)
+}
@@ -0,0 +1,4 @@
+package lido
+
+//go:generate abigen -abi node_operators_registry.json -out node_operators_registry.go -pkg lido -type NodeOperatorsRegistry
+//go:generate abigen -abi staking_router.json -out staking_router.go -pkg lido -type StakingRouter
@@ -0,0 +1,332 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package lido
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// NodeOperatorsRegistryMetaData contains all meta data concerning the NodeOperatorsRegistry contract.
+var NodeOperatorsRegistryMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"getNodeOperatorsCount\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"getNodeOperator\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_nodeOperatorId\",\"type\":\"uint256\"},{\"name\":\"_fullInfo\",\"type\":\"bool\"}],\"outputs\":[{\"name\":\"active\",\"type\":\"bool\"},{\"name\":\"name\",\"type\":\"string\"},{\"name\":\"rewardAddress\",\"type\":\"address\"},{\"name\":\"totalVettedValidators\",\"type\":\"uint64\"},{\"name\":\"totalExitedValidators\",\"type\":\"uint64\"},{\"name\":\"totalAddedValidators\",\"type\":\"uint64\"},{\"name\":\"totalDepositedValidators\",\"type\":\"uint64\"}]},{\"type\":\"function\",\"name\":\"getSigningKeys\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_nodeOperatorId\",\"type\":\"uint256\"},{\"name\":\"_offset\",\"type\":\"uint256\"},{\"name\":\"_limit\",\"type\":\"uint256\"}],\"outputs\":[{\"name\":\"pubkeys\",\"type\":\"bytes\"},{\"name\":\"signatures\",\"type\":\"bytes\"},{\"name\":\"used\",\"type\":\"bool[]\"}]}]",
+}
+
+// NodeOperatorsRegistryABI is the input ABI used to generate the binding from.
+// Deprecated: Use NodeOperatorsRegistryMetaData.ABI instead.
+var NodeOperatorsRegistryABI = NodeOperatorsRegistryMetaData.ABI
+
+// NodeOperatorsRegistry is an auto generated Go binding around an Ethereum contract.
+type NodeOperatorsRegistry struct {
+	NodeOperatorsRegistryCaller     // Read-only binding to the contract
+	NodeOperatorsRegistryTransactor // Write-only binding to the contract
+	NodeOperatorsRegistryFilterer   // Log filterer for contract events
+}
+
+// NodeOperatorsRegistryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type NodeOperatorsRegistryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NodeOperatorsRegistryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type NodeOperatorsRegistryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NodeOperatorsRegistryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type NodeOperatorsRegistryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NodeOperatorsRegistrySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type NodeOperatorsRegistrySession struct {
+	Contract     *NodeOperatorsRegistry // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts          // Call options to use throughout this session
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// NodeOperatorsRegistryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type NodeOperatorsRegistryCallerSession struct {
+	Contract *NodeOperatorsRegistryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                // Call options to use throughout this session
+}
+
+// NodeOperatorsRegistryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type NodeOperatorsRegistryTransactorSession struct {
+	Contract     *NodeOperatorsRegistryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                // Transaction auth options to use throughout this session
+}
+
+// NodeOperatorsRegistryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type NodeOperatorsRegistryRaw struct {
+	Contract *NodeOperatorsRegistry // Generic contract binding to access the raw methods on
+}
+
+// NodeOperatorsRegistryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type NodeOperatorsRegistryCallerRaw struct {
+	Contract *NodeOperatorsRegistryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// NodeOperatorsRegistryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type NodeOperatorsRegistryTransactorRaw struct {
+	Contract *NodeOperatorsRegistryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewNodeOperatorsRegistry creates a new instance of NodeOperatorsRegistry, bound to a specific deployed contract.
+func NewNodeOperatorsRegistry(address common.Address, backend bind.ContractBackend) (*NodeOperatorsRegistry, error) {
+	contract, err := bindNodeOperatorsRegistry(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeOperatorsRegistry{NodeOperatorsRegistryCaller: NodeOperatorsRegistryCaller{contract: contract}, NodeOperatorsRegistryTransactor: NodeOperatorsRegistryTransactor{contract: contract}, NodeOperatorsRegistryFilterer: NodeOperatorsRegistryFilterer{contract: contract}}, nil
+}
+
+// NewNodeOperatorsRegistryCaller creates a new read-only instance of NodeOperatorsRegistry, bound to a specific deployed contract.
+func NewNodeOperatorsRegistryCaller(address common.Address, caller bind.ContractCaller) (*NodeOperatorsRegistryCaller, error) {
+	contract, err := bindNodeOperatorsRegistry(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeOperatorsRegistryCaller{contract: contract}, nil
+}
+
+// NewNodeOperatorsRegistryTransactor creates a new write-only instance of NodeOperatorsRegistry, bound to a specific deployed contract.
+func NewNodeOperatorsRegistryTransactor(address common.Address, transactor bind.ContractTransactor) (*NodeOperatorsRegistryTransactor, error) {
+	contract, err := bindNodeOperatorsRegistry(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeOperatorsRegistryTransactor{contract: contract}, nil
+}
+
+// NewNodeOperatorsRegistryFilterer creates a new log filterer instance of NodeOperatorsRegistry, bound to a specific deployed contract.
+func NewNodeOperatorsRegistryFilterer(address common.Address, filterer bind.ContractFilterer) (*NodeOperatorsRegistryFilterer, error) {
+	contract, err := bindNodeOperatorsRegistry(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeOperatorsRegistryFilterer{contract: contract}, nil
+}
+
+// bindNodeOperatorsRegistry binds a generic wrapper to an already deployed contract.
+func bindNodeOperatorsRegistry(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := NodeOperatorsRegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NodeOperatorsRegistry *NodeOperatorsRegistryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NodeOperatorsRegistry.Contract.NodeOperatorsRegistryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NodeOperatorsRegistry *NodeOperatorsRegistryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NodeOperatorsRegistry.Contract.NodeOperatorsRegistryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NodeOperatorsRegistry *NodeOperatorsRegistryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NodeOperatorsRegistry.Contract.NodeOperatorsRegistryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NodeOperatorsRegistry *NodeOperatorsRegistryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NodeOperatorsRegistry.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NodeOperatorsRegistry *NodeOperatorsRegistryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NodeOperatorsRegistry.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NodeOperatorsRegistry *NodeOperatorsRegistryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NodeOperatorsRegistry.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetNodeOperator is a free data retrieval call binding the contract method 0x9a56983c.
+//
+// Solidity: function getNodeOperator(uint256 _nodeOperatorId, bool _fullInfo) view returns(bool active, string name, address rewardAddress, uint64 totalVettedValidators, uint64 totalExitedValidators, uint64 totalAddedValidators, uint64 totalDepositedValidators)
+func (_NodeOperatorsRegistry *NodeOperatorsRegistryCaller) GetNodeOperator(opts *bind.CallOpts, _nodeOperatorId *big.Int, _fullInfo bool) (struct {
+	Active                   bool
+	Name                     string
+	RewardAddress            common.Address
+	TotalVettedValidators    uint64
+	TotalExitedValidators    uint64
+	TotalAddedValidators     uint64
+	TotalDepositedValidators uint64
+}, error) {
+	var out []interface{}
+	err := _NodeOperatorsRegistry.contract.Call(opts, &out, "getNodeOperator", _nodeOperatorId, _fullInfo)
+
+	outstruct := new(struct {
+		Active                   bool
+		Name                     string
+		RewardAddress            common.Address
+		TotalVettedValidators    uint64
+		TotalExitedValidators    uint64
+		TotalAddedValidators     uint64
+		TotalDepositedValidators uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Active = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Name = *abi.ConvertType(out[1], new(string)).(*string)
+	outstruct.RewardAddress = *abi.ConvertType(out[2], new(common.Address)).(*common.Address)
+	outstruct.TotalVettedValidators = *abi.ConvertType(out[3], new(uint64)).(*uint64)
+	outstruct.TotalExitedValidators = *abi.ConvertType(out[4], new(uint64)).(*uint64)
+	outstruct.TotalAddedValidators = *abi.ConvertType(out[5], new(uint64)).(*uint64)
+	outstruct.TotalDepositedValidators = *abi.ConvertType(out[6], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// GetNodeOperator is a free data retrieval call binding the contract method 0x9a56983c.
+//
+// Solidity: function getNodeOperator(uint256 _nodeOperatorId, bool _fullInfo) view returns(bool active, string name, address rewardAddress, uint64 totalVettedValidators, uint64 totalExitedValidators, uint64 totalAddedValidators, uint64 totalDepositedValidators)
+func (_NodeOperatorsRegistry *NodeOperatorsRegistrySession) GetNodeOperator(_nodeOperatorId *big.Int, _fullInfo bool) (struct {
+	Active                   bool
+	Name                     string
+	RewardAddress            common.Address
+	TotalVettedValidators    uint64
+	TotalExitedValidators    uint64
+	TotalAddedValidators     uint64
+	TotalDepositedValidators uint64
+}, error) {
+	return _NodeOperatorsRegistry.Contract.GetNodeOperator(&_NodeOperatorsRegistry.CallOpts, _nodeOperatorId, _fullInfo)
+}
+
+// GetNodeOperator is a free data retrieval call binding the contract method 0x9a56983c.
+//
+// Solidity: function getNodeOperator(uint256 _nodeOperatorId, bool _fullInfo) view returns(bool active, string name, address rewardAddress, uint64 totalVettedValidators, uint64 totalExitedValidators, uint64 totalAddedValidators, uint64 totalDepositedValidators)
+func (_NodeOperatorsRegistry *NodeOperatorsRegistryCallerSession) GetNodeOperator(_nodeOperatorId *big.Int, _fullInfo bool) (struct {
+	Active                   bool
+	Name                     string
+	RewardAddress            common.Address
+	TotalVettedValidators    uint64
+	TotalExitedValidators    uint64
+	TotalAddedValidators     uint64
+	TotalDepositedValidators uint64
+}, error) {
+	return _NodeOperatorsRegistry.Contract.GetNodeOperator(&_NodeOperatorsRegistry.CallOpts, _nodeOperatorId, _fullInfo)
+}
+
+// GetNodeOperatorsCount is a free data retrieval call binding the contract method 0xa70c70e4.
+//
+// Solidity: function getNodeOperatorsCount() view returns(uint256)
+func (_NodeOperatorsRegistry *NodeOperatorsRegistryCaller) GetNodeOperatorsCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _NodeOperatorsRegistry.contract.Call(opts, &out, "getNodeOperatorsCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNodeOperatorsCount is a free data retrieval call binding the contract method 0xa70c70e4.
+//
+// Solidity: function getNodeOperatorsCount() view returns(uint256)
+func (_NodeOperatorsRegistry *NodeOperatorsRegistrySession) GetNodeOperatorsCount() (*big.Int, error) {
+	return _NodeOperatorsRegistry.Contract.GetNodeOperatorsCount(&_NodeOperatorsRegistry.CallOpts)
+}
+
+// GetNodeOperatorsCount is a free data retrieval call binding the contract method 0xa70c70e4.
+//
+// Solidity: function getNodeOperatorsCount() view returns(uint256)
+func (_NodeOperatorsRegistry *NodeOperatorsRegistryCallerSession) GetNodeOperatorsCount() (*big.Int, error) {
+	return _NodeOperatorsRegistry.Contract.GetNodeOperatorsCount(&_NodeOperatorsRegistry.CallOpts)
+}
+
+// GetSigningKeys is a free data retrieval call binding the contract method 0x59e25c12.
+//
+// Solidity: function getSigningKeys(uint256 _nodeOperatorId, uint256 _offset, uint256 _limit) view returns(bytes pubkeys, bytes signatures, bool[] used)
+func (_NodeOperatorsRegistry *NodeOperatorsRegistryCaller) GetSigningKeys(opts *bind.CallOpts, _nodeOperatorId *big.Int, _offset *big.Int, _limit *big.Int) (struct {
+	Pubkeys    []byte
+	Signatures []byte
+	Used       []bool
+}, error) {
+	var out []interface{}
+	err := _NodeOperatorsRegistry.contract.Call(opts, &out, "getSigningKeys", _nodeOperatorId, _offset, _limit)
+
+	outstruct := new(struct {
+		Pubkeys    []byte
+		Signatures []byte
+		Used       []bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Pubkeys = *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+	outstruct.Signatures = *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+	outstruct.Used = *abi.ConvertType(out[2], new([]bool)).(*[]bool)
+
+	return *outstruct, err
+
+}
+
+// GetSigningKeys is a free data retrieval call binding the contract method 0x59e25c12.
+//
+// Solidity: function getSigningKeys(uint256 _nodeOperatorId, uint256 _offset, uint256 _limit) view returns(bytes pubkeys, bytes signatures, bool[] used)
+func (_NodeOperatorsRegistry *NodeOperatorsRegistrySession) GetSigningKeys(_nodeOperatorId *big.Int, _offset *big.Int, _limit *big.Int) (struct {
+	Pubkeys    []byte
+	Signatures []byte
+	Used       []bool
+}, error) {
+	return _NodeOperatorsRegistry.Contract.GetSigningKeys(&_NodeOperatorsRegistry.CallOpts, _nodeOperatorId, _offset, _limit)
+}
+
+// GetSigningKeys is a free data retrieval call binding the contract method 0x59e25c12.
+//
+// Solidity: function getSigningKeys(uint256 _nodeOperatorId, uint256 _offset, uint256 _limit) view returns(bytes pubkeys, bytes signatures, bool[] used)
+func (_NodeOperatorsRegistry *NodeOperatorsRegistryCallerSession) GetSigningKeys(_nodeOperatorId *big.Int, _offset *big.Int, _limit *big.Int) (struct {
+	Pubkeys    []byte
+	Signatures []byte
+	Used       []bool
+}, error) {
+	return _NodeOperatorsRegistry.Contract.GetSigningKeys(&_NodeOperatorsRegistry.CallOpts, _nodeOperatorId, _offset, _limit)
+}
@@ -0,0 +1,4 @@
+package safe
+
+//go:generate abigen -abi safe.json -out safe_contract.go -pkg safe -type Safe
+//go:generate abigen -abi safe_proxy_factory.json -out safe_proxy_factory.go -pkg safe -type SafeProxyFactory
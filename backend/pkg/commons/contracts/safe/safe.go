@@ -0,0 +1,107 @@
+package safe
+
+import (
+	"math/big"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// kinds of signatures accepted by execTransaction, determined by the v value of a signature
+const (
+	SignatureKindContract     = "contract"      // v = 0, EIP-1271 signature of a contract owner
+	SignatureKindApprovedHash = "approved_hash" // v = 1, hash approved on chain or the executing owner
+	SignatureKindEthSign      = "eth_sign"      // v > 30, signature of the eth_sign prefixed hash
+	SignatureKindEcdsa        = "ecdsa"         // v = 27 or 28, signature of the plain hash
+)
+
+var SafeParsedABI, SafeProxyFactoryParsedABI *abi.ABI
+
+var SafeContract, SafeProxyFactoryContract *bind.BoundContract
+
+func init() {
+	var err error
+
+	SafeParsedABI, err = SafeMetaData.GetAbi()
+	if err != nil {
+		log.Fatal(err, "error getting safe-abi", 0)
+	}
+	SafeProxyFactoryParsedABI, err = SafeProxyFactoryMetaData.GetAbi()
+	if err != nil {
+		log.Fatal(err, "error getting safe-proxy-factory-abi", 0)
+	}
+
+	SafeContract = bind.NewBoundContract(common.Address{}, *SafeParsedABI, nil, nil, nil)
+	SafeProxyFactoryContract = bind.NewBoundContract(common.Address{}, *SafeProxyFactoryParsedABI, nil, nil, nil)
+}
+
+// EventWords returns the static arguments of an event as 32 byte words, indexed arguments first.
+// Since v1.4.0 some arguments of the Safe events are indexed, which keeps the topic but moves them from the data into the topics,
+// the order of the returned words is the same for both variants as long as the indexed arguments come first.
+func EventWords(topics []common.Hash, data []byte) []common.Hash {
+	words := make([]common.Hash, 0, len(topics)+len(data)/32)
+	if len(topics) > 1 {
+		words = append(words, topics[1:]...)
+	}
+	for i := 0; i+32 <= len(data); i += 32 {
+		words = append(words, common.BytesToHash(data[i:i+32]))
+	}
+	return words
+}
+
+type Confirmation struct {
+	Owner common.Address
+	Kind  string
+}
+
+// DecodeSignatures returns the owners that signed the Safe transaction with the given hash.
+// The signatures are packed as 65 byte {r, s, v} values sorted by owner, contract signatures append their dynamic data
+// after the static part, so the static part ends at the smallest offset referenced by a contract signature.
+// Signatures that can not be recovered are skipped, the contract only accepts the transaction if enough of them are valid.
+func DecodeSignatures(safeTxHash common.Hash, signatures []byte) []Confirmation {
+	var confirmations []Confirmation
+	end := uint64(len(signatures))
+	for offset := uint64(0); offset+65 <= end; offset += 65 {
+		r := signatures[offset : offset+32]
+		s := signatures[offset+32 : offset+64]
+		v := signatures[offset+64]
+		switch {
+		case v == 0:
+			if dynamicOffset := new(big.Int).SetBytes(s); dynamicOffset.IsUint64() && dynamicOffset.Uint64() < end {
+				end = dynamicOffset.Uint64()
+			}
+			confirmations = append(confirmations, Confirmation{Owner: common.BytesToAddress(r), Kind: SignatureKindContract})
+		case v == 1:
+			confirmations = append(confirmations, Confirmation{Owner: common.BytesToAddress(r), Kind: SignatureKindApprovedHash})
+		case v > 30:
+			hash := crypto.Keccak256Hash([]byte("\x19Ethereum Signed Message:\n32"), safeTxHash.Bytes())
+			if owner, ok := recoverSigner(hash, r, s, v-4); ok {
+				confirmations = append(confirmations, Confirmation{Owner: owner, Kind: SignatureKindEthSign})
+			}
+		default:
+			if owner, ok := recoverSigner(safeTxHash, r, s, v); ok {
+				confirmations = append(confirmations, Confirmation{Owner: owner, Kind: SignatureKindEcdsa})
+			}
+		}
+	}
+	return confirmations
+}
+
+func recoverSigner(hash common.Hash, r, s []byte, v byte) (common.Address, bool) {
+	if v != 27 && v != 28 {
+		return common.Address{}, false
+	}
+	sig := make([]byte, 0, 65)
+	sig = append(sig, r...)
+	sig = append(sig, s...)
+	sig = append(sig, v-27)
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, false
+	}
+	return crypto.PubkeyToAddress(*pub), true
+}
@@ -0,0 +1,119 @@
+package safe
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestDecodeSignatures(t *testing.T) {
+	safeTxHash := crypto.Keccak256Hash([]byte("safe transaction"))
+	ecdsaKey, ecdsaOwner := newTestKey(t, 1)
+	ethSignKey, ethSignOwner := newTestKey(t, 2)
+	approvingOwner := common.HexToAddress("0xa11ce")
+	contractOwner := common.HexToAddress("0xc0de")
+
+	word := func(v uint64) []byte { return common.BigToHash(new(big.Int).SetUint64(v)).Bytes() }
+	// signs the hash and moves v from 0/1 to 27/28 plus the given offset
+	sign := func(key *ecdsa.PrivateKey, hash common.Hash, vOffset byte) []byte {
+		sig, err := crypto.Sign(hash.Bytes(), key)
+		if err != nil {
+			t.Fatalf("error signing: %v", err)
+		}
+		sig[64] += 27 + vOffset
+		return sig
+	}
+	static := func(r, s []byte, v byte) []byte {
+		return slices.Concat(common.LeftPadBytes(r, 32), common.LeftPadBytes(s, 32), []byte{v})
+	}
+	ecdsaSig := sign(ecdsaKey, safeTxHash, 0)
+	ethSignSig := sign(ethSignKey, crypto.Keccak256Hash([]byte("\x19Ethereum Signed Message:\n32"), safeTxHash.Bytes()), 4)
+	approvedSig := static(approvingOwner.Bytes(), nil, 1)
+	// the dynamic data of the contract signature consists of bytes that would decode as approved hashes if they were
+	// mistaken for static signatures
+	contractData := bytes.Repeat([]byte{1}, 65)
+	contractSig := func(offset uint64) []byte { return static(contractOwner.Bytes(), word(offset), 0) }
+
+	tests := []struct {
+		name       string
+		signatures []byte
+		expected   []Confirmation
+	}{
+		{
+			name:       "ecdsa",
+			signatures: ecdsaSig,
+			expected:   []Confirmation{{ecdsaOwner, SignatureKindEcdsa}},
+		},
+		{
+			name:       "eth_sign",
+			signatures: ethSignSig,
+			expected:   []Confirmation{{ethSignOwner, SignatureKindEthSign}},
+		},
+		{
+			name:       "approved hash",
+			signatures: approvedSig,
+			expected:   []Confirmation{{approvingOwner, SignatureKindApprovedHash}},
+		},
+		{
+			name:       "contract signature with dynamic data",
+			signatures: slices.Concat(contractSig(2*65), ecdsaSig, word(uint64(len(contractData))), contractData),
+			expected:   []Confirmation{{contractOwner, SignatureKindContract}, {ecdsaOwner, SignatureKindEcdsa}},
+		},
+		{
+			name:       "contract signature with an offset beyond the signatures",
+			signatures: slices.Concat(approvedSig, contractSig(1<<40)),
+			expected:   []Confirmation{{approvingOwner, SignatureKindApprovedHash}, {contractOwner, SignatureKindContract}},
+		},
+		{
+			name:       "all kinds",
+			signatures: slices.Concat(approvedSig, contractSig(4*65), ecdsaSig, ethSignSig, word(uint64(len(contractData))), contractData),
+			expected: []Confirmation{
+				{approvingOwner, SignatureKindApprovedHash},
+				{contractOwner, SignatureKindContract},
+				{ecdsaOwner, SignatureKindEcdsa},
+				{ethSignOwner, SignatureKindEthSign},
+			},
+		},
+		{
+			name:       "malformed v",
+			signatures: slices.Concat(static(ecdsaSig[:32], ecdsaSig[32:64], 29), static(ecdsaSig[:32], ecdsaSig[32:64], 2), approvedSig),
+			expected:   []Confirmation{{approvingOwner, SignatureKindApprovedHash}},
+		},
+		{
+			name:       "eth_sign with malformed v",
+			signatures: static(ethSignSig[:32], ethSignSig[32:64], 35),
+			expected:   nil,
+		},
+		{
+			name:       "truncated signature",
+			signatures: slices.Concat(approvedSig, ecdsaSig[:64]),
+			expected:   []Confirmation{{approvingOwner, SignatureKindApprovedHash}},
+		},
+		{
+			name:       "empty",
+			signatures: nil,
+			expected:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confirmations := DecodeSignatures(safeTxHash, tt.signatures)
+			if !slices.Equal(confirmations, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, confirmations)
+			}
+		})
+	}
+}
+
+func newTestKey(t *testing.T, seed byte) (*ecdsa.PrivateKey, common.Address) {
+	key, err := crypto.ToECDSA(common.LeftPadBytes([]byte{seed}, 32))
+	if err != nil {
+		t.Fatalf("error creating key: %v", err)
+	}
+	return key, crypto.PubkeyToAddress(key.PublicKey)
+}
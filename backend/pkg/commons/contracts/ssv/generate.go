@@ -0,0 +1,3 @@
+package ssv
+
+//go:generate abigen -abi ssv_network.json -out ssv_network.go -pkg ssv -type SSVNetwork
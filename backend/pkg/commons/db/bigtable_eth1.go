@@ -1122,8 +1122,7 @@ func (bigtable *Bigtable) TransformBlobTx(blk *types.Eth1Block, cache *freecache
 		// log.LogInfo("sending to: %x", to)
 
 		key := fmt.Sprintf("%s:BTX:%x", bigtable.chainId, tx.GetHash())
-		fee := new(big.Int).Mul(new(big.Int).SetBytes(tx.GetGasPrice()), big.NewInt(int64(tx.GetGasUsed()))).Bytes()
-		blobFee := new(big.Int).Mul(new(big.Int).SetBytes(tx.GetBlobGasPrice()), big.NewInt(int64(tx.GetBlobGasUsed()))).Bytes()
+		fee, blobFee := blobTxFees(tx)
 		indexedTx := &types.Eth1BlobTransactionIndexed{
 			Hash:                tx.GetHash(),
 			BlockNumber:         blk.GetNumber(),
@@ -1183,6 +1182,13 @@ func (bigtable *Bigtable) TransformBlobTx(blk *types.Eth1Block, cache *freecache
 	return bulkData, bulkMetadataUpdates, nil
 }
 
+// blobTxFees returns the execution fee and the blob fee paid by a transaction
+func blobTxFees(tx *types.Eth1Transaction) (fee []byte, blobFee []byte) {
+	fee = new(big.Int).Mul(new(big.Int).SetBytes(tx.GetGasPrice()), big.NewInt(int64(tx.GetGasUsed()))).Bytes()
+	blobFee = new(big.Int).Mul(new(big.Int).SetBytes(tx.GetBlobGasPrice()), big.NewInt(int64(tx.GetBlobGasUsed()))).Bytes()
+	return fee, blobFee
+}
+
 // custom timestamp
 func encodeIsContractUpdateTs(block_number, tx_idx, trace_idx uint64) (gcp_bigtable.Timestamp, error) {
 	var res uint64
@@ -2189,13 +2195,16 @@ func (bigtable *Bigtable) GetEth1TxsForAddress(prefix string, limit int64) ([]*t
 		return nil, nil, err
 	}
 
-	for _, key := range keys {
+	// keep the returned indexes aligned with the returned data
+	dataIndexes := make([]string, 0, len(indexes))
+	for i, key := range keys {
 		if d := keysMap[key]; d != nil {
 			data = append(data, d)
+			dataIndexes = append(dataIndexes, indexes[i])
 		}
 	}
 
-	return data, indexes, nil
+	return data, dataIndexes, nil
 }
 
 func (bigtable *Bigtable) GetAddressesNamesArMetadata(addresses *map[string]string, inputMetadata *map[string]*types.ERC20Metadata) (map[string]string, map[string]*types.ERC20Metadata, error) {
@@ -2551,13 +2560,75 @@ func (bigtable *Bigtable) GetEth1ItxsForAddress(prefix string, limit int64) ([]*
 		return nil, nil, err
 	}
 
-	for _, key := range keys {
+	// keep the returned indexes aligned with the returned data
+	dataIndexes := make([]string, 0, len(indexes))
+	for i, key := range keys {
+		if d := keysMap[key]; d != nil {
+			data = append(data, d)
+			dataIndexes = append(dataIndexes, indexes[i])
+		}
+	}
+
+	return data, dataIndexes, nil
+}
+
+func (bigtable *Bigtable) GetEth1WithdrawalsForAddress(prefix string, limit int64) ([]*types.Eth1WithdrawalIndexed, []string, error) {
+	tmr := time.AfterFunc(REPORT_TIMEOUT, func() {
+		log.WarnWithFields(log.Fields{
+			"prefix":   prefix,
+			"limit":    limit,
+			"func":     utils.GetCurrentFuncName(),
+			"duration": REPORT_TIMEOUT,
+		}, "call took longer than expected")
+	})
+	defer tmr.Stop()
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Second*30))
+	defer cancel()
+
+	// add \x00 to the row range such that we skip the previous value
+	rowRange := gcp_bigtable.NewRange(prefix+"\x00", prefixSuccessor(prefix, 5))
+	data := make([]*types.Eth1WithdrawalIndexed, 0, limit)
+	keys := make([]string, 0, limit)
+	indexes := make([]string, 0, limit)
+	keysMap := make(map[string]*types.Eth1WithdrawalIndexed, limit)
+
+	err := bigtable.tableData.ReadRows(ctx, rowRange, func(row gcp_bigtable.Row) bool {
+		keys = append(keys, strings.TrimPrefix(row[DEFAULT_FAMILY][0].Column, "f:"))
+		indexes = append(indexes, row.Key())
+		return true
+	}, gcp_bigtable.LimitRows(limit))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(keys) == 0 {
+		return data, nil, nil
+	}
+
+	err = bigtable.tableData.ReadRows(ctx, gcp_bigtable.RowList(keys), func(row gcp_bigtable.Row) bool {
+		b := &types.Eth1WithdrawalIndexed{}
+		err := proto.Unmarshal(row[DEFAULT_FAMILY][0].Value, b)
+
+		if err != nil {
+			log.Fatal(err, "error parsing Eth1WithdrawalIndexed data", 0)
+		}
+		keysMap[row.Key()] = b
+		return true
+	})
+	if err != nil {
+		log.Error(err, "error reading rows in bigtable_eth1 / GetEth1WithdrawalsForAddress", 0, map[string]interface{}{"prefix": prefix, "limit": limit})
+		return nil, nil, err
+	}
+
+	dataIndexes := make([]string, 0, len(indexes))
+	for i, key := range keys {
 		if d := keysMap[key]; d != nil {
 			data = append(data, d)
+			dataIndexes = append(dataIndexes, indexes[i])
 		}
 	}
 
-	return data, indexes, nil
+	return data, dataIndexes, nil
 }
 
 func (bigtable *Bigtable) GetEth1ERC20ForAddress(prefix string, limit int64) ([]*types.Eth1ERC20Indexed, string, error) {
@@ -3192,7 +3263,7 @@ func (bigtable *Bigtable) GetAddressNames(addresses map[string]string) error {
 
 	for address, label := range addresses {
 		if label == "" {
-			keys = append(keys, fmt.Sprintf("%s:%x", bigtable.chainId, strings.TrimPrefix(address, "0x")))
+			keys = append(keys, fmt.Sprintf("%s:%s", bigtable.chainId, strings.TrimPrefix(address, "0x")))
 		}
 	}
 
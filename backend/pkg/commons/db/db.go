@@ -1401,6 +1401,56 @@ func GetEpochWithdrawals(epoch uint64) ([]*types.WithdrawalsNotification, error)
 	return withdrawals, nil
 }
 
+func GetEpochConsolidationRequests(epoch uint64) ([]*types.ConsolidationNotification, error) {
+	var consolidations []*types.ConsolidationNotification
+
+	err := ReaderDb.Select(&consolidations, `
+	SELECT
+		c.block_slot as slot,
+		c.source_address,
+		c.source_pubkey,
+		vs.validatorindex as source_index,
+		c.target_pubkey,
+		vt.validatorindex as target_index
+	FROM blocks_consolidation_requests c
+	INNER JOIN blocks b ON b.blockroot = c.block_root AND b.status = '1'
+	LEFT JOIN validators vs on vs.pubkey = c.source_pubkey
+	LEFT JOIN validators vt on vt.pubkey = c.target_pubkey
+	WHERE c.block_slot >= $1 AND c.block_slot < $2 ORDER BY c.block_slot, c.request_index`, epoch*utils.Config.Chain.ClConfig.SlotsPerEpoch, (epoch+1)*utils.Config.Chain.ClConfig.SlotsPerEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("error getting blocks_consolidation_requests for epoch: %d: %w", epoch, err)
+	}
+
+	return consolidations, nil
+}
+
+// GetEpochMissedBestBids returns the proposals of the epoch that were worth less than the best bid on their parent block.
+// the value of a locally built block is the reward of its fee recipient.
+func GetEpochMissedBestBids(epoch uint64) ([]*types.MissedBestBidNotification, error) {
+	var proposals []*types.MissedBestBidNotification
+
+	err := ReaderDb.Select(&proposals, `
+	SELECT
+		b.slot,
+		b.proposer,
+		v.pubkey,
+		(COALESCE(rb.value, ROUND(ep.fee_recipient_reward * 1e18), 0) / 1e18)::float8 AS delivered_value,
+		(bb.value / 1e18)::float8 AS best_bid,
+		bb.tag_id AS best_bid_relay
+	FROM blocks b
+	INNER JOIN relays_best_bids bb ON bb.slot = b.slot AND bb.parent_hash = b.exec_parent_hash
+	INNER JOIN validators v ON v.validatorindex = b.proposer
+	LEFT JOIN execution_payloads ep ON ep.block_hash = b.exec_block_hash
+	LEFT JOIN LATERAL (SELECT MAX(value) AS value FROM relays_blocks WHERE relays_blocks.exec_block_hash = b.exec_block_hash) rb ON true
+	WHERE b.epoch = $1 AND b.status = '1' AND bb.value > COALESCE(rb.value, ROUND(ep.fee_recipient_reward * 1e18), 0)
+	ORDER BY b.slot`, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("error getting proposals below the best bid for epoch: %d: %w", epoch, err)
+	}
+
+	return proposals, nil
+}
+
 func GetValidatorWithdrawals(validator uint64, limit uint64, offset uint64, orderBy string, orderDir string) ([]*types.Withdrawals, error) {
 	var withdrawals []*types.Withdrawals
 	if limit == 0 {
@@ -2034,7 +2084,9 @@ func GetWithdrawableValidatorCount(epoch uint64) (uint64, error) {
         WHERE DAY = (SELECT COALESCE(MAX(day), 0) FROM validator_stats_status)) as stats
 	ON stats.validatorindex = validators.validatorindex
 	WHERE
-		validators.withdrawalcredentials LIKE '\x01' || '%'::bytea AND ((stats.end_effective_balance = $1 AND stats.end_balance > $1) OR (validators.withdrawableepoch <= $2 AND stats.end_balance > 0));`, utils.Config.Chain.ClConfig.MaxEffectiveBalance, epoch)
+		(validators.withdrawalcredentials LIKE '\x01' || '%'::bytea AND ((stats.end_effective_balance = $1 AND stats.end_balance > $1) OR (validators.withdrawableepoch <= $3 AND stats.end_balance > 0))) OR
+		(validators.withdrawalcredentials LIKE '\x02' || '%'::bytea AND ((stats.end_effective_balance = $2 AND stats.end_balance > $2) OR (validators.withdrawableepoch <= $3 AND stats.end_balance > 0)));`,
+		utils.Config.Chain.ClConfig.MinActivationBalance, utils.Config.Chain.ClConfig.MaxEffectiveBalanceElectra, epoch)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return 0, nil
@@ -7,6 +7,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -23,8 +25,11 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/lib/pq"
 	go_ens "github.com/wealdtech/go-ens/v3"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // https://etherscan.io/tx/0x9fec76750a504e5610643d1882e3b07f4fc786acf7b9e6680697bb7165de1165#eventlog
@@ -32,22 +37,15 @@ import (
 // It transforms the logs contained within a block and indexes ens relevant transactions and tags changes (to be verified from the node in a separate process)
 // ==================================================
 //
-// It indexes transactions
+// It indexes registrations and renewals of .eth names
 //
 // - by hashed ens name
 // Row:    <chainID>:ENS:I:H:<nameHash>:<txHash>
 // Family: f
-// Column: nil
-// Cell:   nil
+// Column: <logIndex>
+// Cell:   Proto<EnsNameRegistered> (renewals carry no owner)
 // Example scan: "5:ENS:I:H:4ae569dd0aa2f6e9207e41423c956d0d27cbc376a499ee8d90fe1d84489ae9d1:e627ae94bd16eb1ed8774cd4003fc25625159f13f8a2612cc1c7f8d2ab11b1d7"
 //
-// - by address
-// Row:    <chainID>:ENS:I:A:<address>:<txHash>
-// Family: f
-// Column: nil
-// Cell:   nil
-// Example scan: "5:ENS:I:A:05579fadcf7cc6544f7aa018a2726c85251600c5:e627ae94bd16eb1ed8774cd4003fc25625159f13f8a2612cc1c7f8d2ab11b1d7"
-//
 // ==================================================
 //
 // Track for later verification via the node ("set dirty")
@@ -81,6 +79,65 @@ func (bigtable *Bigtable) TransformEnsNameRegistered(blk *types.Eth1Block, cache
 		metrics.TaskDuration.WithLabelValues("bt_transform_ens").Observe(time.Since(startTime).Seconds())
 	}()
 
+	keys, history, err := bigtable.transformEnsLogs(blk)
+	if err != nil || keys == nil {
+		return nil, nil, err
+	}
+	bulkData = &types.BulkMutations{}
+	for key := range keys {
+		mut := gcp_bigtable.NewMutation()
+		mut.Set(DEFAULT_FAMILY, key, gcp_bigtable.Timestamp(0), nil)
+
+		bulkData.Keys = append(bulkData.Keys, key)
+		bulkData.Muts = append(bulkData.Muts, mut)
+	}
+	if err := appendEnsNameHistoryMutations(bulkData, history); err != nil {
+		return nil, nil, err
+	}
+	return bulkData, &types.BulkMutations{}, nil
+}
+
+// TransformEnsNameHistory only returns the name history rows of TransformEnsNameRegistered, it is used to backfill the
+// history of blocks that were indexed before the history existed without marking their names for verification again
+func (bigtable *Bigtable) TransformEnsNameHistory(blk *types.Eth1Block, cache *freecache.Cache) (bulkData *types.BulkMutations, bulkMetadataUpdates *types.BulkMutations, err error) {
+	keys, history, err := bigtable.transformEnsLogs(blk)
+	if err != nil || keys == nil {
+		return nil, nil, err
+	}
+	bulkData = &types.BulkMutations{}
+	if err := appendEnsNameHistoryMutations(bulkData, history); err != nil {
+		return nil, nil, err
+	}
+	return bulkData, &types.BulkMutations{}, nil
+}
+
+// ensNameHistoryRow is a registration or renewal of a .eth name, stored in the column of its log index
+type ensNameHistoryRow struct {
+	Key      string
+	LogIndex int
+	Event    *types.EnsNameRegistered
+}
+
+func appendEnsNameHistoryMutations(bulkData *types.BulkMutations, history []ensNameHistoryRow) error {
+	muts := make(map[string]*gcp_bigtable.Mutation)
+	for _, row := range history {
+		b, err := proto.Marshal(row.Event)
+		if err != nil {
+			return err
+		}
+		if muts[row.Key] == nil {
+			muts[row.Key] = gcp_bigtable.NewMutation()
+			bulkData.Keys = append(bulkData.Keys, row.Key)
+			bulkData.Muts = append(bulkData.Muts, muts[row.Key])
+		}
+		muts[row.Key].Set(DEFAULT_FAMILY, fmt.Sprintf("%d", row.LogIndex), gcp_bigtable.Timestamp(0), b)
+	}
+	return nil
+}
+
+// transformEnsLogs returns the keys of the names, hashes and addresses to verify and the name history rows of the ens
+// events in the block, the keys are nil on chains without ens
+func (bigtable *Bigtable) transformEnsLogs(blk *types.Eth1Block) (map[string]bool, []ensNameHistoryRow, error) {
 	var ensCrontractAddresses map[string]string
 	switch bigtable.chainId {
 	case "1":
@@ -93,10 +150,36 @@ func (bigtable *Bigtable) TransformEnsNameRegistered(blk *types.Eth1Block, cache
 		return nil, nil, nil
 	}
 
-	bulkData = &types.BulkMutations{}
-	bulkMetadataUpdates = &types.BulkMutations{}
 	keys := make(map[string]bool)
+	var history []ensNameHistoryRow
 	ethLog := gethtypes.Log{}
+	var err error
+
+	// indexes a registration or renewal (owner is nil) of a .eth name for the name history
+	indexNameHistory := func(tx *types.Eth1Transaction, logIndex int, name string, label [32]byte, owner *common.Address, expires *big.Int) error {
+		nameHash, err := go_ens.NameHash(name + ".eth")
+		if err != nil {
+			return err
+		}
+		entry := &types.EnsNameRegistered{
+			BlockNumber:      blk.GetNumber(),
+			RegisterContract: ethLog.Address.Bytes(),
+			Time:             blk.GetTime(),
+			Label:            label[:],
+			Node:             nameHash[:],
+			Name:             []byte(name),
+			Expires:          timestamppb.New(time.Unix(expires.Int64(), 0)),
+		}
+		if owner != nil {
+			entry.Owner = owner.Bytes()
+		}
+		history = append(history, ensNameHistoryRow{
+			Key:      fmt.Sprintf("%s:ENS:I:H:%x:%x", bigtable.chainId, nameHash, tx.GetHash()),
+			LogIndex: logIndex,
+			Event:    entry,
+		})
+		return nil
+	}
 
 	for i, tx := range blk.GetTransactions() {
 		if i >= TX_PER_BLOCK_LIMIT {
@@ -181,6 +264,10 @@ func (bigtable *Bigtable) TransformEnsNameRegistered(blk *types.Eth1Block, cache
 						}
 						keys[fmt.Sprintf("%s:ENS:V:N:%s", bigtable.chainId, r.Name)] = true
 						keys[fmt.Sprintf("%s:ENS:V:A:%x", bigtable.chainId, r.Owner)] = true
+						if err = indexNameHistory(tx, j, r.Name, r.Label, &r.Owner, r.Expires); err != nil {
+							logFields["error"] = err
+							log.WarnWithFields(logFields, "error indexing ens-name history")
+						}
 					} else if bytes.Equal(lTopic, ensContracts.ENSETHRegistrarControllerParsedABI.Events["NameRenewed"].ID.Bytes()) {
 						logFields["event"] = "NameRenewed"
 						r := &ensContracts.ENSETHRegistrarControllerNameRenewed{}
@@ -196,6 +283,10 @@ func (bigtable *Bigtable) TransformEnsNameRegistered(blk *types.Eth1Block, cache
 							continue
 						}
 						keys[fmt.Sprintf("%s:ENS:V:N:%s", bigtable.chainId, r.Name)] = true
+						if err = indexNameHistory(tx, j, r.Name, r.Label, nil, r.Expires); err != nil {
+							logFields["error"] = err
+							log.WarnWithFields(logFields, "error indexing ens-name history")
+						}
 					}
 				} else if ensContract == "OldEnsRegistrarController" {
 					if bytes.Equal(lTopic, ensContracts.ENSOldRegistrarControllerParsedABI.Events["NameRegistered"].ID.Bytes()) {
@@ -214,6 +305,10 @@ func (bigtable *Bigtable) TransformEnsNameRegistered(blk *types.Eth1Block, cache
 						}
 						keys[fmt.Sprintf("%s:ENS:V:N:%s", bigtable.chainId, r.Name)] = true
 						keys[fmt.Sprintf("%s:ENS:V:A:%x", bigtable.chainId, r.Owner)] = true
+						if err = indexNameHistory(tx, j, r.Name, r.Label, &r.Owner, r.Expires); err != nil {
+							logFields["error"] = err
+							log.WarnWithFields(logFields, "error indexing ens-name history")
+						}
 					} else if bytes.Equal(lTopic, ensContracts.ENSOldRegistrarControllerParsedABI.Events["NameRenewed"].ID.Bytes()) {
 						logFields["event"] = "NameRenewed"
 						r := &ensContracts.ENSOldRegistrarControllerNameRenewed{}
@@ -229,6 +324,10 @@ func (bigtable *Bigtable) TransformEnsNameRegistered(blk *types.Eth1Block, cache
 							continue
 						}
 						keys[fmt.Sprintf("%s:ENS:V:N:%s", bigtable.chainId, r.Name)] = true
+						if err = indexNameHistory(tx, j, r.Name, r.Label, nil, r.Expires); err != nil {
+							logFields["error"] = err
+							log.WarnWithFields(logFields, "error indexing ens-name history")
+						}
 					}
 				} else {
 					if bytes.Equal(lTopic, ensContracts.ENSPublicResolverParsedABI.Events["NameChanged"].ID.Bytes()) {
@@ -261,15 +360,12 @@ func (bigtable *Bigtable) TransformEnsNameRegistered(blk *types.Eth1Block, cache
 			}
 		}
 	}
-	for key := range keys {
-		mut := gcp_bigtable.NewMutation()
-		mut.Set(DEFAULT_FAMILY, key, gcp_bigtable.Timestamp(0), nil)
-
-		bulkData.Keys = append(bulkData.Keys, key)
-		bulkData.Muts = append(bulkData.Muts, mut)
-	}
+	return keys, history, nil
+}
 
-	return bulkData, bulkMetadataUpdates, nil
+// SaveEnsNameHistory writes name history rows created by TransformEnsNameHistory to the data table
+func (bigtable *Bigtable) SaveEnsNameHistory(history *types.BulkMutations) error {
+	return bigtable.WriteBulk(history, bigtable.tableData, DEFAULT_BATCH_INSERTS)
 }
 
 func verifyName(name string) error {
@@ -675,3 +771,103 @@ func removeEnsName(name string) error {
 	log.Infof("Ens name removed from db: %v", name)
 	return nil
 }
+
+type EnsNameEvent struct {
+	TxHash   common.Hash
+	LogIndex uint64
+	Event    *types.EnsNameRegistered // Owner is empty for renewals
+}
+
+// GetEnsNameHistory returns the indexed registrations and renewals of a .eth name, newest first
+func (bigtable *Bigtable) GetEnsNameHistory(name string) ([]*EnsNameEvent, error) {
+	nameHash, err := go_ens.NameHash(name)
+	if err != nil {
+		return nil, fmt.Errorf("error hashing ens name [%v]: %w", name, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	prefix := fmt.Sprintf("%s:ENS:I:H:%x:", bigtable.chainId, nameHash)
+	events := make([]*EnsNameEvent, 0)
+	err = bigtable.tableData.ReadRows(ctx, gcp_bigtable.PrefixRange(prefix), func(row gcp_bigtable.Row) bool {
+		events = append(events, decodeEnsNameHistoryRow(prefix, row)...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortEnsNameEvents(events)
+	return events, nil
+}
+
+// decodeEnsNameHistoryRow returns the events of a name history row, columns that can't be decoded are skipped
+func decodeEnsNameHistoryRow(prefix string, row gcp_bigtable.Row) []*EnsNameEvent {
+	txHash := common.HexToHash(strings.TrimPrefix(row.Key(), prefix))
+	events := make([]*EnsNameEvent, 0, len(row[DEFAULT_FAMILY]))
+	for _, item := range row[DEFAULT_FAMILY] {
+		logIndex, err := strconv.ParseUint(strings.TrimPrefix(item.Column, DEFAULT_FAMILY+":"), 10, 64)
+		if err != nil {
+			log.Error(err, "error parsing log index of ens-name history entry", 0, map[string]interface{}{"key": row.Key(), "column": item.Column})
+			continue
+		}
+		event := &types.EnsNameRegistered{}
+		if err := proto.Unmarshal(item.Value, event); err != nil {
+			log.Error(err, "error parsing ens-name history entry", 0, map[string]interface{}{"key": row.Key(), "column": item.Column})
+			continue
+		}
+		events = append(events, &EnsNameEvent{TxHash: txHash, LogIndex: logIndex, Event: event})
+	}
+	return events
+}
+
+// sortEnsNameEvents sorts the events newest first
+func sortEnsNameEvents(events []*EnsNameEvent) {
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Event.BlockNumber != events[j].Event.BlockNumber {
+			return events[i].Event.BlockNumber > events[j].Event.BlockNumber
+		}
+		return events[i].LogIndex > events[j].LogIndex
+	})
+}
+
+type EnsEntry struct {
+	NameHash      []byte    `db:"name_hash"`
+	EnsName       string    `db:"ens_name"`
+	Address       []byte    `db:"address"`
+	IsPrimaryName bool      `db:"is_primary_name"`
+	ValidTo       time.Time `db:"valid_to"`
+}
+
+// GetEnsEntriesForNames returns the unexpired entries of the given names
+func GetEnsEntriesForNames(ctx context.Context, names []string) ([]EnsEntry, error) {
+	entries := []EnsEntry{}
+	if len(names) == 0 {
+		return entries, nil
+	}
+	err := ReaderDb.SelectContext(ctx, &entries, `
+	SELECT name_hash, ens_name, address, is_primary_name, valid_to
+	FROM ens
+	WHERE
+		ens_name = ANY($1) AND
+		valid_to >= now()
+	;`, pq.StringArray(names))
+	return entries, err
+}
+
+// GetEnsEntriesForAddresses returns the unexpired entries resolving to the given addresses
+func GetEnsEntriesForAddresses(ctx context.Context, addresses [][]byte) ([]EnsEntry, error) {
+	entries := []EnsEntry{}
+	if len(addresses) == 0 {
+		return entries, nil
+	}
+	err := ReaderDb.SelectContext(ctx, &entries, `
+	SELECT name_hash, ens_name, address, is_primary_name, valid_to
+	FROM ens
+	WHERE
+		address = ANY($1) AND
+		valid_to >= now()
+	ORDER BY is_primary_name DESC, ens_name
+	;`, pq.ByteaArray(addresses))
+	return entries, err
+}
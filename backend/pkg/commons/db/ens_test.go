@@ -0,0 +1,154 @@
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	gcp_bigtable "cloud.google.com/go/bigtable"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	ensContracts "github.com/gobitfly/beaconchain/pkg/commons/contracts/ens"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	go_ens "github.com/wealdtech/go-ens/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEnsNameHistory(t *testing.T) {
+	controller := common.HexToAddress("0x253553366Da8546fC250F225fe3d25d0C782303b")
+	owner := common.HexToAddress("0xa11ce")
+	controllerAbi := ensContracts.ENSETHRegistrarControllerParsedABI
+
+	registered := func(name string, expires int64) *types.Eth1Log {
+		event := controllerAbi.Events["NameRegistered"]
+		data, err := event.Inputs.NonIndexed().Pack(name, big.NewInt(1), big.NewInt(0), big.NewInt(expires))
+		if err != nil {
+			t.Fatalf("error packing registration: %v", err)
+		}
+		return &types.Eth1Log{
+			Address: controller.Bytes(),
+			Data:    data,
+			Topics:  [][]byte{event.ID.Bytes(), crypto.Keccak256([]byte(name)), common.BytesToHash(owner.Bytes()).Bytes()},
+		}
+	}
+	renewed := func(name string, expires int64) *types.Eth1Log {
+		event := controllerAbi.Events["NameRenewed"]
+		data, err := event.Inputs.NonIndexed().Pack(name, big.NewInt(1), big.NewInt(expires))
+		if err != nil {
+			t.Fatalf("error packing renewal: %v", err)
+		}
+		return &types.Eth1Log{
+			Address: controller.Bytes(),
+			Data:    data,
+			Topics:  [][]byte{event.ID.Bytes(), crypto.Keccak256([]byte(name))},
+		}
+	}
+	block := func(number uint64, txs ...*types.Eth1Transaction) *types.Eth1Block {
+		return &types.Eth1Block{Number: number, Time: timestamppb.New(time.Unix(int64(1700000000+number*12), 0)), Transactions: txs}
+	}
+	registration := &types.Eth1Transaction{Hash: common.HexToHash("0x01").Bytes(), Logs: []*types.Eth1Log{registered("foo", 1800000000), registered("bar", 1800000000)}}
+	// the same events emitted by a contract that isn't an ens controller are ignored
+	unrelated := &types.Eth1Transaction{Hash: common.HexToHash("0x02").Bytes(), Logs: []*types.Eth1Log{registered("foo", 1900000000)}}
+	unrelated.Logs[0].Address = common.HexToAddress("0xbad").Bytes()
+	renewal := &types.Eth1Transaction{Hash: common.HexToHash("0x03").Bytes(), Logs: []*types.Eth1Log{renewed("foo", 1850000000)}}
+
+	bt := &Bigtable{chainId: "1"}
+	// rows as they are read back from bigtable
+	rows := make(map[string]gcp_bigtable.Row)
+	for _, blk := range []*types.Eth1Block{block(100, registration, unrelated), block(200, renewal)} {
+		history, metadata, err := bt.TransformEnsNameHistory(blk, nil)
+		if err != nil {
+			t.Fatalf("block %d: unexpected error: %v", blk.Number, err)
+		}
+		if len(metadata.Keys) != 0 {
+			t.Errorf("block %d: expected no metadata updates, got %v", blk.Number, metadata.Keys)
+		}
+		// the names are not marked for verification again when backfilling
+		for _, key := range history.Keys {
+			if !strings.HasPrefix(key, "1:ENS:I:H:") {
+				t.Errorf("block %d: unexpected row %s", blk.Number, key)
+			}
+		}
+		all, _, err := bt.TransformEnsNameRegistered(blk, nil)
+		if err != nil {
+			t.Fatalf("block %d: unexpected error: %v", blk.Number, err)
+		}
+		if !slices.Contains(all.Keys, "1:ENS:V:N:foo") || len(all.Keys) <= len(history.Keys) {
+			t.Errorf("block %d: expected the names to be marked for verification when indexing, got %v", blk.Number, all.Keys)
+		}
+
+		_, historyRows, err := bt.transformEnsLogs(blk)
+		if err != nil {
+			t.Fatalf("block %d: unexpected error: %v", blk.Number, err)
+		}
+		if len(historyRows) != len(history.Keys) {
+			t.Errorf("block %d: expected a row per transaction and name, got %d events in %d rows", blk.Number, len(historyRows), len(history.Keys))
+		}
+		for _, r := range historyRows {
+			value, err := proto.Marshal(r.Event)
+			if err != nil {
+				t.Fatalf("error encoding event: %v", err)
+			}
+			rows[r.Key] = gcp_bigtable.Row{DEFAULT_FAMILY: append(rows[r.Key][DEFAULT_FAMILY], gcp_bigtable.ReadItem{
+				Row:    r.Key,
+				Column: fmt.Sprintf("%s:%d", DEFAULT_FAMILY, r.LogIndex),
+				Value:  value,
+			})}
+		}
+	}
+
+	history := func(name string) []*EnsNameEvent {
+		nameHash, err := go_ens.NameHash(name)
+		if err != nil {
+			t.Fatalf("error hashing name: %v", err)
+		}
+		prefix := fmt.Sprintf("1:ENS:I:H:%x:", nameHash)
+		var events []*EnsNameEvent
+		for key, row := range rows {
+			if strings.HasPrefix(key, prefix) {
+				events = append(events, decodeEnsNameHistoryRow(prefix, row)...)
+			}
+		}
+		sortEnsNameEvents(events)
+		return events
+	}
+
+	events := history("foo.eth")
+	if len(events) != 2 {
+		t.Fatalf("expected the registration and the renewal, got %d events", len(events))
+	}
+	renewalEvent, registrationEvent := events[0], events[1]
+	if renewalEvent.TxHash != common.HexToHash("0x03") || renewalEvent.Event.BlockNumber != 200 || len(renewalEvent.Event.Owner) != 0 || renewalEvent.Event.Expires.AsTime().Unix() != 1850000000 {
+		t.Errorf("unexpected renewal %+v", renewalEvent)
+	}
+	if registrationEvent.TxHash != common.HexToHash("0x01") || registrationEvent.LogIndex != 0 || registrationEvent.Event.BlockNumber != 100 || !bytes.Equal(registrationEvent.Event.Owner, owner.Bytes()) || registrationEvent.Event.Expires.AsTime().Unix() != 1800000000 {
+		t.Errorf("unexpected registration %+v", registrationEvent)
+	}
+	if string(registrationEvent.Event.Name) != "foo" || registrationEvent.Event.Time.AsTime().Unix() != 1700001200 {
+		t.Errorf("unexpected name %s or time %v of the registration", registrationEvent.Event.Name, registrationEvent.Event.Time.AsTime())
+	}
+
+	events = history("bar.eth")
+	if len(events) != 1 || events[0].LogIndex != 1 || events[0].TxHash != common.HexToHash("0x01") {
+		t.Errorf("expected the registration in the second log, got %+v", events)
+	}
+
+	// columns that can't be decoded are skipped
+	broken := gcp_bigtable.Row{DEFAULT_FAMILY: []gcp_bigtable.ReadItem{
+		{Row: "1:ENS:I:H:00:01", Column: "f:x", Value: nil},
+		{Row: "1:ENS:I:H:00:01", Column: "f:1", Value: []byte{0xff}},
+	}}
+	if events := decodeEnsNameHistoryRow("1:ENS:I:H:00:", broken); len(events) != 0 {
+		t.Errorf("expected no events of a broken row, got %+v", events)
+	}
+
+	// ens is only indexed on chains with ens contracts
+	if history, _, err := (&Bigtable{chainId: "100"}).TransformEnsNameHistory(block(100, registration), nil); err != nil || history != nil {
+		t.Errorf("expected no history on a chain without ens, got %v (%v)", history, err)
+	}
+}
@@ -0,0 +1,574 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	l2Contracts "github.com/gobitfly/beaconchain/pkg/commons/contracts/layer2"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/metrics"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+
+	gcp_bigtable "cloud.google.com/go/bigtable"
+	"github.com/coocood/freecache"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// size of a single blob in bytes
+const blobSize = 131072
+
+// TransformLayer2 accepts an eth1 block and creates bigtable mutations for the rollups configured in Layer2Networks.
+// On the layer 1 the rollup settles on it indexes batch submissions, deposits into and withdrawals out of the rollup,
+// on a rollup itself (the indexer runs with the chain id of the rollup) it indexes the initiated withdrawals so they can be linked to the layer 1.
+// Rows are keyed by the chain id of the rollup.
+// ==================================================
+//
+// Layer 1 side
+//
+// - batches
+// Row:    <l2ChainID>:L2:B:<reversePaddedBlockNumber>:<reversePaddedTxIndex>
+// Family: f
+// Column: data
+// Cell:   Proto<Layer2BatchIndexed>
+// Example scan: "10:L2:B:" returns the most recent batches of chain 10
+//
+// - deposits (layer 1 to layer 2)
+// Row:    <l2ChainID>:L2:D:<reversePaddedBlockNumber>:<reversePaddedTxIndex>:<reversePaddedLogIndex>
+// Family: f
+// Column: data
+// Cell:   Proto<Layer2DepositIndexed>
+// Example scan: "10:L2:D:" returns the most recent deposits into chain 10
+//
+// - withdrawals (layer 2 to layer 1), one row per prove, finalize or execute transaction
+// Row:    <l2ChainID>:L2:W:<reversePaddedBlockNumber>:<reversePaddedTxIndex>:<reversePaddedLogIndex>
+// Family: f
+// Column: data
+// Cell:   Proto<Layer2WithdrawalIndexed>
+// Example scan: "10:L2:W:" returns the most recent withdrawal transactions of chain 10
+//
+// ==================================================
+//
+// Layer 2 side
+//
+// - initiated withdrawals by message id (withdrawal hash for op-stack, outbox position for arbitrum)
+// Row:    <l2ChainID>:L2:M:<messageId>
+// Family: f
+// Column: data
+// Cell:   Proto<Layer2MessageIndexed>
+// Example read: "10:L2:M:4ae569dd0aa2f6e9207e41423c956d0d27cbc376a499ee8d90fe1d84489ae9d1"
+//
+// ==================================================
+
+func (bigtable *Bigtable) TransformLayer2(blk *types.Eth1Block, cache *freecache.Cache) (bulkData *types.BulkMutations, bulkMetadataUpdates *types.BulkMutations, err error) {
+	startTime := time.Now()
+	defer func() {
+		metrics.TaskDuration.WithLabelValues("bt_transform_layer2").Observe(time.Since(startTime).Seconds())
+	}()
+
+	rows, err := bigtable.layer2Rows(blk)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bulkData = &types.BulkMutations{}
+	bulkMetadataUpdates = &types.BulkMutations{}
+	for _, row := range rows {
+		if err := bigtable.addProtoMutation(bulkData, row.key, row.value); err != nil {
+			return nil, nil, err
+		}
+	}
+	return bulkData, bulkMetadataUpdates, nil
+}
+
+// layer2Row is a row of the data table created by TransformLayer2
+type layer2Row struct {
+	key   string
+	value proto.Message
+}
+
+type layer2Rows []layer2Row
+
+func (rows *layer2Rows) add(key string, value proto.Message) {
+	*rows = append(*rows, layer2Row{key: key, value: value})
+}
+
+// layer2Rows returns the rows of all configured rollups for the block. Settlement contracts are only indexed on the
+// layer 1 of a rollup and messages only on the rollup itself, other chains may deploy contracts at the same addresses.
+func (bigtable *Bigtable) layer2Rows(blk *types.Eth1Block) (layer2Rows, error) {
+	rows := layer2Rows{}
+	for _, network := range utils.Config.Layer2Networks {
+		settlementChainId := network.SettlementChainId
+		if settlementChainId == 0 {
+			settlementChainId = utils.Config.Chain.ClConfig.DepositChainID
+		}
+		var err error
+		switch bigtable.chainId {
+		case strconv.FormatUint(network.ChainId, 10):
+			err = transformLayer2Messages(blk, network, &rows)
+		case strconv.FormatUint(settlementChainId, 10):
+			switch network.Stack {
+			case l2Contracts.StackOptimism:
+				err = transformOptimismSettlement(blk, network, &rows)
+			case l2Contracts.StackArbitrum:
+				err = transformArbitrumSettlement(blk, network, &rows)
+			default:
+				err = fmt.Errorf("unknown stack %q of layer 2 network %v", network.Stack, network.Name)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// transformOptimismSettlement indexes batches sent by the batch submitter to the batch inbox and the events of the optimism portal
+func transformOptimismSettlement(blk *types.Eth1Block, network types.Layer2Network, rows *layer2Rows) error {
+	batchInbox := common.HexToAddress(network.BatchInbox)
+	batchSubmitter := common.HexToAddress(network.BatchSubmitter)
+	portal := common.HexToAddress(network.OptimismPortal)
+
+	blockLogIndex := uint64(0)
+	for i, tx := range blk.GetTransactions() {
+		if i >= TX_PER_BLOCK_LIMIT {
+			return fmt.Errorf("unexpected number of transactions in block expected at most %d but got: %v, tx: %x", TX_PER_BLOCK_LIMIT-1, i, tx.GetHash())
+		}
+
+		if common.BytesToAddress(tx.GetTo()) == batchInbox && common.BytesToAddress(tx.GetFrom()) == batchSubmitter {
+			batch := newLayer2Batch(blk, tx)
+			switch {
+			case tx.GetType() == gethtypes.BlobTxType:
+				batch.DataLocation = "blob"
+			case len(tx.GetData()) > 0 && tx.GetData()[0] == 1:
+				// derivation version 1 carries a commitment to data stored with an alt-da provider
+				batch.DataLocation = "alt_da"
+			default:
+				batch.DataLocation = "calldata"
+			}
+			rows.add(fmt.Sprintf("%d:L2:B:%s:%s", network.ChainId, reversedPaddedBlockNumber(blk.GetNumber()), reversePaddedIndex(i, TX_PER_BLOCK_LIMIT)), batch)
+		}
+
+		for j, txLog := range tx.GetLogs() {
+			if j >= ITX_PER_TX_LIMIT {
+				return fmt.Errorf("unexpected number of logs in block expected at most %d but got: %v tx: %x", ITX_PER_TX_LIMIT-1, j, tx.GetHash())
+			}
+			logIndex := blockLogIndex
+			blockLogIndex++
+			if common.BytesToAddress(txLog.GetAddress()) != portal || len(txLog.GetTopics()) == 0 {
+				continue
+			}
+			ethLog := toGethLog(blk, tx, i, j, txLog)
+			logFields := map[string]interface{}{
+				"block":    blk.GetNumber(),
+				"tx":       tx.GetHash(),
+				"logIndex": j,
+				"network":  network.Name,
+			}
+			key := fmt.Sprintf("%s:%s:%s", reversedPaddedBlockNumber(blk.GetNumber()), reversePaddedIndex(i, TX_PER_BLOCK_LIMIT), reversePaddedIndex(j, ITX_PER_TX_LIMIT))
+
+			switch {
+			case bytes.Equal(txLog.GetTopics()[0], l2Contracts.OptimismPortalParsedABI.Events["TransactionDeposited"].ID.Bytes()):
+				r := &l2Contracts.OptimismPortalTransactionDeposited{}
+				if err := l2Contracts.OptimismPortalContract.UnpackLog(r, "TransactionDeposited", ethLog); err != nil {
+					logFields["error"] = err
+					log.WarnWithFields(logFields, "error unpacking layer 2 deposit")
+					continue
+				}
+				deposit, err := l2Contracts.DecodeOptimismDeposit(r.From, r.To, r.Version, r.OpaqueData)
+				if err != nil {
+					logFields["error"] = err
+					log.WarnWithFields(logFields, "error decoding layer 2 deposit")
+					continue
+				}
+				l2TxHash, err := deposit.L2TxHash(common.BytesToHash(blk.GetHash()), logIndex)
+				if err != nil {
+					return fmt.Errorf("error computing layer 2 deposit tx hash of tx %x: %w", tx.GetHash(), err)
+				}
+				indexed := &types.Layer2DepositIndexed{
+					L1TxHash:    tx.GetHash(),
+					BlockNumber: blk.GetNumber(),
+					Time:        blk.GetTime(),
+					Kind:        "deposit",
+					From:        deposit.From.Bytes(),
+					Value:       deposit.Mint.Bytes(),
+					L2TxHash:    l2TxHash.Bytes(),
+				}
+				if deposit.To != nil {
+					indexed.To = deposit.To.Bytes()
+				}
+				rows.add(fmt.Sprintf("%d:L2:D:%s", network.ChainId, key), indexed)
+			case bytes.Equal(txLog.GetTopics()[0], l2Contracts.OptimismPortalParsedABI.Events["WithdrawalProven"].ID.Bytes()):
+				r := &l2Contracts.OptimismPortalWithdrawalProven{}
+				if err := l2Contracts.OptimismPortalContract.UnpackLog(r, "WithdrawalProven", ethLog); err != nil {
+					logFields["error"] = err
+					log.WarnWithFields(logFields, "error unpacking layer 2 withdrawal")
+					continue
+				}
+				indexed := &types.Layer2WithdrawalIndexed{
+					L1TxHash:    tx.GetHash(),
+					BlockNumber: blk.GetNumber(),
+					Time:        blk.GetTime(),
+					Status:      "proven",
+					MessageId:   r.WithdrawalHash[:],
+					From:        r.From.Bytes(),
+					To:          r.To.Bytes(),
+					Success:     true,
+				}
+				rows.add(fmt.Sprintf("%d:L2:W:%s", network.ChainId, key), indexed)
+			case bytes.Equal(txLog.GetTopics()[0], l2Contracts.OptimismPortalParsedABI.Events["WithdrawalFinalized"].ID.Bytes()):
+				r := &l2Contracts.OptimismPortalWithdrawalFinalized{}
+				if err := l2Contracts.OptimismPortalContract.UnpackLog(r, "WithdrawalFinalized", ethLog); err != nil {
+					logFields["error"] = err
+					log.WarnWithFields(logFields, "error unpacking layer 2 withdrawal")
+					continue
+				}
+				indexed := &types.Layer2WithdrawalIndexed{
+					L1TxHash:    tx.GetHash(),
+					BlockNumber: blk.GetNumber(),
+					Time:        blk.GetTime(),
+					Status:      "finalized",
+					MessageId:   r.WithdrawalHash[:],
+					Success:     r.Success,
+				}
+				rows.add(fmt.Sprintf("%d:L2:W:%s", network.ChainId, key), indexed)
+			}
+		}
+	}
+	return nil
+}
+
+// transformArbitrumSettlement indexes the batches of the sequencer inbox, messages delivered to the bridge and executed outbox transactions
+func transformArbitrumSettlement(blk *types.Eth1Block, network types.Layer2Network, rows *layer2Rows) error {
+	sequencerInbox := common.HexToAddress(network.SequencerInbox)
+	bridge := common.HexToAddress(network.Bridge)
+	outbox := common.HexToAddress(network.Outbox)
+	l2ChainId := new(big.Int).SetUint64(network.ChainId)
+
+	type delivery struct {
+		logIndex int
+		message  *l2Contracts.ArbitrumBridgeMessageDelivered
+		data     []byte
+	}
+
+	for i, tx := range blk.GetTransactions() {
+		if i >= TX_PER_BLOCK_LIMIT {
+			return fmt.Errorf("unexpected number of transactions in block expected at most %d but got: %v, tx: %x", TX_PER_BLOCK_LIMIT-1, i, tx.GetHash())
+		}
+
+		// the bridge emits the message first, the inbox that received it emits the message data afterwards
+		deliveries := make([]*delivery, 0)
+		for j, txLog := range tx.GetLogs() {
+			if j >= ITX_PER_TX_LIMIT {
+				return fmt.Errorf("unexpected number of logs in block expected at most %d but got: %v tx: %x", ITX_PER_TX_LIMIT-1, j, tx.GetHash())
+			}
+			if len(txLog.GetTopics()) == 0 {
+				continue
+			}
+			address := common.BytesToAddress(txLog.GetAddress())
+			topic := txLog.GetTopics()[0]
+			ethLog := toGethLog(blk, tx, i, j, txLog)
+			logFields := map[string]interface{}{
+				"block":    blk.GetNumber(),
+				"tx":       tx.GetHash(),
+				"logIndex": j,
+				"network":  network.Name,
+			}
+			key := fmt.Sprintf("%s:%s:%s", reversedPaddedBlockNumber(blk.GetNumber()), reversePaddedIndex(i, TX_PER_BLOCK_LIMIT), reversePaddedIndex(j, ITX_PER_TX_LIMIT))
+
+			switch {
+			case address == sequencerInbox && bytes.Equal(topic, l2Contracts.ArbitrumSequencerInboxParsedABI.Events["SequencerBatchDelivered"].ID.Bytes()):
+				r := &l2Contracts.ArbitrumSequencerInboxSequencerBatchDelivered{}
+				if err := l2Contracts.ArbitrumSequencerInboxContract.UnpackLog(r, "SequencerBatchDelivered", ethLog); err != nil {
+					logFields["error"] = err
+					log.WarnWithFields(logFields, "error unpacking layer 2 batch")
+					continue
+				}
+				batch := newLayer2Batch(blk, tx)
+				batch.HasBatchNumber = true
+				batch.BatchNumber = r.BatchSequenceNumber.Uint64()
+				switch r.DataLocation {
+				case l2Contracts.ArbitrumBatchDataLocationBlob:
+					batch.DataLocation = "blob"
+				case l2Contracts.ArbitrumBatchDataLocationNoData:
+					batch.DataLocation = "none"
+					batch.DataSize = 0
+				default:
+					batch.DataLocation = "calldata"
+				}
+				rows.add(fmt.Sprintf("%d:L2:B:%s:%s", network.ChainId, reversedPaddedBlockNumber(blk.GetNumber()), reversePaddedIndex(i, TX_PER_BLOCK_LIMIT)), batch)
+			case address == bridge && bytes.Equal(topic, l2Contracts.ArbitrumBridgeParsedABI.Events["MessageDelivered"].ID.Bytes()):
+				r := &l2Contracts.ArbitrumBridgeMessageDelivered{}
+				if err := l2Contracts.ArbitrumBridgeContract.UnpackLog(r, "MessageDelivered", ethLog); err != nil {
+					logFields["error"] = err
+					log.WarnWithFields(logFields, "error unpacking layer 2 message")
+					continue
+				}
+				deliveries = append(deliveries, &delivery{logIndex: j, message: r})
+			case bytes.Equal(topic, l2Contracts.ArbitrumInboxParsedABI.Events["InboxMessageDelivered"].ID.Bytes()):
+				r := &l2Contracts.ArbitrumInboxInboxMessageDelivered{}
+				if err := l2Contracts.ArbitrumInboxContract.UnpackLog(r, "InboxMessageDelivered", ethLog); err != nil {
+					logFields["error"] = err
+					log.WarnWithFields(logFields, "error unpacking layer 2 message data")
+					continue
+				}
+				for _, d := range deliveries {
+					if d.message.MessageIndex.Cmp(r.MessageNum) == 0 && d.message.Inbox == address {
+						d.data = r.Data
+					}
+				}
+			case address == outbox && bytes.Equal(topic, l2Contracts.ArbitrumOutboxParsedABI.Events["OutBoxTransactionExecuted"].ID.Bytes()):
+				r := &l2Contracts.ArbitrumOutboxOutBoxTransactionExecuted{}
+				if err := l2Contracts.ArbitrumOutboxContract.UnpackLog(r, "OutBoxTransactionExecuted", ethLog); err != nil {
+					logFields["error"] = err
+					log.WarnWithFields(logFields, "error unpacking layer 2 withdrawal")
+					continue
+				}
+				indexed := &types.Layer2WithdrawalIndexed{
+					L1TxHash:    tx.GetHash(),
+					BlockNumber: blk.GetNumber(),
+					Time:        blk.GetTime(),
+					Status:      "executed",
+					MessageId:   common.BigToHash(r.TransactionIndex).Bytes(),
+					From:        r.L2Sender.Bytes(),
+					To:          r.To.Bytes(),
+					Success:     true,
+				}
+				rows.add(fmt.Sprintf("%d:L2:W:%s", network.ChainId, key), indexed)
+			}
+		}
+
+		for _, d := range deliveries {
+			indexed := &types.Layer2DepositIndexed{
+				L1TxHash:        tx.GetHash(),
+				BlockNumber:     blk.GetNumber(),
+				Time:            blk.GetTime(),
+				From:            d.message.Sender.Bytes(),
+				HasMessageIndex: true,
+				MessageIndex:    d.message.MessageIndex.Uint64(),
+			}
+			switch d.message.Kind {
+			case l2Contracts.ArbitrumMessageKindEthDeposit:
+				indexed.Kind = "deposit"
+			case l2Contracts.ArbitrumMessageKindSubmitRetryable:
+				indexed.Kind = "retryable"
+			case l2Contracts.ArbitrumMessageKindL2Message, l2Contracts.ArbitrumMessageKindL2FundedByL1:
+				indexed.Kind = "message"
+			default:
+				// batch posting reports and other internal messages
+				continue
+			}
+			if d.data != nil && indexed.Kind != "message" {
+				deposit, err := l2Contracts.DecodeArbitrumDeposit(d.message.Kind, d.message.MessageIndex, d.message.Sender, d.message.BaseFeeL1, d.data)
+				if err != nil {
+					log.WarnWithFields(log.Fields{"block": blk.GetNumber(), "tx": tx.GetHash(), "logIndex": d.logIndex, "network": network.Name, "error": err}, "error decoding layer 2 deposit")
+				} else {
+					l2TxHash, err := deposit.L2TxHash(l2ChainId)
+					if err != nil {
+						return fmt.Errorf("error computing layer 2 deposit tx hash of tx %x: %w", tx.GetHash(), err)
+					}
+					indexed.L2TxHash = l2TxHash.Bytes()
+					indexed.Value = deposit.Value.Bytes()
+					if deposit.To != nil {
+						indexed.To = deposit.To.Bytes()
+					}
+				}
+			}
+			key := fmt.Sprintf("%d:L2:D:%s:%s:%s", network.ChainId, reversedPaddedBlockNumber(blk.GetNumber()), reversePaddedIndex(i, TX_PER_BLOCK_LIMIT), reversePaddedIndex(d.logIndex, ITX_PER_TX_LIMIT))
+			rows.add(key, indexed)
+		}
+	}
+	return nil
+}
+
+// transformLayer2Messages indexes the withdrawals initiated on the rollup
+func transformLayer2Messages(blk *types.Eth1Block, network types.Layer2Network, rows *layer2Rows) error {
+	for i, tx := range blk.GetTransactions() {
+		if i >= TX_PER_BLOCK_LIMIT {
+			return fmt.Errorf("unexpected number of transactions in block expected at most %d but got: %v, tx: %x", TX_PER_BLOCK_LIMIT-1, i, tx.GetHash())
+		}
+		for j, txLog := range tx.GetLogs() {
+			if j >= ITX_PER_TX_LIMIT {
+				return fmt.Errorf("unexpected number of logs in block expected at most %d but got: %v tx: %x", ITX_PER_TX_LIMIT-1, j, tx.GetHash())
+			}
+			if len(txLog.GetTopics()) == 0 {
+				continue
+			}
+			address := common.BytesToAddress(txLog.GetAddress())
+			topic := txLog.GetTopics()[0]
+			ethLog := toGethLog(blk, tx, i, j, txLog)
+			logFields := map[string]interface{}{
+				"block":    blk.GetNumber(),
+				"tx":       tx.GetHash(),
+				"logIndex": j,
+				"network":  network.Name,
+			}
+
+			var messageId []byte
+			indexed := &types.Layer2MessageIndexed{
+				L2TxHash:    tx.GetHash(),
+				BlockNumber: blk.GetNumber(),
+				Time:        blk.GetTime(),
+			}
+			switch {
+			case network.Stack == l2Contracts.StackOptimism && address == l2Contracts.L2ToL1MessagePasserAddress && bytes.Equal(topic, l2Contracts.L2ToL1MessagePasserParsedABI.Events["MessagePassed"].ID.Bytes()):
+				r := &l2Contracts.L2ToL1MessagePasserMessagePassed{}
+				if err := l2Contracts.L2ToL1MessagePasserContract.UnpackLog(r, "MessagePassed", ethLog); err != nil {
+					logFields["error"] = err
+					log.WarnWithFields(logFields, "error unpacking layer 2 withdrawal")
+					continue
+				}
+				messageId = r.WithdrawalHash[:]
+				indexed.Sender = r.Sender.Bytes()
+				indexed.Target = r.Target.Bytes()
+				indexed.Value = r.Value.Bytes()
+			case network.Stack == l2Contracts.StackArbitrum && address == l2Contracts.ArbSysAddress && bytes.Equal(topic, l2Contracts.ArbSysParsedABI.Events["L2ToL1Tx"].ID.Bytes()):
+				r := &l2Contracts.ArbSysL2ToL1Tx{}
+				if err := l2Contracts.ArbSysContract.UnpackLog(r, "L2ToL1Tx", ethLog); err != nil {
+					logFields["error"] = err
+					log.WarnWithFields(logFields, "error unpacking layer 2 withdrawal")
+					continue
+				}
+				messageId = common.BigToHash(r.Position).Bytes()
+				indexed.Sender = r.Caller.Bytes()
+				indexed.Target = r.Destination.Bytes()
+				indexed.Value = r.Callvalue.Bytes()
+			default:
+				continue
+			}
+			rows.add(fmt.Sprintf("%d:L2:M:%x", network.ChainId, messageId), indexed)
+		}
+	}
+	return nil
+}
+
+func newLayer2Batch(blk *types.Eth1Block, tx *types.Eth1Transaction) *types.Layer2BatchIndexed {
+	fee, blobFee := blobTxFees(tx)
+	batch := &types.Layer2BatchIndexed{
+		TxHash:      tx.GetHash(),
+		BlockNumber: blk.GetNumber(),
+		Time:        blk.GetTime(),
+		Submitter:   tx.GetFrom(),
+		DataSize:    uint64(len(tx.GetData())),
+		BlobCount:   uint64(len(tx.GetBlobVersionedHashes())),
+		TxFee:       fee,
+		BlobTxFee:   blobFee,
+	}
+	if batch.BlobCount > 0 {
+		batch.DataSize = batch.BlobCount * blobSize
+	}
+	return batch
+}
+
+func toGethLog(blk *types.Eth1Block, tx *types.Eth1Transaction, txIndex, logIndex int, txLog *types.Eth1Log) gethtypes.Log {
+	topics := make([]common.Hash, 0, len(txLog.GetTopics()))
+	for _, t := range txLog.GetTopics() {
+		topics = append(topics, common.BytesToHash(t))
+	}
+	return gethtypes.Log{
+		Address:     common.BytesToAddress(txLog.GetAddress()),
+		Data:        txLog.GetData(),
+		Topics:      topics,
+		BlockNumber: blk.GetNumber(),
+		TxHash:      common.BytesToHash(tx.GetHash()),
+		TxIndex:     uint(txIndex),
+		BlockHash:   common.BytesToHash(blk.GetHash()),
+		Index:       uint(logIndex),
+		Removed:     txLog.GetRemoved(),
+	}
+}
+
+// addProtoMutation queues the marshalled value as the data cell of the row with the given key
+func (bigtable *Bigtable) addProtoMutation(bulkData *types.BulkMutations, key string, value proto.Message) error {
+	b, err := proto.Marshal(value)
+	if err != nil {
+		return err
+	}
+	mut := gcp_bigtable.NewMutation()
+	mut.Set(DEFAULT_FAMILY, DATA_COLUMN, gcp_bigtable.Timestamp(0), b)
+
+	bulkData.Keys = append(bulkData.Keys, key)
+	bulkData.Muts = append(bulkData.Muts, mut)
+	return nil
+}
+
+// GetLayer2Batches returns the batches of a rollup starting after the given page token (or prefix "<l2ChainID>:L2:B:"), newest first
+func (bigtable *Bigtable) GetLayer2Batches(pageToken string, limit int64) ([]*types.Layer2BatchIndexed, []string, error) {
+	return readProtoRows[types.Layer2BatchIndexed](bigtable, pageToken, 3, limit)
+}
+
+// GetLayer2Deposits returns the deposits into a rollup starting after the given page token (or prefix "<l2ChainID>:L2:D:"), newest first
+func (bigtable *Bigtable) GetLayer2Deposits(pageToken string, limit int64) ([]*types.Layer2DepositIndexed, []string, error) {
+	return readProtoRows[types.Layer2DepositIndexed](bigtable, pageToken, 3, limit)
+}
+
+// GetLayer2Withdrawals returns the withdrawal transactions of a rollup starting after the given page token (or prefix "<l2ChainID>:L2:W:"), newest first
+func (bigtable *Bigtable) GetLayer2Withdrawals(pageToken string, limit int64) ([]*types.Layer2WithdrawalIndexed, []string, error) {
+	return readProtoRows[types.Layer2WithdrawalIndexed](bigtable, pageToken, 3, limit)
+}
+
+// GetLayer2Messages returns the initiating rollup transactions of the given withdrawals, keyed by their hex encoded message id
+func (bigtable *Bigtable) GetLayer2Messages(l2ChainId uint64, messageIds [][]byte) (map[string]*types.Layer2MessageIndexed, error) {
+	result := make(map[string]*types.Layer2MessageIndexed, len(messageIds))
+	if len(messageIds) == 0 {
+		return result, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	prefix := fmt.Sprintf("%d:L2:M:", l2ChainId)
+	keys := make(gcp_bigtable.RowList, 0, len(messageIds))
+	for _, id := range messageIds {
+		keys = append(keys, fmt.Sprintf("%s%x", prefix, id))
+	}
+	err := bigtable.tableData.ReadRows(ctx, keys, func(row gcp_bigtable.Row) bool {
+		message := &types.Layer2MessageIndexed{}
+		if err := proto.Unmarshal(row[DEFAULT_FAMILY][0].Value, message); err != nil {
+			log.Error(err, "error parsing Layer2MessageIndexed data", 0, map[string]interface{}{"key": row.Key()})
+			return true
+		}
+		result[strings.TrimPrefix(row.Key(), prefix)] = message
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// readProtoRows reads up to limit rows following the page token within the table of the page token,
+// the table is made up of the first prefixParts colon separated parts of the page token
+func readProtoRows[T any, PT interface {
+	*T
+	proto.Message
+}](bigtable *Bigtable, pageToken string, prefixParts int, limit int64) ([]*T, []string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	// add \x00 to the row range such that we skip the previous value
+	rowRange := gcp_bigtable.NewRange(pageToken+"\x00", prefixSuccessor(pageToken, prefixParts))
+	data := make([]*T, 0, limit)
+	keys := make([]string, 0, limit)
+	err := bigtable.tableData.ReadRows(ctx, rowRange, func(row gcp_bigtable.Row) bool {
+		value := PT(new(T))
+		if err := proto.Unmarshal(row[DEFAULT_FAMILY][0].Value, value); err != nil {
+			log.Error(err, "error parsing indexed data", 0, map[string]interface{}{"key": row.Key()})
+			return true
+		}
+		data = append(data, (*T)(value))
+		keys = append(keys, row.Key())
+		return true
+	}, gcp_bigtable.LimitRows(limit))
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, keys, nil
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+//go:generate go run ./testdata/layer2 testdata/layer2.json
+
+type layer2Fixture struct {
+	Networks []types.Layer2Network `json:"networks"`
+	Blocks   []struct {
+		ChainId string          `json:"chainId"`
+		Block   json.RawMessage `json:"block"`
+		Rows    []struct {
+			Key   string          `json:"key"`
+			Type  string          `json:"type"`
+			Value json.RawMessage `json:"value"`
+		} `json:"rows"`
+	} `json:"blocks"`
+}
+
+func TestLayer2Rows(t *testing.T) {
+	data, err := os.ReadFile("testdata/layer2.json")
+	if err != nil {
+		t.Fatalf("error reading fixture: %v", err)
+	}
+	var fixture layer2Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		t.Fatalf("error decoding fixture: %v", err)
+	}
+
+	previousConfig := utils.Config
+	t.Cleanup(func() { utils.Config = previousConfig })
+	utils.Config = &types.Config{}
+	utils.Config.Layer2Networks = fixture.Networks
+
+	for _, b := range fixture.Blocks {
+		blk := &types.Eth1Block{}
+		if err := protojson.Unmarshal(b.Block, blk); err != nil {
+			t.Fatalf("chain %s: error decoding block: %v", b.ChainId, err)
+		}
+		rows, err := (&Bigtable{chainId: b.ChainId}).layer2Rows(blk)
+		if err != nil {
+			t.Fatalf("chain %s: unexpected error: %v", b.ChainId, err)
+		}
+
+		got := make(map[string]proto.Message, len(rows))
+		for _, row := range rows {
+			if _, ok := got[row.key]; ok {
+				t.Errorf("chain %s: duplicate row %s", b.ChainId, row.key)
+			}
+			got[row.key] = row.value
+		}
+		if len(rows) != len(b.Rows) {
+			t.Errorf("chain %s: expected %d rows, got %d", b.ChainId, len(b.Rows), len(rows))
+		}
+		for _, expected := range b.Rows {
+			var value proto.Message
+			switch expected.Type {
+			case "batch":
+				value = &types.Layer2BatchIndexed{}
+			case "deposit":
+				value = &types.Layer2DepositIndexed{}
+			case "withdrawal":
+				value = &types.Layer2WithdrawalIndexed{}
+			case "message":
+				value = &types.Layer2MessageIndexed{}
+			default:
+				t.Fatalf("chain %s: unknown row type %s", b.ChainId, expected.Type)
+			}
+			if err := protojson.Unmarshal(expected.Value, value); err != nil {
+				t.Fatalf("chain %s: error decoding row %s: %v", b.ChainId, expected.Key, err)
+			}
+			row, ok := got[expected.Key]
+			if !ok {
+				t.Errorf("chain %s: missing %s row %s", b.ChainId, expected.Type, expected.Key)
+				continue
+			}
+			if !proto.Equal(row, value) {
+				t.Errorf("chain %s: row %s: expected %s, got %s", b.ChainId, expected.Key, protojson.Format(value), protojson.Format(row))
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'create users_val_dashboards_members table';
+CREATE TABLE IF NOT EXISTS users_val_dashboards_members (
+    dashboard_id BIGINT      NOT NULL,
+    user_id      BIGINT      NOT NULL,
+    role         VARCHAR(10) NOT NULL CHECK (role IN ('viewer', 'editor', 'admin')), -- the owner (users_val_dashboards.user_id) is never a member
+    added_by     BIGINT      NOT NULL,
+    added_at     TIMESTAMP   NOT NULL DEFAULT(NOW()),
+    foreign key (dashboard_id) references users_val_dashboards(id) ON DELETE CASCADE,
+    primary key (dashboard_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS users_val_dashboards_members_user_id_idx ON users_val_dashboards_members (user_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'create users_val_dashboards_invites table';
+CREATE TABLE IF NOT EXISTS users_val_dashboards_invites (
+    token        CHAR(40)     NOT NULL,
+    dashboard_id BIGINT       NOT NULL,
+    email        VARCHAR(100) NOT NULL,
+    role         VARCHAR(10)  NOT NULL CHECK (role IN ('viewer', 'editor', 'admin')),
+    invited_by   BIGINT       NOT NULL,
+    created_at   TIMESTAMP    NOT NULL DEFAULT(NOW()),
+    foreign key (dashboard_id) references users_val_dashboards(id) ON DELETE CASCADE,
+    primary key (token)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS users_val_dashboards_invites_dashboard_id_email_idx ON users_val_dashboards_invites (dashboard_id, email);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'create users_val_dashboards_audit_log table';
+CREATE TABLE IF NOT EXISTS users_val_dashboards_audit_log (
+    id           BIGSERIAL   NOT NULL,
+    dashboard_id BIGINT      NOT NULL,
+    user_id      BIGINT      NOT NULL,
+    action       VARCHAR(30) NOT NULL,
+    group_id     SMALLINT,
+    validators   BIGINT[],
+    ts           TIMESTAMP   NOT NULL DEFAULT(NOW()),
+    foreign key (dashboard_id) references users_val_dashboards(id) ON DELETE CASCADE,
+    primary key (id)
+);
+CREATE INDEX IF NOT EXISTS users_val_dashboards_audit_log_dashboard_id_idx ON users_val_dashboards_audit_log (dashboard_id, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'delete users_val_dashboards_audit_log table';
+DROP TABLE IF EXISTS users_val_dashboards_audit_log;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'delete users_val_dashboards_invites table';
+DROP TABLE IF EXISTS users_val_dashboards_invites;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'delete users_val_dashboards_members table';
+DROP TABLE IF EXISTS users_val_dashboards_members;
+-- +goose StatementEnd
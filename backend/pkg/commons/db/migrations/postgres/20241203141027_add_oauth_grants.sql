@@ -0,0 +1,73 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'add third party client columns to table oauth_apps';
+ALTER TABLE oauth_apps ADD COLUMN IF NOT EXISTS client_id VARCHAR(64) UNIQUE; -- NULL for the mobile app, which uses the redirect uri based flow
+ALTER TABLE oauth_apps ADD COLUMN IF NOT EXISTS client_secret_hash CHAR(64); -- NULL for public clients, which must rely on PKCE alone
+ALTER TABLE oauth_apps ADD COLUMN IF NOT EXISTS scopes TEXT[] NOT NULL DEFAULT '{}'; -- scopes the app may request
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'create oauth_authorization_codes table';
+CREATE TABLE IF NOT EXISTS oauth_authorization_codes (
+    code_hash      CHAR(64)     NOT NULL,
+    app_id         INT          NOT NULL,
+    user_id        INT          NOT NULL,
+    redirect_uri   VARCHAR(100) NOT NULL,
+    scopes         TEXT[]       NOT NULL,
+    code_challenge VARCHAR(128) NOT NULL,
+    created_ts     TIMESTAMP    NOT NULL DEFAULT(NOW()),
+    primary key (code_hash)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'create oauth_grants table';
+CREATE TABLE IF NOT EXISTS oauth_grants (
+    id         SERIAL    NOT NULL,
+    app_id     INT       NOT NULL,
+    user_id    INT       NOT NULL,
+    scopes     TEXT[]    NOT NULL,
+    created_ts TIMESTAMP NOT NULL DEFAULT(NOW()),
+    primary key (id)
+);
+CREATE INDEX IF NOT EXISTS oauth_grants_user_id_idx ON oauth_grants (user_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'create oauth_tokens table';
+CREATE TABLE IF NOT EXISTS oauth_tokens (
+    id                 BIGSERIAL NOT NULL,
+    grant_id           INT       NOT NULL,
+    access_token_hash  CHAR(64)  NOT NULL UNIQUE,
+    refresh_token_hash CHAR(64)  NOT NULL UNIQUE,
+    access_expires_ts  TIMESTAMP NOT NULL,
+    refresh_expires_ts TIMESTAMP NOT NULL,
+    rotated            BOOL      NOT NULL DEFAULT 'f', -- rotated refresh tokens are kept to detect reuse
+    created_ts         TIMESTAMP NOT NULL DEFAULT(NOW()),
+    foreign key (grant_id) references oauth_grants(id) ON DELETE CASCADE,
+    primary key (id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'delete oauth_tokens table';
+DROP TABLE IF EXISTS oauth_tokens;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'delete oauth_grants table';
+DROP TABLE IF EXISTS oauth_grants;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'delete oauth_authorization_codes table';
+DROP TABLE IF EXISTS oauth_authorization_codes;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'remove third party client columns from table oauth_apps';
+ALTER TABLE oauth_apps DROP COLUMN IF EXISTS client_id;
+ALTER TABLE oauth_apps DROP COLUMN IF EXISTS client_secret_hash;
+ALTER TABLE oauth_apps DROP COLUMN IF EXISTS scopes;
+-- +goose StatementEnd
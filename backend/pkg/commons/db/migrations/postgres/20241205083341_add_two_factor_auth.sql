@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'create users_totp table';
+CREATE TABLE IF NOT EXISTS users_totp (
+    user_id        INT         NOT NULL,
+    secret         VARCHAR(64) NOT NULL,
+    confirmed      BOOL        NOT NULL DEFAULT 'f', -- only confirmed secrets are enforced
+    last_used_step BIGINT      NOT NULL DEFAULT 0,   -- codes can only be used once
+    created_ts     TIMESTAMP   NOT NULL DEFAULT(NOW()),
+    primary key (user_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'create users_webauthn_credentials table';
+CREATE TABLE IF NOT EXISTS users_webauthn_credentials (
+    id            SERIAL      NOT NULL,
+    user_id       INT         NOT NULL,
+    credential_id BYTEA       NOT NULL UNIQUE,
+    public_key    BYTEA       NOT NULL, -- COSE encoded
+    sign_count    BIGINT      NOT NULL DEFAULT 0,
+    name          VARCHAR(50) NOT NULL,
+    created_ts    TIMESTAMP   NOT NULL DEFAULT(NOW()),
+    last_used_ts  TIMESTAMP,
+    primary key (id)
+);
+CREATE INDEX IF NOT EXISTS users_webauthn_credentials_user_id_idx ON users_webauthn_credentials (user_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'create users_recovery_codes table';
+CREATE TABLE IF NOT EXISTS users_recovery_codes (
+    user_id   INT      NOT NULL,
+    code_hash CHAR(64) NOT NULL,
+    primary key (user_id, code_hash)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'add require_two_factor column to table users_val_dashboards';
+ALTER TABLE users_val_dashboards ADD COLUMN IF NOT EXISTS require_two_factor BOOL NOT NULL DEFAULT 'f';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'remove require_two_factor column from table users_val_dashboards';
+ALTER TABLE users_val_dashboards DROP COLUMN IF EXISTS require_two_factor;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'delete users_recovery_codes table';
+DROP TABLE IF EXISTS users_recovery_codes;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'delete users_webauthn_credentials table';
+DROP TABLE IF EXISTS users_webauthn_credentials;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT 'delete users_totp table';
+DROP TABLE IF EXISTS users_totp;
+-- +goose StatementEnd
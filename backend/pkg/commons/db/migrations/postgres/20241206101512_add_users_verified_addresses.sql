@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'create users_verified_addresses table';
+CREATE TABLE IF NOT EXISTS users_verified_addresses (
+    address     BYTEA     NOT NULL, -- an address can only be linked to one user, it can be used to sign in
+    user_id     INT       NOT NULL,
+    verified_ts TIMESTAMP NOT NULL DEFAULT(NOW()),
+    primary key (address)
+);
+CREATE INDEX IF NOT EXISTS users_verified_addresses_user_id_idx ON users_verified_addresses (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'delete users_verified_addresses table';
+DROP TABLE IF EXISTS users_verified_addresses;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'create price_history table';
+CREATE TABLE IF NOT EXISTS price_history (
+    pair       TEXT             NOT NULL, -- e.g. ETH/USD
+    resolution TEXT             NOT NULL, -- 1h or 1d
+    ts         TIMESTAMP        NOT NULL, -- start of the interval
+    open       DOUBLE PRECISION NOT NULL,
+    high       DOUBLE PRECISION NOT NULL,
+    low        DOUBLE PRECISION NOT NULL,
+    close      DOUBLE PRECISION NOT NULL,
+    sources    INT              NOT NULL DEFAULT 1, -- number of sources the candle is based on
+    primary key (pair, resolution, ts)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'delete price_history table';
+DROP TABLE IF EXISTS price_history;
+-- +goose StatementEnd
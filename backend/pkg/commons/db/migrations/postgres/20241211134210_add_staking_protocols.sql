@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'create staking_protocols table';
+CREATE TABLE IF NOT EXISTS staking_protocols (
+    protocol     TEXT    NOT NULL, -- e.g. lido, ether_fi
+    treasury_fee NUMERIC NOT NULL DEFAULT 0, -- share of the rewards kept by the protocol
+    operator_fee NUMERIC NOT NULL DEFAULT 0, -- share of the rewards paid to the node operators
+    last_block   BIGINT  NOT NULL DEFAULT 0, -- last execution block scanned for protocols that are exported from logs
+    updated_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+    primary key (protocol)
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create staking_protocol_operators table';
+CREATE TABLE IF NOT EXISTS staking_protocol_operators (
+    protocol        TEXT    NOT NULL,
+    operator_id     TEXT    NOT NULL, -- id of the operator within the protocol
+    name            TEXT    NOT NULL DEFAULT '',
+    reward_address  BYTEA,
+    active          BOOLEAN NOT NULL DEFAULT TRUE,
+    validator_count INT     NOT NULL DEFAULT 0,
+    primary key (protocol, operator_id)
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create staking_protocol_validators table';
+CREATE TABLE IF NOT EXISTS staking_protocol_validators (
+    pubkey          BYTEA NOT NULL,
+    protocol        TEXT  NOT NULL,
+    operator_id     TEXT  NOT NULL,
+    validator_index INT, -- set once the validator is known to the beacon chain
+    primary key (pubkey)
+);
+CREATE INDEX IF NOT EXISTS idx_staking_protocol_validators_validator_index ON staking_protocol_validators (validator_index);
+CREATE INDEX IF NOT EXISTS idx_staking_protocol_validators_operator ON staking_protocol_validators (protocol, operator_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'delete staking_protocol_validators table';
+DROP TABLE IF EXISTS staking_protocol_validators;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete staking_protocol_operators table';
+DROP TABLE IF EXISTS staking_protocol_operators;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete staking_protocols table';
+DROP TABLE IF EXISTS staking_protocols;
+-- +goose StatementEnd
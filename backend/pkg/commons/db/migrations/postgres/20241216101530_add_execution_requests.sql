@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'create blocks_withdrawal_requests table';
+CREATE TABLE IF NOT EXISTS blocks_withdrawal_requests (
+    block_slot       INT    NOT NULL,
+    block_root       BYTEA  NOT NULL,
+    request_index    INT    NOT NULL, -- index of the request within the block
+    source_address   BYTEA  NOT NULL,
+    validator_pubkey BYTEA  NOT NULL,
+    amount           BIGINT NOT NULL, -- gwei, 0 requests a full exit
+    primary key (block_slot, block_root, request_index)
+);
+CREATE INDEX IF NOT EXISTS idx_blocks_withdrawal_requests_validator_pubkey ON blocks_withdrawal_requests (validator_pubkey);
+CREATE INDEX IF NOT EXISTS idx_blocks_withdrawal_requests_source_address ON blocks_withdrawal_requests (source_address);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create blocks_consolidation_requests table';
+CREATE TABLE IF NOT EXISTS blocks_consolidation_requests (
+    block_slot     INT   NOT NULL,
+    block_root     BYTEA NOT NULL,
+    request_index  INT   NOT NULL, -- index of the request within the block
+    source_address BYTEA NOT NULL,
+    source_pubkey  BYTEA NOT NULL,
+    target_pubkey  BYTEA NOT NULL,
+    primary key (block_slot, block_root, request_index)
+);
+CREATE INDEX IF NOT EXISTS idx_blocks_consolidation_requests_source_pubkey ON blocks_consolidation_requests (source_pubkey);
+CREATE INDEX IF NOT EXISTS idx_blocks_consolidation_requests_target_pubkey ON blocks_consolidation_requests (target_pubkey);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'delete blocks_consolidation_requests table';
+DROP TABLE IF EXISTS blocks_consolidation_requests;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete blocks_withdrawal_requests table';
+DROP TABLE IF EXISTS blocks_withdrawal_requests;
+-- +goose StatementEnd
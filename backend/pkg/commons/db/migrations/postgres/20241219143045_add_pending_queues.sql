@@ -0,0 +1,70 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'create pending_queues_snapshots table';
+CREATE TABLE IF NOT EXISTS pending_queues_snapshots (
+    epoch                  INT    NOT NULL, -- epoch of the state the queues were read from
+    total_active_balance   BIGINT NOT NULL, -- gwei
+    activation_exit_churn  BIGINT NOT NULL, -- gwei per epoch
+    consolidation_churn    BIGINT NOT NULL, -- gwei per epoch
+    created_ts             TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT NOW(),
+    primary key (epoch)
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create pending_deposits table';
+CREATE TABLE IF NOT EXISTS pending_deposits (
+    epoch                  INT    NOT NULL,
+    queue_index            INT    NOT NULL,
+    pubkey                 BYTEA  NOT NULL,
+    withdrawal_credentials BYTEA  NOT NULL,
+    amount                 BIGINT NOT NULL, -- gwei
+    slot                   INT    NOT NULL, -- slot the deposit was included in
+    estimated_epoch        BIGINT NOT NULL, -- estimated epoch the deposit gets applied to the balance
+    primary key (epoch, queue_index)
+);
+CREATE INDEX IF NOT EXISTS idx_pending_deposits_pubkey ON pending_deposits (pubkey);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create pending_partial_withdrawals table';
+CREATE TABLE IF NOT EXISTS pending_partial_withdrawals (
+    epoch              INT    NOT NULL,
+    queue_index        INT    NOT NULL,
+    validator_index    INT    NOT NULL,
+    amount             BIGINT NOT NULL, -- gwei
+    withdrawable_epoch BIGINT NOT NULL,
+    estimated_epoch    BIGINT NOT NULL, -- estimated epoch the withdrawal gets swept
+    primary key (epoch, queue_index)
+);
+CREATE INDEX IF NOT EXISTS idx_pending_partial_withdrawals_validator_index ON pending_partial_withdrawals (validator_index);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create pending_consolidations table';
+CREATE TABLE IF NOT EXISTS pending_consolidations (
+    epoch           INT NOT NULL,
+    queue_index     INT NOT NULL,
+    source_index    INT NOT NULL,
+    target_index    INT NOT NULL,
+    estimated_epoch BIGINT, -- estimated epoch the balance is moved to the target, unknown while the source has no withdrawable epoch yet
+    primary key (epoch, queue_index)
+);
+CREATE INDEX IF NOT EXISTS idx_pending_consolidations_source_index ON pending_consolidations (source_index);
+CREATE INDEX IF NOT EXISTS idx_pending_consolidations_target_index ON pending_consolidations (target_index);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'delete pending_consolidations table';
+DROP TABLE IF EXISTS pending_consolidations;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete pending_partial_withdrawals table';
+DROP TABLE IF EXISTS pending_partial_withdrawals;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete pending_deposits table';
+DROP TABLE IF EXISTS pending_deposits;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete pending_queues_snapshots table';
+DROP TABLE IF EXISTS pending_queues_snapshots;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'create attestations_gossip table';
+CREATE TABLE IF NOT EXISTS attestations_gossip (
+    validator_index INT    NOT NULL,
+    slot            INT    NOT NULL, -- attested slot
+    seen_ts         TIMESTAMP WITHOUT TIME ZONE NOT NULL, -- first time the attestation was seen on gossip
+    inclusion_slot  INT, -- slot of the first canonical block including the attestation, set once the block is finalized
+    primary key (validator_index, slot)
+);
+CREATE INDEX IF NOT EXISTS idx_attestations_gossip_slot ON attestations_gossip (slot);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create pool_voluntary_exits table';
+CREATE TABLE IF NOT EXISTS pool_voluntary_exits (
+    validator_index INT    NOT NULL,
+    epoch           INT    NOT NULL, -- earliest exit epoch of the signed message
+    signature       BYTEA  NOT NULL,
+    seen_ts         TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    primary key (validator_index)
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create pool_bls_to_execution_changes table';
+CREATE TABLE IF NOT EXISTS pool_bls_to_execution_changes (
+    validator_index      INT    NOT NULL,
+    from_bls_pubkey      BYTEA  NOT NULL,
+    to_execution_address BYTEA  NOT NULL,
+    signature            BYTEA  NOT NULL,
+    seen_ts              TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    primary key (validator_index)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'delete pool_bls_to_execution_changes table';
+DROP TABLE IF EXISTS pool_bls_to_execution_changes;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete pool_voluntary_exits table';
+DROP TABLE IF EXISTS pool_voluntary_exits;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete attestations_gossip table';
+DROP TABLE IF EXISTS attestations_gossip;
+-- +goose StatementEnd
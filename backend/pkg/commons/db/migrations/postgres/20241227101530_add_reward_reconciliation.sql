@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'create reward_reconciliation_epochs table';
+CREATE TABLE IF NOT EXISTS reward_reconciliation_epochs (
+    epoch         INT    NOT NULL,
+    validators    INT    NOT NULL, -- validators with a reward of the node or of the local computation
+    mismatches    INT    NOT NULL,
+    reconciled_ts TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    primary key (epoch)
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create reward_reconciliation_mismatches table';
+CREATE TABLE IF NOT EXISTS reward_reconciliation_mismatches (
+    epoch           INT    NOT NULL,
+    validator_index INT    NOT NULL,
+    component       TEXT   NOT NULL, -- e.g. attestation_head, sync or block_attestations
+    node_reward     BIGINT NOT NULL, -- gwei as reported by the reward endpoints of the beacon node
+    computed_reward BIGINT NOT NULL, -- gwei as computed following the consensus specs
+    primary key (epoch, validator_index, component)
+);
+CREATE INDEX IF NOT EXISTS idx_reward_reconciliation_mismatches_validator_index ON reward_reconciliation_mismatches (validator_index);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'delete reward_reconciliation_mismatches table';
+DROP TABLE IF EXISTS reward_reconciliation_mismatches;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete reward_reconciliation_epochs table';
+DROP TABLE IF EXISTS reward_reconciliation_epochs;
+-- +goose StatementEnd
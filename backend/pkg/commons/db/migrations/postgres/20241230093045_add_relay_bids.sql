@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'create relays_bids table';
+CREATE TABLE IF NOT EXISTS relays_bids (
+    tag_id          VARCHAR NOT NULL,
+    slot            INT     NOT NULL,
+    block_hash      bytea   NOT NULL,
+    parent_hash     bytea   NOT NULL,
+    builder_pubkey  bytea   NOT NULL,
+    proposer_pubkey bytea   NOT NULL,
+    value           NUMERIC NOT NULL,
+    num_tx          INT     NOT NULL,
+    timestamp_ms    BIGINT  NOT NULL, -- time the relay received the bid, the slot deadline minus this is the lead time of the bid
+    primary key (slot, tag_id, block_hash)
+);
+CREATE INDEX IF NOT EXISTS idx_relays_bids_builder_pubkey ON relays_bids (builder_pubkey, slot);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create relays_best_bids table';
+CREATE TABLE IF NOT EXISTS relays_best_bids (
+    slot           INT     NOT NULL,
+    parent_hash    bytea   NOT NULL, -- bids on other parents than the canonical one can not be compared to the proposed block
+    block_hash     bytea   NOT NULL,
+    builder_pubkey bytea   NOT NULL,
+    tag_id         VARCHAR NOT NULL, -- first relay the bid was seen on
+    value          NUMERIC NOT NULL,
+    primary key (slot, parent_hash)
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'add last_bid_slot to relays table';
+ALTER TABLE relays ADD COLUMN IF NOT EXISTS last_bid_slot INT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'remove last_bid_slot from relays table';
+ALTER TABLE relays DROP COLUMN IF EXISTS last_bid_slot;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete relays_best_bids table';
+DROP TABLE IF EXISTS relays_best_bids;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete relays_bids table';
+DROP TABLE IF EXISTS relays_bids;
+-- +goose StatementEnd
@@ -0,0 +1,83 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'create ssv_operators table';
+CREATE TABLE IF NOT EXISTS ssv_operators (
+    operator_id BIGINT  NOT NULL,
+    owner       BYTEA   NOT NULL,
+    public_key  BYTEA   NOT NULL,
+    name        TEXT    NOT NULL DEFAULT '', -- taken from the ssv api, operator metadata isn't stored on chain
+    fee         NUMERIC NOT NULL DEFAULT 0, -- per block, in ssv wei
+    removed     BOOLEAN NOT NULL DEFAULT FALSE,
+    primary key (operator_id)
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create ssv_clusters table';
+CREATE TABLE IF NOT EXISTS ssv_clusters (
+    cluster_id      BYTEA    NOT NULL, -- keccak256 of the owner and the operator ids like in the ssv network contract
+    owner           BYTEA    NOT NULL,
+    operator_ids    BIGINT[] NOT NULL,
+    validator_count INT      NOT NULL DEFAULT 0,
+    active          BOOLEAN  NOT NULL DEFAULT TRUE, -- false once the cluster got liquidated
+    balance         NUMERIC  NOT NULL DEFAULT 0, -- in ssv wei, as of the last event of the cluster
+    primary key (cluster_id)
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create ssv_validators table';
+CREATE TABLE IF NOT EXISTS ssv_validators (
+    pubkey          BYTEA    NOT NULL,
+    cluster_id      BYTEA    NOT NULL,
+    owner           BYTEA    NOT NULL,
+    operator_ids    BIGINT[] NOT NULL,
+    removed         BOOLEAN  NOT NULL DEFAULT FALSE,
+    validator_index INT, -- set once the validator is known to the beacon chain
+    primary key (pubkey)
+);
+CREATE INDEX IF NOT EXISTS idx_ssv_validators_validator_index ON ssv_validators (validator_index);
+CREATE INDEX IF NOT EXISTS idx_ssv_validators_cluster_id ON ssv_validators (cluster_id);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create ssv_sync_state table';
+CREATE TABLE IF NOT EXISTS ssv_sync_state (
+    network_address BYTEA  NOT NULL,
+    last_block      BIGINT NOT NULL DEFAULT 0,
+    primary key (network_address)
+);
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'create ssv_operator_performance table';
+CREATE TABLE IF NOT EXISTS ssv_operator_performance (
+    operator_id                 BIGINT NOT NULL,
+    day                         DATE   NOT NULL,
+    validators                  INT    NOT NULL DEFAULT 0,
+    attestations_scheduled      BIGINT NOT NULL DEFAULT 0, -- duties of all validators of the clusters the operator is part of
+    attestations_executed       BIGINT NOT NULL DEFAULT 0,
+    attestation_head_executed   BIGINT NOT NULL DEFAULT 0,
+    attestation_source_executed BIGINT NOT NULL DEFAULT 0,
+    attestation_target_executed BIGINT NOT NULL DEFAULT 0,
+    primary key (operator_id, day)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'delete ssv_operator_performance table';
+DROP TABLE IF EXISTS ssv_operator_performance;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete ssv_sync_state table';
+DROP TABLE IF EXISTS ssv_sync_state;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete ssv_validators table';
+DROP TABLE IF EXISTS ssv_validators;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete ssv_clusters table';
+DROP TABLE IF EXISTS ssv_clusters;
+-- +goose StatementEnd
+-- +goose StatementBegin
+SELECT 'delete ssv_operators table';
+DROP TABLE IF EXISTS ssv_operators;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'create users_two_factor_attempts table';
+CREATE TABLE IF NOT EXISTS users_two_factor_attempts (
+    user_id         INT       NOT NULL,
+    failed_attempts INT       NOT NULL DEFAULT 0, -- consecutive failed verifications, reset by a successful one
+    last_failed_ts  TIMESTAMP NOT NULL DEFAULT(NOW()),
+    locked_until    TIMESTAMP,
+    primary key (user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'delete users_two_factor_attempts table';
+DROP TABLE IF EXISTS users_two_factor_attempts;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'add grant_id column to table oauth_authorization_codes';
+ALTER TABLE oauth_authorization_codes ADD COLUMN IF NOT EXISTS grant_id INT; -- set once the code has been exchanged, a reuse revokes the grant
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'remove grant_id column from table oauth_authorization_codes';
+ALTER TABLE oauth_authorization_codes DROP COLUMN IF EXISTS grant_id;
+-- +goose StatementEnd
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/price"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+)
+
+// SaveHourlyPriceCandles stores hourly candles and recomputes the daily candles of the affected days from the stored hourly candles
+func SaveHourlyPriceCandles(candles []price.Candle) error {
+	if len(candles) == 0 {
+		return nil
+	}
+	tx, err := WriterDb.Beginx()
+	if err != nil {
+		return fmt.Errorf("error starting db transaction to save price candles: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	pairs := make([]string, 0, len(candles))
+	days := make([]time.Time, 0, len(candles))
+	for _, c := range candles {
+		_, err = tx.Exec(`
+			INSERT INTO price_history (pair, resolution, ts, open, high, low, close, sources)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (pair, resolution, ts) DO UPDATE SET
+				open = excluded.open,
+				high = excluded.high,
+				low = excluded.low,
+				close = excluded.close,
+				sources = excluded.sources`,
+			c.Pair, price.ResolutionHour, c.Ts.UTC(), c.Open, c.High, c.Low, c.Close, c.Sources)
+		if err != nil {
+			return fmt.Errorf("error saving price candle %v %v: %w", c.Pair, c.Ts, err)
+		}
+		pairs = append(pairs, c.Pair)
+		days = append(days, c.Ts.UTC().Truncate(time.Hour*24))
+	}
+
+	_, err = tx.Exec(`
+		WITH affected AS (
+			SELECT DISTINCT pair, day FROM unnest($1::TEXT[], $2::TIMESTAMP[]) AS a(pair, day)
+		)
+		INSERT INTO price_history (pair, resolution, ts, open, high, low, close, sources)
+		SELECT
+			h.pair,
+			$4,
+			affected.day,
+			(array_agg(h.open ORDER BY h.ts ASC))[1],
+			MAX(h.high),
+			MIN(h.low),
+			(array_agg(h.close ORDER BY h.ts DESC))[1],
+			MIN(h.sources)
+		FROM affected
+		INNER JOIN price_history h ON h.pair = affected.pair AND h.resolution = $3 AND h.ts >= affected.day AND h.ts < affected.day + INTERVAL '1 day'
+		GROUP BY h.pair, affected.day
+		ON CONFLICT (pair, resolution, ts) DO UPDATE SET
+			open = excluded.open,
+			high = excluded.high,
+			low = excluded.low,
+			close = excluded.close,
+			sources = excluded.sources`,
+		pq.StringArray(pairs), pq.Array(days), price.ResolutionHour, price.ResolutionDay)
+	if err != nil {
+		return fmt.Errorf("error saving daily price candles: %w", err)
+	}
+	return tx.Commit()
+}
+
+// GetLatestPriceCandleTs returns the start of the most recent hourly candle of the pair, the zero time if there is none
+func GetLatestPriceCandleTs(pair string) (time.Time, error) {
+	var ts sql.NullTime
+	err := WriterDb.Get(&ts, `SELECT MAX(ts) FROM price_history WHERE pair = $1 AND resolution = $2`, pair, price.ResolutionHour)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, err
+	}
+	if !ts.Valid {
+		return time.Time{}, nil
+	}
+	return ts.Time, nil
+}
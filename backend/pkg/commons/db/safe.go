@@ -0,0 +1,421 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	safeContracts "github.com/gobitfly/beaconchain/pkg/commons/contracts/safe"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/metrics"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+
+	gcp_bigtable "cloud.google.com/go/bigtable"
+	"github.com/coocood/freecache"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+)
+
+// kinds of indexed Safe events
+const (
+	SafeEventSetup            = "setup"
+	SafeEventAddedOwner       = "added_owner"
+	SafeEventRemovedOwner     = "removed_owner"
+	SafeEventChangedThreshold = "changed_threshold"
+)
+
+// TransformSafe accepts an eth1 block and creates bigtable mutations for Safe multisig wallets.
+// A contract is treated as a Safe if it was deployed through a Safe proxy factory or emitted SafeSetup, events of other contracts
+// are only picked up if the contract is called through execTransaction directly, so blocks must be indexed after the deployment of a Safe.
+// ==================================================
+//
+// - deployments
+// Row:    <chainID>:SAFE:S:<safe>
+// Family: f
+// Column: data
+// Cell:   Proto<SafeCreationIndexed>
+// Example read: "1:SAFE:S:a6b71e26c5e0845f74c812102ca7114b6a896ab2"
+//
+// - setup, owner and threshold changes
+// Row:    <chainID>:SAFE:E:<safe>:<reversePaddedBlockNumber>:<reversePaddedTxIndex>:<reversePaddedLogIndex>
+// Family: f
+// Column: data
+// Cell:   Proto<SafeEventIndexed>
+// Example scan: "1:SAFE:E:a6b71e26c5e0845f74c812102ca7114b6a896ab2:" returns the most recent events of the Safe
+//
+// - executions
+// Row:    <chainID>:SAFE:X:<safe>:<reversePaddedBlockNumber>:<reversePaddedTxIndex>:<reversePaddedLogIndex>
+// Family: f
+// Column: data
+// Cell:   Proto<SafeExecutionIndexed>
+// Example scan: "1:SAFE:X:a6b71e26c5e0845f74c812102ca7114b6a896ab2:" returns the most recent executions of the Safe
+//
+// - executions by Safe transaction hash
+// Row:    <chainID>:SAFE:H:<safeTxHash>
+// Family: f
+// Column: data
+// Cell:   Proto<SafeExecutionIndexed>
+// Example read: "1:SAFE:H:4ae569dd0aa2f6e9207e41423c956d0d27cbc376a499ee8d90fe1d84489ae9d1"
+//
+// - executions by transaction hash, the last execution if a transaction executes multiple Safe transactions
+// Row:    <chainID>:SAFE:T:<txHash>
+// Family: f
+// Column: data
+// Cell:   Proto<SafeExecutionIndexed>
+// Example read: "1:SAFE:T:d2e1f1e5c5e0845f74c812102ca7114b6a896ab2d2e1f1e5c5e0845f74c81210"
+//
+// - on chain approvals of Safe transaction hashes
+// Row:    <chainID>:SAFE:A:<safeTxHash>:<owner>
+// Family: f
+// Column: data
+// Cell:   Proto<SafeApprovalIndexed>
+// Example scan: "1:SAFE:A:4ae569dd0aa2f6e9207e41423c956d0d27cbc376a499ee8d90fe1d84489ae9d1:" returns the approvals of the Safe transaction
+//
+// ==================================================
+
+func (bigtable *Bigtable) TransformSafe(blk *types.Eth1Block, cache *freecache.Cache) (bulkData *types.BulkMutations, bulkMetadataUpdates *types.BulkMutations, err error) {
+	startTime := time.Now()
+	defer func() {
+		metrics.TaskDuration.WithLabelValues("bt_transform_safe").Observe(time.Since(startTime).Seconds())
+	}()
+
+	bulkData = &types.BulkMutations{}
+	bulkMetadataUpdates = &types.BulkMutations{}
+
+	proxyCreationTopic := safeContracts.SafeProxyFactoryParsedABI.Events["ProxyCreation"].ID
+	safeEvents := safeContracts.SafeParsedABI.Events
+	execTransactionMethod := safeContracts.SafeParsedABI.Methods["execTransaction"]
+	created := make(map[common.Address]bool)
+
+	for i, tx := range blk.GetTransactions() {
+		if i >= TX_PER_BLOCK_LIMIT {
+			return nil, nil, fmt.Errorf("unexpected number of transactions in block expected at most %d but got: %v, tx: %x", TX_PER_BLOCK_LIMIT-1, i, tx.GetHash())
+		}
+		if len(tx.GetLogs()) > ITX_PER_TX_LIMIT {
+			return nil, nil, fmt.Errorf("unexpected number of logs in block expected at most %d but got: %v tx: %x", ITX_PER_TX_LIMIT-1, len(tx.GetLogs())-1, tx.GetHash())
+		}
+
+		// the factory emits ProxyCreation after the Safe emitted SafeSetup, so deployments are collected first
+		for j, txLog := range tx.GetLogs() {
+			topics := txLog.GetTopics()
+			if len(topics) == 0 || !bytes.Equal(topics[0], proxyCreationTopic.Bytes()) {
+				continue
+			}
+			words := safeContracts.EventWords(toGethLog(blk, tx, i, j, txLog).Topics, txLog.GetData())
+			if len(words) < 2 {
+				continue
+			}
+			proxy := common.BytesToAddress(words[0].Bytes())
+			created[proxy] = true
+			creation := &types.SafeCreationIndexed{
+				TxHash:      tx.GetHash(),
+				BlockNumber: blk.GetNumber(),
+				Time:        blk.GetTime(),
+				Factory:     txLog.GetAddress(),
+				Singleton:   common.BytesToAddress(words[1].Bytes()).Bytes(),
+				Creator:     tx.GetFrom(),
+			}
+			if err := bigtable.addProtoMutation(bulkData, fmt.Sprintf("%s:SAFE:S:%x", bigtable.chainId, proxy), creation); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		// the arguments of direct execTransaction calls carry the signatures of the owners
+		var execTarget common.Address
+		var execArgs []interface{}
+		if len(tx.GetTo()) > 0 && len(tx.GetData()) >= 4 && bytes.Equal(tx.GetData()[:4], execTransactionMethod.ID) {
+			args, err := execTransactionMethod.Inputs.Unpack(tx.GetData()[4:])
+			if err != nil {
+				log.WarnWithFields(map[string]interface{}{"block": blk.GetNumber(), "tx": tx.GetHash(), "error": err}, "error unpacking safe execTransaction input")
+			} else {
+				execTarget = common.BytesToAddress(tx.GetTo())
+				execArgs = args
+			}
+		}
+
+		for j, txLog := range tx.GetLogs() {
+			if len(txLog.GetTopics()) == 0 {
+				continue
+			}
+			address := common.BytesToAddress(txLog.GetAddress())
+			topic := common.BytesToHash(txLog.GetTopics()[0])
+			ethLog := toGethLog(blk, tx, i, j, txLog)
+			words := safeContracts.EventWords(ethLog.Topics, ethLog.Data)
+			logFields := map[string]interface{}{
+				"block":    blk.GetNumber(),
+				"tx":       tx.GetHash(),
+				"logIndex": j,
+			}
+
+			var kind string
+			switch topic {
+			case safeEvents["SafeSetup"].ID:
+				kind = SafeEventSetup
+			case safeEvents["AddedOwner"].ID:
+				kind = SafeEventAddedOwner
+			case safeEvents["RemovedOwner"].ID:
+				kind = SafeEventRemovedOwner
+			case safeEvents["ChangedThreshold"].ID:
+				kind = SafeEventChangedThreshold
+			case safeEvents["ExecutionSuccess"].ID, safeEvents["ExecutionFailure"].ID, safeEvents["ApproveHash"].ID:
+			default:
+				continue
+			}
+
+			// SafeSetup is specific enough to identify Safes deployed by factories we don't know about
+			if kind == SafeEventSetup && !created[address] {
+				created[address] = true
+				creation := &types.SafeCreationIndexed{
+					TxHash:      tx.GetHash(),
+					BlockNumber: blk.GetNumber(),
+					Time:        blk.GetTime(),
+					Creator:     tx.GetFrom(),
+				}
+				if err := bigtable.addProtoMutation(bulkData, fmt.Sprintf("%s:SAFE:S:%x", bigtable.chainId, address), creation); err != nil {
+					return nil, nil, err
+				}
+			}
+			if !created[address] && address != execTarget {
+				isSafe, err := bigtable.isKnownSafe(address, cache)
+				if err != nil {
+					return nil, nil, err
+				}
+				if !isSafe {
+					continue
+				}
+			}
+
+			position := fmt.Sprintf("%s:%s:%s", reversedPaddedBlockNumber(blk.GetNumber()), reversePaddedIndex(i, TX_PER_BLOCK_LIMIT), reversePaddedIndex(j, ITX_PER_TX_LIMIT))
+			if kind != "" {
+				event := &types.SafeEventIndexed{
+					TxHash:      tx.GetHash(),
+					BlockNumber: blk.GetNumber(),
+					Time:        blk.GetTime(),
+					Kind:        kind,
+				}
+				switch kind {
+				case SafeEventSetup:
+					r := &safeContracts.SafeSafeSetup{}
+					if err := safeContracts.SafeContract.UnpackLog(r, "SafeSetup", ethLog); err != nil {
+						logFields["error"] = err
+						log.WarnWithFields(logFields, "error unpacking safe setup")
+						continue
+					}
+					for _, owner := range r.Owners {
+						event.Owners = append(event.Owners, owner.Bytes())
+					}
+					event.Threshold = r.Threshold.Uint64()
+				case SafeEventAddedOwner, SafeEventRemovedOwner:
+					if len(words) < 1 {
+						continue
+					}
+					event.Owners = [][]byte{common.BytesToAddress(words[0].Bytes()).Bytes()}
+				case SafeEventChangedThreshold:
+					if len(words) < 1 {
+						continue
+					}
+					event.Threshold = words[0].Big().Uint64()
+				}
+				if err := bigtable.addProtoMutation(bulkData, fmt.Sprintf("%s:SAFE:E:%x:%s", bigtable.chainId, address, position), event); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+
+			if len(words) < 2 {
+				continue
+			}
+			if topic == safeEvents["ApproveHash"].ID {
+				approval := &types.SafeApprovalIndexed{
+					TxHash:      tx.GetHash(),
+					BlockNumber: blk.GetNumber(),
+					Time:        blk.GetTime(),
+					Safe:        address.Bytes(),
+					Owner:       common.BytesToAddress(words[1].Bytes()).Bytes(),
+				}
+				if err := bigtable.addProtoMutation(bulkData, fmt.Sprintf("%s:SAFE:A:%x:%x", bigtable.chainId, words[0], approval.Owner), approval); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+
+			execution := &types.SafeExecutionIndexed{
+				TxHash:      tx.GetHash(),
+				BlockNumber: blk.GetNumber(),
+				Time:        blk.GetTime(),
+				Safe:        address.Bytes(),
+				SafeTxHash:  words[0].Bytes(),
+				Success:     topic == safeEvents["ExecutionSuccess"].ID,
+				Payment:     words[1].Big().Bytes(),
+				Executor:    tx.GetFrom(),
+			}
+			if address == execTarget && len(execArgs) == 10 {
+				to, _ := execArgs[0].(common.Address)
+				data, _ := execArgs[2].([]byte)
+				operation, _ := execArgs[3].(uint8)
+				signatures, _ := execArgs[9].([]byte)
+				execution.To = to.Bytes()
+				if value, ok := execArgs[1].(*big.Int); ok {
+					execution.Value = value.Bytes()
+				}
+				if len(data) >= 4 {
+					execution.Method = data[:4]
+				}
+				execution.Operation = uint64(operation)
+				for _, confirmation := range safeContracts.DecodeSignatures(words[0], signatures) {
+					execution.Signers = append(execution.Signers, confirmation.Owner.Bytes())
+					execution.SignatureKinds = append(execution.SignatureKinds, confirmation.Kind)
+				}
+			}
+			keys := []string{
+				fmt.Sprintf("%s:SAFE:X:%x:%s", bigtable.chainId, address, position),
+				fmt.Sprintf("%s:SAFE:H:%x", bigtable.chainId, execution.SafeTxHash),
+				fmt.Sprintf("%s:SAFE:T:%x", bigtable.chainId, tx.GetHash()),
+			}
+			for _, key := range keys {
+				if err := bigtable.addProtoMutation(bulkData, key, execution); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+	}
+
+	return bulkData, bulkMetadataUpdates, nil
+}
+
+// isKnownSafe checks whether the deployment of the address has been indexed, only positive results are cached
+// as a contract can become a Safe later on
+func (bigtable *Bigtable) isKnownSafe(address common.Address, cache *freecache.Cache) (bool, error) {
+	cacheKey := []byte(fmt.Sprintf("%s:SAFE:S:%x", bigtable.chainId, address))
+	if _, err := cache.Get(cacheKey); err == nil {
+		return true, nil
+	}
+	creation, err := bigtable.GetSafeCreation(address.Bytes())
+	if err != nil {
+		return false, err
+	}
+	if creation == nil {
+		return false, nil
+	}
+	_ = cache.Set(cacheKey, []byte{0x1}, int((utils.Day * 2).Seconds()))
+	return true, nil
+}
+
+// GetSafeCreation returns the deployment of the Safe, nil if the address is not a known Safe
+func (bigtable *Bigtable) GetSafeCreation(safe []byte) (*types.SafeCreationIndexed, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	row, err := bigtable.tableData.ReadRow(ctx, fmt.Sprintf("%s:SAFE:S:%x", bigtable.chainId, safe))
+	if err != nil {
+		return nil, err
+	}
+	if row == nil {
+		return nil, nil
+	}
+	creation := &types.SafeCreationIndexed{}
+	if err := proto.Unmarshal(row[DEFAULT_FAMILY][0].Value, creation); err != nil {
+		return nil, err
+	}
+	return creation, nil
+}
+
+// GetSafeEvents returns all setup, owner and threshold events of the Safe, newest first
+func (bigtable *Bigtable) GetSafeEvents(safe []byte) ([]*types.SafeEventIndexed, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	var events []*types.SafeEventIndexed
+	err := bigtable.tableData.ReadRows(ctx, gcp_bigtable.PrefixRange(fmt.Sprintf("%s:SAFE:E:%x:", bigtable.chainId, safe)), func(row gcp_bigtable.Row) bool {
+		event := &types.SafeEventIndexed{}
+		if err := proto.Unmarshal(row[DEFAULT_FAMILY][0].Value, event); err != nil {
+			log.Error(err, "error parsing SafeEventIndexed data", 0, map[string]interface{}{"key": row.Key()})
+			return true
+		}
+		events = append(events, event)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// GetSafeExecutions returns the executions of a Safe starting after the given page token (or prefix "<chainID>:SAFE:X:<safe>:"), newest first
+func (bigtable *Bigtable) GetSafeExecutions(pageToken string, limit int64) ([]*types.SafeExecutionIndexed, []string, error) {
+	return readProtoRows[types.SafeExecutionIndexed](bigtable, pageToken, 4, limit)
+}
+
+// GetSafeExecution returns the execution of the Safe transaction with the given hash, nil if it has not been executed
+func (bigtable *Bigtable) GetSafeExecution(safeTxHash []byte) (*types.SafeExecutionIndexed, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	row, err := bigtable.tableData.ReadRow(ctx, fmt.Sprintf("%s:SAFE:H:%x", bigtable.chainId, safeTxHash))
+	if err != nil {
+		return nil, err
+	}
+	if row == nil {
+		return nil, nil
+	}
+	execution := &types.SafeExecutionIndexed{}
+	if err := proto.Unmarshal(row[DEFAULT_FAMILY][0].Value, execution); err != nil {
+		return nil, err
+	}
+	return execution, nil
+}
+
+// GetSafeApprovals returns the on chain approvals of the Safe transaction with the given hash
+func (bigtable *Bigtable) GetSafeApprovals(safeTxHash []byte) ([]*types.SafeApprovalIndexed, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	var approvals []*types.SafeApprovalIndexed
+	err := bigtable.tableData.ReadRows(ctx, gcp_bigtable.PrefixRange(fmt.Sprintf("%s:SAFE:A:%x:", bigtable.chainId, safeTxHash)), func(row gcp_bigtable.Row) bool {
+		approval := &types.SafeApprovalIndexed{}
+		if err := proto.Unmarshal(row[DEFAULT_FAMILY][0].Value, approval); err != nil {
+			log.Error(err, "error parsing SafeApprovalIndexed data", 0, map[string]interface{}{"key": row.Key()})
+			return true
+		}
+		approvals = append(approvals, approval)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return approvals, nil
+}
+
+// GetSafeExecutionsByTxHashes returns the Safe executions of the given transactions, keyed by their hex encoded transaction hash
+func (bigtable *Bigtable) GetSafeExecutionsByTxHashes(txHashes [][]byte) (map[string]*types.SafeExecutionIndexed, error) {
+	result := make(map[string]*types.SafeExecutionIndexed, len(txHashes))
+	if len(txHashes) == 0 {
+		return result, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	prefix := fmt.Sprintf("%s:SAFE:T:", bigtable.chainId)
+	keys := make(gcp_bigtable.RowList, 0, len(txHashes))
+	for _, hash := range txHashes {
+		keys = append(keys, fmt.Sprintf("%s%x", prefix, hash))
+	}
+	err := bigtable.tableData.ReadRows(ctx, keys, func(row gcp_bigtable.Row) bool {
+		execution := &types.SafeExecutionIndexed{}
+		if err := proto.Unmarshal(row[DEFAULT_FAMILY][0].Value, execution); err != nil {
+			log.Error(err, "error parsing SafeExecutionIndexed data", 0, map[string]interface{}{"key": row.Key()})
+			return true
+		}
+		result[strings.TrimPrefix(row.Key(), prefix)] = execution
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
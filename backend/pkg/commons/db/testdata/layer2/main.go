@@ -0,0 +1,566 @@
+// Command layer2 generates the layer2.json fixture. It builds a layer 1 block and a block of each rollup the way the
+// eth1 indexer stores them, with logs encoded through the contract abis and the opaque deposit data and retryable
+// ticket data laid out byte by byte like the OptimismPortal and the arbitrum Inbox do on chain. The expected rows are
+// derived from the inputs without the decoders under test.
+//
+// The layer 1 block (mainnet addresses of OP Mainnet and Arbitrum One) contains
+//
+//   - op-stack batches in calldata, as alt-da commitment and in blobs, and a transaction to the batch inbox by another sender
+//   - op-stack deposits by an account and by a contract creating a contract, a deposit of an unknown version and a
+//     deposit event emitted by another contract
+//   - a proven and a failed finalized op-stack withdrawal
+//   - arbitrum batches in calldata, blobs and without data
+//   - an arbitrum eth deposit, retryable ticket, l2 message and batch posting report
+//   - an executed arbitrum outbox transaction
+//
+// the rollup blocks contain an initiated withdrawal each and transactions to the settlement contract addresses of the
+// rollups, which must not be indexed there. Run it from pkg/commons/db with go generate.
+package main
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	l1BlockNumber = 20000000
+	l1Time        = 1717171717
+
+	txPerBlockLimit = 10_000
+	itxPerTxLimit   = 100_000
+	maxBlockNumber  = 1_000_000_000
+)
+
+var (
+	optimism = types.Layer2Network{
+		Name:              "optimism",
+		ChainId:           10,
+		Stack:             "op-stack",
+		SettlementChainId: 1,
+		BatchInbox:        "0xFF00000000000000000000000000000000000010",
+		BatchSubmitter:    "0x6887246668a3b87F54DeB3b94Ba47a6f63F32985",
+		OptimismPortal:    "0xbEb5Fc579115071764c7423A4f12eDde41f106Ed",
+	}
+	arbitrum = types.Layer2Network{
+		Name:              "arbitrum",
+		ChainId:           42161,
+		Stack:             "arbitrum",
+		SettlementChainId: 1,
+		SequencerInbox:    "0x1c479675ad559DC151F6Ec7ed3FbF8ceE79582B6",
+		Bridge:            "0x8315177aB297bA92A06054cE80a67Ed4DBd7ed3a",
+		Inbox:             "0x4Dbd4fc535Ac27206064B68FfCf827b0A60BAB3f",
+		Outbox:            "0x0B9857ae2D4A3DBe74ffE1d7DF045bb7F96E4840",
+	}
+
+	user      = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	recipient = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	contract  = common.HexToAddress("0x00000000000000000000000000000000000c0de0")
+	sequencer = common.HexToAddress("0xC1b634853Cb333D3aD8663715b08f41A3Aec47cc")
+
+	messagePasser = common.HexToAddress("0x4200000000000000000000000000000000000016")
+	arbSys        = common.HexToAddress("0x0000000000000000000000000000000000000064")
+
+	gwei  = big.NewInt(1_000_000_000)
+	ether = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+)
+
+type fixtureRow struct {
+	Key   string          `json:"key"`
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+type fixtureBlock struct {
+	ChainId string          `json:"chainId"`
+	Block   json.RawMessage `json:"block"`
+	Rows    []fixtureRow    `json:"rows"`
+}
+
+type fixture struct {
+	Networks []types.Layer2Network `json:"networks"`
+	Blocks   []fixtureBlock        `json:"blocks"`
+}
+
+// block collects the transactions of a block and the rows the indexer is expected to create for them
+type block struct {
+	chainId string
+	blk     *types.Eth1Block
+	rows    []fixtureRow
+	logs    uint64 // logs of the block so far
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: layer2 <fixture path>")
+	}
+
+	f := fixture{Networks: []types.Layer2Network{optimism, arbitrum}}
+	for _, b := range []*block{layer1Block(), optimismBlock(), arbitrumBlock()} {
+		blk, err := marshalProto(b.blk)
+		if err != nil {
+			log.Fatalf("error encoding block: %v", err)
+		}
+		f.Blocks = append(f.Blocks, fixtureBlock{ChainId: b.chainId, Block: blk, Rows: b.rows})
+	}
+
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		log.Fatalf("error encoding fixture: %v", err)
+	}
+	if err := os.WriteFile(os.Args[1], append(data, '\n'), 0o644); err != nil { //nolint:gosec // fixture is not secret
+		log.Fatalf("error saving fixture: %v", err)
+	}
+	log.Printf("saved %d blocks to %s", len(f.Blocks), os.Args[1])
+}
+
+func layer1Block() *block {
+	b := newBlock("1", l1BlockNumber, l1Time)
+	portal := common.HexToAddress(optimism.OptimismPortal)
+	batchInbox := common.HexToAddress(optimism.BatchInbox)
+	batcher := common.HexToAddress(optimism.BatchSubmitter)
+
+	// an unrelated transaction, its logs count towards the log index within the block
+	tx := b.addTx(2, user, contract, nil)
+	b.addLog(tx, contract, []common.Hash{crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))}, nil)
+	b.addLog(tx, contract, []common.Hash{crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))}, nil)
+
+	// op-stack batches, version 0 frames are sent as calldata, version 1 carries an alt-da commitment
+	tx = b.addTx(2, batcher, batchInbox, append([]byte{0x00}, repeat(0xaa, 1500)...))
+	b.expectBatch(optimism, tx, "calldata", 1501, false, 0)
+	tx = b.addTx(2, batcher, batchInbox, append([]byte{0x01, 0x00}, repeat(0xbb, 32)...))
+	b.expectBatch(optimism, tx, "alt_da", 34, false, 0)
+	tx = b.addTx(3, batcher, batchInbox, nil)
+	tx.BlobVersionedHashes = [][]byte{versionedHash(1), versionedHash(2), versionedHash(3)}
+	tx.BlobGasPrice = big.NewInt(3).Bytes()
+	tx.BlobGasUsed = 3 * 131072
+	b.expectBatch(optimism, tx, "blob", 3*131072, false, 0)
+	b.addTx(2, user, batchInbox, []byte{0x00, 0x01})
+
+	// op-stack deposit of an account, opaque data is abi.encodePacked(mint, value, gasLimit, isCreation, data)
+	tx = b.addTx(2, user, portal, nil)
+	mint, value := new(big.Int).Mul(big.NewInt(2), ether), ether
+	opaqueData := slices.Concat(common.BigToHash(mint).Bytes(), common.BigToHash(value).Bytes(), uint64Bytes(100000), []byte{0}, []byte{0xde, 0xad})
+	logIndex := b.addEvent(tx, portal, "optimism_portal", "TransactionDeposited", []common.Hash{addressTopic(user), addressTopic(recipient), common.Hash{}}, opaqueData)
+	b.expectOptimismDeposit(tx, len(tx.Logs)-1, logIndex, user, &recipient, mint, value, 100000, []byte{0xde, 0xad})
+
+	// op-stack deposit of a contract, the sender is aliased and the deposit creates a contract without minting
+	tx = b.addTx(2, user, contract, nil)
+	b.addLog(tx, contract, []common.Hash{crypto.Keccak256Hash([]byte("Deployed()"))}, nil)
+	initCode := repeat(0x60, 64)
+	opaqueData = slices.Concat(common.BigToHash(common.Big0).Bytes(), common.BigToHash(common.Big0).Bytes(), uint64Bytes(500000), []byte{1}, initCode)
+	logIndex = b.addEvent(tx, portal, "optimism_portal", "TransactionDeposited", []common.Hash{addressTopic(alias(contract)), {}, common.Hash{}}, opaqueData)
+	b.expectOptimismDeposit(tx, len(tx.Logs)-1, logIndex, alias(contract), nil, common.Big0, common.Big0, 500000, initCode)
+
+	// unknown deposit versions are skipped as well as deposit events of other contracts
+	tx = b.addTx(2, user, portal, nil)
+	b.addEvent(tx, portal, "optimism_portal", "TransactionDeposited", []common.Hash{addressTopic(user), addressTopic(recipient), common.BigToHash(common.Big1)}, opaqueData)
+	b.addEvent(tx, contract, "optimism_portal", "TransactionDeposited", []common.Hash{addressTopic(user), addressTopic(recipient), common.Hash{}}, opaqueData)
+
+	// op-stack withdrawals
+	withdrawalHash := crypto.Keccak256Hash([]byte("withdrawal"))
+	tx = b.addTx(2, user, portal, nil)
+	b.addEvent(tx, portal, "optimism_portal", "WithdrawalProven", []common.Hash{withdrawalHash, addressTopic(user), addressTopic(recipient)})
+	b.expectWithdrawal(optimism, tx, len(tx.Logs)-1, "proven", withdrawalHash.Bytes(), user.Bytes(), recipient.Bytes(), true)
+	tx = b.addTx(2, user, portal, nil)
+	b.addEvent(tx, portal, "optimism_portal", "WithdrawalFinalized", []common.Hash{withdrawalHash}, false)
+	b.expectWithdrawal(optimism, tx, len(tx.Logs)-1, "finalized", withdrawalHash.Bytes(), nil, nil, false)
+
+	// arbitrum batches
+	sequencerInbox := common.HexToAddress(arbitrum.SequencerInbox)
+	bridge := common.HexToAddress(arbitrum.Bridge)
+	inbox := common.HexToAddress(arbitrum.Inbox)
+	outbox := common.HexToAddress(arbitrum.Outbox)
+	for _, batch := range []struct {
+		txType       uint32
+		number       int64
+		dataLocation uint8
+		location     string
+	}{
+		{2, 700000, 0, "calldata"},
+		{3, 700001, 3, "blob"},
+		{2, 700002, 2, "none"},
+	} {
+		tx = b.addTx(batch.txType, sequencer, sequencerInbox, repeat(0xcc, 900))
+		dataSize := uint64(900)
+		if batch.txType == 3 {
+			tx.Data = repeat(0xcc, 200)
+			tx.BlobVersionedHashes = [][]byte{versionedHash(4)}
+			tx.BlobGasPrice = big.NewInt(1).Bytes()
+			tx.BlobGasUsed = 131072
+			dataSize = 131072
+		}
+		if batch.location == "none" {
+			dataSize = 0
+		}
+		b.addArbitrumBatch(tx, batch.number, batch.dataLocation)
+		b.expectBatch(arbitrum, tx, batch.location, dataSize, true, uint64(batch.number))
+	}
+
+	// arbitrum eth deposit, the message data is abi.encodePacked(destination, value)
+	tx = b.addTx(2, user, inbox, nil)
+	tx.Value = ether.Bytes()
+	messageIndex := big.NewInt(1500000)
+	l1BaseFee := new(big.Int).Mul(big.NewInt(8), gwei)
+	data := slices.Concat(user.Bytes(), common.BigToHash(ether).Bytes())
+	b.addMessage(tx, bridge, inbox, 12, messageIndex, alias(user), l1BaseFee, data)
+	b.expectArbitrumDeposit(tx, len(tx.Logs)-2, "deposit", messageIndex, alias(user), &user, ether, arbitrumDepositHash(messageIndex, alias(user), user, ether))
+
+	// arbitrum retryable ticket, the message data is the packed ticket followed by its call data
+	tx = b.addTx(2, user, inbox, nil)
+	messageIndex = big.NewInt(1500001)
+	deposit := new(big.Int).Div(ether, big.NewInt(10))
+	ticket := retryable{
+		to:               recipient,
+		callValue:        new(big.Int).Div(ether, big.NewInt(20)),
+		deposit:          deposit,
+		maxSubmissionFee: big.NewInt(123456789),
+		feeRefund:        user,
+		beneficiary:      user,
+		gas:              300000,
+		gasFeeCap:        new(big.Int).Div(gwei, big.NewInt(10)),
+		data:             []byte{0xa9, 0x05, 0x9c, 0xbb, 0x01, 0x02},
+	}
+	b.addMessage(tx, bridge, inbox, 9, messageIndex, alias(user), l1BaseFee, ticket.encode())
+	b.expectArbitrumDeposit(tx, len(tx.Logs)-2, "retryable", messageIndex, alias(user), &recipient, deposit, ticket.hash(messageIndex, alias(user), l1BaseFee))
+
+	// arbitrum l2 messages carry signed layer 2 transactions, they are indexed without decoding
+	tx = b.addTx(2, user, inbox, nil)
+	messageIndex = big.NewInt(1500002)
+	b.addMessage(tx, bridge, inbox, 3, messageIndex, alias(user), l1BaseFee, append([]byte{0x04}, repeat(0xdd, 100)...))
+	b.expectArbitrumDeposit(tx, len(tx.Logs)-2, "message", messageIndex, alias(user), nil, nil, nil)
+
+	// batch posting reports are internal messages of the sequencer inbox
+	tx = b.addTx(2, sequencer, sequencerInbox, nil)
+	b.addEvent(tx, bridge, "arbitrum_bridge", "MessageDelivered", []common.Hash{common.BigToHash(big.NewInt(1500003)), crypto.Keccak256Hash([]byte("acc"))},
+		sequencerInbox, uint8(13), sequencerInbox, [32]byte(crypto.Keccak256Hash([]byte("report"))), l1BaseFee, uint64(l1Time))
+
+	// executed arbitrum withdrawal
+	tx = b.addTx(2, user, outbox, nil)
+	b.addEvent(tx, outbox, "arbitrum_outbox", "OutBoxTransactionExecuted", []common.Hash{addressTopic(recipient), addressTopic(user), {}}, big.NewInt(98765))
+	b.expectWithdrawal(arbitrum, tx, len(tx.Logs)-1, "executed", common.BigToHash(big.NewInt(98765)).Bytes(), user.Bytes(), recipient.Bytes(), true)
+
+	return b
+}
+
+func optimismBlock() *block {
+	b := newBlock("10", 120000000, l1Time+6)
+	tx := b.addTx(2, user, messagePasser, nil)
+	withdrawalHash := crypto.Keccak256Hash([]byte("withdrawal"))
+	b.addEvent(tx, messagePasser, "l2_to_l1_message_passer", "MessagePassed",
+		[]common.Hash{common.BigToHash(big.NewInt(7)), addressTopic(user), addressTopic(recipient)},
+		ether, big.NewInt(100000), []byte{}, [32]byte(withdrawalHash))
+	b.expectMessage(optimism, tx, withdrawalHash.Bytes(), user, recipient, ether)
+	// events of other contracts are ignored
+	b.addEvent(tx, contract, "l2_to_l1_message_passer", "MessagePassed",
+		[]common.Hash{common.BigToHash(big.NewInt(8)), addressTopic(user), addressTopic(recipient)},
+		ether, big.NewInt(100000), []byte{}, [32]byte(crypto.Keccak256Hash([]byte("other"))))
+
+	// settlement contracts only exist on the layer 1, look-alikes on a rollup are ignored
+	tx = b.addTx(2, sequencer, common.HexToAddress(arbitrum.SequencerInbox), repeat(0xcc, 900))
+	b.addArbitrumBatch(tx, 700003, 0)
+	b.addTx(2, common.HexToAddress(optimism.BatchSubmitter), common.HexToAddress(optimism.BatchInbox), append([]byte{0x00}, repeat(0xaa, 100)...))
+	return b
+}
+
+func arbitrumBlock() *block {
+	b := newBlock("42161", 220000000, l1Time+1)
+	tx := b.addTx(2, user, arbSys, nil)
+	value := new(big.Int).Div(ether, big.NewInt(2))
+	b.addEvent(tx, arbSys, "arb_sys", "L2ToL1Tx",
+		[]common.Hash{addressTopic(recipient), crypto.Keccak256Hash([]byte("send")), common.BigToHash(big.NewInt(98765))},
+		user, big.NewInt(220000000), big.NewInt(l1BlockNumber), big.NewInt(l1Time+1), value, []byte{})
+	b.expectMessage(arbitrum, tx, common.BigToHash(big.NewInt(98765)).Bytes(), user, recipient, value)
+
+	// settlement contracts only exist on the layer 1, look-alikes on a rollup are ignored
+	b.addTx(2, common.HexToAddress(optimism.BatchSubmitter), common.HexToAddress(optimism.BatchInbox), append([]byte{0x00}, repeat(0xaa, 100)...))
+	tx = b.addTx(2, user, common.HexToAddress(optimism.OptimismPortal), nil)
+	opaqueData := slices.Concat(common.BigToHash(ether).Bytes(), common.BigToHash(ether).Bytes(), uint64Bytes(100000), []byte{0})
+	b.addEvent(tx, common.HexToAddress(optimism.OptimismPortal), "optimism_portal", "TransactionDeposited", []common.Hash{addressTopic(user), addressTopic(user), {}}, opaqueData)
+	return b
+}
+
+func newBlock(chainId string, number uint64, ts int64) *block {
+	return &block{
+		chainId: chainId,
+		blk: &types.Eth1Block{
+			Hash:   crypto.Keccak256([]byte(fmt.Sprintf("block %s %d", chainId, number))),
+			Number: number,
+			Time:   timestamppb.New(time.Unix(ts, 0)),
+		},
+	}
+}
+
+func (b *block) addTx(txType uint32, from, to common.Address, data []byte) *types.Eth1Transaction {
+	tx := &types.Eth1Transaction{
+		Type:     txType,
+		Hash:     crypto.Keccak256([]byte(fmt.Sprintf("tx %s %d %d", b.chainId, b.blk.Number, len(b.blk.Transactions)))),
+		From:     from.Bytes(),
+		To:       to.Bytes(),
+		Data:     data,
+		GasPrice: new(big.Int).Mul(big.NewInt(12), gwei).Bytes(),
+		GasUsed:  21000 + 16*uint64(len(data)),
+		Status:   1,
+	}
+	b.blk.Transactions = append(b.blk.Transactions, tx)
+	return tx
+}
+
+// addLog adds a log to the transaction and returns its index within the block
+func (b *block) addLog(tx *types.Eth1Transaction, address common.Address, topics []common.Hash, data []byte) uint64 {
+	l := &types.Eth1Log{Address: address.Bytes(), Data: data}
+	for _, topic := range topics {
+		l.Topics = append(l.Topics, topic.Bytes())
+	}
+	tx.Logs = append(tx.Logs, l)
+	b.logs++
+	return b.logs - 1
+}
+
+// addEvent adds an event of the contract abi with the given indexed topics and abi encoded non-indexed arguments
+func (b *block) addEvent(tx *types.Eth1Transaction, address common.Address, contractAbi, name string, topics []common.Hash, args ...interface{}) uint64 {
+	event, ok := loadAbi(contractAbi).Events[name]
+	if !ok {
+		log.Fatalf("unknown event %s of %s", name, contractAbi)
+	}
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		log.Fatalf("error packing %s: %v", name, err)
+	}
+	return b.addLog(tx, address, append([]common.Hash{event.ID}, topics...), data)
+}
+
+// addMessage adds the MessageDelivered event of the bridge followed by the InboxMessageDelivered event of the inbox
+// addArbitrumBatch emits the SequencerBatchDelivered event of a batch posted to the sequencer inbox
+func (b *block) addArbitrumBatch(tx *types.Eth1Transaction, number int64, dataLocation uint8) {
+	timeBounds := struct {
+		MinTimestamp   uint64
+		MaxTimestamp   uint64
+		MinBlockNumber uint64
+		MaxBlockNumber uint64
+	}{l1Time - 86400, l1Time + 3600, l1BlockNumber - 7200, l1BlockNumber + 300}
+	b.addEvent(tx, common.HexToAddress(arbitrum.SequencerInbox), "arbitrum_sequencer_inbox", "SequencerBatchDelivered",
+		[]common.Hash{common.BigToHash(big.NewInt(number)), crypto.Keccak256Hash([]byte("before")), crypto.Keccak256Hash([]byte("after"))},
+		[32]byte(crypto.Keccak256Hash([]byte("delayed"))), big.NewInt(1500000), timeBounds, dataLocation)
+}
+
+func (b *block) addMessage(tx *types.Eth1Transaction, bridge, inbox common.Address, kind uint8, messageIndex *big.Int, sender common.Address, l1BaseFee *big.Int, data []byte) {
+	b.addEvent(tx, bridge, "arbitrum_bridge", "MessageDelivered", []common.Hash{common.BigToHash(messageIndex), crypto.Keccak256Hash([]byte("acc"), messageIndex.Bytes())},
+		inbox, kind, sender, [32]byte(crypto.Keccak256Hash(data)), l1BaseFee, uint64(l1Time))
+	b.addEvent(tx, inbox, "arbitrum_inbox", "InboxMessageDelivered", []common.Hash{common.BigToHash(messageIndex)}, data)
+}
+
+func (b *block) expect(key, typ string, value proto.Message) {
+	encoded, err := marshalProto(value)
+	if err != nil {
+		log.Fatalf("error encoding row %s: %v", key, err)
+	}
+	b.rows = append(b.rows, fixtureRow{Key: key, Type: typ, Value: encoded})
+}
+
+func (b *block) txIndex(tx *types.Eth1Transaction) int {
+	return slices.Index(b.blk.Transactions, tx)
+}
+
+func (b *block) logKey(network types.Layer2Network, table string, tx *types.Eth1Transaction, logIndex int) string {
+	return fmt.Sprintf("%d:L2:%s:%09d:%04d:%05d", network.ChainId, table, maxBlockNumber-b.blk.Number, txPerBlockLimit-b.txIndex(tx), itxPerTxLimit-logIndex)
+}
+
+func (b *block) expectBatch(network types.Layer2Network, tx *types.Eth1Transaction, location string, dataSize uint64, hasBatchNumber bool, batchNumber uint64) {
+	b.expect(fmt.Sprintf("%d:L2:B:%09d:%04d", network.ChainId, maxBlockNumber-b.blk.Number, txPerBlockLimit-b.txIndex(tx)), "batch", &types.Layer2BatchIndexed{
+		TxHash:         tx.Hash,
+		BlockNumber:    b.blk.Number,
+		Time:           b.blk.Time,
+		Submitter:      tx.From,
+		DataLocation:   location,
+		DataSize:       dataSize,
+		BlobCount:      uint64(len(tx.BlobVersionedHashes)),
+		TxFee:          new(big.Int).Mul(new(big.Int).SetBytes(tx.GasPrice), new(big.Int).SetUint64(tx.GasUsed)).Bytes(),
+		BlobTxFee:      new(big.Int).Mul(new(big.Int).SetBytes(tx.BlobGasPrice), new(big.Int).SetUint64(tx.BlobGasUsed)).Bytes(),
+		HasBatchNumber: hasBatchNumber,
+		BatchNumber:    batchNumber,
+	})
+}
+
+func (b *block) expectOptimismDeposit(tx *types.Eth1Transaction, txLogIndex int, blockLogIndex uint64, from common.Address, to *common.Address, mint, value *big.Int, gas uint64, data []byte) {
+	// the source hash of user deposits is keccak256(bytes32(0) || keccak256(l1BlockHash || bytes32(logIndex)))
+	depositId := slices.Concat(b.blk.Hash, common.BigToHash(new(big.Int).SetUint64(blockLogIndex)).Bytes())
+	sourceHash := crypto.Keccak256Hash(make([]byte, 32), crypto.Keccak256(depositId))
+	fields := []interface{}{sourceHash, from, []byte{}, mint, value, gas, false, data}
+	if to != nil {
+		fields[2] = *to
+	}
+	if mint.Sign() == 0 {
+		fields[3] = []byte{}
+	}
+	indexed := &types.Layer2DepositIndexed{
+		L1TxHash:    tx.Hash,
+		BlockNumber: b.blk.Number,
+		Time:        b.blk.Time,
+		Kind:        "deposit",
+		From:        from.Bytes(),
+		Value:       mint.Bytes(),
+		L2TxHash:    typedTxHash(0x7e, fields),
+	}
+	if to != nil {
+		indexed.To = to.Bytes()
+	}
+	b.expect(b.logKey(optimism, "D", tx, txLogIndex), "deposit", indexed)
+}
+
+func (b *block) expectArbitrumDeposit(tx *types.Eth1Transaction, txLogIndex int, kind string, messageIndex *big.Int, from common.Address, to *common.Address, value *big.Int, l2TxHash []byte) {
+	indexed := &types.Layer2DepositIndexed{
+		L1TxHash:        tx.Hash,
+		BlockNumber:     b.blk.Number,
+		Time:            b.blk.Time,
+		Kind:            kind,
+		From:            from.Bytes(),
+		L2TxHash:        l2TxHash,
+		HasMessageIndex: true,
+		MessageIndex:    messageIndex.Uint64(),
+	}
+	if to != nil {
+		indexed.To = to.Bytes()
+	}
+	if value != nil {
+		indexed.Value = value.Bytes()
+	}
+	b.expect(b.logKey(arbitrum, "D", tx, txLogIndex), "deposit", indexed)
+}
+
+func (b *block) expectWithdrawal(network types.Layer2Network, tx *types.Eth1Transaction, txLogIndex int, status string, messageId, from, to []byte, success bool) {
+	b.expect(b.logKey(network, "W", tx, txLogIndex), "withdrawal", &types.Layer2WithdrawalIndexed{
+		L1TxHash:    tx.Hash,
+		BlockNumber: b.blk.Number,
+		Time:        b.blk.Time,
+		Status:      status,
+		MessageId:   messageId,
+		From:        from,
+		To:          to,
+		Success:     success,
+	})
+}
+
+func (b *block) expectMessage(network types.Layer2Network, tx *types.Eth1Transaction, messageId []byte, sender, target common.Address, value *big.Int) {
+	b.expect(fmt.Sprintf("%d:L2:M:%x", network.ChainId, messageId), "message", &types.Layer2MessageIndexed{
+		L2TxHash:    tx.Hash,
+		BlockNumber: b.blk.Number,
+		Time:        b.blk.Time,
+		Sender:      sender.Bytes(),
+		Target:      target.Bytes(),
+		Value:       value.Bytes(),
+	})
+}
+
+type retryable struct {
+	to               common.Address
+	callValue        *big.Int
+	deposit          *big.Int
+	maxSubmissionFee *big.Int
+	feeRefund        common.Address
+	beneficiary      common.Address
+	gas              uint64
+	gasFeeCap        *big.Int
+	data             []byte
+}
+
+// encode lays out the ticket like Inbox.createRetryableTicket: abi.encodePacked(uint256(uint160(to)), l2CallValue, msg.value,
+// maxSubmissionCost, uint256(uint160(excessFeeRefundAddress)), uint256(uint160(callValueRefundAddress)), gasLimit, maxFeePerGas, data.length, data)
+func (r retryable) encode() []byte {
+	return slices.Concat(
+		common.BytesToHash(r.to.Bytes()).Bytes(),
+		common.BigToHash(r.callValue).Bytes(),
+		common.BigToHash(r.deposit).Bytes(),
+		common.BigToHash(r.maxSubmissionFee).Bytes(),
+		common.BytesToHash(r.feeRefund.Bytes()).Bytes(),
+		common.BytesToHash(r.beneficiary.Bytes()).Bytes(),
+		common.BigToHash(new(big.Int).SetUint64(r.gas)).Bytes(),
+		common.BigToHash(r.gasFeeCap).Bytes(),
+		common.BigToHash(big.NewInt(int64(len(r.data)))).Bytes(),
+		r.data,
+	)
+}
+
+// hash returns the hash of the ArbitrumSubmitRetryableTx (type 0x69) the rollup creates for the ticket
+func (r retryable) hash(messageIndex *big.Int, from common.Address, l1BaseFee *big.Int) []byte {
+	return typedTxHash(0x69, []interface{}{
+		new(big.Int).SetUint64(arbitrum.ChainId), common.BigToHash(messageIndex), from, l1BaseFee, r.deposit, r.gasFeeCap, r.gas,
+		r.to, r.callValue, r.beneficiary, r.maxSubmissionFee, r.feeRefund, r.data,
+	})
+}
+
+// arbitrumDepositHash returns the hash of the ArbitrumDepositTx (type 0x64) the rollup creates for an eth deposit
+func arbitrumDepositHash(messageIndex *big.Int, from, to common.Address, value *big.Int) []byte {
+	return typedTxHash(0x64, []interface{}{new(big.Int).SetUint64(arbitrum.ChainId), common.BigToHash(messageIndex), from, to, value})
+}
+
+func typedTxHash(txType byte, fields []interface{}) []byte {
+	encoded, err := rlp.EncodeToBytes(fields)
+	if err != nil {
+		log.Fatalf("error encoding tx: %v", err)
+	}
+	return crypto.Keccak256(append([]byte{txType}, encoded...))
+}
+
+// alias applies the address aliasing of contract senders on the layer 1 to their layer 2 address
+func alias(address common.Address) common.Address {
+	offset := new(big.Int).SetBytes(common.FromHex("0x1111000000000000000000000000000000001111"))
+	aliased := new(big.Int).Add(new(big.Int).SetBytes(address.Bytes()), offset)
+	return common.BytesToAddress(aliased.Bytes())
+}
+
+func addressTopic(address common.Address) common.Hash {
+	return common.BytesToHash(address.Bytes())
+}
+
+func versionedHash(i byte) []byte {
+	hash := crypto.Keccak256([]byte{i})
+	hash[0] = 0x01
+	return hash
+}
+
+func uint64Bytes(v uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, v)
+}
+
+func repeat(b byte, n int) []byte {
+	return slices.Repeat([]byte{b}, n)
+}
+
+var abis = map[string]abi.ABI{}
+
+func loadAbi(name string) abi.ABI {
+	if parsed, ok := abis[name]; ok {
+		return parsed
+	}
+	file, err := os.Open("../contracts/layer2/" + name + ".json")
+	if err != nil {
+		log.Fatalf("error opening abi: %v", err)
+	}
+	defer file.Close()
+	parsed, err := abi.JSON(file)
+	if err != nil {
+		log.Fatalf("error parsing abi %s: %v", name, err)
+	}
+	abis[name] = parsed
+	return parsed
+}
+
+// marshalProto encodes the message as json, the formatting is normalized when the fixture is indented
+func marshalProto(m proto.Message) (json.RawMessage, error) {
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+}
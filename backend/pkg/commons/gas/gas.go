@@ -0,0 +1,282 @@
+package gas
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+)
+
+// EIP-1559 parameters
+const (
+	ElasticityMultiplier     = 2
+	BaseFeeChangeDenominator = 8
+)
+
+// percentiles of the effective priority fees of recent transactions and of the base fees of recent blocks that are used for the estimates
+const (
+	SlowPercentile     = 25
+	StandardPercentile = 50
+	FastPercentile     = 75
+	RapidPercentile    = 90
+)
+
+// estimates are not stored if the head of the blocks table is older than this
+const maxHeadAge = time.Minute * 5
+
+type Estimate struct {
+	Ts       time.Time
+	Block    uint64
+	BaseFee  *big.Int // base fee of the block following Block
+	Slow     *big.Int
+	Standard *big.Int
+	Fast     *big.Int
+	Rapid    *big.Int
+}
+
+// Percentiles holds the values at SlowPercentile, StandardPercentile, FastPercentile and RapidPercentile
+type Percentiles struct {
+	Slow     *big.Int
+	Standard *big.Int
+	Fast     *big.Int
+	Rapid    *big.Int
+}
+
+// percentilesOf returns the percentiles of the given values, the values are sorted in place
+func percentilesOf(values []*big.Int) Percentiles {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Cmp(values[j]) < 0
+	})
+	at := func(percentile int) *big.Int {
+		if len(values) == 0 {
+			return new(big.Int)
+		}
+		return new(big.Int).Set(values[(len(values)-1)*percentile/100])
+	}
+	return Percentiles{
+		Slow:     at(SlowPercentile),
+		Standard: at(StandardPercentile),
+		Fast:     at(FastPercentile),
+		Rapid:    at(RapidPercentile),
+	}
+}
+
+// BaseFeePercentiles returns the percentiles of the base fees of the given blocks
+func BaseFeePercentiles(blocks []*types.Eth1BlockIndexed) Percentiles {
+	baseFees := make([]*big.Int, 0, len(blocks))
+	for _, block := range blocks {
+		baseFees = append(baseFees, new(big.Int).SetBytes(block.BaseFee))
+	}
+	return percentilesOf(baseFees)
+}
+
+// NextBaseFee applies the EIP-1559 update rule to compute the base fee of the block following a parent block
+func NextBaseFee(parentBaseFee *big.Int, gasUsed, gasLimit uint64) *big.Int {
+	gasTarget := gasLimit / ElasticityMultiplier
+	if gasTarget == 0 || gasUsed == gasTarget {
+		return new(big.Int).Set(parentBaseFee)
+	}
+
+	var diff uint64
+	if gasUsed > gasTarget {
+		diff = gasUsed - gasTarget
+	} else {
+		diff = gasTarget - gasUsed
+	}
+	delta := new(big.Int).Mul(parentBaseFee, new(big.Int).SetUint64(diff))
+	delta.Div(delta, new(big.Int).SetUint64(gasTarget))
+	delta.Div(delta, big.NewInt(BaseFeeChangeDenominator))
+
+	if gasUsed > gasTarget {
+		// the base fee must increase by at least 1 wei if the block was above target
+		if delta.Sign() == 0 {
+			delta.SetUint64(1)
+		}
+		return delta.Add(parentBaseFee, delta)
+	}
+	next := delta.Sub(parentBaseFee, delta)
+	if next.Sign() < 0 {
+		next.SetUint64(0)
+	}
+	return next
+}
+
+// ForecastBaseFees projects the base fee for the next count blocks following a block with the given base fee and gas usage.
+// The first value is exact, all following blocks are assumed to be filled to the given utilization (gas used / gas limit).
+func ForecastBaseFees(baseFee *big.Int, gasUsed, gasLimit uint64, utilization float64, count int) []*big.Int {
+	if count <= 0 {
+		return nil
+	}
+	utilization = min(max(utilization, 0), 1)
+	assumedGasUsed := uint64(utilization * float64(gasLimit))
+
+	forecast := make([]*big.Int, 0, count)
+	next := NextBaseFee(baseFee, gasUsed, gasLimit)
+	forecast = append(forecast, next)
+	for len(forecast) < count {
+		next = NextBaseFee(next, assumedGasUsed, gasLimit)
+		forecast = append(forecast, next)
+	}
+	return forecast
+}
+
+// AverageUtilization returns the average ratio of gas used to gas limit of the given blocks
+func AverageUtilization(blocks []*types.Eth1BlockIndexed) float64 {
+	var utilization float64
+	var count int
+	for _, block := range blocks {
+		if block.GasLimit == 0 {
+			continue
+		}
+		utilization += float64(block.GasUsed) / float64(block.GasLimit)
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+	return utilization / float64(count)
+}
+
+// EffectivePriorityFee returns the priority fee per gas a transaction paid to the fee recipient of a block with the given base fee
+func EffectivePriorityFee(tx *types.Eth1Transaction, baseFee *big.Int) *big.Int {
+	var tip *big.Int
+	switch tx.Type {
+	case 0, 1:
+		tip = new(big.Int).Sub(new(big.Int).SetBytes(tx.GasPrice), baseFee)
+	default:
+		// priority fee is capped because the base fee is filled first
+		tip = new(big.Int).Sub(new(big.Int).SetBytes(tx.MaxFeePerGas), baseFee)
+		prioFee := new(big.Int).SetBytes(tx.MaxPriorityFeePerGas)
+		if prioFee.Cmp(tip) < 0 {
+			tip = prioFee
+		}
+	}
+	if tip.Sign() < 0 {
+		return new(big.Int)
+	}
+	return tip
+}
+
+// EstimateFromBlocks derives gas price estimates from the effective priority fees of the transactions in the given blocks.
+// Each estimate is the base fee of the block following the most recent one plus the respective priority fee percentile.
+func EstimateFromBlocks(blocks []*types.Eth1Block) (*Estimate, error) {
+	if len(blocks) == 0 {
+		return nil, fmt.Errorf("no blocks provided")
+	}
+
+	var head *types.Eth1Block
+	tips := make([]*big.Int, 0)
+	for _, block := range blocks {
+		if head == nil || block.Number > head.Number {
+			head = block
+		}
+		baseFee := new(big.Int).SetBytes(block.BaseFee)
+		for _, tx := range block.Transactions {
+			tips = append(tips, EffectivePriorityFee(tx, baseFee))
+		}
+	}
+	// without transactions all percentiles are 0 and the estimates fall back to the base fee
+	tipPercentiles := percentilesOf(tips)
+
+	nextBaseFee := NextBaseFee(new(big.Int).SetBytes(head.BaseFee), head.GasUsed, head.GasLimit)
+	return &Estimate{
+		Ts:       head.Time.AsTime(),
+		Block:    head.Number,
+		BaseFee:  nextBaseFee,
+		Slow:     tipPercentiles.Slow.Add(tipPercentiles.Slow, nextBaseFee),
+		Standard: tipPercentiles.Standard.Add(tipPercentiles.Standard, nextBaseFee),
+		Fast:     tipPercentiles.Fast.Add(tipPercentiles.Fast, nextBaseFee),
+		Rapid:    tipPercentiles.Rapid.Add(tipPercentiles.Rapid, nextBaseFee),
+	}, nil
+}
+
+// Collect computes gas price estimates from the most recent blockCount blocks of the blocks table
+func Collect(bt *db.Bigtable, blockCount uint64) (*Estimate, error) {
+	if blockCount == 0 {
+		return nil, fmt.Errorf("block count must be greater than 0")
+	}
+	lastBlock, err := bt.GetLastBlockInBlocksTable()
+	if err != nil {
+		return nil, fmt.Errorf("error getting last block in blocks table: %w", err)
+	}
+	high := uint64(lastBlock)
+	low := uint64(0)
+	if high >= blockCount {
+		low = high - blockCount + 1
+	}
+
+	blocks := make([]*types.Eth1Block, 0, blockCount)
+	stream := make(chan *types.Eth1Block)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- bt.GetFullBlocksDescending(stream, high, low)
+		close(stream)
+	}()
+	for block := range stream {
+		blocks = append(blocks, block)
+	}
+	if err := <-errs; err != nil {
+		return nil, fmt.Errorf("error getting blocks %v-%v: %w", low, high, err)
+	}
+
+	return EstimateFromBlocks(blocks)
+}
+
+// CollectAndSave computes the current gas price estimates and stores them in the gas now history
+func CollectAndSave(bt *db.Bigtable, blockCount uint64) error {
+	estimate, err := Collect(bt, blockCount)
+	if err != nil {
+		return err
+	}
+	if age := time.Since(estimate.Ts); age > maxHeadAge {
+		log.Warnf("skipping gas price estimates, head block %v is %v old", estimate.Block, age.Round(time.Second))
+		return nil
+	}
+
+	err = bt.SaveGasNowHistory(estimate.Slow, estimate.Standard, estimate.Rapid, estimate.Fast)
+	if err != nil {
+		return err
+	}
+	log.Debugf("saved gas price estimates for block %v (slow: %v, standard: %v, fast: %v, rapid: %v)", estimate.Block, estimate.Slow, estimate.Standard, estimate.Fast, estimate.Rapid)
+	return nil
+}
+
+// GetHistory returns the stored gas price estimates between pastTs and ts, newest first
+func GetHistory(bt *db.Bigtable, ts, pastTs time.Time) ([]types.GasNowHistory, error) {
+	return bt.GetGasNowHistory(ts, pastTs)
+}
+
+// GetLatest returns the most recently stored gas price estimates if they are not older than maxAge, nil otherwise
+func GetLatest(bt *db.Bigtable, maxAge time.Duration) (*types.GasNowHistory, error) {
+	ts := time.Now()
+	history, err := GetHistory(bt, ts, ts.Add(-maxAge))
+	if err != nil {
+		return nil, err
+	}
+	if len(history) == 0 {
+		return nil, nil
+	}
+	return &history[0], nil
+}
+
+// GetAverageRapid returns the average rapid gas price of the estimates stored within the given duration
+// along with the number of estimates it is based on
+func GetAverageRapid(bt *db.Bigtable, duration time.Duration) (*big.Int, int, error) {
+	ts := time.Now()
+	history, err := GetHistory(bt, ts, ts.Add(-duration))
+	if err != nil {
+		return nil, 0, err
+	}
+	sum := new(big.Int)
+	if len(history) == 0 {
+		return sum, 0, nil
+	}
+	for _, entry := range history {
+		sum.Add(sum, entry.Rapid)
+	}
+	return sum.Div(sum, big.NewInt(int64(len(history)))), len(history), nil
+}
@@ -0,0 +1,182 @@
+package gas
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	tests := []struct {
+		name          string
+		parentBaseFee int64
+		gasUsed       uint64
+		gasLimit      uint64
+		expected      int64
+	}{
+		{"at target", 1000000000, 15000000, 30000000, 1000000000},
+		{"full block", 1000000000, 30000000, 30000000, 1125000000},
+		{"empty block", 1000000000, 0, 30000000, 875000000},
+		{"above target", 1000000000, 20000000, 30000000, 1041666666},
+		{"below target", 1000000000, 10000000, 30000000, 958333334},
+		{"above target increases by at least 1 wei", 7, 15000001, 30000000, 8},
+		{"below target rounds the decrease down", 7, 14999999, 30000000, 7},
+		{"no gas limit", 1000000000, 0, 0, 1000000000},
+	}
+	for _, tt := range tests {
+		parent := big.NewInt(tt.parentBaseFee)
+		next := NextBaseFee(parent, tt.gasUsed, tt.gasLimit)
+		if next.Cmp(big.NewInt(tt.expected)) != 0 {
+			t.Errorf("%s: expected %d, got %v", tt.name, tt.expected, next)
+		}
+		if parent.Int64() != tt.parentBaseFee {
+			t.Errorf("%s: parent base fee was modified to %v", tt.name, parent)
+		}
+	}
+}
+
+func TestForecastBaseFees(t *testing.T) {
+	tests := []struct {
+		name        string
+		gasUsed     uint64
+		utilization float64
+		count       int
+		expected    []int64
+	}{
+		{"no blocks", 30000000, 0.5, 0, nil},
+		{"at target utilization", 30000000, 0.5, 3, []int64{1125000000, 1125000000, 1125000000}},
+		{"full utilization", 30000000, 1, 3, []int64{1125000000, 1265625000, 1423828125}},
+		{"utilization is capped at 1", 30000000, 1.5, 3, []int64{1125000000, 1265625000, 1423828125}},
+		{"no utilization", 30000000, 0, 3, []int64{1125000000, 984375000, 861328125}},
+		{"negative utilization is capped at 0", 15000000, -1, 2, []int64{1000000000, 875000000}},
+	}
+	for _, tt := range tests {
+		forecast := ForecastBaseFees(big.NewInt(1000000000), tt.gasUsed, 30000000, tt.utilization, tt.count)
+		if len(forecast) != len(tt.expected) {
+			t.Fatalf("%s: expected %d blocks, got %d", tt.name, len(tt.expected), len(forecast))
+		}
+		for i := range tt.expected {
+			if forecast[i].Cmp(big.NewInt(tt.expected[i])) != 0 {
+				t.Errorf("%s: block %d: expected %d, got %v", tt.name, i, tt.expected[i], forecast[i])
+			}
+		}
+	}
+}
+
+func TestEffectivePriorityFee(t *testing.T) {
+	tests := []struct {
+		name     string
+		tx       *types.Eth1Transaction
+		expected int64
+	}{
+		{"legacy", &types.Eth1Transaction{Type: 0, GasPrice: big.NewInt(30).Bytes()}, 10},
+		{"access list", &types.Eth1Transaction{Type: 1, GasPrice: big.NewInt(25).Bytes()}, 5},
+		{"legacy below base fee", &types.Eth1Transaction{Type: 0, GasPrice: big.NewInt(10).Bytes()}, 0},
+		{"dynamic fee pays the priority fee", &types.Eth1Transaction{Type: 2, MaxFeePerGas: big.NewInt(30).Bytes(), MaxPriorityFeePerGas: big.NewInt(2).Bytes()}, 2},
+		{"dynamic fee capped by the max fee", &types.Eth1Transaction{Type: 2, MaxFeePerGas: big.NewInt(21).Bytes(), MaxPriorityFeePerGas: big.NewInt(2).Bytes()}, 1},
+		{"dynamic fee below base fee", &types.Eth1Transaction{Type: 2, MaxFeePerGas: big.NewInt(19).Bytes(), MaxPriorityFeePerGas: big.NewInt(2).Bytes()}, 0},
+		{"blob", &types.Eth1Transaction{Type: 3, MaxFeePerGas: big.NewInt(40).Bytes(), MaxPriorityFeePerGas: big.NewInt(3).Bytes()}, 3},
+	}
+	for _, tt := range tests {
+		if tip := EffectivePriorityFee(tt.tx, big.NewInt(20)); tip.Cmp(big.NewInt(tt.expected)) != 0 {
+			t.Errorf("%s: expected %d, got %v", tt.name, tt.expected, tip)
+		}
+	}
+}
+
+func TestEstimateFromBlocks(t *testing.T) {
+	if _, err := EstimateFromBlocks(nil); err == nil {
+		t.Errorf("expected an error without blocks")
+	}
+
+	ts := time.Unix(1700000000, 0)
+	legacyTx := func(gasPrice int64) *types.Eth1Transaction {
+		return &types.Eth1Transaction{Type: 0, GasPrice: big.NewInt(gasPrice).Bytes()}
+	}
+	// the tips of the head block are 1 to 9, the older block adds a tip of 11
+	head := &types.Eth1Block{Number: 101, BaseFee: big.NewInt(1000).Bytes(), GasUsed: 15000000, GasLimit: 30000000, Time: timestamppb.New(ts)}
+	for tip := int64(9); tip >= 1; tip-- {
+		head.Transactions = append(head.Transactions, legacyTx(1000+tip))
+	}
+	older := &types.Eth1Block{Number: 100, BaseFee: big.NewInt(2000).Bytes(), GasUsed: 30000000, GasLimit: 30000000, Time: timestamppb.New(ts.Add(-time.Second * 12))}
+	older.Transactions = append(older.Transactions, legacyTx(2011))
+
+	estimate, err := EstimateFromBlocks([]*types.Eth1Block{older, head})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if estimate.Block != 101 || !estimate.Ts.Equal(ts) {
+		t.Errorf("expected estimate of block 101 at %v, got block %d at %v", ts, estimate.Block, estimate.Ts)
+	}
+	for _, value := range []struct {
+		name     string
+		got      *big.Int
+		expected int64
+	}{
+		{"base fee", estimate.BaseFee, 1000},
+		{"slow", estimate.Slow, 1003},
+		{"standard", estimate.Standard, 1005},
+		{"fast", estimate.Fast, 1007},
+		{"rapid", estimate.Rapid, 1009},
+	} {
+		if value.got.Cmp(big.NewInt(value.expected)) != 0 {
+			t.Errorf("%s: expected %d, got %v", value.name, value.expected, value.got)
+		}
+	}
+
+	// without transactions every estimate is the base fee of the next block
+	empty := &types.Eth1Block{Number: 102, BaseFee: big.NewInt(1000).Bytes(), GasUsed: 30000000, GasLimit: 30000000, Time: timestamppb.New(ts)}
+	estimate, err = EstimateFromBlocks([]*types.Eth1Block{empty})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, got := range []*big.Int{estimate.BaseFee, estimate.Slow, estimate.Standard, estimate.Fast, estimate.Rapid} {
+		if got.Cmp(big.NewInt(1125)) != 0 {
+			t.Errorf("expected 1125 without transactions, got %v", got)
+		}
+	}
+}
+
+func TestBaseFeePercentiles(t *testing.T) {
+	blocks := make([]*types.Eth1BlockIndexed, 0, 10)
+	for _, baseFee := range []int64{7, 3, 10, 1, 5, 9, 2, 8, 4, 6} {
+		blocks = append(blocks, &types.Eth1BlockIndexed{BaseFee: big.NewInt(baseFee).Bytes()})
+	}
+	percentiles := BaseFeePercentiles(blocks)
+	for _, value := range []struct {
+		name     string
+		got      *big.Int
+		expected int64
+	}{
+		{"slow", percentiles.Slow, 3},
+		{"standard", percentiles.Standard, 5},
+		{"fast", percentiles.Fast, 7},
+		{"rapid", percentiles.Rapid, 9},
+	} {
+		if value.got.Cmp(big.NewInt(value.expected)) != 0 {
+			t.Errorf("%s: expected %d, got %v", value.name, value.expected, value.got)
+		}
+	}
+
+	percentiles = BaseFeePercentiles(nil)
+	if percentiles.Slow.Sign() != 0 || percentiles.Rapid.Sign() != 0 {
+		t.Errorf("expected 0 without blocks, got %v and %v", percentiles.Slow, percentiles.Rapid)
+	}
+}
+
+func TestAverageUtilization(t *testing.T) {
+	blocks := []*types.Eth1BlockIndexed{
+		{GasUsed: 15000000, GasLimit: 30000000},
+		{GasUsed: 30000000, GasLimit: 30000000},
+		{GasUsed: 0, GasLimit: 0}, // ignored
+	}
+	if utilization := AverageUtilization(blocks); utilization != 0.75 {
+		t.Errorf("expected 0.75, got %v", utilization)
+	}
+	if utilization := AverageUtilization(nil); utilization != 0 {
+		t.Errorf("expected 0 without blocks, got %v", utilization)
+	}
+}
@@ -0,0 +1,196 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Historic prices are collected from multiple sources as individual quotes and aggregated to hourly and daily OHLC candles.
+// Sources only have to provide the pairs they know, pairs of the same quote currency are combined to cross pairs afterwards.
+
+const (
+	ResolutionHour = "1h"
+	ResolutionDay  = "1d"
+)
+
+// quotes deviating more than this from the median of all sources within the same hour are discarded
+const maxQuoteDeviation = 0.1
+
+// Quote is a single price observation of a pair, e.g. "ETH/USD"
+type Quote struct {
+	Pair  string
+	Ts    time.Time
+	Price float64
+}
+
+type Candle struct {
+	Pair       string    `db:"pair"`
+	Resolution string    `db:"resolution"`
+	Ts         time.Time `db:"ts"` // start of the interval
+	Open       float64   `db:"open"`
+	High       float64   `db:"high"`
+	Low        float64   `db:"low"`
+	Close      float64   `db:"close"`
+	Sources    int       `db:"sources"` // number of sources that provided quotes
+}
+
+// Source provides historic quotes
+type Source interface {
+	Name() string
+	// Quotes returns all quotes of the pair in [from, to), sources that don't know the pair return no quotes
+	Quotes(ctx context.Context, pair string, from, to time.Time) ([]Quote, error)
+}
+
+// FetchQuotes collects the quotes of the pair from all sources, failing sources are skipped as long as one source succeeds
+func FetchQuotes(ctx context.Context, sources []Source, pair string, from, to time.Time) (map[string][]Quote, error) {
+	result := make(map[string][]Quote, len(sources))
+	var errs []string
+	for _, source := range sources {
+		quotes, err := source.Quotes(ctx, pair, from, to)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", source.Name(), err))
+			continue
+		}
+		if len(quotes) > 0 {
+			result[source.Name()] = quotes
+		}
+	}
+	if len(errs) == len(sources) && len(sources) > 0 {
+		return nil, fmt.Errorf("all price sources failed for %s: %s", pair, strings.Join(errs, "; "))
+	}
+	return result, nil
+}
+
+// AggregateHourly builds hourly candles from the quotes of all sources.
+// Each source is condensed to its own candle first so sources with many quotes don't outweigh others,
+// the candles of all sources are then combined using the median. Quotes that deviate too much from the
+// median close of the hour are treated as outliers and ignored.
+func AggregateHourly(pair string, quotesBySource map[string][]Quote) []Candle {
+	type bucket map[string][]float64 // source -> prices in order
+	buckets := map[time.Time]bucket{}
+	for source, quotes := range quotesBySource {
+		sorted := slices.Clone(quotes)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].Ts.Before(sorted[j].Ts) })
+		for _, quote := range sorted {
+			if quote.Price <= 0 || math.IsNaN(quote.Price) || math.IsInf(quote.Price, 0) {
+				continue
+			}
+			hour := quote.Ts.UTC().Truncate(time.Hour)
+			if buckets[hour] == nil {
+				buckets[hour] = bucket{}
+			}
+			buckets[hour][source] = append(buckets[hour][source], quote.Price)
+		}
+	}
+
+	candles := make([]Candle, 0, len(buckets))
+	for hour, bySource := range buckets {
+		closes := make([]float64, 0, len(bySource))
+		for _, prices := range bySource {
+			closes = append(closes, prices[len(prices)-1])
+		}
+		reference := median(closes)
+
+		var opens, highs, lows, finalCloses []float64
+		for _, prices := range bySource {
+			filtered := make([]float64, 0, len(prices))
+			for _, p := range prices {
+				if math.Abs(p-reference)/reference <= maxQuoteDeviation {
+					filtered = append(filtered, p)
+				}
+			}
+			if len(filtered) == 0 {
+				continue
+			}
+			opens = append(opens, filtered[0])
+			highs = append(highs, slices.Max(filtered))
+			lows = append(lows, slices.Min(filtered))
+			finalCloses = append(finalCloses, filtered[len(filtered)-1])
+		}
+		if len(finalCloses) == 0 {
+			continue
+		}
+		candle := Candle{
+			Pair:       pair,
+			Resolution: ResolutionHour,
+			Ts:         hour,
+			Open:       median(opens),
+			High:       median(highs),
+			Low:        median(lows),
+			Close:      median(finalCloses),
+			Sources:    len(finalCloses),
+		}
+		// medians of the individual values don't guarantee a consistent candle
+		candle.High = max(candle.High, candle.Open, candle.Close)
+		candle.Low = min(candle.Low, candle.Open, candle.Close)
+		candles = append(candles, candle)
+	}
+	sort.Slice(candles, func(i, j int) bool { return candles[i].Ts.Before(candles[j].Ts) })
+	return candles
+}
+
+// CrossCandles derives base/quote candles from base/X and quote/X candles of the same hours, e.g. ETH/EUR from ETH/USD and EUR/USD.
+// High and low are approximated with the close of the divisor, intra-hour movements of fiat pairs are negligible.
+func CrossCandles(pair string, base, quote []Candle) []Candle {
+	quoteByTs := make(map[time.Time]Candle, len(quote))
+	for _, c := range quote {
+		quoteByTs[c.Ts] = c
+	}
+	result := make([]Candle, 0, len(base))
+	for _, b := range base {
+		q, ok := quoteByTs[b.Ts]
+		if !ok || q.Open == 0 || q.Close == 0 {
+			continue
+		}
+		result = append(result, Candle{
+			Pair:       pair,
+			Resolution: b.Resolution,
+			Ts:         b.Ts,
+			Open:       b.Open / q.Open,
+			High:       b.High / q.Close,
+			Low:        b.Low / q.Close,
+			Close:      b.Close / q.Close,
+			Sources:    min(b.Sources, q.Sources),
+		})
+	}
+	return result
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return (sorted[mid-1] + sorted[mid]) / 2
+}
+
+// HistoricPair maps currencies to the pair stored in the price history and the factor the price has to be multiplied with,
+// mirroring the special cases of GetPrice
+func HistoricPair(a, b string) (string, float64) {
+	factor := 1.0
+	if a == "xDAI" {
+		a = "DAI"
+	}
+	if b == "xDAI" {
+		b = "DAI"
+	}
+	if a == "mGNO" {
+		a = "GNO"
+		factor /= 32
+	}
+	if b == "mGNO" {
+		b = "GNO"
+		factor *= 32
+	}
+	return a + "/" + b, factor
+}
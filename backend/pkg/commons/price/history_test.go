@@ -0,0 +1,189 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+type testSource struct {
+	name   string
+	quotes []Quote
+	err    error
+}
+
+func (s testSource) Name() string { return s.name }
+
+func (s testSource) Quotes(ctx context.Context, pair string, from, to time.Time) ([]Quote, error) {
+	return s.quotes, s.err
+}
+
+var testHour = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func testQuotes(minutes []int, prices ...float64) []Quote {
+	quotes := make([]Quote, len(prices))
+	for i, p := range prices {
+		quotes[i] = Quote{Pair: "ETH/USD", Ts: testHour.Add(time.Duration(minutes[i]) * time.Minute), Price: p}
+	}
+	return quotes
+}
+
+func TestFetchQuotes(t *testing.T) {
+	failing := testSource{name: "failing", err: errors.New("unavailable")}
+	empty := testSource{name: "empty"}
+	working := testSource{name: "working", quotes: testQuotes([]int{0}, 3000)}
+
+	tests := []struct {
+		name     string
+		sources  []Source
+		expected []string
+		err      bool
+	}{
+		{"failing source is skipped", []Source{failing, working}, []string{"working"}, false},
+		{"source without quotes is omitted", []Source{empty, working}, []string{"working"}, false},
+		{"all sources failing", []Source{failing, failing}, nil, true},
+		{"no sources", nil, nil, false},
+	}
+	for _, tt := range tests {
+		result, err := FetchQuotes(context.Background(), tt.sources, "ETH/USD", testHour, testHour.Add(time.Hour))
+		if (err != nil) != tt.err {
+			t.Errorf("%s: expected error %v, got %v", tt.name, tt.err, err)
+			continue
+		}
+		if len(result) != len(tt.expected) {
+			t.Errorf("%s: expected %d sources, got %d", tt.name, len(tt.expected), len(result))
+		}
+		for _, name := range tt.expected {
+			if _, ok := result[name]; !ok {
+				t.Errorf("%s: expected quotes of %s", tt.name, name)
+			}
+		}
+	}
+}
+
+func TestAggregateHourly(t *testing.T) {
+	tests := []struct {
+		name     string
+		quotes   map[string][]Quote
+		expected []Candle
+	}{
+		{
+			name: "median of sources",
+			quotes: map[string][]Quote{
+				"a": testQuotes([]int{0, 30, 59}, 3000, 3100, 3050),
+				"b": testQuotes([]int{59, 0}, 3060, 2990),
+				"c": testQuotes([]int{0, 59}, 3010, 3040),
+			},
+			expected: []Candle{{Ts: testHour, Open: 3000, High: 3060, Low: 3000, Close: 3050, Sources: 3}},
+		},
+		{
+			name: "outlier source is ignored",
+			quotes: map[string][]Quote{
+				"a": testQuotes([]int{0}, 3000),
+				"b": testQuotes([]int{0}, 3020),
+				"c": testQuotes([]int{0}, 30200),
+			},
+			expected: []Candle{{Ts: testHour, Open: 3010, High: 3010, Low: 3010, Close: 3010, Sources: 2}},
+		},
+		{
+			name: "outlier quote of a source is ignored",
+			quotes: map[string][]Quote{
+				"a": testQuotes([]int{0, 10, 59}, 3000, 300, 3000),
+			},
+			expected: []Candle{{Ts: testHour, Open: 3000, High: 3000, Low: 3000, Close: 3000, Sources: 1}},
+		},
+		{
+			name: "invalid prices are ignored",
+			quotes: map[string][]Quote{
+				"a": testQuotes([]int{0, 1, 2, 3}, 0, -1, math.NaN(), math.Inf(1)),
+				"b": testQuotes([]int{0}, 3000),
+			},
+			expected: []Candle{{Ts: testHour, Open: 3000, High: 3000, Low: 3000, Close: 3000, Sources: 1}},
+		},
+		{
+			name: "hours without quotes are missing",
+			quotes: map[string][]Quote{
+				"a": testQuotes([]int{180, 0}, 3100, 3000),
+				"b": testQuotes([]int{0}, 3000),
+			},
+			expected: []Candle{
+				{Ts: testHour, Open: 3000, High: 3000, Low: 3000, Close: 3000, Sources: 2},
+				{Ts: testHour.Add(time.Hour * 3), Open: 3100, High: 3100, Low: 3100, Close: 3100, Sources: 1},
+			},
+		},
+		{
+			name:     "no sources",
+			quotes:   map[string][]Quote{},
+			expected: []Candle{},
+		},
+	}
+	for _, tt := range tests {
+		candles := AggregateHourly("ETH/USD", tt.quotes)
+		if len(candles) != len(tt.expected) {
+			t.Errorf("%s: expected %d candles, got %d", tt.name, len(tt.expected), len(candles))
+			continue
+		}
+		for i, expected := range tt.expected {
+			expected.Pair = "ETH/USD"
+			expected.Resolution = ResolutionHour
+			if candles[i] != expected {
+				t.Errorf("%s: candle %d: expected %+v, got %+v", tt.name, i, expected, candles[i])
+			}
+		}
+	}
+}
+
+func TestCrossCandles(t *testing.T) {
+	eth := []Candle{
+		{Resolution: ResolutionHour, Ts: testHour, Open: 3000, High: 3300, Low: 2700, Close: 3300, Sources: 3},
+		{Resolution: ResolutionHour, Ts: testHour.Add(time.Hour), Open: 3300, High: 3300, Low: 3300, Close: 3300, Sources: 3},
+		{Resolution: ResolutionHour, Ts: testHour.Add(time.Hour * 2), Open: 3300, High: 3300, Low: 3300, Close: 3300, Sources: 3},
+	}
+	eur := []Candle{
+		{Resolution: ResolutionHour, Ts: testHour, Open: 1.2, High: 1.2, Low: 1.1, Close: 1.1, Sources: 2},
+		{Resolution: ResolutionHour, Ts: testHour.Add(time.Hour * 2), Open: 0, High: 0, Low: 0, Close: 0, Sources: 2},
+	}
+	expected := []Candle{
+		{Pair: "ETH/EUR", Resolution: ResolutionHour, Ts: testHour, Open: 2500, High: 3000, Low: 2454.545, Close: 3000, Sources: 2},
+	}
+
+	candles := CrossCandles("ETH/EUR", eth, eur)
+	if len(candles) != len(expected) {
+		t.Fatalf("expected %d candles, got %d", len(expected), len(candles))
+	}
+	for i, e := range expected {
+		c := candles[i]
+		if c.Pair != e.Pair || c.Resolution != e.Resolution || !c.Ts.Equal(e.Ts) || c.Sources != e.Sources {
+			t.Errorf("candle %d: expected %+v, got %+v", i, e, c)
+		}
+		for _, v := range [][2]float64{{c.Open, e.Open}, {c.High, e.High}, {c.Low, e.Low}, {c.Close, e.Close}} {
+			if math.Abs(v[0]-v[1]) > 0.001 {
+				t.Errorf("candle %d: expected %+v, got %+v", i, e, c)
+				break
+			}
+		}
+	}
+}
+
+func TestHistoricPair(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		pair   string
+		factor float64
+	}{
+		{"ETH", "USD", "ETH/USD", 1},
+		{"GNO", "EUR", "GNO/EUR", 1},
+		{"mGNO", "USD", "GNO/USD", 1.0 / 32},
+		{"xDAI", "EUR", "DAI/EUR", 1},
+		{"USD", "mGNO", "USD/GNO", 32},
+		{"mGNO", "xDAI", "GNO/DAI", 1.0 / 32},
+	}
+	for _, tt := range tests {
+		pair, factor := HistoricPair(tt.a, tt.b)
+		if pair != tt.pair || factor != tt.factor {
+			t.Errorf("HistoricPair(%s, %s): expected %s * %v, got %s * %v", tt.a, tt.b, tt.pair, tt.factor, pair, factor)
+		}
+	}
+}
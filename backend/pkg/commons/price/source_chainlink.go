@@ -0,0 +1,149 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gobitfly/beaconchain/pkg/commons/contracts/chainlink_feed"
+	"github.com/shopspring/decimal"
+)
+
+// ChainlinkSource reads historic answers of the chainlink feeds of the chain.
+// Round ids of the proxy contracts encode the phase in the upper 16 bits and the round of the phase aggregator in the lower 64 bits,
+// rounds are ordered by time within a phase so the first round of the range can be found with a binary search.
+type ChainlinkSource struct {
+	client *ethclient.Client
+	feeds  map[string]*chainlink_feed.Feed
+}
+
+func NewChainlinkSource(chainId uint64, client *ethclient.Client) (*ChainlinkSource, error) {
+	s := &ChainlinkSource{
+		client: client,
+		feeds:  map[string]*chainlink_feed.Feed{},
+	}
+	for pair, addrHex := range ChainlinkFeedAddresses(chainId) {
+		feed, err := chainlink_feed.NewFeed(common.HexToAddress(addrHex), client)
+		if err != nil {
+			return nil, fmt.Errorf("error initializing chainlink feed for %v: %w", pair, err)
+		}
+		s.feeds[pair] = feed
+	}
+	return s, nil
+}
+
+func (s *ChainlinkSource) Name() string {
+	return "chainlink"
+}
+
+type chainlinkRound struct {
+	ts     time.Time
+	answer *big.Int
+}
+
+func (s *ChainlinkSource) Quotes(ctx context.Context, pair string, from, to time.Time) ([]Quote, error) {
+	feed, exists := s.feeds[pair]
+	if !exists {
+		return nil, nil
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	decimals, err := feed.Decimals(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting decimals of chainlink feed %v: %w", pair, err)
+	}
+	latestRound, err := feed.LatestRound(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest round of chainlink feed %v: %w", pair, err)
+	}
+	currentPhase := uint16(new(big.Int).Rsh(latestRound, 64).Uint64())
+
+	getRound := func(phase uint16, round uint64) (*chainlinkRound, error) {
+		roundId := new(big.Int).Lsh(big.NewInt(int64(phase)), 64)
+		roundId.Or(roundId, new(big.Int).SetUint64(round))
+		data, err := feed.GetRoundData(opts, roundId)
+		if err != nil {
+			return nil, fmt.Errorf("error getting round %v of chainlink feed %v: %w", roundId, pair, err)
+		}
+		return &chainlinkRound{ts: time.Unix(data.UpdatedAt.Int64(), 0), answer: data.Answer}, nil
+	}
+
+	var quotes []Quote
+	divisor := decimal.New(1, int32(decimals))
+	// walk the phases backwards until the range is covered
+	for phase := currentPhase; phase > 0; phase-- {
+		var lastRound uint64
+		if phase == currentPhase {
+			lastRound = new(big.Int).And(latestRound, new(big.Int).SetUint64(^uint64(0))).Uint64()
+		} else {
+			aggregatorAddress, err := feed.PhaseAggregators(opts, phase)
+			if err != nil {
+				return nil, fmt.Errorf("error getting aggregator of phase %v of chainlink feed %v: %w", phase, pair, err)
+			}
+			if aggregatorAddress == (common.Address{}) {
+				continue
+			}
+			aggregator, err := chainlink_feed.NewFeed(aggregatorAddress, s.client)
+			if err != nil {
+				return nil, err
+			}
+			latest, err := aggregator.LatestRound(opts)
+			if err != nil {
+				return nil, fmt.Errorf("error getting latest round of phase %v of chainlink feed %v: %w", phase, pair, err)
+			}
+			lastRound = latest.Uint64()
+		}
+		if lastRound == 0 {
+			continue
+		}
+
+		// first round that was updated at or after the start of the range
+		var searchErr error
+		first := uint64(sort.Search(int(lastRound), func(i int) bool {
+			if searchErr != nil {
+				return true
+			}
+			r, err := getRound(phase, uint64(i)+1)
+			if err != nil {
+				searchErr = err
+				return true
+			}
+			return !r.ts.Before(from)
+		})) + 1
+		if searchErr != nil {
+			return nil, searchErr
+		}
+
+		for round := first; round <= lastRound; round++ {
+			r, err := getRound(phase, round)
+			if err != nil {
+				return nil, err
+			}
+			if !r.ts.Before(to) {
+				break
+			}
+			if r.ts.Before(from) || r.answer.Sign() <= 0 {
+				continue
+			}
+			quotes = append(quotes, Quote{
+				Pair:  pair,
+				Ts:    r.ts,
+				Price: decimal.NewFromBigInt(r.answer, 0).Div(divisor).InexactFloat64(),
+			})
+		}
+
+		// older phases are only needed if this phase started after the range began
+		firstOfPhase, err := getRound(phase, 1)
+		if err != nil {
+			return nil, err
+		}
+		if !firstOfPhase.ts.After(from) {
+			break
+		}
+	}
+	return quotes, nil
+}
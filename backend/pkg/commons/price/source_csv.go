@@ -0,0 +1,88 @@
+package price
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CsvSource serves quotes of a csv file with the columns "timestamp,pair,price", e.g. "2024-01-01T00:00:00Z,ETH/USD,2281.47".
+// Timestamps may either be RFC3339 or unix seconds, a header line is skipped. The file is read once on creation.
+type CsvSource struct {
+	name   string
+	quotes map[string][]Quote
+}
+
+func NewCsvSource(path string) (*CsvSource, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening price csv %v: %w", path, err)
+	}
+	defer f.Close()
+	s, err := ReadCsvSource(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading price csv %v: %w", path, err)
+	}
+	s.name = "csv:" + path
+	return s, nil
+}
+
+func ReadCsvSource(r io.Reader) (*CsvSource, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+	s := &CsvSource{
+		name:   "csv",
+		quotes: map[string][]Quote{},
+	}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		ts, err := parseQuoteTime(record[0])
+		if err != nil {
+			if line == 1 {
+				// header
+				continue
+			}
+			return nil, fmt.Errorf("invalid timestamp in line %d: %w", line, err)
+		}
+		price, err := strconv.ParseFloat(record[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price in line %d: %w", line, err)
+		}
+		pair := strings.ToUpper(record[1])
+		s.quotes[pair] = append(s.quotes[pair], Quote{Pair: pair, Ts: ts, Price: price})
+	}
+	return s, nil
+}
+
+func parseQuoteTime(value string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func (s *CsvSource) Name() string {
+	return s.name
+}
+
+func (s *CsvSource) Quotes(ctx context.Context, pair string, from, to time.Time) ([]Quote, error) {
+	var result []Quote
+	for _, quote := range s.quotes[pair] {
+		if !quote.Ts.Before(from) && quote.Ts.Before(to) {
+			result = append(result, quote)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,146 @@
+package price
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var httpSourceClient = &http.Client{Timeout: time.Second * 30}
+
+// HttpSource reads quotes from a json api. The url is a template that may contain the placeholders
+// {pair}, {base}, {quote}, {from} and {to} (unix seconds), so any api returning a list of price points can be used,
+// including a local stub during development.
+type HttpSource struct {
+	name           string
+	urlTemplate    string
+	itemsPath      []string // path to the array of price points in the response, empty if the response is the array itself
+	timestampField string   // unix seconds, unix milliseconds or RFC3339
+	priceField     string   // number or numeric string
+	pairs          map[string]bool
+}
+
+// NewHttpSource creates a source for the given pairs, all pairs are requested if none are given.
+// The items path is dot separated, e.g. "data.prices".
+func NewHttpSource(name, urlTemplate, itemsPath, timestampField, priceField string, pairs []string) *HttpSource {
+	s := &HttpSource{
+		name:           name,
+		urlTemplate:    urlTemplate,
+		timestampField: timestampField,
+		priceField:     priceField,
+	}
+	if itemsPath != "" {
+		s.itemsPath = strings.Split(itemsPath, ".")
+	}
+	if len(pairs) > 0 {
+		s.pairs = make(map[string]bool, len(pairs))
+		for _, pair := range pairs {
+			s.pairs[strings.ToUpper(pair)] = true
+		}
+	}
+	return s
+}
+
+func (s *HttpSource) Name() string {
+	return s.name
+}
+
+func (s *HttpSource) Quotes(ctx context.Context, pair string, from, to time.Time) ([]Quote, error) {
+	if s.pairs != nil && !s.pairs[pair] {
+		return nil, nil
+	}
+	base, quote, _ := strings.Cut(pair, "/")
+	url := strings.NewReplacer(
+		"{pair}", pair,
+		"{base}", base,
+		"{quote}", quote,
+		"{from}", strconv.FormatInt(from.Unix(), 10),
+		"{to}", strconv.FormatInt(to.Unix(), 10),
+	).Replace(s.urlTemplate)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := httpSourceClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return nil, fmt.Errorf("unexpected status %v: %s", res.StatusCode, body)
+	}
+
+	var body interface{}
+	decoder := json.NewDecoder(res.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+	for _, key := range s.itemsPath {
+		obj, ok := body.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected response, %v is not an object", key)
+		}
+		body = obj[key]
+	}
+	items, ok := body.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected response, no list of price points found")
+	}
+
+	result := make([]Quote, 0, len(items))
+	for i, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected response, price point %d is not an object", i)
+		}
+		ts, err := parseJsonTime(obj[s.timestampField])
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp of price point %d: %w", i, err)
+		}
+		price, err := parseJsonFloat(obj[s.priceField])
+		if err != nil {
+			return nil, fmt.Errorf("invalid price of price point %d: %w", i, err)
+		}
+		if ts.Before(from) || !ts.Before(to) {
+			continue
+		}
+		result = append(result, Quote{Pair: pair, Ts: ts, Price: price})
+	}
+	return result, nil
+}
+
+func parseJsonTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return time.Time{}, err
+		}
+		// values this large can only be milliseconds
+		if n > 1e11 {
+			return time.UnixMilli(n), nil
+		}
+		return time.Unix(n, 0), nil
+	case string:
+		return parseQuoteTime(v)
+	}
+	return time.Time{}, fmt.Errorf("unsupported value %v", value)
+}
+
+func parseJsonFloat(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(v, 64)
+	}
+	return 0, fmt.Errorf("unsupported value %v", value)
+}
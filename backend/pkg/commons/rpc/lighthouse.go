@@ -26,14 +26,12 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 )
 
-// TODO replace most lc.cl.Endpoint with lc.cl and use the interface
-
 // LighthouseLatestHeadEpoch is used to cache the latest head epoch for participation requests
 var LighthouseLatestHeadEpoch uint64 = 0
 
 // LighthouseClient holds the Lighthouse client info
 type LighthouseClient struct {
-	cl                  *consapi.NodeClient
+	cl                  consapi.ClientInt
 	assignmentsCache    *lru.Cache
 	assignmentsCacheMux *sync.Mutex
 	slotsCache          *lru.Cache
@@ -42,7 +40,7 @@ type LighthouseClient struct {
 }
 
 // NewLighthouseClient is used to create a new Lighthouse client
-func NewLighthouseClient(cl *consapi.NodeClient, chainID *big.Int) (*LighthouseClient, error) {
+func NewLighthouseClient(cl consapi.ClientInt, chainID *big.Int) (*LighthouseClient, error) {
 	signer := gethtypes.NewCancunSigner(chainID)
 	client := &LighthouseClient{
 		cl:                  cl,
@@ -56,6 +54,18 @@ func NewLighthouseClient(cl *consapi.NodeClient, chainID *big.Int) (*LighthouseC
 	return client, nil
 }
 
+// endpoint returns the node used for the lighthouse specific requests that are not part of the standard beacon api
+func (lc *LighthouseClient) endpoint() string {
+	switch cl := lc.cl.(type) {
+	case *consapi.MultiNodeClient:
+		return cl.BestNode().Endpoint
+	case *consapi.NodeClient:
+		return cl.Endpoint
+	default:
+		return ""
+	}
+}
+
 func (lc *LighthouseClient) GetNewBlockChan() chan *types.Block {
 	blkCh := make(chan *types.Block, 10)
 	go func() {
@@ -245,6 +255,60 @@ func (lc *LighthouseClient) GetValidatorState(epoch uint64) (*constypes.Standard
 	return parsedValidators, nil
 }
 
+// GetAttesters resolves the validators that signed an attestation from the committees of its epoch
+func (lc *LighthouseClient) GetAttesters(attestation *constypes.Attestation) ([]uint64, error) {
+	epoch := attestation.Data.Slot / utils.Config.Chain.ClConfig.SlotsPerEpoch
+	assignments, err := lc.GetEpochAssignments(epoch)
+	if err != nil {
+		return nil, fmt.Errorf("error receiving epoch assignment for epoch %v: %w", epoch, err)
+	}
+	return attestersFromAssignments(attestation, assignments)
+}
+
+// attestersFromAssignments resolves the aggregation bits of the attestation to validators using the committees of its epoch.
+// Pre electra attestations cover a single committee, electra attestations cover all committees set in the
+// committee bits with the aggregation bits of the committees concatenated.
+func attestersFromAssignments(attestation *constypes.Attestation, assignments *types.EpochAssignments) ([]uint64, error) {
+	committees := []uint64{uint64(attestation.Data.Index)}
+	if len(attestation.CommitteeBits) > 0 {
+		committees = committees[:0]
+		for c := 0; c < len(attestation.CommitteeBits)*8; c++ {
+			if utils.BitAtVector(attestation.CommitteeBits, c) {
+				committees = append(committees, uint64(c))
+			}
+		}
+	}
+
+	aggregationBits := bitfield.Bitlist(attestation.AggregationBits)
+	attesters := []uint64{}
+	offset := uint64(0)
+	for _, committee := range committees {
+		memberCount := aggregationBits.Len()
+		if len(committees) > 1 {
+			memberCount = 0
+			for {
+				if _, found := assignments.AttestorAssignments[utils.FormatAttestorAssignmentKey(attestation.Data.Slot, committee, memberCount)]; !found {
+					break
+				}
+				memberCount++
+			}
+		}
+
+		for i := uint64(0); i < memberCount && offset+i < aggregationBits.Len(); i++ {
+			if !aggregationBits.BitAt(offset + i) {
+				continue
+			}
+			validator, found := assignments.AttestorAssignments[utils.FormatAttestorAssignmentKey(attestation.Data.Slot, committee, i)]
+			if !found { // This should never happen!
+				return nil, fmt.Errorf("error retrieving assigned validator for attestation bit %v for slot %v committee index %v member index %v", offset+i, attestation.Data.Slot, committee, i)
+			}
+			attesters = append(attesters, validator)
+		}
+		offset += memberCount
+	}
+	return attesters, nil
+}
+
 // GetEpochData will get the epoch data from Lighthouse RPC api
 func (lc *LighthouseClient) GetEpochData(epoch uint64, skipHistoricBalances bool) (*types.EpochData, error) {
 	wg := &errgroup.Group{}
@@ -267,12 +331,8 @@ func (lc *LighthouseClient) GetEpochData(epoch uint64, skipHistoricBalances bool
 		data.Finalized = false
 	}
 
-	parsedValidators, err := lc.GetValidatorState(epoch)
-	if err != nil {
-		return nil, fmt.Errorf("error retrieving epoch validators: %w", err)
-	}
-
-	for _, validator := range parsedValidators.Data {
+	// the validators are converted while they are streamed, so the full api response is never held in memory
+	appendValidator := func(validator *constypes.StandardValidator) error {
 		data.Validators = append(data.Validators, &types.Validator{
 			Index:                      validator.Index,
 			PublicKey:                  validator.Validator.Pubkey,
@@ -286,6 +346,15 @@ func (lc *LighthouseClient) GetEpochData(epoch uint64, skipHistoricBalances bool
 			WithdrawableEpoch:          validator.Validator.WithdrawableEpoch,
 			Status:                     string(validator.Status),
 		})
+		return nil
+	}
+	err = lc.cl.StreamValidators(epoch*utils.Config.Chain.ClConfig.SlotsPerEpoch, appendValidator)
+	if err != nil && epoch == 0 {
+		data.Validators = nil
+		err = lc.cl.StreamValidators("genesis", appendValidator)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving epoch validators: %w", err)
 	}
 
 	log.Infof("retrieved data for %v validators for epoch %v", len(data.Validators), epoch)
@@ -950,26 +1019,16 @@ func (lc *LighthouseClient) blockFromResponse(parsedHeaders *constypes.StandardB
 			Signature: attestation.Signature,
 		}
 
-		aggregationBits := bitfield.Bitlist(a.AggregationBits)
-		assignments, err := lc.GetEpochAssignments(a.Data.Slot / utils.Config.Chain.ClConfig.SlotsPerEpoch)
+		attesters, err := lc.GetAttesters(&attestation)
 		if err != nil {
-			return nil, fmt.Errorf("error receiving epoch assignment for epoch %v: %w", a.Data.Slot/utils.Config.Chain.ClConfig.SlotsPerEpoch, err)
+			return nil, fmt.Errorf("error resolving attesters of attestation %v of block %v: %w", i, block.Slot, err)
 		}
-
-		for i := uint64(0); i < aggregationBits.Len(); i++ {
-			if aggregationBits.BitAt(i) {
-				validator, found := assignments.AttestorAssignments[utils.FormatAttestorAssignmentKey(a.Data.Slot, uint64(a.Data.CommitteeIndex), i)]
-				if !found { // This should never happen!
-					validator = 0
-					log.Fatal(fmt.Errorf("error retrieving assigned validator for attestation %v of block %v for slot %v committee index %v member index %v", i, block.Slot, a.Data.Slot, a.Data.CommitteeIndex, i), "", 0)
-				}
-				a.Attesters = append(a.Attesters, validator)
-
-				if block.AttestationDuties[types.ValidatorIndex(validator)] == nil {
-					block.AttestationDuties[types.ValidatorIndex(validator)] = []types.Slot{types.Slot(a.Data.Slot)}
-				} else {
-					block.AttestationDuties[types.ValidatorIndex(validator)] = append(block.AttestationDuties[types.ValidatorIndex(validator)], types.Slot(a.Data.Slot))
-				}
+		a.Attesters = attesters
+		for _, validator := range attesters {
+			if block.AttestationDuties[types.ValidatorIndex(validator)] == nil {
+				block.AttestationDuties[types.ValidatorIndex(validator)] = []types.Slot{types.Slot(a.Data.Slot)}
+			} else {
+				block.AttestationDuties[types.ValidatorIndex(validator)] = append(block.AttestationDuties[types.ValidatorIndex(validator)], types.Slot(a.Data.Slot))
 			}
 		}
 
@@ -1007,9 +1066,41 @@ func (lc *LighthouseClient) blockFromResponse(parsedHeaders *constypes.StandardB
 		}
 	}
 
+	if requests := parsedBlock.Message.Body.ExecutionRequests; requests != nil {
+		addExecutionRequests(block, requests)
+	}
+
 	return block, nil
 }
 
+// addExecutionRequests adds the execution layer requests of an electra block to the block, the requests keep their order
+// which is used as their index when saving them
+func addExecutionRequests(block *types.Block, requests *constypes.ExecutionRequests) {
+	for _, deposit := range requests.Deposits {
+		block.DepositRequests = append(block.DepositRequests, &types.DepositRequest{
+			Pubkey:                deposit.Pubkey,
+			WithdrawalCredentials: deposit.WithdrawalCredentials,
+			Amount:                deposit.Amount,
+			Signature:             deposit.Signature,
+			Index:                 deposit.Index,
+		})
+	}
+	for _, withdrawal := range requests.Withdrawals {
+		block.WithdrawalRequests = append(block.WithdrawalRequests, &types.WithdrawalRequest{
+			SourceAddress:   withdrawal.SourceAddress,
+			ValidatorPubkey: withdrawal.ValidatorPubkey,
+			Amount:          withdrawal.Amount,
+		})
+	}
+	for _, consolidation := range requests.Consolidations {
+		block.ConsolidationRequests = append(block.ConsolidationRequests, &types.ConsolidationRequest{
+			SourceAddress: consolidation.SourceAddress,
+			SourcePubkey:  consolidation.SourcePubkey,
+			TargetPubkey:  consolidation.TargetPubkey,
+		})
+	}
+}
+
 func syncCommitteeParticipation(bits []byte) float64 {
 	participating := 0
 	for i := 0; i < int(utils.Config.Chain.ClConfig.SyncCommitteeSize); i++ {
@@ -1044,7 +1135,7 @@ func (lc *LighthouseClient) GetValidatorParticipation(epoch uint64) (*types.Vali
 
 	log.Infof("requesting validator inclusion data for epoch %v", request_epoch)
 
-	parsedResponse, err := network.Get[LighthouseValidatorParticipationResponse](nil, fmt.Sprintf("%s/lighthouse/validator_inclusion/%d/global", lc.cl.Endpoint, request_epoch))
+	parsedResponse, err := network.Get[LighthouseValidatorParticipationResponse](nil, fmt.Sprintf("%s/lighthouse/validator_inclusion/%d/global", lc.endpoint(), request_epoch))
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving validator participation data for epoch %v: %w", request_epoch, err)
 	}
@@ -1056,7 +1147,7 @@ func (lc *LighthouseClient) GetValidatorParticipation(epoch uint64) (*types.Vali
 		prevEpochActiveGwei := parsedResponse.Data.PreviousEpochActiveGwei
 		if prevEpochActiveGwei == 0 {
 			// lh@5.2.0+ has no previous_epoch_active_gwei field anymore, see https://github.com/sigp/lighthouse/pull/5279
-			parsedPrevResponse, err := network.Get[LighthouseValidatorParticipationResponse](nil, fmt.Sprintf("%s/lighthouse/validator_inclusion/%d/global", lc.cl.Endpoint, request_epoch-1))
+			parsedPrevResponse, err := network.Get[LighthouseValidatorParticipationResponse](nil, fmt.Sprintf("%s/lighthouse/validator_inclusion/%d/global", lc.endpoint(), request_epoch-1))
 			if err != nil {
 				return nil, fmt.Errorf("error retrieving validator participation data for prevEpoch %v: %w", request_epoch-1, err)
 			}
@@ -0,0 +1,133 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	constypes "github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+// electraBlockFixture holds an /eth/v2/beacon/blocks response of an electra block in slot 320 that attests slot 319 with
+// one attestation spanning committees 0 and 2 and one covering committee 1, and carries a deposit, a full exit, a partial
+// withdrawal, a switch to compounding credentials and a consolidation request
+const electraBlockFixture = "../../consapi/testdata/electra_block.json"
+
+func loadElectraBlock(t *testing.T) *constypes.StandardBeaconSlotResponse {
+	data, err := os.ReadFile(electraBlockFixture)
+	if err != nil {
+		t.Fatalf("error reading fixture: %v", err)
+	}
+	var block constypes.StandardBeaconSlotResponse
+	if err := json.Unmarshal(data, &block); err != nil {
+		t.Fatalf("error decoding fixture: %v", err)
+	}
+	return &block
+}
+
+// hexBytes returns n bytes starting with prefix and ending with id, the way the fixture values are built
+func hexBytes(prefix byte, n int, id uint64) []byte {
+	b := make([]byte, n)
+	b[0] = prefix
+	for i := 0; i < 8; i++ {
+		b[n-1-i] = byte(id >> (8 * i))
+	}
+	return b
+}
+
+func TestAttestersFromAssignments(t *testing.T) {
+	// committees of slot 319: 0 = [100, 101, 102], 1 = [110, 111, 112, 113], 2 = [120, 121]
+	assignments := &types.EpochAssignments{AttestorAssignments: map[string]uint64{}}
+	for committee, members := range [][]uint64{{100, 101, 102}, {110, 111, 112, 113}, {120, 121}} {
+		for i, validator := range members {
+			assignments.AttestorAssignments[utils.FormatAttestorAssignmentKey(319, uint64(committee), uint64(i))] = validator
+		}
+	}
+
+	block := loadElectraBlock(t)
+	if block.Version != "electra" || len(block.Data.Message.Body.Attestations) != 2 {
+		t.Fatalf("unexpected fixture: version %v with %d attestations", block.Version, len(block.Data.Message.Body.Attestations))
+	}
+	pre := constypes.Attestation{AggregationBits: hexutil.Bytes{0x13}}
+	pre.Data.Slot = 319
+	pre.Data.Index = 1
+
+	tests := []struct {
+		name        string
+		attestation *constypes.Attestation
+		expected    []uint64
+	}{
+		// the aggregation bits 1, 0, 1 of committee 0 are followed by the bits 0, 1 of committee 2
+		{"electra attestation spanning two committees", &block.Data.Message.Body.Attestations[0], []uint64{100, 102, 121}},
+		{"electra attestation of a single committee", &block.Data.Message.Body.Attestations[1], []uint64{110, 111, 112, 113}},
+		{"pre electra attestation", &pre, []uint64{110, 111}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attesters, err := attestersFromAssignments(tt.attestation, assignments)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(attesters, tt.expected) {
+				t.Errorf("expected attesters %v, got %v", tt.expected, attesters)
+			}
+		})
+	}
+
+	unknown := constypes.Attestation{AggregationBits: hexutil.Bytes{0x03}, CommitteeBits: hexutil.Bytes{0x08, 0, 0, 0, 0, 0, 0, 0}}
+	unknown.Data.Slot = 319
+	if _, err := attestersFromAssignments(&unknown, assignments); err == nil {
+		t.Errorf("expected an error for an attestation of an unknown committee")
+	}
+}
+
+func TestAddExecutionRequests(t *testing.T) {
+	requests := loadElectraBlock(t).Data.Message.Body.ExecutionRequests
+	if requests == nil {
+		t.Fatalf("expected execution requests in the electra block")
+	}
+	block := &types.Block{}
+	addExecutionRequests(block, requests)
+
+	if len(block.DepositRequests) != 1 {
+		t.Fatalf("expected 1 deposit request, got %d", len(block.DepositRequests))
+	}
+	deposit := block.DepositRequests[0]
+	if !bytes.Equal(deposit.Pubkey, hexBytes(0xa0, 48, 1)) || !bytes.Equal(deposit.WithdrawalCredentials, hexBytes(0x02, 32, 0xee01)) || deposit.Amount != 32000000000 || deposit.Index != 128 {
+		t.Errorf("unexpected deposit request %+v", deposit)
+	}
+
+	// the rows saved for the block are indexed by the position of the request
+	expectedWithdrawals := []types.WithdrawalRequest{
+		{SourceAddress: hexBytes(0xee, 20, 2), ValidatorPubkey: hexBytes(0xa0, 48, 2), Amount: 0},
+		{SourceAddress: hexBytes(0xee, 20, 3), ValidatorPubkey: hexBytes(0xa0, 48, 3), Amount: 1000000000},
+	}
+	if len(block.WithdrawalRequests) != len(expectedWithdrawals) {
+		t.Fatalf("expected %d withdrawal requests, got %d", len(expectedWithdrawals), len(block.WithdrawalRequests))
+	}
+	for i, expected := range expectedWithdrawals {
+		wr := block.WithdrawalRequests[i]
+		if !bytes.Equal(wr.SourceAddress, expected.SourceAddress) || !bytes.Equal(wr.ValidatorPubkey, expected.ValidatorPubkey) || wr.Amount != expected.Amount {
+			t.Errorf("withdrawal request %d: expected %+v, got %+v", i, expected, wr)
+		}
+	}
+
+	expectedConsolidations := []types.ConsolidationRequest{
+		{SourceAddress: hexBytes(0xee, 20, 4), SourcePubkey: hexBytes(0xa0, 48, 4), TargetPubkey: hexBytes(0xa0, 48, 4)},
+		{SourceAddress: hexBytes(0xee, 20, 5), SourcePubkey: hexBytes(0xa0, 48, 5), TargetPubkey: hexBytes(0xa0, 48, 6)},
+	}
+	if len(block.ConsolidationRequests) != len(expectedConsolidations) {
+		t.Fatalf("expected %d consolidation requests, got %d", len(expectedConsolidations), len(block.ConsolidationRequests))
+	}
+	for i, expected := range expectedConsolidations {
+		cr := block.ConsolidationRequests[i]
+		if !bytes.Equal(cr.SourceAddress, expected.SourceAddress) || !bytes.Equal(cr.SourcePubkey, expected.SourcePubkey) || !bytes.Equal(cr.TargetPubkey, expected.TargetPubkey) {
+			t.Errorf("consolidation request %d: expected %+v, got %+v", i, expected, cr)
+		}
+	}
+}
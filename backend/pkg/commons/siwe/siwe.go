@@ -0,0 +1,236 @@
+package siwe
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Sign-In with Ethereum messages as defined in EIP-4361 (https://eips.ethereum.org/EIPS/eip-4361).
+// Only signatures of externally owned accounts (EIP-191 personal_sign) are supported, contract wallets (EIP-1271) are not.
+
+const (
+	headerSuffix = " wants you to sign in with your Ethereum account:"
+	uriTag       = "URI: "
+	versionTag   = "Version: "
+	chainIdTag   = "Chain ID: "
+	nonceTag     = "Nonce: "
+	issuedAtTag  = "Issued At: "
+	expiresTag   = "Expiration Time: "
+	notBeforeTag = "Not Before: "
+	requestIdTag = "Request ID: "
+	resourcesTag = "Resources:"
+)
+
+type Message struct {
+	Scheme         string // optional
+	Domain         string
+	Address        common.Address
+	Statement      string // optional
+	Uri            string
+	Version        string
+	ChainId        uint64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime *time.Time // optional
+	NotBefore      *time.Time // optional
+	RequestId      string     // optional
+	Resources      []string   // optional
+}
+
+// ParseMessage parses a message in the EIP-4361 format
+func ParseMessage(message string) (*Message, error) {
+	lines := strings.Split(message, "\n")
+	var m Message
+	i := 0
+	next := func() (string, bool) {
+		if i >= len(lines) {
+			return "", false
+		}
+		i++
+		return lines[i-1], true
+	}
+
+	// header
+	line, _ := next()
+	origin, found := strings.CutSuffix(line, headerSuffix)
+	if !found || origin == "" {
+		return nil, errors.New("siwe: invalid message header")
+	}
+	if scheme, domain, found := strings.Cut(origin, "://"); found {
+		m.Scheme, m.Domain = scheme, domain
+	} else {
+		m.Domain = origin
+	}
+
+	// address, has to use the EIP-55 mixed case checksum encoding
+	line, _ = next()
+	if !common.IsHexAddress(line) || common.HexToAddress(line).Hex() != line {
+		return nil, errors.New("siwe: invalid address, must be checksummed")
+	}
+	m.Address = common.HexToAddress(line)
+
+	// optional statement surrounded by empty lines
+	if line, ok := next(); !ok || line != "" {
+		return nil, errors.New("siwe: missing empty line after address")
+	}
+	line, _ = next()
+	if line != "" && !strings.HasPrefix(line, uriTag) {
+		if strings.Contains(line, "\r") {
+			return nil, errors.New("siwe: invalid statement")
+		}
+		m.Statement = line
+		if line, ok := next(); !ok || line != "" {
+			return nil, errors.New("siwe: missing empty line after statement")
+		}
+		line, _ = next()
+	} else if line == "" {
+		line, _ = next()
+	}
+
+	// required fields
+	var err error
+	value, found := strings.CutPrefix(line, uriTag)
+	if !found {
+		return nil, errors.New("siwe: missing uri")
+	}
+	if _, err := url.Parse(value); err != nil || value == "" {
+		return nil, errors.New("siwe: invalid uri")
+	}
+	m.Uri = value
+
+	line, _ = next()
+	if m.Version, found = strings.CutPrefix(line, versionTag); !found || m.Version != "1" {
+		return nil, errors.New("siwe: missing or unsupported version")
+	}
+
+	line, _ = next()
+	value, found = strings.CutPrefix(line, chainIdTag)
+	if !found {
+		return nil, errors.New("siwe: missing chain id")
+	}
+	if m.ChainId, err = strconv.ParseUint(value, 10, 64); err != nil {
+		return nil, errors.New("siwe: invalid chain id")
+	}
+
+	line, _ = next()
+	if m.Nonce, found = strings.CutPrefix(line, nonceTag); !found || len(m.Nonce) < 8 || !isAlphanumeric(m.Nonce) {
+		return nil, errors.New("siwe: missing or invalid nonce")
+	}
+
+	line, _ = next()
+	value, found = strings.CutPrefix(line, issuedAtTag)
+	if !found {
+		return nil, errors.New("siwe: missing issued at")
+	}
+	if m.IssuedAt, err = time.Parse(time.RFC3339, value); err != nil {
+		return nil, errors.New("siwe: invalid issued at")
+	}
+
+	// optional fields, in this order
+	line, ok := next()
+	if value, found := strings.CutPrefix(line, expiresTag); ok && found {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, errors.New("siwe: invalid expiration time")
+		}
+		m.ExpirationTime = &t
+		line, ok = next()
+	}
+	if value, found := strings.CutPrefix(line, notBeforeTag); ok && found {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, errors.New("siwe: invalid not before")
+		}
+		m.NotBefore = &t
+		line, ok = next()
+	}
+	if value, found := strings.CutPrefix(line, requestIdTag); ok && found {
+		m.RequestId = value
+		line, ok = next()
+	}
+	if ok && line == resourcesTag {
+		for {
+			line, ok = next()
+			value, found := strings.CutPrefix(line, "- ")
+			if !ok || !found {
+				break
+			}
+			m.Resources = append(m.Resources, value)
+		}
+	}
+	// a single trailing newline is tolerated
+	if ok && (line != "" || i != len(lines)) {
+		return nil, fmt.Errorf("siwe: unexpected content in line %d", i)
+	}
+	return &m, nil
+}
+
+func isAlphanumeric(s string) bool {
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks that the message was created by the site at the given origin (e.g. "https://beaconcha.in") for the given chain and nonce
+// and is valid at the given time. The domain and scheme of the message as well as the origin of its uri have to match the origin.
+func (m *Message) Validate(origin string, chainId uint64, nonce string, t time.Time) error {
+	expected, err := url.Parse(origin)
+	if err != nil || expected.Scheme == "" || expected.Host == "" {
+		return fmt.Errorf("siwe: invalid origin %q", origin)
+	}
+	if m.Domain != expected.Host {
+		return errors.New("siwe: domain mismatch")
+	}
+	if m.Scheme != "" && m.Scheme != expected.Scheme {
+		return errors.New("siwe: scheme mismatch")
+	}
+	uri, err := url.Parse(m.Uri)
+	if err != nil || uri.Scheme != expected.Scheme || uri.Host != expected.Host {
+		return errors.New("siwe: uri mismatch")
+	}
+	if m.ChainId != chainId {
+		return errors.New("siwe: chain id mismatch")
+	}
+	if m.Nonce != nonce {
+		return errors.New("siwe: nonce mismatch")
+	}
+	if m.ExpirationTime != nil && !t.Before(*m.ExpirationTime) {
+		return errors.New("siwe: message expired")
+	}
+	if m.NotBefore != nil && t.Before(*m.NotBefore) {
+		return errors.New("siwe: message not yet valid")
+	}
+	return nil
+}
+
+// VerifySignature checks that the raw message was signed by the address of the message.
+// The signature is the 65 byte [R || S || V] output of personal_sign, V may be 0/1 or 27/28.
+func (m *Message) VerifySignature(message string, signature []byte) error {
+	if len(signature) != crypto.SignatureLength {
+		return errors.New("siwe: invalid signature length")
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	publicKey, err := crypto.SigToPub(accounts.TextHash([]byte(message)), sig)
+	if err != nil {
+		return errors.New("siwe: invalid signature")
+	}
+	if crypto.PubkeyToAddress(*publicKey) != m.Address {
+		return errors.New("siwe: signature does not match address")
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package siwe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// the example message of EIP-4361 with an expiration time
+const exampleMessage = `example.com wants you to sign in with your Ethereum account:
+0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2
+
+I accept the ExampleOrg Terms of Service: https://example.com/tos
+
+URI: https://example.com/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756
+Issued At: 2021-09-30T16:25:24Z
+Expiration Time: 2021-09-30T16:35:24Z
+Resources:
+- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/
+- https://example.com/my-web2-claim.json`
+
+func TestParseMessage(t *testing.T) {
+	m, err := ParseMessage(exampleMessage)
+	if err != nil {
+		t.Fatalf("error parsing message: %v", err)
+	}
+	if m.Domain != "example.com" || m.Scheme != "" || m.Address.Hex() != "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2" {
+		t.Errorf("unexpected header %s %s %s", m.Scheme, m.Domain, m.Address.Hex())
+	}
+	if m.Statement != "I accept the ExampleOrg Terms of Service: https://example.com/tos" {
+		t.Errorf("unexpected statement %q", m.Statement)
+	}
+	if m.Uri != "https://example.com/login" || m.ChainId != 1 || m.Nonce != "32891756" {
+		t.Errorf("unexpected fields %s %d %s", m.Uri, m.ChainId, m.Nonce)
+	}
+	if m.ExpirationTime == nil || !m.ExpirationTime.Equal(time.Date(2021, 9, 30, 16, 35, 24, 0, time.UTC)) || len(m.Resources) != 2 {
+		t.Errorf("unexpected optional fields %v %v", m.ExpirationTime, m.Resources)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	issued := time.Date(2021, 9, 30, 16, 25, 24, 0, time.UTC)
+	tests := []struct {
+		name    string
+		modify  func(m *Message)
+		origin  string
+		chainId uint64
+		nonce   string
+		time    time.Time
+		valid   bool
+	}{
+		{"valid", nil, "https://example.com", 1, "32891756", issued, true},
+		{"valid with scheme", func(m *Message) { m.Scheme = "https" }, "https://example.com", 1, "32891756", issued, true},
+		{"other domain", nil, "https://beaconcha.in", 1, "32891756", issued, false},
+		{"other scheme", func(m *Message) { m.Scheme = "http" }, "https://example.com", 1, "32891756", issued, false},
+		{"uri of another origin", func(m *Message) { m.Uri = "https://attacker.example/login" }, "https://example.com", 1, "32891756", issued, false},
+		{"uri of another scheme", func(m *Message) { m.Uri = "http://example.com/login" }, "https://example.com", 1, "32891756", issued, false},
+		{"uri of another port", func(m *Message) { m.Uri = "https://example.com:8443/login" }, "https://example.com", 1, "32891756", issued, false},
+		{"relative uri", func(m *Message) { m.Uri = "/login" }, "https://example.com", 1, "32891756", issued, false},
+		{"other chain", nil, "https://example.com", 17000, "32891756", issued, false},
+		{"other nonce", nil, "https://example.com", 1, "12345678", issued, false},
+		{"expired", nil, "https://example.com", 1, "32891756", issued.Add(time.Minute * 10), false},
+		{"not yet valid", func(m *Message) { notBefore := issued.Add(time.Minute); m.NotBefore = &notBefore }, "https://example.com", 1, "32891756", issued, false},
+		{"invalid origin", nil, "example.com", 1, "32891756", issued, false},
+	}
+	for _, tt := range tests {
+		m, err := ParseMessage(exampleMessage)
+		if err != nil {
+			t.Fatalf("error parsing message: %v", err)
+		}
+		if tt.modify != nil {
+			tt.modify(m)
+		}
+		if err := m.Validate(tt.origin, tt.chainId, tt.nonce, tt.time); (err == nil) != tt.valid {
+			t.Errorf("%s: expected valid %v, got error %v", tt.name, tt.valid, err)
+		}
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	message := "example.com wants you to sign in with your Ethereum account:\n" + crypto.PubkeyToAddress(key.PublicKey).Hex() + exampleMessage[len("example.com wants you to sign in with your Ethereum account:\n0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"):]
+	m, err := ParseMessage(message)
+	if err != nil {
+		t.Fatalf("error parsing message: %v", err)
+	}
+	signature, err := crypto.Sign(accounts.TextHash([]byte(message)), key)
+	if err != nil {
+		t.Fatalf("error signing message: %v", err)
+	}
+	if err := m.VerifySignature(message, signature); err != nil {
+		t.Errorf("expected signature with recovery id 0/1 to be valid: %v", err)
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	if err := m.VerifySignature(message, signature); err != nil {
+		t.Errorf("expected signature with recovery id 27/28 to be valid: %v", err)
+	}
+	if err := m.VerifySignature(message+"\n", signature); err == nil {
+		t.Errorf("expected signature of another message to be rejected")
+	}
+}
@@ -0,0 +1,135 @@
+package twofactor
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// decodeCbor decodes the first CBOR data item (RFC 8949) and returns it together with the remaining bytes.
+// Only what authenticators produce for WebAuthn is supported: definite lengths, integers, byte and text strings,
+// arrays, maps and simple values. Integers are returned as int64, maps as map[any]any.
+func decodeCbor(data []byte) (any, []byte, error) {
+	return decodeCborItem(data, 0)
+}
+
+const maxCborDepth = 16
+
+var errCborTruncated = errors.New("cbor: unexpected end of data")
+
+func decodeCborItem(data []byte, depth int) (any, []byte, error) {
+	if depth > maxCborDepth {
+		return nil, nil, errors.New("cbor: maximum nesting depth exceeded")
+	}
+	if len(data) == 0 {
+		return nil, nil, errCborTruncated
+	}
+	majorType := data[0] >> 5
+	info := data[0] & 0x1f
+	data = data[1:]
+
+	if majorType == 7 {
+		switch info {
+		case 20:
+			return false, data, nil
+		case 21:
+			return true, data, nil
+		case 22, 23:
+			return nil, data, nil
+		default:
+			return nil, nil, fmt.Errorf("cbor: unsupported simple value %d", info)
+		}
+	}
+
+	var argument uint64
+	switch {
+	case info < 24:
+		argument = uint64(info)
+	case info == 24:
+		if len(data) < 1 {
+			return nil, nil, errCborTruncated
+		}
+		argument, data = uint64(data[0]), data[1:]
+	case info == 25:
+		if len(data) < 2 {
+			return nil, nil, errCborTruncated
+		}
+		argument, data = uint64(binary.BigEndian.Uint16(data)), data[2:]
+	case info == 26:
+		if len(data) < 4 {
+			return nil, nil, errCborTruncated
+		}
+		argument, data = uint64(binary.BigEndian.Uint32(data)), data[4:]
+	case info == 27:
+		if len(data) < 8 {
+			return nil, nil, errCborTruncated
+		}
+		argument, data = binary.BigEndian.Uint64(data), data[8:]
+	default:
+		return nil, nil, errors.New("cbor: indefinite lengths are not supported")
+	}
+
+	switch majorType {
+	case 0:
+		if argument > 1<<63-1 {
+			return nil, nil, errors.New("cbor: integer overflow")
+		}
+		return int64(argument), data, nil
+	case 1:
+		if argument > 1<<63-1 {
+			return nil, nil, errors.New("cbor: integer overflow")
+		}
+		return -1 - int64(argument), data, nil
+	case 2, 3:
+		if uint64(len(data)) < argument {
+			return nil, nil, errCborTruncated
+		}
+		value := data[:argument]
+		if majorType == 3 {
+			return string(value), data[argument:], nil
+		}
+		return value, data[argument:], nil
+	case 4:
+		if argument > uint64(len(data)) {
+			return nil, nil, errCborTruncated
+		}
+		array := make([]any, 0, argument)
+		for i := uint64(0); i < argument; i++ {
+			var item any
+			var err error
+			item, data, err = decodeCborItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			array = append(array, item)
+		}
+		return array, data, nil
+	case 5:
+		if argument > uint64(len(data)) {
+			return nil, nil, errCborTruncated
+		}
+		m := make(map[any]any, argument)
+		for i := uint64(0); i < argument; i++ {
+			var key, value any
+			var err error
+			key, data, err = decodeCborItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, nil, errors.New("cbor: unsupported map key type")
+			}
+			value, data, err = decodeCborItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			m[key] = value
+		}
+		return m, data, nil
+	default:
+		// tags (major type 6) are not used by authenticators
+		return nil, nil, fmt.Errorf("cbor: unsupported major type %d", majorType)
+	}
+}
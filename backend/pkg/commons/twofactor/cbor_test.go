@@ -0,0 +1,111 @@
+package twofactor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeCbor(t *testing.T) {
+	// examples of RFC 8949 appendix A within the supported subset
+	tests := []struct {
+		encoded  string
+		expected any
+	}{
+		{"00", int64(0)},
+		{"17", int64(23)},
+		{"1818", int64(24)},
+		{"1903e8", int64(1000)},
+		{"1a000f4240", int64(1000000)},
+		{"1b000000e8d4a51000", int64(1000000000000)},
+		{"20", int64(-1)},
+		{"3863", int64(-100)},
+		{"3903e7", int64(-1000)},
+		{"f4", false},
+		{"f5", true},
+		{"f6", nil},
+		{"40", []byte{}},
+		{"4401020304", []byte{1, 2, 3, 4}},
+		{"60", ""},
+		{"6161", "a"},
+		{"6449455446", "IETF"},
+		{"80", []any{}},
+		{"83010203", []any{int64(1), int64(2), int64(3)}},
+		{"8301820203820405", []any{int64(1), []any{int64(2), int64(3)}, []any{int64(4), int64(5)}}},
+		{"a0", map[any]any{}},
+		{"a201020304", map[any]any{int64(1): int64(2), int64(3): int64(4)}},
+		{"a26161016162820203", map[any]any{"a": int64(1), "b": []any{int64(2), int64(3)}}},
+	}
+	for _, tt := range tests {
+		data, _ := hex.DecodeString(tt.encoded)
+		decoded, rest, err := decodeCbor(data)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.encoded, err)
+			continue
+		}
+		if len(rest) != 0 {
+			t.Errorf("%s: expected no remaining bytes, got %x", tt.encoded, rest)
+		}
+		if !reflect.DeepEqual(decoded, tt.expected) {
+			t.Errorf("%s: expected %#v, got %#v", tt.encoded, tt.expected, decoded)
+		}
+	}
+
+	// only the first item is decoded
+	decoded, rest, err := decodeCbor([]byte{0x01, 0x02, 0x03})
+	if err != nil || decoded != int64(1) || !bytes.Equal(rest, []byte{0x02, 0x03}) {
+		t.Errorf("expected 1 with remaining 0203, got %v with remaining %x (%v)", decoded, rest, err)
+	}
+}
+
+func TestDecodeCborMalformed(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+	}{
+		{"empty", ""},
+		{"missing 1 byte argument", "18"},
+		{"truncated 2 byte argument", "1903"},
+		{"truncated 4 byte argument", "1a000f42"},
+		{"truncated 8 byte argument", "1b000000e8d4a510"},
+		{"reserved additional information", "1c"},
+		{"truncated byte string", "44010203"},
+		{"truncated text string", "6449455"},
+		{"byte string longer than the input", "5bffffffffffffffff00"},
+		{"truncated array", "830102"},
+		{"array longer than the input", "9bffffffffffffffff00"},
+		{"truncated map", "a20102"},
+		{"map longer than the input", "bbffffffffffffffff00"},
+		{"map value missing", "a101"},
+		{"array map key", "a18001"},
+		{"indefinite byte string", "5f4101ff"},
+		{"indefinite array", "9f01ff"},
+		{"indefinite map", "bf0101ff"},
+		{"unsigned integer overflow", "1bffffffffffffffff"},
+		{"negative integer overflow", "3bffffffffffffffff"},
+		{"tag", "c11a514b67b0"},
+		{"half precision float", "f93c00"},
+		{"undefined simple value", "f0"},
+		{"nesting too deep", "818181818181818181818181818181818100"},
+	}
+	for _, tt := range tests {
+		data, _ := hex.DecodeString(tt.encoded)
+		if decoded, _, err := decodeCbor(data); err == nil {
+			t.Errorf("%s: expected an error, got %#v", tt.name, decoded)
+		}
+	}
+}
+
+func FuzzDecodeCbor(f *testing.F) {
+	for _, seed := range []string{"00", "3903e7", "6449455446", "8301820203820405", "a26161016162820203", "9bffffffffffffffff00", "a18001"} {
+		data, _ := hex.DecodeString(seed)
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, rest, err := decodeCbor(data)
+		if err == nil && len(rest) >= len(data) {
+			t.Errorf("decoded item did not consume any input")
+		}
+	})
+}
@@ -0,0 +1,24 @@
+package twofactor
+
+import "time"
+
+// failed verifications are counted per user, every MaxFailedAttempts consecutive failures lock the second factor of the
+// user for a duration that doubles with every lockout
+const (
+	MaxFailedAttempts = 5
+	firstLockout      = time.Minute
+	maxLockout        = time.Hour * 24
+)
+
+// LockoutDuration returns how long the second factor is locked after the given number of consecutive failed
+// verifications, zero if it isn't locked
+func LockoutDuration(failedAttempts int) time.Duration {
+	if failedAttempts < MaxFailedAttempts || failedAttempts%MaxFailedAttempts != 0 {
+		return 0
+	}
+	lockout := firstLockout
+	for i := MaxFailedAttempts; i < failedAttempts && lockout < maxLockout; i += MaxFailedAttempts {
+		lockout *= 2
+	}
+	return min(lockout, maxLockout)
+}
@@ -0,0 +1,31 @@
+package twofactor
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockoutDuration(t *testing.T) {
+	tests := []struct {
+		failedAttempts int
+		expected       time.Duration
+	}{
+		{0, 0},
+		{1, 0},
+		{4, 0},
+		{5, time.Minute},
+		{6, 0},
+		{9, 0},
+		{10, time.Minute * 2},
+		{15, time.Minute * 4},
+		{50, time.Minute * 512},
+		{55, time.Minute * 1024},
+		{60, time.Hour * 24},
+		{1000, time.Hour * 24},
+	}
+	for _, tt := range tests {
+		if got := LockoutDuration(tt.failedAttempts); got != tt.expected {
+			t.Errorf("LockoutDuration(%d) = %s, expected %s", tt.failedAttempts, got, tt.expected)
+		}
+	}
+}
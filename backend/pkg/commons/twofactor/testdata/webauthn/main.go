@@ -0,0 +1,258 @@
+// Command webauthn generates the webauthn.json fixture. It plays a software authenticator that answers a registration
+// and an assertion for every supported algorithm the way a browser hands them to the site, i.e. base64url encoded client
+// data, CBOR attestation objects with "none" attestation and raw authenticator data. The encoding is done independently
+// of the package under test so the fixture checks the parser against the format and not against itself.
+//
+//   - ES256 registers with counter 0 and asserts with counter 1
+//   - EdDSA has no counter and always reports 0
+//   - RS256 registers with counter 5 and asserts with counter 6
+//
+// Run it from pkg/commons/twofactor with go generate.
+package main
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"log"
+	"math/big"
+	"os"
+	"slices"
+)
+
+const (
+	rpId   = "beaconcha.in"
+	origin = "https://beaconcha.in"
+
+	flagUserPresent            = 0x01
+	flagAttestedCredentialData = 0x40
+)
+
+type creationResponse struct {
+	Id       string `json:"id"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AttestationObject string `json:"attestationObject"`
+	} `json:"response"`
+}
+
+type assertionResponse struct {
+	Id       string `json:"id"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AuthenticatorData string `json:"authenticatorData"`
+		Signature         string `json:"signature"`
+	} `json:"response"`
+}
+
+type fixtureCredential struct {
+	Algorithm             int64             `json:"algorithm"`
+	RegistrationChallenge string            `json:"registrationChallenge"`
+	Registration          creationResponse  `json:"registration"`
+	AssertionChallenge    string            `json:"assertionChallenge"`
+	Assertion             assertionResponse `json:"assertion"`
+	SignCount             uint32            `json:"signCount"`
+}
+
+type fixture struct {
+	RpId        string              `json:"rpId"`
+	Origin      string              `json:"origin"`
+	Credentials []fixtureCredential `json:"credentials"`
+}
+
+// authenticator is a software authenticator holding a single credential
+type authenticator struct {
+	algorithm    int64
+	credentialId []byte
+	coseKey      []byte
+	sign         func(data []byte) []byte
+	counter      uint32
+	increments   bool
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: webauthn <fixture path>")
+	}
+
+	f := fixture{RpId: rpId, Origin: origin}
+	for _, a := range []*authenticator{newES256(), newEdDSA(), newRS256()} {
+		registrationChallenge, assertionChallenge := randomBytes(32), randomBytes(32)
+		credential := fixtureCredential{
+			Algorithm:             a.algorithm,
+			RegistrationChallenge: encode(registrationChallenge),
+			Registration:          a.register(registrationChallenge),
+			AssertionChallenge:    encode(assertionChallenge),
+			Assertion:             a.assert(assertionChallenge),
+			SignCount:             a.counter,
+		}
+		f.Credentials = append(f.Credentials, credential)
+	}
+
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		log.Fatalf("error encoding fixture: %v", err)
+	}
+	if err := os.WriteFile(os.Args[1], append(data, '\n'), 0o644); err != nil { //nolint:gosec // fixture is not secret
+		log.Fatalf("error saving fixture: %v", err)
+	}
+	log.Printf("saved %d credentials to %s", len(f.Credentials), os.Args[1])
+}
+
+func newES256() *authenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		log.Fatal(err)
+	}
+	x, y := make([]byte, 32), make([]byte, 32)
+	key.X.FillBytes(x)
+	key.Y.FillBytes(y)
+	return &authenticator{
+		algorithm:    -7,
+		credentialId: randomBytes(64),
+		// kty EC2, alg ES256, crv P-256, x, y in canonical key order
+		coseKey: slices.Concat(cborHead(5, 5), cborInt(1), cborInt(2), cborInt(3), cborInt(-7), cborInt(-1), cborInt(1), cborInt(-2), cborBytes(x), cborInt(-3), cborBytes(y)),
+		sign: func(data []byte) []byte {
+			hash := sha256.Sum256(data)
+			signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+			if err != nil {
+				log.Fatal(err)
+			}
+			return signature
+		},
+		increments: true,
+	}
+}
+
+func newEdDSA() *authenticator {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return &authenticator{
+		algorithm:    -8,
+		credentialId: randomBytes(32),
+		// kty OKP, alg EdDSA, crv Ed25519, x
+		coseKey: slices.Concat(cborHead(5, 4), cborInt(1), cborInt(1), cborInt(3), cborInt(-8), cborInt(-1), cborInt(6), cborInt(-2), cborBytes(publicKey)),
+		sign: func(data []byte) []byte {
+			return ed25519.Sign(privateKey, data)
+		},
+	}
+}
+
+func newRS256() *authenticator {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return &authenticator{
+		algorithm:    -257,
+		credentialId: randomBytes(16),
+		// kty RSA, alg RS256, n, e
+		coseKey: slices.Concat(cborHead(5, 4), cborInt(1), cborInt(3), cborInt(3), cborInt(-257), cborInt(-1), cborBytes(key.N.Bytes()), cborInt(-2), cborBytes(big.NewInt(int64(key.E)).Bytes())),
+		sign: func(data []byte) []byte {
+			hash := sha256.Sum256(data)
+			signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+			if err != nil {
+				log.Fatal(err)
+			}
+			return signature
+		},
+		counter:    5,
+		increments: true,
+	}
+}
+
+func (a *authenticator) register(challenge []byte) creationResponse {
+	authData := a.authenticatorData(flagUserPresent | flagAttestedCredentialData)
+	credentialIdLength := make([]byte, 2)
+	binary.BigEndian.PutUint16(credentialIdLength, uint16(len(a.credentialId)))
+	// attested credential data with an all zero aaguid as sent with "none" attestation
+	authData = slices.Concat(authData, make([]byte, 16), credentialIdLength, a.credentialId, a.coseKey)
+
+	var resp creationResponse
+	resp.Id = encode(a.credentialId)
+	resp.Type = "public-key"
+	resp.Response.ClientDataJSON = encode(clientData("webauthn.create", challenge))
+	resp.Response.AttestationObject = encode(slices.Concat(cborHead(5, 3), cborText("fmt"), cborText("none"), cborText("attStmt"), cborHead(5, 0), cborText("authData"), cborBytes(authData)))
+	return resp
+}
+
+func (a *authenticator) assert(challenge []byte) assertionResponse {
+	if a.increments {
+		a.counter++
+	}
+	authData := a.authenticatorData(flagUserPresent)
+	clientDataJSON := clientData("webauthn.get", challenge)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+
+	var resp assertionResponse
+	resp.Id = encode(a.credentialId)
+	resp.Type = "public-key"
+	resp.Response.ClientDataJSON = encode(clientDataJSON)
+	resp.Response.AuthenticatorData = encode(authData)
+	resp.Response.Signature = encode(a.sign(slices.Concat(authData, clientDataHash[:])))
+	return resp
+}
+
+// authenticatorData returns the rp id hash, flags and signature counter
+func (a *authenticator) authenticatorData(flags byte) []byte {
+	rpIdHash := sha256.Sum256([]byte(rpId))
+	counter := make([]byte, 4)
+	binary.BigEndian.PutUint32(counter, a.counter)
+	return slices.Concat(rpIdHash[:], []byte{flags}, counter)
+}
+
+// clientData returns the client data JSON in the member order browsers use
+func clientData(typ string, challenge []byte) []byte {
+	return []byte(`{"type":"` + typ + `","challenge":"` + encode(challenge) + `","origin":"` + origin + `","crossOrigin":false}`)
+}
+
+func cborHead(majorType byte, argument uint64) []byte {
+	switch {
+	case argument < 24:
+		return []byte{majorType<<5 | byte(argument)}
+	case argument <= 0xff:
+		return []byte{majorType<<5 | 24, byte(argument)}
+	case argument <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{majorType<<5 | 25}, uint16(argument))
+	default:
+		return binary.BigEndian.AppendUint32([]byte{majorType<<5 | 26}, uint32(argument))
+	}
+}
+
+func cborInt(value int64) []byte {
+	if value < 0 {
+		return cborHead(1, uint64(-1-value))
+	}
+	return cborHead(0, uint64(value))
+}
+
+func cborBytes(value []byte) []byte {
+	return append(cborHead(2, uint64(len(value))), value...)
+}
+
+func cborText(value string) []byte {
+	return append(cborHead(3, uint64(len(value))), value...)
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		log.Fatal(err)
+	}
+	return b
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,101 @@
+package twofactor
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+// the SHA1 secret of RFC 6238 appendix B, "12345678901234567890" base32 encoded
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTotpCode(t *testing.T) {
+	// RFC 6238 appendix B SHA1 test vectors, truncated to the last 6 of the 8 digits
+	tests := []struct {
+		time     int64
+		expected string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	key := []byte("12345678901234567890")
+	for _, tt := range tests {
+		if code := totpCode(key, tt.time/totpPeriod); code != tt.expected {
+			t.Errorf("time %d: expected code %s, got %s", tt.time, tt.expected, code)
+		}
+		step, ok := ValidateTotp(rfc6238Secret, tt.expected, time.Unix(tt.time, 0), 0)
+		if !ok || step != tt.time/totpPeriod {
+			t.Errorf("time %d: expected code to be valid for step %d, got %d (%v)", tt.time, tt.time/totpPeriod, step, ok)
+		}
+	}
+}
+
+func TestValidateTotp(t *testing.T) {
+	// 1111111109 is step 37037036, code 081804
+	now := time.Unix(1111111109, 0)
+	key := []byte("12345678901234567890")
+
+	tests := []struct {
+		name         string
+		secret       string
+		code         string
+		time         time.Time
+		lastUsedStep int64
+		expectedStep int64
+		valid        bool
+	}{
+		{"current step", rfc6238Secret, "081804", now, 0, 37037036, true},
+		{"lower case padded secret", "gezdgnbvgy3tqojqgezdgnbvgy3tqojq====", "081804", now, 0, 37037036, true},
+		{"previous step", rfc6238Secret, totpCode(key, 37037035), now, 0, 37037035, true},
+		{"next step", rfc6238Secret, totpCode(key, 37037037), now, 0, 37037037, true},
+		{"two steps ago", rfc6238Secret, totpCode(key, 37037034), now, 0, 0, false},
+		{"two steps ahead", rfc6238Secret, totpCode(key, 37037038), now, 0, 0, false},
+		{"replayed step", rfc6238Secret, "081804", now, 37037036, 0, false},
+		{"later step already used", rfc6238Secret, totpCode(key, 37037035), now, 37037036, 0, false},
+		{"wrong code", rfc6238Secret, "123456", now, 0, 0, false},
+		{"too short", rfc6238Secret, "81804", now, 0, 0, false},
+		{"too long", rfc6238Secret, "0081804", now, 0, 0, false},
+		{"invalid secret", "not base32!", "081804", now, 0, 0, false},
+	}
+	for _, tt := range tests {
+		step, ok := ValidateTotp(tt.secret, tt.code, tt.time, tt.lastUsedStep)
+		if ok != tt.valid || step != tt.expectedStep {
+			t.Errorf("%s: expected (%d, %v), got (%d, %v)", tt.name, tt.expectedStep, tt.valid, step, ok)
+		}
+	}
+}
+
+func TestNewTotpSecret(t *testing.T) {
+	secret, err := NewTotpSecret()
+	if err != nil {
+		t.Fatalf("error creating secret: %v", err)
+	}
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil || len(key) != 20 {
+		t.Fatalf("expected a base32 encoded 20 byte secret, got %s (%v)", secret, err)
+	}
+	code := totpCode(key, time.Now().Unix()/totpPeriod)
+	if _, ok := ValidateTotp(secret, code, time.Now(), 0); !ok {
+		t.Errorf("expected the code of the new secret to be valid")
+	}
+}
+
+func TestTotpUri(t *testing.T) {
+	uri, err := url.Parse(TotpUri("beaconcha.in", "user@example.com", rfc6238Secret))
+	if err != nil {
+		t.Fatalf("error parsing uri: %v", err)
+	}
+	if uri.Scheme != "otpauth" || uri.Host != "totp" || uri.Path != "/beaconcha.in:user@example.com" {
+		t.Errorf("unexpected uri %s", uri)
+	}
+	query := uri.Query()
+	for key, expected := range map[string]string{"secret": rfc6238Secret, "issuer": "beaconcha.in", "algorithm": "SHA1", "digits": "6", "period": "30"} {
+		if query.Get(key) != expected {
+			t.Errorf("expected %s %s, got %s", key, expected, query.Get(key))
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package twofactor
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+// WebAuthn (https://www.w3.org/TR/webauthn-2/) registration and assertion verification for security keys used as second factor.
+// Attestation statements are not verified, registrations request "none" attestation as we don't restrict authenticator models.
+
+// COSE algorithm identifiers offered to authenticators, in order of preference
+const (
+	CoseAlgES256 = -7
+	CoseAlgEdDSA = -8
+	CoseAlgRS256 = -257
+)
+
+var SupportedCoseAlgorithms = []int64{CoseAlgES256, CoseAlgEdDSA, CoseAlgRS256}
+
+const (
+	flagUserPresent            = 0x01
+	flagAttestedCredentialData = 0x40
+)
+
+// RelyingParty identifies the site credentials are bound to
+type RelyingParty struct {
+	Id      string   // domain without scheme and port
+	Origins []string // allowed origins of the client data
+}
+
+// CredentialCreationResponse is the JSON encoded PublicKeyCredential returned by navigator.credentials.create()
+type CredentialCreationResponse struct {
+	Id       string `json:"id"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AttestationObject string `json:"attestationObject"`
+	} `json:"response"`
+}
+
+// CredentialAssertionResponse is the JSON encoded PublicKeyCredential returned by navigator.credentials.get()
+type CredentialAssertionResponse struct {
+	Id       string `json:"id"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AuthenticatorData string `json:"authenticatorData"`
+		Signature         string `json:"signature"`
+	} `json:"response"`
+}
+
+// Credential is what has to be stored after a successful registration
+type Credential struct {
+	Id        []byte
+	PublicKey []byte // COSE encoded
+	SignCount uint32
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// DecodeBase64Url decodes the unpadded base64url encoding used for binary WebAuthn values, padding is tolerated
+func DecodeBase64Url(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+func EncodeBase64Url(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// VerifyRegistration verifies the response of a credential creation for the given challenge and returns the new credential
+func (rp RelyingParty) VerifyRegistration(challenge []byte, resp CredentialCreationResponse) (*Credential, error) {
+	if resp.Type != "public-key" {
+		return nil, errors.New("webauthn: invalid credential type")
+	}
+	clientDataJSON, err := DecodeBase64Url(resp.Response.ClientDataJSON)
+	if err != nil {
+		return nil, errors.New("webauthn: invalid client data encoding")
+	}
+	if err := rp.verifyClientData(clientDataJSON, "webauthn.create", challenge); err != nil {
+		return nil, err
+	}
+
+	attestationObject, err := DecodeBase64Url(resp.Response.AttestationObject)
+	if err != nil {
+		return nil, errors.New("webauthn: invalid attestation object encoding")
+	}
+	decoded, _, err := decodeCbor(attestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("webauthn: invalid attestation object: %w", err)
+	}
+	attestation, ok := decoded.(map[any]any)
+	if !ok {
+		return nil, errors.New("webauthn: invalid attestation object")
+	}
+	authData, ok := attestation["authData"].([]byte)
+	if !ok {
+		return nil, errors.New("webauthn: missing authenticator data")
+	}
+	flags, signCount, err := rp.verifyAuthenticatorData(authData)
+	if err != nil {
+		return nil, err
+	}
+	if flags&flagAttestedCredentialData == 0 {
+		return nil, errors.New("webauthn: missing attested credential data")
+	}
+
+	// attested credential data: aaguid (16), credential id length (2), credential id, public key
+	attested := authData[37:]
+	if len(attested) < 18 {
+		return nil, errors.New("webauthn: attested credential data too short")
+	}
+	idLength := int(binary.BigEndian.Uint16(attested[16:18]))
+	attested = attested[18:]
+	if len(attested) < idLength {
+		return nil, errors.New("webauthn: attested credential data too short")
+	}
+	credentialId := attested[:idLength]
+	publicKey, rest, err := decodeCbor(attested[idLength:])
+	if err != nil {
+		return nil, fmt.Errorf("webauthn: invalid credential public key: %w", err)
+	}
+	if _, err := parseCosePublicKey(publicKey); err != nil {
+		return nil, err
+	}
+	if expectedId, err := DecodeBase64Url(resp.Id); err != nil || !bytes.Equal(expectedId, credentialId) {
+		return nil, errors.New("webauthn: credential id mismatch")
+	}
+
+	return &Credential{
+		Id:        bytes.Clone(credentialId),
+		PublicKey: bytes.Clone(attested[idLength : len(attested)-len(rest)]),
+		SignCount: signCount,
+	}, nil
+}
+
+// VerifyAssertion verifies the response of a credential assertion for the given challenge against the stored credential.
+// Returns the new signature counter which has to be stored.
+func (rp RelyingParty) VerifyAssertion(challenge []byte, resp CredentialAssertionResponse, credential Credential) (uint32, error) {
+	if resp.Type != "public-key" {
+		return 0, errors.New("webauthn: invalid credential type")
+	}
+	clientDataJSON, err := DecodeBase64Url(resp.Response.ClientDataJSON)
+	if err != nil {
+		return 0, errors.New("webauthn: invalid client data encoding")
+	}
+	if err := rp.verifyClientData(clientDataJSON, "webauthn.get", challenge); err != nil {
+		return 0, err
+	}
+	authData, err := DecodeBase64Url(resp.Response.AuthenticatorData)
+	if err != nil {
+		return 0, errors.New("webauthn: invalid authenticator data encoding")
+	}
+	_, signCount, err := rp.verifyAuthenticatorData(authData)
+	if err != nil {
+		return 0, err
+	}
+	signature, err := DecodeBase64Url(resp.Response.Signature)
+	if err != nil {
+		return 0, errors.New("webauthn: invalid signature encoding")
+	}
+
+	decoded, _, err := decodeCbor(credential.PublicKey)
+	if err != nil {
+		return 0, fmt.Errorf("webauthn: invalid stored public key: %w", err)
+	}
+	publicKey, err := parseCosePublicKey(decoded)
+	if err != nil {
+		return 0, err
+	}
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	if !publicKey.verify(append(bytes.Clone(authData), clientDataHash[:]...), signature) {
+		return 0, errors.New("webauthn: invalid signature")
+	}
+
+	// a counter that doesn't increase indicates a cloned authenticator, authenticators without counter always report 0
+	if (signCount != 0 || credential.SignCount != 0) && signCount <= credential.SignCount {
+		return 0, errors.New("webauthn: signature counter did not increase")
+	}
+	return signCount, nil
+}
+
+func (rp RelyingParty) verifyClientData(clientDataJSON []byte, expectedType string, challenge []byte) error {
+	var data clientData
+	if err := json.Unmarshal(clientDataJSON, &data); err != nil {
+		return errors.New("webauthn: invalid client data")
+	}
+	if data.Type != expectedType {
+		return errors.New("webauthn: invalid client data type")
+	}
+	receivedChallenge, err := DecodeBase64Url(data.Challenge)
+	if err != nil || len(challenge) == 0 || subtle.ConstantTimeCompare(receivedChallenge, challenge) != 1 {
+		return errors.New("webauthn: challenge mismatch")
+	}
+	if !slices.Contains(rp.Origins, data.Origin) {
+		return errors.New("webauthn: origin not allowed")
+	}
+	return nil
+}
+
+// verifyAuthenticatorData checks the relying party id hash and user presence, returns the flags and the signature counter
+func (rp RelyingParty) verifyAuthenticatorData(authData []byte) (byte, uint32, error) {
+	// rp id hash (32), flags (1), signature counter (4)
+	if len(authData) < 37 {
+		return 0, 0, errors.New("webauthn: authenticator data too short")
+	}
+	rpIdHash := sha256.Sum256([]byte(rp.Id))
+	if subtle.ConstantTimeCompare(authData[:32], rpIdHash[:]) != 1 {
+		return 0, 0, errors.New("webauthn: relying party id mismatch")
+	}
+	flags := authData[32]
+	if flags&flagUserPresent == 0 {
+		return 0, 0, errors.New("webauthn: user not present")
+	}
+	return flags, binary.BigEndian.Uint32(authData[33:37]), nil
+}
+
+type cosePublicKey struct {
+	algorithm int64
+	ecdsa     *ecdsa.PublicKey
+	rsa       *rsa.PublicKey
+	ed25519   ed25519.PublicKey
+}
+
+// parseCosePublicKey parses a COSE_Key (RFC 9053) of one of the supported algorithms
+func parseCosePublicKey(decoded any) (*cosePublicKey, error) {
+	key, ok := decoded.(map[any]any)
+	if !ok {
+		return nil, errors.New("webauthn: invalid public key")
+	}
+	algorithm, _ := key[int64(3)].(int64)
+	switch algorithm {
+	case CoseAlgES256:
+		curve, _ := key[int64(-1)].(int64)
+		x, _ := key[int64(-2)].([]byte)
+		y, _ := key[int64(-3)].([]byte)
+		if curve != 1 || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("webauthn: invalid ES256 public key")
+		}
+		// ecdh validates that the point is on the curve
+		if _, err := ecdh.P256().NewPublicKey(slices.Concat([]byte{0x04}, x, y)); err != nil {
+			return nil, errors.New("webauthn: invalid ES256 public key")
+		}
+		publicKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		return &cosePublicKey{algorithm: algorithm, ecdsa: publicKey}, nil
+	case CoseAlgRS256:
+		n, _ := key[int64(-1)].([]byte)
+		e, _ := key[int64(-2)].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("webauthn: invalid RS256 public key")
+		}
+		return &cosePublicKey{algorithm: algorithm, rsa: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}}, nil
+	case CoseAlgEdDSA:
+		curve, _ := key[int64(-1)].(int64)
+		x, _ := key[int64(-2)].([]byte)
+		if curve != 6 || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("webauthn: invalid EdDSA public key")
+		}
+		return &cosePublicKey{algorithm: algorithm, ed25519: ed25519.PublicKey(x)}, nil
+	default:
+		return nil, fmt.Errorf("webauthn: unsupported public key algorithm %d", algorithm)
+	}
+}
+
+func (k *cosePublicKey) verify(data, signature []byte) bool {
+	switch k.algorithm {
+	case CoseAlgES256:
+		hash := sha256.Sum256(data)
+		return ecdsa.VerifyASN1(k.ecdsa, hash[:], signature)
+	case CoseAlgRS256:
+		hash := sha256.Sum256(data)
+		return rsa.VerifyPKCS1v15(k.rsa, crypto.SHA256, hash[:], signature) == nil
+	case CoseAlgEdDSA:
+		return ed25519.Verify(k.ed25519, data, signature)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,183 @@
+package twofactor
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+//go:generate go run ./testdata/webauthn testdata/webauthn.json
+
+type webauthnFixture struct {
+	RpId        string `json:"rpId"`
+	Origin      string `json:"origin"`
+	Credentials []struct {
+		Algorithm             int64                       `json:"algorithm"`
+		RegistrationChallenge string                      `json:"registrationChallenge"`
+		Registration          CredentialCreationResponse  `json:"registration"`
+		AssertionChallenge    string                      `json:"assertionChallenge"`
+		Assertion             CredentialAssertionResponse `json:"assertion"`
+		SignCount             uint32                      `json:"signCount"`
+	} `json:"credentials"`
+}
+
+func loadWebauthnFixture(t *testing.T) (RelyingParty, webauthnFixture) {
+	data, err := os.ReadFile("testdata/webauthn.json")
+	if err != nil {
+		t.Fatalf("error reading fixture: %v", err)
+	}
+	var fixture webauthnFixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		t.Fatalf("error decoding fixture: %v", err)
+	}
+	return RelyingParty{Id: fixture.RpId, Origins: []string{fixture.Origin}}, fixture
+}
+
+func mustDecodeBase64Url(t *testing.T, s string) []byte {
+	b, err := DecodeBase64Url(s)
+	if err != nil {
+		t.Fatalf("error decoding %s: %v", s, err)
+	}
+	return b
+}
+
+func TestVerifyRegistrationAndAssertion(t *testing.T) {
+	rp, fixture := loadWebauthnFixture(t)
+	if len(fixture.Credentials) != len(SupportedCoseAlgorithms) {
+		t.Fatalf("expected a credential for each of the %d supported algorithms, got %d", len(SupportedCoseAlgorithms), len(fixture.Credentials))
+	}
+
+	for _, c := range fixture.Credentials {
+		credential, err := rp.VerifyRegistration(mustDecodeBase64Url(t, c.RegistrationChallenge), c.Registration)
+		if err != nil {
+			t.Fatalf("algorithm %d: error verifying registration: %v", c.Algorithm, err)
+		}
+		if string(credential.Id) != string(mustDecodeBase64Url(t, c.Registration.Id)) {
+			t.Errorf("algorithm %d: credential id mismatch", c.Algorithm)
+		}
+		decoded, rest, err := decodeCbor(credential.PublicKey)
+		if err != nil || len(rest) != 0 {
+			t.Fatalf("algorithm %d: stored public key is not a single cbor item: %v", c.Algorithm, err)
+		}
+		publicKey, err := parseCosePublicKey(decoded)
+		if err != nil {
+			t.Fatalf("algorithm %d: error parsing stored public key: %v", c.Algorithm, err)
+		}
+		if publicKey.algorithm != c.Algorithm {
+			t.Errorf("algorithm %d: expected stored key algorithm %d, got %d", c.Algorithm, c.Algorithm, publicKey.algorithm)
+		}
+
+		signCount, err := rp.VerifyAssertion(mustDecodeBase64Url(t, c.AssertionChallenge), c.Assertion, *credential)
+		if err != nil {
+			t.Fatalf("algorithm %d: error verifying assertion: %v", c.Algorithm, err)
+		}
+		if signCount != c.SignCount {
+			t.Errorf("algorithm %d: expected sign count %d, got %d", c.Algorithm, c.SignCount, signCount)
+		}
+	}
+}
+
+func TestVerifyRegistrationRejects(t *testing.T) {
+	rp, fixture := loadWebauthnFixture(t)
+	c := fixture.Credentials[0]
+	challenge := mustDecodeBase64Url(t, c.RegistrationChallenge)
+
+	tests := []struct {
+		name   string
+		rp     RelyingParty
+		modify func(resp *CredentialCreationResponse, challenge []byte) []byte
+	}{
+		{"other challenge", rp, func(resp *CredentialCreationResponse, challenge []byte) []byte {
+			return mustDecodeBase64Url(t, c.AssertionChallenge)
+		}},
+		{"other origin", RelyingParty{Id: rp.Id, Origins: []string{"https://example.com"}}, nil},
+		{"other relying party id", RelyingParty{Id: "example.com", Origins: rp.Origins}, nil},
+		{"other credential type", rp, func(resp *CredentialCreationResponse, challenge []byte) []byte {
+			resp.Type = "password"
+			return challenge
+		}},
+		{"assertion client data", rp, func(resp *CredentialCreationResponse, challenge []byte) []byte {
+			resp.Response.ClientDataJSON = c.Assertion.Response.ClientDataJSON
+			return mustDecodeBase64Url(t, c.AssertionChallenge)
+		}},
+		{"other credential id", rp, func(resp *CredentialCreationResponse, challenge []byte) []byte {
+			resp.Id = fixture.Credentials[1].Registration.Id
+			return challenge
+		}},
+		{"truncated attestation object", rp, func(resp *CredentialCreationResponse, challenge []byte) []byte {
+			attestationObject := mustDecodeBase64Url(t, resp.Response.AttestationObject)
+			resp.Response.AttestationObject = EncodeBase64Url(attestationObject[:len(attestationObject)-1])
+			return challenge
+		}},
+	}
+	for _, tt := range tests {
+		resp := c.Registration
+		challenge := challenge
+		if tt.modify != nil {
+			challenge = tt.modify(&resp, challenge)
+		}
+		if _, err := tt.rp.VerifyRegistration(challenge, resp); err == nil {
+			t.Errorf("%s: expected registration to be rejected", tt.name)
+		}
+	}
+}
+
+func TestVerifyAssertionRejects(t *testing.T) {
+	rp, fixture := loadWebauthnFixture(t)
+	c := fixture.Credentials[0]
+	credential, err := rp.VerifyRegistration(mustDecodeBase64Url(t, c.RegistrationChallenge), c.Registration)
+	if err != nil {
+		t.Fatalf("error verifying registration: %v", err)
+	}
+	other, err := rp.VerifyRegistration(mustDecodeBase64Url(t, fixture.Credentials[1].RegistrationChallenge), fixture.Credentials[1].Registration)
+	if err != nil {
+		t.Fatalf("error verifying registration: %v", err)
+	}
+	challenge := mustDecodeBase64Url(t, c.AssertionChallenge)
+
+	tests := []struct {
+		name       string
+		rp         RelyingParty
+		credential Credential
+		modify     func(resp *CredentialAssertionResponse)
+	}{
+		{"other origin", RelyingParty{Id: rp.Id, Origins: []string{"https://example.com"}}, *credential, nil},
+		{"other relying party id", RelyingParty{Id: "example.com", Origins: rp.Origins}, *credential, nil},
+		{"public key of another credential", rp, *other, nil},
+		{"replayed sign count", rp, Credential{Id: credential.Id, PublicKey: credential.PublicKey, SignCount: c.SignCount}, nil},
+		{"tampered signature", rp, *credential, func(resp *CredentialAssertionResponse) {
+			signature := mustDecodeBase64Url(t, resp.Response.Signature)
+			signature[len(signature)-1] ^= 0x01
+			resp.Response.Signature = EncodeBase64Url(signature)
+		}},
+		{"tampered sign count", rp, *credential, func(resp *CredentialAssertionResponse) {
+			authData := mustDecodeBase64Url(t, resp.Response.AuthenticatorData)
+			authData[36]++
+			resp.Response.AuthenticatorData = EncodeBase64Url(authData)
+		}},
+		{"user not present", rp, *credential, func(resp *CredentialAssertionResponse) {
+			authData := mustDecodeBase64Url(t, resp.Response.AuthenticatorData)
+			authData[32] &^= flagUserPresent
+			resp.Response.AuthenticatorData = EncodeBase64Url(authData)
+		}},
+		{"truncated authenticator data", rp, *credential, func(resp *CredentialAssertionResponse) {
+			resp.Response.AuthenticatorData = EncodeBase64Url(mustDecodeBase64Url(t, resp.Response.AuthenticatorData)[:36])
+		}},
+		{"registration client data", rp, *credential, func(resp *CredentialAssertionResponse) {
+			resp.Response.ClientDataJSON = c.Registration.Response.ClientDataJSON
+		}},
+	}
+	for _, tt := range tests {
+		resp := c.Assertion
+		if tt.modify != nil {
+			tt.modify(&resp)
+		}
+		if _, err := tt.rp.VerifyAssertion(challenge, resp, tt.credential); err == nil {
+			t.Errorf("%s: expected assertion to be rejected", tt.name)
+		}
+	}
+
+	if _, err := rp.VerifyAssertion(mustDecodeBase64Url(t, c.RegistrationChallenge), c.Assertion, *credential); err == nil {
+		t.Errorf("other challenge: expected assertion to be rejected")
+	}
+}
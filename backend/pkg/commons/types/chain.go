@@ -28,6 +28,8 @@ type ClChainConfig struct {
 	CappellaForkEpoch    uint64 `yaml:"CAPELLA_FORK_EPOCH"`
 	DenebForkVersion     string `yaml:"DENEB_FORK_VERSION"`
 	DenebForkEpoch       uint64 `yaml:"DENEB_FORK_EPOCH"`
+	ElectraForkVersion   string `yaml:"ELECTRA_FORK_VERSION"`
+	ElectraForkEpoch     uint64 `yaml:"ELECTRA_FORK_EPOCH"`
 	Eip6110ForkVersion   string `yaml:"EIP6110_FORK_VERSION"`
 	Eip6110ForkEpoch     uint64 `yaml:"EIP6110_FORK_EPOCH"`
 	Eip7002ForkVersion   string `yaml:"EIP7002_FORK_VERSION"`
@@ -35,16 +37,18 @@ type ClChainConfig struct {
 	WhiskForkVersion     string `yaml:"WHISK_FORK_VERSION"`
 	WhiskForkEpoch       uint64 `yaml:"WHISK_FORK_EPOCH"`
 	// time parameters
-	SecondsPerSlot                   uint64 `yaml:"SECONDS_PER_SLOT"`
-	SecondsPerEth1Block              uint64 `yaml:"SECONDS_PER_ETH1_BLOCK"`
-	MinValidatorWithdrawabilityDelay uint64 `yaml:"MIN_VALIDATOR_WITHDRAWABILITY_DELAY"`
-	ShardCommitteePeriod             uint64 `yaml:"SHARD_COMMITTEE_PERIOD"`
-	Eth1FollowDistance               uint64 `yaml:"ETH1_FOLLOW_DISTANCE"`
-	InactivityScoreBias              uint64 `yaml:"INACTIVITY_SCORE_BIAS"`
-	InactivityScoreRecoveryRate      uint64 `yaml:"INACTIVITY_SCORE_RECOVERY_RATE"`
-	EjectionBalance                  uint64 `yaml:"EJECTION_BALANCE"`
-	MinPerEpochChurnLimit            uint64 `yaml:"MIN_PER_EPOCH_CHURN_LIMIT"`
-	ChurnLimitQuotient               uint64 `yaml:"CHURN_LIMIT_QUOTIENT"`
+	SecondsPerSlot                      uint64 `yaml:"SECONDS_PER_SLOT"`
+	SecondsPerEth1Block                 uint64 `yaml:"SECONDS_PER_ETH1_BLOCK"`
+	MinValidatorWithdrawabilityDelay    uint64 `yaml:"MIN_VALIDATOR_WITHDRAWABILITY_DELAY"`
+	ShardCommitteePeriod                uint64 `yaml:"SHARD_COMMITTEE_PERIOD"`
+	Eth1FollowDistance                  uint64 `yaml:"ETH1_FOLLOW_DISTANCE"`
+	InactivityScoreBias                 uint64 `yaml:"INACTIVITY_SCORE_BIAS"`
+	InactivityScoreRecoveryRate         uint64 `yaml:"INACTIVITY_SCORE_RECOVERY_RATE"`
+	EjectionBalance                     uint64 `yaml:"EJECTION_BALANCE"`
+	MinPerEpochChurnLimit               uint64 `yaml:"MIN_PER_EPOCH_CHURN_LIMIT"`
+	ChurnLimitQuotient                  uint64 `yaml:"CHURN_LIMIT_QUOTIENT"`
+	MinPerEpochChurnLimitElectra        uint64 `yaml:"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA"`
+	MaxPerEpochActivationExitChurnLimit uint64 `yaml:"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT"`
 	// fork choice
 	ProposerScoreBoost uint64 `yaml:"PROPOSER_SCORE_BOOST"`
 	// deposit contract
@@ -85,6 +89,8 @@ type ClChainConfig struct {
 	SafeSlotsToUpdateJustified     uint64 `yaml:"SAFE_SLOTS_TO_UPDATE_JUSTIFIED"`
 	MinDepositAmount               uint64 `yaml:"MIN_DEPOSIT_AMOUNT"`
 	MaxEffectiveBalance            uint64 `yaml:"MAX_EFFECTIVE_BALANCE"`
+	MinActivationBalance           uint64 `yaml:"MIN_ACTIVATION_BALANCE"`
+	MaxEffectiveBalanceElectra     uint64 `yaml:"MAX_EFFECTIVE_BALANCE_ELECTRA"`
 	EffectiveBalanceIncrement      uint64 `yaml:"EFFECTIVE_BALANCE_INCREMENT"`
 	MinAttestationInclusionDelay   uint64 `yaml:"MIN_ATTESTATION_INCLUSION_DELAY"`
 	SlotsPerEpoch                  uint64 `yaml:"SLOTS_PER_EPOCH"`
@@ -139,4 +145,10 @@ type ClChainConfig struct {
 	FieldElementsPerBlob       uint64 `yaml:"FIELD_ELEMENTS_PER_BLOB"`
 	MaxBlobCommitmentsPerBlock uint64 `yaml:"MAX_BLOB_COMMITMENTS_PER_BLOCK"`
 	MaxBlobsPerBlock           uint64 `yaml:"MAX_BLOBS_PER_BLOCK"`
+
+	// electra
+	// https://github.com/ethereum/consensus-specs/blob/dev/presets/mainnet/electra.yaml
+	MaxPendingPartialsPerWithdrawalsSweep uint64 `yaml:"MAX_PENDING_PARTIALS_PER_WITHDRAWALS_SWEEP"`
+	MaxPendingDepositsPerEpoch            uint64 `yaml:"MAX_PENDING_DEPOSITS_PER_EPOCH"`
+	WhistleblowerRewardQuotientElectra    uint64 `yaml:"WHISTLEBLOWER_REWARD_QUOTIENT_ELECTRA"`
 }
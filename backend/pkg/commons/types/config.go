@@ -105,6 +105,10 @@ type Config struct {
 			Host     string `yaml:"host" envconfig:"INDEXER_NODE_HOST"`
 			Type     string `yaml:"type" envconfig:"INDEXER_NODE_TYPE"`
 			PageSize int32  `yaml:"pageSize" envconfig:"INDEXER_NODE_PAGE_SIZE"`
+			// additional beacon nodes, if set the exporter sends its requests to the healthiest of all nodes
+			Endpoints       []string `yaml:"endpoints" envconfig:"INDEXER_NODE_ENDPOINTS"`
+			MaxHeadSlotLag  uint64   `yaml:"maxHeadSlotLag" envconfig:"INDEXER_NODE_MAX_HEAD_SLOT_LAG"`
+			FinalizedQuorum int      `yaml:"finalizedQuorum" envconfig:"INDEXER_NODE_FINALIZED_QUORUM"` // number of nodes that have to agree on finalized data
 		} `yaml:"node"`
 		ELDepositContractFirstBlock uint64 `yaml:"eth1DepositContractFirstBlock" envconfig:"INDEXER_ETH1_DEPOSIT_CONTRACT_FIRST_BLOCK"`
 		DoNotTraceDeposits          bool   `yaml:"doNotTraceDeposits" envconfig:"INDEXER_DO_NOT_TRACE_DEPOSITS"`
@@ -281,15 +285,49 @@ type Config struct {
 		MachineEventSecondRatioThreshold              float64 `yaml:"machineEventSecondRatioThreshold" envconfig:"MACHINE_EVENT_SECOND_RATIO_THRESHOLD"`
 	} `yaml:"notifications"`
 	SSVExporter struct {
-		Enabled bool   `yaml:"enabled" envconfig:"SSV_EXPORTER_ENABLED"`
-		Address string `yaml:"address" envconfig:"SSV_EXPORTER_ADDRESS"`
+		Enabled bool `yaml:"enabled" envconfig:"SSV_EXPORTER_ENABLED"`
+		// operators, clusters and validators are synced from the events of the ssv network contract
+		NetworkAddress  string `yaml:"networkAddress" envconfig:"SSV_EXPORTER_NETWORK_ADDRESS"`
+		DeploymentBlock uint64 `yaml:"deploymentBlock" envconfig:"SSV_EXPORTER_DEPLOYMENT_BLOCK"`
+		// optional, the operator names are fetched from the ssv api (e.g. https://api.ssv.network/api/v4/mainnet)
+		ApiAddress string `yaml:"apiAddress" envconfig:"SSV_EXPORTER_API_ADDRESS"`
 	} `yaml:"SSVExporter"`
 	RocketpoolExporter struct {
 		Enabled bool `yaml:"enabled" envconfig:"ROCKETPOOL_EXPORTER_ENABLED"`
 	} `yaml:"rocketpoolExporter"`
+	StakingProtocolsExporter struct {
+		Enabled bool `yaml:"enabled" envconfig:"STAKING_PROTOCOLS_EXPORTER_ENABLED"`
+		// a protocol is only exported if its contract addresses are set
+		Lido struct {
+			NodeOperatorsRegistryAddress string `yaml:"nodeOperatorsRegistryAddress" envconfig:"STAKING_PROTOCOLS_LIDO_NODE_OPERATORS_REGISTRY_ADDRESS"`
+			StakingRouterAddress         string `yaml:"stakingRouterAddress" envconfig:"STAKING_PROTOCOLS_LIDO_STAKING_ROUTER_ADDRESS"`
+		} `yaml:"lido"`
+		EtherFi struct {
+			StakingManagerAddress      string `yaml:"stakingManagerAddress" envconfig:"STAKING_PROTOCOLS_ETHER_FI_STAKING_MANAGER_ADDRESS"`
+			NodeOperatorManagerAddress string `yaml:"nodeOperatorManagerAddress" envconfig:"STAKING_PROTOCOLS_ETHER_FI_NODE_OPERATOR_MANAGER_ADDRESS"`
+			DeploymentBlock            uint64 `yaml:"deploymentBlock" envconfig:"STAKING_PROTOCOLS_ETHER_FI_DEPLOYMENT_BLOCK"`
+			// the fee split isn't exposed by the contracts in a single place
+			TreasuryFee float64 `yaml:"treasuryFee" envconfig:"STAKING_PROTOCOLS_ETHER_FI_TREASURY_FEE"`
+			OperatorFee float64 `yaml:"operatorFee" envconfig:"STAKING_PROTOCOLS_ETHER_FI_OPERATOR_FEE"`
+		} `yaml:"etherFi"`
+	} `yaml:"stakingProtocolsExporter"`
 	MevBoostRelayExporter struct {
 		Enabled bool `yaml:"enabled" envconfig:"MEVBOOSTRELAY_EXPORTER_ENABLED"`
+		// bids received by a relay for a slot beyond the best bid of each builder are capped to this many, 0 keeps 100 bids
+		MaxBidsPerSlot uint64 `yaml:"maxBidsPerSlot" envconfig:"MEVBOOSTRELAY_EXPORTER_MAX_BIDS_PER_SLOT"`
+		// bids are kept for this many days, 0 keeps them for a week. the best bid of each slot is kept forever
+		BidsRetentionDays uint64 `yaml:"bidsRetentionDays" envconfig:"MEVBOOSTRELAY_EXPORTER_BIDS_RETENTION_DAYS"`
 	} `yaml:"mevBoostRelayExporter"`
+	GossipExporter struct {
+		Enabled bool `yaml:"enabled" envconfig:"GOSSIP_EXPORTER_ENABLED"`
+		// attestations seen on gossip are kept for this many epochs, 0 keeps them for a day
+		AttestationsRetentionEpochs uint64 `yaml:"attestationsRetentionEpochs" envconfig:"GOSSIP_EXPORTER_ATTESTATIONS_RETENTION_EPOCHS"`
+	} `yaml:"gossipExporter"`
+	RewardReconciliation struct {
+		Enabled bool `yaml:"enabled" envconfig:"REWARD_RECONCILIATION_ENABLED"`
+		// only every nth finalized epoch is reconciled, 0 reconciles every epoch
+		EpochInterval uint64 `yaml:"epochInterval" envconfig:"REWARD_RECONCILIATION_EPOCH_INTERVAL"`
+	} `yaml:"rewardReconciliation"`
 	Pprof struct {
 		Enabled bool   `yaml:"enabled" envconfig:"PPROF_ENABLED"`
 		Port    string `yaml:"port" envconfig:"PPROF_PORT"`
@@ -303,6 +341,8 @@ type Config struct {
 		ServiceMonitoringConfigurations []ServiceMonitoringConfiguration `yaml:"serviceMonitoringConfigurations" envconfig:"SERVICE_MONITORING_CONFIGURATIONS"`
 	} `yaml:"monitoring"`
 	InternalAlerts InternalAlertDiscord `yaml:"internalAlerts"`
+	Layer2Networks []Layer2Network      `yaml:"layer2Networks"`
+	PriceHistory   PriceHistoryConfig   `yaml:"priceHistory"`
 
 	ApiKeySecret     string   `yaml:"apiKeySecret" envconfig:"API_KEY_SECRET"`
 	CorsAllowedHosts []string `yaml:"corsAllowedHosts" envconfig:"CORS_ALLOWED_HOSTS"`
@@ -310,6 +350,48 @@ type Config struct {
 	SkipDataAccessServiceInitWait bool `yaml:"skipDataAccessServiceInitWait" envconfig:"SKIP_DATA_ACCESS_SERVICE_INIT_WAIT"`
 }
 
+// PriceHistoryConfig configures the sources of the hourly and daily price history.
+// All pairs are quoted in USD, pairs between two other currencies are derived from their USD pairs.
+type PriceHistoryConfig struct {
+	Enabled          bool                     `yaml:"enabled" envconfig:"PRICE_HISTORY_ENABLED"`
+	Pairs            []string                 `yaml:"pairs" envconfig:"PRICE_HISTORY_PAIRS"`                  // e.g. ETH/USD, defaults to the pairs of the chainlink feeds of the chain
+	BackfillStart    string                   `yaml:"backfillStart" envconfig:"PRICE_HISTORY_BACKFILL_START"` // YYYY-MM-DD, defaults to the genesis of the chain
+	DisableChainlink bool                     `yaml:"disableChainlink" envconfig:"PRICE_HISTORY_DISABLE_CHAINLINK"`
+	CsvFiles         []string                 `yaml:"csvFiles" envconfig:"PRICE_HISTORY_CSV_FILES"`
+	HttpSources      []PriceHistoryHttpSource `yaml:"httpSources"`
+}
+
+// PriceHistoryHttpSource is a json api returning a list of price points, see price.HttpSource for the supported url placeholders
+type PriceHistoryHttpSource struct {
+	Name           string   `yaml:"name"`
+	Url            string   `yaml:"url"`
+	ItemsPath      string   `yaml:"itemsPath"`
+	TimestampField string   `yaml:"timestampField"`
+	PriceField     string   `yaml:"priceField"`
+	Pairs          []string `yaml:"pairs"`
+}
+
+// Layer2Network is a rollup settling on the configured chain.
+// The layer 1 side is indexed by the indexer of the configured chain, the layer 2 side by an indexer running against the rollup itself.
+type Layer2Network struct {
+	Name    string `yaml:"name"` // network name used in the api
+	ChainId uint64 `yaml:"chainId"`
+	Stack   string `yaml:"stack"` // "op-stack" or "arbitrum"
+	// chain id of the layer 1 the rollup settles on, defaults to the execution layer of the configured beacon chain
+	SettlementChainId uint64 `yaml:"settlementChainId"`
+
+	// op-stack contracts
+	BatchInbox     string `yaml:"batchInbox"`
+	BatchSubmitter string `yaml:"batchSubmitter"`
+	OptimismPortal string `yaml:"optimismPortal"`
+
+	// arbitrum contracts
+	SequencerInbox string `yaml:"sequencerInbox"`
+	Bridge         string `yaml:"bridge"`
+	Inbox          string `yaml:"inbox"`
+	Outbox         string `yaml:"outbox"`
+}
+
 type Chain struct {
 	Name                       string `yaml:"name" envconfig:"CHAIN_NAME"`
 	Id                         uint64 `yaml:"id" envconfig:"CHAIN_ID"`
@@ -130,6 +130,9 @@ type Block struct {
 	ExcessBlobGas              uint64
 	BlobKZGCommitments         [][]byte
 	BlobKZGProofs              [][]byte
+	DepositRequests            []*DepositRequest
+	WithdrawalRequests         []*WithdrawalRequest
+	ConsolidationRequests      []*ConsolidationRequest
 	AttestationDuties          map[ValidatorIndex][]Slot
 	SyncDuties                 map[ValidatorIndex]bool
 	Finalized                  bool
@@ -148,6 +151,26 @@ type BLSToExecutionChange struct {
 	Address        []byte
 }
 
+type DepositRequest struct {
+	Pubkey                []byte
+	WithdrawalCredentials []byte
+	Amount                uint64
+	Signature             []byte
+	Index                 uint64
+}
+
+type WithdrawalRequest struct {
+	SourceAddress   []byte
+	ValidatorPubkey []byte
+	Amount          uint64
+}
+
+type ConsolidationRequest struct {
+	SourceAddress []byte
+	SourcePubkey  []byte
+	TargetPubkey  []byte
+}
+
 type Transaction struct {
 	Raw []byte
 	// Note: below values may be nil/0 if Raw fails to decode into a valid transaction
@@ -213,6 +236,25 @@ type WithdrawalsNotification struct {
 	Pubkey         []byte `json:"pubkey"`
 }
 
+type ConsolidationNotification struct {
+	Slot          uint64        `db:"slot"`
+	SourceAddress []byte        `db:"source_address"`
+	SourcePubkey  []byte        `db:"source_pubkey"`
+	SourceIndex   sql.NullInt64 `db:"source_index"`
+	TargetPubkey  []byte        `db:"target_pubkey"`
+	TargetIndex   sql.NullInt64 `db:"target_index"`
+}
+
+// MissedBestBidNotification is a proposal that was worth less than the best bid the relays received for the slot, values are in ETH
+type MissedBestBidNotification struct {
+	Slot           uint64  `db:"slot"`
+	Proposer       uint64  `db:"proposer"`
+	ProposerPubkey []byte  `db:"pubkey"`
+	DeliveredValue float64 `db:"delivered_value"`
+	BestBid        float64 `db:"best_bid"`
+	BestBidRelay   string  `db:"best_bid_relay"`
+}
+
 // Eth1Data is a struct to hold the ETH1 data
 type Eth1Data struct {
 	DepositRoot  []byte
@@ -524,6 +566,7 @@ type Relay struct {
 	ExportFailureCount  uint64         `db:"export_failure_count"`
 	LastExportTryTs     time.Time      `db:"last_export_try_ts"`
 	LastExportSuccessTs time.Time      `db:"last_export_success_ts"`
+	LastBidSlot         sql.NullInt64  `db:"last_bid_slot"` // last slot the received bids were exported for
 }
 
 type RelayBlock struct {
@@ -718,3 +761,11 @@ type SlashingInfo struct {
 	SlashedValidatorPubkey []byte `db:"slashedvalidator_pubkey"`
 	Reason                 string `db:"reason"`
 }
+
+// staking protocols whose validators are identified by the staking protocols exporter
+const (
+	StakingProtocolLido    = "lido"
+	StakingProtocolEtherFi = "ether_fi"
+)
+
+var StakingProtocols = []string{StakingProtocolLido, StakingProtocolEtherFi}
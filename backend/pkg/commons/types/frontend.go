@@ -88,6 +88,8 @@ const (
 	SyncCommitteeSoonEventName              EventName = "validator_synccommittee_soon"
 	ValidatorReceivedWithdrawalEventName    EventName = "validator_withdrawal"
 	ValidatorGotSlashedEventName            EventName = "validator_got_slashed"
+	ValidatorConsolidationEventName         EventName = "validator_consolidation"
+	ValidatorMissedBestBidEventName         EventName = "validator_missed_best_bid"
 	ValidatorGroupEfficiencyEventName       EventName = "validator_group_efficiency"
 	RocketpoolCollateralMinReachedEventName EventName = "rocketpool_colleteral_min" //nolint:misspell
 	RocketpoolCollateralMaxReachedEventName EventName = "rocketpool_colleteral_max" //nolint:misspell
@@ -130,6 +132,8 @@ var EventSortOrder = []EventName{
 	ValidatorIsOnlineEventName,
 	ValidatorGroupEfficiencyEventName,
 	ValidatorReceivedWithdrawalEventName,
+	ValidatorConsolidationEventName,
+	ValidatorMissedBestBidEventName,
 	NetworkLivenessIncreasedEventName,
 	EthClientUpdateEventName,
 	TaxReportEventName,
@@ -183,6 +187,8 @@ var LegacyEventLabel map[EventName]string = map[EventName]string{
 	ValidatorIsOfflineEventName:              "Your validator(s) went offline",
 	ValidatorIsOnlineEventName:               "Your validator(s) came back online",
 	ValidatorReceivedWithdrawalEventName:     "A withdrawal was initiated for your validators",
+	ValidatorConsolidationEventName:          "A consolidation was requested for your validator(s)",
+	ValidatorMissedBestBidEventName:          "Your validator(s) proposed a block below the best MEV-Boost bid",
 	NetworkLivenessIncreasedEventName:        "The network is experiencing liveness issues",
 	EthClientUpdateEventName:                 "An Ethereum client has a new update available",
 	MonitoringMachineOfflineEventName:        "Your machine(s) might be offline",
@@ -210,6 +216,8 @@ var EventLabel map[EventName]string = map[EventName]string{
 	ValidatorIsOfflineEventName:              "Validator offline",
 	ValidatorIsOnlineEventName:               "Validator back online",
 	ValidatorReceivedWithdrawalEventName:     "Withdrawal processed",
+	ValidatorConsolidationEventName:          "Validator consolidation",
+	ValidatorMissedBestBidEventName:          "Missed best bid",
 	NetworkLivenessIncreasedEventName:        "The network is experiencing liveness issues",
 	EthClientUpdateEventName:                 "An Ethereum client has a new update available",
 	MonitoringMachineOfflineEventName:        "Machine offline",
@@ -246,6 +254,8 @@ var EventNames = []EventName{
 	ValidatorIsOfflineEventName,
 	ValidatorIsOnlineEventName,
 	ValidatorReceivedWithdrawalEventName,
+	ValidatorConsolidationEventName,
+	ValidatorMissedBestBidEventName,
 	NetworkLivenessIncreasedEventName,
 	EthClientUpdateEventName,
 	MonitoringMachineOfflineEventName,
@@ -309,7 +319,17 @@ var AddWatchlistEvents = []EventNameDesc{
 	{
 		Desc:  "Withdrawal processed",
 		Event: ValidatorReceivedWithdrawalEventName,
-		Info:  template.HTML(`<i data-toggle="tooltip" data-html="true" title="<div class='text-left'>Will trigger a notifcation when:<br><ul><li>A partial withdrawal is processed</li><li>Your validator exits and its full balance is withdrawn</li></ul> <div>Requires that your validator has 0x01 credentials</div></div>" class="fas fa-question-circle"></i>`),
+		Info:  template.HTML(`<i data-toggle="tooltip" data-html="true" title="<div class='text-left'>Will trigger a notifcation when:<br><ul><li>A partial withdrawal is processed</li><li>Your validator exits and its full balance is withdrawn</li></ul> <div>Requires that your validator has 0x01 or 0x02 credentials</div></div>" class="fas fa-question-circle"></i>`),
+	},
+	{
+		Desc:  "Consolidation requested",
+		Event: ValidatorConsolidationEventName,
+		Info:  template.HTML(`<i data-toggle="tooltip" data-html="true" title="<div class='text-left'>Will trigger a notifcation when your validator is the source or the target of a consolidation request</div>" class="fas fa-question-circle"></i>`),
+	},
+	{
+		Desc:  "Missed best bid",
+		Event: ValidatorMissedBestBidEventName,
+		Info:  template.HTML(`<i data-toggle="tooltip" data-html="true" title="<div class='text-left'>Will trigger a notifcation when your validator proposes a block worth less than the best bid the MEV-Boost relays received for the slot</div>" class="fas fa-question-circle"></i>`),
 	},
 }
 
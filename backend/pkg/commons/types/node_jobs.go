@@ -9,6 +9,8 @@ import (
 
 	"github.com/attestantio/go-eth2-client/spec/capella"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 type NodeJobStatus string
@@ -22,11 +24,13 @@ type NodeJobType string
 
 const BLSToExecutionChangesNodeJobType NodeJobType = "BLS_TO_EXECUTION_CHANGES"
 const VoluntaryExitsNodeJobType NodeJobType = "VOLUNTARY_EXITS"
+const SignedTransactionNodeJobType NodeJobType = "SIGNED_TRANSACTION"
 const UnknownNodeJobType NodeJobType = "UNKNOWN"
 
 var NodeJobTypes = []NodeJobType{
 	BLSToExecutionChangesNodeJobType,
 	VoluntaryExitsNodeJobType,
+	SignedTransactionNodeJobType,
 }
 
 func NewNodeJob(data []byte) (*NodeJob, error) {
@@ -99,6 +103,19 @@ func (nj *NodeJob) ParseData() error {
 			return nj.SanitizeRawData()
 		}
 	}
+	{
+		// signed execution layer transactions are passed as hex encoded string
+		var d hexutil.Bytes
+		err := json.Unmarshal(nj.RawData, &d)
+		if err == nil && len(d) > 0 {
+			if nj.Type != "" && nj.Type != UnknownNodeJobType && nj.Type != SignedTransactionNodeJobType {
+				return fmt.Errorf("nodejob.RawData mismatches nodejob.Type (%v)", nj.Type)
+			}
+			nj.Type = SignedTransactionNodeJobType
+			nj.Data = d
+			return nj.SanitizeRawData()
+		}
+	}
 	return CreateNodeJobUserError{Message: "can not unmarshal data: invalid json"}
 }
 
@@ -120,3 +137,16 @@ func (nj NodeJob) GetVoluntaryExitsNodeJobData() (*phase0.SignedVoluntaryExit, b
 	d, ok := nj.Data.(*phase0.SignedVoluntaryExit)
 	return d, ok
 }
+
+func (nj NodeJob) GetSignedTransactionNodeJobData() (*gethtypes.Transaction, bool) {
+	d, ok := nj.Data.(hexutil.Bytes)
+	if !ok {
+		return nil, false
+	}
+	tx := &gethtypes.Transaction{}
+	err := tx.UnmarshalBinary(d)
+	if err != nil {
+		return nil, false
+	}
+	return tx, true
+}
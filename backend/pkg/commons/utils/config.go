@@ -374,6 +374,10 @@ func setCLConfig(cfg *types.Config) error {
 			log.Warnf("DenebForkEpoch not set, defaulting to maxForkEpoch")
 			jr.Data.DenebForkEpoch = &maxForkEpoch
 		}
+		if jr.Data.ElectraForkEpoch == nil {
+			log.Warnf("ElectraForkEpoch not set, defaulting to maxForkEpoch")
+			jr.Data.ElectraForkEpoch = &maxForkEpoch
+		}
 
 		chainCfg := types.ClChainConfig{
 			PresetBase:                              jr.Data.PresetBase,
@@ -393,6 +397,8 @@ func setCLConfig(cfg *types.Config) error {
 			CappellaForkEpoch:                       *jr.Data.CapellaForkEpoch,
 			DenebForkVersion:                        jr.Data.DenebForkVersion,
 			DenebForkEpoch:                          *jr.Data.DenebForkEpoch,
+			ElectraForkVersion:                      jr.Data.ElectraForkVersion,
+			ElectraForkEpoch:                        *jr.Data.ElectraForkEpoch,
 			SecondsPerSlot:                          uint64(jr.Data.SecondsPerSlot),
 			SecondsPerEth1Block:                     uint64(jr.Data.SecondsPerEth1Block),
 			MinValidatorWithdrawabilityDelay:        uint64(jr.Data.MinValidatorWithdrawabilityDelay),
@@ -403,6 +409,8 @@ func setCLConfig(cfg *types.Config) error {
 			EjectionBalance:                         uint64(jr.Data.EjectionBalance),
 			MinPerEpochChurnLimit:                   uint64(jr.Data.MinPerEpochChurnLimit),
 			ChurnLimitQuotient:                      uint64(jr.Data.ChurnLimitQuotient),
+			MinPerEpochChurnLimitElectra:            uint64(jr.Data.MinPerEpochChurnLimitElectra),
+			MaxPerEpochActivationExitChurnLimit:     uint64(jr.Data.MaxPerEpochActivationExitChurnLimit),
 			ProposerScoreBoost:                      uint64(jr.Data.ProposerScoreBoost),
 			DepositChainID:                          uint64(jr.Data.DepositChainID),
 			DepositNetworkID:                        uint64(jr.Data.DepositNetworkID),
@@ -417,6 +425,8 @@ func setCLConfig(cfg *types.Config) error {
 			SafeSlotsToUpdateJustified:              uint64(jr.Data.SafeSlotsToUpdateJustified),
 			MinDepositAmount:                        uint64(jr.Data.MinDepositAmount),
 			MaxEffectiveBalance:                     uint64(jr.Data.MaxEffectiveBalance),
+			MinActivationBalance:                    uint64(jr.Data.MinActivationBalance),
+			MaxEffectiveBalanceElectra:              uint64(jr.Data.MaxEffectiveBalanceElectra),
 			EffectiveBalanceIncrement:               uint64(jr.Data.EffectiveBalanceIncrement),
 			MinAttestationInclusionDelay:            uint64(jr.Data.MinAttestationInclusionDelay),
 			SlotsPerEpoch:                           uint64(jr.Data.SlotsPerEpoch),
@@ -455,6 +465,9 @@ func setCLConfig(cfg *types.Config) error {
 			MaxExtraDataBytes:                       uint64(jr.Data.MaxExtraDataBytes),
 			MaxWithdrawalsPerPayload:                uint64(jr.Data.MaxWithdrawalsPerPayload),
 			MaxValidatorsPerWithdrawalSweep:         uint64(jr.Data.MaxValidatorsPerWithdrawalsSweep),
+			MaxPendingPartialsPerWithdrawalsSweep:   uint64(jr.Data.MaxPendingPartialsPerWithdrawalsSweep),
+			MaxPendingDepositsPerEpoch:              uint64(jr.Data.MaxPendingDepositsPerEpoch),
+			WhistleblowerRewardQuotientElectra:      uint64(jr.Data.WhistleblowerRewardQuotientElectra),
 			MaxBlsToExecutionChange:                 uint64(jr.Data.MaxBlsToExecutionChanges),
 		}
 
@@ -483,6 +496,34 @@ func setCLConfig(cfg *types.Config) error {
 		cfg.Chain.ClConfig = *chainConfig
 	}
 
+	// pre electra configs do not contain the electra parameters, derive them from the phase0 values where the spec allows it
+	if cfg.Chain.ClConfig.MinActivationBalance == 0 {
+		cfg.Chain.ClConfig.MinActivationBalance = cfg.Chain.ClConfig.MaxEffectiveBalance
+	}
+	if cfg.Chain.ClConfig.MaxEffectiveBalanceElectra == 0 {
+		cfg.Chain.ClConfig.MaxEffectiveBalanceElectra = cfg.Chain.ClConfig.MaxEffectiveBalance * 64
+	}
+	// the churn and queue parameters differ per network, they must be configured once electra is scheduled
+	electraParams := map[string]*uint64{
+		"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA":          &cfg.Chain.ClConfig.MinPerEpochChurnLimitElectra,
+		"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT":  &cfg.Chain.ClConfig.MaxPerEpochActivationExitChurnLimit,
+		"MAX_PENDING_PARTIALS_PER_WITHDRAWALS_SWEEP": &cfg.Chain.ClConfig.MaxPendingPartialsPerWithdrawalsSweep,
+		"MAX_PENDING_DEPOSITS_PER_EPOCH":             &cfg.Chain.ClConfig.MaxPendingDepositsPerEpoch,
+		"WHISTLEBLOWER_REWARD_QUOTIENT_ELECTRA":      &cfg.Chain.ClConfig.WhistleblowerRewardQuotientElectra,
+	}
+	if cfg.Chain.ClConfig.ElectraForkEpoch != uint64(18446744073709551615) {
+		missing := []string{}
+		for name, value := range electraParams {
+			if *value == 0 {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) > 0 {
+			slices.Sort(missing)
+			return fmt.Errorf("electra is scheduled at epoch %v but the chain config is missing %v", cfg.Chain.ClConfig.ElectraForkEpoch, strings.Join(missing, ", "))
+		}
+	}
+
 	// rewrite to match to allow trace as well
 	switch strings.ToLower(os.Getenv("LOG_LEVEL")) {
 	case "trace":
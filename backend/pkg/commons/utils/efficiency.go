@@ -1,6 +1,9 @@
 package utils
 
-import "database/sql"
+import (
+	"database/sql"
+	"math"
+)
 
 func CalculateTotalEfficiency(attestationEff, proposalEff, syncEff sql.NullFloat64) float64 {
 	efficiency := float64(0)
@@ -23,3 +26,16 @@ func CalculateTotalEfficiency(attestationEff, proposalEff, syncEff sql.NullFloat
 
 	return efficiency
 }
+
+// CalculateAPR annualizes the income (in gwei) that was earned within the given hours on the given total effective balance (in gwei)
+func CalculateAPR(income float64, effectiveBalance uint64, hours float64) float64 {
+	if effectiveBalance == 0 || hours <= 0 {
+		return 0
+	}
+
+	apr := ((income / hours) / float64(effectiveBalance)) * 24.0 * 365.0 * 100.0
+	if math.IsNaN(apr) || math.IsInf(apr, 0) {
+		return 0
+	}
+	return apr
+}
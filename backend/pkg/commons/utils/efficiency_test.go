@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateAPR(t *testing.T) {
+	tests := []struct {
+		name             string
+		income           float64
+		effectiveBalance uint64
+		hours            float64
+		expected         float64
+	}{
+		{"32 ETH validator", 1000000, 32000000000, 24, 1.140625},
+		{"compounding validator with the same yield", 64000000, 2048000000000, 24, 1.140625},
+		{"income of a week", 7000000, 32000000000, 7 * 24, 1.140625},
+		{"penalties", -1000000, 32000000000, 24, -1.140625},
+		{"no income", 0, 32000000000, 24, 0},
+		{"no effective balance", 1000000, 0, 24, 0},
+		{"no hours", 1000000, 32000000000, 0, 0},
+		{"negative hours", 1000000, 32000000000, -1, 0},
+	}
+	for _, tt := range tests {
+		if apr := CalculateAPR(tt.income, tt.effectiveBalance, tt.hours); math.Abs(apr-tt.expected) > 1e-9 {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, apr)
+		}
+	}
+}
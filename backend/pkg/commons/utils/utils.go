@@ -373,6 +373,33 @@ func GetAddressOfWithdrawalCredentials(withCred []byte) (*common.Address, error)
 	addr := common.BytesToAddress(withCred[12:])
 	return &addr, nil
 }
+
+// withdrawal credential types as defined by the prefix of the credentials
+const (
+	BlsWithdrawalCredentialsType         uint8 = 0x00
+	ExecutionWithdrawalCredentialsType   uint8 = 0x01
+	CompoundingWithdrawalCredentialsType uint8 = 0x02
+)
+
+var WithdrawalCredentialsTypes = []uint8{BlsWithdrawalCredentialsType, ExecutionWithdrawalCredentialsType, CompoundingWithdrawalCredentialsType}
+
+// GetWithdrawalCredentialsType returns the type prefix of the withdrawal credentials
+func GetWithdrawalCredentialsType(withCred []byte) uint8 {
+	if len(withCred) == 0 {
+		return BlsWithdrawalCredentialsType
+	}
+	return withCred[0]
+}
+
+// GetMaxEffectiveBalanceOfWithdrawalCredentials returns the effective balance cap of a validator with the given withdrawal credentials,
+// compounding validators are capped by MAX_EFFECTIVE_BALANCE_ELECTRA while all others stay capped by MIN_ACTIVATION_BALANCE
+func GetMaxEffectiveBalanceOfWithdrawalCredentials(withCred []byte) uint64 {
+	if GetWithdrawalCredentialsType(withCred) == CompoundingWithdrawalCredentialsType {
+		return Config.Chain.ClConfig.MaxEffectiveBalanceElectra
+	}
+	return Config.Chain.ClConfig.MinActivationBalance
+}
+
 func GetWithdrawalCredentialsOfAddress(addr common.Address) []byte {
 	// Create a new byte slice with the desired prefix
 	prefix := []byte{0x01}
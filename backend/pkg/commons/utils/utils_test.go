@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+)
+
+func TestGetMaxEffectiveBalanceOfWithdrawalCredentials(t *testing.T) {
+	previousConfig := Config
+	t.Cleanup(func() { Config = previousConfig })
+	Config = &types.Config{}
+	Config.Chain.ClConfig.MinActivationBalance = 32000000000
+	Config.Chain.ClConfig.MaxEffectiveBalanceElectra = 2048000000000
+
+	credentials := func(prefix byte) []byte {
+		withCred := make([]byte, 32)
+		withCred[0] = prefix
+		withCred[31] = 0xaa
+		return withCred
+	}
+
+	tests := []struct {
+		name            string
+		withCred        []byte
+		expectedType    uint8
+		expectedBalance uint64
+	}{
+		{"bls", credentials(0x00), BlsWithdrawalCredentialsType, 32000000000},
+		{"execution", credentials(0x01), ExecutionWithdrawalCredentialsType, 32000000000},
+		{"compounding", credentials(0x02), CompoundingWithdrawalCredentialsType, 2048000000000},
+		{"missing credentials", nil, BlsWithdrawalCredentialsType, 32000000000},
+	}
+	for _, tt := range tests {
+		if credentialsType := GetWithdrawalCredentialsType(tt.withCred); credentialsType != tt.expectedType {
+			t.Errorf("%s: expected type %#x, got %#x", tt.name, tt.expectedType, credentialsType)
+		}
+		if balance := GetMaxEffectiveBalanceOfWithdrawalCredentials(tt.withCred); balance != tt.expectedBalance {
+			t.Errorf("%s: expected %d, got %d", tt.name, tt.expectedBalance, balance)
+		}
+	}
+}
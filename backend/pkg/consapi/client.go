@@ -2,6 +2,8 @@ package consapi
 
 import (
 	"net/http"
+	"sync"
+	"sync/atomic"
 
 	"github.com/gobitfly/beaconchain/pkg/consapi/types"
 )
@@ -14,9 +16,14 @@ type ClientInt interface {
 	GetSlot(blockID any) (*types.StandardBeaconSlotResponse, error)
 
 	// Optional params ids and status to filter the response.
+	// Unfiltered by ids the validators are read from the ssz encoded state if the node supports it.
 	// eth/v1/beacon/states/{state_id}/validators
 	GetValidators(state any, ids []string, status []types.ValidatorStatus) (*types.StandardValidatorsResponse, error)
 
+	// Calls fn for every validator of the state without holding the whole response in memory.
+	// /eth/v2/debug/beacon/states/{state_id} or eth/v1/beacon/states/{state_id}/validators
+	StreamValidators(state any, fn func(*types.StandardValidator) error) error
+
 	// eth/v1/beacon/states/{state_id}/validators/{validator_id}
 	GetValidator(validatorID, stateID any) (*types.StandardSingleValidatorsResponse, error)
 
@@ -60,10 +67,33 @@ type ClientInt interface {
 	// /eth/v1/beacon/genesis
 	GetGenesis() (*types.StandardGenesisResponse, error)
 
+	// /eth/v1/beacon/states/{state_id}/pending_deposits
+	GetPendingDeposits(stateID any) (*types.StandardPendingDepositsResponse, error)
+
+	// /eth/v1/beacon/states/{state_id}/pending_partial_withdrawals
+	GetPendingPartialWithdrawals(stateID any) (*types.StandardPendingPartialWithdrawalsResponse, error)
+
+	// /eth/v1/beacon/states/{state_id}/pending_consolidations
+	GetPendingConsolidations(stateID any) (*types.StandardPendingConsolidationsResponse, error)
+
+	// /eth/v1/node/syncing
+	GetSyncing() (*types.StandardSyncingResponse, error)
+
 	// /eth/v1/events
 	GetEvents(topics []types.EventTopic) chan *types.EventResponse
 }
 type NodeClient struct {
 	Endpoint   string
 	httpClient *http.Client
+
+	// spec of the node, loaded on the first ssz request
+	specMu sync.Mutex
+	spec   *types.StandardSpec
+	// set once the node answered an ssz request with json or an unsupported status, it then only gets json requests
+	sszBlocksUnsupported atomic.Bool
+	sszStatesUnsupported atomic.Bool
+	// balances of the last state the validators were read from as ssz, they are requested for the same state right after
+	balancesMu    sync.Mutex
+	balancesState string
+	balances      []uint64
 }
@@ -0,0 +1,576 @@
+package consapi
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/consapi/network"
+	"github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+var ErrNoQuorum = errors.New("beacon nodes did not agree on the response")
+
+type MultiNodeConfig struct {
+	// interval in which the head slot and sync status of every node is checked
+	HealthCheckInterval time.Duration
+	// nodes whose head slot is more than this behind the best node are only used if no other node is available
+	MaxHeadSlotLag uint64
+	// number of nodes that have to return the same response for finalized data, 0 or 1 disables the cross-check
+	FinalizedQuorum int
+	// client used for all requests except the health checks, defaults to the one of NewClient
+	HttpClient *http.Client
+}
+
+// MultiNodeClient implements ClientInt on top of several beacon nodes. Requests are sent to the healthiest node
+// and fail over to the next one if a node does not respond properly. Responses for finalized data can optionally
+// be cross-checked between the nodes.
+type MultiNodeClient struct {
+	nodes  []*multiNode
+	config MultiNodeConfig
+	done   chan struct{}
+	once   sync.Once
+}
+
+type multiNode struct {
+	client       *NodeClient
+	healthClient *NodeClient
+
+	mu             sync.RWMutex
+	checked        bool
+	headSlot       uint64
+	finalizedEpoch uint64
+	slotsPerEpoch  uint64
+	isSyncing      bool
+	err            error // last health check or request error, cleared by the next successful health check
+}
+
+func NewMultiNodeClient(endpoints []string, config MultiNodeConfig) (Client, error) {
+	if len(endpoints) == 0 {
+		return Client{}, errors.New("no beacon node endpoints given")
+	}
+	if config.FinalizedQuorum > len(endpoints) {
+		return Client{}, fmt.Errorf("finalized quorum of %d can not be reached with %d beacon nodes", config.FinalizedQuorum, len(endpoints))
+	}
+	if config.HealthCheckInterval == 0 {
+		config.HealthCheckInterval = 12 * time.Second
+	}
+	if config.HttpClient == nil {
+		config.HttpClient = &http.Client{
+			Timeout: 500 * time.Second,
+		}
+	}
+
+	healthHttpClient := &http.Client{
+		Timeout: min(config.HealthCheckInterval, 10*time.Second),
+	}
+	client := &MultiNodeClient{
+		config: config,
+		done:   make(chan struct{}),
+	}
+	for _, endpoint := range endpoints {
+		client.nodes = append(client.nodes, &multiNode{
+			client:       &NodeClient{Endpoint: endpoint, httpClient: config.HttpClient},
+			healthClient: &NodeClient{Endpoint: endpoint, httpClient: healthHttpClient},
+		})
+	}
+
+	client.checkHealth()
+	go func() {
+		ticker := time.NewTicker(config.HealthCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				client.checkHealth()
+			case <-client.done:
+				return
+			}
+		}
+	}()
+
+	return Client{ClientInt: client}, nil
+}
+
+// Close stops the health checks and all event subscriptions of the client
+func (c *MultiNodeClient) Close() {
+	c.once.Do(func() {
+		close(c.done)
+	})
+}
+
+// BestNode returns the node requests are currently sent to
+func (c *MultiNodeClient) BestNode() *NodeClient {
+	return c.orderedNodes()[0].client
+}
+
+func (c *MultiNodeClient) checkHealth() {
+	wg := sync.WaitGroup{}
+	for _, node := range c.nodes {
+		wg.Add(1)
+		go func(node *multiNode) {
+			defer wg.Done()
+			node.checkHealth()
+		}(node)
+	}
+	wg.Wait()
+}
+
+func (n *multiNode) checkHealth() {
+	n.mu.RLock()
+	slotsPerEpoch := n.slotsPerEpoch
+	n.mu.RUnlock()
+
+	err := func() error {
+		if slotsPerEpoch == 0 {
+			spec, err := n.healthClient.GetSpec()
+			if err != nil {
+				return fmt.Errorf("error retrieving spec: %w", err)
+			}
+			slotsPerEpoch = uint64(spec.Data.SlotsPerEpoch)
+		}
+		syncing, err := n.healthClient.GetSyncing()
+		if err != nil {
+			return fmt.Errorf("error retrieving sync status: %w", err)
+		}
+		finality, err := n.healthClient.GetFinalityCheckpoints("head")
+		if err != nil {
+			return fmt.Errorf("error retrieving finality checkpoints: %w", err)
+		}
+
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		n.slotsPerEpoch = slotsPerEpoch
+		n.headSlot = syncing.Data.HeadSlot
+		n.isSyncing = syncing.Data.IsSyncing
+		n.finalizedEpoch = finality.Data.Finalized.Epoch
+		return nil
+	}()
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.checked = true
+	n.err = err
+}
+
+func (n *multiNode) fail(err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.err = err
+}
+
+type multiNodeState struct {
+	node      *multiNode
+	index     int
+	available bool
+	headSlot  uint64
+}
+
+// nodeStates returns a snapshot of the health of all nodes, nodes that lag behind, are syncing or failed are not available
+func (c *MultiNodeClient) nodeStates() []multiNodeState {
+	states := make([]multiNodeState, len(c.nodes))
+	bestHeadSlot := uint64(0)
+	for i, node := range c.nodes {
+		node.mu.RLock()
+		states[i] = multiNodeState{
+			node:      node,
+			index:     i,
+			available: node.checked && node.err == nil && !node.isSyncing,
+			headSlot:  node.headSlot,
+		}
+		node.mu.RUnlock()
+		if states[i].available {
+			bestHeadSlot = max(bestHeadSlot, states[i].headSlot)
+		}
+	}
+	for i := range states {
+		if states[i].available && states[i].headSlot+c.config.MaxHeadSlotLag < bestHeadSlot {
+			states[i].available = false
+		}
+	}
+	return states
+}
+
+// orderedNodes returns all nodes sorted by health, available nodes with the highest head slot first.
+// Unavailable nodes are only used as a last resort, in the order they were configured.
+func (c *MultiNodeClient) orderedNodes() []*multiNode {
+	states := c.nodeStates()
+	slices.SortStableFunc(states, func(a, b multiNodeState) int {
+		if a.available != b.available {
+			if a.available {
+				return -1
+			}
+			return 1
+		}
+		if a.available && a.headSlot != b.headSlot {
+			if a.headSlot > b.headSlot {
+				return -1
+			}
+			return 1
+		}
+		return a.index - b.index
+	})
+
+	result := make([]*multiNode, len(states))
+	for i, state := range states {
+		result[i] = state.node
+	}
+	return result
+}
+
+// finalizedCheckpoint returns the highest finalized epoch known to any of the nodes together with the slots per epoch
+func (c *MultiNodeClient) finalizedCheckpoint() (finalizedEpoch uint64, slotsPerEpoch uint64, ok bool) {
+	for _, node := range c.nodes {
+		node.mu.RLock()
+		if node.checked && node.err == nil && node.slotsPerEpoch > 0 {
+			finalizedEpoch, slotsPerEpoch, ok = max(finalizedEpoch, node.finalizedEpoch), node.slotsPerEpoch, true
+		}
+		node.mu.RUnlock()
+	}
+	return finalizedEpoch, slotsPerEpoch, ok
+}
+
+// isFinalizedID reports whether the response for the given state or block id has to be cross-checked
+func (c *MultiNodeClient) isFinalizedID(id any) bool {
+	if c.config.FinalizedQuorum <= 1 {
+		return false
+	}
+	s := fmt.Sprint(id)
+	if s == "genesis" {
+		return true
+	}
+	slot, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		// roots and named ids like head or finalized, which move and may legitimately differ between the nodes
+		return false
+	}
+	finalizedEpoch, slotsPerEpoch, ok := c.finalizedCheckpoint()
+	return ok && slot <= finalizedEpoch*slotsPerEpoch
+}
+
+func (c *MultiNodeClient) isFinalizedEpoch(epoch uint64) bool {
+	if c.config.FinalizedQuorum <= 1 {
+		return false
+	}
+	finalizedEpoch, _, ok := c.finalizedCheckpoint()
+	return ok && epoch < finalizedEpoch
+}
+
+// isNodeError reports whether err is caused by the node rather than by the request, e.g. a 404 for a missed slot is a valid answer
+func isNodeError(err error) bool {
+	if httpErr := network.SpecificError(err); httpErr != nil {
+		return httpErr.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// multiNodeRequest sends the request to the nodes ordered by their health until one answers properly.
+// If finalized is set the request is sent to all nodes and the response is only returned once the quorum agrees on it.
+func multiNodeRequest[T any](c *MultiNodeClient, finalized bool, request func(*NodeClient) (*T, error)) (*T, error) {
+	nodes := c.orderedNodes()
+	if finalized {
+		return multiNodeQuorumRequest(c, nodes, request)
+	}
+
+	var errs []error
+	for _, node := range nodes {
+		response, err := request(node.client)
+		if err == nil {
+			return response, nil
+		}
+		if !isNodeError(err) {
+			return response, err
+		}
+		node.fail(err)
+		errs = append(errs, fmt.Errorf("%s: %w", node.client.Endpoint, err))
+	}
+	return nil, errors.Join(errs...)
+}
+
+func multiNodeQuorumRequest[T any](c *MultiNodeClient, nodes []*multiNode, request func(*NodeClient) (*T, error)) (*T, error) {
+	type result struct {
+		response *T
+		hash     [32]byte
+		err      error
+	}
+	results := make([]result, len(nodes))
+	wg := sync.WaitGroup{}
+	for i, node := range nodes {
+		wg.Add(1)
+		go func(i int, node *multiNode) {
+			defer wg.Done()
+			response, err := request(node.client)
+			if err != nil {
+				if isNodeError(err) {
+					node.fail(err)
+				}
+				results[i].err = fmt.Errorf("%s: %w", node.client.Endpoint, err)
+				return
+			}
+			data, err := json.Marshal(response)
+			if err != nil {
+				results[i].err = fmt.Errorf("error hashing response of %s: %w", node.client.Endpoint, err)
+				return
+			}
+			results[i] = result{response: response, hash: sha256.Sum256(data)}
+		}(i, node)
+	}
+	wg.Wait()
+
+	// the results are ordered by node health, so the response of the healthiest node wins if several reach the quorum
+	votes := make(map[[32]byte]int)
+	var errs []error
+	for _, r := range results {
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+		votes[r.hash]++
+	}
+	for _, r := range results {
+		if r.err == nil && votes[r.hash] >= c.config.FinalizedQuorum {
+			return r.response, nil
+		}
+	}
+	// a request that failed on the client side, e.g. a missed slot, is returned as is if no node answered it
+	if len(votes) == 0 {
+		for _, r := range results {
+			if !isNodeError(r.err) {
+				return nil, r.err
+			}
+		}
+	}
+	return nil, errors.Join(append([]error{fmt.Errorf("%w: %d distinct responses from %d nodes", ErrNoQuorum, len(votes), len(nodes))}, errs...)...)
+}
+
+// multiNodeStateRequest answers requests for the validators or balances of a whole state. They are too large to be
+// requested from every node, so for finalized states the nodes only have to agree on the state root. The data is then
+// requested by that root from a single node, which makes sure it belongs to the state the quorum agreed on.
+func multiNodeStateRequest[T any](c *MultiNodeClient, stateID any, request func(n *NodeClient, stateID any) (*T, error)) (*T, error) {
+	if !c.isFinalizedID(stateID) {
+		return multiNodeRequest(c, false, func(n *NodeClient) (*T, error) {
+			return request(n, stateID)
+		})
+	}
+	root, err := multiNodeQuorumRequest(c, c.orderedNodes(), func(n *NodeClient) (*types.StandardStateRootResponse, error) {
+		return n.getStateRoot(stateID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	stateRoot := root.Data.Root.String()
+
+	var errs []error
+	for _, node := range c.orderedNodes() {
+		response, err := request(node.client, stateRoot)
+		if err == nil {
+			return response, nil
+		}
+		// a node that can't serve the finalized state the quorum agreed on is skipped, whatever it answered
+		if isNodeError(err) {
+			node.fail(err)
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", node.client.Endpoint, err))
+	}
+	return nil, errors.Join(errs...)
+}
+
+func (c *MultiNodeClient) GetSlot(blockID any) (*types.StandardBeaconSlotResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedID(blockID), func(n *NodeClient) (*types.StandardBeaconSlotResponse, error) {
+		return n.GetSlot(blockID)
+	})
+}
+
+func (c *MultiNodeClient) GetValidators(state any, ids []string, status []types.ValidatorStatus) (*types.StandardValidatorsResponse, error) {
+	return multiNodeStateRequest(c, state, func(n *NodeClient, state any) (*types.StandardValidatorsResponse, error) {
+		return n.GetValidators(state, ids, status)
+	})
+}
+
+// StreamValidators can neither cross-check nor repeat a stream that already handed out validators, it only
+// fails over to the next node as long as fn was not called yet
+func (c *MultiNodeClient) StreamValidators(state any, fn func(*types.StandardValidator) error) error {
+	var errs []error
+	for _, node := range c.orderedNodes() {
+		streamed := false
+		err := node.client.StreamValidators(state, func(validator *types.StandardValidator) error {
+			streamed = true
+			return fn(validator)
+		})
+		if err == nil {
+			return nil
+		}
+		if !isNodeError(err) {
+			return err
+		}
+		node.fail(err)
+		if streamed {
+			return err
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", node.client.Endpoint, err))
+	}
+	return errors.Join(errs...)
+}
+
+func (c *MultiNodeClient) GetValidator(validatorID, stateID any) (*types.StandardSingleValidatorsResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedID(stateID), func(n *NodeClient) (*types.StandardSingleValidatorsResponse, error) {
+		return n.GetValidator(validatorID, stateID)
+	})
+}
+
+func (c *MultiNodeClient) GetPropoalAssignments(epoch uint64) (*types.StandardProposerAssignmentsResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedEpoch(epoch), func(n *NodeClient) (*types.StandardProposerAssignmentsResponse, error) {
+		return n.GetPropoalAssignments(epoch)
+	})
+}
+
+func (c *MultiNodeClient) GetPropoalRewards(blockID any) (*types.StandardBlockRewardsResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedID(blockID), func(n *NodeClient) (*types.StandardBlockRewardsResponse, error) {
+		return n.GetPropoalRewards(blockID)
+	})
+}
+
+func (c *MultiNodeClient) GetSyncRewards(blockID any) (*types.StandardSyncCommitteeRewardsResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedID(blockID), func(n *NodeClient) (*types.StandardSyncCommitteeRewardsResponse, error) {
+		return n.GetSyncRewards(blockID)
+	})
+}
+
+func (c *MultiNodeClient) GetAttestationRewards(epoch uint64) (*types.StandardAttestationRewardsResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedEpoch(epoch), func(n *NodeClient) (*types.StandardAttestationRewardsResponse, error) {
+		return n.GetAttestationRewards(epoch)
+	})
+}
+
+func (c *MultiNodeClient) GetSyncCommitteesAssignments(epoch *uint64, stateID any) (*types.StandardSyncCommitteesResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedID(stateID), func(n *NodeClient) (*types.StandardSyncCommitteesResponse, error) {
+		return n.GetSyncCommitteesAssignments(epoch, stateID)
+	})
+}
+
+func (c *MultiNodeClient) GetSpec() (*types.StandardSpecResponse, error) {
+	return multiNodeRequest(c, false, func(n *NodeClient) (*types.StandardSpecResponse, error) {
+		return n.GetSpec()
+	})
+}
+
+func (c *MultiNodeClient) GetBlockHeader(blockID any) (*types.StandardBeaconHeaderResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedID(blockID), func(n *NodeClient) (*types.StandardBeaconHeaderResponse, error) {
+		return n.GetBlockHeader(blockID)
+	})
+}
+
+func (c *MultiNodeClient) GetBlockHeaders(slot *uint64, parentRoot *any) (*types.StandardBeaconHeadersResponse, error) {
+	return multiNodeRequest(c, false, func(n *NodeClient) (*types.StandardBeaconHeadersResponse, error) {
+		return n.GetBlockHeaders(slot, parentRoot)
+	})
+}
+
+func (c *MultiNodeClient) GetFinalityCheckpoints(stateID any) (*types.StandardFinalityCheckpointsResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedID(stateID), func(n *NodeClient) (*types.StandardFinalityCheckpointsResponse, error) {
+		return n.GetFinalityCheckpoints(stateID)
+	})
+}
+
+func (c *MultiNodeClient) GetValidatorBalances(stateID any) (*types.StandardValidatorBalancesResponse, error) {
+	return multiNodeStateRequest(c, stateID, func(n *NodeClient, stateID any) (*types.StandardValidatorBalancesResponse, error) {
+		return n.GetValidatorBalances(stateID)
+	})
+}
+
+func (c *MultiNodeClient) GetBlobSidecars(blockID any) (*types.StandardBlobSidecarsResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedID(blockID), func(n *NodeClient) (*types.StandardBlobSidecarsResponse, error) {
+		return n.GetBlobSidecars(blockID)
+	})
+}
+
+func (c *MultiNodeClient) GetCommittees(stateID any, epoch, index, slot *uint64) (*types.StandardCommitteesResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedID(stateID), func(n *NodeClient) (*types.StandardCommitteesResponse, error) {
+		return n.GetCommittees(stateID, epoch, index, slot)
+	})
+}
+
+func (c *MultiNodeClient) GetGenesis() (*types.StandardGenesisResponse, error) {
+	return multiNodeRequest(c, c.config.FinalizedQuorum > 1, func(n *NodeClient) (*types.StandardGenesisResponse, error) {
+		return n.GetGenesis()
+	})
+}
+
+func (c *MultiNodeClient) GetPendingDeposits(stateID any) (*types.StandardPendingDepositsResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedID(stateID), func(n *NodeClient) (*types.StandardPendingDepositsResponse, error) {
+		return n.GetPendingDeposits(stateID)
+	})
+}
+
+func (c *MultiNodeClient) GetPendingPartialWithdrawals(stateID any) (*types.StandardPendingPartialWithdrawalsResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedID(stateID), func(n *NodeClient) (*types.StandardPendingPartialWithdrawalsResponse, error) {
+		return n.GetPendingPartialWithdrawals(stateID)
+	})
+}
+
+func (c *MultiNodeClient) GetPendingConsolidations(stateID any) (*types.StandardPendingConsolidationsResponse, error) {
+	return multiNodeRequest(c, c.isFinalizedID(stateID), func(n *NodeClient) (*types.StandardPendingConsolidationsResponse, error) {
+		return n.GetPendingConsolidations(stateID)
+	})
+}
+
+func (c *MultiNodeClient) GetSyncing() (*types.StandardSyncingResponse, error) {
+	return multiNodeRequest(c, false, func(n *NodeClient) (*types.StandardSyncingResponse, error) {
+		return n.GetSyncing()
+	})
+}
+
+// GetEvents subscribes to the healthiest node and switches to another node once the subscribed node becomes unhealthy.
+// Events that occur while switching may be missed or delivered twice.
+func (c *MultiNodeClient) GetEvents(topics []types.EventTopic) chan *types.EventResponse {
+	responseCh := make(chan *types.EventResponse, 32)
+
+	go func() {
+		ticker := time.NewTicker(c.config.HealthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			node := c.orderedNodes()[0]
+			done := make(chan struct{})
+			events := node.client.subscribeEvents(topics, done)
+
+		subscription:
+			for {
+				select {
+				case event := <-events:
+					select {
+					case responseCh <- event:
+					case <-c.done:
+						close(done)
+						return
+					}
+				case <-ticker.C:
+					if c.orderedNodes()[0] != node && !c.isAvailable(node) {
+						break subscription
+					}
+				case <-c.done:
+					close(done)
+					return
+				}
+			}
+			close(done)
+		}
+	}()
+	return responseCh
+}
+
+// isAvailable reports whether the node is still healthy enough to be used for requests
+func (c *MultiNodeClient) isAvailable(node *multiNode) bool {
+	for _, state := range c.nodeStates() {
+		if state.node == node {
+			return state.available
+		}
+	}
+	return false
+}
@@ -0,0 +1,357 @@
+package consapi_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/consapi"
+	"github.com/gobitfly/beaconchain/pkg/consapi/network"
+	"github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+// fakeBeaconNode serves the parts of the beacon api used by the multi node client
+type fakeBeaconNode struct {
+	server *httptest.Server
+
+	mu             sync.Mutex
+	headSlot       uint64
+	finalizedEpoch uint64
+	isSyncing      bool
+	down           bool // every request fails, including the health checks
+	failHeaders    bool // only header requests fail
+	root           string
+	headerRequests int
+	stateRoot      string
+	balanceStates  []string // states the balances were requested for
+	events         chan string
+}
+
+func newFakeBeaconNode(t *testing.T, headSlot uint64, root string) *fakeBeaconNode {
+	node := &fakeBeaconNode{
+		headSlot: headSlot,
+		root:     root,
+		events:   make(chan string, 8),
+	}
+	node.server = httptest.NewServer(http.HandlerFunc(node.serveHTTP))
+	t.Cleanup(node.server.Close)
+	return node
+}
+
+func (n *fakeBeaconNode) set(f func(n *fakeBeaconNode)) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	f(n)
+}
+
+func (n *fakeBeaconNode) requests() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.headerRequests
+}
+
+func (n *fakeBeaconNode) getStateRoot() string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.stateRoot
+}
+
+func (n *fakeBeaconNode) getBalanceStates() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.balanceStates
+}
+
+func (n *fakeBeaconNode) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	n.mu.Lock()
+	down, failHeaders, headSlot, finalizedEpoch, isSyncing, root := n.down, n.failHeaders, n.headSlot, n.finalizedEpoch, n.isSyncing, n.root
+	if strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/headers/") {
+		n.headerRequests++
+	}
+	n.mu.Unlock()
+
+	if down {
+		http.Error(w, "node down", http.StatusServiceUnavailable)
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/eth/v1/config/spec":
+		fmt.Fprint(w, `{"data":{"SLOTS_PER_EPOCH":"32"}}`)
+	case r.URL.Path == "/eth/v1/node/syncing":
+		fmt.Fprintf(w, `{"data":{"head_slot":"%d","sync_distance":"0","is_syncing":%t,"is_optimistic":false,"el_offline":false}}`, headSlot, isSyncing)
+	case r.URL.Path == "/eth/v1/beacon/states/head/finality_checkpoints":
+		fmt.Fprintf(w, `{"data":{"previous_justified":{"epoch":"0","root":"0x00"},"current_justified":{"epoch":"0","root":"0x00"},"finalized":{"epoch":"%d","root":"0x00"}}}`, finalizedEpoch)
+	case strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/states/") && strings.HasSuffix(r.URL.Path, "/root"):
+		fmt.Fprintf(w, `{"data":{"root":"%s"}}`, n.getStateRoot())
+	case strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/states/") && strings.HasSuffix(r.URL.Path, "/validator_balances"):
+		n.set(func(n *fakeBeaconNode) {
+			n.balanceStates = append(n.balanceStates, strings.Split(r.URL.Path, "/")[5])
+		})
+		fmt.Fprint(w, `{"data":[{"index":"0","balance":"32000000000"}]}`)
+	case r.URL.Path == "/eth/v1/beacon/headers/404":
+		http.Error(w, `{"code":404,"message":"block not found"}`, http.StatusNotFound)
+	case strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/headers/"):
+		if failHeaders {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintf(w, `{"data":{"root":"%s","header":{"message":{"slot":"%d"}}}}`, root, headSlot)
+	case r.URL.Path == "/eth/v1/events":
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		for {
+			select {
+			case data := <-n.events:
+				fmt.Fprintf(w, "event: head\ndata: %s\n\n", data)
+				w.(http.Flusher).Flush()
+			case <-r.Context().Done():
+				return
+			}
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestMultiNodeClient(t *testing.T, config consapi.MultiNodeConfig, nodes ...*fakeBeaconNode) consapi.Client {
+	endpoints := make([]string, len(nodes))
+	for i, node := range nodes {
+		endpoints[i] = node.server.URL
+	}
+	if config.HealthCheckInterval == 0 {
+		config.HealthCheckInterval = time.Hour
+	}
+	client, err := consapi.NewMultiNodeClient(endpoints, config)
+	if err != nil {
+		t.Fatalf("error creating multi node client: %v", err)
+	}
+	t.Cleanup(client.ClientInt.(*consapi.MultiNodeClient).Close)
+	return client
+}
+
+func TestMultiNodeClientPrefersHighestHead(t *testing.T) {
+	a := newFakeBeaconNode(t, 100, "0xaa")
+	b := newFakeBeaconNode(t, 110, "0xbb")
+	client := newTestMultiNodeClient(t, consapi.MultiNodeConfig{MaxHeadSlotLag: 2}, a, b)
+
+	res, err := client.GetBlockHeader("head")
+	if err != nil {
+		t.Fatalf("error getting block header: %v", err)
+	}
+	if res.Data.Root.String() != "0xbb" {
+		t.Errorf("expected the header of the node with the highest head, got root %v", res.Data.Root)
+	}
+	if a.requests() != 0 {
+		t.Errorf("expected the lagging node not to be queried, got %d requests", a.requests())
+	}
+}
+
+func TestMultiNodeClientSkipsSyncingNode(t *testing.T) {
+	a := newFakeBeaconNode(t, 200, "0xaa")
+	a.set(func(n *fakeBeaconNode) { n.isSyncing = true })
+	b := newFakeBeaconNode(t, 100, "0xbb")
+	client := newTestMultiNodeClient(t, consapi.MultiNodeConfig{}, a, b)
+
+	res, err := client.GetBlockHeader("head")
+	if err != nil {
+		t.Fatalf("error getting block header: %v", err)
+	}
+	if res.Data.Root.String() != "0xbb" {
+		t.Errorf("expected the header of the synced node, got root %v", res.Data.Root)
+	}
+}
+
+func TestMultiNodeClientFailover(t *testing.T) {
+	a := newFakeBeaconNode(t, 110, "0xaa")
+	a.set(func(n *fakeBeaconNode) { n.failHeaders = true })
+	b := newFakeBeaconNode(t, 100, "0xbb")
+	c := newFakeBeaconNode(t, 100, "0xcc")
+	client := newTestMultiNodeClient(t, consapi.MultiNodeConfig{MaxHeadSlotLag: 32}, a, b, c)
+
+	for i := 0; i < 2; i++ {
+		res, err := client.GetBlockHeader("head")
+		if err != nil {
+			t.Fatalf("error getting block header: %v", err)
+		}
+		if res.Data.Root.String() != "0xbb" {
+			t.Errorf("expected the header of the first healthy node, got root %v", res.Data.Root)
+		}
+	}
+	if a.requests() != 1 {
+		t.Errorf("expected the failed node to be skipped until the next health check, got %d requests", a.requests())
+	}
+	if c.requests() != 0 {
+		t.Errorf("expected no request to the third node, got %d requests", c.requests())
+	}
+
+	a.set(func(n *fakeBeaconNode) { n.down = true })
+	b.set(func(n *fakeBeaconNode) { n.down = true })
+	c.set(func(n *fakeBeaconNode) { n.down = true })
+	_, err := client.GetBlockHeader("head")
+	if err == nil {
+		t.Errorf("expected an error if all nodes are down")
+	}
+}
+
+func TestMultiNodeClientDoesNotFailoverOnNotFound(t *testing.T) {
+	a := newFakeBeaconNode(t, 100, "0xaa")
+	b := newFakeBeaconNode(t, 100, "0xbb")
+	client := newTestMultiNodeClient(t, consapi.MultiNodeConfig{}, a, b)
+
+	_, err := client.GetBlockHeader(404)
+	if httpErr := network.SpecificError(err); httpErr == nil || httpErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if a.requests()+b.requests() != 1 {
+		t.Errorf("expected a single request for a missed slot, got %d", a.requests()+b.requests())
+	}
+
+	// the node is still used afterwards
+	res, err := client.GetBlockHeader("head")
+	if err != nil {
+		t.Fatalf("error getting block header: %v", err)
+	}
+	if res.Data.Root.String() != "0xaa" {
+		t.Errorf("expected the header of the first node, got root %v", res.Data.Root)
+	}
+}
+
+func TestMultiNodeClientFinalizedQuorum(t *testing.T) {
+	a := newFakeBeaconNode(t, 400, "0xaa")
+	b := newFakeBeaconNode(t, 400, "0xbb")
+	c := newFakeBeaconNode(t, 400, "0xbb")
+	for _, node := range []*fakeBeaconNode{a, b, c} {
+		node.set(func(n *fakeBeaconNode) { n.finalizedEpoch = 10 })
+	}
+	client := newTestMultiNodeClient(t, consapi.MultiNodeConfig{FinalizedQuorum: 2}, a, b, c)
+
+	// slot 64 is finalized, so all nodes are asked and the majority wins
+	res, err := client.GetBlockHeader(64)
+	if err != nil {
+		t.Fatalf("error getting finalized block header: %v", err)
+	}
+	if res.Data.Root.String() != "0xbb" {
+		t.Errorf("expected the header the quorum agreed on, got root %v", res.Data.Root)
+	}
+	if a.requests() != 1 || b.requests() != 1 || c.requests() != 1 {
+		t.Errorf("expected every node to be asked once, got %d, %d and %d requests", a.requests(), b.requests(), c.requests())
+	}
+
+	// unfinalized data is not cross-checked
+	_, err = client.GetBlockHeader("head")
+	if err != nil {
+		t.Fatalf("error getting block header: %v", err)
+	}
+	if a.requests()+b.requests()+c.requests() != 4 {
+		t.Errorf("expected a single request for unfinalized data, got %d", a.requests()+b.requests()+c.requests()-3)
+	}
+
+	c.set(func(n *fakeBeaconNode) { n.root = "0xcc" })
+	_, err = client.GetBlockHeader(64)
+	if !errors.Is(err, consapi.ErrNoQuorum) {
+		t.Errorf("expected no quorum if all nodes disagree, got %v", err)
+	}
+}
+
+func TestMultiNodeClientFinalizedStateQuorum(t *testing.T) {
+	a := newFakeBeaconNode(t, 400, "0xaa")
+	b := newFakeBeaconNode(t, 400, "0xaa")
+	c := newFakeBeaconNode(t, 400, "0xaa")
+	for i, node := range []*fakeBeaconNode{a, b, c} {
+		node.set(func(n *fakeBeaconNode) {
+			n.finalizedEpoch = 10
+			n.stateRoot = "0x5b"
+			if i == 0 {
+				n.stateRoot = "0x5a"
+			}
+		})
+	}
+	client := newTestMultiNodeClient(t, consapi.MultiNodeConfig{FinalizedQuorum: 2}, a, b, c)
+
+	// the balances of a finalized state are requested from a single node by the state root the quorum agreed on
+	res, err := client.GetValidatorBalances(64)
+	if err != nil {
+		t.Fatalf("error getting finalized balances: %v", err)
+	}
+	if len(res.Data) != 1 {
+		t.Errorf("expected the balance of 1 validator, got %d", len(res.Data))
+	}
+	requests := append(append(a.getBalanceStates(), b.getBalanceStates()...), c.getBalanceStates()...)
+	if len(requests) != 1 || requests[0] != "0x5b" {
+		t.Errorf("expected a single balances request for state 0x5b, got %v", requests)
+	}
+
+	// unfinalized states are requested as they are
+	_, err = client.GetValidatorBalances("head")
+	if err != nil {
+		t.Fatalf("error getting balances: %v", err)
+	}
+	if states := a.getBalanceStates(); len(states) != 2 || states[1] != "head" {
+		t.Errorf("expected the head balances to be requested from the first node, got %v", states)
+	}
+
+	c.set(func(n *fakeBeaconNode) { n.stateRoot = "0x5c" })
+	_, err = client.GetValidatorBalances(64)
+	if !errors.Is(err, consapi.ErrNoQuorum) {
+		t.Errorf("expected no quorum if all nodes disagree on the state root, got %v", err)
+	}
+}
+
+func TestMultiNodeClientInvalidQuorum(t *testing.T) {
+	_, err := consapi.NewMultiNodeClient([]string{"http://localhost:1"}, consapi.MultiNodeConfig{FinalizedQuorum: 2})
+	if err == nil {
+		t.Errorf("expected an error for a quorum larger than the number of nodes")
+	}
+}
+
+func TestMultiNodeClientEventsFailover(t *testing.T) {
+	a := newFakeBeaconNode(t, 110, "0xaa")
+	b := newFakeBeaconNode(t, 100, "0xbb")
+	client := newTestMultiNodeClient(t, consapi.MultiNodeConfig{HealthCheckInterval: 50 * time.Millisecond, MaxHeadSlotLag: 32}, a, b)
+
+	events := client.GetEvents([]types.EventTopic{types.EventHead})
+	receive := func() string {
+		for {
+			select {
+			case event := <-events:
+				if event.Error != nil {
+					continue
+				}
+				return string(event.Data)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("timed out waiting for event")
+				return ""
+			}
+		}
+	}
+
+	a.events <- `{"slot":"1"}`
+	if data := receive(); data != `{"slot":"1"}` {
+		t.Errorf("expected the event of the healthiest node, got %v", data)
+	}
+
+	a.set(func(n *fakeBeaconNode) { n.down = true })
+	// keep feeding the second node until the subscription switched over
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		select {
+		case b.events <- `{"slot":"2"}`:
+		default:
+		}
+		select {
+		case event := <-events:
+			if event.Error == nil && string(event.Data) == `{"slot":"2"}` {
+				return
+			}
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	t.Errorf("expected the subscription to fail over to the second node")
+}
@@ -1,6 +1,7 @@
 package consapi
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -33,6 +34,9 @@ func NewClientWithConfig(endpoint string, httpClient *http.Client) Client {
 }
 
 func (r *NodeClient) GetValidatorBalances(stateID any) (*types.StandardValidatorBalancesResponse, error) {
+	if balances := r.getStateBalances(stateID); balances != nil {
+		return balances, nil
+	}
 	requestURL := fmt.Sprintf("%s/eth/v1/beacon/states/%v/validator_balances", r.Endpoint, stateID)
 	return network.Get[types.StandardValidatorBalancesResponse](r.httpClient, requestURL)
 }
@@ -42,6 +46,12 @@ func (r *NodeClient) GetFinalityCheckpoints(stateID any) (*types.StandardFinalit
 	return network.Get[types.StandardFinalityCheckpointsResponse](r.httpClient, requestURL)
 }
 
+// getStateRoot is used by the multi node client to cross-check states without downloading them from every node
+func (r *NodeClient) getStateRoot(stateID any) (*types.StandardStateRootResponse, error) {
+	requestURL := fmt.Sprintf("%s/eth/v1/beacon/states/%v/root", r.Endpoint, stateID)
+	return network.Get[types.StandardStateRootResponse](r.httpClient, requestURL)
+}
+
 func (r *NodeClient) GetBlockHeader(blockID any) (*types.StandardBeaconHeaderResponse, error) {
 	requestURL := fmt.Sprintf("%s/eth/v1/beacon/headers/%v", r.Endpoint, blockID)
 	return network.Get[types.StandardBeaconHeaderResponse](r.httpClient, requestURL)
@@ -74,10 +84,29 @@ func (r *NodeClient) GetSpec() (*types.StandardSpecResponse, error) {
 
 func (r *NodeClient) GetSlot(blockID any) (*types.StandardBeaconSlotResponse, error) {
 	requestURL := fmt.Sprintf("%s/eth/v2/beacon/blocks/%v", r.Endpoint, blockID)
+	if r.sszBlockSupported(blockID) {
+		slot, err := r.getSlotSSZ(requestURL)
+		if err == nil || !sszFallback(err) {
+			return slot, err
+		}
+	}
 	return network.Get[types.StandardBeaconSlotResponse](r.httpClient, requestURL)
 }
 
 func (r *NodeClient) GetValidators(state any, ids []string, status []types.ValidatorStatus) (*types.StandardValidatorsResponse, error) {
+	if len(ids) == 0 {
+		response := &types.StandardValidatorsResponse{}
+		err := r.streamStateValidatorsSSZ(state, func(validator *types.StandardValidator) error {
+			if matchesValidatorStatus(validator.Status, status) {
+				response.Data = append(response.Data, *validator)
+			}
+			return nil
+		})
+		if err == nil || !sszFallback(err) {
+			return response, err
+		}
+	}
+
 	requestURL := fmt.Sprintf("%s/eth/v1/beacon/states/%v/validators", r.Endpoint, state)
 	if len(ids) > 0 {
 		idStr := strings.Join(ids, ",")
@@ -94,7 +123,21 @@ func (r *NodeClient) GetValidators(state any, ids []string, status []types.Valid
 		requestURL += fmt.Sprintf("status=%s", statusStr)
 	}
 
-	return network.Get[types.StandardValidatorsResponse](r.httpClient, requestURL)
+	response := &types.StandardValidatorsResponse{}
+	err := network.Stream(r.httpClient, requestURL, response, func(validator *types.StandardValidator) error {
+		response.Data = append(response.Data, *validator)
+		return nil
+	})
+	return response, err
+}
+
+func (r *NodeClient) StreamValidators(state any, fn func(*types.StandardValidator) error) error {
+	err := r.streamStateValidatorsSSZ(state, fn)
+	if err == nil || !sszFallback(err) {
+		return err
+	}
+	requestURL := fmt.Sprintf("%s/eth/v1/beacon/states/%v/validators", r.Endpoint, state)
+	return network.Stream(r.httpClient, requestURL, nil, fn)
 }
 
 func (r *NodeClient) GetValidator(validatorID, state any) (*types.StandardSingleValidatorsResponse, error) {
@@ -144,35 +187,74 @@ func (r *NodeClient) GetGenesis() (*types.StandardGenesisResponse, error) {
 	return network.Get[types.StandardGenesisResponse](r.httpClient, requestURL)
 }
 
+func (r *NodeClient) GetPendingDeposits(stateID any) (*types.StandardPendingDepositsResponse, error) {
+	requestURL := fmt.Sprintf("%s/eth/v1/beacon/states/%v/pending_deposits", r.Endpoint, stateID)
+	return network.Get[types.StandardPendingDepositsResponse](r.httpClient, requestURL)
+}
+
+func (r *NodeClient) GetPendingPartialWithdrawals(stateID any) (*types.StandardPendingPartialWithdrawalsResponse, error) {
+	requestURL := fmt.Sprintf("%s/eth/v1/beacon/states/%v/pending_partial_withdrawals", r.Endpoint, stateID)
+	return network.Get[types.StandardPendingPartialWithdrawalsResponse](r.httpClient, requestURL)
+}
+
+func (r *NodeClient) GetPendingConsolidations(stateID any) (*types.StandardPendingConsolidationsResponse, error) {
+	requestURL := fmt.Sprintf("%s/eth/v1/beacon/states/%v/pending_consolidations", r.Endpoint, stateID)
+	return network.Get[types.StandardPendingConsolidationsResponse](r.httpClient, requestURL)
+}
+
+func (r *NodeClient) GetSyncing() (*types.StandardSyncingResponse, error) {
+	requestURL := fmt.Sprintf("%s/eth/v1/node/syncing", r.Endpoint)
+	return network.Get[types.StandardSyncingResponse](r.httpClient, requestURL)
+}
+
 func (r *NodeClient) GetEvents(topics []types.EventTopic) chan *types.EventResponse {
+	return r.subscribeEvents(topics, nil)
+}
+
+// subscribeEvents streams the events of the given topics until done is closed, a nil done channel streams forever
+func (r *NodeClient) subscribeEvents(topics []types.EventTopic, done <-chan struct{}) chan *types.EventResponse {
 	joinedTopics := strings.Join(utils.ConvertToStringSlice(topics), ",")
 	requestURL := fmt.Sprintf("%s/eth/v1/events?topics=%v", r.Endpoint, joinedTopics)
 	responseCh := make(chan *types.EventResponse, 32)
 
-	req, _ := http.NewRequest(http.MethodGet, requestURL, nil)
+	// closing the stream does not abort a pending read, so the request itself is cancelled once done is closed
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
 	// disable gzip compression for sse
 	req.Header.Set("accept-encoding", "identity")
 
 	go func() {
+		defer cancel()
 		stream, err := eventsource.SubscribeWithRequest("", req)
 
 		if err != nil {
-			responseCh <- &types.EventResponse{Error: err}
+			select {
+			case responseCh <- &types.EventResponse{Error: err}:
+			case <-done:
+			}
 			return
 		}
 		defer stream.Close()
 
 		for {
+			var response *types.EventResponse
 			select {
 			// It is important to register to Errors, otherwise the stream does not reconnect if the connection was lost
 			case err := <-stream.Errors:
-				responseCh <- &types.EventResponse{Error: err}
+				response = &types.EventResponse{Error: err}
 			case e := <-stream.Events:
-				var response types.EventResponse
-				response.Data = []byte(e.Data())
-				response.Event = types.EventTopic(e.Event())
+				response = &types.EventResponse{
+					Data:  []byte(e.Data()),
+					Event: types.EventTopic(e.Event()),
+				}
+			case <-done:
+				return
+			}
 
-				responseCh <- &response
+			select {
+			case responseCh <- response:
+			case <-done:
+				return
 			}
 		}
 	}()
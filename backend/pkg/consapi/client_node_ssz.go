@@ -0,0 +1,163 @@
+package consapi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/gobitfly/beaconchain/pkg/consapi/network"
+	"github.com/gobitfly/beaconchain/pkg/consapi/ssz"
+	"github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+// sszSpec returns the spec of the node which the ssz decoding depends on, it is only requested until it succeeded once
+func (r *NodeClient) sszSpec() (*types.StandardSpec, error) {
+	r.specMu.Lock()
+	defer r.specMu.Unlock()
+	if r.spec == nil {
+		spec, err := r.GetSpec()
+		if err != nil {
+			return nil, fmt.Errorf("%w: error getting spec: %w", network.ErrSSZNotSupported, err)
+		}
+		r.spec = &spec.Data
+	}
+	if r.spec.SlotsPerEpoch <= 0 || r.spec.SlotsPerHistoricalRoot <= 0 {
+		return nil, fmt.Errorf("%w: incomplete spec of %s", network.ErrSSZNotSupported, r.Endpoint)
+	}
+	return r.spec, nil
+}
+
+// getSSZ requests the ssz encoding of a resource. Once the node answered that it can't serve it, this is remembered
+// in unsupported and all further requests directly return network.ErrSSZNotSupported.
+func (r *NodeClient) getSSZ(requestURL string, unsupported *atomic.Bool) (*network.SSZResponse, error) {
+	if unsupported.Load() {
+		return nil, network.ErrSSZNotSupported
+	}
+	res, err := network.GetSSZ(r.httpClient, requestURL)
+	if errors.Is(err, network.ErrSSZNotSupported) {
+		unsupported.Store(true)
+	}
+	return res, err
+}
+
+// sszFallback reports whether a failed ssz request should be repeated as json. Server errors are returned as they are
+// so they count against the health of the node, client errors like an unknown state id get their answer from the json api.
+func sszFallback(err error) bool {
+	if errors.Is(err, network.ErrSSZNotSupported) || errors.Is(err, ssz.ErrUnsupportedVersion) {
+		return true
+	}
+	httpErr := network.SpecificError(err)
+	return httpErr != nil && httpErr.StatusCode < http.StatusInternalServerError
+}
+
+// sszBlockSupported reports whether blocks of the id can be decoded from ssz. The typed decoders only support the
+// mainnet preset up to deneb, so numeric slots from electra on are requested as json directly. Other ids are tried as
+// ssz and fall back to json once the consensus version of the response is known.
+func (r *NodeClient) sszBlockSupported(blockID any) bool {
+	if r.sszBlocksUnsupported.Load() {
+		return false
+	}
+	spec, err := r.sszSpec()
+	if err != nil || spec.PresetBase != "mainnet" {
+		return false
+	}
+	slot, err := strconv.ParseUint(fmt.Sprintf("%v", blockID), 10, 64)
+	if err != nil {
+		return true
+	}
+	return spec.ElectraForkEpoch == nil || slot/uint64(spec.SlotsPerEpoch) < *spec.ElectraForkEpoch
+}
+
+func (r *NodeClient) getSlotSSZ(requestURL string) (*types.StandardBeaconSlotResponse, error) {
+	res, err := r.getSSZ(requestURL, &r.sszBlocksUnsupported)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block: %w", err)
+	}
+	block, err := ssz.SignedBlock(res.Version, data)
+	if err != nil {
+		return nil, err
+	}
+	return &types.StandardBeaconSlotResponse{
+		Version: res.Version,
+		Data:    *block,
+	}, nil
+}
+
+// streamStateValidatorsSSZ reads the validators from the ssz encoded state, which is a fraction of the size of the
+// json validators response and decodes without reflection. The balances are kept for a following balances request of
+// the same state, the state is not downloaded for the balances alone.
+func (r *NodeClient) streamStateValidatorsSSZ(state any, fn func(*types.StandardValidator) error) error {
+	if r.sszStatesUnsupported.Load() {
+		return network.ErrSSZNotSupported
+	}
+	spec, err := r.sszSpec()
+	if err != nil {
+		return err
+	}
+	res, err := r.getSSZ(fmt.Sprintf("%s/eth/v2/debug/beacon/states/%v", r.Endpoint, state), &r.sszStatesUnsupported)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	var balances []uint64
+	_, err = ssz.Validators(res.Body, uint64(spec.SlotsPerHistoricalRoot), uint64(spec.SlotsPerEpoch), func(validator *types.StandardValidator) error {
+		balances = append(balances, validator.Balance)
+		return fn(validator)
+	})
+	if err != nil {
+		return err
+	}
+	r.setStateBalances(state, balances)
+	return nil
+}
+
+// setStateBalances keeps the balances of a state, only states that don't change are kept
+func (r *NodeClient) setStateBalances(state any, balances []uint64) {
+	id := fmt.Sprint(state)
+	if id == "head" || id == "finalized" || id == "justified" {
+		return
+	}
+	r.balancesMu.Lock()
+	defer r.balancesMu.Unlock()
+	r.balancesState = id
+	r.balances = balances
+}
+
+// getStateBalances returns the balances of the state if its validators have been read last, nil otherwise
+func (r *NodeClient) getStateBalances(state any) *types.StandardValidatorBalancesResponse {
+	r.balancesMu.Lock()
+	defer r.balancesMu.Unlock()
+	if r.balances == nil || r.balancesState != fmt.Sprint(state) {
+		return nil
+	}
+	response := &types.StandardValidatorBalancesResponse{
+		Data: make([]types.StandardValidatorBalance, len(r.balances)),
+	}
+	for i, balance := range r.balances {
+		response.Data[i] = types.StandardValidatorBalance{Index: uint64(i), Balance: balance}
+	}
+	return response
+}
+
+// matchesValidatorStatus applies the status filter of the validators endpoint, a filter matches its exact status
+// or all statuses it is the prefix of, e.g. active matches active_ongoing
+func matchesValidatorStatus(status types.ValidatorStatus, filter []types.ValidatorStatus) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, f := range filter {
+		if status == f || strings.HasPrefix(string(status), string(f)+"_") {
+			return true
+		}
+	}
+	return false
+}
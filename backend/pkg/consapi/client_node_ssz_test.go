@@ -0,0 +1,230 @@
+package consapi_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gobitfly/beaconchain/pkg/consapi"
+	"github.com/gobitfly/beaconchain/pkg/consapi/network"
+	"github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+// sszState encodes the prefix of a BeaconState with 64 slots per historical root up to the balances,
+// validator 0 is active and validator 1 has exited
+func sszState(slot uint64) []byte {
+	prefixSize := 272 + 64*64
+	validatorsOffset := prefixSize + 16 // some variable size data before the registry
+	state := make([]byte, validatorsOffset)
+	binary.LittleEndian.PutUint64(state[40:], slot)
+	binary.LittleEndian.PutUint32(state[prefixSize-8:], uint32(validatorsOffset))
+	binary.LittleEndian.PutUint32(state[prefixSize-4:], uint32(validatorsOffset+2*121))
+
+	for i, epochs := range [][4]uint64{{0, 0, math.MaxUint64, math.MaxUint64}, {0, 0, 1, 2}} {
+		validator := make([]byte, 121)
+		validator[0] = byte(i + 1)
+		binary.LittleEndian.PutUint64(validator[80:], 32e9)
+		for j, epoch := range epochs {
+			binary.LittleEndian.PutUint64(validator[89+j*8:], epoch)
+		}
+		state = append(state, validator...)
+	}
+	for _, balance := range []uint64{32000000001, 32000000002} {
+		state = binary.LittleEndian.AppendUint64(state, balance)
+	}
+	return state
+}
+
+// sszNode serves states and blocks as ssz if sszSupported is set and as json otherwise
+type sszNode struct {
+	server       *httptest.Server
+	sszSupported bool
+	presetBase   string
+
+	mu       sync.Mutex
+	requests map[string]int
+}
+
+func newSSZNode(t *testing.T, sszSupported bool, presetBase string) *sszNode {
+	node := &sszNode{sszSupported: sszSupported, presetBase: presetBase, requests: make(map[string]int)}
+	node.server = httptest.NewServer(http.HandlerFunc(node.serveHTTP))
+	t.Cleanup(node.server.Close)
+	return node
+}
+
+func (n *sszNode) count(path string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.requests[path]
+}
+
+func (n *sszNode) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	n.mu.Lock()
+	n.requests[r.URL.Path]++
+	n.mu.Unlock()
+
+	ssz := n.sszSupported && r.Header.Get("Accept") == network.ContentTypeSSZ
+	switch {
+	case r.URL.Path == "/eth/v1/config/spec":
+		fmt.Fprintf(w, `{"data":{"PRESET_BASE":%q,"SLOTS_PER_EPOCH":"8","SLOTS_PER_HISTORICAL_ROOT":"64","ELECTRA_FORK_EPOCH":"10"}}`, n.presetBase)
+	case r.URL.Path == "/eth/v2/debug/beacon/states/16" && ssz:
+		w.Header().Set("Content-Type", network.ContentTypeSSZ)
+		_, _ = w.Write(sszState(16))
+	case strings.HasPrefix(r.URL.Path, "/eth/v2/debug/beacon/states/"):
+		http.Error(w, `{"code":406,"message":"only ssz"}`, http.StatusNotAcceptable)
+	case r.URL.Path == "/eth/v1/beacon/states/16/validators":
+		fmt.Fprint(w, `{"execution_optimistic":false,"finalized":true,"data":[{"index":"0","balance":"32000000001","status":"active_ongoing","validator":{"pubkey":"0x01"}},{"index":"1","balance":"32000000002","status":"withdrawal_possible","validator":{"pubkey":"0x02"}}]}`)
+	case r.URL.Path == "/eth/v1/beacon/states/16/validator_balances":
+		fmt.Fprint(w, `{"data":[{"index":"0","balance":"32000000001"},{"index":"1","balance":"32000000002"}]}`)
+	case r.URL.Path == "/eth/v2/beacon/blocks/16" && ssz:
+		block := &phase0.SignedBeaconBlock{Message: &phase0.BeaconBlock{Slot: 16, ProposerIndex: 1, Body: &phase0.BeaconBlockBody{ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)}}}}
+		data, err := block.MarshalSSZ()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", network.ContentTypeSSZ)
+		w.Header().Set("Eth-Consensus-Version", "phase0")
+		_, _ = w.Write(data)
+	case strings.HasPrefix(r.URL.Path, "/eth/v2/beacon/blocks/"):
+		slot := strings.TrimPrefix(r.URL.Path, "/eth/v2/beacon/blocks/")
+		fmt.Fprintf(w, `{"version":"electra","finalized":true,"data":{"message":{"slot":"%s","proposer_index":"2"}}}`, slot)
+	default:
+		http.Error(w, `{"code":404,"message":"not found"}`, http.StatusNotFound)
+	}
+}
+
+func TestSSZValidators(t *testing.T) {
+	node := newSSZNode(t, true, "minimal")
+	cl := consapi.NewClient(node.server.URL)
+
+	validators, err := cl.GetValidators(16, nil, []types.ValidatorStatus{types.Active})
+	if err != nil {
+		t.Fatalf("error getting validators: %v", err)
+	}
+	if len(validators.Data) != 1 || validators.Data[0].Index != 0 || validators.Data[0].Balance != 32000000001 || validators.Data[0].Status != types.ActiveOngoing {
+		t.Errorf("expected only the active validator 0, got %+v", validators.Data)
+	}
+
+	var streamed []types.ValidatorStatus
+	err = cl.StreamValidators(16, func(validator *types.StandardValidator) error {
+		streamed = append(streamed, validator.Status)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("error streaming validators: %v", err)
+	}
+	if len(streamed) != 2 || streamed[1] != types.WithdrawalPossible {
+		t.Errorf("expected both validators with validator 1 withdrawable, got %v", streamed)
+	}
+
+	balances, err := cl.GetValidatorBalances(16)
+	if err != nil {
+		t.Fatalf("error getting balances: %v", err)
+	}
+	if len(balances.Data) != 2 || balances.Data[1].Index != 1 || balances.Data[1].Balance != 32000000002 {
+		t.Errorf("unexpected balances %+v", balances.Data)
+	}
+
+	if node.count("/eth/v1/beacon/states/16/validators") != 0 || node.count("/eth/v1/beacon/states/16/validator_balances") != 0 {
+		t.Errorf("expected no json requests to a node serving ssz")
+	}
+	if node.count("/eth/v2/debug/beacon/states/16") != 2 {
+		t.Errorf("expected the balances of the state to be reused, got %d state requests", node.count("/eth/v2/debug/beacon/states/16"))
+	}
+	if node.count("/eth/v1/config/spec") != 1 {
+		t.Errorf("expected the spec to be requested once, got %d", node.count("/eth/v1/config/spec"))
+	}
+
+	// client errors of the ssz request are answered by the json api
+	_, err = cl.GetValidators(17, nil, nil)
+	if httpErr := network.SpecificError(err); httpErr == nil || httpErr.StatusCode != http.StatusNotFound || !strings.Contains(httpErr.Url, "/eth/v1/") {
+		t.Errorf("expected the not found error of the json api, got %v", err)
+	}
+}
+
+func TestSSZBalances(t *testing.T) {
+	node := newSSZNode(t, true, "minimal")
+	cl := consapi.NewClient(node.server.URL)
+
+	// the whole state is not downloaded for the balances alone
+	balances, err := cl.GetValidatorBalances(16)
+	if err != nil {
+		t.Fatalf("error getting balances: %v", err)
+	}
+	if len(balances.Data) != 2 || balances.Data[0].Balance != 32000000001 {
+		t.Errorf("unexpected balances %+v", balances.Data)
+	}
+	if node.count("/eth/v2/debug/beacon/states/16") != 0 || node.count("/eth/v1/beacon/states/16/validator_balances") != 1 {
+		t.Errorf("expected the balances to be requested from the balances endpoint")
+	}
+}
+
+func TestSSZFallback(t *testing.T) {
+	node := newSSZNode(t, false, "minimal")
+	cl := consapi.NewClient(node.server.URL)
+
+	for i := 0; i < 2; i++ {
+		validators, err := cl.GetValidators(16, nil, nil)
+		if err != nil {
+			t.Fatalf("error getting validators: %v", err)
+		}
+		if len(validators.Data) != 2 || !validators.Finalized || validators.Data[1].Status != types.WithdrawalPossible {
+			t.Errorf("unexpected validators %+v", validators)
+		}
+	}
+	var count int
+	err := cl.StreamValidators(16, func(validator *types.StandardValidator) error {
+		count++
+		return nil
+	})
+	if err != nil || count != 2 {
+		t.Errorf("expected 2 streamed validators, got %d and error %v", count, err)
+	}
+	balances, err := cl.GetValidatorBalances(16)
+	if err != nil || len(balances.Data) != 2 {
+		t.Errorf("expected 2 balances, got %+v and error %v", balances, err)
+	}
+
+	// the node is not asked for ssz again once it refused it
+	if requests := node.count("/eth/v2/debug/beacon/states/16"); requests != 1 {
+		t.Errorf("expected a single ssz state request, got %d", requests)
+	}
+}
+
+func TestSSZBlocks(t *testing.T) {
+	node := newSSZNode(t, true, "mainnet")
+	cl := consapi.NewClient(node.server.URL)
+
+	block, err := cl.GetSlot(16)
+	if err != nil {
+		t.Fatalf("error getting block: %v", err)
+	}
+	if block.Version != "phase0" || block.Data.Message.Slot != 16 || block.Data.Message.ProposerIndex != 1 {
+		t.Errorf("expected the ssz encoded phase0 block, got %+v", block)
+	}
+
+	// electra blocks can't be decoded from ssz and are requested as json directly
+	block, err = cl.GetSlot(80)
+	if err != nil {
+		t.Fatalf("error getting block: %v", err)
+	}
+	if block.Version != "electra" || block.Data.Message.ProposerIndex != 2 {
+		t.Errorf("expected the json encoded electra block, got %+v", block)
+	}
+
+	// blocks of other presets are always requested as json
+	block, err = consapi.NewClient(newSSZNode(t, true, "minimal").server.URL).GetSlot(16)
+	if err != nil {
+		t.Fatalf("error getting block: %v", err)
+	}
+	if block.Version != "electra" {
+		t.Errorf("expected the json block for the minimal preset, got %+v", block)
+	}
+}
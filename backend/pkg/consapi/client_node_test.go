@@ -1,26 +1,43 @@
 package consapi_test
 
 import (
-	"fmt"
 	"log"
 	"os"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/gobitfly/beaconchain/pkg/consapi"
+	"github.com/gobitfly/beaconchain/pkg/consapi/fixtures"
 	"github.com/gobitfly/beaconchain/pkg/consapi/network"
 	"github.com/gobitfly/beaconchain/pkg/consapi/types"
 )
 
+// the fixture holds a small synthetic chain of 24 slots (8 slots per epoch, 4 validators, slot 10 missed) recorded from
+// the simulated node in testdata/synthetic_chain, it can be replaced by a recording of a real node using the
+// record-beacon-fixtures misc command
+//
+//go:generate go run ./testdata/synthetic_chain testdata/synthetic_chain.json.gz
+const fixturePath = "testdata/synthetic_chain.json.gz"
+
 var cl consapi.Client
+var fixture *fixtures.Fixture
 
 func TestMain(m *testing.M) {
-	setup()
-	code := m.Run()
-	os.Exit(code)
+	os.Exit(run(m))
 }
 
-func setup() {
-	cl = consapi.NewClient("http://localhost:32787")
+func run(m *testing.M) int {
+	var err error
+	fixture, err = fixtures.Load(fixturePath)
+	if err != nil {
+		log.Printf("error loading fixture: %v", err)
+		return 1
+	}
+	server := fixtures.NewReplayServer(fixture)
+	defer server.Close()
+	cl = consapi.NewClient(server.URL)
+	return m.Run()
 }
 
 func TestGetBlockHeader(t *testing.T) {
@@ -40,7 +57,7 @@ func TestGetSlot(t *testing.T) {
 }
 
 func TestGetValidators(t *testing.T) {
-	res, err := cl.GetValidators("head", nil, nil)
+	res, err := cl.GetValidators(fixture.EndSlot, nil, nil)
 	if err != nil {
 		t.Errorf("Error getting validators: %v", err)
 	}
@@ -49,7 +66,7 @@ func TestGetValidators(t *testing.T) {
 
 func TestGetValidatorsFilter(t *testing.T) {
 	filter := types.ActiveSlashed
-	res, err := cl.GetValidators("head", nil, []types.ValidatorStatus{filter})
+	res, err := cl.GetValidators(fixture.EndSlot, nil, []types.ValidatorStatus{filter})
 	if err != nil {
 		t.Errorf("Error getting validators: %v", err)
 	}
@@ -61,29 +78,31 @@ func TestGetValidatorsFilter(t *testing.T) {
 }
 
 func TestGetValidatorsFilterIndex(t *testing.T) {
-	filter := []string{"4", "5", "6"}
-	res, err := cl.GetValidators("head", filter, nil)
+	res, err := cl.GetValidators(fixture.EndSlot, []string{"1", "3"}, nil)
 	if err != nil {
-		t.Errorf("Error getting validators: %v", err)
+		t.Fatalf("Error getting validators: %v", err)
 	}
-	for _, v := range res.Data {
-		if !contains(filter, fmt.Sprintf("%v", v.Index)) {
-			t.Errorf("Error getting validators: %v", v.Index)
-		}
+	if indices := validatorIndices(res); !slices.Equal(indices, []uint64{1, 3}) {
+		t.Errorf("Expected validators 1 and 3, got %v", indices)
 	}
 }
 
 func TestGetValidatorsFilterBoth(t *testing.T) {
-	filter := []string{"4", "5", "6"}
-	filterStatus := types.ActiveOngoing
-	res, err := cl.GetValidators("head", filter, []types.ValidatorStatus{filterStatus})
+	res, err := cl.GetValidators(fixture.EndSlot, []string{"1", "3"}, []types.ValidatorStatus{types.ActiveOngoing})
 	if err != nil {
-		t.Errorf("Error getting validators: %v", err)
+		t.Fatalf("Error getting validators: %v", err)
 	}
-	for _, v := range res.Data {
-		if !contains(filter, fmt.Sprintf("%v", v.Index)) {
-			t.Errorf("Error getting validators: %v", v.Index)
-		}
+	if indices := validatorIndices(res); !slices.Equal(indices, []uint64{1, 3}) {
+		t.Errorf("Expected validators 1 and 3, got %v", indices)
+	}
+
+	// all validators of the fixture are active
+	res, err = cl.GetValidators(fixture.EndSlot, []string{"1", "3"}, []types.ValidatorStatus{types.Exited})
+	if err != nil {
+		t.Fatalf("Error getting validators: %v", err)
+	}
+	if len(res.Data) != 0 {
+		t.Errorf("Expected no exited validators, got %v", validatorIndices(res))
 	}
 }
 
@@ -96,7 +115,7 @@ func TestGetPropoalAssignments(t *testing.T) {
 }
 
 func TestGetPropoalRewards(t *testing.T) {
-	res, err := cl.GetPropoalRewards("head")
+	res, err := cl.GetPropoalRewards(fixture.EndSlot)
 	if err != nil {
 		t.Errorf("Error getting proposal rewards: %v", err)
 	}
@@ -104,7 +123,7 @@ func TestGetPropoalRewards(t *testing.T) {
 }
 
 func TestGetSyncRewards(t *testing.T) {
-	res, err := cl.GetSyncRewards("head")
+	res, err := cl.GetSyncRewards(fixture.EndSlot)
 	if err != nil {
 		t.Errorf("Error getting sync rewards: %v", err)
 	}
@@ -120,7 +139,7 @@ func TestGetAttestationRewards(t *testing.T) {
 }
 
 func TestGetSyncCommitteesAssignments(t *testing.T) {
-	res, err := cl.GetSyncCommitteesAssignments(nil, "head")
+	res, err := cl.GetSyncCommitteesAssignments(nil, fixture.StartSlot)
 	if err != nil {
 		t.Errorf("Error getting sync committees assignments: %v", err)
 	}
@@ -166,7 +185,7 @@ func TestGetFinalityCheckpoints(t *testing.T) {
 }
 
 func TestGetValidatorBalances(t *testing.T) {
-	res, err := cl.GetValidatorBalances("head")
+	res, err := cl.GetValidatorBalances("genesis")
 	if err != nil {
 		t.Errorf("Error getting validator balances: %v", err)
 	}
@@ -174,7 +193,7 @@ func TestGetValidatorBalances(t *testing.T) {
 }
 
 func TestGetBlobSidecars(t *testing.T) {
-	res, err := cl.GetBlobSidecars("head")
+	res, err := cl.GetBlobSidecars(fixture.EndSlot)
 	if err != nil {
 		t.Errorf("Error getting blob sidecars: %v", err)
 	}
@@ -182,7 +201,7 @@ func TestGetBlobSidecars(t *testing.T) {
 }
 
 func TestGetCommittees(t *testing.T) {
-	res, err := cl.GetCommittees("head", nil, nil, nil)
+	res, err := cl.GetCommittees(fixture.EndSlot, nil, nil, nil)
 	if err != nil {
 		t.Errorf("Error getting committees: %v", err)
 	}
@@ -200,9 +219,18 @@ func TestGetGenesis(t *testing.T) {
 func TestGetEvents(t *testing.T) {
 	res := cl.GetEvents([]types.EventTopic{types.EventHead, types.EventBlock, types.EventChainReorg, types.EventFinalizedCheckpoint})
 
-	for event := range res {
+	// the replayed stream stays open after the recorded events, so only the recorded number of events is read
+	for i := 0; i < len(fixture.Events); i++ {
+		var event *types.EventResponse
+		select {
+		case event = <-res:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for event %d of %d", i+1, len(fixture.Events))
+		}
+
 		if event.Error != nil {
 			t.Errorf("Error getting event: %v", event.Error)
+			continue
 		}
 
 		if event.Event == types.EventHead {
@@ -239,11 +267,18 @@ func TestGetEvents(t *testing.T) {
 	}
 }
 
-func contains(arr []string, val string) bool {
-	for _, v := range arr {
-		if v == val {
-			return true
-		}
+func TestGetSlotMissed(t *testing.T) {
+	_, err := cl.GetSlot(10)
+	httpErr := network.SpecificError(err)
+	if httpErr == nil || httpErr.StatusCode != 404 {
+		t.Errorf("Expected a not found error for a missed slot, got: %v", err)
+	}
+}
+
+func validatorIndices(res *types.StandardValidatorsResponse) []uint64 {
+	indices := make([]uint64, len(res.Data))
+	for i, v := range res.Data {
+		indices[i] = v.Index
 	}
-	return false
+	return indices
 }
@@ -0,0 +1,101 @@
+// Package fixtures records beacon api responses into compressed fixture files and replays them from a local
+// http server, so code depending on a beacon node can be tested without network access.
+package fixtures
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// Fixture holds the recorded responses and events of a beacon node
+type Fixture struct {
+	StartSlot uint64     `json:"start_slot"`
+	EndSlot   uint64     `json:"end_slot"`
+	Responses []Response `json:"responses"`
+	Events    []Event    `json:"events"`
+}
+
+type Response struct {
+	Method string `json:"method"`
+	Path   string `json:"path"` // request path including the normalized query
+	Status int    `json:"status"`
+	Body   string `json:"body"`
+}
+
+type Event struct {
+	Event string `json:"event"`
+	Data  string `json:"data"`
+}
+
+// requestKey normalizes a request so that the order of the query parameters does not matter
+func requestKey(method string, u *url.URL) string {
+	key := method + " " + u.Path
+	if query := u.Query().Encode(); query != "" {
+		key += "?" + query
+	}
+	return key
+}
+
+// Add stores a response, a response recorded earlier for the same request is replaced
+func (f *Fixture) Add(method string, u *url.URL, status int, body []byte) {
+	key := requestKey(method, u)
+	response := Response{
+		Method: method,
+		Path:   key[len(method)+1:],
+		Status: status,
+		Body:   string(body),
+	}
+	for i := range f.Responses {
+		if f.Responses[i].Method+" "+f.Responses[i].Path == key {
+			f.Responses[i] = response
+			return
+		}
+	}
+	f.Responses = append(f.Responses, response)
+}
+
+// Load reads a gzip compressed fixture file
+func Load(path string) (*Fixture, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening fixture %s: %w", path, err)
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing fixture %s: %w", path, err)
+	}
+	defer reader.Close()
+
+	var fixture Fixture
+	if err := json.NewDecoder(reader).Decode(&fixture); err != nil {
+		return nil, fmt.Errorf("error decoding fixture %s: %w", path, err)
+	}
+	return &fixture, nil
+}
+
+// Save writes the fixture gzip compressed to the given path
+func (f *Fixture) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("error creating fixture directory: %w", err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating fixture %s: %w", path, err)
+	}
+	defer file.Close()
+
+	writer := gzip.NewWriter(file)
+	if err := json.NewEncoder(writer).Encode(f); err != nil {
+		return fmt.Errorf("error encoding fixture %s: %w", path, err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("error compressing fixture %s: %w", path, err)
+	}
+	return file.Close()
+}
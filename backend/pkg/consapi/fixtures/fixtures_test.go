@@ -0,0 +1,107 @@
+package fixtures_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/consapi"
+	"github.com/gobitfly/beaconchain/pkg/consapi/fixtures"
+	"github.com/gobitfly/beaconchain/pkg/consapi/network"
+	"github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+// upstreamNode serves a single epoch of a chain with two slots per epoch where slot 1 was missed
+func upstreamNode(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/config/spec":
+			fmt.Fprint(w, `{"data":{"SLOTS_PER_EPOCH":"2"}}`)
+		case "/eth/v1/beacon/headers/0", "/eth/v1/beacon/headers/0xaa":
+			fmt.Fprint(w, `{"data":{"root":"0xaa","header":{"message":{"slot":"0","state_root":"0xbb"}}}}`)
+		case "/eth/v1/validator/duties/proposer/0":
+			fmt.Fprint(w, `{"dependent_root":"0xaa","data":[{"validator_index":"1","slot":"0"},{"validator_index":"0","slot":"1"}]}`)
+		case "/eth/v1/beacon/states/1/validators":
+			fmt.Fprint(w, `{"data":[{"index":"0","balance":"1","status":"active_ongoing"},{"index":"1","balance":"2","status":"exited_unslashed"}]}`)
+		case "/eth/v1/events":
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprint(w, "event: head\ndata: {\"slot\":\"0\"}\n\nevent: block\ndata: {\"slot\":\"0\"}\n\n")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		default:
+			if r.URL.Path == "/eth/v2/beacon/blocks/0" || r.URL.Path == "/eth/v2/beacon/blocks/0xaa" {
+				fmt.Fprint(w, `{"data":{"message":{"slot":"0","proposer_index":"1"}}}`)
+				return
+			}
+			http.Error(w, `{"code":404,"message":"not found"}`, http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	recorder := fixtures.NewRecorder(upstreamNode(t).URL)
+	if err := recorder.RecordEvents([]types.EventTopic{types.EventHead, types.EventBlock}, 500*time.Millisecond); err != nil {
+		t.Fatalf("error recording events: %v", err)
+	}
+	if err := recorder.RecordSlotRange(0, 1); err != nil {
+		t.Fatalf("error recording slot range: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "fixture.json.gz")
+	if err := recorder.Fixture().Save(path); err != nil {
+		t.Fatalf("error saving fixture: %v", err)
+	}
+	server, fixture := fixtures.NewTestServer(t, path)
+	if fixture.StartSlot != 0 || fixture.EndSlot != 1 {
+		t.Errorf("expected the slot range 0-1, got %d-%d", fixture.StartSlot, fixture.EndSlot)
+	}
+	cl := consapi.NewClient(server.URL)
+
+	block, err := cl.GetSlot(0)
+	if err != nil {
+		t.Fatalf("error getting replayed block: %v", err)
+	}
+	if block.Data.Message.ProposerIndex != 1 {
+		t.Errorf("expected the recorded proposer 1, got %d", block.Data.Message.ProposerIndex)
+	}
+
+	// the missed slot is replayed as not found, a request that was never recorded is not implemented
+	_, err = cl.GetSlot(1)
+	if httpErr := network.SpecificError(err); httpErr == nil || httpErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a not found error for the missed slot, got %v", err)
+	}
+	_, err = cl.GetSlot(2)
+	if httpErr := network.SpecificError(err); httpErr == nil || httpErr.StatusCode != http.StatusNotImplemented {
+		t.Errorf("expected a not implemented error for an unrecorded slot, got %v", err)
+	}
+
+	validators, err := cl.GetValidators(1, nil, []types.ValidatorStatus{types.Active})
+	if err != nil {
+		t.Fatalf("error getting replayed validators: %v", err)
+	}
+	if len(validators.Data) != 1 || validators.Data[0].Index != 0 {
+		t.Errorf("expected only the active validator 0, got %+v", validators.Data)
+	}
+	validators, err = cl.GetValidators(1, []string{"1"}, nil)
+	if err != nil {
+		t.Fatalf("error getting replayed validators: %v", err)
+	}
+	if len(validators.Data) != 1 || validators.Data[0].Index != 1 {
+		t.Errorf("expected only validator 1, got %+v", validators.Data)
+	}
+
+	events := cl.GetEvents([]types.EventTopic{types.EventBlock})
+	select {
+	case event := <-events:
+		if event.Error != nil || event.Event != types.EventBlock || string(event.Data) != `{"slot":"0"}` {
+			t.Errorf("expected the recorded block event, got %+v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the replayed event")
+	}
+}
@@ -0,0 +1,265 @@
+package fixtures
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/consapi"
+	"github.com/gobitfly/beaconchain/pkg/consapi/network"
+	"github.com/gobitfly/beaconchain/pkg/consapi/types"
+	"github.com/gobitfly/beaconchain/pkg/consapi/utils"
+)
+
+// Recorder is a proxy to a beacon node that records every response passing through it, including the events of the
+// /eth/v1/events stream
+type Recorder struct {
+	upstream   string
+	httpClient *http.Client
+
+	mu      sync.Mutex
+	fixture Fixture
+}
+
+func NewRecorder(upstream string) *Recorder {
+	return &Recorder{
+		upstream:   strings.TrimSuffix(upstream, "/"),
+		httpClient: &http.Client{},
+	}
+}
+
+// Fixture returns a copy of everything recorded so far
+func (r *Recorder) Fixture() *Fixture {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fixture := r.fixture
+	fixture.Responses = append([]Response(nil), r.fixture.Responses...)
+	fixture.Events = append([]Event(nil), r.fixture.Events...)
+	return &fixture
+}
+
+// ServeHTTP proxies the request to the upstream node and records the response. Fixtures only hold json, so
+// requests for ssz are refused and the client falls back to json.
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if strings.Contains(req.Header.Get("Accept"), network.ContentTypeSSZ) {
+		http.Error(w, `{"code":406,"message":"fixtures only hold json responses"}`, http.StatusNotAcceptable)
+		return
+	}
+	requestURL := r.upstream + req.URL.Path
+	if req.URL.RawQuery != "" {
+		requestURL += "?" + req.URL.RawQuery
+	}
+	upstreamReq, err := http.NewRequestWithContext(req.Context(), req.Method, requestURL, req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	for _, header := range []string{"Accept", "Content-Type"} {
+		if value := req.Header.Get(header); value != "" {
+			upstreamReq.Header.Set(header, value)
+		}
+	}
+
+	if req.URL.Path == "/eth/v1/events" {
+		r.streamEvents(w, upstreamReq)
+		return
+	}
+
+	res, err := r.httpClient.Do(upstreamReq)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	r.mu.Lock()
+	r.fixture.Add(req.Method, req.URL, res.StatusCode, body)
+	r.mu.Unlock()
+
+	w.Header().Set("Content-Type", res.Header.Get("Content-Type"))
+	w.WriteHeader(res.StatusCode)
+	_, _ = w.Write(body)
+}
+
+// streamEvents passes the event stream through line by line and records every complete event
+func (r *Recorder) streamEvents(w http.ResponseWriter, upstreamReq *http.Request) {
+	// disable gzip compression for sse
+	upstreamReq.Header.Set("accept-encoding", "identity")
+	res, err := r.httpClient.Do(upstreamReq)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer res.Body.Close()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", res.Header.Get("Content-Type"))
+	w.WriteHeader(res.StatusCode)
+	flusher.Flush()
+
+	var event Event
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return
+		}
+		switch {
+		case line == "":
+			if event.Event != "" {
+				r.mu.Lock()
+				r.fixture.Events = append(r.fixture.Events, event)
+				r.mu.Unlock()
+			}
+			event = Event{}
+			flusher.Flush()
+		case strings.HasPrefix(line, "event:"):
+			event.Event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			event.Data += strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		}
+	}
+}
+
+// RecordEvents subscribes to the given topics through the recorder and records the received events for the given duration
+func (r *Recorder) RecordEvents(topics []types.EventTopic, duration time.Duration) error {
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	requestURL := fmt.Sprintf("%s/eth/v1/events?topics=%s", server.URL, strings.Join(utils.ConvertToStringSlice(topics), ","))
+	req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating events request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	client := &http.Client{Timeout: duration}
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error subscribing to events: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error subscribing to events: status %d", res.StatusCode)
+	}
+	// the client timeout ends the subscription, everything read until then has been recorded by the proxy
+	_, _ = io.Copy(io.Discard, res.Body)
+	return nil
+}
+
+// RecordSlotRange records the responses the consapi client, the slot exporter and the dashboard data exporter request
+// for the given slot range, responses of missed slots and other http errors are recorded as well
+func (r *Recorder) RecordSlotRange(startSlot, endSlot uint64) error {
+	if endSlot < startSlot {
+		return fmt.Errorf("invalid slot range %d-%d", startSlot, endSlot)
+	}
+	server := httptest.NewServer(r)
+	defer server.Close()
+	cl := consapi.NewClient(server.URL)
+
+	r.mu.Lock()
+	r.fixture.StartSlot, r.fixture.EndSlot = startSlot, endSlot
+	r.mu.Unlock()
+
+	spec, err := cl.GetSpec()
+	if err != nil {
+		return fmt.Errorf("error retrieving spec: %w", err)
+	}
+	if spec.Data.SlotsPerEpoch <= 0 {
+		return fmt.Errorf("invalid slots per epoch %d", spec.Data.SlotsPerEpoch)
+	}
+	slotsPerEpoch := uint64(spec.Data.SlotsPerEpoch)
+
+	requests := []func() error{
+		func() error { _, err := cl.GetGenesis(); return err },
+		func() error { _, err := cl.GetSyncing(); return err },
+		func() error { _, err := cl.GetBlockHeader("head"); return err },
+		func() error { _, err := cl.GetBlockHeaders(nil, nil); return err },
+		func() error { _, err := cl.GetFinalityCheckpoints("head"); return err },
+	}
+
+	for slot := startSlot; slot <= endSlot; slot++ {
+		slot := slot
+		requests = append(requests,
+			func() error { _, err := cl.GetBlockHeaders(&slot, nil); return err },
+			func() error { _, err := cl.GetSlot(slot); return err },
+			func() error { _, err := cl.GetPropoalRewards(slot); return err },
+			func() error { _, err := cl.GetSyncRewards(slot); return err },
+			func() error { _, err := cl.GetBlobSidecars(slot); return err },
+			func() error {
+				// the slot exporter requests the block and its blobs by root
+				header, err := cl.GetBlockHeader(slot)
+				if err != nil {
+					return err
+				}
+				if _, err := cl.GetSlot(header.Data.Root.String()); err != nil {
+					return err
+				}
+				_, err = cl.GetBlobSidecars(header.Data.Root.String())
+				return err
+			},
+		)
+	}
+
+	for epoch := startSlot / slotsPerEpoch; epoch <= endSlot/slotsPerEpoch; epoch++ {
+		epoch := epoch
+		firstSlot := epoch * slotsPerEpoch
+		lastSlot := firstSlot + slotsPerEpoch - 1
+		requests = append(requests,
+			func() error { _, err := cl.GetAttestationRewards(epoch); return err },
+			func() error { _, err := cl.GetCommittees(lastSlot, nil, nil, nil); return err },
+			func() error { _, err := cl.GetValidators(firstSlot, nil, nil); return err },
+			func() error { _, err := cl.GetValidators(lastSlot, nil, nil); return err },
+			func() error { _, err := cl.GetValidatorBalances(firstSlot); return err },
+			func() error { _, err := cl.GetSyncCommitteesAssignments(nil, firstSlot); return err },
+			func() error {
+				// the epoch assignments are based on the state the proposer duties depend on
+				duties, err := cl.GetPropoalAssignments(epoch)
+				if err != nil {
+					return err
+				}
+				header, err := cl.GetBlockHeader(duties.DependentRoot.String())
+				if err != nil {
+					return err
+				}
+				stateRoot := header.Data.Header.Message.StateRoot.String()
+				if _, err := cl.GetCommittees(stateRoot, &epoch, nil, nil); err != nil {
+					return err
+				}
+				_, err = cl.GetSyncCommitteesAssignments(&epoch, stateRoot)
+				return err
+			},
+		)
+		if epoch == 0 {
+			requests = append(requests,
+				func() error { _, err := cl.GetValidators("genesis", nil, nil); return err },
+				func() error { _, err := cl.GetValidatorBalances("genesis"); return err },
+			)
+		} else {
+			// the dashboard data exporter uses the state at the end of the previous epoch as start state
+			requests = append(requests, func() error { _, err := cl.GetValidators(firstSlot-1, nil, nil); return err })
+		}
+	}
+
+	for _, request := range requests {
+		// http errors are part of the recording, only failing to reach the node aborts it
+		if err := request(); err != nil && network.SpecificError(err) == nil {
+			return fmt.Errorf("error recording slot range %d-%d: %w", startSlot, endSlot, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gobitfly/beaconchain/pkg/consapi/network"
+	"github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+var validatorsPathRegex = regexp.MustCompile(`^/eth/v1/beacon/states/[^/]+/validators$`)
+
+type replayHandler struct {
+	fixture   *Fixture
+	responses map[string]Response
+}
+
+// ReplayServer serves the recorded responses of a fixture
+type ReplayServer struct {
+	*httptest.Server
+}
+
+// NewReplayServer starts a server that answers every recorded request with the recorded response. Requests that are
+// not part of the fixture are answered with 501 Not Implemented, so they can not be mistaken for a missed slot.
+// Requests for ssz are answered with 406 Not Acceptable, which makes the client fall back to json.
+func NewReplayServer(fixture *Fixture) *ReplayServer {
+	handler := &replayHandler{
+		fixture:   fixture,
+		responses: make(map[string]Response, len(fixture.Responses)),
+	}
+	for _, response := range fixture.Responses {
+		handler.responses[response.Method+" "+response.Path] = response
+	}
+	return &ReplayServer{httptest.NewServer(handler)}
+}
+
+// Close shuts down the server including open event streams. The listener is closed first, otherwise an event
+// subscription would reconnect and block the shutdown.
+func (s *ReplayServer) Close() {
+	s.Listener.Close()
+	s.CloseClientConnections()
+	s.Server.Close()
+}
+
+// NewTestServer loads the fixture at the given path and replays it for the duration of the test
+func NewTestServer(t testing.TB, path string) (*ReplayServer, *Fixture) {
+	t.Helper()
+	fixture, err := Load(path)
+	if err != nil {
+		t.Fatalf("error loading fixture: %v", err)
+	}
+	server := NewReplayServer(fixture)
+	t.Cleanup(server.Close)
+	return server, fixture
+}
+
+func (h *replayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/eth/v1/events" {
+		h.serveEvents(w, r)
+		return
+	}
+	if strings.Contains(r.Header.Get("Accept"), network.ContentTypeSSZ) {
+		writeResponse(w, http.StatusNotAcceptable, []byte(`{"code":406,"message":"fixtures only hold json responses"}`))
+		return
+	}
+
+	if response, ok := h.responses[requestKey(r.Method, r.URL)]; ok {
+		writeResponse(w, response.Status, []byte(response.Body))
+		return
+	}
+
+	// filtered validator requests are answered from the recorded unfiltered state
+	if validatorsPathRegex.MatchString(r.URL.Path) && r.URL.RawQuery != "" {
+		if response, ok := h.responses[requestKey(r.Method, &url.URL{Path: r.URL.Path})]; ok {
+			if response.Status != http.StatusOK {
+				writeResponse(w, response.Status, []byte(response.Body))
+				return
+			}
+			body, err := filterValidators([]byte(response.Body), r.URL.Query())
+			if err != nil {
+				writeResponse(w, http.StatusInternalServerError, []byte(fmt.Sprintf(`{"code":500,"message":%q}`, err.Error())))
+				return
+			}
+			writeResponse(w, http.StatusOK, body)
+			return
+		}
+	}
+
+	writeResponse(w, http.StatusNotImplemented, []byte(fmt.Sprintf(`{"code":501,"message":"request %s %s is not part of the fixture"}`, r.Method, r.URL.RequestURI())))
+}
+
+// serveEvents replays the recorded events of the requested topics and keeps the stream open until the client disconnects
+func (h *replayHandler) serveEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	topics := strings.Split(r.URL.Query().Get("topics"), ",")
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+	for _, event := range h.fixture.Events {
+		if !slices.Contains(topics, event.Event) {
+			continue
+		}
+		fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Event, event.Data)
+	}
+	flusher.Flush()
+	<-r.Context().Done()
+}
+
+func writeResponse(w http.ResponseWriter, status int, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+// filterValidators applies the id and status filters of the validators endpoint
+func filterValidators(body []byte, query url.Values) ([]byte, error) {
+	var response struct {
+		ExecutionOptimistic bool              `json:"execution_optimistic"`
+		Finalized           bool              `json:"finalized"`
+		Data                []json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("error decoding recorded validators: %w", err)
+	}
+
+	var ids, statuses []string
+	if id := query.Get("id"); id != "" {
+		ids = strings.Split(id, ",")
+	}
+	if status := query.Get("status"); status != "" {
+		statuses = strings.Split(status, ",")
+	}
+
+	filtered := make([]json.RawMessage, 0, len(response.Data))
+	for _, raw := range response.Data {
+		var validator types.StandardValidator
+		if err := json.Unmarshal(raw, &validator); err != nil {
+			return nil, fmt.Errorf("error decoding recorded validator: %w", err)
+		}
+		if len(ids) > 0 && !slices.Contains(ids, fmt.Sprintf("%d", validator.Index)) && !slices.Contains(ids, validator.Validator.Pubkey.String()) {
+			continue
+		}
+		// a status filter matches the status itself as well as the statuses it groups, e.g. active matches active_ongoing
+		if len(statuses) > 0 && !slices.ContainsFunc(statuses, func(status string) bool {
+			return string(validator.Status) == status || strings.HasPrefix(string(validator.Status), status+"_")
+		}) {
+			continue
+		}
+		filtered = append(filtered, raw)
+	}
+	response.Data = filtered
+	return json.Marshal(response)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gobitfly/beaconchain/pkg/consapi/utils"
@@ -31,7 +32,47 @@ func Post[T any](r *http.Client, url string) (*T, error) {
 	return utils.Unmarshal[T](result, err)
 }
 
+// Helper for get and streaming unmarshal of the elements of the data array, see utils.StreamData
+func Stream[T any](r *http.Client, url string, meta any, fn func(*T) error) error {
+	result, err := HTTPReq("GET", url, r)
+	if err != nil {
+		return err
+	}
+	return utils.StreamData(result, meta, fn)
+}
+
+// GetSSZ requests the ssz encoding of a resource. ErrSSZNotSupported is returned if the node can not serve it,
+// the caller is responsible for closing the body of the response.
+func GetSSZ(r *http.Client, url string) (*SSZResponse, error) {
+	res, err := httpReq("GET", url, ContentTypeSSZ, r)
+	if err != nil {
+		if httpErr := SpecificError(err); httpErr != nil {
+			switch httpErr.StatusCode {
+			case http.StatusNotAcceptable, http.StatusUnsupportedMediaType, http.StatusNotImplemented:
+				return nil, fmt.Errorf("%w: %w", ErrSSZNotSupported, err)
+			}
+		}
+		return nil, err
+	}
+	if contentType := res.Header.Get("Content-Type"); !strings.HasPrefix(contentType, ContentTypeSSZ) {
+		res.Body.Close()
+		return nil, fmt.Errorf("%w: url: %s, content type: %s", ErrSSZNotSupported, url, contentType)
+	}
+	return &SSZResponse{
+		Version: res.Header.Get("Eth-Consensus-Version"),
+		Body:    res.Body,
+	}, nil
+}
+
 func HTTPReq(method string, requestURL string, httpClient *http.Client) (io.ReadCloser, error) {
+	res, err := httpReq(method, requestURL, "", httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+func httpReq(method string, requestURL string, accept string, httpClient *http.Client) (*http.Response, error) {
 	data := []byte{}
 	if method == "POST" {
 		data = []byte("[]")
@@ -45,7 +86,10 @@ func HTTPReq(method string, requestURL string, httpClient *http.Client) (io.Read
 		httpClient = &http.Client{Timeout: 20 * time.Second}
 	}
 
-	r.Header.Add("Content-Type", "application/json")
+	r.Header.Add("Content-Type", ContentTypeJSON)
+	if accept != "" {
+		r.Header.Add("Accept", accept)
+	}
 
 	res, err := httpClient.Do(r)
 	if err != nil {
@@ -53,6 +97,7 @@ func HTTPReq(method string, requestURL string, httpClient *http.Client) (io.Read
 	}
 
 	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
 		body, _ := io.ReadAll(res.Body)
 		return nil, &HttpReqHttpError{
 			StatusCode: res.StatusCode,
@@ -61,7 +106,21 @@ func HTTPReq(method string, requestURL string, httpClient *http.Client) (io.Read
 		}
 	}
 
-	return res.Body, nil
+	return res, nil
+}
+
+const (
+	ContentTypeJSON = "application/json"
+	ContentTypeSSZ  = "application/octet-stream"
+)
+
+// ErrSSZNotSupported is returned by GetSSZ if the node does not serve the requested resource as ssz
+var ErrSSZNotSupported = errors.New("ssz encoding not supported")
+
+type SSZResponse struct {
+	// consensus version of the encoded data, e.g. deneb
+	Version string
+	Body    io.ReadCloser
 }
 
 type RPCErrorMessage struct {
@@ -0,0 +1,55 @@
+package ssz
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+// ErrUnsupportedVersion is returned for blocks of a consensus version without a typed ssz decoder
+var ErrUnsupportedVersion = errors.New("unsupported consensus version")
+
+type sszBlock interface {
+	UnmarshalSSZ(buf []byte) error
+}
+
+// SignedBlock decodes a ssz encoded signed beacon block of the given consensus version. The typed blocks of
+// go-eth2-client only cover the mainnet preset up to deneb, later versions return ErrUnsupportedVersion.
+func SignedBlock(version string, data []byte) (*types.AnySignedBlock, error) {
+	var block sszBlock
+	switch version {
+	case "phase0":
+		block = &phase0.SignedBeaconBlock{}
+	case "altair":
+		block = &altair.SignedBeaconBlock{}
+	case "bellatrix":
+		block = &bellatrix.SignedBeaconBlock{}
+	case "capella":
+		block = &capella.SignedBeaconBlock{}
+	case "deneb":
+		block = &deneb.SignedBeaconBlock{}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedVersion, version)
+	}
+	if err := block.UnmarshalSSZ(data); err != nil {
+		return nil, fmt.Errorf("error decoding %s block: %w", version, err)
+	}
+
+	// the json encoding of go-eth2-client follows the beacon api, blocks are small enough to convert them through it
+	encoded, err := json.Marshal(block)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding %s block: %w", version, err)
+	}
+	result := &types.AnySignedBlock{}
+	if err := json.Unmarshal(encoded, result); err != nil {
+		return nil, fmt.Errorf("error converting %s block: %w", version, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,189 @@
+package ssz_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gobitfly/beaconchain/pkg/consapi/ssz"
+	"github.com/gobitfly/beaconchain/pkg/consapi/types"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+const farFuture = phase0.Epoch(math.MaxUint64)
+
+// testState encodes a mainnet preset capella state at slot 100 (epoch 3) with a validator in every status
+func testState(t *testing.T) ([]byte, []types.ValidatorStatus) {
+	validators := []struct {
+		validator phase0.Validator
+		balance   phase0.Gwei
+		status    types.ValidatorStatus
+	}{
+		{phase0.Validator{ActivationEpoch: 0, ExitEpoch: farFuture, WithdrawableEpoch: farFuture}, 32e9, types.ActiveOngoing},
+		{phase0.Validator{ActivationEligibilityEpoch: farFuture, ActivationEpoch: farFuture, ExitEpoch: farFuture, WithdrawableEpoch: farFuture}, 1e9, types.PendingInitialized},
+		{phase0.Validator{ActivationEligibilityEpoch: 2, ActivationEpoch: farFuture, ExitEpoch: farFuture, WithdrawableEpoch: farFuture}, 32e9, types.PendingQueued},
+		{phase0.Validator{ExitEpoch: 10, WithdrawableEpoch: 20}, 32e9, types.ActiveExiting},
+		{phase0.Validator{Slashed: true, ExitEpoch: 10, WithdrawableEpoch: 20}, 31e9, types.ActiveSlashed},
+		{phase0.Validator{ExitEpoch: 2, WithdrawableEpoch: 10}, 32e9, types.ExitedUnslashed},
+		{phase0.Validator{Slashed: true, ExitEpoch: 2, WithdrawableEpoch: 10}, 31e9, types.ExitedSlashed},
+		{phase0.Validator{ExitEpoch: 1, WithdrawableEpoch: 2}, 32e9, types.WithdrawalPossible},
+		{phase0.Validator{ExitEpoch: 1, WithdrawableEpoch: 2}, 0, types.WithdrawalDone},
+	}
+
+	state := &capella.BeaconState{
+		Slot:                        100,
+		Fork:                        &phase0.Fork{},
+		LatestBlockHeader:           &phase0.BeaconBlockHeader{},
+		BlockRoots:                  make([]phase0.Root, 8192),
+		StateRoots:                  make([]phase0.Root, 8192),
+		HistoricalRoots:             []phase0.Root{{1}, {2}},
+		ETH1Data:                    &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+		ETH1DataVotes:               []*phase0.ETH1Data{{BlockHash: make([]byte, 32)}},
+		RANDAOMixes:                 make([]phase0.Root, 65536),
+		Slashings:                   make([]phase0.Gwei, 8192),
+		JustificationBits:           bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint: &phase0.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &phase0.Checkpoint{},
+		FinalizedCheckpoint:         &phase0.Checkpoint{},
+		CurrentSyncCommittee:        &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 512)},
+		NextSyncCommittee:           &altair.SyncCommittee{Pubkeys: make([]phase0.BLSPubKey, 512)},
+		LatestExecutionPayloadHeader: &capella.ExecutionPayloadHeader{
+			ExtraData: []byte{},
+		},
+	}
+	statuses := make([]types.ValidatorStatus, 0, len(validators))
+	for i, v := range validators {
+		validator := v.validator
+		validator.PublicKey = phase0.BLSPubKey{byte(i), 0xaa}
+		validator.WithdrawalCredentials = bytes.Repeat([]byte{byte(i)}, 32)
+		validator.EffectiveBalance = 32e9
+		state.Validators = append(state.Validators, &validator)
+		state.Balances = append(state.Balances, v.balance)
+		state.PreviousEpochParticipation = append(state.PreviousEpochParticipation, 0)
+		state.CurrentEpochParticipation = append(state.CurrentEpochParticipation, 0)
+		state.InactivityScores = append(state.InactivityScores, 0)
+		statuses = append(statuses, v.status)
+	}
+
+	data, err := state.MarshalSSZ()
+	if err != nil {
+		t.Fatalf("error encoding state: %v", err)
+	}
+	return data, statuses
+}
+
+func TestValidators(t *testing.T) {
+	data, statuses := testState(t)
+
+	var validators []*types.StandardValidator
+	slot, err := ssz.Validators(bytes.NewReader(data), 8192, 32, func(validator *types.StandardValidator) error {
+		validators = append(validators, validator)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("error decoding validators: %v", err)
+	}
+	if slot != 100 {
+		t.Errorf("expected slot 100, got %d", slot)
+	}
+	if len(validators) != len(statuses) {
+		t.Fatalf("expected %d validators, got %d", len(statuses), len(validators))
+	}
+	for i, validator := range validators {
+		if validator.Index != uint64(i) || validator.Status != statuses[i] {
+			t.Errorf("validator %d: expected index %d with status %s, got %d with %s", i, i, statuses[i], validator.Index, validator.Status)
+		}
+		if validator.Validator.Pubkey[0] != byte(i) || validator.Validator.Pubkey[1] != 0xaa || validator.Validator.WithdrawalCredentials[31] != byte(i) {
+			t.Errorf("validator %d: unexpected keys %s and %s", i, validator.Validator.Pubkey, validator.Validator.WithdrawalCredentials)
+		}
+		if validator.Validator.EffectiveBalance != 32e9 {
+			t.Errorf("validator %d: unexpected effective balance %d", i, validator.Validator.EffectiveBalance)
+		}
+	}
+	if validators[4].Balance != 31e9 || !validators[4].Validator.Slashed || validators[4].Validator.ExitEpoch != 10 || validators[4].Validator.WithdrawableEpoch != 20 {
+		t.Errorf("unexpected slashed validator %+v", validators[4])
+	}
+}
+
+func TestBalances(t *testing.T) {
+	data, _ := testState(t)
+
+	slot, balances, err := ssz.Balances(bytes.NewReader(data), 8192)
+	if err != nil {
+		t.Fatalf("error decoding balances: %v", err)
+	}
+	if slot != 100 || len(balances) != 9 {
+		t.Fatalf("expected 9 balances at slot 100, got %d at slot %d", len(balances), slot)
+	}
+	if balances[0] != 32e9 || balances[1] != 1e9 || balances[8] != 0 {
+		t.Errorf("unexpected balances %v", balances)
+	}
+
+	// a truncated state must not be decoded
+	if _, _, err := ssz.Balances(bytes.NewReader(data[:len(data)/2]), 8192); err == nil {
+		t.Errorf("expected an error for a truncated state")
+	}
+}
+
+func TestSignedBlock(t *testing.T) {
+	block := &capella.SignedBeaconBlock{
+		Message: &capella.BeaconBlock{
+			Slot:          1234,
+			ProposerIndex: 56,
+			Body: &capella.BeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				Attestations: []*phase0.Attestation{{
+					AggregationBits: bitfield.NewBitlist(8),
+					Data: &phase0.AttestationData{
+						Slot:   1233,
+						Index:  3,
+						Source: &phase0.Checkpoint{},
+						Target: &phase0.Checkpoint{Epoch: 38},
+					},
+				}},
+				SyncAggregate: &altair.SyncAggregate{SyncCommitteeBits: bitfield.NewBitvector512()},
+				ExecutionPayload: &capella.ExecutionPayload{
+					BlockNumber:   789,
+					ExtraData:     []byte("graffiti"),
+					BaseFeePerGas: [32]byte{7},
+					Transactions:  []bellatrix.Transaction{{0x02, 0x01}},
+					Withdrawals:   []*capella.Withdrawal{{Index: 1, ValidatorIndex: 56, Amount: 5000}},
+				},
+			},
+		},
+	}
+	data, err := block.MarshalSSZ()
+	if err != nil {
+		t.Fatalf("error encoding block: %v", err)
+	}
+
+	decoded, err := ssz.SignedBlock("capella", data)
+	if err != nil {
+		t.Fatalf("error decoding block: %v", err)
+	}
+	message := decoded.Message
+	if message.Slot != 1234 || message.ProposerIndex != 56 {
+		t.Errorf("unexpected block %d of proposer %d", message.Slot, message.ProposerIndex)
+	}
+	if len(message.Body.Attestations) != 1 || message.Body.Attestations[0].Data.Slot != 1233 || message.Body.Attestations[0].Data.Target.Epoch != 38 {
+		t.Errorf("unexpected attestations %+v", message.Body.Attestations)
+	}
+	payload := message.Body.ExecutionPayload
+	if payload == nil || payload.BlockNumber != 789 || payload.BaseFeePerGas != 7 || len(payload.Transactions) != 1 {
+		t.Fatalf("unexpected execution payload %+v", payload)
+	}
+	if len(payload.Withdrawals) != 1 || payload.Withdrawals[0].ValidatorIndex != 56 || payload.Withdrawals[0].Amount != 5000 {
+		t.Errorf("unexpected withdrawals %+v", payload.Withdrawals)
+	}
+
+	if _, err := ssz.SignedBlock("electra", data); err == nil {
+		t.Errorf("expected an error for an unsupported version")
+	}
+	if _, err := ssz.SignedBlock("deneb", data); err == nil {
+		t.Errorf("expected an error for a block of the wrong version")
+	}
+}
@@ -0,0 +1,178 @@
+// Package ssz decodes the ssz encoded responses of the beacon api into the json types of the consapi package.
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+const (
+	validatorSize = 121
+	balanceSize   = 8
+
+	farFutureEpoch = math.MaxUint64
+)
+
+// stateHeader holds the part of a BeaconState up to the offsets of the validator registry and the balances.
+// Its layout is the same in every fork, only the size of the block and state roots depends on the preset:
+//
+//	genesis_time, genesis_validators_root, slot, fork, latest_block_header, block_roots, state_roots,
+//	historical_roots (offset), eth1_data, eth1_data_votes (offset), eth1_deposit_index, validators (offset),
+//	balances (offset), ...
+type stateHeader struct {
+	slot             uint64
+	validatorsOffset uint64
+	balancesOffset   uint64
+	read             uint64
+}
+
+func readStateHeader(source io.Reader, slotsPerHistoricalRoot uint64) (*stateHeader, error) {
+	rootsSize := 2 * 32 * slotsPerHistoricalRoot
+	validatorsOffsetPosition := 8 + 32 + 8 + 16 + 112 + rootsSize + 4 + 72 + 4 + 8
+	buf := make([]byte, validatorsOffsetPosition+8)
+	if _, err := io.ReadFull(source, buf); err != nil {
+		return nil, fmt.Errorf("error reading state header: %w", err)
+	}
+
+	header := &stateHeader{
+		slot:             binary.LittleEndian.Uint64(buf[40:48]),
+		validatorsOffset: uint64(binary.LittleEndian.Uint32(buf[validatorsOffsetPosition:])),
+		balancesOffset:   uint64(binary.LittleEndian.Uint32(buf[validatorsOffsetPosition+4:])),
+		read:             uint64(len(buf)),
+	}
+	if header.validatorsOffset < header.read || header.balancesOffset < header.validatorsOffset {
+		return nil, fmt.Errorf("invalid state offsets %d and %d", header.validatorsOffset, header.balancesOffset)
+	}
+	if (header.balancesOffset-header.validatorsOffset)%validatorSize != 0 {
+		return nil, fmt.Errorf("invalid validator registry size %d", header.balancesOffset-header.validatorsOffset)
+	}
+	return header, nil
+}
+
+func (h *stateHeader) validatorCount() uint64 {
+	return (h.balancesOffset - h.validatorsOffset) / validatorSize
+}
+
+// skipTo discards the encoded state up to the given offset
+func (h *stateHeader) skipTo(source io.Reader, offset uint64) error {
+	if offset < h.read {
+		return fmt.Errorf("can not skip back to offset %d from %d", offset, h.read)
+	}
+	if _, err := io.CopyN(io.Discard, source, int64(offset-h.read)); err != nil {
+		return fmt.Errorf("error skipping state to offset %d: %w", offset, err)
+	}
+	h.read = offset
+	return nil
+}
+
+func (h *stateHeader) readBalances(source io.Reader) ([]uint64, error) {
+	if err := h.skipTo(source, h.balancesOffset); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, h.validatorCount()*balanceSize)
+	if _, err := io.ReadFull(source, buf); err != nil {
+		return nil, fmt.Errorf("error reading balances: %w", err)
+	}
+	h.read += uint64(len(buf))
+
+	balances := make([]uint64, h.validatorCount())
+	for i := range balances {
+		balances[i] = binary.LittleEndian.Uint64(buf[i*balanceSize:])
+	}
+	return balances, nil
+}
+
+// Balances decodes the slot and the balances of a ssz encoded BeaconState, the validator registry is skipped
+func Balances(source io.Reader, slotsPerHistoricalRoot uint64) (uint64, []uint64, error) {
+	header, err := readStateHeader(source, slotsPerHistoricalRoot)
+	if err != nil {
+		return 0, nil, err
+	}
+	balances, err := header.readBalances(source)
+	if err != nil {
+		return 0, nil, err
+	}
+	return header.slot, balances, nil
+}
+
+// Validators decodes the validator registry and the balances of a ssz encoded BeaconState and calls fn for every
+// validator in index order. The registry is kept in its compact encoding until the balances are read, the
+// validators are decoded one by one afterwards. The status is derived like the beacon api does for the epoch of
+// the state.
+func Validators(source io.Reader, slotsPerHistoricalRoot, slotsPerEpoch uint64, fn func(*types.StandardValidator) error) (uint64, error) {
+	header, err := readStateHeader(source, slotsPerHistoricalRoot)
+	if err != nil {
+		return 0, err
+	}
+	if err := header.skipTo(source, header.validatorsOffset); err != nil {
+		return 0, err
+	}
+	registry := make([]byte, header.balancesOffset-header.validatorsOffset)
+	if _, err := io.ReadFull(source, registry); err != nil {
+		return 0, fmt.Errorf("error reading validator registry: %w", err)
+	}
+	header.read += uint64(len(registry))
+
+	balances, err := header.readBalances(source)
+	if err != nil {
+		return 0, err
+	}
+
+	epoch := header.slot / slotsPerEpoch
+	for i := range balances {
+		validator := decodeValidator(registry[i*validatorSize : (i+1)*validatorSize])
+		validator.Index = uint64(i)
+		validator.Balance = balances[i]
+		validator.Status = validatorStatus(validator, epoch)
+		if err := fn(validator); err != nil {
+			return 0, err
+		}
+	}
+	return header.slot, nil
+}
+
+func decodeValidator(buf []byte) *types.StandardValidator {
+	validator := &types.StandardValidator{}
+	validator.Validator.Pubkey = append([]byte(nil), buf[0:48]...)
+	validator.Validator.WithdrawalCredentials = append([]byte(nil), buf[48:80]...)
+	validator.Validator.EffectiveBalance = binary.LittleEndian.Uint64(buf[80:88])
+	validator.Validator.Slashed = buf[88] != 0
+	validator.Validator.ActivationEligibilityEpoch = binary.LittleEndian.Uint64(buf[89:97])
+	validator.Validator.ActivationEpoch = binary.LittleEndian.Uint64(buf[97:105])
+	validator.Validator.ExitEpoch = binary.LittleEndian.Uint64(buf[105:113])
+	validator.Validator.WithdrawableEpoch = binary.LittleEndian.Uint64(buf[113:121])
+	return validator
+}
+
+// validatorStatus follows the validator status definition of the beacon api
+func validatorStatus(validator *types.StandardValidator, epoch uint64) types.ValidatorStatus {
+	v := validator.Validator
+	switch {
+	case v.ActivationEpoch > epoch:
+		if v.ActivationEligibilityEpoch == farFutureEpoch {
+			return types.PendingInitialized
+		}
+		return types.PendingQueued
+	case epoch < v.ExitEpoch:
+		if v.ExitEpoch == farFutureEpoch {
+			return types.ActiveOngoing
+		}
+		if v.Slashed {
+			return types.ActiveSlashed
+		}
+		return types.ActiveExiting
+	case epoch < v.WithdrawableEpoch:
+		if v.Slashed {
+			return types.ExitedSlashed
+		}
+		return types.ExitedUnslashed
+	case validator.Balance > 0:
+		return types.WithdrawalPossible
+	default:
+		return types.WithdrawalDone
+	}
+}
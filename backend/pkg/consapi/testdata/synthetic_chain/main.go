@@ -0,0 +1,412 @@
+// Command synthetic_chain generates the synthetic_chain.json.gz fixture. It simulates a beacon node serving a small
+// deneb chain and records it through the fixtures recorder, the same way the record-beacon-fixtures misc command records
+// a real node. Public networks can't provide a chain that small, which keeps the fixture small and the expectations of
+// the tests easy to follow:
+//
+//   - 24 slots with 8 slots per epoch and 4 validators, slot 10 is missed
+//   - validator v attests in slot v of every epoch and proposes every slot s with s % 4 == v
+//   - validator 3 misses the head vote in epoch 1 and the sync committee duty in slot 12
+//   - validator 2 gets a withdrawal in slot 12
+//
+// Roots are derived from the slot, e.g. the block root of slot s is 0xb0 followed by s, so they can be told apart in
+// failing tests. Run it from pkg/consapi with go generate.
+package main
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/consapi/fixtures"
+	"github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+const (
+	slotsPerEpoch = 8
+	validators    = 4
+	headSlot      = 23
+	missedSlot    = 10
+	genesisTime   = 1700000000
+	farFuture     = "18446744073709551615"
+)
+
+type m = map[string]any
+
+// hexBytes returns n bytes starting with prefix and ending with id
+func hexBytes(prefix byte, n int, id uint64) string {
+	b := make([]byte, n)
+	b[0] = prefix
+	if n >= 9 {
+		binary.BigEndian.PutUint64(b[n-8:], id)
+	}
+	return fmt.Sprintf("0x%x", b)
+}
+func blockRoot(s uint64) string { return hexBytes(0xb0, 32, s) }
+func stateRoot(s uint64) string { return hexBytes(0x50, 32, s) }
+func u(v uint64) string         { return strconv.FormatUint(v, 10) }
+func i(v int64) string          { return strconv.FormatInt(v, 10) }
+func missed(s uint64) bool      { return s == missedSlot }
+func proposer(s uint64) uint64  { return s % validators }
+func committee(s uint64) []string {
+	if s%slotsPerEpoch < validators {
+		return []string{u(s % slotsPerEpoch)}
+	}
+	return []string{}
+}
+func pubkey(v uint64) string { return hexBytes(0xa0, 48, v+1) }
+
+func header(s uint64) m {
+	parent := uint64(0)
+	if s > 0 {
+		parent = s - 1
+		if missed(parent) {
+			parent--
+		}
+	}
+	return m{"root": blockRoot(s), "canonical": true, "header": m{"message": m{
+		"slot": u(s), "proposer_index": u(proposer(s)), "parent_root": blockRoot(parent), "state_root": stateRoot(s), "body_root": hexBytes(0xbd, 32, s),
+	}, "signature": hexBytes(0x99, 96, s)}}
+}
+
+// attestations of the slots since the previous block
+func attestedSlots(s uint64) []uint64 {
+	res := []uint64{}
+	if s == 0 {
+		return res
+	}
+	for a := s - 1; ; a-- {
+		if len(committee(a)) > 0 {
+			res = append(res, a)
+		}
+		if !missed(a) || a == 0 {
+			break
+		}
+	}
+	// the slot before a missed slot is attested in the block after the missed slot
+	if s > 1 && missed(s-1) {
+		if len(committee(s-2)) > 0 {
+			res = append(res, s-2)
+		}
+	}
+	return dedupe(res)
+}
+
+func dedupe(in []uint64) []uint64 {
+	seen := map[uint64]bool{}
+	out := []uint64{}
+	for _, v := range in {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func syncBits(s uint64) string {
+	if s == 12 {
+		return "0x77"
+	}
+	return "0xff"
+}
+
+func block(s uint64) m {
+	atts := []m{}
+	for _, a := range attestedSlots(s) {
+		e := a / slotsPerEpoch
+		atts = append(atts, m{
+			"aggregation_bits": "0x03",
+			"signature":        hexBytes(0x98, 96, a),
+			"data": m{
+				"slot": u(a), "index": "0", "beacon_block_root": blockRoot(a),
+				"source": m{"epoch": u(max(e, 1) - 1), "root": blockRoot((max(e, 1) - 1) * slotsPerEpoch)},
+				"target": m{"epoch": u(e), "root": blockRoot(e * slotsPerEpoch)},
+			},
+		})
+	}
+	withdrawals := []m{}
+	if s == 12 {
+		withdrawals = append(withdrawals, m{"index": "0", "validator_index": "2", "address": hexBytes(0xee, 20, 2), "amount": "5000"})
+	}
+	h := header(s)["header"].(m)["message"].(m)
+	return m{"version": "deneb", "execution_optimistic": false, "finalized": s < 2*slotsPerEpoch, "data": m{
+		"message": m{
+			"slot": u(s), "proposer_index": h["proposer_index"], "parent_root": h["parent_root"], "state_root": stateRoot(s),
+			"body": m{
+				"randao_reveal":      hexBytes(0x97, 96, s),
+				"eth1_data":          m{"deposit_root": hexBytes(0xde, 32, 0), "deposit_count": u(validators), "block_hash": hexBytes(0xe1, 32, 0)},
+				"graffiti":           fmt.Sprintf("0x%x", append([]byte("synthetic"), make([]byte, 23)...)),
+				"proposer_slashings": []m{}, "attester_slashings": []m{}, "attestations": atts, "deposits": []m{}, "voluntary_exits": []m{},
+				"sync_aggregate": m{"sync_committee_bits": syncBits(s), "sync_committee_signature": hexBytes(0x96, 96, s)},
+				"execution_payload": m{
+					"parent_hash": hexBytes(0xe0, 32, 100+s-1), "fee_recipient": hexBytes(0xfe, 20, proposer(s)), "state_root": hexBytes(0xe5, 32, s),
+					"receipts_root": hexBytes(0xe7, 32, s), "logs_bloom": fmt.Sprintf("0x%x", make([]byte, 256)), "prev_randao": hexBytes(0xe8, 32, s),
+					"block_number": u(100 + s), "gas_limit": "30000000", "gas_used": "0", "timestamp": u(genesisTime + 12*s), "extra_data": "0x",
+					"base_fee_per_gas": "7", "block_hash": hexBytes(0xe0, 32, 100+s), "transactions": []string{}, "withdrawals": withdrawals,
+					"blob_gas_used": "0", "excess_blob_gas": "0",
+				},
+				"bls_to_execution_changes": []m{}, "blob_kzg_commitments": []string{},
+			},
+		},
+		"signature": hexBytes(0x95, 96, s),
+	}}
+}
+
+func validatorsAt(s uint64, genesis bool) m {
+	data := []m{}
+	for v := uint64(0); v < validators; v++ {
+		balance := uint64(32000000000)
+		if !genesis {
+			balance += s*100 + v
+		}
+		data = append(data, m{"index": u(v), "balance": u(balance), "status": "active_ongoing", "validator": m{
+			"pubkey": pubkey(v), "withdrawal_credentials": hexBytes(0x01, 32, 0xee00+v), "effective_balance": "32000000000", "slashed": false,
+			"activation_eligibility_epoch": "0", "activation_epoch": "0", "exit_epoch": farFuture, "withdrawable_epoch": farFuture,
+		}})
+	}
+	return m{"execution_optimistic": false, "finalized": s < 2*slotsPerEpoch, "data": data}
+}
+
+func committees(e uint64) m {
+	data := []m{}
+	for s := e * slotsPerEpoch; s < (e+1)*slotsPerEpoch; s++ {
+		data = append(data, m{"index": "0", "slot": u(s), "validators": committee(s)})
+	}
+	return m{"execution_optimistic": false, "finalized": false, "data": data}
+}
+
+func syncCommittee() m {
+	vals := []string{"0", "1", "2", "3", "0", "1", "2", "3"}
+	return m{"execution_optimistic": false, "finalized": false, "data": m{"validators": vals, "validator_aggregates": [][]string{vals}}}
+}
+
+var stateRe = regexp.MustCompile(`^/eth/v1/beacon/states/([^/]+)/(.+)$`)
+
+// resolveState returns the slot of a state id
+func resolveState(id string) (uint64, bool, bool) {
+	switch id {
+	case "genesis":
+		return 0, true, true
+	case "head":
+		return headSlot, false, true
+	}
+	if strings.HasPrefix(id, "0x") {
+		for s := uint64(0); s <= headSlot; s++ {
+			if stateRoot(s) == id {
+				return s, false, true
+			}
+		}
+		return 0, false, false
+	}
+	s, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || s > headSlot {
+		return 0, false, false
+	}
+	return s, false, true
+}
+
+func resolveBlock(id string) (uint64, bool) {
+	switch id {
+	case "head":
+		return headSlot, true
+	case "genesis":
+		return 0, true
+	}
+	if strings.HasPrefix(id, "0x") {
+		for s := uint64(0); s <= headSlot; s++ {
+			if blockRoot(s) == id && !missed(s) {
+				return s, true
+			}
+		}
+		return 0, false
+	}
+	s, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || s > headSlot || missed(s) {
+		return 0, false
+	}
+	return s, true
+}
+
+func write(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func notFound(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	fmt.Fprint(w, `{"code":404,"message":"NOT_FOUND: beacon block"}`)
+}
+
+func serve(w http.ResponseWriter, r *http.Request) {
+	p := r.URL.Path
+	q := r.URL.Query()
+	switch {
+	case p == "/eth/v1/config/spec":
+		write(w, m{"data": m{"CONFIG_NAME": "synthetic", "PRESET_BASE": "minimal", "SLOTS_PER_EPOCH": u(slotsPerEpoch), "SECONDS_PER_SLOT": "12",
+			"ALTAIR_FORK_EPOCH": "0", "BELLATRIX_FORK_EPOCH": "0", "CAPELLA_FORK_EPOCH": "0", "DENEB_FORK_EPOCH": "0",
+			"SYNC_COMMITTEE_SIZE": "8", "EPOCHS_PER_SYNC_COMMITTEE_PERIOD": "8", "DEPOSIT_CHAIN_ID": "1337", "GENESIS_FORK_VERSION": "0x10000038"}})
+	case p == "/eth/v1/beacon/genesis":
+		write(w, m{"data": m{"genesis_time": u(genesisTime), "genesis_validators_root": hexBytes(0x9e, 32, 0), "genesis_fork_version": "0x10000038"}})
+	case p == "/eth/v1/node/syncing":
+		write(w, m{"data": m{"head_slot": u(headSlot), "sync_distance": "0", "is_syncing": false, "is_optimistic": false, "el_offline": false}})
+	case p == "/eth/v1/beacon/headers":
+		s := uint64(headSlot)
+		if v := q.Get("slot"); v != "" {
+			s, _ = strconv.ParseUint(v, 10, 64)
+		}
+		data := []m{}
+		if !missed(s) {
+			data = append(data, header(s))
+		}
+		write(w, m{"execution_optimistic": false, "finalized": s < 2*slotsPerEpoch, "data": data})
+	case strings.HasPrefix(p, "/eth/v1/beacon/headers/"):
+		s, ok := resolveBlock(strings.TrimPrefix(p, "/eth/v1/beacon/headers/"))
+		if !ok {
+			notFound(w)
+			return
+		}
+		write(w, m{"execution_optimistic": false, "finalized": s < 2*slotsPerEpoch, "data": header(s)})
+	case strings.HasPrefix(p, "/eth/v2/beacon/blocks/"):
+		s, ok := resolveBlock(strings.TrimPrefix(p, "/eth/v2/beacon/blocks/"))
+		if !ok {
+			notFound(w)
+			return
+		}
+		write(w, block(s))
+	case strings.HasPrefix(p, "/eth/v1/beacon/blob_sidecars/"):
+		if _, ok := resolveBlock(strings.TrimPrefix(p, "/eth/v1/beacon/blob_sidecars/")); !ok {
+			notFound(w)
+			return
+		}
+		write(w, m{"data": []m{}})
+	case strings.HasPrefix(p, "/eth/v1/beacon/rewards/blocks/"):
+		s, ok := resolveBlock(strings.TrimPrefix(p, "/eth/v1/beacon/rewards/blocks/"))
+		if !ok {
+			notFound(w)
+			return
+		}
+		att := int64(1000 * len(attestedSlots(s)))
+		write(w, m{"execution_optimistic": false, "finalized": s < 2*slotsPerEpoch, "data": m{
+			"proposer_index": u(proposer(s)), "total": i(att + 500), "attestations": i(att), "sync_aggregate": "500", "proposer_slashings": "0", "attester_slashings": "0"}})
+	case strings.HasPrefix(p, "/eth/v1/beacon/rewards/sync_committee/"):
+		s, ok := resolveBlock(strings.TrimPrefix(p, "/eth/v1/beacon/rewards/sync_committee/"))
+		if !ok {
+			notFound(w)
+			return
+		}
+		data := []m{}
+		for v := uint64(0); v < validators; v++ {
+			reward := int64(20)
+			if s == 12 && v == 3 {
+				reward = -20
+			}
+			data = append(data, m{"validator_index": u(v), "reward": i(reward)})
+		}
+		write(w, m{"execution_optimistic": false, "finalized": s < 2*slotsPerEpoch, "data": data})
+	case strings.HasPrefix(p, "/eth/v1/beacon/rewards/attestations/"):
+		e, _ := strconv.ParseUint(strings.TrimPrefix(p, "/eth/v1/beacon/rewards/attestations/"), 10, 64)
+		total := []m{}
+		for v := uint64(0); v < validators; v++ {
+			head := "10"
+			if e == 1 && v == 3 {
+				head = "0"
+			}
+			total = append(total, m{"validator_index": u(v), "head": head, "target": "20", "source": "10", "inclusion_delay": "0", "inactivity": "0"})
+		}
+		write(w, m{"execution_optimistic": false, "finalized": e < 2, "data": m{
+			"ideal_rewards": []m{{"effective_balance": "32000000000", "head": "10", "target": "20", "source": "10", "inclusion_delay": "0", "inactivity": "0"}},
+			"total_rewards": total}})
+	case strings.HasPrefix(p, "/eth/v1/validator/duties/proposer/"):
+		e, _ := strconv.ParseUint(strings.TrimPrefix(p, "/eth/v1/validator/duties/proposer/"), 10, 64)
+		dependent := blockRoot(0)
+		if e > 0 {
+			dependent = blockRoot(e*slotsPerEpoch - 1)
+		}
+		data := []m{}
+		for s := e * slotsPerEpoch; s < (e+1)*slotsPerEpoch; s++ {
+			data = append(data, m{"pubkey": pubkey(proposer(s)), "validator_index": u(proposer(s)), "slot": u(s)})
+		}
+		write(w, m{"dependent_root": dependent, "execution_optimistic": false, "data": data})
+	case p == "/eth/v1/beacon/states/head/finality_checkpoints":
+		write(w, m{"data": m{
+			"previous_justified": m{"epoch": "1", "root": blockRoot(8)},
+			"current_justified":  m{"epoch": "2", "root": blockRoot(16)},
+			"finalized":          m{"epoch": "1", "root": blockRoot(8)},
+		}})
+	case stateRe.MatchString(p):
+		parts := stateRe.FindStringSubmatch(p)
+		s, genesis, ok := resolveState(parts[1])
+		if !ok {
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"code":404,"message":"NOT_FOUND: beacon state"}`)
+			return
+		}
+		switch parts[2] {
+		case "validators":
+			write(w, validatorsAt(s, genesis))
+		case "validator_balances":
+			vals := validatorsAt(s, genesis)["data"].([]m)
+			data := []m{}
+			for _, v := range vals {
+				data = append(data, m{"index": v["index"], "balance": v["balance"]})
+			}
+			write(w, m{"execution_optimistic": false, "finalized": false, "data": data})
+		case "committees":
+			e := s / slotsPerEpoch
+			if v := q.Get("epoch"); v != "" {
+				e, _ = strconv.ParseUint(v, 10, 64)
+			}
+			write(w, committees(e))
+		case "sync_committees":
+			write(w, syncCommittee())
+		default:
+			http.NotFound(w, r)
+		}
+	case p == "/eth/v1/events":
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(200)
+		for s := uint64(20); s <= headSlot; s++ {
+			head, _ := json.Marshal(m{"slot": u(s), "block": blockRoot(s), "state": stateRoot(s), "epoch_transition": false,
+				"previous_duty_dependent_root": blockRoot(15), "current_duty_dependent_root": blockRoot(15), "execution_optimistic": false})
+			blk, _ := json.Marshal(m{"slot": u(s), "block": blockRoot(s), "execution_optimistic": false})
+			fmt.Fprintf(w, "event: block\ndata: %s\n\nevent: head\ndata: %s\n\n", blk, head)
+		}
+		fin, _ := json.Marshal(m{"block": blockRoot(8), "state": stateRoot(8), "epoch": "1", "execution_optimistic": false})
+		fmt.Fprintf(w, "event: finalized_checkpoint\ndata: %s\n\n", fin)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: synthetic_chain <fixture path>")
+	}
+	node := httptest.NewServer(http.HandlerFunc(serve))
+	defer node.Close()
+
+	recorder := fixtures.NewRecorder(node.URL)
+	err := recorder.RecordEvents([]types.EventTopic{types.EventHead, types.EventBlock, types.EventChainReorg, types.EventFinalizedCheckpoint}, time.Second)
+	if err != nil {
+		log.Fatalf("error recording events: %v", err)
+	}
+	if err := recorder.RecordSlotRange(0, headSlot); err != nil {
+		log.Fatalf("error recording slots: %v", err)
+	}
+	fixture := recorder.Fixture()
+	if err := fixture.Save(os.Args[1]); err != nil {
+		log.Fatalf("error saving fixture: %v", err)
+	}
+	log.Printf("saved %d responses and %d events to %s", len(fixture.Responses), len(fixture.Events), os.Args[1])
+}
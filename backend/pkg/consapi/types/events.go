@@ -8,17 +8,19 @@ import (
 type EventTopic string
 
 const (
-	EventHead  EventTopic = "head"
-	EventBlock EventTopic = "block"
-	// EventAttestation                 EventTopic = "attestation"
-	// EventVoluntaryExit               EventTopic = "voluntary_exit"
-	// EventBlsToExecutionChange        EventTopic = "bls_to_execution_change"
-	EventFinalizedCheckpoint EventTopic = "finalized_checkpoint"
-	EventChainReorg          EventTopic = "chain_reorg"
-	// EventContributionAndProof        EventTopic = "contribution_and_proof"
+	EventHead                 EventTopic = "head"
+	EventBlock                EventTopic = "block"
+	EventAttestation          EventTopic = "attestation"
+	EventSingleAttestation    EventTopic = "single_attestation"
+	EventVoluntaryExit        EventTopic = "voluntary_exit"
+	EventBlsToExecutionChange EventTopic = "bls_to_execution_change"
+	EventFinalizedCheckpoint  EventTopic = "finalized_checkpoint"
+	EventChainReorg           EventTopic = "chain_reorg"
+	EventContributionAndProof EventTopic = "contribution_and_proof"
 	// EventLightClientFinalityUpdate   EventTopic = "light_client_finality_update"
 	// EventLightClientOptimisticUpdate EventTopic = "light_client_optimistic_update"
-	// EventPayloadAttributes           EventTopic = "payload_attributes"
+	EventPayloadAttributes EventTopic = "payload_attributes"
+	EventBlobSidecar       EventTopic = "blob_sidecar"
 )
 
 type EventResponse struct {
@@ -59,6 +61,62 @@ func (e EventResponse) FinalizedCheckpoint() (*StandardFinalizedCheckpointRespon
 	return utils.UnmarshalOld[StandardFinalizedCheckpointResponse](e.Data, e.Error)
 }
 
+// Helper to get Attestation response type, returns nil if it is not an attestation event
+func (e EventResponse) Attestation() (*StandardEventAttestationResponse, error) {
+	if e.Event != EventAttestation {
+		return nil, nil
+	}
+	return utils.UnmarshalOld[StandardEventAttestationResponse](e.Data, e.Error)
+}
+
+// Helper to get SingleAttestation response type, returns nil if it is not a single attestation event
+func (e EventResponse) SingleAttestation() (*StandardEventSingleAttestationResponse, error) {
+	if e.Event != EventSingleAttestation {
+		return nil, nil
+	}
+	return utils.UnmarshalOld[StandardEventSingleAttestationResponse](e.Data, e.Error)
+}
+
+// Helper to get VoluntaryExit response type, returns nil if it is not a voluntary exit event
+func (e EventResponse) VoluntaryExit() (*StandardEventVoluntaryExitResponse, error) {
+	if e.Event != EventVoluntaryExit {
+		return nil, nil
+	}
+	return utils.UnmarshalOld[StandardEventVoluntaryExitResponse](e.Data, e.Error)
+}
+
+// Helper to get BlsToExecutionChange response type, returns nil if it is not a bls to execution change event
+func (e EventResponse) BlsToExecutionChange() (*StandardEventBlsToExecutionChangeResponse, error) {
+	if e.Event != EventBlsToExecutionChange {
+		return nil, nil
+	}
+	return utils.UnmarshalOld[StandardEventBlsToExecutionChangeResponse](e.Data, e.Error)
+}
+
+// Helper to get ContributionAndProof response type, returns nil if it is not a contribution and proof event
+func (e EventResponse) ContributionAndProof() (*StandardEventContributionAndProofResponse, error) {
+	if e.Event != EventContributionAndProof {
+		return nil, nil
+	}
+	return utils.UnmarshalOld[StandardEventContributionAndProofResponse](e.Data, e.Error)
+}
+
+// Helper to get PayloadAttributes response type, returns nil if it is not a payload attributes event
+func (e EventResponse) PayloadAttributes() (*StandardEventPayloadAttributesResponse, error) {
+	if e.Event != EventPayloadAttributes {
+		return nil, nil
+	}
+	return utils.UnmarshalOld[StandardEventPayloadAttributesResponse](e.Data, e.Error)
+}
+
+// Helper to get BlobSidecar response type, returns nil if it is not a blob sidecar event
+func (e EventResponse) BlobSidecar() (*StandardEventBlobSidecarResponse, error) {
+	if e.Event != EventBlobSidecar {
+		return nil, nil
+	}
+	return utils.UnmarshalOld[StandardEventBlobSidecarResponse](e.Data, e.Error)
+}
+
 type StandardEventHeadResponse struct {
 	Slot                      uint64        `json:"slot,string"`
 	Block                     string        `json:"block"`
@@ -92,3 +150,72 @@ type StandardFinalizedCheckpointResponse struct {
 	Epoch               uint64        `json:"epoch,string"`
 	ExecutionOptimistic bool          `json:"execution_optimistic"`
 }
+
+// the attestation event carries attestations that passed gossip validation, after electra they can span multiple committees
+type StandardEventAttestationResponse = Attestation
+
+// after electra unaggregated attestations are gossiped with the index of the attester instead of aggregation bits
+type StandardEventSingleAttestationResponse struct {
+	CommitteeIndex uint64        `json:"committee_index,string"`
+	AttesterIndex  uint64        `json:"attester_index,string"`
+	Signature      hexutil.Bytes `json:"signature"`
+	Data           struct {
+		Slot            uint64        `json:"slot,string"`
+		Index           uint16        `json:"index,string"`
+		BeaconBlockRoot hexutil.Bytes `json:"beacon_block_root"`
+		Source          struct {
+			Epoch uint64        `json:"epoch,string"`
+			Root  hexutil.Bytes `json:"root"`
+		} `json:"source"`
+		Target struct {
+			Epoch uint64        `json:"epoch,string"`
+			Root  hexutil.Bytes `json:"root"`
+		} `json:"target"`
+	} `json:"data"`
+}
+
+type StandardEventVoluntaryExitResponse = VoluntaryExit
+
+type StandardEventBlsToExecutionChangeResponse = SignedBLSToExecutionChange
+
+type StandardEventContributionAndProofResponse struct {
+	Message struct {
+		AggregatorIndex uint64 `json:"aggregator_index,string"`
+		Contribution    struct {
+			Slot              uint64        `json:"slot,string"`
+			BeaconBlockRoot   hexutil.Bytes `json:"beacon_block_root"`
+			SubcommitteeIndex uint64        `json:"subcommittee_index,string"`
+			AggregationBits   hexutil.Bytes `json:"aggregation_bits"`
+			Signature         hexutil.Bytes `json:"signature"`
+		} `json:"contribution"`
+		SelectionProof hexutil.Bytes `json:"selection_proof"`
+	} `json:"message"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+type StandardEventPayloadAttributesResponse struct {
+	Version string `json:"version"`
+	Data    struct {
+		ProposerIndex     uint64        `json:"proposer_index,string"`
+		ProposalSlot      uint64        `json:"proposal_slot,string"`
+		ParentBlockNumber uint64        `json:"parent_block_number,string"`
+		ParentBlockRoot   hexutil.Bytes `json:"parent_block_root"`
+		ParentBlockHash   hexutil.Bytes `json:"parent_block_hash"`
+		PayloadAttributes struct {
+			Timestamp             uint64              `json:"timestamp,string"`
+			PrevRandao            hexutil.Bytes       `json:"prev_randao"`
+			SuggestedFeeRecipient hexutil.Bytes       `json:"suggested_fee_recipient"`
+			Withdrawals           []WithdrawalPayload `json:"withdrawals"`
+			// present only after deneb
+			ParentBeaconBlockRoot hexutil.Bytes `json:"parent_beacon_block_root"`
+		} `json:"payload_attributes"`
+	} `json:"data"`
+}
+
+type StandardEventBlobSidecarResponse struct {
+	BlockRoot     hexutil.Bytes `json:"block_root"`
+	Index         uint64        `json:"index,string"`
+	Slot          uint64        `json:"slot,string"`
+	KzgCommitment hexutil.Bytes `json:"kzg_commitment"`
+	VersionedHash hexutil.Bytes `json:"versioned_hash"`
+}
@@ -19,3 +19,12 @@ type StandardFinalityCheckpointsResponse struct {
 		} `json:"finalized"`
 	} `json:"data"`
 }
+
+// /eth/v1/beacon/states/{state_id}/root
+type StandardStateRootResponse struct {
+	Data struct {
+		Root hexutil.Bytes `json:"root"`
+	} `json:"data"`
+	ExecutionOptimistic bool `json:"execution_optimistic"`
+	Finalized           bool `json:"finalized"`
+}
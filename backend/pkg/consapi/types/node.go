@@ -0,0 +1,12 @@
+package types
+
+// /eth/v1/node/syncing
+type StandardSyncingResponse struct {
+	Data struct {
+		HeadSlot     uint64 `json:"head_slot,string"`
+		SyncDistance uint64 `json:"sync_distance,string"`
+		IsSyncing    bool   `json:"is_syncing"`
+		IsOptimistic bool   `json:"is_optimistic"`
+		ElOffline    bool   `json:"el_offline"`
+	} `json:"data"`
+}
@@ -23,6 +23,8 @@ type StandardSpec struct {
 	CapellaForkEpoch                        *uint64  `json:"CAPELLA_FORK_EPOCH,string"`
 	DenebForkVersion                        string   `json:"DENEB_FORK_VERSION"`
 	DenebForkEpoch                          *uint64  `json:"DENEB_FORK_EPOCH,string"`
+	ElectraForkVersion                      string   `json:"ELECTRA_FORK_VERSION"`
+	ElectraForkEpoch                        *uint64  `json:"ELECTRA_FORK_EPOCH,string"`
 	SecondsPerSlot                          int64    `json:"SECONDS_PER_SLOT,string"`
 	SecondsPerEth1Block                     int64    `json:"SECONDS_PER_ETH1_BLOCK,string"`
 	MinValidatorWithdrawabilityDelay        int64    `json:"MIN_VALIDATOR_WITHDRAWABILITY_DELAY,string"`
@@ -34,6 +36,8 @@ type StandardSpec struct {
 	EjectionBalance                         int64    `json:"EJECTION_BALANCE,string"`
 	MinPerEpochChurnLimit                   int64    `json:"MIN_PER_EPOCH_CHURN_LIMIT,string"`
 	ChurnLimitQuotient                      int64    `json:"CHURN_LIMIT_QUOTIENT,string"`
+	MinPerEpochChurnLimitElectra            int64    `json:"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA,string"`
+	MaxPerEpochActivationExitChurnLimit     int64    `json:"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT,string"`
 	ProposerScoreBoost                      int64    `json:"PROPOSER_SCORE_BOOST,string"`
 	DepositChainID                          int64    `json:"DEPOSIT_CHAIN_ID,string"`
 	DepositNetworkID                        int64    `json:"DEPOSIT_NETWORK_ID,string"`
@@ -57,6 +61,8 @@ type StandardSpec struct {
 	SafeSlotsToUpdateJustified              int64    `json:"SAFE_SLOTS_TO_UPDATE_JUSTIFIED,string"`
 	MinDepositAmount                        int64    `json:"MIN_DEPOSIT_AMOUNT,string"`
 	MaxEffectiveBalance                     int64    `json:"MAX_EFFECTIVE_BALANCE,string"`
+	MinActivationBalance                    int64    `json:"MIN_ACTIVATION_BALANCE,string"`
+	MaxEffectiveBalanceElectra              int64    `json:"MAX_EFFECTIVE_BALANCE_ELECTRA,string"`
 	EffectiveBalanceIncrement               int64    `json:"EFFECTIVE_BALANCE_INCREMENT,string"`
 	MinAttestationInclusionDelay            int64    `json:"MIN_ATTESTATION_INCLUSION_DELAY,string"`
 	SlotsPerEpoch                           int64    `json:"SLOTS_PER_EPOCH,string"`
@@ -96,6 +102,9 @@ type StandardSpec struct {
 	MaxBlsToExecutionChanges                int64    `json:"MAX_BLS_TO_EXECUTION_CHANGES,string"`
 	MaxWithdrawalsPerPayload                int64    `json:"MAX_WITHDRAWALS_PER_PAYLOAD,string"`
 	MaxValidatorsPerWithdrawalsSweep        int64    `json:"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP,string"`
+	MaxPendingPartialsPerWithdrawalsSweep   int64    `json:"MAX_PENDING_PARTIALS_PER_WITHDRAWALS_SWEEP,string"`
+	MaxPendingDepositsPerEpoch              int64    `json:"MAX_PENDING_DEPOSITS_PER_EPOCH,string"`
+	WhistleblowerRewardQuotientElectra      int64    `json:"WHISTLEBLOWER_REWARD_QUOTIENT_ELECTRA,string"`
 	DomainSelectionProof                    string   `json:"DOMAIN_SELECTION_PROOF"`
 	DomainVoluntaryExit                     string   `json:"DOMAIN_VOLUNTARY_EXIT"`
 	TargetAggregatorsPerCommittee           int64    `json:"TARGET_AGGREGATORS_PER_COMMITTEE,string"`
@@ -74,8 +74,10 @@ type StandardProposerAssignmentsResponse struct {
 
 // /eth/v1/beacon/states/{state_id}/validator_balances
 type StandardValidatorBalancesResponse struct {
-	Data []struct {
-		Index   uint64 `json:"index,string"`
-		Balance uint64 `json:"balance,string"`
-	} `json:"data"`
+	Data []StandardValidatorBalance `json:"data"`
+}
+
+type StandardValidatorBalance struct {
+	Index   uint64 `json:"index,string"`
+	Balance uint64 `json:"balance,string"`
 }
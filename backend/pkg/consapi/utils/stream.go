@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// StreamData decodes a response of the form {"data": [...], ...} element by element and calls fn for every element
+// of the data array, so large responses never have to be held in memory as a whole. All other fields of the response
+// are unmarshalled into meta if it is not nil.
+func StreamData[T any](source io.ReadCloser, meta any, fn func(*T) error) error {
+	defer source.Close()
+	decoder := json.NewDecoder(source)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return errors.Wrap(err, "unmarshal json failed")
+		}
+		key, ok := token.(string)
+		if !ok {
+			return errors.Errorf("unmarshal json failed: unexpected key %v", token)
+		}
+
+		if key != "data" {
+			var value json.RawMessage
+			if err := decoder.Decode(&value); err != nil {
+				return errors.Wrapf(err, "unmarshal json failed for %s", key)
+			}
+			fields[key] = value
+			continue
+		}
+
+		if err := expectDelim(decoder, '['); err != nil {
+			return err
+		}
+		for decoder.More() {
+			var element T
+			if err := decoder.Decode(&element); err != nil {
+				return errors.Wrap(err, "unmarshal json failed")
+			}
+			if err := fn(&element); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(decoder, ']'); err != nil {
+			return err
+		}
+	}
+
+	if meta == nil || len(fields) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return errors.Wrap(err, "marshal json failed")
+	}
+	return errors.Wrap(json.Unmarshal(data, meta), "unmarshal json failed")
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return errors.Wrap(err, "unmarshal json failed")
+	}
+	if token != delim {
+		return errors.Errorf("unmarshal json failed: expected %v, got %v", delim, token)
+	}
+	return nil
+}
@@ -90,6 +90,24 @@ func saveBlocks(blocks map[uint64]map[string]*types.Block, tx *sqlx.Tx, forceSlo
 	}
 	defer stmtBLSChange.Close()
 
+	stmtWithdrawalRequests, err := tx.Prepare(`
+		INSERT INTO blocks_withdrawal_requests (block_slot, block_root, request_index, source_address, validator_pubkey, amount)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (block_slot, block_root, request_index) DO NOTHING`)
+	if err != nil {
+		return err
+	}
+	defer stmtWithdrawalRequests.Close()
+
+	stmtConsolidationRequests, err := tx.Prepare(`
+		INSERT INTO blocks_consolidation_requests (block_slot, block_root, request_index, source_address, source_pubkey, target_pubkey)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (block_slot, block_root, request_index) DO NOTHING`)
+	if err != nil {
+		return err
+	}
+	defer stmtConsolidationRequests.Close()
+
 	stmtProposerSlashing, err := tx.Prepare(`
 		INSERT INTO blocks_proposerslashings (block_slot, block_index, block_root, proposerindex, header1_slot, header1_parentroot, header1_stateroot, header1_bodyroot, header1_signature, header2_slot, header2_parentroot, header2_stateroot, header2_bodyroot, header2_signature)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
@@ -321,6 +339,18 @@ func saveBlocks(blocks map[uint64]map[string]*types.Block, tx *sqlx.Tx, forceSlo
 					return fmt.Errorf("error executing stmtBLSChange for block %v index %v: %w", b.Slot, i, err)
 				}
 			}
+			for i, wr := range b.WithdrawalRequests {
+				_, err := stmtWithdrawalRequests.Exec(b.Slot, b.BlockRoot, i, wr.SourceAddress, wr.ValidatorPubkey, wr.Amount)
+				if err != nil {
+					return fmt.Errorf("error executing stmtWithdrawalRequests for block %v index %v: %w", b.Slot, i, err)
+				}
+			}
+			for i, cr := range b.ConsolidationRequests {
+				_, err := stmtConsolidationRequests.Exec(b.Slot, b.BlockRoot, i, cr.SourceAddress, cr.SourcePubkey, cr.TargetPubkey)
+				if err != nil {
+					return fmt.Errorf("error executing stmtConsolidationRequests for block %v index %v: %w", b.Slot, i, err)
+				}
+			}
 
 			for i, as := range b.AttesterSlashings {
 				_, err := stmtAttesterSlashing.Exec(b.Slot, i, b.BlockRoot, pq.Array(as.Attestation1.AttestingIndices), as.Attestation1.Signature, as.Attestation1.Data.Slot, as.Attestation1.Data.CommitteeIndex, as.Attestation1.Data.BeaconBlockRoot, as.Attestation1.Data.Source.Epoch, as.Attestation1.Data.Source.Root, as.Attestation1.Data.Target.Epoch, as.Attestation1.Data.Target.Root, pq.Array(as.Attestation2.AttestingIndices), as.Attestation2.Signature, as.Attestation2.Data.Slot, as.Attestation2.Data.CommitteeIndex, as.Attestation2.Data.BeaconBlockRoot, as.Attestation2.Data.Source.Epoch, as.Attestation2.Data.Source.Root, as.Attestation2.Data.Target.Epoch, as.Attestation2.Data.Target.Root)
@@ -11,7 +11,6 @@ import (
 	"github.com/gobitfly/beaconchain/pkg/commons/utils"
 	"github.com/gobitfly/beaconchain/pkg/consapi"
 	"github.com/gobitfly/beaconchain/pkg/consapi/types"
-	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -44,6 +43,9 @@ func StartAll(context ModuleContext, modules []ModuleInterface, justV2 bool) {
 		if utils.Config.RocketpoolExporter.Enabled {
 			go rocketpoolExporter()
 		}
+		if utils.Config.StakingProtocolsExporter.Enabled {
+			go stakingProtocolsExporter()
+		}
 
 		if utils.Config.Indexer.PubKeyTagsExporter.Enabled {
 			go UpdatePubkeyTag()
@@ -156,7 +158,10 @@ func notifyAllModules(goPool *errgroup.Group, modules []ModuleInterface, f func(
 }
 
 func GetModuleContext() (ModuleContext, error) {
-	cl := consapi.NewClient("http://" + utils.Config.Indexer.Node.Host + ":" + utils.Config.Indexer.Node.Port)
+	cl, err := newConsensusClient()
+	if err != nil {
+		return ModuleContext{}, err
+	}
 
 	spec, err := cl.GetSpec()
 	if err != nil {
@@ -165,14 +170,9 @@ func GetModuleContext() (ModuleContext, error) {
 
 	config.ClConfig = &spec.Data
 
-	nodeImpl, ok := cl.ClientInt.(*consapi.NodeClient)
-	if !ok {
-		return ModuleContext{}, errors.New("lighthouse client can only be used with real node impl")
-	}
-
 	chainID := new(big.Int).SetUint64(utils.Config.Chain.ClConfig.DepositChainID)
 
-	clClient, err := rpc.NewLighthouseClient(nodeImpl, chainID)
+	clClient, err := rpc.NewLighthouseClient(cl.ClientInt, chainID)
 	if err != nil {
 		log.Fatal(err, "error creating lighthouse client", 0)
 	}
@@ -185,6 +185,24 @@ func GetModuleContext() (ModuleContext, error) {
 	return moduleContext, nil
 }
 
+// newConsensusClient connects to the configured beacon node, or to all of them with failover if additional endpoints are configured
+func newConsensusClient() (consapi.Client, error) {
+	endpoint := "http://" + utils.Config.Indexer.Node.Host + ":" + utils.Config.Indexer.Node.Port
+	if len(utils.Config.Indexer.Node.Endpoints) == 0 {
+		return consapi.NewClient(endpoint), nil
+	}
+
+	cl, err := consapi.NewMultiNodeClient(append([]string{endpoint}, utils.Config.Indexer.Node.Endpoints...), consapi.MultiNodeConfig{
+		HealthCheckInterval: time.Duration(utils.Config.Chain.ClConfig.SecondsPerSlot) * time.Second,
+		MaxHeadSlotLag:      utils.Config.Indexer.Node.MaxHeadSlotLag,
+		FinalizedQuorum:     utils.Config.Indexer.Node.FinalizedQuorum,
+	})
+	if err != nil {
+		return consapi.Client{}, fmt.Errorf("error creating multi node consensus client: %w", err)
+	}
+	return cl, nil
+}
+
 type ModuleContext struct {
 	CL         consapi.Client
 	ConsClient *rpc.LighthouseClient
@@ -1077,8 +1077,6 @@ type Data struct {
 	syncCommitteeElectedState *constypes.StandardValidatorsResponse
 }
 
-const MAX_EFFECTIVE_BALANCE = 32e9
-
 // Data for a single validator
 // use skipSerialCalls = false if you are not sure what you are doing. This flag is mainly
 // to gain performance improvements when exporting a couple sequential epochs in a row
@@ -0,0 +1,67 @@
+package modules
+
+import (
+	"testing"
+
+	"golang.org/x/sync/errgroup"
+)
+
+func TestDashboardDataEpoch(t *testing.T) {
+	d := NewDashboardDataModule(ModuleContext{CL: newSyntheticChainClient(t)}).(*dashboardData)
+
+	errGroup := &errgroup.Group{}
+	d.getSyncCommitteesData(errGroup, map[uint64]bool{0: true})
+	if err := errGroup.Wait(); err != nil {
+		t.Fatalf("error getting sync committee: %v", err)
+	}
+
+	data, err := d.GetEpochDataRaw(1, false)
+	if err != nil {
+		t.Fatalf("error getting epoch data: %v", err)
+	}
+	if !data.missedslots[10] || len(data.missedslots) != 1 {
+		t.Errorf("expected slot 10 to be the only missed slot, got %v", data.missedslots)
+	}
+
+	rows, err := d.ProcessEpochData(data)
+	if err != nil {
+		t.Fatalf("error processing epoch data: %v", err)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("expected a row per validator, got %d", len(rows))
+	}
+
+	for i, row := range rows {
+		if row.BalanceStart != 32000000700+uint64(i) || row.BalanceEnd != 32000001500+uint64(i) {
+			t.Errorf("validator %d: unexpected balances %d -> %d", i, row.BalanceStart, row.BalanceEnd)
+		}
+		if row.BlockScheduled.Int16 != 2 {
+			t.Errorf("validator %d: expected 2 scheduled blocks, got %d", i, row.BlockScheduled.Int16)
+		}
+		if row.SyncScheduled.Int16 != 7 {
+			t.Errorf("validator %d: expected 7 scheduled sync duties, got %d", i, row.SyncScheduled.Int16)
+		}
+	}
+
+	// validator 2 missed the proposal in slot 10
+	if rows[2].BlocksProposed.Int16 != 1 || rows[0].BlocksProposed.Int16 != 2 {
+		t.Errorf("expected 1 proposed block for validator 2 and 2 for validator 0, got %d and %d", rows[2].BlocksProposed.Int16, rows[0].BlocksProposed.Int16)
+	}
+	if rows[2].WithdrawalsAmount.Int64 != 5000 || rows[2].WithdrawalsCount.Int16 != 1 {
+		t.Errorf("expected the withdrawal of validator 2, got %d in %d withdrawals", rows[2].WithdrawalsAmount.Int64, rows[2].WithdrawalsCount.Int16)
+	}
+	// validator 3 missed its sync duty in slot 12
+	if rows[3].SyncExecuted.Int16 != 6 || rows[3].SyncReward.Int64 != 100 || rows[0].SyncExecuted.Int16 != 7 {
+		t.Errorf("unexpected sync committee performance: validator 3 executed %d with reward %d, validator 0 executed %d", rows[3].SyncExecuted.Int16, rows[3].SyncReward.Int64, rows[0].SyncExecuted.Int16)
+	}
+	// the attestation of slot 9 was included after the missed slot 10
+	if rows[1].InclusionDelaySum.Int16 != 1 || rows[1].OptimalInclusionDelay.Int16 != 1 {
+		t.Errorf("expected an inclusion delay of 1 with an optimal delay of 1 for validator 1, got %d and %d", rows[1].InclusionDelaySum.Int16, rows[1].OptimalInclusionDelay.Int16)
+	}
+	if rows[3].AttestationHeadExecuted.Valid || !rows[3].AttestationTargetExecuted.Valid {
+		t.Errorf("expected validator 3 to miss the head vote only")
+	}
+	if rows[0].AttestationIdealReward.Int64 != 40 {
+		t.Errorf("expected an ideal attestation reward of 40, got %d", rows[0].AttestationIdealReward.Int64)
+	}
+}
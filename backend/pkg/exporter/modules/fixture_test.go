@@ -0,0 +1,36 @@
+package modules
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/gobitfly/beaconchain/pkg/consapi"
+	"github.com/gobitfly/beaconchain/pkg/consapi/fixtures"
+)
+
+// syntheticChainFixture holds a synthetic deneb chain of 24 slots with 8 slots per epoch and 4 validators. Validator v
+// proposes every slot with slot % 4 == v and attests in slot v of every epoch, slot 10 was missed.
+const syntheticChainFixture = "../../consapi/testdata/synthetic_chain.json.gz"
+
+// newSyntheticChainClient replays the synthetic chain and sets the matching chain config for the duration of the test
+func newSyntheticChainClient(t *testing.T) consapi.Client {
+	server, _ := fixtures.NewTestServer(t, syntheticChainFixture)
+
+	previousConfig := utils.Config
+	t.Cleanup(func() { utils.Config = previousConfig })
+	utils.Config = &types.Config{}
+	utils.Config.Chain.ClConfig = types.ClChainConfig{
+		ElectraForkEpoch:             math.MaxUint64,
+		SlotsPerEpoch:                8,
+		SecondsPerSlot:               12,
+		SyncCommitteeSize:            8,
+		EpochsPerSyncCommitteePeriod: 8,
+		GenesisForkVersion:           "0x10000038",
+		DepositChainID:               1337,
+	}
+	utils.Config.Chain.GenesisTimestamp = 1700000000
+
+	return consapi.NewClient(server.URL)
+}
@@ -0,0 +1,312 @@
+package modules
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	constypes "github.com/gobitfly/beaconchain/pkg/consapi/types"
+	"github.com/lib/pq"
+)
+
+// gossipExporter consumes the gossip events of the beacon node. It records when the attestations of dashboard validators
+// are first seen on gossip and, once finalized, the slot of the block that included them. Voluntary exits and bls to
+// execution changes are stored as soon as they enter the operation pool of the node and removed once their inclusion
+// is finalized.
+type gossipExporter struct {
+	ModuleContext ModuleContext
+	FlushMutex    *sync.Mutex
+
+	lastFinalizedEpoch  uint64 // finalized epoch of the previous checkpoint, 0 if none was handled yet
+	mu                  sync.Mutex
+	dashboardValidators map[uint64]bool
+	attestations        map[gossipAttestationKey]time.Time // seen but not yet written
+}
+
+type gossipAttestationKey struct {
+	ValidatorIndex uint64
+	Slot           uint64
+}
+
+func NewGossipExporter(moduleContext ModuleContext) ModuleInterface {
+	return &gossipExporter{
+		ModuleContext:       moduleContext,
+		FlushMutex:          &sync.Mutex{},
+		dashboardValidators: make(map[uint64]bool),
+		attestations:        make(map[gossipAttestationKey]time.Time),
+	}
+}
+
+func (d *gossipExporter) Init() error {
+	err := d.updateDashboardValidators()
+	if err != nil {
+		return err
+	}
+
+	// the gossip topics get their own subscription, so the volume of attestations can't delay the head events of the other modules
+	go func() {
+		events := d.ModuleContext.CL.GetEvents([]constypes.EventTopic{
+			constypes.EventAttestation,
+			constypes.EventSingleAttestation,
+			constypes.EventVoluntaryExit,
+			constypes.EventBlsToExecutionChange,
+		})
+		for event := range events {
+			err := d.handleEvent(event, time.Now())
+			if err != nil {
+				log.Error(err, "error handling gossip event", 0, log.Fields{"event": event.Event})
+			}
+		}
+	}()
+	return nil
+}
+
+func (d *gossipExporter) GetName() string {
+	return "Gossip-Exporter"
+}
+
+func (d *gossipExporter) OnChainReorg(event *constypes.StandardEventChainReorg) (err error) {
+	return nil // nop
+}
+
+func (d *gossipExporter) OnFinalizedCheckpoint(event *constypes.StandardFinalizedCheckpointResponse) (err error) {
+	retention := utils.Config.GossipExporter.AttestationsRetentionEpochs
+	if retention == 0 {
+		retention = utils.EpochsPerDay()
+	}
+	startSlot, endSlot := gossipInclusionRange(d.lastFinalizedEpoch, event.Epoch, retention)
+	if startSlot < endSlot {
+		err = updateGossipInclusions(startSlot, endSlot)
+		if err != nil {
+			return err
+		}
+		err = pruneIncludedPoolOperations(endSlot)
+		if err != nil {
+			return err
+		}
+	}
+	d.lastFinalizedEpoch = event.Epoch
+
+	if event.Epoch <= retention {
+		return nil
+	}
+	_, err = db.WriterDb.Exec(`DELETE FROM attestations_gossip WHERE slot < $1`, (event.Epoch-retention)*utils.Config.Chain.ClConfig.SlotsPerEpoch)
+	if err != nil {
+		return fmt.Errorf("error removing old gossip attestations: %w", err)
+	}
+	return nil
+}
+
+// gossipInclusionRange returns the slots of the newly finalized blocks whose attestations are matched with the gossip
+// attestations. The last epoch of the previous range is checked again, as its blocks may have been exported after the
+// previous checkpoint.
+func gossipInclusionRange(lastFinalizedEpoch, finalizedEpoch, retention uint64) (uint64, uint64) {
+	start := uint64(0)
+	if lastFinalizedEpoch > 0 {
+		start = lastFinalizedEpoch - 1
+	} else if finalizedEpoch > 2 {
+		start = finalizedEpoch - 2
+	}
+	if finalizedEpoch > retention {
+		start = max(start, finalizedEpoch-retention)
+	}
+	start = min(start, finalizedEpoch)
+	slotsPerEpoch := utils.Config.Chain.ClConfig.SlotsPerEpoch
+	return start * slotsPerEpoch, finalizedEpoch * slotsPerEpoch
+}
+
+// updateGossipInclusions sets the inclusion slot of the gossip attestations included in the canonical blocks between the given slots
+func updateGossipInclusions(startSlot, endSlot uint64) error {
+	res, err := db.WriterDb.Exec(`
+		UPDATE attestations_gossip g
+		SET inclusion_slot = i.inclusion_slot
+		FROM (
+			SELECT blocks_attestations.slot, v.validator_index, MIN(blocks_attestations.block_slot) AS inclusion_slot
+			FROM blocks_attestations
+			INNER JOIN blocks ON blocks_attestations.block_slot = blocks.slot AND blocks_attestations.block_root = blocks.blockroot AND blocks.status = '1'
+			CROSS JOIN LATERAL UNNEST(blocks_attestations.validators) AS v(validator_index)
+			WHERE blocks_attestations.block_slot >= $1 AND blocks_attestations.block_slot < $2
+			GROUP BY blocks_attestations.slot, v.validator_index
+		) i
+		WHERE g.validator_index = i.validator_index AND g.slot = i.slot AND (g.inclusion_slot IS NULL OR g.inclusion_slot > i.inclusion_slot)`,
+		startSlot, endSlot)
+	if err != nil {
+		return fmt.Errorf("error updating inclusion of gossip attestations in slots %v to %v: %w", startSlot, endSlot, err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error retrieving number of included gossip attestations: %w", err)
+	}
+	log.Debugf("matched %v gossip attestations with their inclusion in slots %v to %v", updated, startSlot, endSlot)
+	return nil
+}
+
+// pruneIncludedPoolOperations removes the voluntary exits and bls to execution changes included in canonical blocks before the given slot
+func pruneIncludedPoolOperations(endSlot uint64) error {
+	_, err := db.WriterDb.Exec(`
+		DELETE FROM pool_voluntary_exits p
+		USING blocks_voluntaryexits e
+		INNER JOIN blocks ON e.block_slot = blocks.slot AND e.block_root = blocks.blockroot AND blocks.status = '1'
+		WHERE e.validatorindex = p.validator_index AND e.block_slot < $1`, endSlot)
+	if err != nil {
+		return fmt.Errorf("error removing included voluntary exits from the pool: %w", err)
+	}
+	_, err = db.WriterDb.Exec(`
+		DELETE FROM pool_bls_to_execution_changes p
+		USING blocks_bls_change c
+		INNER JOIN blocks ON c.block_slot = blocks.slot AND c.block_root = blocks.blockroot AND blocks.status = '1'
+		WHERE c.validatorindex = p.validator_index AND c.block_slot < $1`, endSlot)
+	if err != nil {
+		return fmt.Errorf("error removing included bls to execution changes from the pool: %w", err)
+	}
+	return nil
+}
+
+func (d *gossipExporter) OnHead(event *constypes.StandardEventHeadResponse) (err error) {
+	// if mutex is locked, return early
+	if !d.FlushMutex.TryLock() {
+		log.Infof("gossip exporter is already running")
+		return nil
+	}
+	defer d.FlushMutex.Unlock()
+
+	if event.EpochTransition {
+		err = d.updateDashboardValidators()
+		if err != nil {
+			return err
+		}
+	}
+	return d.flushAttestations()
+}
+
+func (d *gossipExporter) updateDashboardValidators() error {
+	var validators []uint64
+	err := db.AlloyReader.Select(&validators, `SELECT DISTINCT validator_index FROM users_val_dashboards_validators`)
+	if err != nil {
+		return fmt.Errorf("error retrieving dashboard validators: %w", err)
+	}
+
+	dashboardValidators := make(map[uint64]bool, len(validators))
+	for _, validator := range validators {
+		dashboardValidators[validator] = true
+	}
+	d.mu.Lock()
+	d.dashboardValidators = dashboardValidators
+	d.mu.Unlock()
+	return nil
+}
+
+func (d *gossipExporter) handleEvent(event *constypes.EventResponse, seen time.Time) error {
+	if event.Error != nil {
+		return event.Error
+	}
+
+	switch event.Event {
+	case constypes.EventAttestation:
+		attestation, err := event.Attestation()
+		if err != nil {
+			return err
+		}
+		if !d.hasDashboardValidators() {
+			return nil
+		}
+		attesters, err := d.ModuleContext.ConsClient.GetAttesters(attestation)
+		if err != nil {
+			return err
+		}
+		d.addAttestations(attestation.Data.Slot, attesters, seen)
+
+	case constypes.EventSingleAttestation:
+		attestation, err := event.SingleAttestation()
+		if err != nil {
+			return err
+		}
+		d.addAttestations(attestation.Data.Slot, []uint64{attestation.AttesterIndex}, seen)
+
+	case constypes.EventVoluntaryExit:
+		exit, err := event.VoluntaryExit()
+		if err != nil {
+			return err
+		}
+		_, err = db.WriterDb.Exec(`
+			INSERT INTO pool_voluntary_exits (validator_index, epoch, signature, seen_ts)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (validator_index) DO NOTHING`,
+			exit.Message.ValidatorIndex, exit.Message.Epoch, []byte(exit.Signature), seen.UTC())
+		if err != nil {
+			return fmt.Errorf("error saving voluntary exit of validator %v: %w", exit.Message.ValidatorIndex, err)
+		}
+		log.InfoWithFields(log.Fields{"validator": exit.Message.ValidatorIndex, "epoch": exit.Message.Epoch}, "voluntary exit entered the pool")
+
+	case constypes.EventBlsToExecutionChange:
+		change, err := event.BlsToExecutionChange()
+		if err != nil {
+			return err
+		}
+		_, err = db.WriterDb.Exec(`
+			INSERT INTO pool_bls_to_execution_changes (validator_index, from_bls_pubkey, to_execution_address, signature, seen_ts)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (validator_index) DO NOTHING`,
+			change.Message.ValidatorIndex, []byte(change.Message.FromBlsPubkey), []byte(change.Message.ToExecutionAddress), []byte(change.Signature), seen.UTC())
+		if err != nil {
+			return fmt.Errorf("error saving bls to execution change of validator %v: %w", change.Message.ValidatorIndex, err)
+		}
+		log.InfoWithFields(log.Fields{"validator": change.Message.ValidatorIndex}, "bls to execution change entered the pool")
+	}
+	return nil
+}
+
+func (d *gossipExporter) hasDashboardValidators() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.dashboardValidators) > 0
+}
+
+// addAttestations keeps the first time the attestations of dashboard validators were seen, aggregates repeat the attestations
+// they were built from
+func (d *gossipExporter) addAttestations(slot uint64, attesters []uint64, seen time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, validator := range attesters {
+		if !d.dashboardValidators[validator] {
+			continue
+		}
+		key := gossipAttestationKey{ValidatorIndex: validator, Slot: slot}
+		if first, found := d.attestations[key]; !found || seen.Before(first) {
+			d.attestations[key] = seen
+		}
+	}
+}
+
+func (d *gossipExporter) flushAttestations() error {
+	d.mu.Lock()
+	attestations := d.attestations
+	d.attestations = make(map[gossipAttestationKey]time.Time)
+	d.mu.Unlock()
+	if len(attestations) == 0 {
+		return nil
+	}
+
+	validators := make([]int64, 0, len(attestations))
+	slots := make([]int64, 0, len(attestations))
+	seen := make([]string, 0, len(attestations))
+	for key, ts := range attestations {
+		validators = append(validators, int64(key.ValidatorIndex))
+		slots = append(slots, int64(key.Slot))
+		seen = append(seen, ts.UTC().Format(time.RFC3339Nano))
+	}
+
+	_, err := db.WriterDb.Exec(`
+		INSERT INTO attestations_gossip (validator_index, slot, seen_ts)
+		SELECT * FROM UNNEST($1::int[], $2::int[], $3::timestamp[])
+		ON CONFLICT (validator_index, slot) DO UPDATE SET seen_ts = LEAST(attestations_gossip.seen_ts, excluded.seen_ts)`,
+		pq.Array(validators), pq.Array(slots), pq.Array(seen))
+	if err != nil {
+		return fmt.Errorf("error saving %v gossip attestations: %w", len(attestations), err)
+	}
+	log.Debugf("saved %v gossip attestations", len(attestations))
+	return nil
+}
@@ -0,0 +1,107 @@
+package modules
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/rpc"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	constypes "github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+func TestGossipAttestations(t *testing.T) {
+	cl := newSyntheticChainClient(t)
+	client, err := rpc.NewLighthouseClient(cl.ClientInt, big.NewInt(1337))
+	if err != nil {
+		t.Fatalf("error creating client: %v", err)
+	}
+	d := NewGossipExporter(ModuleContext{CL: cl, ConsClient: client}).(*gossipExporter)
+	d.dashboardValidators = map[uint64]bool{1: true, 3: true}
+
+	seen := time.Unix(1700000000+9*12+4, 0)
+	events := []struct {
+		event constypes.EventResponse
+		seen  time.Time
+	}{
+		// validator 1 is the only member of the committee of slot 9
+		{constypes.EventResponse{Event: constypes.EventAttestation, Data: []byte(`{"aggregation_bits":"0x03","data":{"slot":"9","index":"0","beacon_block_root":"0x00","source":{"epoch":"0","root":"0x00"},"target":{"epoch":"1","root":"0x00"}},"signature":"0x00"}`)}, seen.Add(time.Second)},
+		{constypes.EventResponse{Event: constypes.EventSingleAttestation, Data: []byte(`{"committee_index":"0","attester_index":"1","data":{"slot":"9","index":"0"},"signature":"0x00"}`)}, seen},
+		// validator 2 is not part of a dashboard
+		{constypes.EventResponse{Event: constypes.EventSingleAttestation, Data: []byte(`{"committee_index":"0","attester_index":"2","data":{"slot":"10","index":"0"},"signature":"0x00"}`)}, seen},
+		{constypes.EventResponse{Event: constypes.EventSingleAttestation, Data: []byte(`{"committee_index":"0","attester_index":"3","data":{"slot":"11","index":"0"},"signature":"0x00"}`)}, seen.Add(12 * time.Second)},
+	}
+	for _, e := range events {
+		if err := d.handleEvent(&e.event, e.seen); err != nil {
+			t.Fatalf("error handling %s event: %v", e.event.Event, err)
+		}
+	}
+
+	if len(d.attestations) != 2 {
+		t.Fatalf("expected the attestations of the 2 dashboard validators, got %v", d.attestations)
+	}
+	if first := d.attestations[gossipAttestationKey{ValidatorIndex: 1, Slot: 9}]; !first.Equal(seen) {
+		t.Errorf("expected the first sighting of the attestation of validator 1, got %v", first)
+	}
+	if _, found := d.attestations[gossipAttestationKey{ValidatorIndex: 3, Slot: 11}]; !found {
+		t.Errorf("expected the attestation of validator 3 in slot 11")
+	}
+}
+
+func TestGossipEventTypes(t *testing.T) {
+	event := constypes.EventResponse{Event: constypes.EventPayloadAttributes, Data: []byte(`{"version":"deneb","data":{"proposer_index":"5","proposal_slot":"13","parent_block_number":"112","parent_block_root":"0x01","parent_block_hash":"0x02","payload_attributes":{"timestamp":"1700000156","prev_randao":"0x03","suggested_fee_recipient":"0x04","withdrawals":[{"index":"1","validator_index":"2","address":"0x05","amount":"5000"}],"parent_beacon_block_root":"0x01"}}}`)}
+	attributes, err := event.PayloadAttributes()
+	if err != nil {
+		t.Fatalf("error decoding payload attributes: %v", err)
+	}
+	if attributes.Data.ProposalSlot != 13 || attributes.Data.PayloadAttributes.Withdrawals[0].Amount != 5000 {
+		t.Errorf("unexpected payload attributes %+v", attributes.Data)
+	}
+	if exit, err := event.VoluntaryExit(); exit != nil || err != nil {
+		t.Errorf("expected no voluntary exit for a payload attributes event")
+	}
+
+	event = constypes.EventResponse{Event: constypes.EventBlobSidecar, Data: []byte(`{"block_root":"0x01","index":"2","slot":"12","kzg_commitment":"0x02","versioned_hash":"0x03"}`)}
+	sidecar, err := event.BlobSidecar()
+	if err != nil || sidecar.Index != 2 || sidecar.Slot != 12 {
+		t.Errorf("unexpected blob sidecar %+v, error %v", sidecar, err)
+	}
+
+	event = constypes.EventResponse{Event: constypes.EventContributionAndProof, Data: []byte(`{"message":{"aggregator_index":"3","contribution":{"slot":"12","beacon_block_root":"0x01","subcommittee_index":"1","aggregation_bits":"0xff","signature":"0x02"},"selection_proof":"0x03"},"signature":"0x04"}`)}
+	contribution, err := event.ContributionAndProof()
+	if err != nil || contribution.Message.AggregatorIndex != 3 || contribution.Message.Contribution.SubcommitteeIndex != 1 {
+		t.Errorf("unexpected contribution %+v, error %v", contribution, err)
+	}
+}
+
+func TestGossipInclusionRange(t *testing.T) {
+	previousConfig := utils.Config
+	t.Cleanup(func() { utils.Config = previousConfig })
+	utils.Config = &types.Config{}
+	utils.Config.Chain.ClConfig.SlotsPerEpoch = 8
+
+	tests := []struct {
+		name               string
+		lastFinalizedEpoch uint64
+		finalizedEpoch     uint64
+		retention          uint64
+		start              uint64
+		end                uint64
+	}{
+		{"first checkpoint", 0, 10, 225, 64, 80},
+		{"first checkpoint after genesis", 0, 1, 225, 0, 8},
+		{"next checkpoint", 10, 11, 225, 72, 88},
+		{"checkpoint after a period without finality", 10, 20, 225, 72, 160},
+		{"checkpoint beyond the retention", 10, 300, 225, 600, 2400},
+		{"repeated checkpoint", 11, 11, 225, 80, 88},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := gossipInclusionRange(tt.lastFinalizedEpoch, tt.finalizedEpoch, tt.retention)
+			if start != tt.start || end != tt.end {
+				t.Errorf("expected slots %d to %d, got %d to %d", tt.start, tt.end, start, end)
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package modules
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	constypes "github.com/gobitfly/beaconchain/pkg/consapi/types"
+	"github.com/lib/pq"
+	"golang.org/x/sync/errgroup"
+)
+
+// pendingQueuesExporter snapshots the electra deposit, partial withdrawal and consolidation queues of the beacon state every epoch
+// and estimates when each queued item will be processed based on the churn limits
+type pendingQueuesExporter struct {
+	ModuleContext ModuleContext
+	ExportMutex   *sync.Mutex
+}
+
+func NewPendingQueuesExporter(moduleContext ModuleContext) ModuleInterface {
+	return &pendingQueuesExporter{
+		ModuleContext: moduleContext,
+		ExportMutex:   &sync.Mutex{},
+	}
+}
+
+func (d *pendingQueuesExporter) Init() error {
+	return nil // nop
+}
+
+func (d *pendingQueuesExporter) GetName() string {
+	return "PendingQueues-Exporter"
+}
+
+func (d *pendingQueuesExporter) OnChainReorg(event *constypes.StandardEventChainReorg) (err error) {
+	return nil // nop
+}
+
+func (d *pendingQueuesExporter) OnFinalizedCheckpoint(event *constypes.StandardFinalizedCheckpointResponse) (err error) {
+	return nil // nop
+}
+
+func (d *pendingQueuesExporter) OnHead(event *constypes.StandardEventHeadResponse) (err error) {
+	epoch := utils.EpochOfSlot(event.Slot)
+	if !event.EpochTransition || epoch < utils.Config.Chain.ClConfig.ElectraForkEpoch {
+		return nil
+	}
+
+	// if mutex is locked, return early
+	if !d.ExportMutex.TryLock() {
+		log.Infof("pending queues exporter is already running")
+		return nil
+	}
+	defer d.ExportMutex.Unlock()
+
+	start := time.Now()
+	err = d.exportPendingQueues(event.Slot)
+	if err != nil {
+		return fmt.Errorf("error exporting pending queues of epoch %v: %w", epoch, err)
+	}
+	log.InfoWithFields(log.Fields{"epoch": epoch, "duration": time.Since(start)}, "exported pending queues")
+	return nil
+}
+
+type pendingQueuesChurn struct {
+	TotalActiveBalance  uint64
+	ActivationExitChurn uint64
+	ConsolidationChurn  uint64
+}
+
+// getPendingQueuesChurn calculates the per epoch churn limits as defined by get_activation_exit_churn_limit and get_consolidation_churn_limit
+func getPendingQueuesChurn(totalActiveBalance uint64) pendingQueuesChurn {
+	cfg := utils.Config.Chain.ClConfig
+
+	balanceChurn := cfg.MinPerEpochChurnLimitElectra
+	if cfg.ChurnLimitQuotient > 0 && totalActiveBalance/cfg.ChurnLimitQuotient > balanceChurn {
+		balanceChurn = totalActiveBalance / cfg.ChurnLimitQuotient
+	}
+	if cfg.EffectiveBalanceIncrement > 0 {
+		balanceChurn -= balanceChurn % cfg.EffectiveBalanceIncrement
+	}
+
+	activationExitChurn := min(balanceChurn, cfg.MaxPerEpochActivationExitChurnLimit)
+	return pendingQueuesChurn{
+		TotalActiveBalance:  totalActiveBalance,
+		ActivationExitChurn: activationExitChurn,
+		ConsolidationChurn:  balanceChurn - activationExitChurn,
+	}
+}
+
+func (d *pendingQueuesExporter) exportPendingQueues(slot uint64) error {
+	epoch := utils.EpochOfSlot(slot)
+
+	var deposits *constypes.StandardPendingDepositsResponse
+	var partialWithdrawals *constypes.StandardPendingPartialWithdrawalsResponse
+	var consolidations *constypes.StandardPendingConsolidationsResponse
+	var totalActiveBalance uint64
+
+	g := errgroup.Group{}
+	g.Go(func() error {
+		var err error
+		deposits, err = d.ModuleContext.CL.GetPendingDeposits(slot)
+		if err != nil {
+			return fmt.Errorf("error retrieving pending deposits: %w", err)
+		}
+		return nil
+	})
+	g.Go(func() error {
+		var err error
+		partialWithdrawals, err = d.ModuleContext.CL.GetPendingPartialWithdrawals(slot)
+		if err != nil {
+			return fmt.Errorf("error retrieving pending partial withdrawals: %w", err)
+		}
+		return nil
+	})
+	g.Go(func() error {
+		var err error
+		consolidations, err = d.ModuleContext.CL.GetPendingConsolidations(slot)
+		if err != nil {
+			return fmt.Errorf("error retrieving pending consolidations: %w", err)
+		}
+		return nil
+	})
+	g.Go(func() error {
+		// the eligible ether of an epoch is the total effective balance of the active validators
+		err := db.ReaderDb.Get(&totalActiveBalance, `SELECT eligibleether FROM epochs WHERE eligibleether > 0 ORDER BY epoch DESC LIMIT 1`)
+		if err != nil && err != sql.ErrNoRows {
+			return fmt.Errorf("error retrieving total active balance: %w", err)
+		}
+		return nil
+	})
+	err := g.Wait()
+	if err != nil {
+		return err
+	}
+
+	churn := getPendingQueuesChurn(totalActiveBalance)
+
+	sourceIndices := make([]uint64, len(consolidations.Data))
+	for i, consolidation := range consolidations.Data {
+		sourceIndices[i] = consolidation.SourceIndex
+	}
+	withdrawableEpochs, err := getWithdrawableEpochs(sourceIndices)
+	if err != nil {
+		return err
+	}
+
+	depositEpochs := estimatePendingDepositEpochs(deposits.Data, epoch, churn.ActivationExitChurn)
+	depositRows := make([][]interface{}, len(deposits.Data))
+	for i, deposit := range deposits.Data {
+		depositRows[i] = []interface{}{epoch, i, []byte(deposit.Pubkey), []byte(deposit.WithdrawalCredentials), deposit.Amount, deposit.Slot, depositEpochs[i]}
+	}
+
+	withdrawalEpochs := estimatePendingPartialWithdrawalEpochs(partialWithdrawals.Data, slot)
+	withdrawalRows := make([][]interface{}, len(partialWithdrawals.Data))
+	for i, withdrawal := range partialWithdrawals.Data {
+		withdrawalRows[i] = []interface{}{epoch, i, withdrawal.ValidatorIndex, withdrawal.Amount, withdrawal.WithdrawableEpoch, withdrawalEpochs[i]}
+	}
+
+	consolidationEpochs := estimatePendingConsolidationEpochs(consolidations.Data, withdrawableEpochs, epoch)
+	consolidationRows := make([][]interface{}, len(consolidations.Data))
+	for i, consolidation := range consolidations.Data {
+		consolidationRows[i] = []interface{}{epoch, i, consolidation.SourceIndex, consolidation.TargetIndex, consolidationEpochs[i]}
+	}
+
+	// the queues of an epoch only become visible once its snapshot row is written, older snapshots are removed afterwards
+	for _, table := range []string{"pending_deposits", "pending_partial_withdrawals", "pending_consolidations"} {
+		// clean up leftovers of a previous, interrupted export of the same epoch
+		_, err = db.WriterDb.Exec(fmt.Sprintf(`DELETE FROM %s WHERE epoch = $1`, table), epoch)
+		if err != nil {
+			return fmt.Errorf("error removing pending queue rows from %s: %w", table, err)
+		}
+	}
+	err = db.CopyToTable("pending_deposits", []string{"epoch", "queue_index", "pubkey", "withdrawal_credentials", "amount", "slot", "estimated_epoch"}, depositRows)
+	if err != nil {
+		return err
+	}
+	err = db.CopyToTable("pending_partial_withdrawals", []string{"epoch", "queue_index", "validator_index", "amount", "withdrawable_epoch", "estimated_epoch"}, withdrawalRows)
+	if err != nil {
+		return err
+	}
+	err = db.CopyToTable("pending_consolidations", []string{"epoch", "queue_index", "source_index", "target_index", "estimated_epoch"}, consolidationRows)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.WriterDb.Beginx()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	_, err = tx.Exec(`
+		INSERT INTO pending_queues_snapshots (epoch, total_active_balance, activation_exit_churn, consolidation_churn)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (epoch) DO UPDATE SET
+			total_active_balance = excluded.total_active_balance,
+			activation_exit_churn = excluded.activation_exit_churn,
+			consolidation_churn = excluded.consolidation_churn,
+			created_ts = NOW()`,
+		epoch, churn.TotalActiveBalance, churn.ActivationExitChurn, churn.ConsolidationChurn)
+	if err != nil {
+		return fmt.Errorf("error saving pending queues snapshot: %w", err)
+	}
+	for _, table := range []string{"pending_deposits", "pending_partial_withdrawals", "pending_consolidations", "pending_queues_snapshots"} {
+		_, err = tx.Exec(fmt.Sprintf(`DELETE FROM %s WHERE epoch < $1`, table), epoch)
+		if err != nil {
+			return fmt.Errorf("error removing old snapshots from %s: %w", table, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// getWithdrawableEpochs returns the withdrawable epochs of the given validators, validators without a withdrawable epoch are omitted
+func getWithdrawableEpochs(validators []uint64) (map[uint64]uint64, error) {
+	withdrawableEpochs := make(map[uint64]uint64, len(validators))
+	if len(validators) == 0 {
+		return withdrawableEpochs, nil
+	}
+
+	var rows []struct {
+		ValidatorIndex    uint64 `db:"validatorindex"`
+		WithdrawableEpoch uint64 `db:"withdrawableepoch"`
+	}
+	err := db.ReaderDb.Select(&rows, `SELECT validatorindex, withdrawableepoch FROM validators WHERE validatorindex = ANY($1)`, pq.Array(validators))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving withdrawable epochs: %w", err)
+	}
+	for _, row := range rows {
+		if row.WithdrawableEpoch == db.MaxSqlNumber {
+			continue
+		}
+		withdrawableEpochs[row.ValidatorIndex] = row.WithdrawableEpoch
+	}
+	return withdrawableEpochs, nil
+}
+
+// estimatePendingDepositEpochs simulates process_pending_deposits, each epoch at most MAX_PENDING_DEPOSITS_PER_EPOCH deposits are applied
+// as long as the activation churn allows it. Unused churn is carried over to the next epoch if a deposit exceeded it.
+// Deposits are only processed once the slot they were included in is finalized.
+func estimatePendingDepositEpochs(deposits []constypes.PendingDeposit, epoch uint64, churn uint64) []uint64 {
+	result := make([]uint64, len(deposits))
+	if churn == 0 {
+		return result
+	}
+
+	maxPerEpoch := utils.Config.Chain.ClConfig.MaxPendingDepositsPerEpoch
+	available := churn
+	processed := uint64(0)
+	count := uint64(0)
+	for i, deposit := range deposits {
+		// it normally takes two epochs to finalize
+		if finalizedEpoch := utils.EpochOfSlot(deposit.Slot) + 2; deposit.Slot > 0 && epoch < finalizedEpoch {
+			epoch = finalizedEpoch
+			available, processed, count = churn, 0, 0
+		}
+		for count >= maxPerEpoch || processed+deposit.Amount > available {
+			carry := uint64(0)
+			if count < maxPerEpoch {
+				carry = available - processed
+			}
+			epoch++
+			available, processed, count = carry+churn, 0, 0
+		}
+		processed += deposit.Amount
+		count++
+		// deposits are applied during the transition into the next epoch
+		result[i] = epoch + 1
+	}
+	return result
+}
+
+// estimatePendingPartialWithdrawalEpochs simulates the withdrawal sweep, each block includes at most MAX_PENDING_PARTIALS_PER_WITHDRAWALS_SWEEP
+// pending partial withdrawals whose withdrawable epoch has been reached
+func estimatePendingPartialWithdrawalEpochs(withdrawals []constypes.PendingPartialWithdrawal, slot uint64) []uint64 {
+	result := make([]uint64, len(withdrawals))
+
+	maxPerSlot := max(utils.Config.Chain.ClConfig.MaxPendingPartialsPerWithdrawalsSweep, 1)
+	nextSlot := slot + 1
+	count := uint64(0)
+	for i, withdrawal := range withdrawals {
+		if firstSlot := withdrawal.WithdrawableEpoch * utils.Config.Chain.ClConfig.SlotsPerEpoch; firstSlot > nextSlot {
+			nextSlot, count = firstSlot, 0
+		}
+		result[i] = utils.EpochOfSlot(nextSlot)
+		count++
+		if count >= maxPerSlot {
+			nextSlot, count = nextSlot+1, 0
+		}
+	}
+	return result
+}
+
+// estimatePendingConsolidationEpochs simulates process_pending_consolidations, consolidations are applied in order once the source validator
+// became withdrawable, so a consolidation can not be processed before the ones queued in front of it
+func estimatePendingConsolidationEpochs(consolidations []constypes.PendingConsolidation, withdrawableEpochs map[uint64]uint64, epoch uint64) []sql.NullInt64 {
+	result := make([]sql.NullInt64, len(consolidations))
+
+	next := epoch + 1
+	for i, consolidation := range consolidations {
+		withdrawableEpoch, ok := withdrawableEpochs[consolidation.SourceIndex]
+		if !ok {
+			// all following consolidations are blocked by this one
+			break
+		}
+		next = max(next, withdrawableEpoch)
+		result[i] = sql.NullInt64{Int64: int64(next), Valid: true}
+	}
+	return result
+}
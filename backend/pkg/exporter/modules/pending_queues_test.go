@@ -0,0 +1,153 @@
+package modules
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	constypes "github.com/gobitfly/beaconchain/pkg/consapi/types"
+)
+
+// setPendingQueuesConfig sets the mainnet electra churn and queue parameters for the duration of the test
+func setPendingQueuesConfig(t *testing.T) {
+	previousConfig := utils.Config
+	t.Cleanup(func() { utils.Config = previousConfig })
+	utils.Config = &types.Config{}
+	utils.Config.Chain.ClConfig = types.ClChainConfig{
+		SlotsPerEpoch:                         32,
+		EffectiveBalanceIncrement:             1000000000,
+		ChurnLimitQuotient:                    65536,
+		MinPerEpochChurnLimitElectra:          128000000000,
+		MaxPerEpochActivationExitChurnLimit:   256000000000,
+		MaxPendingDepositsPerEpoch:            16,
+		MaxPendingPartialsPerWithdrawalsSweep: 8,
+	}
+}
+
+func TestGetPendingQueuesChurn(t *testing.T) {
+	setPendingQueuesConfig(t)
+
+	tests := []struct {
+		name                string
+		totalActiveBalance  uint64
+		activationExitChurn uint64
+		consolidationChurn  uint64
+	}{
+		{"no active balance uses the minimum churn", 0, 128000000000, 0},
+		{"below the minimum churn", 5000000000000000, 128000000000, 0},
+		{"rounded down to the balance increment", 10000000000000000, 152000000000, 0},
+		{"above the activation exit limit", 34000000000000000, 256000000000, 262000000000},
+	}
+	for _, tt := range tests {
+		churn := getPendingQueuesChurn(tt.totalActiveBalance)
+		if churn.TotalActiveBalance != tt.totalActiveBalance {
+			t.Errorf("%s: expected total active balance %d, got %d", tt.name, tt.totalActiveBalance, churn.TotalActiveBalance)
+		}
+		if churn.ActivationExitChurn != tt.activationExitChurn {
+			t.Errorf("%s: expected activation exit churn %d, got %d", tt.name, tt.activationExitChurn, churn.ActivationExitChurn)
+		}
+		if churn.ConsolidationChurn != tt.consolidationChurn {
+			t.Errorf("%s: expected consolidation churn %d, got %d", tt.name, tt.consolidationChurn, churn.ConsolidationChurn)
+		}
+	}
+}
+
+func TestEstimatePendingDepositEpochs(t *testing.T) {
+	setPendingQueuesConfig(t)
+
+	deposits := func(slot uint64, amounts ...uint64) []constypes.PendingDeposit {
+		result := make([]constypes.PendingDeposit, len(amounts))
+		for i, amount := range amounts {
+			result[i] = constypes.PendingDeposit{Amount: amount * 1000000000, Slot: slot}
+		}
+		return result
+	}
+	repeat := func(amount uint64, n int) []uint64 {
+		result := make([]uint64, n)
+		for i := range result {
+			result[i] = amount
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		deposits []constypes.PendingDeposit
+		churn    uint64
+		expected []uint64
+	}{
+		{"no churn", deposits(0, 32, 32), 0, []uint64{0, 0}},
+		{"deposits up to the churn are applied in the next epoch", deposits(0, 32, 32, 32), 64000000000, []uint64{101, 101, 102}},
+		{"unused churn is carried over", deposits(0, 48, 48, 48), 64000000000, []uint64{101, 102, 103}},
+		{"deposit exceeding the churn waits for the carried churn", deposits(0, 200), 64000000000, []uint64{104}},
+		{"at most max pending deposits per epoch", deposits(0, repeat(1, 17)...), 1000000000000, append(repeat(101, 16), 102)},
+		{"deposits wait for the finalization of their slot", append(deposits(0, 32), deposits(105*32, 32)...), 64000000000, []uint64{101, 108}},
+	}
+	for _, tt := range tests {
+		epochs := estimatePendingDepositEpochs(tt.deposits, 100, tt.churn)
+		if len(epochs) != len(tt.expected) {
+			t.Fatalf("%s: expected %d epochs, got %d", tt.name, len(tt.expected), len(epochs))
+		}
+		for i := range tt.expected {
+			if epochs[i] != tt.expected[i] {
+				t.Errorf("%s: deposit %d: expected epoch %d, got %d", tt.name, i, tt.expected[i], epochs[i])
+			}
+		}
+	}
+}
+
+func TestEstimatePendingPartialWithdrawalEpochs(t *testing.T) {
+	setPendingQueuesConfig(t)
+
+	withdrawals := make([]constypes.PendingPartialWithdrawal, 0, 11)
+	for i := 0; i < 9; i++ {
+		withdrawals = append(withdrawals, constypes.PendingPartialWithdrawal{ValidatorIndex: uint64(i), WithdrawableEpoch: 100})
+	}
+	// the sweep stops at the first withdrawal that is not withdrawable yet, so the following one has to wait as well
+	withdrawals = append(withdrawals,
+		constypes.PendingPartialWithdrawal{ValidatorIndex: 9, WithdrawableEpoch: 110},
+		constypes.PendingPartialWithdrawal{ValidatorIndex: 10, WithdrawableEpoch: 105},
+	)
+
+	// the next slot is the last one of epoch 100 and only fits 8 withdrawals
+	expected := []uint64{100, 100, 100, 100, 100, 100, 100, 100, 101, 110, 110}
+	epochs := estimatePendingPartialWithdrawalEpochs(withdrawals, 100*32+30)
+	if len(epochs) != len(expected) {
+		t.Fatalf("expected %d epochs, got %d", len(expected), len(epochs))
+	}
+	for i := range expected {
+		if epochs[i] != expected[i] {
+			t.Errorf("withdrawal %d: expected epoch %d, got %d", i, expected[i], epochs[i])
+		}
+	}
+}
+
+func TestEstimatePendingConsolidationEpochs(t *testing.T) {
+	consolidations := []constypes.PendingConsolidation{
+		{SourceIndex: 1, TargetIndex: 10},
+		{SourceIndex: 2, TargetIndex: 10},
+		{SourceIndex: 3, TargetIndex: 11},
+		{SourceIndex: 4, TargetIndex: 11},
+		{SourceIndex: 5, TargetIndex: 12},
+	}
+	withdrawableEpochs := map[uint64]uint64{1: 90, 2: 120, 3: 110, 5: 95}
+
+	// source 4 is not withdrawable yet, which blocks all consolidations queued behind it
+	expected := []sql.NullInt64{
+		{Int64: 101, Valid: true},
+		{Int64: 120, Valid: true},
+		{Int64: 120, Valid: true},
+		{},
+		{},
+	}
+	epochs := estimatePendingConsolidationEpochs(consolidations, withdrawableEpochs, 100)
+	if len(epochs) != len(expected) {
+		t.Fatalf("expected %d epochs, got %d", len(expected), len(epochs))
+	}
+	for i := range expected {
+		if epochs[i] != expected[i] {
+			t.Errorf("consolidation %d: expected %v, got %v", i, expected[i], epochs[i])
+		}
+	}
+}
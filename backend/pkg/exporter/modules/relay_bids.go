@@ -0,0 +1,275 @@
+package modules
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/lib/pq"
+)
+
+// relays only serve the received bids of recent slots, if the export fell further behind the slots in between are skipped
+const maxRelayBidSlotsPerExport = 32
+
+func fetchReceivedBids(r types.Relay, slot uint64) ([]BidTrace, error) {
+	var bids []BidTrace
+	url := fmt.Sprintf("%s/relay/v1/data/bidtraces/builder_blocks_received?slot=%d", r.Endpoint, slot)
+	client := &http.Client{
+		Timeout: time.Second * 30,
+	}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving received bids for relay: %v, slot: %v, url: %v: %w", r.ID, slot, url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error retrieving received bids for relay: %v, slot: %v, url: %v: status %v", r.ID, slot, url, resp.StatusCode)
+	}
+	err = json.NewDecoder(resp.Body).Decode(&bids)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding json for received bids for relay: %v, slot: %v, url: %v: %w", r.ID, slot, url, err)
+	}
+	return bids, nil
+}
+
+// exportRelayBids exports the bids the relay received for the slots since the last export up to the previous slot
+func exportRelayBids(r types.Relay, mux *sync.Mutex) error {
+	headSlot := utils.TimeToSlot(uint64(time.Now().Unix()))
+	if headSlot == 0 {
+		return nil
+	}
+	lastSlot := headSlot - 1
+	firstSlot := lastSlot - min(lastSlot, maxRelayBidSlotsPerExport-1)
+	if r.LastBidSlot.Valid && uint64(r.LastBidSlot.Int64) >= firstSlot {
+		firstSlot = uint64(r.LastBidSlot.Int64) + 1
+	}
+	if firstSlot > lastSlot {
+		return nil
+	}
+
+	maxBids := utils.Config.MevBoostRelayExporter.MaxBidsPerSlot
+	if maxBids == 0 {
+		maxBids = 100
+	}
+	bidsBySlot := make(map[uint64][]BidTrace)
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		bids, err := fetchReceivedBids(r, slot)
+		if err != nil {
+			if slot == firstSlot {
+				return err
+			}
+			// keep what we have, the export continues with this slot next time
+			log.WarnWithFields(log.Fields{"relay": r.ID, "slot": slot}, err.Error())
+			lastSlot = slot - 1
+			break
+		}
+		bidsBySlot[slot] = capBidsPerSlot(validRelayBids(r, slot, bids), int(maxBids))
+		// sleep for a bit to not kill the relay
+		time.Sleep(time.Millisecond * 100)
+	}
+
+	mux.Lock()
+	defer mux.Unlock()
+	return storeRelayBids(r, firstSlot, lastSlot, bidsBySlot)
+}
+
+func storeRelayBids(r types.Relay, firstSlot, lastSlot uint64, bidsBySlot map[uint64][]BidTrace) error {
+	tx, err := db.WriterDb.Beginx()
+	if err != nil {
+		return fmt.Errorf("error starting db transaction: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		bids := bidsBySlot[slot]
+		if len(bids) == 0 {
+			continue
+		}
+		blockHashes := make([][]byte, 0, len(bids))
+		parentHashes := make([][]byte, 0, len(bids))
+		builders := make([][]byte, 0, len(bids))
+		proposers := make([][]byte, 0, len(bids))
+		values := make([]string, 0, len(bids))
+		numTxs := make([]int64, 0, len(bids))
+		timestamps := make([]int64, 0, len(bids))
+		for _, bid := range bids {
+			decoded, err := decodeRelayBid(bid)
+			if err != nil {
+				log.WarnWithFields(log.Fields{"relay": r.ID, "slot": slot}, err.Error())
+				continue
+			}
+			blockHashes = append(blockHashes, decoded.BlockHash)
+			parentHashes = append(parentHashes, decoded.ParentHash)
+			builders = append(builders, decoded.BuilderPubkey)
+			proposers = append(proposers, decoded.ProposerPubkey)
+			values = append(values, bidValue(bid).String())
+			numTxs = append(numTxs, int64(bid.NumTx))
+			timestamps = append(timestamps, int64(bid.TimestampMs))
+		}
+		_, err = tx.Exec(`
+			INSERT INTO relays_bids (tag_id, slot, block_hash, parent_hash, builder_pubkey, proposer_pubkey, value, num_tx, timestamp_ms)
+			SELECT $1, $2, b.* FROM unnest($3::bytea[], $4::bytea[], $5::bytea[], $6::bytea[], $7::numeric[], $8::int[], $9::bigint[]) AS b
+			ON CONFLICT DO NOTHING`,
+			r.ID, slot, pq.ByteaArray(blockHashes), pq.ByteaArray(parentHashes), pq.ByteaArray(builders), pq.ByteaArray(proposers),
+			pq.StringArray(values), pq.Int64Array(numTxs), pq.Int64Array(timestamps))
+		if err != nil {
+			return fmt.Errorf("error inserting bids of slot %v: %w", slot, err)
+		}
+
+		for _, bid := range bestBidsByParent(bids) {
+			decoded, err := decodeRelayBid(bid)
+			if err != nil {
+				continue
+			}
+			_, err = tx.Exec(`
+				INSERT INTO relays_best_bids (slot, parent_hash, block_hash, builder_pubkey, tag_id, value)
+				VALUES ($1, $2, $3, $4, $5, $6)
+				ON CONFLICT (slot, parent_hash) DO UPDATE SET
+					block_hash = EXCLUDED.block_hash,
+					builder_pubkey = EXCLUDED.builder_pubkey,
+					tag_id = EXCLUDED.tag_id,
+					value = EXCLUDED.value
+				WHERE EXCLUDED.value > relays_best_bids.value`,
+				slot, decoded.ParentHash, decoded.BlockHash, decoded.BuilderPubkey, r.ID, bidValue(bid).String())
+			if err != nil {
+				return fmt.Errorf("error updating best bid of slot %v: %w", slot, err)
+			}
+		}
+	}
+
+	_, err = tx.Exec(`UPDATE relays SET last_bid_slot = $1 WHERE tag_id = $2 AND endpoint = $3`, lastSlot, r.ID, r.Endpoint)
+	if err != nil {
+		return fmt.Errorf("error updating last bid slot: %w", err)
+	}
+	return tx.Commit()
+}
+
+// pruneRelayBids removes the bids older than the retention, the best bids of the slots are kept
+func pruneRelayBids() {
+	retentionDays := utils.Config.MevBoostRelayExporter.BidsRetentionDays
+	if retentionDays == 0 {
+		retentionDays = 7
+	}
+	retentionSlots := retentionDays * utils.EpochsPerDay() * utils.Config.Chain.ClConfig.SlotsPerEpoch
+	headSlot := utils.TimeToSlot(uint64(time.Now().Unix()))
+	if headSlot <= retentionSlots {
+		return
+	}
+	_, err := db.WriterDb.Exec(`DELETE FROM relays_bids WHERE slot < $1`, headSlot-retentionSlots)
+	if err != nil {
+		log.Error(err, "error removing old relay bids", 0)
+	}
+}
+
+// decodedRelayBid holds the hex fields of a bid, relays pass on what the builders submit so they have to be checked
+type decodedRelayBid struct {
+	BlockHash      []byte
+	ParentHash     []byte
+	BuilderPubkey  []byte
+	ProposerPubkey []byte
+}
+
+func decodeRelayBid(bid BidTrace) (*decodedRelayBid, error) {
+	var result decodedRelayBid
+	for _, field := range []struct {
+		name   string
+		value  string
+		length int
+		target *[]byte
+	}{
+		{"block_hash", bid.BlockHash, 32, &result.BlockHash},
+		{"parent_hash", bid.ParentHash, 32, &result.ParentHash},
+		{"builder_pubkey", bid.BuilderPubkey, 48, &result.BuilderPubkey},
+		{"proposer_pubkey", bid.ProposerPubkey, 48, &result.ProposerPubkey},
+	} {
+		decoded, err := hexutil.Decode(field.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q of bid: %w", field.name, field.value, err)
+		}
+		if len(decoded) != field.length {
+			return nil, fmt.Errorf("invalid %s %q of bid: expected %d bytes, got %d", field.name, field.value, field.length, len(decoded))
+		}
+		*field.target = decoded
+	}
+	return &result, nil
+}
+
+// validRelayBids skips the bids that can't be decoded, a single malformed bid must not stop the export
+func validRelayBids(r types.Relay, slot uint64, bids []BidTrace) []BidTrace {
+	result := make([]BidTrace, 0, len(bids))
+	for _, bid := range bids {
+		if _, err := decodeRelayBid(bid); err != nil {
+			log.WarnWithFields(log.Fields{"relay": r.ID, "slot": slot}, err.Error())
+			continue
+		}
+		result = append(result, bid)
+	}
+	return result
+}
+
+func bidValue(bid BidTrace) *big.Int {
+	if bid.Value.Int == nil {
+		return new(big.Int)
+	}
+	return bid.Value.BigInt()
+}
+
+// capBidsPerSlot keeps the best bid of every builder and fills up with the next highest bids until maxBids bids are kept.
+// builders resubmit improved blocks many times per slot, keeping their best bid makes sure every builder that took part
+// in the auction is accounted for.
+func capBidsPerSlot(bids []BidTrace, maxBids int) []BidTrace {
+	sorted := slices.Clone(bids)
+	slices.SortStableFunc(sorted, func(a, b BidTrace) int {
+		return bidValue(b).Cmp(bidValue(a))
+	})
+
+	kept := make([]bool, len(sorted))
+	seenBuilders := make(map[string]bool)
+	count := 0
+	for i, bid := range sorted {
+		if !seenBuilders[bid.BuilderPubkey] {
+			seenBuilders[bid.BuilderPubkey] = true
+			kept[i] = true
+			count++
+		}
+	}
+	for i := range sorted {
+		if count >= maxBids {
+			break
+		}
+		if !kept[i] {
+			kept[i] = true
+			count++
+		}
+	}
+
+	result := make([]BidTrace, 0, count)
+	for i, bid := range sorted {
+		if kept[i] {
+			result = append(result, bid)
+		}
+	}
+	return result
+}
+
+// bestBidsByParent returns the highest bid for every parent block the builders built on
+func bestBidsByParent(bids []BidTrace) map[string]BidTrace {
+	best := make(map[string]BidTrace)
+	for _, bid := range bids {
+		current, ok := best[bid.ParentHash]
+		if !ok || bidValue(bid).Cmp(bidValue(current)) > 0 {
+			best[bid.ParentHash] = bid
+		}
+	}
+	return best
+}
@@ -0,0 +1,105 @@
+package modules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+)
+
+func testBid(t *testing.T, builder, parent string, value string) BidTrace {
+	t.Helper()
+	bid := BidTrace{BuilderPubkey: builder, ParentHash: parent, BlockHash: builder + value}
+	if err := bid.Value.Set(value); err != nil {
+		t.Fatal(err)
+	}
+	return bid
+}
+
+func TestCapBidsPerSlot(t *testing.T) {
+	bids := []BidTrace{
+		testBid(t, "a", "p", "100"),
+		testBid(t, "a", "p", "300"),
+		testBid(t, "a", "p", "200"),
+		testBid(t, "b", "p", "50"),
+		testBid(t, "c", "p", "10"),
+		testBid(t, "b", "p", "20"),
+	}
+
+	// the best bid of every builder is kept even if the cap is lower than the number of builders
+	expected := []string{"a300", "b50", "c10"}
+	capped := capBidsPerSlot(bids, 2)
+	if len(capped) != len(expected) {
+		t.Fatalf("expected %d bids, got %d", len(expected), len(capped))
+	}
+	for i := range expected {
+		if capped[i].BlockHash != expected[i] {
+			t.Errorf("bid %d: expected %s, got %s", i, expected[i], capped[i].BlockHash)
+		}
+	}
+
+	expected = []string{"a300", "a200", "b50", "c10"}
+	capped = capBidsPerSlot(bids, 4)
+	if len(capped) != len(expected) {
+		t.Fatalf("expected %d bids, got %d", len(expected), len(capped))
+	}
+	for i := range expected {
+		if capped[i].BlockHash != expected[i] {
+			t.Errorf("bid %d: expected %s, got %s", i, expected[i], capped[i].BlockHash)
+		}
+	}
+}
+
+func TestBestBidsByParent(t *testing.T) {
+	bids := []BidTrace{
+		testBid(t, "a", "p1", "100"),
+		testBid(t, "b", "p1", "300"),
+		testBid(t, "c", "p2", "1000"),
+		{BuilderPubkey: "d", ParentHash: "p2", Value: types.WeiString{}},
+	}
+	best := bestBidsByParent(bids)
+	if len(best) != 2 || best["p1"].BuilderPubkey != "b" || best["p2"].BuilderPubkey != "c" {
+		t.Errorf("unexpected best bids %+v", best)
+	}
+}
+
+func TestValidRelayBids(t *testing.T) {
+	hash := "0x" + strings.Repeat("ab", 32)
+	pubkey := "0x" + strings.Repeat("cd", 48)
+	valid := BidTrace{BlockHash: hash, ParentHash: hash, BuilderPubkey: pubkey, ProposerPubkey: pubkey}
+
+	tests := []struct {
+		name  string
+		apply func(bid *BidTrace)
+		valid bool
+	}{
+		{"valid", func(bid *BidTrace) {}, true},
+		{"invalid hex", func(bid *BidTrace) { bid.BlockHash = "0xzz" }, false},
+		{"missing prefix", func(bid *BidTrace) { bid.ParentHash = strings.Repeat("ab", 32) }, false},
+		{"empty", func(bid *BidTrace) { bid.ProposerPubkey = "" }, false},
+		{"short hash", func(bid *BidTrace) { bid.BlockHash = "0xabcd" }, false},
+		{"hash as pubkey", func(bid *BidTrace) { bid.BuilderPubkey = hash }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bid := valid
+			tt.apply(&bid)
+			result := validRelayBids(types.Relay{ID: "relay"}, 1, []BidTrace{valid, bid})
+			expected := 1
+			if tt.valid {
+				expected = 2
+			}
+			if len(result) != expected {
+				t.Errorf("expected %d valid bids, got %d", expected, len(result))
+			}
+		})
+	}
+
+	decoded, err := decodeRelayBid(valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.BlockHash) != 32 || len(decoded.BuilderPubkey) != 48 || decoded.ProposerPubkey[0] != 0xcd {
+		t.Errorf("unexpected decoded bid %+v", decoded)
+	}
+}
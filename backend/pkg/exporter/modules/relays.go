@@ -25,6 +25,9 @@ type BidTrace struct {
 	GasLimit             uint64          `json:"gas_limit,string"`
 	GasUsed              uint64          `json:"gas_used,string"`
 	Value                types.WeiString `json:"value"`
+	// only set for the bids received by the relay
+	NumTx       uint64 `json:"num_tx,string"`
+	TimestampMs uint64 `json:"timestamp_ms,string"`
 }
 
 func mevBoostRelaysExporter() {
@@ -32,7 +35,7 @@ func mevBoostRelaysExporter() {
 	for {
 		// we retrieve the relays from the db each loop to prevent having to restart the exporter for changes
 		relays = nil
-		err := db.ReaderDb.Select(&relays, `select tag_id, endpoint, public_link, is_censoring, is_ethical, export_failure_count, last_export_try_ts, last_export_success_ts from relays`)
+		err := db.ReaderDb.Select(&relays, `select tag_id, endpoint, public_link, is_censoring, is_ethical, export_failure_count, last_export_try_ts, last_export_success_ts, last_bid_slot from relays`)
 		wg := &sync.WaitGroup{}
 		mux := &sync.Mutex{}
 		if err == nil {
@@ -47,6 +50,7 @@ func mevBoostRelaysExporter() {
 			log.Error(err, "failed to retrieve relays from db", 0)
 		}
 		wg.Wait()
+		pruneRelayBids()
 		time.Sleep(time.Minute)
 	}
 }
@@ -98,6 +102,12 @@ func singleRelayExport(r types.Relay, wg *sync.WaitGroup, mux *sync.Mutex) {
 		log.Error(err, "could not update successful relay eport", 0, map[string]interface{}{"relay": r.ID})
 	}
 
+	// not every relay serves the received bids, so a failure does not delay the export of the delivered payloads
+	err = exportRelayBids(r, mux)
+	if err != nil {
+		log.WarnWithFields(log.Fields{"relay": r.ID}, fmt.Sprintf("failed to export bids for relay: %v", err))
+	}
+
 	log.Infof("finished syncing payloads from relay")
 }
 
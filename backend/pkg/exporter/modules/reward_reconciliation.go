@@ -0,0 +1,661 @@
+package modules
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/metrics"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/gobitfly/beaconchain/pkg/consapi/network"
+	constypes "github.com/gobitfly/beaconchain/pkg/consapi/types"
+	"github.com/gobitfly/beaconchain/pkg/exporter/rewards"
+	"github.com/gobitfly/beaconchain/pkg/monitoring/constants"
+	"github.com/gobitfly/beaconchain/pkg/monitoring/services"
+	"github.com/lib/pq"
+	"golang.org/x/sync/errgroup"
+)
+
+// rewardReconciler recomputes the rewards of finalized epochs following the consensus specs and compares them per
+// validator with the reward endpoints of the beacon node, which the dashboard data is based on. Mismatches are stored
+// per epoch, validator and reward component and reported to the monitoring.
+// Only the blocks and the finality are taken from the node, the effective balances, the committees and the
+// participation are read from the data the exporter stored, so that the check does not rely on the node it checks.
+type rewardReconciler struct {
+	ModuleContext ModuleContext
+	Mutex         *sync.Mutex
+	lastEpoch     uint64 // last reconciled epoch, 0 if none was reconciled yet
+}
+
+// at most this many epochs are caught up with after a restart
+const rewardReconciliationMaxBacklog = 32
+
+const (
+	rewardComponentAttestationHead        = "attestation_head"
+	rewardComponentAttestationSource      = "attestation_source"
+	rewardComponentAttestationTarget      = "attestation_target"
+	rewardComponentAttestationInactivity  = "attestation_inactivity"
+	rewardComponentSync                   = "sync"
+	rewardComponentBlockAttestations      = "block_attestations"
+	rewardComponentBlockSyncAggregate     = "block_sync_aggregate"
+	rewardComponentBlockProposerSlashings = "block_proposer_slashings"
+	rewardComponentBlockAttesterSlashings = "block_attester_slashings"
+)
+
+type rewardKey struct {
+	ValidatorIndex uint64
+	Component      string
+}
+
+type rewardMismatch struct {
+	ValidatorIndex uint64
+	Component      string
+	NodeReward     int64
+	ComputedReward int64
+}
+
+// rewardReconciliationData holds everything needed to compute and compare the rewards of an epoch
+type rewardReconciliationData struct {
+	epoch          uint64
+	blocks         map[uint64]*constypes.AnySignedBlock // blocks of the previous, the reconciled and the next epoch
+	validators     []rewards.Validator                  // registry at the end of the reconciled epoch
+	nextValidators []rewards.Validator                  // registry at the end of the next epoch, whose transition processes the attestations of the reconciled epoch
+	slashedBefore  map[uint64]bool                      // validators slashed before the reconciled epoch
+	finalizedEpoch uint64                               // finalized checkpoint after the transition of the next epoch
+	syncCommittee  []uint64
+	attesters      map[uint64][][]uint64 // attesting indices per block slot and attestation
+
+	nodeAttestationRewards []constypes.AttestationReward
+	nodeBlockRewards       map[uint64]*constypes.StandardBlockRewardsResponse
+	nodeSyncRewards        map[uint64]*constypes.StandardSyncCommitteeRewardsResponse
+}
+
+func NewRewardReconciler(moduleContext ModuleContext) ModuleInterface {
+	return &rewardReconciler{
+		ModuleContext: moduleContext,
+		Mutex:         &sync.Mutex{},
+	}
+}
+
+func (r *rewardReconciler) Init() error {
+	err := db.WriterDb.Get(&r.lastEpoch, `SELECT COALESCE(MAX(epoch), 0) FROM reward_reconciliation_epochs`)
+	if err != nil {
+		return fmt.Errorf("error retrieving last reconciled epoch: %w", err)
+	}
+	return nil
+}
+
+func (r *rewardReconciler) GetName() string {
+	return "Reward-Reconciler"
+}
+
+func (r *rewardReconciler) OnHead(event *constypes.StandardEventHeadResponse) (err error) {
+	return nil // nop
+}
+
+func (r *rewardReconciler) OnChainReorg(event *constypes.StandardEventChainReorg) (err error) {
+	return nil // nop
+}
+
+func (r *rewardReconciler) OnFinalizedCheckpoint(event *constypes.StandardFinalizedCheckpointResponse) (err error) {
+	// if mutex is locked, return early
+	if !r.Mutex.TryLock() {
+		log.Infof("reward reconciliation is already running")
+		return nil
+	}
+	go func() {
+		defer r.Mutex.Unlock()
+		r.reconcileUpTo(event.Epoch)
+	}()
+	return nil
+}
+
+// reconcileUpTo reconciles the epochs whose next epoch is finalized, the event epoch is the justified one
+func (r *rewardReconciler) reconcileUpTo(justifiedEpoch uint64) {
+	if justifiedEpoch < 3 {
+		return
+	}
+	upTo := justifiedEpoch - 3
+
+	start := r.lastEpoch + 1
+	if r.lastEpoch == 0 {
+		start = upTo
+	}
+	if upTo >= rewardReconciliationMaxBacklog && start < upTo-rewardReconciliationMaxBacklog {
+		start = upTo - rewardReconciliationMaxBacklog
+	}
+	// the rewards of an epoch depend on the participation of its previous epoch, which has to be an altair epoch
+	start = max(start, utils.Config.Chain.ClConfig.AltairForkEpoch+1)
+
+	for epoch := start; epoch <= upTo; epoch++ {
+		interval := utils.Config.RewardReconciliation.EpochInterval
+		if interval > 1 && epoch%interval != 0 {
+			continue
+		}
+		err := r.reconcileEpoch(epoch)
+		if err != nil {
+			log.Error(err, "error reconciling rewards", 0, log.Fields{"epoch": epoch})
+			metrics.Errors.WithLabelValues("exporter_reward_reconciliation_fail").Inc()
+			return
+		}
+		r.lastEpoch = epoch
+	}
+}
+
+func (r *rewardReconciler) reconcileEpoch(epoch uint64) error {
+	start := time.Now()
+	report := services.NewStatusReport("exporter_reward_reconciliation", constants.Default, time.Duration(utils.Config.Chain.ClConfig.SlotsPerEpoch*utils.Config.Chain.ClConfig.SecondsPerSlot)*time.Second)
+	report(constants.Running, nil)
+
+	data, err := r.getData(epoch)
+	if err != nil {
+		report(constants.Failure, map[string]string{"error": err.Error()})
+		return err
+	}
+
+	cfg := rewards.NewConfig(&utils.Config.Chain.ClConfig)
+	computed, err := computeRewards(cfg, data)
+	if err != nil {
+		report(constants.Failure, map[string]string{"error": err.Error()})
+		return err
+	}
+
+	// the inactivity scores are not part of the computation, during an inactivity leak they can't be assumed to be zero
+	ignored := map[string]bool{}
+	if epoch > data.finalizedEpoch && epoch-data.finalizedEpoch > cfg.MinEpochsToInactivityPenalty {
+		ignored[rewardComponentAttestationInactivity] = true
+	}
+	mismatches, validators := reconcileRewards(nodeRewards(data), computed, ignored)
+
+	err = storeRewardReconciliation(epoch, validators, mismatches)
+	if err != nil {
+		report(constants.Failure, map[string]string{"error": err.Error()})
+		return err
+	}
+
+	for _, mismatch := range mismatches {
+		metrics.Counter.WithLabelValues("reward_reconciliation_mismatch_" + mismatch.Component).Inc()
+	}
+	metrics.State.WithLabelValues("reward_reconciliation_epoch").Set(float64(epoch))
+	metrics.State.WithLabelValues("reward_reconciliation_mismatches").Set(float64(len(mismatches)))
+
+	metadata := map[string]string{
+		"epoch":      fmt.Sprintf("%d", epoch),
+		"validators": fmt.Sprintf("%d", validators),
+		"mismatches": fmt.Sprintf("%d", len(mismatches)),
+		"took":       time.Since(start).String(),
+	}
+	if len(mismatches) > 0 {
+		log.WarnWithFields(log.Fields{"epoch": epoch, "mismatches": len(mismatches)}, "rewards of the beacon node do not match the computed rewards")
+		metadata["error"] = fmt.Sprintf("%d reward mismatches in epoch %d", len(mismatches), epoch)
+		report(constants.Failure, metadata)
+		return nil
+	}
+	log.InfoWithFields(log.Fields{"epoch": epoch, "validators": validators, "took": time.Since(start)}, "reconciled rewards")
+	report(constants.Success, metadata)
+	return nil
+}
+
+func (r *rewardReconciler) getData(epoch uint64) (*rewardReconciliationData, error) {
+	slotsPerEpoch := utils.Config.Chain.ClConfig.SlotsPerEpoch
+	data := &rewardReconciliationData{
+		epoch:            epoch,
+		blocks:           make(map[uint64]*constypes.AnySignedBlock, 3*slotsPerEpoch),
+		nodeBlockRewards: make(map[uint64]*constypes.StandardBlockRewardsResponse, slotsPerEpoch),
+		nodeSyncRewards:  make(map[uint64]*constypes.StandardSyncCommitteeRewardsResponse, slotsPerEpoch),
+	}
+	cl := r.ModuleContext.CL
+
+	errGroup := &errgroup.Group{}
+	errGroup.SetLimit(epochFetchParallelismWithinEpoch)
+	mutex := &sync.Mutex{}
+
+	for slot := (epoch - 1) * slotsPerEpoch; slot < (epoch+2)*slotsPerEpoch; slot++ {
+		slot := slot
+		errGroup.Go(func() error {
+			block, err := cl.GetSlot(slot)
+			if err != nil {
+				httpErr := network.SpecificError(err)
+				if httpErr != nil && httpErr.StatusCode == http.StatusNotFound {
+					return nil // missed
+				}
+				return fmt.Errorf("error retrieving block of slot %v: %w", slot, err)
+			}
+			mutex.Lock()
+			data.blocks[slot] = &block.Data
+			mutex.Unlock()
+			if slot/slotsPerEpoch != epoch {
+				return nil
+			}
+
+			blockRewards, err := cl.GetPropoalRewards(slot)
+			if err != nil {
+				return fmt.Errorf("error retrieving block rewards of slot %v: %w", slot, err)
+			}
+			syncRewards, err := cl.GetSyncRewards(slot)
+			if err != nil {
+				return fmt.Errorf("error retrieving sync committee rewards of slot %v: %w", slot, err)
+			}
+			mutex.Lock()
+			data.nodeBlockRewards[slot] = blockRewards
+			data.nodeSyncRewards[slot] = syncRewards
+			mutex.Unlock()
+			return nil
+		})
+	}
+
+	errGroup.Go(func() error {
+		attestationRewards, err := cl.GetAttestationRewards(epoch)
+		if err != nil {
+			return fmt.Errorf("error retrieving attestation rewards of epoch %v: %w", epoch, err)
+		}
+		data.nodeAttestationRewards = attestationRewards.Data.TotalRewards
+		return nil
+	})
+
+	errGroup.Go(func() error {
+		var err error
+		data.validators, data.nextValidators, data.slashedBefore, err = getStoredRegistries(epoch)
+		return err
+	})
+
+	errGroup.Go(func() error {
+		var err error
+		data.attesters, err = getStoredAttesters((epoch-1)*slotsPerEpoch, (epoch+2)*slotsPerEpoch-1)
+		return err
+	})
+
+	errGroup.Go(func() error {
+		// the state of the first slot of the epoch after next has gone through the transition of the next epoch
+		checkpoints, err := cl.GetFinalityCheckpoints((epoch + 2) * slotsPerEpoch)
+		if err != nil {
+			return fmt.Errorf("error retrieving finality checkpoints after epoch %v: %w", epoch+1, err)
+		}
+		data.finalizedEpoch = checkpoints.Data.Finalized.Epoch
+		return nil
+	})
+
+	errGroup.Go(func() error {
+		period := utils.SyncPeriodOfEpoch(epoch)
+		err := db.ReaderDb.Select(&data.syncCommittee, `SELECT validatorindex FROM sync_committees WHERE period = $1 ORDER BY committeeindex`, period)
+		if err != nil {
+			return fmt.Errorf("error retrieving sync committee of period %v: %w", period, err)
+		}
+		if uint64(len(data.syncCommittee)) != utils.Config.Chain.ClConfig.SyncCommitteeSize {
+			return fmt.Errorf("sync committee of period %v has %v of %v members stored", period, len(data.syncCommittee), utils.Config.Chain.ClConfig.SyncCommitteeSize)
+		}
+		return nil
+	})
+
+	err := errGroup.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// storedValidator holds the epochs of a validator in the validators table, which don't change once they are set
+type storedValidator struct {
+	Index             uint64 `db:"validatorindex"`
+	ActivationEpoch   uint64 `db:"activationepoch"`
+	ExitEpoch         uint64 `db:"exitepoch"`
+	WithdrawableEpoch uint64 `db:"withdrawableepoch"`
+}
+
+// getStoredRegistries rebuilds the registries at the end of the reconciled and the next epoch from the validators
+// table, the effective balances stored per epoch and the stored slashings. It also returns the validators slashed
+// before the reconciled epoch.
+func getStoredRegistries(epoch uint64) ([]rewards.Validator, []rewards.Validator, map[uint64]bool, error) {
+	slotsPerEpoch := utils.Config.Chain.ClConfig.SlotsPerEpoch
+
+	var stored []storedValidator
+	err := db.ReaderDb.Select(&stored, `SELECT validatorindex, activationepoch, exitepoch, withdrawableepoch FROM validators ORDER BY validatorindex`)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error retrieving validators: %w", err)
+	}
+
+	var slashings []struct {
+		ValidatorIndex uint64 `db:"validatorindex"`
+		Slot           uint64 `db:"slot"`
+	}
+	err = db.ReaderDb.Select(&slashings, `
+		SELECT validatorindex, MIN(slot) AS slot
+		FROM (
+			SELECT
+				blocks.slot,
+				UNNEST(ARRAY(
+					SELECT UNNEST(attestation1_indices)
+						INTERSECT
+					SELECT UNNEST(attestation2_indices)
+				)) AS validatorindex
+			FROM blocks_attesterslashings
+			INNER JOIN blocks ON blocks_attesterslashings.block_slot = blocks.slot AND blocks.status = '1'
+			WHERE blocks.slot < $1
+			UNION ALL
+			SELECT blocks.slot, blocks_proposerslashings.proposerindex AS validatorindex
+			FROM blocks_proposerslashings
+			INNER JOIN blocks ON blocks_proposerslashings.block_slot = blocks.slot AND blocks.status = '1'
+			WHERE blocks.slot < $1
+		) slashings
+		GROUP BY validatorindex`, (epoch+2)*slotsPerEpoch)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error retrieving slashings up to epoch %v: %w", epoch+1, err)
+	}
+	slashedAt := make(map[uint64]uint64, len(slashings))
+	slashedBefore := make(map[uint64]bool)
+	for _, slashing := range slashings {
+		slashedAt[slashing.ValidatorIndex] = slashing.Slot
+		if slashing.Slot < epoch*slotsPerEpoch {
+			slashedBefore[slashing.ValidatorIndex] = true
+		}
+	}
+
+	indices := make([]uint64, 0, len(stored))
+	for _, validator := range stored {
+		indices = append(indices, validator.Index)
+	}
+	balances, err := db.BigtableClient.GetValidatorBalanceHistory(indices, epoch, epoch+1)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error retrieving effective balances of epochs %v to %v: %w", epoch, epoch+1, err)
+	}
+
+	validators, err := storedRegistry(epoch, (epoch+1)*slotsPerEpoch, stored, balances, slashedAt)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	nextValidators, err := storedRegistry(epoch+1, (epoch+2)*slotsPerEpoch, stored, balances, slashedAt)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return validators, nextValidators, slashedBefore, nil
+}
+
+// storedRegistry returns the registry of an epoch, the effective balances stored for an epoch are the ones of its
+// first slot and don't change until the next epoch. Validators without a stored balance did not exist yet, slashings
+// are applied up to the given slot.
+func storedRegistry(epoch, endSlot uint64, stored []storedValidator, balances map[uint64][]*types.ValidatorBalance, slashedAt map[uint64]uint64) ([]rewards.Validator, error) {
+	fromSql := func(epoch uint64) uint64 {
+		if epoch >= db.MaxSqlNumber {
+			return db.FarFutureEpoch
+		}
+		return epoch
+	}
+	validators := make([]rewards.Validator, 0, len(stored))
+	for i, validator := range stored {
+		if validator.Index != uint64(i) {
+			return nil, fmt.Errorf("unexpected validator %v at position %v", validator.Index, i)
+		}
+		var balance *types.ValidatorBalance
+		for _, b := range balances[validator.Index] {
+			if b.Epoch == epoch {
+				balance = b
+			}
+		}
+		if balance == nil {
+			// the registry ends at the first validator without a balance
+			for _, later := range stored[i+1:] {
+				for _, b := range balances[later.Index] {
+					if b.Epoch == epoch {
+						return nil, fmt.Errorf("no balance of validator %v stored for epoch %v", validator.Index, epoch)
+					}
+				}
+			}
+			break
+		}
+		slot, slashed := slashedAt[validator.Index]
+		validators = append(validators, rewards.Validator{
+			EffectiveBalance:  balance.EffectiveBalance,
+			Slashed:           slashed && slot < endSlot,
+			ActivationEpoch:   fromSql(validator.ActivationEpoch),
+			ExitEpoch:         fromSql(validator.ExitEpoch),
+			WithdrawableEpoch: fromSql(validator.WithdrawableEpoch),
+		})
+	}
+	if len(validators) == 0 {
+		return nil, fmt.Errorf("no balances stored for epoch %v", epoch)
+	}
+	return validators, nil
+}
+
+// getStoredAttesters returns the attesting indices the slot exporter stored for the attestations of the canonical
+// blocks between the given slots
+func getStoredAttesters(startSlot, endSlot uint64) (map[uint64][][]uint64, error) {
+	var rows []struct {
+		BlockSlot  uint64        `db:"block_slot"`
+		BlockIndex uint64        `db:"block_index"`
+		Validators pq.Int64Array `db:"validators"`
+	}
+	err := db.ReaderDb.Select(&rows, `
+		SELECT blocks_attestations.block_slot, blocks_attestations.block_index, blocks_attestations.validators
+		FROM blocks_attestations
+		INNER JOIN blocks ON blocks_attestations.block_slot = blocks.slot AND blocks_attestations.block_root = blocks.blockroot AND blocks.status = '1'
+		WHERE blocks_attestations.block_slot BETWEEN $1 AND $2
+		ORDER BY blocks_attestations.block_slot, blocks_attestations.block_index`, startSlot, endSlot)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving attestations of slots %v to %v: %w", startSlot, endSlot, err)
+	}
+	attesters := make(map[uint64][][]uint64)
+	for _, row := range rows {
+		if row.BlockIndex != uint64(len(attesters[row.BlockSlot])) {
+			return nil, fmt.Errorf("attestation %v of slot %v is not stored", len(attesters[row.BlockSlot]), row.BlockSlot)
+		}
+		indices := make([]uint64, 0, len(row.Validators))
+		for _, index := range row.Validators {
+			indices = append(indices, uint64(index))
+		}
+		attesters[row.BlockSlot] = append(attesters[row.BlockSlot], indices)
+	}
+	return attesters, nil
+}
+
+// computeRewards replays the blocks of the epochs around the reconciled epoch to rebuild the participation flags of
+// the previous and the reconciled epoch and computes the rewards of the reconciled epoch from them
+func computeRewards(cfg rewards.Config, data *rewardReconciliationData) (map[rewardKey]int64, error) {
+	computed := make(map[rewardKey]int64)
+	state := rewards.NewEpochState(cfg, data.epoch, data.validators)
+
+	slots := make([]uint64, 0, len(data.blocks))
+	for slot := range data.blocks {
+		slots = append(slots, slot)
+	}
+	slices.Sort(slots)
+	// the block root at a slot is the parent root of the first block after it
+	blockRootAt := func(slot uint64) []byte {
+		i := sort.Search(len(slots), func(i int) bool { return slots[i] > slot })
+		if i == len(slots) {
+			return nil
+		}
+		return data.blocks[slots[i]].Message.ParentRoot
+	}
+
+	participation := map[uint64]*rewards.Participation{
+		data.epoch - 1: rewards.NewParticipation(data.epoch - 1),
+		data.epoch:     rewards.NewParticipation(data.epoch),
+	}
+	slashed := make(map[uint64]bool) // validators slashed by an earlier block of the epoch
+	slashable := func(index uint64) bool {
+		if slashed[index] || data.slashedBefore[index] || index >= uint64(len(state.Validators)) {
+			return false
+		}
+		validator := state.Validators[index]
+		validator.Slashed = false // checked above, the registry is the one of the end of the epoch
+		return validator.IsSlashable(data.epoch)
+	}
+
+	for _, slot := range slots {
+		block := data.blocks[slot]
+		// the blocks of the other epochs only contribute participation flags
+		var blockState *rewards.EpochState
+		if slot/cfg.SlotsPerEpoch == data.epoch {
+			blockState = state
+		}
+
+		attestationsReward := uint64(0)
+		for i := range block.Message.Body.Attestations {
+			attestation := &block.Message.Body.Attestations[i]
+			targetEpoch := attestation.Data.Target.Epoch
+			p, found := participation[targetEpoch]
+			if !found {
+				continue
+			}
+			matchingTarget := bytes.Equal(attestation.Data.Target.Root, blockRootAt(targetEpoch*cfg.SlotsPerEpoch))
+			matchingHead := bytes.Equal(attestation.Data.BeaconBlockRoot, blockRootAt(attestation.Data.Slot))
+			if i >= len(data.attesters[slot]) {
+				return nil, fmt.Errorf("no attesters stored for attestation %v in slot %v", i, slot)
+			}
+			attestationsReward += p.Add(data.attesters[slot][i], cfg.AttestationFlags(targetEpoch, slot-attestation.Data.Slot, matchingTarget, matchingHead), blockState)
+		}
+		if blockState == nil {
+			continue
+		}
+
+		proposer := block.Message.ProposerIndex
+		computed[rewardKey{proposer, rewardComponentBlockAttestations}] += int64(attestationsReward)
+
+		if block.Message.Body.SyncAggregate != nil {
+			syncRewards, proposerReward := state.SyncAggregateRewards(data.syncCommittee, block.Message.Body.SyncAggregate.SyncCommitteeBits)
+			for validator, reward := range syncRewards {
+				computed[rewardKey{validator, rewardComponentSync}] += reward
+			}
+			computed[rewardKey{proposer, rewardComponentBlockSyncAggregate}] += int64(proposerReward)
+		}
+
+		for _, slashing := range block.Message.Body.ProposerSlashings {
+			index := slashing.SignedHeader1.Message.ProposerIndex
+			if slashable(index) {
+				computed[rewardKey{proposer, rewardComponentBlockProposerSlashings}] += int64(state.WhistleblowerReward(index))
+				slashed[index] = true
+			}
+		}
+		for i := range block.Message.Body.AttesterSlashings {
+			indices := block.Message.Body.AttesterSlashings[i].GetSlashedIndices()
+			slices.Sort(indices)
+			for _, index := range indices {
+				if slashable(index) {
+					computed[rewardKey{proposer, rewardComponentBlockAttesterSlashings}] += int64(state.WhistleblowerReward(index))
+					slashed[index] = true
+				}
+			}
+		}
+	}
+
+	nextState := rewards.NewEpochState(cfg, data.epoch+1, data.nextValidators)
+	for _, reward := range nextState.AttestationRewards(participation[data.epoch], data.finalizedEpoch, nil) {
+		computed[rewardKey{reward.ValidatorIndex, rewardComponentAttestationHead}] += reward.Head
+		computed[rewardKey{reward.ValidatorIndex, rewardComponentAttestationSource}] += reward.Source
+		computed[rewardKey{reward.ValidatorIndex, rewardComponentAttestationTarget}] += reward.Target
+		computed[rewardKey{reward.ValidatorIndex, rewardComponentAttestationInactivity}] += reward.Inactivity
+	}
+	return computed, nil
+}
+
+// nodeRewards sums the rewards reported by the beacon node per validator and component
+func nodeRewards(data *rewardReconciliationData) map[rewardKey]int64 {
+	node := make(map[rewardKey]int64)
+	for _, reward := range data.nodeAttestationRewards {
+		node[rewardKey{reward.ValidatorIndex, rewardComponentAttestationHead}] += int64(reward.Head)
+		node[rewardKey{reward.ValidatorIndex, rewardComponentAttestationSource}] += int64(reward.Source)
+		node[rewardKey{reward.ValidatorIndex, rewardComponentAttestationTarget}] += int64(reward.Target)
+		node[rewardKey{reward.ValidatorIndex, rewardComponentAttestationInactivity}] += int64(reward.Inactivity)
+	}
+	for _, syncRewards := range data.nodeSyncRewards {
+		for _, reward := range syncRewards.Data {
+			node[rewardKey{reward.ValidatorIndex, rewardComponentSync}] += reward.Reward
+		}
+	}
+	for _, blockRewards := range data.nodeBlockRewards {
+		proposer := blockRewards.Data.ProposerIndex
+		node[rewardKey{proposer, rewardComponentBlockAttestations}] += blockRewards.Data.Attestations
+		node[rewardKey{proposer, rewardComponentBlockSyncAggregate}] += blockRewards.Data.SyncAggregate
+		node[rewardKey{proposer, rewardComponentBlockProposerSlashings}] += blockRewards.Data.ProposerSlashings
+		node[rewardKey{proposer, rewardComponentBlockAttesterSlashings}] += blockRewards.Data.AttesterSlashings
+	}
+	return node
+}
+
+// reconcileRewards compares the rewards of the node with the computed ones, a reward missing on one side counts as zero.
+// It returns the mismatches ordered by validator and component and the number of validators compared.
+func reconcileRewards(node, computed map[rewardKey]int64, ignored map[string]bool) ([]rewardMismatch, int) {
+	validators := make(map[uint64]bool)
+	mismatches := []rewardMismatch{}
+	compare := func(key rewardKey) {
+		if ignored[key.Component] {
+			return
+		}
+		validators[key.ValidatorIndex] = true
+		if node[key] != computed[key] {
+			mismatches = append(mismatches, rewardMismatch{
+				ValidatorIndex: key.ValidatorIndex,
+				Component:      key.Component,
+				NodeReward:     node[key],
+				ComputedReward: computed[key],
+			})
+		}
+	}
+	for key := range node {
+		compare(key)
+	}
+	for key := range computed {
+		if _, found := node[key]; !found {
+			compare(key)
+		}
+	}
+
+	sort.Slice(mismatches, func(i, j int) bool {
+		if mismatches[i].ValidatorIndex != mismatches[j].ValidatorIndex {
+			return mismatches[i].ValidatorIndex < mismatches[j].ValidatorIndex
+		}
+		return mismatches[i].Component < mismatches[j].Component
+	})
+	return mismatches, len(validators)
+}
+
+func storeRewardReconciliation(epoch uint64, validators int, mismatches []rewardMismatch) error {
+	tx, err := db.WriterDb.Beginx()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer utils.Rollback(tx)
+
+	_, err = tx.Exec(`DELETE FROM reward_reconciliation_mismatches WHERE epoch = $1`, epoch)
+	if err != nil {
+		return fmt.Errorf("error removing reward mismatches of epoch %v: %w", epoch, err)
+	}
+
+	if len(mismatches) > 0 {
+		indices := make([]int64, 0, len(mismatches))
+		components := make([]string, 0, len(mismatches))
+		nodeRewards := make([]int64, 0, len(mismatches))
+		computedRewards := make([]int64, 0, len(mismatches))
+		for _, mismatch := range mismatches {
+			indices = append(indices, int64(mismatch.ValidatorIndex))
+			components = append(components, mismatch.Component)
+			nodeRewards = append(nodeRewards, mismatch.NodeReward)
+			computedRewards = append(computedRewards, mismatch.ComputedReward)
+		}
+		_, err = tx.Exec(`
+			INSERT INTO reward_reconciliation_mismatches (epoch, validator_index, component, node_reward, computed_reward)
+			SELECT $1, * FROM UNNEST($2::int[], $3::text[], $4::bigint[], $5::bigint[])`,
+			epoch, pq.Array(indices), pq.Array(components), pq.Array(nodeRewards), pq.Array(computedRewards))
+		if err != nil {
+			return fmt.Errorf("error saving %v reward mismatches of epoch %v: %w", len(mismatches), epoch, err)
+		}
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO reward_reconciliation_epochs (epoch, validators, mismatches, reconciled_ts)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (epoch) DO UPDATE SET validators = excluded.validators, mismatches = excluded.mismatches, reconciled_ts = excluded.reconciled_ts`,
+		epoch, validators, len(mismatches))
+	if err != nil {
+		return fmt.Errorf("error saving reconciliation of epoch %v: %w", epoch, err)
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,193 @@
+package modules
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	constypes "github.com/gobitfly/beaconchain/pkg/consapi/types"
+	"github.com/gobitfly/beaconchain/pkg/exporter/rewards"
+)
+
+// rewardTestData has a block in every slot of the epochs 1 to 3 with 4 slots each, the root of the block of a slot is
+// the slot number. Validators 0 and 1 attest optimally, validator 2 votes for a wrong head and is included late,
+// validator 3 misses its attestation and gets slashed twice.
+func rewardTestData() *rewardReconciliationData {
+	validators := make([]rewards.Validator, 4)
+	for i := range validators {
+		validators[i] = rewards.Validator{EffectiveBalance: 32e9, ExitEpoch: math.MaxUint64, WithdrawableEpoch: math.MaxUint64}
+	}
+	data := &rewardReconciliationData{
+		epoch:          2,
+		blocks:         make(map[uint64]*constypes.AnySignedBlock),
+		validators:     validators,
+		nextValidators: validators,
+		finalizedEpoch: 1,
+		syncCommittee:  []uint64{0, 1, 2, 3},
+		attesters:      map[uint64][][]uint64{9: {{0, 1}}, 13: {{2}}},
+	}
+
+	attestation := func(slot uint64, head byte) constypes.Attestation {
+		var a constypes.Attestation
+		a.Data.Slot = slot
+		a.Data.BeaconBlockRoot = []byte{head}
+		a.Data.Target.Epoch = 2
+		a.Data.Target.Root = []byte{8}
+		return a
+	}
+
+	for slot := uint64(4); slot < 16; slot++ {
+		block := &constypes.AnySignedBlock{}
+		block.Message.Slot = slot
+		block.Message.ProposerIndex = slot % 4
+		block.Message.ParentRoot = []byte{byte(slot - 1)}
+		block.Message.Body.SyncAggregate = &constypes.SyncAggregate{SyncCommitteeBits: []byte{0x07}}
+		switch slot {
+		case 9:
+			block.Message.Body.Attestations = []constypes.Attestation{attestation(8, 8)}
+		case 10, 11:
+			var slashing constypes.ProposerSlashing
+			slashing.SignedHeader1.Message.ProposerIndex = 3
+			block.Message.Body.ProposerSlashings = []constypes.ProposerSlashing{slashing}
+		case 13:
+			block.Message.Body.Attestations = []constypes.Attestation{attestation(11, 10)}
+		}
+		data.blocks[slot] = block
+	}
+	return data
+}
+
+func TestComputeRewards(t *testing.T) {
+	cfg := rewards.Config{
+		SlotsPerEpoch:                      4,
+		EffectiveBalanceIncrement:          1e9,
+		BaseRewardFactor:                   64,
+		MinAttestationInclusionDelay:       1,
+		SyncCommitteeSize:                  4,
+		MinEpochsToInactivityPenalty:       4,
+		InactivityScoreBias:                4,
+		InactivityPenaltyQuotientBellatrix: 1 << 24,
+		WhistleblowerRewardQuotient:        512,
+		DenebForkEpoch:                     0,
+		ElectraForkEpoch:                   math.MaxUint64,
+	}
+	data := rewardTestData()
+	computed, err := computeRewards(cfg, data)
+	if err != nil {
+		t.Fatalf("error computing rewards: %v", err)
+	}
+
+	state := rewards.NewEpochState(cfg, 2, data.validators)
+	all := rewards.TimelySourceFlag | rewards.TimelyTargetFlag | rewards.TimelyHeadFlag
+	if expected := rewards.NewParticipation(2).Add([]uint64{0, 1}, all, state); computed[rewardKey{1, rewardComponentBlockAttestations}] != int64(expected) {
+		t.Errorf("expected the proposer of slot 9 to get %d for the attestations, got %d", expected, computed[rewardKey{1, rewardComponentBlockAttestations}])
+	}
+
+	if computed[rewardKey{0, rewardComponentAttestationHead}] <= 0 || computed[rewardKey{2, rewardComponentAttestationHead}] != 0 {
+		t.Errorf("expected a head reward for validator 0 only, got %d and %d", computed[rewardKey{0, rewardComponentAttestationHead}], computed[rewardKey{2, rewardComponentAttestationHead}])
+	}
+	if computed[rewardKey{2, rewardComponentAttestationTarget}] <= 0 || computed[rewardKey{2, rewardComponentAttestationSource}] <= 0 {
+		t.Errorf("expected source and target rewards for the late attestation of validator 2")
+	}
+	if computed[rewardKey{3, rewardComponentAttestationSource}] >= 0 || computed[rewardKey{3, rewardComponentAttestationTarget}] >= 0 {
+		t.Errorf("expected source and target penalties for validator 3")
+	}
+
+	// only the first slashing of validator 3 is rewarded
+	if computed[rewardKey{2, rewardComponentBlockProposerSlashings}] != 62500000 || computed[rewardKey{3, rewardComponentBlockProposerSlashings}] != 0 {
+		t.Errorf("unexpected slashing rewards %d and %d", computed[rewardKey{2, rewardComponentBlockProposerSlashings}], computed[rewardKey{3, rewardComponentBlockProposerSlashings}])
+	}
+
+	// validator 3 misses its sync committee seat in all 4 blocks of the epoch
+	syncRewards, _ := state.SyncAggregateRewards(data.syncCommittee, []byte{0x07})
+	if computed[rewardKey{3, rewardComponentSync}] != 4*syncRewards[3] || computed[rewardKey{0, rewardComponentSync}] != 4*syncRewards[0] {
+		t.Errorf("unexpected sync committee rewards %d and %d", computed[rewardKey{0, rewardComponentSync}], computed[rewardKey{3, rewardComponentSync}])
+	}
+
+	// attestations whose attesters were not stored yet can't be replayed
+	delete(data.attesters, 13)
+	if _, err := computeRewards(cfg, data); err == nil {
+		t.Errorf("expected an error for an attestation without stored attesters")
+	}
+}
+
+func TestStoredRegistry(t *testing.T) {
+	stored := []storedValidator{
+		{Index: 0, ActivationEpoch: 0, ExitEpoch: db.MaxSqlNumber, WithdrawableEpoch: db.MaxSqlNumber},
+		{Index: 1, ActivationEpoch: 0, ExitEpoch: 20, WithdrawableEpoch: 8212},
+		{Index: 2, ActivationEpoch: 12, ExitEpoch: db.MaxSqlNumber, WithdrawableEpoch: db.MaxSqlNumber},
+	}
+	balance := func(epoch, effectiveBalance uint64) *types.ValidatorBalance {
+		return &types.ValidatorBalance{Epoch: epoch, EffectiveBalance: effectiveBalance}
+	}
+	// validator 2 was deposited in epoch 10, validator 1 got slashed in slot 44 of epoch 11
+	balances := map[uint64][]*types.ValidatorBalance{
+		0: {balance(10, 32e9), balance(11, 31e9)},
+		1: {balance(10, 32e9), balance(11, 32e9)},
+		2: {balance(11, 32e9)},
+	}
+	slashedAt := map[uint64]uint64{1: 44}
+
+	validators, err := storedRegistry(10, 44, stored, balances, slashedAt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []rewards.Validator{
+		{EffectiveBalance: 32e9, ActivationEpoch: 0, ExitEpoch: math.MaxUint64, WithdrawableEpoch: math.MaxUint64},
+		{EffectiveBalance: 32e9, ActivationEpoch: 0, ExitEpoch: 20, WithdrawableEpoch: 8212},
+	}
+	if !reflect.DeepEqual(validators, expected) {
+		t.Errorf("expected registry %+v, got %+v", expected, validators)
+	}
+
+	validators, err = storedRegistry(11, 48, stored, balances, slashedAt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(validators) != 3 || validators[0].EffectiveBalance != 31e9 || !validators[1].Slashed || validators[2].ActivationEpoch != 12 {
+		t.Errorf("unexpected registry %+v", validators)
+	}
+
+	// a gap in the stored balances means the epoch was not stored completely
+	delete(balances, 1)
+	if _, err := storedRegistry(11, 48, stored, balances, slashedAt); err == nil {
+		t.Errorf("expected an error for a missing balance")
+	}
+	if _, err := storedRegistry(12, 52, stored, balances, slashedAt); err == nil {
+		t.Errorf("expected an error for an epoch without balances")
+	}
+}
+
+func TestReconcileRewards(t *testing.T) {
+	computed := map[rewardKey]int64{
+		{0, rewardComponentAttestationHead}:       100,
+		{0, rewardComponentAttestationInactivity}: 0,
+		{1, rewardComponentAttestationHead}:       100,
+		{2, rewardComponentBlockAttestations}:     5000,
+	}
+	node := map[rewardKey]int64{
+		{0, rewardComponentAttestationHead}:       100,
+		{0, rewardComponentAttestationInactivity}: -20,
+		{1, rewardComponentAttestationHead}:       90,
+		{3, rewardComponentSync}:                  0,
+	}
+
+	mismatches, validators := reconcileRewards(node, computed, map[string]bool{rewardComponentAttestationInactivity: true})
+	if validators != 4 {
+		t.Errorf("expected 4 compared validators, got %d", validators)
+	}
+	expected := []rewardMismatch{
+		{ValidatorIndex: 1, Component: rewardComponentAttestationHead, NodeReward: 90, ComputedReward: 100},
+		{ValidatorIndex: 2, Component: rewardComponentBlockAttestations, NodeReward: 0, ComputedReward: 5000},
+	}
+	if len(mismatches) != len(expected) {
+		t.Fatalf("expected %d mismatches, got %+v", len(expected), mismatches)
+	}
+	for i := range expected {
+		if mismatches[i] != expected[i] {
+			t.Errorf("expected mismatch %+v, got %+v", expected[i], mismatches[i])
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package modules
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/gobitfly/beaconchain/pkg/commons/rpc"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+)
+
+func TestSlotExporterBlocks(t *testing.T) {
+	client, err := rpc.NewLighthouseClient(newSyntheticChainClient(t).ClientInt, big.NewInt(1337))
+	if err != nil {
+		t.Fatalf("error creating client: %v", err)
+	}
+
+	// the first slot of an epoch carries the duties and the validator state of the epoch
+	block, err := client.GetBlockBySlot(8)
+	if err != nil {
+		t.Fatalf("error getting block: %v", err)
+	}
+	if block.Status != 1 || block.Proposer != 0 || block.ExecutionPayload == nil || block.ExecutionPayload.BlockNumber != 108 {
+		t.Errorf("unexpected block at slot 8: status %d, proposer %d", block.Status, block.Proposer)
+	}
+	if block.EpochAssignments == nil || len(block.Validators) != 4 {
+		t.Fatalf("expected the epoch assignments and validators with the first slot of the epoch")
+	}
+	if block.EpochAssignments.ProposerAssignments[10] != 2 || block.EpochAssignments.AttestorAssignments["9-0-0"] != 1 {
+		t.Errorf("unexpected epoch assignments %+v", block.EpochAssignments)
+	}
+	if len(block.EpochAssignments.SyncAssignments) != 8 || len(block.SyncDuties) != 4 {
+		t.Errorf("expected 8 sync committee members and the duties of all 4 validators, got %d and %d", len(block.EpochAssignments.SyncAssignments), len(block.SyncDuties))
+	}
+
+	block, err = client.GetBlockBySlot(10)
+	if err != nil {
+		t.Fatalf("error getting missed block: %v", err)
+	}
+	if block.Status != 0 || block.Proposer != 2 {
+		t.Errorf("expected slot 10 to be missed by validator 2, got status %d and proposer %d", block.Status, block.Proposer)
+	}
+
+	// the attestations of the missed slot and the slot before it are included in slot 11
+	block, err = client.GetBlockBySlot(11)
+	if err != nil {
+		t.Fatalf("error getting block: %v", err)
+	}
+	if len(block.Attestations) != 2 {
+		t.Fatalf("expected 2 attestations in slot 11, got %d", len(block.Attestations))
+	}
+	if duties := block.AttestationDuties[types.ValidatorIndex(1)]; len(duties) != 1 || duties[0] != 9 {
+		t.Errorf("expected validator 1 to attest slot 9, got %v", duties)
+	}
+
+	block, err = client.GetBlockBySlot(12)
+	if err != nil {
+		t.Fatalf("error getting block: %v", err)
+	}
+	if block.SyncDuties[types.ValidatorIndex(3)] || !block.SyncDuties[types.ValidatorIndex(2)] {
+		t.Errorf("expected validator 3 to miss its sync duty in slot 12, got %v", block.SyncDuties)
+	}
+	if len(block.ExecutionPayload.Withdrawals) != 1 || block.ExecutionPayload.Withdrawals[0].ValidatorIndex != 2 {
+		t.Errorf("expected the withdrawal of validator 2 in slot 12")
+	}
+}
@@ -1,166 +1,630 @@
 package modules
 
 import (
-	"encoding/hex"
+	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"net/http"
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gobitfly/beaconchain/pkg/commons/contracts/ssv"
 	"github.com/gobitfly/beaconchain/pkg/commons/db"
 	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/metrics"
 	"github.com/gobitfly/beaconchain/pkg/commons/utils"
-
-	"github.com/gorilla/websocket"
+	edb "github.com/gobitfly/beaconchain/pkg/exporter/db"
+	"github.com/lib/pq"
 )
 
-type SSVExporterResponse struct {
-	Type   string `json:"type"`
-	Filter struct {
-		From int `json:"from"`
-		To   int `json:"to"`
-	} `json:"filter"`
-	Data []struct {
-		Index     int    `json:"index"`
-		Publickey string `json:"publicKey"`
-		Operators []struct {
-			Nodeid    int    `json:"nodeId"`
-			Publickey string `json:"publicKey"`
-		} `json:"operators"`
-	} `json:"data"`
+// the validators of the ssv network are distributed validators, an owner splits the key of a validator into shares and
+// registers them with a cluster of operators. a duty is performed if a quorum of the operators of the cluster signs it.
+// operators, clusters and validators are synced from the events of the ssv network contract.
+
+const ssvTag = "ssv"
+
+// the operator performance of the last days is recomputed on every run as the daily aggregate of the current day is still growing
+const ssvPerformanceDays = 7
+
+type ssvOperator struct {
+	Id        uint64
+	Owner     common.Address
+	PublicKey []byte
+	Fee       *big.Int // nil if the fee wasn't changed
+	Added     bool     // false if only the fee or the removal of an operator exported earlier is known
+	Removed   bool
+}
+
+type ssvCluster struct {
+	Id             []byte
+	Owner          common.Address
+	OperatorIds    []uint64
+	ValidatorCount uint32
+	Active         bool
+	Balance        *big.Int
+}
+
+type ssvValidator struct {
+	Pubkey      []byte
+	Owner       common.Address
+	OperatorIds []uint64
+	ClusterId   []byte
+	Removed     bool
+}
+
+// ssvChanges is the state of the operators, clusters and validators after the events of a block range were applied in order
+type ssvChanges struct {
+	Operators  map[uint64]*ssvOperator
+	Clusters   map[string]*ssvCluster
+	Validators map[string]*ssvValidator
+}
+
+func newSsvChanges() *ssvChanges {
+	return &ssvChanges{
+		Operators:  make(map[uint64]*ssvOperator),
+		Clusters:   make(map[string]*ssvCluster),
+		Validators: make(map[string]*ssvValidator),
+	}
+}
+
+// ssvClusterId is the hash the ssv network contract identifies clusters by, keccak256(abi.encodePacked(owner, operatorIds))
+func ssvClusterId(owner common.Address, operatorIds []uint64) []byte {
+	data := owner.Bytes()
+	for _, id := range operatorIds {
+		data = append(data, common.LeftPadBytes(new(big.Int).SetUint64(id).Bytes(), 32)...)
+	}
+	return crypto.Keccak256(data)
+}
+
+func (c *ssvChanges) operator(id uint64) *ssvOperator {
+	if _, ok := c.Operators[id]; !ok {
+		c.Operators[id] = &ssvOperator{Id: id}
+	}
+	return c.Operators[id]
+}
+
+func (c *ssvChanges) addOperator(id uint64, owner common.Address, publicKey []byte, fee *big.Int) {
+	o := c.operator(id)
+	o.Owner = owner
+	o.PublicKey = publicKey
+	o.Fee = fee
+	o.Added = true
+	o.Removed = false
+}
+
+func (c *ssvChanges) removeOperator(id uint64) {
+	c.operator(id).Removed = true
+}
+
+func (c *ssvChanges) setOperatorFee(id uint64, fee *big.Int) {
+	c.operator(id).Fee = fee
+}
+
+// setCluster stores the cluster as emitted with the event, every cluster event carries the complete state after the event
+func (c *ssvChanges) setCluster(owner common.Address, operatorIds []uint64, cluster ssv.ISSVNetworkCoreCluster) {
+	id := ssvClusterId(owner, operatorIds)
+	c.Clusters[string(id)] = &ssvCluster{
+		Id:             id,
+		Owner:          owner,
+		OperatorIds:    operatorIds,
+		ValidatorCount: cluster.ValidatorCount,
+		Active:         cluster.Active,
+		Balance:        cluster.Balance,
+	}
+}
+
+func (c *ssvChanges) setValidator(owner common.Address, operatorIds []uint64, pubkey []byte, removed bool) {
+	c.Validators[hexutil.Encode(pubkey)] = &ssvValidator{
+		Pubkey:      pubkey,
+		Owner:       owner,
+		OperatorIds: operatorIds,
+		ClusterId:   ssvClusterId(owner, operatorIds),
+		Removed:     removed,
+	}
+}
+
+func (c *ssvChanges) applyLog(filterer *ssv.SSVNetworkFilterer, eventName string, l gethtypes.Log) error {
+	switch eventName {
+	case "OperatorAdded":
+		e, err := filterer.ParseOperatorAdded(l)
+		if err != nil {
+			return err
+		}
+		c.addOperator(e.OperatorId, e.Owner, e.PublicKey, e.Fee)
+	case "OperatorRemoved":
+		e, err := filterer.ParseOperatorRemoved(l)
+		if err != nil {
+			return err
+		}
+		c.removeOperator(e.OperatorId)
+	case "OperatorFeeExecuted":
+		e, err := filterer.ParseOperatorFeeExecuted(l)
+		if err != nil {
+			return err
+		}
+		c.setOperatorFee(e.OperatorId, e.Fee)
+	case "ValidatorAdded":
+		e, err := filterer.ParseValidatorAdded(l)
+		if err != nil {
+			return err
+		}
+		c.setValidator(e.Owner, e.OperatorIds, e.PublicKey, false)
+		c.setCluster(e.Owner, e.OperatorIds, e.Cluster)
+	case "ValidatorRemoved":
+		e, err := filterer.ParseValidatorRemoved(l)
+		if err != nil {
+			return err
+		}
+		c.setValidator(e.Owner, e.OperatorIds, e.PublicKey, true)
+		c.setCluster(e.Owner, e.OperatorIds, e.Cluster)
+	case "ClusterLiquidated":
+		e, err := filterer.ParseClusterLiquidated(l)
+		if err != nil {
+			return err
+		}
+		c.setCluster(e.Owner, e.OperatorIds, e.Cluster)
+	case "ClusterReactivated":
+		e, err := filterer.ParseClusterReactivated(l)
+		if err != nil {
+			return err
+		}
+		c.setCluster(e.Owner, e.OperatorIds, e.Cluster)
+	case "ClusterDeposited":
+		e, err := filterer.ParseClusterDeposited(l)
+		if err != nil {
+			return err
+		}
+		c.setCluster(e.Owner, e.OperatorIds, e.Cluster)
+	case "ClusterWithdrawn":
+		e, err := filterer.ParseClusterWithdrawn(l)
+		if err != nil {
+			return err
+		}
+		c.setCluster(e.Owner, e.OperatorIds, e.Cluster)
+	}
+	return nil
 }
 
 func ssvExporter() {
+	cfg := utils.Config.SSVExporter
+	if cfg.NetworkAddress == "" {
+		log.Warnf("ssv exporter is enabled but no ssv network address is configured")
+		return
+	}
+	client, err := ethclient.Dial(utils.Config.Eth1GethEndpoint)
+	if err != nil {
+		log.Fatal(err, "new ssv exporter geth client error", 0)
+	}
+	networkAddress := common.HexToAddress(cfg.NetworkAddress)
+	filterer, err := ssv.NewSSVNetworkFilterer(networkAddress, client)
+	if err != nil {
+		log.Fatal(err, "error initializing ssv network filterer", 0)
+	}
+
+	var nameCursor uint64
 	for {
-		err := exportSSV()
+		start := time.Now()
+		err := exportSsvNetwork(context.Background(), client, filterer, networkAddress, cfg.DeploymentBlock)
 		if err != nil {
-			log.Error(err, "error exporting ssv validators", 0)
+			log.Error(err, "error exporting ssv network", 0)
+		} else {
+			metrics.TaskDuration.WithLabelValues("ssv_exporter_network").Observe(time.Since(start).Seconds())
 		}
-		log.Warnf("connection to ssv-exporter closed, reconnecting")
-		time.Sleep(time.Second * 10)
+
+		if cfg.ApiAddress != "" {
+			nameCursor, err = updateSsvOperatorNames(context.Background(), cfg.ApiAddress, nameCursor)
+			if err != nil {
+				log.Error(err, "error updating ssv operator names", 0)
+			}
+		}
+
+		start = time.Now()
+		err = exportSsvOperatorPerformance(context.Background())
+		if err != nil {
+			log.Error(err, "error exporting ssv operator performance", 0)
+		} else {
+			metrics.TaskDuration.WithLabelValues("ssv_exporter_operator_performance").Observe(time.Since(start).Seconds())
+		}
+		time.Sleep(time.Minute * 10)
 	}
 }
 
-func exportSSV() error {
-	c, r, err := websocket.DefaultDialer.Dial(utils.Config.SSVExporter.Address, nil)
+// exportSsvNetwork applies the events of the ssv network contract since the last export, the state is saved per log interval.
+// Only finalized blocks are exported, polled logs of reorged blocks are never reported as removed and applied events can't be reverted.
+func exportSsvNetwork(ctx context.Context, client *ethclient.Client, filterer *ssv.SSVNetworkFilterer, networkAddress common.Address, deploymentBlock uint64) error {
+	networkAbi, err := ssv.SSVNetworkMetaData.GetAbi()
 	if err != nil {
 		return err
 	}
-	defer c.Close()
-	defer r.Body.Close()
+	eventNames := make(map[common.Hash]string, len(networkAbi.Events))
+	eventIds := make([]common.Hash, 0, len(networkAbi.Events))
+	for name, event := range networkAbi.Events {
+		eventNames[event.ID] = name
+		eventIds = append(eventIds, event.ID)
+	}
 
-	done := make(chan struct{})
+	var lastBlock uint64
+	err = db.WriterDb.GetContext(ctx, &lastBlock, `SELECT COALESCE(MAX(last_block), 0) FROM ssv_sync_state WHERE network_address = $1`, networkAddress.Bytes())
+	if err != nil {
+		return fmt.Errorf("error getting last exported ssv block: %w", err)
+	}
+	finalizedHeader, err := client.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return fmt.Errorf("error getting finalized block: %w", err)
+	}
+	finalizedBlock := finalizedHeader.Number.Uint64()
 
-	go func() {
-		defer close(done)
-		for {
-			_, message, err := c.ReadMessage()
-			if err != nil {
-				log.Error(err, "error reading message from ssv-exporter", 0)
-				return
-			}
+	fromBlock := max(lastBlock+1, deploymentBlock)
+	for from := fromBlock; from <= finalizedBlock; from += GethEventLogInterval {
+		to := min(from+GethEventLogInterval-1, finalizedBlock)
+		logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: []common.Address{networkAddress},
+			Topics:    [][]common.Hash{eventIds},
+		})
+		if err != nil {
+			return fmt.Errorf("error filtering ssv network logs of blocks %v-%v: %w", from, to, err)
+		}
 
-			t0 := time.Now()
-			res := SSVExporterResponse{}
-			err = json.Unmarshal(message, &res)
-			if err != nil {
-				log.Error(err, "error unmarshaling json from ssv-exporter", 0)
+		changes := newSsvChanges()
+		for _, l := range logs {
+			if l.Removed || len(l.Topics) == 0 {
 				continue
 			}
-			log.InfoWithFields(log.Fields{"number": len(res.Data)}, "exporting ssv validators")
-			err = saveSSV(&res)
+			err = changes.applyLog(filterer, eventNames[l.Topics[0]], l)
 			if err != nil {
-				log.Error(err, "error tagging ssv validators", 0)
-				continue
+				return fmt.Errorf("error parsing ssv network log %v of tx %v: %w", l.Index, l.TxHash, err)
 			}
-			log.InfoWithFields(log.Fields{"number": len(res.Data), "duration": time.Since(t0)}, "tagged ssv validators")
 		}
-	}()
 
-	qryValidatorsTicker := time.NewTicker(time.Minute * 10)
-	defer qryValidatorsTicker.Stop()
-
-	for {
-		err := c.WriteMessage(websocket.TextMessage, []byte(`{"type":"validator","filter":{"from":0}}`))
+		// the tags of the former websocket based export are replaced by the ones of the contract events
+		err = saveSsvChanges(ctx, changes, networkAddress, to, lastBlock == 0 && from == fromBlock)
 		if err != nil {
 			return err
 		}
-		select {
-		case <-qryValidatorsTicker.C:
-			continue
-		case <-done:
-			return nil
-		}
+		log.InfoWithFields(log.Fields{"from": from, "to": to, "operators": len(changes.Operators), "clusters": len(changes.Clusters), "validators": len(changes.Validators)}, "exported ssv network events")
 	}
+	return nil
 }
 
-func saveSSV(res *SSVExporterResponse) error {
-	tx, err := db.WriterDb.Beginx()
+func saveSsvChanges(ctx context.Context, changes *ssvChanges, networkAddress common.Address, lastBlock uint64, resetTags bool) error {
+	tx, err := db.WriterDb.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer utils.Rollback(tx)
 
-	// for now make sure to correct wrongly marked validators
-	for {
-		res, err := tx.Exec(`delete from validator_tags where publickey in (select publickey from validator_tags where tag = 'ssv' limit 1000)`)
+	if resetTags {
+		_, err = tx.ExecContext(ctx, `DELETE FROM validator_tags WHERE tag = $1`, ssvTag)
 		if err != nil {
-			return err
+			return fmt.Errorf("error removing ssv validator tags: %w", err)
 		}
-		rows, err := res.RowsAffected()
-		if err != nil {
-			return err
+	}
+
+	for _, operator := range changes.Operators {
+		var fee *string
+		if operator.Fee != nil {
+			f := operator.Fee.String()
+			fee = &f
 		}
-		if rows == 0 {
-			break
+		if operator.Added {
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO ssv_operators (operator_id, owner, public_key, fee, removed)
+				VALUES ($1, $2, $3, COALESCE($4::numeric, 0), $5)
+				ON CONFLICT (operator_id) DO UPDATE SET
+					owner = excluded.owner,
+					public_key = excluded.public_key,
+					fee = excluded.fee,
+					removed = excluded.removed`,
+				operator.Id, operator.Owner.Bytes(), operator.PublicKey, fee, operator.Removed)
+		} else {
+			_, err = tx.ExecContext(ctx, `UPDATE ssv_operators SET fee = COALESCE($2::numeric, fee), removed = removed OR $3 WHERE operator_id = $1`,
+				operator.Id, fee, operator.Removed)
+		}
+		if err != nil {
+			return fmt.Errorf("error saving ssv operator %v: %w", operator.Id, err)
 		}
-		time.Sleep(time.Millisecond * 100)
 	}
 
+	clusters := make([]*ssvCluster, 0, len(changes.Clusters))
+	for _, cluster := range changes.Clusters {
+		clusters = append(clusters, cluster)
+	}
 	batchSize := 5000
-	for b := 0; b < len(res.Data); b += batchSize {
-		start := b
-		end := b + batchSize
-		if len(res.Data) < end {
-			end = len(res.Data)
-		}
-		n := 1
-		valueStrings := make([]string, 0, batchSize)
-		valueArgs := make([]interface{}, 0, batchSize*n)
-		for i, d := range res.Data[start:end] {
-			valueStrings = append(valueStrings, fmt.Sprintf("($%d, 'ssv')", i*n+1))
-			pubkey, err := hex.DecodeString(strings.Replace(d.Publickey, "0x", "", -1))
-			if err != nil {
-				return err
-			}
-			valueArgs = append(valueArgs, pubkey)
+	for b := 0; b < len(clusters); b += batchSize {
+		end := min(b+batchSize, len(clusters))
+		n := 6
+		valueStrings := make([]string, 0, end-b)
+		valueArgs := make([]interface{}, 0, (end-b)*n)
+		for i, cluster := range clusters[b:end] {
+			valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)", i*n+1, i*n+2, i*n+3, i*n+4, i*n+5, i*n+6))
+			valueArgs = append(valueArgs, cluster.Id, cluster.Owner.Bytes(), ssvOperatorIdsArray(cluster.OperatorIds), cluster.ValidatorCount, cluster.Active, cluster.Balance.String())
 		}
-		_, err := tx.Exec(fmt.Sprintf(`insert into validator_tags (publickey, tag) values %s on conflict (publickey, tag) do nothing`, strings.Join(valueStrings, ",")), valueArgs...)
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(`
+			INSERT INTO ssv_clusters (cluster_id, owner, operator_ids, validator_count, active, balance)
+			VALUES %s
+			ON CONFLICT (cluster_id) DO UPDATE SET
+				validator_count = excluded.validator_count,
+				active = excluded.active,
+				balance = excluded.balance`, strings.Join(valueStrings, ",")), valueArgs...)
 		if err != nil {
-			return err
+			return fmt.Errorf("error saving ssv clusters: %w", err)
 		}
 	}
 
-	// currently the ssv-exporter also exports publickeys that are not actually part of the network
-	for {
-		res, err := tx.Exec(`delete from validator_tags where publickey in (select publickey from validator_tags where publickey not in (select pubkey from validators) limit 1000)`)
+	validators := make([]*ssvValidator, 0, len(changes.Validators))
+	var added, removed [][]byte
+	for _, validator := range changes.Validators {
+		validators = append(validators, validator)
+		if validator.Removed {
+			removed = append(removed, validator.Pubkey)
+		} else {
+			added = append(added, validator.Pubkey)
+		}
+	}
+	for b := 0; b < len(validators); b += batchSize {
+		end := min(b+batchSize, len(validators))
+		n := 5
+		valueStrings := make([]string, 0, end-b)
+		valueArgs := make([]interface{}, 0, (end-b)*n)
+		for i, validator := range validators[b:end] {
+			valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)", i*n+1, i*n+2, i*n+3, i*n+4, i*n+5))
+			valueArgs = append(valueArgs, validator.Pubkey, validator.ClusterId, validator.Owner.Bytes(), ssvOperatorIdsArray(validator.OperatorIds), validator.Removed)
+		}
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(`
+			INSERT INTO ssv_validators (pubkey, cluster_id, owner, operator_ids, removed)
+			VALUES %s
+			ON CONFLICT (pubkey) DO UPDATE SET
+				cluster_id = excluded.cluster_id,
+				owner = excluded.owner,
+				operator_ids = excluded.operator_ids,
+				removed = excluded.removed`, strings.Join(valueStrings, ",")), valueArgs...)
 		if err != nil {
-			return err
+			return fmt.Errorf("error saving ssv validators: %w", err)
 		}
-		rows, err := res.RowsAffected()
+	}
+
+	if len(added) > 0 {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO validator_tags (publickey, tag)
+			SELECT pubkey, $2 FROM unnest($1::bytea[]) AS pubkey
+			ON CONFLICT (publickey, tag) DO NOTHING`, pq.ByteaArray(added), ssvTag)
 		if err != nil {
-			return err
+			return fmt.Errorf("error inserting into validator_tags: %w", err)
 		}
-		if rows == 0 {
-			break
+	}
+	if len(removed) > 0 {
+		_, err = tx.ExecContext(ctx, `DELETE FROM validator_tags WHERE tag = $2 AND publickey = ANY($1)`, pq.ByteaArray(removed), ssvTag)
+		if err != nil {
+			return fmt.Errorf("error removing from validator_tags: %w", err)
 		}
-		time.Sleep(time.Millisecond * 100)
 	}
 
-	err = tx.Commit()
+	// validators are registered with the ssv network before they are deposited, so their index is filled in on later runs
+	_, err = tx.ExecContext(ctx, `
+		UPDATE ssv_validators
+		SET validator_index = validators.validatorindex
+		FROM validators
+		WHERE ssv_validators.validator_index IS NULL AND ssv_validators.pubkey = validators.pubkey`)
 	if err != nil {
-		return err
+		return fmt.Errorf("error updating ssv_validators with validatorindex: %w", err)
 	}
 
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO ssv_sync_state (network_address, last_block) VALUES ($1, $2)
+		ON CONFLICT (network_address) DO UPDATE SET last_block = excluded.last_block`, networkAddress.Bytes(), lastBlock)
+	if err != nil {
+		return fmt.Errorf("error saving ssv sync state: %w", err)
+	}
+	return tx.Commit()
+}
+
+func ssvOperatorIdsArray(operatorIds []uint64) pq.Int64Array {
+	result := make(pq.Int64Array, len(operatorIds))
+	for i, id := range operatorIds {
+		result[i] = int64(id)
+	}
+	return result
+}
+
+// updateSsvOperatorNames fetches the names of up to 100 operators without a name, starting after the given operator id.
+// the id to continue with next time is returned so operators without a name in the api don't block the others.
+func updateSsvOperatorNames(ctx context.Context, apiAddress string, cursor uint64) (uint64, error) {
+	var ids []uint64
+	err := db.WriterDb.SelectContext(ctx, &ids, `
+		SELECT operator_id FROM ssv_operators WHERE name = '' AND NOT removed AND operator_id > $1 ORDER BY operator_id LIMIT 100`, cursor)
+	if err != nil {
+		return cursor, fmt.Errorf("error getting ssv operators without name: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	client := &http.Client{
+		Timeout: time.Second * 10,
+	}
+	for _, id := range ids {
+		cursor = id
+		name, err := fetchSsvOperatorName(ctx, client, apiAddress, id)
+		if err != nil {
+			log.WarnWithFields(log.Fields{"operator": id}, err.Error())
+			continue
+		}
+		if name != "" {
+			_, err = db.WriterDb.ExecContext(ctx, `UPDATE ssv_operators SET name = $1 WHERE operator_id = $2`, name, id)
+			if err != nil {
+				return cursor, fmt.Errorf("error saving name of ssv operator %v: %w", id, err)
+			}
+		}
+		// sleep for a bit to not run into the rate limit of the api
+		time.Sleep(time.Millisecond * 200)
+	}
+	return cursor, nil
+}
+
+func fetchSsvOperatorName(ctx context.Context, client *http.Client, apiAddress string, id uint64) (string, error) {
+	url := fmt.Sprintf("%s/operators/%d", strings.TrimSuffix(apiAddress, "/"), id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving ssv operator, url: %v: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error retrieving ssv operator, url: %v: status %v", url, resp.StatusCode)
+	}
+	var operator struct {
+		Name string `json:"name"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&operator)
+	if err != nil {
+		return "", fmt.Errorf("error decoding json for ssv operator, url: %v: %w", url, err)
+	}
+	return strings.TrimSpace(operator.Name), nil
+}
+
+type ssvValidatorDuties struct {
+	ValidatorIndex            uint64 `db:"validator_index"`
+	AttestationsScheduled     int64  `db:"attestations_scheduled"`
+	AttestationsExecuted      int64  `db:"attestations_executed"`
+	AttestationHeadExecuted   int64  `db:"attestation_head_executed"`
+	AttestationSourceExecuted int64  `db:"attestation_source_executed"`
+	AttestationTargetExecuted int64  `db:"attestation_target_executed"`
+}
+
+type ssvOperatorPerformance struct {
+	Validators                int64
+	AttestationsScheduled     int64
+	AttestationsExecuted      int64
+	AttestationHeadExecuted   int64
+	AttestationSourceExecuted int64
+	AttestationTargetExecuted int64
+}
+
+// aggregateSsvOperatorPerformance sums up the attestation duties of the validators per operator. the chain doesn't tell
+// which operators of a cluster signed, so every operator of the cluster is accountable for all duties of its validators.
+func aggregateSsvOperatorPerformance(operatorsPerValidator map[uint64][]uint64, duties []ssvValidatorDuties) map[uint64]*ssvOperatorPerformance {
+	result := make(map[uint64]*ssvOperatorPerformance)
+	for _, d := range duties {
+		for _, operatorId := range operatorsPerValidator[d.ValidatorIndex] {
+			p, ok := result[operatorId]
+			if !ok {
+				p = &ssvOperatorPerformance{}
+				result[operatorId] = p
+			}
+			p.Validators++
+			p.AttestationsScheduled += d.AttestationsScheduled
+			p.AttestationsExecuted += d.AttestationsExecuted
+			p.AttestationHeadExecuted += d.AttestationHeadExecuted
+			p.AttestationSourceExecuted += d.AttestationSourceExecuted
+			p.AttestationTargetExecuted += d.AttestationTargetExecuted
+		}
+	}
+	return result
+}
+
+// exportSsvOperatorPerformance computes the daily performance of the operators from the daily aggregates of the dashboard
+// data. the operators of the validators are the current ones, past changes of the clusters aren't taken into account.
+func exportSsvOperatorPerformance(ctx context.Context) error {
+	var latestDay *time.Time
+	err := db.AlloyWriter.GetContext(ctx, &latestDay, fmt.Sprintf(`SELECT MAX(day) FROM %s`, edb.DayWriterTableName))
+	if err != nil {
+		return fmt.Errorf("error getting latest aggregated day: %w", err)
+	}
+	if latestDay == nil {
+		return nil
+	}
+	fromDay := latestDay.AddDate(0, 0, -(ssvPerformanceDays - 1))
+	var lastDay *time.Time
+	err = db.WriterDb.GetContext(ctx, &lastDay, `SELECT MAX(day) FROM ssv_operator_performance`)
+	if err != nil {
+		return fmt.Errorf("error getting last day of ssv operator performance: %w", err)
+	}
+	if lastDay != nil && lastDay.After(fromDay) {
+		fromDay = *lastDay
+	}
+
+	var validators []struct {
+		ValidatorIndex uint64        `db:"validator_index"`
+		OperatorIds    pq.Int64Array `db:"operator_ids"`
+	}
+	err = db.WriterDb.SelectContext(ctx, &validators, `SELECT validator_index, operator_ids FROM ssv_validators WHERE NOT removed AND validator_index IS NOT NULL`)
+	if err != nil {
+		return fmt.Errorf("error getting ssv validators: %w", err)
+	}
+	if len(validators) == 0 {
+		return nil
+	}
+	operatorsPerValidator := make(map[uint64][]uint64, len(validators))
+	indices := make(pq.Int64Array, len(validators))
+	for i, v := range validators {
+		indices[i] = int64(v.ValidatorIndex)
+		for _, id := range v.OperatorIds {
+			operatorsPerValidator[v.ValidatorIndex] = append(operatorsPerValidator[v.ValidatorIndex], uint64(id))
+		}
+	}
+
+	for day := fromDay; !day.After(*latestDay); day = day.AddDate(0, 0, 1) {
+		var duties []ssvValidatorDuties
+		err = db.AlloyWriter.SelectContext(ctx, &duties, fmt.Sprintf(`
+			SELECT
+				validator_index,
+				COALESCE(attestations_scheduled, 0) AS attestations_scheduled,
+				COALESCE(attestations_executed, 0) AS attestations_executed,
+				COALESCE(attestation_head_executed, 0) AS attestation_head_executed,
+				COALESCE(attestation_source_executed, 0) AS attestation_source_executed,
+				COALESCE(attestation_target_executed, 0) AS attestation_target_executed
+			FROM %s
+			WHERE day = $1 AND validator_index = ANY($2)`, edb.DayWriterTableName), day, indices)
+		if err != nil {
+			return fmt.Errorf("error getting duties of ssv validators of day %v: %w", day.Format("2006-01-02"), err)
+		}
+		err = saveSsvOperatorPerformance(ctx, day, aggregateSsvOperatorPerformance(operatorsPerValidator, duties))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func saveSsvOperatorPerformance(ctx context.Context, day time.Time, performance map[uint64]*ssvOperatorPerformance) error {
+	if len(performance) == 0 {
+		return nil
+	}
+	n := 8
+	valueStrings := make([]string, 0, len(performance))
+	valueArgs := make([]interface{}, 0, len(performance)*n)
+	i := 0
+	for operatorId, p := range performance {
+		valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)", i*n+1, i*n+2, i*n+3, i*n+4, i*n+5, i*n+6, i*n+7, i*n+8))
+		valueArgs = append(valueArgs, operatorId, day, p.Validators, p.AttestationsScheduled, p.AttestationsExecuted, p.AttestationHeadExecuted, p.AttestationSourceExecuted, p.AttestationTargetExecuted)
+		i++
+	}
+	_, err := db.WriterDb.ExecContext(ctx, fmt.Sprintf(`
+		INSERT INTO ssv_operator_performance (operator_id, day, validators, attestations_scheduled, attestations_executed, attestation_head_executed, attestation_source_executed, attestation_target_executed)
+		VALUES %s
+		ON CONFLICT (operator_id, day) DO UPDATE SET
+			validators = excluded.validators,
+			attestations_scheduled = excluded.attestations_scheduled,
+			attestations_executed = excluded.attestations_executed,
+			attestation_head_executed = excluded.attestation_head_executed,
+			attestation_source_executed = excluded.attestation_source_executed,
+			attestation_target_executed = excluded.attestation_target_executed`, strings.Join(valueStrings, ",")), valueArgs...)
+	if err != nil {
+		return fmt.Errorf("error saving ssv operator performance of day %v: %w", day.Format("2006-01-02"), err)
+	}
 	return nil
 }
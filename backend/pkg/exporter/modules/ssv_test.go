@@ -0,0 +1,144 @@
+package modules
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/contracts/ssv"
+)
+
+func TestSsvChanges(t *testing.T) {
+	owner := common.HexToAddress("0x1")
+	operatorIds := []uint64{1, 2, 3, 4}
+	pubkey := []byte{0xaa}
+	cluster := func(validators uint32, active bool) ssv.ISSVNetworkCoreCluster {
+		return ssv.ISSVNetworkCoreCluster{ValidatorCount: validators, Active: active, Balance: big.NewInt(int64(validators))}
+	}
+
+	changes := newSsvChanges()
+	changes.addOperator(1, owner, []byte{0x01}, big.NewInt(100))
+	changes.setOperatorFee(1, big.NewInt(200))
+	changes.removeOperator(2)
+	changes.setValidator(owner, operatorIds, pubkey, false)
+	changes.setCluster(owner, operatorIds, cluster(1, true))
+	changes.setValidator(owner, operatorIds, pubkey, true)
+	changes.setCluster(owner, operatorIds, cluster(0, true))
+	changes.setCluster(owner, operatorIds, cluster(0, false))
+
+	if o := changes.Operators[1]; !o.Added || o.Removed || o.Fee.Int64() != 200 {
+		t.Errorf("unexpected operator 1 %+v", o)
+	}
+	if o := changes.Operators[2]; o.Added || !o.Removed || o.Fee != nil {
+		t.Errorf("unexpected operator 2 %+v", o)
+	}
+	if len(changes.Validators) != 1 || len(changes.Clusters) != 1 {
+		t.Fatalf("expected 1 validator and 1 cluster, got %d and %d", len(changes.Validators), len(changes.Clusters))
+	}
+	for _, v := range changes.Validators {
+		if !v.Removed || !bytes.Equal(v.ClusterId, ssvClusterId(owner, operatorIds)) {
+			t.Errorf("unexpected validator %+v", v)
+		}
+	}
+	for _, c := range changes.Clusters {
+		if c.Active || c.ValidatorCount != 0 {
+			t.Errorf("unexpected cluster %+v", c)
+		}
+	}
+
+	// a validator that is added again after it was removed is part of the network
+	changes.setValidator(owner, operatorIds, pubkey, false)
+	for _, v := range changes.Validators {
+		if v.Removed {
+			t.Errorf("expected validator to be added again")
+		}
+	}
+}
+
+func TestSsvClusterId(t *testing.T) {
+	owner := common.HexToAddress("0x1")
+	id := ssvClusterId(owner, []uint64{1, 2, 3, 4})
+	if len(id) != 32 {
+		t.Fatalf("expected a 32 byte cluster id, got %d bytes", len(id))
+	}
+	if bytes.Equal(id, ssvClusterId(owner, []uint64{1, 2, 3, 5})) {
+		t.Errorf("expected different cluster ids for different operators")
+	}
+	if bytes.Equal(id, ssvClusterId(common.HexToAddress("0x2"), []uint64{1, 2, 3, 4})) {
+		t.Errorf("expected different cluster ids for different owners")
+	}
+}
+
+func TestAggregateSsvOperatorPerformance(t *testing.T) {
+	operatorsPerValidator := map[uint64][]uint64{
+		10: {1, 2, 3, 4},
+		11: {1, 5, 6, 7},
+	}
+	duties := []ssvValidatorDuties{
+		{ValidatorIndex: 10, AttestationsScheduled: 225, AttestationsExecuted: 225, AttestationHeadExecuted: 220},
+		{ValidatorIndex: 11, AttestationsScheduled: 225, AttestationsExecuted: 200, AttestationHeadExecuted: 190},
+		// not an ssv validator
+		{ValidatorIndex: 12, AttestationsScheduled: 225},
+	}
+
+	performance := aggregateSsvOperatorPerformance(operatorsPerValidator, duties)
+	if len(performance) != 7 {
+		t.Fatalf("expected performance of 7 operators, got %d", len(performance))
+	}
+	if p := performance[1]; p.Validators != 2 || p.AttestationsScheduled != 450 || p.AttestationsExecuted != 425 || p.AttestationHeadExecuted != 410 {
+		t.Errorf("unexpected performance of operator 1 %+v", p)
+	}
+	if p := performance[2]; p.Validators != 1 || p.AttestationsScheduled != 225 || p.AttestationsExecuted != 225 {
+		t.Errorf("unexpected performance of operator 2 %+v", p)
+	}
+	if p := performance[5]; p.Validators != 1 || p.AttestationsExecuted != 200 {
+		t.Errorf("unexpected performance of operator 5 %+v", p)
+	}
+}
+
+func TestSsvApplyClusterBalanceLogs(t *testing.T) {
+	networkAbi, err := ssv.SSVNetworkMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	filterer, err := ssv.NewSSVNetworkFilterer(common.HexToAddress("0x55"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := common.HexToAddress("0x1")
+	operatorIds := []uint64{1, 2, 3, 4}
+	// a top up or withdrawal only changes the balance of the cluster
+	clusterLog := func(name string, value, balance int64) gethtypes.Log {
+		event := networkAbi.Events[name]
+		data, err := event.Inputs.NonIndexed().Pack(operatorIds, big.NewInt(value), ssv.ISSVNetworkCoreCluster{ValidatorCount: 2, Active: true, Balance: big.NewInt(balance)})
+		if err != nil {
+			t.Fatalf("error packing %s: %v", name, err)
+		}
+		return gethtypes.Log{Topics: []common.Hash{event.ID, common.BytesToHash(owner.Bytes())}, Data: data}
+	}
+
+	changes := newSsvChanges()
+	for _, l := range []struct {
+		name string
+		log  gethtypes.Log
+	}{
+		{"ClusterDeposited", clusterLog("ClusterDeposited", 500, 1500)},
+		{"ClusterWithdrawn", clusterLog("ClusterWithdrawn", 300, 1200)},
+	} {
+		if err := changes.applyLog(filterer, l.name, l.log); err != nil {
+			t.Fatalf("error applying %s: %v", l.name, err)
+		}
+		c := changes.Clusters[string(ssvClusterId(owner, operatorIds))]
+		if c == nil {
+			t.Fatalf("expected %s to set the cluster", l.name)
+		}
+		if c.Owner != owner || c.ValidatorCount != 2 || !c.Active {
+			t.Errorf("unexpected cluster after %s %+v", l.name, c)
+		}
+	}
+	if balance := changes.Clusters[string(ssvClusterId(owner, operatorIds))].Balance; balance.Int64() != 1200 {
+		t.Errorf("expected the balance after the withdrawal, got %v", balance)
+	}
+}
@@ -0,0 +1,122 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gobitfly/beaconchain/pkg/commons/contracts/etherfi"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+)
+
+// ether.fi validators are identified by the ValidatorRegistered events of the staking manager, the operators by the
+// OperatorRegistered events of the node operator manager; operators are identified by their address
+type etherFiStakingProtocol struct {
+	client          *ethclient.Client
+	stakingManager  *etherfi.StakingManagerFilterer
+	operatorManager *etherfi.NodeOperatorManagerFilterer
+	deploymentBlock uint64
+	treasuryFee     float64
+	operatorFee     float64
+}
+
+func newEtherFiStakingProtocol(client *ethclient.Client, stakingManagerAddress, operatorManagerAddress string, deploymentBlock uint64, treasuryFee, operatorFee float64) (*etherFiStakingProtocol, error) {
+	// unlike the lido fees, the ether.fi fees are configured, so a misconfiguration is reported on startup
+	if err := validateStakingProtocolFees(treasuryFee, operatorFee); err != nil {
+		return nil, err
+	}
+	stakingManager, err := etherfi.NewStakingManagerFilterer(common.HexToAddress(stakingManagerAddress), client)
+	if err != nil {
+		return nil, err
+	}
+	protocol := &etherFiStakingProtocol{
+		client:          client,
+		stakingManager:  stakingManager,
+		deploymentBlock: deploymentBlock,
+		treasuryFee:     treasuryFee,
+		operatorFee:     operatorFee,
+	}
+	if operatorManagerAddress != "" {
+		protocol.operatorManager, err = etherfi.NewNodeOperatorManagerFilterer(common.HexToAddress(operatorManagerAddress), client)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return protocol, nil
+}
+
+func (e *etherFiStakingProtocol) Name() string {
+	return types.StakingProtocolEtherFi
+}
+
+func (e *etherFiStakingProtocol) Export(ctx context.Context, state *stakingProtocolState) (*stakingProtocolExport, error) {
+	head, err := e.client.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	export := &stakingProtocolExport{
+		TreasuryFee: e.treasuryFee,
+		OperatorFee: e.operatorFee,
+		LastBlock:   head,
+	}
+
+	// only operators that registered or got validators in the scanned range are updated
+	operators := make(map[string]*stakingProtocolOperator)
+	operator := func(address common.Address) *stakingProtocolOperator {
+		id := hexutil.Encode(address.Bytes())
+		if _, ok := operators[id]; !ok {
+			operators[id] = &stakingProtocolOperator{
+				Id:             id,
+				RewardAddress:  address.Bytes(),
+				Active:         true,
+				ValidatorCount: state.ValidatorsPerOperator[id],
+			}
+		}
+		return operators[id]
+	}
+
+	fromBlock := max(state.LastBlock+1, e.deploymentBlock)
+	for from := fromBlock; from <= head; from += GethEventLogInterval {
+		to := min(from+GethEventLogInterval-1, head)
+		opts := &bind.FilterOpts{Start: from, End: &to, Context: ctx}
+
+		if e.operatorManager != nil {
+			registrations, err := e.operatorManager.FilterOperatorRegistered(opts)
+			if err != nil {
+				return nil, fmt.Errorf("error filtering ether.fi operator registrations: %w", err)
+			}
+			for registrations.Next() {
+				operator(registrations.Event.User)
+			}
+			if err := registrations.Error(); err != nil {
+				return nil, fmt.Errorf("error iterating ether.fi operator registrations: %w", err)
+			}
+			registrations.Close()
+		}
+
+		validators, err := e.stakingManager.FilterValidatorRegistered(opts, nil, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error filtering ether.fi validator registrations: %w", err)
+		}
+		for validators.Next() {
+			o := operator(validators.Event.Operator)
+			o.ValidatorCount++
+			export.Validators = append(export.Validators, stakingProtocolValidator{
+				Pubkey:     validators.Event.ValidatorPubKey,
+				OperatorId: o.Id,
+			})
+		}
+		if err := validators.Error(); err != nil {
+			return nil, fmt.Errorf("error iterating ether.fi validator registrations: %w", err)
+		}
+		validators.Close()
+	}
+
+	for _, o := range operators {
+		export.Operators = append(export.Operators, *o)
+	}
+	return export, nil
+}
@@ -0,0 +1,114 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gobitfly/beaconchain/pkg/commons/contracts/lido"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+)
+
+// lido validators are identified by the signing keys of the operators in the curated staking module,
+// only keys that have been deposited belong to validators
+type lidoStakingProtocol struct {
+	client        *ethclient.Client
+	registry      *lido.NodeOperatorsRegistryCaller
+	stakingRouter *lido.StakingRouterCaller
+}
+
+const lidoSigningKeysBatchSize = 100
+
+func newLidoStakingProtocol(client *ethclient.Client, registryAddress, stakingRouterAddress string) (*lidoStakingProtocol, error) {
+	registry, err := lido.NewNodeOperatorsRegistryCaller(common.HexToAddress(registryAddress), client)
+	if err != nil {
+		return nil, err
+	}
+	stakingRouter, err := lido.NewStakingRouterCaller(common.HexToAddress(stakingRouterAddress), client)
+	if err != nil {
+		return nil, err
+	}
+	return &lidoStakingProtocol{client: client, registry: registry, stakingRouter: stakingRouter}, nil
+}
+
+func (l *lidoStakingProtocol) Name() string {
+	return types.StakingProtocolLido
+}
+
+func (l *lidoStakingProtocol) Export(ctx context.Context, state *stakingProtocolState) (*stakingProtocolExport, error) {
+	head, err := l.client.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// read everything at the same block so that the operators and their keys are consistent
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(head)}
+
+	fees, err := l.stakingRouter.GetStakingFeeAggregateDistributionE4Precision(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting lido fee distribution: %w", err)
+	}
+	export := &stakingProtocolExport{LastBlock: head}
+	export.TreasuryFee, export.OperatorFee = lidoFees(fees.TreasuryFee, fees.ModulesFee)
+
+	count, err := l.registry.GetNodeOperatorsCount(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting lido node operator count: %w", err)
+	}
+	for id := uint64(0); id < count.Uint64(); id++ {
+		operatorId := new(big.Int).SetUint64(id)
+		operator, err := l.registry.GetNodeOperator(opts, operatorId, true)
+		if err != nil {
+			return nil, fmt.Errorf("error getting lido node operator %d: %w", id, err)
+		}
+		export.Operators = append(export.Operators, stakingProtocolOperator{
+			Id:             strconv.FormatUint(id, 10),
+			Name:           operator.Name,
+			RewardAddress:  operator.RewardAddress.Bytes(),
+			Active:         operator.Active,
+			ValidatorCount: operator.TotalDepositedValidators,
+		})
+
+		validators, err := lidoOperatorValidators(id, state.ValidatorsPerOperator[strconv.FormatUint(id, 10)], operator.TotalDepositedValidators, func(offset, limit uint64) ([]byte, error) {
+			keys, err := l.registry.GetSigningKeys(opts, operatorId, new(big.Int).SetUint64(offset), new(big.Int).SetUint64(limit))
+			return keys.Pubkeys, err
+		})
+		if err != nil {
+			return nil, err
+		}
+		export.Validators = append(export.Validators, validators...)
+	}
+	return export, nil
+}
+
+// lidoFees converts the fees of the staking router, which are reported with a precision of 1e4 (100% = 10000)
+func lidoFees(treasuryFeeE4, modulesFeeE4 uint16) (treasuryFee, operatorFee float64) {
+	return float64(treasuryFeeE4) / 1e4, float64(modulesFeeE4) / 1e4
+}
+
+// lidoOperatorValidators returns the validators of the signing keys of an operator that have been deposited since the
+// last export. Keys are only ever appended once they are deposited, so the exported keys are a prefix of the deposited
+// ones; getSigningKeys returns the concatenated pubkeys of the keys in the given range.
+func lidoOperatorValidators(id, exported, deposited uint64, getSigningKeys func(offset, limit uint64) ([]byte, error)) ([]stakingProtocolValidator, error) {
+	var validators []stakingProtocolValidator
+	for offset := exported; offset < deposited; offset += lidoSigningKeysBatchSize {
+		limit := min(lidoSigningKeysBatchSize, deposited-offset)
+		pubkeys, err := getSigningKeys(offset, limit)
+		if err != nil {
+			return nil, fmt.Errorf("error getting signing keys of lido node operator %d: %w", id, err)
+		}
+		if len(pubkeys) != int(limit)*48 {
+			return nil, fmt.Errorf("unexpected length of signing keys of lido node operator %d: %d", id, len(pubkeys))
+		}
+		for i := 0; i < len(pubkeys); i += 48 {
+			validators = append(validators, stakingProtocolValidator{
+				Pubkey:     pubkeys[i : i+48],
+				OperatorId: strconv.FormatUint(id, 10),
+			})
+		}
+	}
+	return validators, nil
+}
@@ -0,0 +1,226 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/metrics"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/jmoiron/sqlx"
+)
+
+// stakingProtocol is implemented by the modules that identify the validators of a staking protocol on chain
+type stakingProtocol interface {
+	Name() string
+	// Export returns the fee split and the operators of the protocol together with the validators added since the given state
+	Export(ctx context.Context, state *stakingProtocolState) (*stakingProtocolExport, error)
+}
+
+// stakingProtocolState is what has been exported for a protocol so far
+type stakingProtocolState struct {
+	LastBlock             uint64
+	ValidatorsPerOperator map[string]uint64
+}
+
+type stakingProtocolExport struct {
+	// shares of the rewards of the validators that are kept by the protocol and paid to the operators
+	TreasuryFee float64
+	OperatorFee float64
+	Operators   []stakingProtocolOperator
+	Validators  []stakingProtocolValidator
+	LastBlock   uint64
+}
+
+type stakingProtocolOperator struct {
+	Id             string
+	Name           string
+	RewardAddress  []byte
+	Active         bool
+	ValidatorCount uint64
+}
+
+type stakingProtocolValidator struct {
+	Pubkey     []byte
+	OperatorId string
+}
+
+func stakingProtocolsExporter() {
+	client, err := ethclient.Dial(utils.Config.Eth1GethEndpoint)
+	if err != nil {
+		log.Fatal(err, "new staking protocols geth client error", 0)
+	}
+
+	protocols := []stakingProtocol{}
+	if cfg := utils.Config.StakingProtocolsExporter.Lido; cfg.NodeOperatorsRegistryAddress != "" {
+		protocol, err := newLidoStakingProtocol(client, cfg.NodeOperatorsRegistryAddress, cfg.StakingRouterAddress)
+		if err != nil {
+			log.Fatal(err, "error initializing lido staking protocol", 0)
+		}
+		protocols = append(protocols, protocol)
+	}
+	if cfg := utils.Config.StakingProtocolsExporter.EtherFi; cfg.StakingManagerAddress != "" {
+		protocol, err := newEtherFiStakingProtocol(client, cfg.StakingManagerAddress, cfg.NodeOperatorManagerAddress, cfg.DeploymentBlock, cfg.TreasuryFee, cfg.OperatorFee)
+		if err != nil {
+			log.Fatal(err, "error initializing ether.fi staking protocol", 0)
+		}
+		protocols = append(protocols, protocol)
+	}
+	if len(protocols) == 0 {
+		log.Warnf("staking protocols exporter is enabled but no protocol is configured")
+		return
+	}
+
+	for {
+		for _, protocol := range protocols {
+			start := time.Now()
+			err := exportStakingProtocol(context.Background(), db.WriterDb, protocol)
+			if err != nil {
+				log.Error(err, "error exporting staking protocol", 0, log.Fields{"protocol": protocol.Name()})
+				continue
+			}
+			metrics.TaskDuration.WithLabelValues("staking_protocol_" + protocol.Name()).Observe(time.Since(start).Seconds())
+		}
+		time.Sleep(time.Minute * 10)
+	}
+}
+
+// validateStakingProtocolFees checks that the fees are shares of the rewards that add up to at most 100%
+func validateStakingProtocolFees(treasuryFee, operatorFee float64) error {
+	if treasuryFee < 0 || treasuryFee > 1 || operatorFee < 0 || operatorFee > 1 {
+		return fmt.Errorf("fees must be between 0 and 1, got treasury fee %v and operator fee %v", treasuryFee, operatorFee)
+	}
+	if treasuryFee+operatorFee > 1 {
+		return fmt.Errorf("fees must not exceed the rewards, got treasury fee %v and operator fee %v", treasuryFee, operatorFee)
+	}
+	return nil
+}
+
+func getStakingProtocolState(ctx context.Context, dbConn *sqlx.DB, protocol string) (*stakingProtocolState, error) {
+	state := &stakingProtocolState{ValidatorsPerOperator: make(map[string]uint64)}
+	err := dbConn.GetContext(ctx, &state.LastBlock, `SELECT COALESCE(MAX(last_block), 0) FROM staking_protocols WHERE protocol = $1`, protocol)
+	if err != nil {
+		return nil, fmt.Errorf("error getting last block of %s: %w", protocol, err)
+	}
+	var counts []struct {
+		OperatorId string `db:"operator_id"`
+		Count      uint64 `db:"count"`
+	}
+	err = dbConn.SelectContext(ctx, &counts, `SELECT operator_id, COUNT(*) AS count FROM staking_protocol_validators WHERE protocol = $1 GROUP BY operator_id`, protocol)
+	if err != nil {
+		return nil, fmt.Errorf("error getting validator counts of %s: %w", protocol, err)
+	}
+	for _, count := range counts {
+		state.ValidatorsPerOperator[count.OperatorId] = count.Count
+	}
+	return state, nil
+}
+
+func exportStakingProtocol(ctx context.Context, dbConn *sqlx.DB, protocol stakingProtocol) error {
+	state, err := getStakingProtocolState(ctx, dbConn, protocol.Name())
+	if err != nil {
+		return err
+	}
+	export, err := protocol.Export(ctx, state)
+	if err != nil {
+		return err
+	}
+	// the fees are deducted from the rewards of the validators on the dashboard, so they have to be a valid split
+	if err := validateStakingProtocolFees(export.TreasuryFee, export.OperatorFee); err != nil {
+		return fmt.Errorf("invalid fees of %s: %w", protocol.Name(), err)
+	}
+
+	tx, err := dbConn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer utils.Rollback(tx)
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO staking_protocols (protocol, treasury_fee, operator_fee, last_block, updated_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (protocol) DO UPDATE SET
+			treasury_fee = excluded.treasury_fee,
+			operator_fee = excluded.operator_fee,
+			last_block = excluded.last_block,
+			updated_at = excluded.updated_at`,
+		protocol.Name(), export.TreasuryFee, export.OperatorFee, export.LastBlock)
+	if err != nil {
+		return fmt.Errorf("error saving staking protocol: %w", err)
+	}
+
+	batchSize := 5000
+	for b := 0; b < len(export.Operators); b += batchSize {
+		end := min(b+batchSize, len(export.Operators))
+		n := 6
+		valueStrings := make([]string, 0, end-b)
+		valueArgs := make([]interface{}, 0, (end-b)*n)
+		for i, operator := range export.Operators[b:end] {
+			valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)", i*n+1, i*n+2, i*n+3, i*n+4, i*n+5, i*n+6))
+			valueArgs = append(valueArgs, protocol.Name(), operator.Id, operator.Name, operator.RewardAddress, operator.Active, operator.ValidatorCount)
+		}
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(`
+			INSERT INTO staking_protocol_operators (protocol, operator_id, name, reward_address, active, validator_count)
+			VALUES %s
+			ON CONFLICT (protocol, operator_id) DO UPDATE SET
+				name = excluded.name,
+				reward_address = excluded.reward_address,
+				active = excluded.active,
+				validator_count = excluded.validator_count`, strings.Join(valueStrings, ",")), valueArgs...)
+		if err != nil {
+			return fmt.Errorf("error saving staking protocol operators: %w", err)
+		}
+	}
+
+	for b := 0; b < len(export.Validators); b += batchSize {
+		end := min(b+batchSize, len(export.Validators))
+		n := 3
+		valueStrings := make([]string, 0, end-b)
+		tagStrings := make([]string, 0, end-b)
+		valueArgs := make([]interface{}, 0, (end-b)*n)
+		tagArgs := make([]interface{}, 0, end-b+1)
+		tagArgs = append(tagArgs, protocol.Name())
+		for i, validator := range export.Validators[b:end] {
+			valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, $%d)", i*n+1, i*n+2, i*n+3))
+			valueArgs = append(valueArgs, validator.Pubkey, protocol.Name(), validator.OperatorId)
+			tagStrings = append(tagStrings, fmt.Sprintf("($%d, $1)", i+2))
+			tagArgs = append(tagArgs, validator.Pubkey)
+		}
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(`
+			INSERT INTO staking_protocol_validators (pubkey, protocol, operator_id)
+			VALUES %s
+			ON CONFLICT (pubkey) DO NOTHING`, strings.Join(valueStrings, ",")), valueArgs...)
+		if err != nil {
+			return fmt.Errorf("error saving staking protocol validators: %w", err)
+		}
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(`INSERT INTO validator_tags (publickey, tag) VALUES %s ON CONFLICT (publickey, tag) DO NOTHING`, strings.Join(tagStrings, ",")), tagArgs...)
+		if err != nil {
+			return fmt.Errorf("error inserting into validator_tags: %w", err)
+		}
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(`INSERT INTO validator_pool (publickey, pool) VALUES %s ON CONFLICT (publickey) DO NOTHING`, strings.Join(tagStrings, ",")), tagArgs...)
+		if err != nil {
+			return fmt.Errorf("error inserting into validator_pool: %w", err)
+		}
+	}
+
+	// validators are usually exported before they are deposited, so their index is filled in on later runs
+	_, err = tx.ExecContext(ctx, `
+		UPDATE staking_protocol_validators
+		SET validator_index = validators.validatorindex
+		FROM validators
+		WHERE staking_protocol_validators.validator_index IS NULL AND staking_protocol_validators.pubkey = validators.pubkey`)
+	if err != nil {
+		return fmt.Errorf("error updating staking_protocol_validators with validatorindex: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	log.InfoWithFields(log.Fields{"protocol": protocol.Name(), "operators": len(export.Operators), "new validators": len(export.Validators)}, "exported staking protocol")
+	return nil
+}
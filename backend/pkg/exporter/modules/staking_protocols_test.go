@@ -0,0 +1,113 @@
+package modules
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestValidateStakingProtocolFees(t *testing.T) {
+	tests := []struct {
+		name        string
+		treasuryFee float64
+		operatorFee float64
+		valid       bool
+	}{
+		{"lido split", 0.05, 0.05, true},
+		{"no fees", 0, 0, true},
+		{"all rewards", 0.4, 0.6, true},
+		{"exceeding the rewards", 0.6, 0.6, false},
+		{"percentage instead of share", 10, 0, false},
+		{"negative fee", -0.05, 0.1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateStakingProtocolFees(tt.treasuryFee, tt.operatorFee)
+			if tt.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestNewEtherFiStakingProtocolFees(t *testing.T) {
+	if _, err := newEtherFiStakingProtocol(nil, "0x1", "", 0, 0.05, 0.05); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := newEtherFiStakingProtocol(nil, "0x1", "", 0, 5, 5); err == nil {
+		t.Errorf("expected an error for fees configured as percentages")
+	}
+}
+
+func TestLidoFees(t *testing.T) {
+	treasuryFee, operatorFee := lidoFees(500, 500)
+	if treasuryFee != 0.05 || operatorFee != 0.05 {
+		t.Errorf("expected fees of 5%% each, got treasury fee %v and operator fee %v", treasuryFee, operatorFee)
+	}
+	treasuryFee, operatorFee = lidoFees(10000, 0)
+	if treasuryFee != 1 || operatorFee != 0 {
+		t.Errorf("expected the treasury to get all rewards, got treasury fee %v and operator fee %v", treasuryFee, operatorFee)
+	}
+}
+
+func TestLidoOperatorValidators(t *testing.T) {
+	// the signing keys of operator 7, key i starts with i
+	keys := make([][]byte, 250)
+	for i := range keys {
+		keys[i] = bytes.Repeat([]byte{byte(i)}, 48)
+	}
+	type call struct{ offset, limit uint64 }
+	var calls []call
+	getSigningKeys := func(offset, limit uint64) ([]byte, error) {
+		calls = append(calls, call{offset, limit})
+		return slices.Concat(keys[offset : offset+limit]...), nil
+	}
+
+	tests := []struct {
+		name      string
+		exported  uint64
+		deposited uint64
+		calls     []call
+	}{
+		{"first export", 0, 250, []call{{0, 100}, {100, 100}, {200, 50}}},
+		{"keys deposited since the last export", 120, 230, []call{{120, 100}, {220, 10}}},
+		{"nothing deposited since the last export", 250, 250, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = nil
+			validators, err := lidoOperatorValidators(7, tt.exported, tt.deposited, getSigningKeys)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(calls, tt.calls) {
+				t.Errorf("expected signing key requests %v, got %v", tt.calls, calls)
+			}
+			if len(validators) != int(tt.deposited-tt.exported) {
+				t.Fatalf("expected %d validators, got %d", tt.deposited-tt.exported, len(validators))
+			}
+			for i, validator := range validators {
+				if validator.OperatorId != "7" || !bytes.Equal(validator.Pubkey, keys[tt.exported+uint64(i)]) {
+					t.Errorf("expected key %d of operator 7, got %x of operator %v", tt.exported+uint64(i), validator.Pubkey, validator.OperatorId)
+				}
+			}
+		})
+	}
+
+	_, err := lidoOperatorValidators(7, 0, 2, func(offset, limit uint64) ([]byte, error) {
+		return keys[0], nil
+	})
+	if err == nil {
+		t.Errorf("expected an error for a missing signing key")
+	}
+	_, err = lidoOperatorValidators(7, 0, 2, func(offset, limit uint64) ([]byte, error) {
+		return nil, errors.New("execution reverted")
+	})
+	if err == nil {
+		t.Errorf("expected the error of the registry to be returned")
+	}
+}
@@ -0,0 +1,296 @@
+// Package rewards computes the consensus layer rewards of the Altair and later forks following the consensus specs.
+// It only needs effective balances, committees and the attestations and sync aggregates of the blocks, so its results
+// are independent of the reward endpoints of the beacon node and can be used to cross check them.
+package rewards
+
+import (
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+)
+
+// ParticipationFlags are the timely source, target and head flags of the epoch participation of a validator
+type ParticipationFlags uint8
+
+const (
+	TimelySourceFlag ParticipationFlags = 1 << iota
+	TimelyTargetFlag
+	TimelyHeadFlag
+)
+
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/beacon-chain.md#incentivization-weights
+const (
+	timelySourceWeight = 14
+	timelyTargetWeight = 26
+	timelyHeadWeight   = 14
+	syncRewardWeight   = 2
+	proposerWeight     = 8
+	weightDenominator  = 64
+)
+
+var flagWeights = []struct {
+	flag   ParticipationFlags
+	weight uint64
+}{
+	{TimelySourceFlag, timelySourceWeight},
+	{TimelyTargetFlag, timelyTargetWeight},
+	{TimelyHeadFlag, timelyHeadWeight},
+}
+
+// Config holds the chain parameters the reward computation depends on
+type Config struct {
+	SlotsPerEpoch                      uint64
+	EffectiveBalanceIncrement          uint64
+	BaseRewardFactor                   uint64
+	MinAttestationInclusionDelay       uint64
+	SyncCommitteeSize                  uint64
+	MinEpochsToInactivityPenalty       uint64
+	InactivityScoreBias                uint64
+	InactivityPenaltyQuotientAltair    uint64
+	InactivityPenaltyQuotientBellatrix uint64
+	WhistleblowerRewardQuotient        uint64
+	WhistleblowerRewardQuotientElectra uint64
+	BellatrixForkEpoch                 uint64
+	DenebForkEpoch                     uint64
+	ElectraForkEpoch                   uint64
+}
+
+func NewConfig(c *types.ClChainConfig) Config {
+	return Config{
+		SlotsPerEpoch:                      c.SlotsPerEpoch,
+		EffectiveBalanceIncrement:          c.EffectiveBalanceIncrement,
+		BaseRewardFactor:                   c.BaseRewardFactor,
+		MinAttestationInclusionDelay:       c.MinAttestationInclusionDelay,
+		SyncCommitteeSize:                  c.SyncCommitteeSize,
+		MinEpochsToInactivityPenalty:       c.MinEpochsToInactivityPenalty,
+		InactivityScoreBias:                c.InactivityScoreBias,
+		InactivityPenaltyQuotientAltair:    c.InvactivityPenaltyQuotientAltair,
+		InactivityPenaltyQuotientBellatrix: c.InvactivityPenaltyQuotientBellatrix,
+		WhistleblowerRewardQuotient:        c.WhistleblowerRewardQuotient,
+		WhistleblowerRewardQuotientElectra: c.WhistleblowerRewardQuotientElectra,
+		BellatrixForkEpoch:                 c.BellatrixForkEpoch,
+		DenebForkEpoch:                     c.DenebForkEpoch,
+		ElectraForkEpoch:                   c.ElectraForkEpoch,
+	}
+}
+
+// AttestationFlags returns the flags an attestation earns when it is included with the given delay
+// (get_attestation_participation_flag_indices). The source of an included attestation always matches the justified
+// checkpoint as blocks with other sources are invalid.
+func (c Config) AttestationFlags(targetEpoch, inclusionDelay uint64, matchingTarget, matchingHead bool) ParticipationFlags {
+	var flags ParticipationFlags
+	if inclusionDelay <= integerSquareRoot(c.SlotsPerEpoch) {
+		flags |= TimelySourceFlag
+	}
+	// as of deneb the target flag is earned for the whole inclusion window (EIP-7045)
+	if matchingTarget && (targetEpoch >= c.DenebForkEpoch || inclusionDelay <= c.SlotsPerEpoch) {
+		flags |= TimelyTargetFlag
+	}
+	if matchingTarget && matchingHead && inclusionDelay == c.MinAttestationInclusionDelay {
+		flags |= TimelyHeadFlag
+	}
+	return flags
+}
+
+type Validator struct {
+	EffectiveBalance  uint64
+	Slashed           bool
+	ActivationEpoch   uint64
+	ExitEpoch         uint64
+	WithdrawableEpoch uint64
+}
+
+func (v *Validator) isActive(epoch uint64) bool {
+	return v.ActivationEpoch <= epoch && epoch < v.ExitEpoch
+}
+
+// IsSlashable reports whether a slashing of the validator included in the epoch is valid
+func (v *Validator) IsSlashable(epoch uint64) bool {
+	return !v.Slashed && v.ActivationEpoch <= epoch && epoch < v.WithdrawableEpoch
+}
+
+// EpochState is the validator registry as seen by the blocks of an epoch and by the epoch transition at its end
+type EpochState struct {
+	cfg                    Config
+	Epoch                  uint64
+	Validators             []Validator
+	TotalActiveBalance     uint64
+	BaseRewardPerIncrement uint64
+}
+
+func NewEpochState(cfg Config, epoch uint64, validators []Validator) *EpochState {
+	totalActiveBalance := uint64(0)
+	for i := range validators {
+		if validators[i].isActive(epoch) {
+			totalActiveBalance += validators[i].EffectiveBalance
+		}
+	}
+	totalActiveBalance = max(totalActiveBalance, cfg.EffectiveBalanceIncrement)
+
+	return &EpochState{
+		cfg:                    cfg,
+		Epoch:                  epoch,
+		Validators:             validators,
+		TotalActiveBalance:     totalActiveBalance,
+		BaseRewardPerIncrement: cfg.EffectiveBalanceIncrement * cfg.BaseRewardFactor / integerSquareRoot(totalActiveBalance),
+	}
+}
+
+func (s *EpochState) BaseReward(index uint64) uint64 {
+	if index >= uint64(len(s.Validators)) {
+		return 0
+	}
+	return s.Validators[index].EffectiveBalance / s.cfg.EffectiveBalanceIncrement * s.BaseRewardPerIncrement
+}
+
+// Participation mirrors the epoch participation of the state for a single epoch
+type Participation struct {
+	Epoch uint64
+	Flags map[uint64]ParticipationFlags
+}
+
+func NewParticipation(epoch uint64) *Participation {
+	return &Participation{
+		Epoch: epoch,
+		Flags: make(map[uint64]ParticipationFlags),
+	}
+}
+
+// Add sets the flags earned by the attesters of an attestation and returns the reward the including block gets for the
+// flags that were not set before. state is the epoch state of the including block, it may be nil if only the flags are of interest.
+func (p *Participation) Add(attesters []uint64, flags ParticipationFlags, state *EpochState) uint64 {
+	numerator := uint64(0)
+	for _, index := range attesters {
+		for _, w := range flagWeights {
+			if flags&w.flag == 0 || p.Flags[index]&w.flag != 0 {
+				continue
+			}
+			p.Flags[index] |= w.flag
+			if state != nil {
+				numerator += state.BaseReward(index) * w.weight
+			}
+		}
+	}
+	return numerator / ((weightDenominator - proposerWeight) * weightDenominator / proposerWeight)
+}
+
+// AttestationReward has the same components as the attestation rewards of the beacon node, penalties are negative
+type AttestationReward struct {
+	ValidatorIndex uint64
+	Head           int64
+	Target         int64
+	Source         int64
+	Inactivity     int64
+}
+
+// AttestationRewards computes the rewards and penalties for the participation in the previous epoch that are applied by
+// the epoch transition at the end of s.Epoch. finalizedEpoch is the finalized checkpoint after the justification processing
+// of that transition and inactivityScores are the scores after its inactivity updates. Missing scores are treated as zero,
+// which holds for every validator once the chain has been finalizing for a while.
+func (s *EpochState) AttestationRewards(participation *Participation, finalizedEpoch uint64, inactivityScores []uint64) []AttestationReward {
+	previousEpoch := participation.Epoch
+	inactivityLeak := previousEpoch > finalizedEpoch && previousEpoch-finalizedEpoch > s.cfg.MinEpochsToInactivityPenalty
+
+	participating := func(index uint64, flag ParticipationFlags) bool {
+		v := &s.Validators[index]
+		return !v.Slashed && v.isActive(previousEpoch) && participation.Flags[index]&flag != 0
+	}
+
+	participatingIncrements := make(map[ParticipationFlags]uint64, len(flagWeights))
+	for _, w := range flagWeights {
+		balance := uint64(0)
+		for index := range participation.Flags {
+			if index < uint64(len(s.Validators)) && participating(index, w.flag) {
+				balance += s.Validators[index].EffectiveBalance
+			}
+		}
+		participatingIncrements[w.flag] = max(balance, s.cfg.EffectiveBalanceIncrement) / s.cfg.EffectiveBalanceIncrement
+	}
+	activeIncrements := s.TotalActiveBalance / s.cfg.EffectiveBalanceIncrement
+
+	inactivityPenaltyQuotient := s.cfg.InactivityPenaltyQuotientAltair
+	if s.Epoch >= s.cfg.BellatrixForkEpoch {
+		inactivityPenaltyQuotient = s.cfg.InactivityPenaltyQuotientBellatrix
+	}
+
+	rewards := make([]AttestationReward, 0, len(s.Validators))
+	for i := range s.Validators {
+		index := uint64(i)
+		v := &s.Validators[i]
+		if !v.isActive(previousEpoch) && !(v.Slashed && previousEpoch+1 < v.WithdrawableEpoch) {
+			continue // not eligible
+		}
+
+		baseReward := s.BaseReward(index)
+		reward := AttestationReward{ValidatorIndex: index}
+		for _, w := range flagWeights {
+			var delta int64
+			if participating(index, w.flag) {
+				if !inactivityLeak {
+					delta = int64(baseReward * w.weight * participatingIncrements[w.flag] / (activeIncrements * weightDenominator))
+				}
+			} else if w.flag != TimelyHeadFlag {
+				delta = -int64(baseReward * w.weight / weightDenominator)
+			}
+
+			switch w.flag {
+			case TimelySourceFlag:
+				reward.Source = delta
+			case TimelyTargetFlag:
+				reward.Target = delta
+			case TimelyHeadFlag:
+				reward.Head = delta
+			}
+		}
+
+		if !participating(index, TimelyTargetFlag) && index < uint64(len(inactivityScores)) {
+			reward.Inactivity = -int64(v.EffectiveBalance * inactivityScores[index] / (s.cfg.InactivityScoreBias * inactivityPenaltyQuotient))
+		}
+		rewards = append(rewards, reward)
+	}
+	return rewards
+}
+
+// SyncAggregateRewards returns the reward or penalty of every sync committee member for the sync aggregate of a block
+// of the epoch and the reward of its proposer. Members with several seats in the committee get the sum of their seats.
+func (s *EpochState) SyncAggregateRewards(committee []uint64, bits []byte) (map[uint64]int64, uint64) {
+	totalActiveIncrements := s.TotalActiveBalance / s.cfg.EffectiveBalanceIncrement
+	totalBaseRewards := s.BaseRewardPerIncrement * totalActiveIncrements
+	maxParticipantRewards := totalBaseRewards * syncRewardWeight / weightDenominator / s.cfg.SlotsPerEpoch
+	participantReward := maxParticipantRewards / s.cfg.SyncCommitteeSize
+	proposerReward := participantReward * proposerWeight / (weightDenominator - proposerWeight)
+
+	rewards := make(map[uint64]int64, len(committee))
+	totalProposerReward := uint64(0)
+	for i, validator := range committee {
+		if i/8 < len(bits) && bits[i/8]&(1<<(i%8)) != 0 {
+			rewards[validator] += int64(participantReward)
+			totalProposerReward += proposerReward
+		} else {
+			rewards[validator] -= int64(participantReward)
+		}
+	}
+	return rewards, totalProposerReward
+}
+
+// WhistleblowerReward returns the reward of a proposer for including a slashing of the validator, the proposer is always
+// the whistleblower and receives the whole reward
+func (s *EpochState) WhistleblowerReward(index uint64) uint64 {
+	if index >= uint64(len(s.Validators)) {
+		return 0
+	}
+	quotient := s.cfg.WhistleblowerRewardQuotient
+	if s.Epoch >= s.cfg.ElectraForkEpoch {
+		quotient = s.cfg.WhistleblowerRewardQuotientElectra
+	}
+	return s.Validators[index].EffectiveBalance / quotient
+}
+
+// integerSquareRoot is the integer_squareroot of the specs
+func integerSquareRoot(n uint64) uint64 {
+	x := n
+	y := (x + 1) / 2
+	for y < x {
+		x = y
+		y = (x + n/x) / 2
+	}
+	return x
+}
@@ -0,0 +1,134 @@
+package rewards_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gobitfly/beaconchain/pkg/exporter/rewards"
+)
+
+// mainnet parameters with deneb and electra activating at epoch 100
+var cfg = rewards.Config{
+	SlotsPerEpoch:                      32,
+	EffectiveBalanceIncrement:          1e9,
+	BaseRewardFactor:                   64,
+	MinAttestationInclusionDelay:       1,
+	SyncCommitteeSize:                  512,
+	MinEpochsToInactivityPenalty:       4,
+	InactivityScoreBias:                4,
+	InactivityPenaltyQuotientAltair:    3 * 1 << 24,
+	InactivityPenaltyQuotientBellatrix: 1 << 24,
+	WhistleblowerRewardQuotient:        512,
+	WhistleblowerRewardQuotientElectra: 4096,
+	BellatrixForkEpoch:                 0,
+	DenebForkEpoch:                     100,
+	ElectraForkEpoch:                   100,
+}
+
+// testState has 4 active validators with 32 ETH, 178885 gwei base reward per increment
+func testState(epoch uint64) *rewards.EpochState {
+	validators := make([]rewards.Validator, 4)
+	for i := range validators {
+		validators[i] = rewards.Validator{EffectiveBalance: 32e9, ExitEpoch: math.MaxUint64, WithdrawableEpoch: math.MaxUint64}
+	}
+	return rewards.NewEpochState(cfg, epoch, validators)
+}
+
+func TestAttestationFlags(t *testing.T) {
+	all := rewards.TimelySourceFlag | rewards.TimelyTargetFlag | rewards.TimelyHeadFlag
+	tests := []struct {
+		name           string
+		targetEpoch    uint64
+		delay          uint64
+		matchingTarget bool
+		matchingHead   bool
+		expected       rewards.ParticipationFlags
+	}{
+		{"optimal", 10, 1, true, true, all},
+		{"late head", 10, 2, true, true, rewards.TimelySourceFlag | rewards.TimelyTargetFlag},
+		{"wrong head", 10, 1, true, false, rewards.TimelySourceFlag | rewards.TimelyTargetFlag},
+		{"wrong target", 10, 1, false, true, rewards.TimelySourceFlag},
+		{"late source", 10, 6, true, true, rewards.TimelyTargetFlag},
+		{"late target before deneb", 10, 33, true, true, 0},
+		{"late target after deneb", 100, 40, true, true, rewards.TimelyTargetFlag},
+	}
+	for _, test := range tests {
+		if flags := cfg.AttestationFlags(test.targetEpoch, test.delay, test.matchingTarget, test.matchingHead); flags != test.expected {
+			t.Errorf("%s: expected flags %b, got %b", test.name, test.expected, flags)
+		}
+	}
+}
+
+func TestAttestationRewards(t *testing.T) {
+	state := testState(11)
+	if state.BaseRewardPerIncrement != 178885 || state.BaseReward(0) != 5724320 {
+		t.Fatalf("unexpected base reward %d per increment and %d per validator", state.BaseRewardPerIncrement, state.BaseReward(0))
+	}
+
+	all := rewards.TimelySourceFlag | rewards.TimelyTargetFlag | rewards.TimelyHeadFlag
+	participation := rewards.NewParticipation(10)
+	participation.Add([]uint64{0, 1}, all, nil)
+	participation.Add([]uint64{2}, rewards.TimelySourceFlag|rewards.TimelyTargetFlag, nil)
+
+	expected := []rewards.AttestationReward{
+		{ValidatorIndex: 0, Head: 626097, Target: 1744128, Source: 939146},
+		{ValidatorIndex: 1, Head: 626097, Target: 1744128, Source: 939146},
+		{ValidatorIndex: 2, Head: 0, Target: 1744128, Source: 939146},
+		{ValidatorIndex: 3, Head: 0, Target: -2325505, Source: -1252195},
+	}
+	for i, reward := range state.AttestationRewards(participation, 9, nil) {
+		if reward != expected[i] {
+			t.Errorf("validator %d: expected %+v, got %+v", i, expected[i], reward)
+		}
+	}
+
+	// during an inactivity leak only penalties are applied
+	expected = []rewards.AttestationReward{
+		{ValidatorIndex: 0},
+		{ValidatorIndex: 1},
+		{ValidatorIndex: 2},
+		{ValidatorIndex: 3, Target: -2325505, Source: -1252195, Inactivity: -47683},
+	}
+	for i, reward := range state.AttestationRewards(participation, 5, []uint64{0, 0, 0, 100}) {
+		if reward != expected[i] {
+			t.Errorf("leak, validator %d: expected %+v, got %+v", i, expected[i], reward)
+		}
+	}
+}
+
+func TestProposerRewards(t *testing.T) {
+	state := testState(10)
+	all := rewards.TimelySourceFlag | rewards.TimelyTargetFlag | rewards.TimelyHeadFlag
+
+	participation := rewards.NewParticipation(10)
+	if reward := participation.Add([]uint64{0, 1}, all, state); reward != 1379970 {
+		t.Errorf("expected a proposer reward of 1379970 for two optimal attestations, got %d", reward)
+	}
+	if reward := participation.Add([]uint64{0, 1}, all, state); reward != 0 {
+		t.Errorf("expected no proposer reward for attestations already included, got %d", reward)
+	}
+
+	// validator 3 misses all its seats
+	committee := make([]uint64, 512)
+	bits := make([]byte, 64)
+	for i := range committee {
+		committee[i] = uint64(i % 4)
+		if i%4 != 3 {
+			bits[i/8] |= 1 << (i % 8)
+		}
+	}
+	syncRewards, proposerReward := state.SyncAggregateRewards(committee, bits)
+	if syncRewards[0] != 128*43 || syncRewards[3] != -128*43 {
+		t.Errorf("unexpected sync committee rewards %v", syncRewards)
+	}
+	if proposerReward != 384*6 {
+		t.Errorf("expected a proposer reward of %d for the sync aggregate, got %d", 384*6, proposerReward)
+	}
+
+	if reward := state.WhistleblowerReward(1); reward != 62500000 {
+		t.Errorf("unexpected whistleblower reward %d", reward)
+	}
+	if reward := testState(100).WhistleblowerReward(1); reward != 7812500 {
+		t.Errorf("unexpected electra whistleblower reward %d", reward)
+	}
+}
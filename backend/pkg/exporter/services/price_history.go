@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/metrics"
+	"github.com/gobitfly/beaconchain/pkg/commons/price"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+)
+
+// prices are fetched in chunks of this size, each chunk is saved before the next one is fetched
+const priceHistoryChunk = time.Hour * 24
+
+// StartPriceHistoryService keeps the hourly and daily price history up to date, on the first run it backfills from the configured start
+func StartPriceHistoryService() {
+	cfg := utils.Config.PriceHistory
+	sources, err := getPriceHistorySources()
+	if err != nil {
+		log.Error(err, "error initializing price history sources", 0)
+		return
+	}
+	if len(sources) == 0 {
+		log.Warnf("no price history sources configured, not starting price history service")
+		return
+	}
+	pairs := cfg.Pairs
+	if len(pairs) == 0 {
+		for pair := range price.ChainlinkFeedAddresses(utils.Config.Chain.ClConfig.DepositChainID) {
+			pairs = append(pairs, pair)
+		}
+	}
+	for i := range pairs {
+		pairs[i] = strings.ToUpper(pairs[i])
+	}
+	sort.Strings(pairs)
+
+	for {
+		err := updatePriceHistory(sources, pairs)
+		if err != nil {
+			log.Error(err, "error updating price history", 0)
+		}
+		time.Sleep(time.Minute * 10)
+	}
+}
+
+func getPriceHistorySources() ([]price.Source, error) {
+	cfg := utils.Config.PriceHistory
+	sources := []price.Source{}
+	if !cfg.DisableChainlink && len(price.ChainlinkFeedAddresses(utils.Config.Chain.ClConfig.DepositChainID)) > 0 {
+		client, err := ethclient.Dial(utils.Config.Eth1ErigonEndpoint)
+		if err != nil {
+			return nil, fmt.Errorf("error dialing price history eth1 endpoint: %w", err)
+		}
+		source, err := price.NewChainlinkSource(utils.Config.Chain.ClConfig.DepositChainID, client)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+	for _, path := range cfg.CsvFiles {
+		source, err := price.NewCsvSource(path)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+	for _, s := range cfg.HttpSources {
+		sources = append(sources, price.NewHttpSource(s.Name, s.Url, s.ItemsPath, s.TimestampField, s.PriceField, s.Pairs))
+	}
+	return sources, nil
+}
+
+func getPriceHistoryStart(pairs []string) (time.Time, error) {
+	start := utils.EpochToTime(0)
+	if utils.Config.PriceHistory.BackfillStart != "" {
+		var err error
+		start, err = time.Parse("2006-01-02", utils.Config.PriceHistory.BackfillStart)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid price history backfill start: %w", err)
+		}
+	}
+	// continue after the pair that is furthest behind
+	var earliest time.Time
+	for _, pair := range pairs {
+		ts, err := db.GetLatestPriceCandleTs(pair)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if ts.IsZero() {
+			return start.UTC().Truncate(time.Hour), nil
+		}
+		if earliest.IsZero() || ts.Before(earliest) {
+			earliest = ts
+		}
+	}
+	// the latest hour may have been incomplete when it was saved
+	return earliest.Add(-time.Hour), nil
+}
+
+func updatePriceHistory(sources []price.Source, pairs []string) error {
+	start := time.Now()
+	defer func() {
+		metrics.TaskDuration.WithLabelValues("service_price_history").Observe(time.Since(start).Seconds())
+	}()
+
+	from, err := getPriceHistoryStart(pairs)
+	if err != nil {
+		return err
+	}
+	// only completed hours are stored
+	end := time.Now().UTC().Truncate(time.Hour)
+	for ; from.Before(end); from = from.Add(priceHistoryChunk) {
+		to := from.Add(priceHistoryChunk)
+		if to.After(end) {
+			to = end
+		}
+		candles, err := getPriceHistoryCandles(sources, pairs, from, to)
+		if err != nil {
+			return err
+		}
+		err = db.SaveHourlyPriceCandles(candles)
+		if err != nil {
+			return err
+		}
+		log.Infof("exported price history from %v to %v (%v candles)", from.Format(time.RFC3339), to.Format(time.RFC3339), len(candles))
+	}
+	return nil
+}
+
+// getPriceHistoryCandles aggregates the quotes of all sources and derives the pairs between all currencies quoted in USD
+func getPriceHistoryCandles(sources []price.Source, pairs []string, from, to time.Time) ([]price.Candle, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	defer cancel()
+
+	usdCandles := map[string][]price.Candle{}
+	result := []price.Candle{}
+	for _, pair := range pairs {
+		quotes, err := price.FetchQuotes(ctx, sources, pair, from, to)
+		if err != nil {
+			return nil, err
+		}
+		candles := price.AggregateHourly(pair, quotes)
+		result = append(result, candles...)
+		if base, quote, _ := strings.Cut(pair, "/"); quote == "USD" {
+			usdCandles[base] = candles
+		}
+	}
+	for a, aCandles := range usdCandles {
+		for b, bCandles := range usdCandles {
+			if a == b {
+				continue
+			}
+			result = append(result, price.CrossCandles(a+"/"+b, aCandles, bCandles)...)
+		}
+	}
+	return result, nil
+}
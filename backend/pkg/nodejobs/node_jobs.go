@@ -91,6 +91,8 @@ func CreateNodeJob(data []byte) (*types.NodeJob, error) {
 		return CreateBLSToExecutionChangesNodeJob(j)
 	case types.VoluntaryExitsNodeJobType:
 		return CreateVoluntaryExitNodeJob(j)
+	case types.SignedTransactionNodeJobType:
+		return CreateSignedTransactionNodeJob(j)
 	}
 }
 
@@ -104,6 +106,10 @@ func UpdateNodeJobs() error {
 	if err != nil {
 		return fmt.Errorf("error updating voluntary-exit-job: %w", err)
 	}
+	err = UpdateSignedTransactionNodeJobs()
+	if err != nil {
+		return fmt.Errorf("error updating signed-transaction-job: %w", err)
+	}
 	return nil
 }
 
@@ -117,6 +123,10 @@ func SubmitNodeJobs() error {
 	if err != nil {
 		return err
 	}
+	err = SubmitSignedTransactionNodeJobs()
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -126,6 +136,7 @@ func CreateBLSToExecutionChangesNodeJob(nj *types.NodeJob) (*types.NodeJob, erro
 	}
 	nj.ID = uuid.New().String()
 	nj.Status = types.PendingNodeJobStatus
+	nj.CreatedTime = time.Now()
 
 	opsByIndex := map[uint64]*capella.SignedBLSToExecutionChange{}
 	opsToCheck := map[uint64]bool{}
@@ -172,7 +183,7 @@ func CreateBLSToExecutionChangesNodeJob(nj *types.NodeJob) (*types.NodeJob, erro
 		delete(opsToCheck, v.Index)
 	}
 	if len(opsToCheck) > 0 {
-		return nil, fmt.Errorf("could not check all validators")
+		return nil, types.CreateNodeJobUserError{Message: "some validators could not be found"}
 	}
 
 	tx, err := db.WriterDb.Beginx()
@@ -215,7 +226,7 @@ func CreateBLSToExecutionChangesNodeJob(nj *types.NodeJob) (*types.NodeJob, erro
 		}
 	}
 
-	_, err = tx.Exec(`insert into node_jobs (id, type, status, data, created_time) values ($1, $2, $3, $4, now())`, nj.ID, nj.Type, nj.Status, nj.RawData)
+	_, err = tx.Exec(`insert into node_jobs (id, type, status, data, created_time) values ($1, $2, $3, $4, $5)`, nj.ID, nj.Type, nj.Status, nj.RawData, nj.CreatedTime)
 	if err != nil {
 		return nil, fmt.Errorf("error inserting into node_jobs: %w", err)
 	}
@@ -340,14 +351,15 @@ func SubmitBLSToExecutionChangesNodeJob(job *types.NodeJob) error {
 
 func CreateVoluntaryExitNodeJob(nj *types.NodeJob) (*types.NodeJob, error) {
 	if len(nj.RawData) > 5e3 {
-		return nil, fmt.Errorf("data size exceeds maximum")
+		return nil, types.CreateNodeJobUserError{Message: "data-size exceeds maximum of 5KB"}
 	}
 	nj.ID = uuid.New().String()
 	nj.Status = types.PendingNodeJobStatus
+	nj.CreatedTime = time.Now()
 
 	njd, ok := nj.GetVoluntaryExitsNodeJobData()
 	if !ok {
-		return nil, fmt.Errorf("invalid job")
+		return nil, types.CreateNodeJobUserError{Message: "invalid data"}
 	}
 
 	vali := struct {
@@ -355,25 +367,28 @@ func CreateVoluntaryExitNodeJob(nj *types.NodeJob) (*types.NodeJob, error) {
 		Status string `db:"status"`
 	}{}
 	err := db.WriterDb.Get(&vali, `select pubkey, status from validators where validatorindex = $1`, njd.Message.ValidatorIndex)
+	if err == sql.ErrNoRows {
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("validator with index %v not found", njd.Message.ValidatorIndex)}
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	switch constypes.ValidatorDbStatus(vali.Status) {
 	case constypes.DbExited, constypes.DbExitingOffline, constypes.DbExitingOnline:
-		return nil, fmt.Errorf("validator has exited")
+		return nil, types.CreateNodeJobUserError{Message: "validator has exited"}
 	case constypes.DbSlashed, constypes.DbSlashingOffline, constypes.DbSlashingOnline:
-		return nil, fmt.Errorf("validator has been slashed")
+		return nil, types.CreateNodeJobUserError{Message: "validator has been slashed"}
 	default:
 	}
 
 	forkVersion := utils.ForkVersionAtEpoch(uint64(njd.Message.Epoch))
 	err = utils.VerifyVoluntaryExitSignature(njd, forkVersion.CurrentVersion, vali.Pubkey)
 	if err != nil {
-		return nil, err
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("can not verify signature: %v", err)}
 	}
 
-	_, err = db.WriterDb.Exec(`insert into node_jobs (id, type, status, data, created_time) values ($1, $2, $3, $4, now())`, nj.ID, nj.Type, nj.Status, nj.RawData)
+	_, err = db.WriterDb.Exec(`insert into node_jobs (id, type, status, data, created_time) values ($1, $2, $3, $4, $5)`, nj.ID, nj.Type, nj.Status, nj.RawData, nj.CreatedTime)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,324 @@
+package nodejobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gobitfly/beaconchain/pkg/commons/db"
+	"github.com/gobitfly/beaconchain/pkg/commons/log"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+	"github.com/google/uuid"
+)
+
+// submitted transactions that dropped out of the mempool of the node are rebroadcasted until they are older than this
+const maxSignedTransactionPendingTime = time.Hour
+
+// every signed transaction job gets its own deadline so a slow node can not exhaust the time of the jobs after it
+const signedTransactionNodeJobTimeout = time.Second * 10
+
+func dialSignedTransactionElClient() (*ethclient.Client, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), signedTransactionNodeJobTimeout)
+	defer cancel()
+	return dialElClient(ctx)
+}
+
+func dialElClient(ctx context.Context) (*ethclient.Client, error) {
+	if utils.Config.NodeJobsProcessor.ElEndpoint == "" {
+		return nil, fmt.Errorf("no el endpoint configured for node jobs")
+	}
+	client, err := ethclient.DialContext(ctx, utils.Config.NodeJobsProcessor.ElEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error dialing el endpoint: %w", err)
+	}
+	return client, nil
+}
+
+func CreateSignedTransactionNodeJob(nj *types.NodeJob) (*types.NodeJob, error) {
+	if len(nj.RawData) > 3e5 {
+		return nil, types.CreateNodeJobUserError{Message: "data-size exceeds maximum of 300KB"}
+	}
+	nj.ID = uuid.New().String()
+	nj.Status = types.PendingNodeJobStatus
+	nj.CreatedTime = time.Now()
+
+	tx, ok := nj.GetSignedTransactionNodeJobData()
+	if !ok {
+		return nil, types.CreateNodeJobUserError{Message: "invalid transaction encoding"}
+	}
+	if tx.Type() == gethtypes.BlobTxType {
+		return nil, types.CreateNodeJobUserError{Message: "blob transactions are not supported"}
+	}
+	if !tx.Protected() {
+		return nil, types.CreateNodeJobUserError{Message: "transaction is not replay-protected"}
+	}
+	chainId := new(big.Int).SetUint64(utils.Config.Chain.ClConfig.DepositChainID)
+	if tx.ChainId().Cmp(chainId) != 0 {
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("transaction chain id %v does not match network chain id %v", tx.ChainId(), chainId)}
+	}
+	from, err := gethtypes.Sender(gethtypes.LatestSignerForChainID(chainId), tx)
+	if err != nil {
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("can not verify signature: %v", err)}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	client, err := dialElClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	nonce, err := client.NonceAt(ctx, from, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting nonce of %v: %w", from, err)
+	}
+	if tx.Nonce() < nonce {
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("nonce too low: account %v has nonce %v, transaction has nonce %v", from, nonce, tx.Nonce())}
+	}
+	balance, err := client.BalanceAt(ctx, from, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance of %v: %w", from, err)
+	}
+	if balance.Cmp(tx.Cost()) < 0 {
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("insufficient funds: account %v has a balance of %v wei, transaction costs up to %v wei", from, balance, tx.Cost())}
+	}
+
+	var existingJobs []string
+	err = db.WriterDb.Select(&existingJobs, `select id from node_jobs where type = $1 and data = $2::jsonb and status in ($3, $4)`, nj.Type, string(nj.RawData), types.PendingNodeJobStatus, types.SubmittedToNodeNodeJobStatus)
+	if err != nil {
+		return nil, err
+	}
+	if len(existingJobs) > 0 {
+		return nil, types.CreateNodeJobUserError{Message: fmt.Sprintf("there is already a job for this transaction: %v", existingJobs[0])}
+	}
+
+	_, err = db.WriterDb.Exec(`insert into node_jobs (id, type, status, data, created_time) values ($1, $2, $3, $4, $5)`, nj.ID, nj.Type, nj.Status, nj.RawData, nj.CreatedTime)
+	if err != nil {
+		return nil, err
+	}
+	log.InfoWithFields(log.Fields{"id": nj.ID, "type": nj.Type, "hash": tx.Hash().Hex()}, "created node_job")
+	return nj, nil
+}
+
+func UpdateSignedTransactionNodeJobs() error {
+	jobs := []*types.NodeJob{}
+	err := db.WriterDb.Select(&jobs, `select id, type, status, created_time, submitted_to_node_time, completed_time, data from node_jobs where type = $1 and status = $2`, types.SignedTransactionNodeJobType, types.SubmittedToNodeNodeJobStatus)
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	client, err := dialSignedTransactionElClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	for _, job := range jobs {
+		err := job.ParseData()
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), signedTransactionNodeJobTimeout)
+		err = UpdateSignedTransactionNodeJob(ctx, client, job)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateSignedTransactionNodeJob tracks a submitted transaction until it is included, reverted transactions are marked as failed.
+// Transactions that got dropped by the node are rebroadcasted, transactions whose nonce got used by a different transaction are marked as failed.
+func UpdateSignedTransactionNodeJob(ctx context.Context, client *ethclient.Client, job *types.NodeJob) error {
+	status, err := signedTransactionNodeJobStatus(ctx, client, job)
+	if err != nil {
+		return err
+	}
+	switch status {
+	case types.CompletedNodeJobStatus:
+		return setNodeJobCompleted(job)
+	case types.FailedNodeJobStatus:
+		return setNodeJobFailed(job)
+	}
+	return nil
+}
+
+// signedTransactionNodeJobStatus returns the new status of a submitted transaction, the status of the job is returned unchanged while the transaction is pending
+func signedTransactionNodeJobStatus(ctx context.Context, client *ethclient.Client, job *types.NodeJob) (types.NodeJobStatus, error) {
+	tx, ok := job.GetSignedTransactionNodeJobData()
+	if !ok {
+		return "", fmt.Errorf("invalid job-data")
+	}
+
+	status, err := signedTransactionReceiptStatus(ctx, client, job, tx)
+	if err != nil || status != "" {
+		return status, err
+	}
+
+	from, err := gethtypes.Sender(gethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return "", err
+	}
+	nonce, err := client.NonceAt(ctx, from, nil)
+	if err != nil {
+		return "", fmt.Errorf("error getting nonce of %v: %w", from, err)
+	}
+	if nonce > tx.Nonce() {
+		// the transaction itself may have been included since the receipt was looked up
+		status, err := signedTransactionReceiptStatus(ctx, client, job, tx)
+		if err != nil || status != "" {
+			return status, err
+		}
+		log.WarnWithFields(log.Fields{"id": job.ID, "hash": tx.Hash().Hex(), "nonce": tx.Nonce()}, "transaction of node_job got replaced")
+		return types.FailedNodeJobStatus, nil
+	}
+
+	_, _, err = client.TransactionByHash(ctx, tx.Hash())
+	if err == nil {
+		// still pending in the mempool of the node
+		return job.Status, nil
+	}
+	if !errors.Is(err, ethereum.NotFound) {
+		return "", fmt.Errorf("error getting transaction %v: %w", tx.Hash(), err)
+	}
+	if time.Since(job.SubmittedToNodeTime.Time) > maxSignedTransactionPendingTime {
+		log.WarnWithFields(log.Fields{"id": job.ID, "hash": tx.Hash().Hex()}, "transaction of node_job got dropped")
+		return types.FailedNodeJobStatus, nil
+	}
+	err = client.SendTransaction(ctx, tx)
+	if err != nil && !isKnownTransactionError(err) {
+		log.WarnWithFields(log.Fields{"id": job.ID, "hash": tx.Hash().Hex(), "error": err}, "failed rebroadcasting transaction of node_job")
+		return job.Status, nil
+	}
+	log.InfoWithFields(log.Fields{"id": job.ID, "hash": tx.Hash().Hex()}, "rebroadcasted transaction of node_job")
+	return job.Status, nil
+}
+
+// signedTransactionReceiptStatus returns the status of an included transaction, an empty status if the transaction is not included (yet)
+func signedTransactionReceiptStatus(ctx context.Context, client *ethclient.Client, job *types.NodeJob, tx *gethtypes.Transaction) (types.NodeJobStatus, error) {
+	receipt, err := client.TransactionReceipt(ctx, tx.Hash())
+	if errors.Is(err, ethereum.NotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("error getting receipt of transaction %v: %w", tx.Hash(), err)
+	}
+	if receipt.Status != gethtypes.ReceiptStatusSuccessful {
+		log.WarnWithFields(log.Fields{"id": job.ID, "hash": tx.Hash().Hex(), "block": receipt.BlockNumber}, "transaction of node_job reverted")
+		return types.FailedNodeJobStatus, nil
+	}
+	return types.CompletedNodeJobStatus, nil
+}
+
+func SubmitSignedTransactionNodeJobs() error {
+	maxSubmittedJobs := 1000
+	jobs := []*types.NodeJob{}
+	err := db.WriterDb.Select(&jobs, `select id, type, status, created_time, submitted_to_node_time, completed_time, data from node_jobs where type = $1 and status = $2 order by created_time limit $4-(select count(*) from node_jobs where type = $1 and status = $3)`, types.SignedTransactionNodeJobType, types.PendingNodeJobStatus, types.SubmittedToNodeNodeJobStatus, maxSubmittedJobs)
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	client, err := dialSignedTransactionElClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	for _, job := range jobs {
+		err = job.ParseData()
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), signedTransactionNodeJobTimeout)
+		err = SubmitSignedTransactionNodeJob(ctx, client, job)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("error calling SubmitSignedTransactionNodeJob for job %v: %w", job.ID, err)
+		}
+	}
+	return nil
+}
+
+func SubmitSignedTransactionNodeJob(ctx context.Context, client *ethclient.Client, job *types.NodeJob) error {
+	tx, ok := job.GetSignedTransactionNodeJobData()
+	if !ok {
+		return fmt.Errorf("invalid job-data")
+	}
+	jobStatus := types.SubmittedToNodeNodeJobStatus
+	err := client.SendTransaction(ctx, tx)
+	if err != nil && !isKnownTransactionError(err) {
+		if !isRejectedTransactionError(err) {
+			// timeouts and connection problems say nothing about the transaction, keep the job pending and retry it in the next run
+			log.WarnWithFields(log.Fields{"error": err, "hash": tx.Hash().Hex(), "jobID": job.ID, "jobType": job.Type}, "could not reach node for submitting a job, retrying later")
+			return nil
+		}
+		jobStatus = types.FailedNodeJobStatus
+		log.WarnWithFields(log.Fields{"error": err, "hash": tx.Hash().Hex(), "jobID": job.ID, "jobType": job.Type}, "failed submitting a job")
+	}
+	job.Status = jobStatus
+	job.SubmittedToNodeTime.Time = time.Now()
+	job.SubmittedToNodeTime.Valid = true
+	_, err = db.WriterDb.Exec(`update node_jobs set status = $1, submitted_to_node_time = $2 where id = $3`, job.Status, job.SubmittedToNodeTime.Time, job.ID)
+	if err != nil {
+		return err
+	}
+	log.InfoWithFields(log.Fields{"id": job.ID, "type": job.Type, "status": jobStatus}, "submitted node_job")
+	return nil
+}
+
+// the node already has the transaction in its mempool
+func isKnownTransactionError(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "already known")
+}
+
+// the node answered the request and refused the transaction (nonce too low, underpriced, invalid sender, ...),
+// as opposed to context and transport errors where the transaction never got judged by the node
+func isRejectedTransactionError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	// a node without eth_sendRawTransaction did not look at the transaction either
+	return rpcErr.ErrorCode() != -32601
+}
+
+func setNodeJobCompleted(job *types.NodeJob) error {
+	job.Status = types.CompletedNodeJobStatus
+	job.CompletedTime.Time = time.Now()
+	job.CompletedTime.Valid = true
+	_, err := db.WriterDb.Exec(`update node_jobs set status = $1, completed_time = $2 where id = $3`, job.Status, job.CompletedTime.Time, job.ID)
+	if err != nil {
+		return err
+	}
+	log.InfoWithFields(log.Fields{"id": job.ID, "type": job.Type, "status": job.Status}, "updated node_job")
+	return nil
+}
+
+func setNodeJobFailed(job *types.NodeJob) error {
+	job.Status = types.FailedNodeJobStatus
+	_, err := db.WriterDb.Exec(`update node_jobs set status = $1 where id = $2`, job.Status, job.ID)
+	if err != nil {
+		return err
+	}
+	log.InfoWithFields(log.Fields{"id": job.ID, "type": job.Type, "status": job.Status}, "updated node_job")
+	return nil
+}
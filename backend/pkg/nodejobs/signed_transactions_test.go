@@ -0,0 +1,346 @@
+package nodejobs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gobitfly/beaconchain/pkg/commons/types"
+	"github.com/gobitfly/beaconchain/pkg/commons/utils"
+)
+
+// elStubError is returned by an elStubHandler to answer a call with a json-rpc error
+type elStubError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type elStubHandler func(method string, params []json.RawMessage) (interface{}, *elStubError)
+
+// newElStub starts a json-rpc server answering the calls of an el client, the called methods are recorded in calls
+func newElStub(t *testing.T, handler elStubHandler) (*httptest.Server, *[]string) {
+	calls := &[]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("error decoding json-rpc request: %v", err)
+			return
+		}
+		*calls = append(*calls, req.Method)
+		result, rpcErr := handler(req.Method, req.Params)
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.Id}
+		if rpcErr != nil {
+			resp["error"] = rpcErr
+		} else {
+			resp["result"] = result
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("error encoding json-rpc response: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, calls
+}
+
+func signTestTransaction(t *testing.T, key *ecdsa.PrivateKey, chainId int64, nonce uint64) *gethtypes.Transaction {
+	to := common.HexToAddress("0xb0b")
+	return gethtypes.MustSignNewTx(key, gethtypes.LatestSignerForChainID(big.NewInt(chainId)), &gethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(chainId),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(1e10),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1e18),
+	})
+}
+
+func newSignedTransactionNodeJob(t *testing.T, tx *gethtypes.Transaction) *types.NodeJob {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatalf("error encoding transaction: %v", err)
+	}
+	data, err := json.Marshal(hexutil.Bytes(raw))
+	if err != nil {
+		t.Fatalf("error encoding job data: %v", err)
+	}
+	job, err := types.NewNodeJob(data)
+	if err != nil {
+		t.Fatalf("error creating job: %v", err)
+	}
+	return job
+}
+
+func TestCreateSignedTransactionNodeJobRejections(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	tx := signTestTransaction(t, key, 1, 5)
+	// a signature that doesn't recover to any account
+	invalidSig := make([]byte, 65)
+	invalidSig[63] = 1
+	invalidSignature, err := tx.WithSignature(gethtypes.LatestSignerForChainID(big.NewInt(1)), invalidSig)
+	if err != nil {
+		t.Fatalf("error replacing signature: %v", err)
+	}
+	unprotected, err := gethtypes.SignNewTx(key, gethtypes.HomesteadSigner{}, &gethtypes.LegacyTx{Nonce: 5, GasPrice: big.NewInt(1e10), Gas: 21000})
+	if err != nil {
+		t.Fatalf("error signing transaction: %v", err)
+	}
+	// the account has nonce 5 and just enough funds for the transaction
+	account := func(method string, params []json.RawMessage) (interface{}, *elStubError) {
+		switch method {
+		case "eth_getTransactionCount":
+			return hexutil.Uint64(5), nil
+		case "eth_getBalance":
+			return (*hexutil.Big)(tx.Cost()), nil
+		}
+		return nil, &elStubError{Code: -32601, Message: "method not found"}
+	}
+
+	tests := []struct {
+		name    string
+		tx      *gethtypes.Transaction
+		handler elStubHandler
+		calls   []string
+		message string // empty if the job fails with an error of the node instead of a user error
+	}{
+		{"different chain id", signTestTransaction(t, key, 17000, 5), account, []string{}, "does not match network chain id"},
+		{"invalid signature", invalidSignature, account, []string{}, "can not verify signature"},
+		{"not replay protected", unprotected, account, []string{}, "not replay-protected"},
+		{"nonce too low", signTestTransaction(t, key, 1, 4), account, []string{"eth_getTransactionCount"}, "nonce too low"},
+		{"insufficient funds", tx, func(method string, params []json.RawMessage) (interface{}, *elStubError) {
+			if method == "eth_getBalance" {
+				return (*hexutil.Big)(new(big.Int).Sub(tx.Cost(), big.NewInt(1))), nil
+			}
+			return account(method, params)
+		}, []string{"eth_getTransactionCount", "eth_getBalance"}, "insufficient funds"},
+		{"node error", tx, func(method string, params []json.RawMessage) (interface{}, *elStubError) {
+			return nil, &elStubError{Code: -32000, Message: "header not found"}
+		}, []string{"eth_getTransactionCount"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newElStub(t, tt.handler)
+			utils.Config = &types.Config{}
+			utils.Config.Chain.ClConfig.DepositChainID = 1
+			utils.Config.NodeJobsProcessor.ElEndpoint = server.URL
+
+			_, err := CreateSignedTransactionNodeJob(newSignedTransactionNodeJob(t, tt.tx))
+			var userErr types.CreateNodeJobUserError
+			if tt.message == "" {
+				if err == nil || errors.As(err, &userErr) {
+					t.Errorf("expected an error of the node, got %v", err)
+				}
+			} else if !errors.As(err, &userErr) || !strings.Contains(userErr.Message, tt.message) {
+				t.Errorf("expected a user error containing %q, got %v", tt.message, err)
+			}
+			if !slices.Equal(*calls, tt.calls) {
+				t.Errorf("expected calls %v, got %v", tt.calls, *calls)
+			}
+		})
+	}
+}
+
+func TestSignedTransactionNodeJobStatus(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	tx := signTestTransaction(t, key, 1, 5)
+	receipt := func(status uint64) *gethtypes.Receipt {
+		return &gethtypes.Receipt{Status: status, TxHash: tx.Hash(), Logs: []*gethtypes.Log{}, BlockNumber: big.NewInt(100), GasUsed: 21000, CumulativeGasUsed: 21000}
+	}
+
+	type chainState struct {
+		receipts    []*gethtypes.Receipt // returned by consecutive receipt lookups, the last one is repeated
+		nonce       uint64
+		inMempool   bool
+		receiptErr  bool
+		submittedAt time.Duration
+	}
+	tests := []struct {
+		name   string
+		state  chainState
+		status types.NodeJobStatus
+		calls  []string
+		err    bool
+	}{
+		{
+			name:   "included",
+			state:  chainState{receipts: []*gethtypes.Receipt{receipt(gethtypes.ReceiptStatusSuccessful)}, nonce: 6},
+			status: types.CompletedNodeJobStatus,
+			calls:  []string{"eth_getTransactionReceipt"},
+		},
+		{
+			name:   "reverted",
+			state:  chainState{receipts: []*gethtypes.Receipt{receipt(gethtypes.ReceiptStatusFailed)}, nonce: 6},
+			status: types.FailedNodeJobStatus,
+			calls:  []string{"eth_getTransactionReceipt"},
+		},
+		{
+			name:   "included after the receipt lookup",
+			state:  chainState{receipts: []*gethtypes.Receipt{nil, receipt(gethtypes.ReceiptStatusSuccessful)}, nonce: 6},
+			status: types.CompletedNodeJobStatus,
+			calls:  []string{"eth_getTransactionReceipt", "eth_getTransactionCount", "eth_getTransactionReceipt"},
+		},
+		{
+			name:   "replaced",
+			state:  chainState{receipts: []*gethtypes.Receipt{nil}, nonce: 6},
+			status: types.FailedNodeJobStatus,
+			calls:  []string{"eth_getTransactionReceipt", "eth_getTransactionCount", "eth_getTransactionReceipt"},
+		},
+		{
+			name:   "pending",
+			state:  chainState{receipts: []*gethtypes.Receipt{nil}, nonce: 5, inMempool: true},
+			status: types.SubmittedToNodeNodeJobStatus,
+			calls:  []string{"eth_getTransactionReceipt", "eth_getTransactionCount", "eth_getTransactionByHash"},
+		},
+		{
+			name:   "dropped",
+			state:  chainState{receipts: []*gethtypes.Receipt{nil}, nonce: 5},
+			status: types.SubmittedToNodeNodeJobStatus,
+			calls:  []string{"eth_getTransactionReceipt", "eth_getTransactionCount", "eth_getTransactionByHash", "eth_sendRawTransaction"},
+		},
+		{
+			name:   "dropped for too long",
+			state:  chainState{receipts: []*gethtypes.Receipt{nil}, nonce: 5, submittedAt: maxSignedTransactionPendingTime + time.Minute},
+			status: types.FailedNodeJobStatus,
+			calls:  []string{"eth_getTransactionReceipt", "eth_getTransactionCount", "eth_getTransactionByHash"},
+		},
+		{
+			name:  "receipt lookup failed",
+			state: chainState{receiptErr: true},
+			calls: []string{"eth_getTransactionReceipt"},
+			err:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiptLookups := 0
+			server, calls := newElStub(t, func(method string, params []json.RawMessage) (interface{}, *elStubError) {
+				switch method {
+				case "eth_getTransactionReceipt":
+					if tt.state.receiptErr {
+						return nil, &elStubError{Code: -32000, Message: "internal error"}
+					}
+					r := tt.state.receipts[min(receiptLookups, len(tt.state.receipts)-1)]
+					receiptLookups++
+					if r == nil {
+						return nil, nil
+					}
+					return r, nil
+				case "eth_getTransactionCount":
+					return hexutil.Uint64(tt.state.nonce), nil
+				case "eth_getTransactionByHash":
+					if tt.state.inMempool {
+						return tx, nil
+					}
+					return nil, nil
+				case "eth_sendRawTransaction":
+					return tx.Hash(), nil
+				}
+				return nil, &elStubError{Code: -32601, Message: "method not found"}
+			})
+			client, err := ethclient.Dial(server.URL)
+			if err != nil {
+				t.Fatalf("error dialing stub: %v", err)
+			}
+			defer client.Close()
+
+			job := newSignedTransactionNodeJob(t, tx)
+			job.Status = types.SubmittedToNodeNodeJobStatus
+			job.SubmittedToNodeTime.Time = time.Now().Add(-tt.state.submittedAt)
+			job.SubmittedToNodeTime.Valid = true
+
+			status, err := signedTransactionNodeJobStatus(context.Background(), client, job)
+			if tt.err != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if status != tt.status {
+				t.Errorf("expected status %q, got %q", tt.status, status)
+			}
+			if !slices.Equal(*calls, tt.calls) {
+				t.Errorf("expected calls %v, got %v", tt.calls, *calls)
+			}
+		})
+	}
+}
+
+func TestIsRejectedTransactionError(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	tx := signTestTransaction(t, key, 1, 5)
+	send := func(ctx context.Context, url string) error {
+		client, err := ethclient.Dial(url)
+		if err != nil {
+			t.Fatalf("error dialing stub: %v", err)
+		}
+		defer client.Close()
+		return client.SendTransaction(ctx, tx)
+	}
+	rejecting := func(code int, message string) string {
+		server, _ := newElStub(t, func(method string, params []json.RawMessage) (interface{}, *elStubError) {
+			return nil, &elStubError{Code: code, Message: message}
+		})
+		return server.URL
+	}
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer unavailable.Close()
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		url      string
+		rejected bool
+	}{
+		{"nonce too low", context.Background(), rejecting(-32000, "nonce too low"), true},
+		{"underpriced", context.Background(), rejecting(-32000, "transaction underpriced"), true},
+		{"method not available", context.Background(), rejecting(-32601, "the method eth_sendRawTransaction does not exist"), false},
+		{"bad gateway", context.Background(), unavailable.URL, false},
+		{"connection refused", context.Background(), closed.URL, false},
+		{"canceled", canceled, rejecting(-32000, "nonce too low"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := send(tt.ctx, tt.url)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if rejected := isRejectedTransactionError(err); rejected != tt.rejected {
+				t.Errorf("expected rejected to be %v for %v", tt.rejected, err)
+			}
+		})
+	}
+
+	if !isKnownTransactionError(errors.New("already known")) || isKnownTransactionError(errors.New("nonce too low")) {
+		t.Errorf("expected only transactions in the mempool of the node to be known")
+	}
+}
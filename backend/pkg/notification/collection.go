@@ -17,6 +17,7 @@ import (
 	"github.com/gobitfly/beaconchain/pkg/commons/cache"
 	"github.com/gobitfly/beaconchain/pkg/commons/db"
 	"github.com/gobitfly/beaconchain/pkg/commons/ethclients"
+	"github.com/gobitfly/beaconchain/pkg/commons/gas"
 	"github.com/gobitfly/beaconchain/pkg/commons/log"
 	"github.com/gobitfly/beaconchain/pkg/commons/metrics"
 	"github.com/gobitfly/beaconchain/pkg/commons/services"
@@ -56,6 +57,8 @@ func notificationCollector() {
 		gob.Register(&ValidatorIsOnlineNotification{})
 		gob.Register(&ValidatorGotSlashedNotification{})
 		gob.Register(&ValidatorWithdrawalNotification{})
+		gob.Register(&ValidatorConsolidationNotification{})
+		gob.Register(&ValidatorMissedBestBidNotification{})
 		gob.Register(&NetworkNotification{})
 		gob.Register(&RocketpoolNotification{})
 		gob.Register(&MonitorMachineNotification{})
@@ -370,6 +373,20 @@ func collectNotifications(mc modules.ModuleContext, epoch uint64) (types.Notific
 	}
 	log.Infof("collecting withdrawal notifications took: %v", time.Since(start))
 
+	err = collectConsolidationNotifications(notificationsByUserID, epoch)
+	if err != nil {
+		metrics.Errors.WithLabelValues("notifications_collect_validator_consolidation").Inc()
+		return nil, fmt.Errorf("error collecting consolidation notifications: %v", err)
+	}
+	log.Infof("collecting consolidation notifications took: %v", time.Since(start))
+
+	err = collectMissedBestBidNotifications(notificationsByUserID, epoch)
+	if err != nil {
+		metrics.Errors.WithLabelValues("notifications_collect_validator_missed_best_bid").Inc()
+		return nil, fmt.Errorf("error collecting missed best bid notifications: %v", err)
+	}
+	log.Infof("collecting missed best bid notifications took: %v", time.Since(start))
+
 	err = collectNetworkNotifications(notificationsByUserID)
 	if err != nil {
 		metrics.Errors.WithLabelValues("notifications_collect_network").Inc()
@@ -481,25 +498,18 @@ func collectUserDbNotifications(epoch uint64) (types.NotificationsPerUserId, err
 }
 
 func collectGasPriceNotifications(notificationsByUserID types.NotificationsPerUserId, epoch uint64) error {
-	// retrieve gas price history
-	ts := time.Now()
-	duration := time.Minute * 10
-	gasPrices, err := db.BigtableClient.GetGasNowHistory(ts, ts.Add(duration*-1))
+	// retrieve the average of the recent gas price estimates
+	rapid, count, err := gas.GetAverageRapid(db.BigtableClient, time.Minute*10)
 	if err != nil {
 		return fmt.Errorf("error getting gas price history: %w", err)
 	}
 
-	if len(gasPrices) == 0 {
+	if count == 0 {
 		log.Warnf("no gas price data found for epoch %v", epoch)
 		return nil
 	}
 
-	averageGasPrice := decimal.NewFromInt(0)
-	for _, gasPrice := range gasPrices {
-		log.Infof("gas price for %v: %v", gasPrice.Ts, gasPrice.Rapid)
-		averageGasPrice = averageGasPrice.Add(decimal.NewFromBigInt(gasPrice.Rapid, 0))
-	}
-	averageGasPrice = averageGasPrice.Div(decimal.NewFromInt(int64(len(gasPrices)))).Div(decimal.NewFromInt(params.GWei))
+	averageGasPrice := decimal.NewFromBigInt(rapid, 0).Div(decimal.NewFromInt(params.GWei))
 
 	log.Infof("average gas price is %f GWei", averageGasPrice.InexactFloat64())
 
@@ -1364,6 +1374,136 @@ func collectWithdrawalNotifications(notificationsByUserID types.NotificationsPer
 	return nil
 }
 
+// collectConsolidationNotifications notifies the subscribers of both the source and the target validator of a consolidation request
+func collectConsolidationNotifications(notificationsByUserID types.NotificationsPerUserId, epoch uint64) error {
+	subMap, err := GetSubsForEventFilter(types.ValidatorConsolidationEventName, "", nil, nil)
+	if err != nil {
+		return fmt.Errorf("error getting subscriptions for consolidations %w", err)
+	}
+
+	events, err := db.GetEpochConsolidationRequests(epoch)
+	if err != nil {
+		return fmt.Errorf("error getting consolidation requests from database, err: %w", err)
+	}
+
+	log.Infof("retrieved %v consolidation requests", len(events))
+	for _, event := range events {
+		if !event.SourceIndex.Valid || !event.TargetIndex.Valid {
+			// requests for unknown validators are invalid and ignored by the beacon chain
+			continue
+		}
+		// a subscription that covers both validators is only notified once per request
+		notifiedSubs := make(map[uint64]bool)
+		for _, validator := range []struct {
+			pubkey []byte
+			index  uint64
+		}{{event.SourcePubkey, uint64(event.SourceIndex.Int64)}, {event.TargetPubkey, uint64(event.TargetIndex.Int64)}} {
+			subscribers, ok := subMap[hex.EncodeToString(validator.pubkey)]
+			if !ok {
+				continue
+			}
+			for _, sub := range subscribers {
+				if sub.UserID == nil || sub.ID == nil {
+					return fmt.Errorf("error expected userId and subId to be defined but got user: %v, sub: %v", sub.UserID, sub.ID)
+				}
+				if notifiedSubs[*sub.ID] {
+					continue
+				}
+				if sub.LastEpoch != nil {
+					lastSentEpoch := *sub.LastEpoch
+					if lastSentEpoch >= epoch || epoch < sub.CreatedEpoch {
+						continue
+					}
+				}
+				notifiedSubs[*sub.ID] = true
+				log.Infof("creating %v notification for validator %v in epoch %v", types.ValidatorConsolidationEventName, validator.index, epoch)
+				n := &ValidatorConsolidationNotification{
+					NotificationBaseImpl: types.NotificationBaseImpl{
+						SubscriptionID:     *sub.ID,
+						UserID:             *sub.UserID,
+						EventFilter:        hex.EncodeToString(validator.pubkey),
+						EventName:          sub.EventName,
+						DashboardId:        sub.DashboardId,
+						DashboardName:      sub.DashboardName,
+						DashboardGroupId:   sub.DashboardGroupId,
+						DashboardGroupName: sub.DashboardGroupName,
+						Epoch:              epoch,
+					},
+					ValidatorIndex: validator.index,
+					SourceIndex:    uint64(event.SourceIndex.Int64),
+					TargetIndex:    uint64(event.TargetIndex.Int64),
+					Slot:           event.Slot,
+					SourceAddress:  event.SourceAddress,
+				}
+				notificationsByUserID.AddNotification(n)
+				metrics.NotificationsCollected.WithLabelValues(string(n.GetEventName())).Inc()
+			}
+		}
+	}
+
+	return nil
+}
+
+// collectMissedBestBidNotifications notifies the subscribers of proposers whose block was worth less than the best bid the
+// relays received for the slot by more than the threshold of the subscription
+func collectMissedBestBidNotifications(notificationsByUserID types.NotificationsPerUserId, epoch uint64) error {
+	subMap, err := GetSubsForEventFilter(types.ValidatorMissedBestBidEventName, "", nil, nil)
+	if err != nil {
+		return fmt.Errorf("error getting subscriptions for missed best bids %w", err)
+	}
+
+	events, err := db.GetEpochMissedBestBids(epoch)
+	if err != nil {
+		return fmt.Errorf("error getting proposals below the best bid from database, err: %w", err)
+	}
+
+	log.Infof("retrieved %v proposals below the best bid", len(events))
+	for _, event := range events {
+		subscribers, ok := subMap[hex.EncodeToString(event.ProposerPubkey)]
+		if !ok {
+			continue
+		}
+		for _, sub := range subscribers {
+			if sub.UserID == nil || sub.ID == nil {
+				return fmt.Errorf("error expected userId and subId to be defined but got user: %v, sub: %v", sub.UserID, sub.ID)
+			}
+			if sub.LastEpoch != nil {
+				lastSentEpoch := *sub.LastEpoch
+				if lastSentEpoch >= epoch || epoch < sub.CreatedEpoch {
+					continue
+				}
+			}
+			if event.BestBid-event.DeliveredValue <= sub.EventThreshold {
+				continue
+			}
+			log.Infof("creating %v notification for validator %v in epoch %v", types.ValidatorMissedBestBidEventName, event.Proposer, epoch)
+			n := &ValidatorMissedBestBidNotification{
+				NotificationBaseImpl: types.NotificationBaseImpl{
+					SubscriptionID:     *sub.ID,
+					UserID:             *sub.UserID,
+					EventFilter:        hex.EncodeToString(event.ProposerPubkey),
+					EventName:          sub.EventName,
+					DashboardId:        sub.DashboardId,
+					DashboardName:      sub.DashboardName,
+					DashboardGroupId:   sub.DashboardGroupId,
+					DashboardGroupName: sub.DashboardGroupName,
+					Epoch:              epoch,
+				},
+				ValidatorIndex: event.Proposer,
+				Slot:           event.Slot,
+				DeliveredValue: event.DeliveredValue,
+				BestBid:        event.BestBid,
+				BestBidRelay:   event.BestBidRelay,
+				Threshold:      sub.EventThreshold,
+			}
+			notificationsByUserID.AddNotification(n)
+			metrics.NotificationsCollected.WithLabelValues(string(n.GetEventName())).Inc()
+		}
+	}
+
+	return nil
+}
+
 func collectEthClientNotifications(notificationsByUserID types.NotificationsPerUserId) error {
 	updatedClients := ethclients.GetUpdatedClients() //only check if there are new updates
 	for _, client := range updatedClients {
@@ -419,6 +419,80 @@ func (n *ValidatorWithdrawalNotification) GetLegacyTitle() string {
 	return "Withdrawal Processed"
 }
 
+type ValidatorConsolidationNotification struct {
+	types.NotificationBaseImpl
+
+	ValidatorIndex uint64 // the subscribed validator, either the source or the target of the consolidation
+	SourceIndex    uint64
+	TargetIndex    uint64
+	Slot           uint64
+	SourceAddress  []byte
+}
+
+func (n *ValidatorConsolidationNotification) GetEntitiyId() string {
+	return fmt.Sprintf("%v", n.ValidatorIndex)
+}
+
+func (n *ValidatorConsolidationNotification) GetInfo(format types.NotificationFormat) string {
+	dashboardAndGroupInfo := formatValidatorPrefixedDashboardAndGroupLink(format, n)
+	source := formatValidatorLink(format, n.SourceIndex)
+	slot := formatSlotLink(format, n.Slot)
+	if n.SourceIndex == n.TargetIndex {
+		return fmt.Sprintf(`A switch to compounding withdrawal credentials has been requested for validator %s%s in slot %s.`, source, dashboardAndGroupInfo, slot)
+	}
+	target := formatValidatorLink(format, n.TargetIndex)
+	return fmt.Sprintf(`A consolidation of validator %s into validator %s%s has been requested in slot %s.`, source, target, dashboardAndGroupInfo, slot)
+}
+
+func (n *ValidatorConsolidationNotification) GetTitle() string {
+	return n.GetLegacyTitle()
+}
+
+func (n *ValidatorConsolidationNotification) GetLegacyInfo() string {
+	if n.SourceIndex == n.TargetIndex {
+		return fmt.Sprintf(`A switch to compounding withdrawal credentials has been requested for validator %v in slot %v.`, n.SourceIndex, n.Slot)
+	}
+	return fmt.Sprintf(`A consolidation of validator %v into validator %v has been requested in slot %v.`, n.SourceIndex, n.TargetIndex, n.Slot)
+}
+
+func (n *ValidatorConsolidationNotification) GetLegacyTitle() string {
+	return "Validator Consolidation"
+}
+
+type ValidatorMissedBestBidNotification struct {
+	types.NotificationBaseImpl
+
+	ValidatorIndex uint64
+	Slot           uint64
+	DeliveredValue float64 // ETH
+	BestBid        float64 // ETH
+	BestBidRelay   string
+	Threshold      float64 // ETH the proposal had to miss the best bid by
+}
+
+func (n *ValidatorMissedBestBidNotification) GetEntitiyId() string {
+	return fmt.Sprintf("%v", n.ValidatorIndex)
+}
+
+func (n *ValidatorMissedBestBidNotification) GetInfo(format types.NotificationFormat) string {
+	dashboardAndGroupInfo := formatValidatorPrefixedDashboardAndGroupLink(format, n)
+	vali := formatValidatorLink(format, n.ValidatorIndex)
+	slot := formatSlotLink(format, n.Slot)
+	return fmt.Sprintf(`Validator %s%s proposed a block worth %.5f %v at slot %s while the best bid on relay %s was %.5f %v.`, vali, dashboardAndGroupInfo, n.DeliveredValue, utils.Config.Frontend.ElCurrency, slot, n.BestBidRelay, n.BestBid, utils.Config.Frontend.ElCurrency)
+}
+
+func (n *ValidatorMissedBestBidNotification) GetTitle() string {
+	return n.GetLegacyTitle()
+}
+
+func (n *ValidatorMissedBestBidNotification) GetLegacyInfo() string {
+	return fmt.Sprintf(`Validator %v proposed a block worth %.5f %v at slot %v while the best bid on relay %s was %.5f %v.`, n.ValidatorIndex, n.DeliveredValue, utils.Config.Frontend.ElCurrency, n.Slot, n.BestBidRelay, n.BestBid, utils.Config.Frontend.ElCurrency)
+}
+
+func (n *ValidatorMissedBestBidNotification) GetLegacyTitle() string {
+	return "Missed Best Bid"
+}
+
 type EthClientNotification struct {
 	types.NotificationBaseImpl
 
@@ -0,0 +1,42 @@
+// Code generated by tygo. DO NOT EDIT.
+/* eslint-disable */
+import type { Hash, Address, ApiPagingResponse } from './common'
+
+//////////
+// source: address.go
+
+export interface AddressEventLogTableRow {
+  tx_hash: Hash;
+  block: number /* uint64 */;
+  age: number /* uint64 */;
+  log_index: number /* uint64 */;
+  address: Address;
+  event?: string;
+  topics: Hash[];
+  data: Hash;
+}
+export type GetAddressEventLogsResponse = ApiPagingResponse<AddressEventLogTableRow>;
+export interface AddressBalanceHistoryTableRow {
+  block: number /* uint64 */;
+  age: number /* uint64 */;
+  tx_hash?: Hash;
+  type: 'transaction' | 'internal_transaction' | 'withdrawal';
+  change: string /* decimal.Decimal */;
+  /**
+   * balance after the change was applied
+   */
+  balance: string /* decimal.Decimal */;
+}
+export type GetAddressBalanceHistoryResponse = ApiPagingResponse<AddressBalanceHistoryTableRow>;
+export interface AddressTokenSupplyHistoryTableRow {
+  block: number /* uint64 */;
+  age: number /* uint64 */;
+  tx_hash: Hash;
+  type: 'mint' | 'burn';
+  change: string /* decimal.Decimal */;
+  /**
+   * total supply after the change was applied
+   */
+  total_supply: string /* decimal.Decimal */;
+}
+export type GetAddressTokenSupplyHistoryResponse = ApiPagingResponse<AddressTokenSupplyHistoryTableRow>;
@@ -1,6 +1,6 @@
 // Code generated by tygo. DO NOT EDIT.
 /* eslint-disable */
-import type { ApiDataResponse, Hash, Address, ClElValue } from './common'
+import type { ApiDataResponse, Hash, Address, ClElValue, ApiPagingResponse } from './common'
 
 //////////
 // source: block.go
@@ -116,6 +116,14 @@ export interface BlockOverview {
   consensus_layer?: BlockConsensusLayer;
 }
 export type InternalGetBlockOverviewResponse = ApiDataResponse<BlockOverview>;
+/**
+ * MultisigExecutionLabel marks a transaction that executed a Safe transaction
+ */
+export interface MultisigExecutionLabel {
+  safe: Hash;
+  safe_tx_hash: Hash;
+  success: boolean;
+}
 export interface BlockTransactionTableRow {
   success: boolean;
   tx_hash: Hash;
@@ -128,8 +136,14 @@ export interface BlockTransactionTableRow {
   value: string /* decimal.Decimal */;
   gas_price: string /* decimal.Decimal */;
   tx_fee: string /* decimal.Decimal */;
+  /**
+   * only set for transactions executing a Safe transaction
+   */
+  multisig_execution?: MultisigExecutionLabel;
 }
 export type InternalGetBlockTransactionsResponse = ApiDataResponse<BlockTransactionTableRow[]>;
+export type GetTransactionsResponse = ApiPagingResponse<BlockTransactionTableRow>;
+export type GetAddressTransactionsResponse = ApiPagingResponse<BlockTransactionTableRow>;
 export interface BlockVoteTableRow {
   allocated_slot: number /* uint64 */;
   committee: number /* uint64 */;
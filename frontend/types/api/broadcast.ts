@@ -0,0 +1,19 @@
+// Code generated by tygo. DO NOT EDIT.
+/* eslint-disable */
+import type { Hash, ApiDataResponse } from './common'
+
+//////////
+// source: broadcast.go
+
+export interface Broadcast {
+  id: string;
+  type: 'bls_to_execution_changes' | 'voluntary_exits' | 'signed_transaction';
+  status: 'pending' | 'submitted_to_node' | 'completed' | 'failed';
+  created_time: number /* int64 */;
+  submitted_to_node_time?: number /* int64 */;
+  completed_time?: number /* int64 */;
+  validators?: number /* uint64 */[]; // for consensus layer broadcasts
+  tx_hash?: Hash; // for signed transactions
+}
+export type PostBroadcastResponse = ApiDataResponse<Broadcast>;
+export type GetBroadcastResponse = ApiDataResponse<Broadcast>;
@@ -18,6 +18,7 @@ export interface ValidatorDashboard {
   archived_reason?: 'user' | 'dashboard_limit' | 'validator_limit' | 'group_limit';
   validator_count: number /* uint64 */;
   group_count: number /* uint64 */;
+  role?: 'owner' | 'admin' | 'editor' | 'viewer'; // role of the requesting user
 }
 export interface UserDashboardsData {
   validator_dashboards: ValidatorDashboard[];
@@ -0,0 +1,39 @@
+// Code generated by tygo. DO NOT EDIT.
+/* eslint-disable */
+import type { Address, Hash, ApiDataResponse } from './common'
+
+//////////
+// source: ens.go
+
+export interface EnsName {
+  name: string;
+  is_primary: boolean;
+  expires_at: number /* int64 */;
+}
+export interface EnsAddressNames {
+  address: Hash;
+  primary_name?: string; // name the address reverse resolves to
+  names: EnsName[]; // all names resolving to the address
+}
+export type GetAddressEnsResponse = ApiDataResponse<EnsAddressNames>;
+export interface EnsNameHistoryEntry {
+  type: 'registration' | 'renewal';
+  block: number /* uint64 */;
+  timestamp: number /* int64 */;
+  tx_hash: Hash;
+  owner?: Hash; // only set for registrations
+  expires_at: number /* int64 */;
+}
+export interface EnsNameDetails {
+  name: string;
+  address?: Hash; // not set if the name currently does not resolve
+  is_primary: boolean;
+  expires_at?: number /* int64 */;
+  history: EnsNameHistoryEntry[]; // registrations and renewals of .eth names, newest first
+}
+export type GetEnsNameResponse = ApiDataResponse<EnsNameDetails>;
+export interface EnsBatchResolution {
+  names: { [key: string]: Hash | undefined}; // name -> resolved address, null if the name does not resolve
+  addresses: { [key: string]: string | undefined}; // address -> primary name, null if the address has none
+}
+export type PostEnsBatchResolutionResponse = ApiDataResponse<EnsBatchResolution>;
@@ -0,0 +1,34 @@
+// Code generated by tygo. DO NOT EDIT.
+/* eslint-disable */
+import type { PubKey, Address, ApiPagingResponse } from './common'
+
+//////////
+// source: execution_requests.go
+
+export interface ExecutionRequestValidator {
+  index?: number /* uint64 */; // not set if the public key is not known to the beacon chain
+  public_key: PubKey;
+}
+/**
+ * EIP-7002 withdrawal request triggered from the withdrawal address of a validator
+ */
+export interface WithdrawalRequest {
+  slot: number /* uint64 */;
+  epoch: number /* uint64 */;
+  source_address: Address;
+  validator: ExecutionRequestValidator;
+  amount: string /* decimal.Decimal */;
+  is_full_exit: boolean; // a request with an amount of 0 exits the validator
+}
+export type GetWithdrawalRequestsResponse = ApiPagingResponse<WithdrawalRequest>;
+/**
+ * EIP-7251 consolidation request, a request with the same source and target switches the validator to compounding withdrawal credentials
+ */
+export interface ConsolidationRequest {
+  slot: number /* uint64 */;
+  epoch: number /* uint64 */;
+  source_address: Address;
+  source: ExecutionRequestValidator;
+  target: ExecutionRequestValidator;
+}
+export type GetConsolidationRequestsResponse = ApiPagingResponse<ConsolidationRequest>;
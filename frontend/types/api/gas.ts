@@ -0,0 +1,41 @@
+// Code generated by tygo. DO NOT EDIT.
+/* eslint-disable */
+import type { ApiDataResponse, ChartData } from './common'
+
+//////////
+// source: gas.go
+
+export interface BaseFeeForecast {
+  block: number /* uint64 */;
+  base_fee: string /* decimal.Decimal */;
+}
+export interface GasPercentiles {
+  slow: string /* decimal.Decimal */;
+  standard: string /* decimal.Decimal */;
+  fast: string /* decimal.Decimal */;
+  rapid: string /* decimal.Decimal */;
+}
+export interface GasNowData {
+  timestamp: number /* int64 */;
+  /**
+   * gas prices in wei, base fee of the next block plus the 25th, 50th, 75th and 90th percentile of recent priority fees
+   */
+  slow: string /* decimal.Decimal */;
+  standard: string /* decimal.Decimal */;
+  fast: string /* decimal.Decimal */;
+  rapid: string /* decimal.Decimal */;
+  latest_block: number /* uint64 */;
+  base_fee: string /* decimal.Decimal */; // of the latest block
+  utilization: number /* float64 */; // average ratio of gas used to gas limit of recent blocks
+  /**
+   * 25th, 50th, 75th and 90th percentile of the base fees of recent blocks
+   */
+  base_fee_percentiles: GasPercentiles;
+  /**
+   * projected base fees of the upcoming blocks, assuming they are filled to the recent utilization
+   */
+  base_fee_forecast: BaseFeeForecast[];
+}
+export type GetGasNowResponse = ApiDataResponse<GasNowData>;
+export type GetAverageGasLimitHistoryResponse = ApiDataResponse<ChartData<string, number /* float64 */>>; // line chart, series id is 'average'
+export type GetGasUsedHistoryResponse = ApiDataResponse<ChartData<string, number /* float64 */>>; // line chart, series id is 'total' or 'average'
@@ -21,11 +21,40 @@ export interface LatestStateData {
 }
 export type InternalGetLatestStateResponse = ApiDataResponse<LatestStateData>;
 export interface RocketPoolData {
+  /**
+   * start of the current and the next rewards interval
+   */
   last_update_slot: number /* uint64 */;
   next_update_slot: number /* uint64 */;
   eth_rates: {
     rpl: number /* float64 */;
     reth: number /* float64 */;
   };
+  nodes: number /* uint64 */;
+  minipools: number /* uint64 */;
+  oracle_dao_members: number /* uint64 */;
+  reth_supply: string /* decimal.Decimal */;
+  total_eth_staking: string /* decimal.Decimal */;
+  effective_rpl_staked: string /* decimal.Decimal */;
+  node_fee: number /* float64 */; // commission of new minipools
+  node_demand: string /* decimal.Decimal */; // eth in the deposit pool minus the eth waiting in the minipool queue
+  active_dao_proposals: number /* uint64 */;
 }
 export type InternalGetRocketPoolResponse = ApiDataResponse<RocketPoolData>;
+/**
+ * PriceCandle is the price of ETH within an interval starting at Ts
+ */
+export interface PriceCandle {
+  ts: number /* int64 */;
+  open: number /* float64 */;
+  high: number /* float64 */;
+  low: number /* float64 */;
+  close: number /* float64 */;
+}
+export interface EthPriceHistoryData {
+  base: string; // main currency of the network the prices are given for, e.g. ETH or GNO
+  currency: string;
+  resolution: 'hourly' | 'daily';
+  candles: PriceCandle[];
+}
+export type GetEthPriceHistoryResponse = ApiDataResponse<EthPriceHistoryData>;
@@ -0,0 +1,46 @@
+// Code generated by tygo. DO NOT EDIT.
+/* eslint-disable */
+import type { Hash, ApiPagingResponse } from './common'
+
+//////////
+// source: layer2.go
+
+export interface Layer2Batch {
+  tx_hash: Hash; // layer 1 transaction submitting the batch
+  block: number /* uint64 */;
+  timestamp: number /* int64 */;
+  submitter: Hash;
+  batch_number?: number /* uint64 */; // only known for arbitrum style rollups
+  data_location: 'calldata' | 'blob' | 'alt_da' | 'none';
+  data_size: number /* uint64 */; // bytes posted to the layer 1, blobs always count as full blobs
+  blob_count: number /* uint64 */;
+  tx_fee: string /* decimal.Decimal */;
+  blob_fee: string /* decimal.Decimal */;
+}
+export type GetLayer2BatchesResponse = ApiPagingResponse<Layer2Batch>;
+export interface Layer1ToLayer2Transaction {
+  type: 'deposit' | 'retryable' | 'message';
+  l1_tx_hash: Hash;
+  l1_block: number /* uint64 */;
+  timestamp: number /* int64 */;
+  from: Hash;
+  to?: Hash; // not set for contract creations and undecoded messages
+  value: string /* decimal.Decimal */; // eth credited on the layer 2
+  l2_tx_hash?: Hash; // not set for messages the layer 2 hash cannot be derived for
+  message_index?: number /* uint64 */;
+}
+export type GetLayer1ToLayer2TransactionsResponse = ApiPagingResponse<Layer1ToLayer2Transaction>;
+export interface Layer2ToLayer1Transaction {
+  status: 'proven' | 'finalized' | 'executed';
+  l1_tx_hash: Hash;
+  l1_block: number /* uint64 */;
+  timestamp: number /* int64 */;
+  message_id: Hash; // withdrawal hash for op-stack, outbox position for arbitrum style rollups
+  from?: Hash;
+  to?: Hash;
+  success: boolean;
+  l2_tx_hash?: Hash; // transaction initiating the withdrawal, if indexed
+  l2_block?: number /* uint64 */;
+  value?: string /* decimal.Decimal */;
+}
+export type GetLayer2ToLayer1TransactionsResponse = ApiPagingResponse<Layer2ToLayer1Transaction>;
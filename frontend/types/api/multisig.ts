@@ -0,0 +1,51 @@
+// Code generated by tygo. DO NOT EDIT.
+/* eslint-disable */
+import type { Address, Hash, ApiDataResponse, ApiPagingResponse } from './common'
+
+//////////
+// source: multisig.go
+
+export interface MultisigSafe {
+  address: Address;
+  owners: Address[];
+  threshold: number /* uint64 */;
+  /**
+   * not set for Safes deployed through a proxy factory that isn't indexed
+   */
+  factory?: Hash;
+  singleton?: Hash;
+  creator: Hash;
+  creation_tx_hash: Hash;
+  creation_block: number /* uint64 */;
+  creation_timestamp: number /* int64 */;
+}
+export type GetMultisigSafeResponse = ApiDataResponse<MultisigSafe>;
+export interface MultisigSafeTransaction {
+  tx_hash: Hash;
+  block: number /* uint64 */;
+  timestamp: number /* int64 */;
+  safe_tx_hash: Hash;
+  success: boolean;
+  executor: Address;
+  payment: string /* decimal.Decimal */; // refund paid to the executor, in the gas token of the Safe transaction
+  /**
+   * the details of the Safe transaction are only known if execTransaction was called directly
+   */
+  to?: Address;
+  value?: string /* decimal.Decimal */;
+  method?: string;
+  operation?: 'call' | 'delegate_call';
+  confirmations?: number /* uint64 */;
+}
+export type GetMultisigSafeTransactionsResponse = ApiPagingResponse<MultisigSafeTransaction>;
+export interface MultisigTransactionConfirmation {
+  owner: Address;
+  kind: 'ecdsa' | 'eth_sign' | 'approved_hash' | 'contract';
+  /**
+   * only set for confirmations given on chain through approveHash
+   */
+  tx_hash?: Hash;
+  block?: number /* uint64 */;
+  timestamp?: number /* int64 */;
+}
+export type GetMultisigTransactionConfirmationsResponse = ApiDataResponse<MultisigTransactionConfirmation[]>;
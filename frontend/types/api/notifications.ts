@@ -44,7 +44,7 @@ export interface NotificationDashboardsTableRow {
   group_id: number /* uint64 */;
   group_name: string;
   entity_count: number /* uint64 */;
-  event_types: ('validator_online' | 'validator_offline' | 'group_efficiency_below' | 'attestation_missed' | 'proposal_success' | 'proposal_missed' | 'proposal_upcoming' | 'max_collateral' | 'min_collateral' | 'sync' | 'withdrawal' | 'validator_got_slashed' | 'validator_has_slashed' | 'incoming_tx' | 'outgoing_tx' | 'transfer_erc20' | 'transfer_erc721' | 'transfer_erc1155')[];
+  event_types: ('validator_online' | 'validator_offline' | 'group_efficiency_below' | 'attestation_missed' | 'proposal_success' | 'proposal_missed' | 'proposal_upcoming' | 'max_collateral' | 'min_collateral' | 'sync' | 'withdrawal' | 'consolidation' | 'missed_best_bid' | 'validator_got_slashed' | 'validator_has_slashed' | 'incoming_tx' | 'outgoing_tx' | 'transfer_erc20' | 'transfer_erc721' | 'transfer_erc1155')[];
 }
 export type InternalGetUserNotificationDashboardsResponse = ApiPagingResponse<NotificationDashboardsTableRow>;
 export interface NotificationEventValidatorBackOnline {
@@ -56,6 +56,24 @@ export interface NotificationEventWithdrawal {
   amount: string /* decimal.Decimal */;
   address: Address;
 }
+/**
+ * a consolidation with the same source and target switches the validator to compounding withdrawal credentials
+ */
+export interface NotificationEventConsolidation {
+  source: number /* uint64 */;
+  target: number /* uint64 */;
+  slot: number /* uint64 */;
+}
+/**
+ * a proposal that was worth less than the best bid the relays received for the slot, values in wei
+ */
+export interface NotificationEventMissedBestBid {
+  index: number /* uint64 */;
+  slot: number /* uint64 */;
+  delivered_value: string /* decimal.Decimal */;
+  best_bid: string /* decimal.Decimal */;
+  best_bid_relay: string;
+}
 export interface NotificationValidatorDashboardDetail {
   dashboard_name: string;
   group_name: string;
@@ -70,6 +88,8 @@ export interface NotificationValidatorDashboardDetail {
   sync: number /* uint64 */[]; // validator indices
   attestation_missed: IndexEpoch[]; // index (epoch)
   withdrawal: NotificationEventWithdrawal[];
+  consolidation: NotificationEventConsolidation[];
+  missed_best_bid: NotificationEventMissedBestBid[];
   min_collateral: Address[]; // node addresses
   max_collateral: Address[]; // node addresses
 }
@@ -186,7 +206,10 @@ export interface NotificationSettingsValidatorDashboard {
   is_upcoming_block_proposal_subscribed: boolean;
   is_sync_subscribed: boolean;
   is_withdrawal_processed_subscribed: boolean;
+  is_consolidation_subscribed: boolean;
   is_slashed_subscribed: boolean;
+  is_missed_best_bid_subscribed: boolean;
+  missed_best_bid_threshold: number /* float64 */; // ETH the proposal has to miss the best bid by
   is_max_collateral_subscribed: boolean;
   max_collateral_threshold: number /* float64 */;
   is_min_collateral_subscribed: boolean;
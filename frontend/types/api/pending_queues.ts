@@ -0,0 +1,48 @@
+// Code generated by tygo. DO NOT EDIT.
+/* eslint-disable */
+import type { ApiDataResponse, Hash, ApiPagingResponse } from './common'
+
+//////////
+// source: pending_queues.go
+
+export interface PendingQueueSummary {
+  count: number /* uint64 */;
+  amount: string /* decimal.Decimal */; // for consolidations the effective balance of the source validators
+  estimated_clear_epoch?: number /* uint64 */; // not set if the queue is empty or the last item has no estimate yet
+}
+export interface NetworkValidatorQueue {
+  epoch: number /* uint64 */; // epoch of the state the queues were read from
+  activation_exit_churn: string /* decimal.Decimal */;
+  consolidation_churn: string /* decimal.Decimal */;
+  deposits: PendingQueueSummary;
+  partial_withdrawals: PendingQueueSummary;
+  consolidations: PendingQueueSummary;
+}
+export type GetNetworkValidatorQueueResponse = ApiDataResponse<NetworkValidatorQueue>;
+export interface PendingDeposit {
+  position: number /* uint64 */;
+  validator: ExecutionRequestValidator;
+  withdrawal_credential: Hash;
+  amount: string /* decimal.Decimal */;
+  slot: number /* uint64 */; // slot the deposit was included in
+  estimated_epoch: number /* uint64 */;
+  estimated_timestamp: number /* uint64 */;
+}
+export type GetPendingDepositsResponse = ApiPagingResponse<PendingDeposit>;
+export interface PendingPartialWithdrawal {
+  position: number /* uint64 */;
+  index: number /* uint64 */;
+  amount: string /* decimal.Decimal */;
+  withdrawable_epoch: number /* uint64 */;
+  estimated_epoch: number /* uint64 */;
+  estimated_timestamp: number /* uint64 */;
+}
+export type GetPendingPartialWithdrawalsResponse = ApiPagingResponse<PendingPartialWithdrawal>;
+export interface PendingConsolidation {
+  position: number /* uint64 */;
+  source_index: number /* uint64 */;
+  target_index: number /* uint64 */;
+  estimated_epoch?: number /* uint64 */; // not set while the source validator has not initiated its exit yet
+  estimated_timestamp?: number /* uint64 */;
+}
+export type GetPendingConsolidationsResponse = ApiPagingResponse<PendingConsolidation>;
@@ -0,0 +1,30 @@
+// Code generated by tygo. DO NOT EDIT.
+/* eslint-disable */
+import type { ApiDataResponse, PubKey } from './common'
+
+//////////
+// source: relays.go
+
+export interface RelayPerformance {
+  name: string;
+  link?: string;
+  is_censoring: boolean; // relay filters transactions of sanctioned addresses
+  is_ethical: boolean;
+  slots_bid: number /* uint64 */; // slots the relay received at least one bid for
+  slots_delivered: number /* uint64 */; // slots with bids the relay delivered the payload for
+  win_rate: number /* float64 */; // delivered payloads per slot with bids
+  median_best_bid: string /* decimal.Decimal */; // median of the best bid the relay received per slot
+  median_bid_lead_time: number /* int64 */; // milliseconds the bids arrived before the start of the slot, negative if they arrived late
+}
+export type GetNetworkRelaysResponse = ApiDataResponse<RelayPerformance[]>;
+export interface BuilderPerformance {
+  public_key: PubKey;
+  relays: string[]; // relays the builder submitted bids to
+  is_censoring: boolean; // builder only submitted bids to censoring relays
+  slots_bid: number /* uint64 */;
+  slots_won: number /* uint64 */; // slots with bids of the builder that included its payload
+  win_rate: number /* float64 */;
+  median_best_bid: string /* decimal.Decimal */; // median of the best bid of the builder per slot
+  median_bid_lead_time: number /* int64 */;
+}
+export type GetNetworkBuildersResponse = ApiDataResponse<BuilderPerformance[]>;
@@ -0,0 +1,53 @@
+// Code generated by tygo. DO NOT EDIT.
+/* eslint-disable */
+import type { ApiDataResponse } from './common'
+
+//////////
+// source: sync_committee.go
+
+export interface SyncCommitteeMember {
+  committee_index: number /* uint64 */; // position in the committee, determines the bit in the sync aggregate
+  validator: number /* uint64 */;
+  /**
+   * only slots with a canonical block count, a missed block is not the fault of the committee
+   */
+  signed: number /* uint64 */;
+  missed: number /* uint64 */;
+  participation: number /* float64 */;
+  reward: string /* decimal.Decimal */;
+}
+export interface SyncCommitteeData {
+  period: number /* uint64 */;
+  start_epoch: number /* uint64 */;
+  end_epoch: number /* uint64 */;
+  status: 'past' | 'current' | 'next';
+  participation: number /* float64 */; // average participation of all slots with a canonical block so far
+  members: SyncCommitteeMember[];
+}
+export type GetSyncCommitteeResponse = ApiDataResponse<SyncCommitteeData>;
+export interface SyncCommitteeSlot {
+  slot: number /* uint64 */;
+  status: 'success' | 'missed' | 'orphaned' | 'scheduled';
+  participation?: number /* float64 */; // only set for slots with a canonical block
+  /**
+   * committee indices of the members that did not sign, only set for slots with a canonical block
+   */
+  missed_members?: number /* uint64 */[];
+}
+export type GetSyncCommitteeSlotsResponse = ApiDataResponse<SyncCommitteeSlot[]>;
+export interface VDBSyncCommitteesTableRow {
+  validator: number /* uint64 */;
+  group_id: number /* uint64 */;
+  period: number /* uint64 */;
+  start_epoch: number /* uint64 */;
+  end_epoch: number /* uint64 */;
+  status: 'current' | 'next';
+  committee_indices: number /* uint64 */[]; // a validator may be selected more than once
+  /**
+   * participation of the current period so far, per committee seat
+   */
+  signed: number /* uint64 */;
+  missed: number /* uint64 */;
+  participation?: number /* float64 */;
+}
+export type GetValidatorDashboardSyncCommitteesResponse = ApiDataResponse<VDBSyncCommitteesTableRow[]>;
@@ -0,0 +1,45 @@
+// Code generated by tygo. DO NOT EDIT.
+/* eslint-disable */
+import type { Address, Hash, ApiDataResponse } from './common'
+
+//////////
+// source: transaction.go
+
+export interface TransactionLog {
+  index: number /* uint64 */;
+  address: Address;
+  event?: string;
+  topics: Hash[];
+  data: Hash;
+  removed?: boolean;
+}
+export interface TransactionDetails {
+  hash: Hash;
+  block: number /* uint64 */;
+  position: number /* uint64 */;
+  time: number /* int64 */;
+  success: boolean;
+  error?: string;
+  method: string;
+  tx_type: number /* uint32 */;
+  nonce: number /* uint64 */;
+  from: Address;
+  to?: Address;
+  contract_created?: Address;
+  value: string /* decimal.Decimal */;
+  tx_fee: string /* decimal.Decimal */;
+  gas_price: string /* decimal.Decimal */;
+  max_fee_per_gas?: string /* decimal.Decimal */;
+  max_priority_fee_per_gas?: string /* decimal.Decimal */;
+  gas_limit: number /* uint64 */;
+  gas_used: number /* uint64 */;
+  /**
+   * EIP-4844 only
+   */
+  blob_gas_used?: number /* uint64 */;
+  blob_gas_price?: string /* decimal.Decimal */;
+  blob_versioned_hashes?: Hash[];
+  input: Hash;
+  logs: TransactionLog[];
+}
+export type GetTransactionResponse = ApiDataResponse<TransactionDetails>;
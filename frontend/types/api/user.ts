@@ -1,6 +1,6 @@
 // Code generated by tygo. DO NOT EDIT.
 /* eslint-disable */
-import type { ApiDataResponse, ChartHistorySeconds } from './common'
+import type { ApiDataResponse, ChartHistorySeconds, Address, Hash } from './common'
 
 //////////
 // source: user.go
@@ -24,6 +24,11 @@ export interface UserSubscription {
   end: number /* int64 */;
 }
 export type InternalGetUserInfoResponse = ApiDataResponse<UserInfo>;
+export interface ApiKey {
+  api_key: string;
+  created_at: number /* int64 */;
+}
+export type InternalPostApiKeysResponse = ApiDataResponse<ApiKey>;
 export interface EmailUpdate {
   id: number /* uint64 */;
   current_email: string;
@@ -136,3 +141,120 @@ export interface OAuthAppData {
   RedirectURI: string;
   Active: boolean;
 }
+/**
+ * OAuth for third party apps
+ */
+export interface OAuthAuthorizeInfo {
+  app_name: string;
+  scopes: ('dashboards:read' | 'dashboards:manage' | 'notifications:manage')[];
+}
+export type GetOAuthAuthorizeInfoResponse = ApiDataResponse<OAuthAuthorizeInfo>;
+export interface OAuthAuthorizeRedirect {
+  redirect_uri: string; // includes the authorization code or the error
+}
+export type PostOAuthAuthorizeResponse = ApiDataResponse<OAuthAuthorizeRedirect>;
+/**
+ * token responses must conform to the OAuth spec and are not wrapped
+ */
+export interface OAuthTokenResponse {
+  access_token: string;
+  token_type: string;
+  expires_in: number /* uint64 */;
+  refresh_token: string;
+  scope: string;
+}
+export interface UserOAuthApp {
+  app_id: number /* uint64 */;
+  app_name: string;
+  scopes: ('dashboards:read' | 'dashboards:manage' | 'notifications:manage')[];
+  authorized_at: number /* int64 */;
+}
+export type GetUserOAuthAppsResponse = ApiDataResponse<UserOAuthApp[]>;
+/**
+ * Two factor authentication
+ */
+export interface LoginResult {
+  two_factor_required: boolean;
+  two_factor_methods?: ('totp' | 'webauthn' | 'recovery_code')[]; // methods the login can be completed with
+}
+export type InternalPostLoginResponse = ApiDataResponse<LoginResult>;
+export interface WebAuthnCredentialInfo {
+  id: number /* uint64 */;
+  name: string;
+  created_at: number /* int64 */;
+  last_used_at?: number /* int64 */;
+}
+export interface UserTwoFactorStatus {
+  totp_enabled: boolean;
+  webauthn_credentials: WebAuthnCredentialInfo[];
+  recovery_codes_left: number /* uint64 */;
+}
+export type InternalGetUserTwoFactorResponse = ApiDataResponse<UserTwoFactorStatus>;
+export interface TotpEnrollment {
+  secret: string;
+  uri: string; // otpauth uri, to be shown as qr code
+}
+export type InternalPostUserTotpResponse = ApiDataResponse<TotpEnrollment>;
+export interface RecoveryCodes {
+  recovery_codes?: string[]; // only returned once, when the first factor is enabled or the codes are regenerated
+}
+export type InternalPostUserRecoveryCodesResponse = ApiDataResponse<RecoveryCodes>;
+export interface WebAuthnRelyingParty {
+  id: string;
+  name: string;
+}
+export interface WebAuthnUser {
+  id: string; // base64url
+  name: string;
+  displayName: string;
+}
+export interface WebAuthnCredentialParameter {
+  type: 'public-key';
+  alg: number /* int64 */;
+}
+export interface WebAuthnCredentialDescriptor {
+  type: 'public-key';
+  id: string; // base64url
+}
+export interface WebAuthnAuthenticatorSelection {
+  userVerification: 'required' | 'preferred' | 'discouraged';
+}
+/**
+ * options to be passed to navigator.credentials.create(), binary values are base64url encoded
+ */
+export interface WebAuthnCreationOptions {
+  challenge: string;
+  rp: WebAuthnRelyingParty;
+  user: WebAuthnUser;
+  pubKeyCredParams: WebAuthnCredentialParameter[];
+  timeout: number /* uint64 */;
+  excludeCredentials: WebAuthnCredentialDescriptor[];
+  attestation: 'none';
+  authenticatorSelection: WebAuthnAuthenticatorSelection;
+}
+export type InternalPostUserWebAuthnRegistrationOptionsResponse = ApiDataResponse<WebAuthnCreationOptions>;
+/**
+ * options to be passed to navigator.credentials.get(), binary values are base64url encoded
+ */
+export interface WebAuthnRequestOptions {
+  challenge: string;
+  timeout: number /* uint64 */;
+  rpId: string;
+  allowCredentials: WebAuthnCredentialDescriptor[];
+  userVerification: 'required' | 'preferred' | 'discouraged';
+}
+export type InternalPostWebAuthnAssertionOptionsResponse = ApiDataResponse<WebAuthnRequestOptions>;
+/**
+ * Sign-In with Ethereum
+ */
+export interface SiweNonce {
+  nonce: string;
+  expires_at: number /* int64 */;
+  chain_id: number /* uint64 */; // the message has to be signed for this chain
+}
+export type InternalPostSiweNonceResponse = ApiDataResponse<SiweNonce>;
+export interface VerifiedAddress {
+  address: Hash;
+  verified_at: number /* int64 */;
+}
+export type InternalGetUserVerifiedAddressesResponse = ApiDataResponse<VerifiedAddress[]>;
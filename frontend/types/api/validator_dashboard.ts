@@ -1,6 +1,7 @@
 // Code generated by tygo. DO NOT EDIT.
 /* eslint-disable */
 import type { ValidatorStateCounts, PeriodicValues, ClElValue, ChartHistorySeconds, ApiDataResponse, StatusCount, ApiPagingResponse, Luck, ChartData, ValidatorHistoryDuties, Address, PubKey, Hash, PercentageDetails } from './common'
+import type { SsvOperator } from './protocols'
 
 //////////
 // source: validator_dashboard.go
@@ -50,6 +51,7 @@ export interface VDBSummaryTableRow {
   attestations: StatusCount;
   proposals: StatusCount;
   reward: ClElValue<string /* decimal.Decimal */>;
+  effective_balance: string /* decimal.Decimal */;
 }
 export type GetValidatorDashboardSummaryResponse = ApiPagingResponse<VDBSummaryTableRow>;
 export interface VDBGroupSummaryColumnItem {
@@ -116,6 +118,10 @@ export interface VDBRewardsTableRow {
   duty: VDBRewardsTableDuty;
   group_id: number /* int64 */;
   reward: ClElValue<string /* decimal.Decimal */>;
+  /**
+   * reward valued at the price of the day of the epoch, only set if a currency was requested
+   */
+  reward_fiat?: ClElValue<number /* float64 */>;
 }
 export type GetValidatorDashboardRewardsResponse = ApiPagingResponse<VDBRewardsTableRow>;
 export interface VDBGroupRewardsDetails {
@@ -140,6 +146,7 @@ export type GetValidatorDashboardRewardsChartResponse = ApiDataResponse<ChartDat
 export interface VDBEpochDutiesTableRow {
   validator: number /* uint64 */;
   duties: ValidatorHistoryDuties;
+  ssv_operators?: number /* uint64 */[]; // operators of the ssv cluster of the validator, only set in the ssv protocol mode
 }
 export type GetValidatorDashboardDutiesResponse = ApiPagingResponse<VDBEpochDutiesTableRow>;
 /**
@@ -166,7 +173,7 @@ export interface VDBHeatmapEvents {
 export interface VDBHeatmapCell {
   x: number /* int64 */; // Timestamp
   y: number /* uint64 */; // Group ID
-  value: number /* float64 */; // Attestaton Rewards
+  value: number /* float64 */; // Total efficiency or CL rewards in gwei, depending on the metric
   events?: VDBHeatmapEvents;
 }
 export interface VDBHeatmap {
@@ -174,6 +181,7 @@ export interface VDBHeatmap {
   group_ids: number /* uint64 */[]; // Y-Axis Categories
   data: VDBHeatmapCell[];
   aggregation: 'epoch' | 'hourly' | 'daily' | 'weekly';
+  metric: 'efficiency' | 'reward';
 }
 export type GetValidatorDashboardHeatmapResponse = ApiDataResponse<VDBHeatmap>;
 export interface VDBHeatmapTooltipData {
@@ -239,6 +247,117 @@ export interface VDBWithdrawalsTableRow {
   is_missing_estimate: boolean;
 }
 export type GetValidatorDashboardWithdrawalsResponse = ApiPagingResponse<VDBWithdrawalsTableRow>;
+/**
+ * ------------------------------------------------------------
+ * Execution Layer Requests Tab
+ */
+export interface VDBWithdrawalRequestsTableRow {
+  slot: number /* uint64 */;
+  epoch: number /* uint64 */;
+  group_id: number /* uint64 */;
+  source_address: Address;
+  validator: ExecutionRequestValidator;
+  amount: string /* decimal.Decimal */;
+  is_full_exit: boolean;
+}
+export type GetValidatorDashboardWithdrawalRequestsResponse = ApiPagingResponse<VDBWithdrawalRequestsTableRow>;
+export interface VDBConsolidationsTableRow {
+  slot: number /* uint64 */;
+  epoch: number /* uint64 */;
+  group_id: number /* uint64 */; // group of the source validator, or of the target if the source is not part of the dashboard
+  source_address: Address;
+  source: ExecutionRequestValidator;
+  target: ExecutionRequestValidator;
+}
+export type GetValidatorDashboardConsolidationsResponse = ApiPagingResponse<VDBConsolidationsTableRow>;
+/**
+ * ------------------------------------------------------------
+ * Pending Queues Tab
+ */
+export interface VDBPendingDepositsTableRow {
+  position: number /* uint64 */;
+  group_id: number /* uint64 */;
+  validator: ExecutionRequestValidator;
+  amount: string /* decimal.Decimal */;
+  estimated_epoch: number /* uint64 */;
+  estimated_timestamp: number /* uint64 */;
+}
+export interface VDBPendingPartialWithdrawalsTableRow {
+  position: number /* uint64 */;
+  group_id: number /* uint64 */;
+  index: number /* uint64 */;
+  amount: string /* decimal.Decimal */;
+  estimated_epoch: number /* uint64 */;
+  estimated_timestamp: number /* uint64 */;
+}
+export interface VDBPendingConsolidationsTableRow {
+  position: number /* uint64 */;
+  group_id: number /* uint64 */; // group of the source validator, or of the target if the source is not part of the dashboard
+  source_index: number /* uint64 */;
+  target_index: number /* uint64 */;
+  estimated_epoch?: number /* uint64 */;
+  estimated_timestamp?: number /* uint64 */;
+}
+export interface VDBPendingQueuesData {
+  epoch: number /* uint64 */; // epoch of the state the queues were read from
+  deposits: VDBPendingDepositsTableRow[];
+  partial_withdrawals: VDBPendingPartialWithdrawalsTableRow[];
+  consolidations: VDBPendingConsolidationsTableRow[];
+}
+export type GetValidatorDashboardPendingQueuesResponse = ApiDataResponse<VDBPendingQueuesData>;
+export interface VDBGossipAttestationsSummary {
+  seen: number /* uint64 */; // attestations seen on gossip whose inclusion is finalized
+  included: number /* uint64 */; // seen attestations that were included in a canonical block
+  seen_before_inclusion: number /* uint64 */; // included attestations that were seen before the slot of the including block started
+  average_lead: number /* float64 */; // seconds the attestations seen before their inclusion were seen ahead of the including slot on average
+}
+export interface VDBPoolVoluntaryExitsTableRow {
+  group_id: number /* uint64 */;
+  index: number /* uint64 */;
+  epoch: number /* uint64 */;
+  seen_timestamp: number /* int64 */;
+}
+export interface VDBPoolBlsChangesTableRow {
+  group_id: number /* uint64 */;
+  index: number /* uint64 */;
+  to_execution_address: Address;
+  seen_timestamp: number /* int64 */;
+}
+export interface VDBGossipData {
+  attestations: VDBGossipAttestationsSummary;
+  voluntary_exits: VDBPoolVoluntaryExitsTableRow[]; // exits in the operation pool of the node whose inclusion is not finalized yet
+  bls_changes: VDBPoolBlsChangesTableRow[]; // bls to execution changes in the operation pool of the node whose inclusion is not finalized yet
+}
+export type GetValidatorDashboardGossipResponse = ApiDataResponse<VDBGossipData>;
+export interface VDBMevBidsTableRow {
+  slot: number /* uint64 */;
+  epoch: number /* uint64 */;
+  group_id: number /* uint64 */;
+  proposer: number /* uint64 */;
+  delivered_by_relay: boolean; // false if the block was built locally
+  delivered_value: string /* decimal.Decimal */; // value of the delivered payload or the fee recipient reward of a locally built block
+  best_bid: string /* decimal.Decimal */; // highest bid on the parent of the proposed block seen on any relay
+  best_bid_relay: string;
+  best_bid_builder: PubKey;
+  value_left: string /* decimal.Decimal */; // best bid minus the delivered value, 0 if the proposal got at least the best bid
+}
+export type GetValidatorDashboardMevBidsResponse = ApiPagingResponse<VDBMevBidsTableRow>;
+/**
+ * ------------------------------------------------------------
+ * SSV Clusters Tab
+ */
+export interface VDBSsvClusterTableRow {
+  cluster_id: Hash;
+  owner: Address;
+  is_active: boolean; // false if the cluster got liquidated
+  validators: number /* uint64 */[]; // validators of the dashboard registered with the cluster
+  /**
+   * attestations of the validators of the dashboard in the cluster in the last 7 days
+   */
+  attestations: StatusCount;
+  operators: SsvOperator[];
+}
+export type GetValidatorDashboardSsvClustersResponse = ApiDataResponse<VDBSsvClusterTableRow[]>;
 export interface VDBTotalWithdrawalsData {
   total_amount: string /* decimal.Decimal */;
 }
@@ -304,11 +423,44 @@ export interface VDBManageValidatorsTableRow {
   public_key: PubKey;
   group_id: number /* uint64 */;
   balance: string /* decimal.Decimal */;
+  effective_balance: string /* decimal.Decimal */;
   status: 'slashed' | 'exited' | 'deposited' | 'pending' | 'slashing_offline' | 'slashing_online' | 'exiting_offline' | 'exiting_online' | 'active_offline' | 'active_online';
   queue_position?: number /* uint64 */;
   withdrawal_credential: Hash;
+  verified_owner: boolean; // the dashboard owner has proven ownership of the withdrawal address
 }
 export type GetValidatorDashboardValidatorsResponse = ApiPagingResponse<VDBManageValidatorsTableRow>;
+/**
+ * ------------------------------------------------------------
+ * Members
+ */
+export interface VDBMember {
+  user_id: number /* uint64 */;
+  email: string;
+  role: 'owner' | 'admin' | 'editor' | 'viewer';
+  added_at?: number /* int64 */; // not set for the owner
+}
+export interface VDBInvite {
+  email: string;
+  role: 'admin' | 'editor' | 'viewer';
+  invited_at: number /* int64 */;
+  expires_at: number /* int64 */;
+}
+export interface VDBMembersData {
+  members: VDBMember[]; // the owner is always listed first
+  invites: VDBInvite[]; // pending invites, including expired ones
+  require_two_factor: boolean; // members without a second factor can't access the dashboard
+}
+export type GetValidatorDashboardMembersResponse = ApiDataResponse<VDBMembersData>;
+export interface VDBAuditLogTableRow {
+  timestamp: number /* int64 */;
+  user_id: number /* uint64 */;
+  email: string; // empty if the user no longer exists
+  action: 'validators_added' | 'validators_removed' | 'group_added' | 'group_removed';
+  group_id?: number /* uint64 */;
+  validators?: number /* uint64 */[]; // not set if all validators of the group were removed
+}
+export type GetValidatorDashboardAuditLogResponse = ApiPagingResponse<VDBAuditLogTableRow>;
 /**
  * ------------------------------------------------------------
  * Misc.